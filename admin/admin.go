@@ -0,0 +1,568 @@
+package admin
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/bds"
+	"github.com/d1nch8g/consensuscraft/buildinfo"
+	"github.com/d1nch8g/consensuscraft/crash"
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/keys"
+	"github.com/d1nch8g/consensuscraft/logger"
+	"github.com/d1nch8g/consensuscraft/network"
+	"github.com/d1nch8g/consensuscraft/startup"
+)
+
+// Parameters defines what the admin server exposes
+type Parameters struct {
+	Peers        *network.Peers
+	Connectivity *network.Connectivity
+	DB           *database.DB
+	Startup      *startup.Report
+	Maintenance  *network.Maintenance
+	Token        string // Required on every request when not empty
+	ViewerToken  string // Grants read-only access with Redaction applied to inventories, needs Token
+	Redaction    *database.Redaction
+	ExportRate   int    // Records per second streamed by GET /api/export, unlimited when 0
+	Quarantine   string // Directory entries failing revalidation are moved to, quarantining is refused when empty
+	Sessions     *bds.SessionLog
+	Online       func() []bds.Session  // Sessions of the players connected now
+	PackHealth   func() bds.PackHealth // Content log issues of the pack, hidden when nil
+	WebAddress   string                // This node, recorded as the server of shared inventory changes
+	Icons        *Icons                // Item icons of rendered inventories, the embedded mcpack's when nil
+}
+
+// Server is the operator HTTP API and dashboard
+type Server struct {
+	peers        *network.Peers
+	connectivity *network.Connectivity
+	db           *database.DB
+	startup      *startup.Report
+	maintenance  *network.Maintenance
+	token        string
+	viewerToken  string
+	redact       *database.Redaction
+	exportRate   int
+	quarantine   string
+	sessions     *bds.SessionLog
+	online       func() []bds.Session
+	packHealth   func() bds.PackHealth
+	webAddress   string
+	icons        *Icons
+	exports      chan struct{} // Holds a slot while an export runs
+	mux          *http.ServeMux
+}
+
+// New creates the admin server
+func New(params Parameters) *Server {
+	s := &Server{
+		peers:        params.Peers,
+		connectivity: params.Connectivity,
+		db:           params.DB,
+		startup:      params.Startup,
+		maintenance:  params.Maintenance,
+		token:        params.Token,
+		viewerToken:  params.ViewerToken,
+		redact:       params.Redaction,
+		exportRate:   params.ExportRate,
+		quarantine:   params.Quarantine,
+		sessions:     params.Sessions,
+		online:       params.Online,
+		packHealth:   params.PackHealth,
+		webAddress:   params.WebAddress,
+		icons:        params.Icons,
+		exports:      make(chan struct{}, 1),
+		mux:          http.NewServeMux(),
+	}
+	if s.icons == nil {
+		s.icons = embeddedIcons()
+	}
+
+	s.mux.HandleFunc("GET /{$}", s.dashboard)
+	s.mux.HandleFunc("GET /version", s.version)
+	s.mux.HandleFunc("GET /api/peers", s.listPeers)
+	s.mux.HandleFunc("GET /api/connectivity", s.connectivityStatus)
+	s.mux.HandleFunc("GET /api/players", s.searchPlayers)
+	s.mux.HandleFunc("GET /api/players/{player}/inventories", s.playerHistory)
+	s.mux.HandleFunc("GET /api/players/{player}/inventory", s.playerInventoryAt)
+	s.mux.HandleFunc("GET /api/players/{player}/trail", s.playerTrail)
+	s.mux.HandleFunc("POST /api/players/{player}/revalidate", s.revalidatePlayer)
+	s.mux.HandleFunc("DELETE /api/players/{player}", s.requireToken(s.deletePlayer))
+	s.mux.HandleFunc("GET /api/icons/{type}", s.itemIcon)
+	s.mux.HandleFunc("GET /api/sessions", s.listSessions)
+	s.mux.HandleFunc("GET /api/groups/{group}", s.groupInfo)
+	s.mux.HandleFunc("PUT /api/groups/{group}/members", s.setGroupMembers)
+	s.mux.HandleFunc("GET /api/groups/{group}/inventory", s.groupInventory)
+	s.mux.HandleFunc("PUT /api/groups/{group}/inventory", s.putGroupInventory)
+	s.mux.HandleFunc("GET /api/export", s.requireToken(s.exportDatabase))
+	s.mux.HandleFunc("GET /api/origins", s.listOrigins)
+	s.mux.HandleFunc("GET /api/latency", s.databaseLatency)
+	s.mux.HandleFunc("GET /api/keys", s.keyUsage)
+	s.mux.HandleFunc("GET /api/crashes", s.listCrashes)
+	s.mux.HandleFunc("GET /api/pack", s.packStatus)
+	s.mux.HandleFunc("GET /api/bans", s.listBans)
+	s.mux.HandleFunc("GET /api/bans/export", s.exportBans)
+	s.mux.HandleFunc("POST /api/bans/import", s.importBans)
+	s.mux.HandleFunc("GET /api/startup", s.startupReport)
+	s.mux.HandleFunc("GET /api/maintenance", s.maintenanceStatus)
+	s.mux.HandleFunc("POST /api/maintenance", s.setMaintenance)
+	s.mux.HandleFunc("GET /api/notices", s.listNotices)
+	s.mux.HandleFunc("POST /api/notices", s.sendNotice)
+	s.mux.HandleFunc("GET /api/freeze", s.freezeStatus)
+	s.mux.HandleFunc("POST /api/freeze", s.proposeFreeze)
+	s.mux.HandleFunc("POST /api/freeze/{id}/endorse", s.endorseFreeze)
+	s.mux.HandleFunc("GET /api/netconfig", s.networkConfigStatus)
+	s.mux.HandleFunc("POST /api/netconfig", s.publishNetworkConfig)
+	s.mux.HandleFunc("POST /api/netconfig/{version}/accept", s.acceptNetworkConfig)
+
+	return s
+}
+
+// Roles of the callers of the API
+type role int
+
+const (
+	roleNone   role = iota
+	roleViewer      // Reads through GET requests, with the redacted fields of inventories hidden
+	roleAdmin
+)
+
+type roleKey struct{}
+
+// ServeHTTP authenticates the request and dispatches it, viewers are limited to reading
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	caller := s.authorize(r)
+	switch {
+	case caller == roleNone:
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	case caller == roleViewer && r.Method != http.MethodGet && r.Method != http.MethodHead:
+		http.Error(w, "viewers are read-only", http.StatusForbidden)
+		return
+	}
+
+	s.mux.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), roleKey{}, caller)))
+}
+
+// authorize accepts the tokens as a bearer header or, for the dashboard in a browser, a token query parameter
+// Every caller is an admin when no token is configured
+func (s *Server) authorize(r *http.Request) role {
+	if s.token == "" {
+		return roleAdmin
+	}
+
+	provided := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if provided == "" {
+		provided = r.URL.Query().Get("token")
+	}
+
+	switch {
+	case subtle.ConstantTimeCompare([]byte(provided), []byte(s.token)) == 1:
+		return roleAdmin
+	case s.viewerToken != "" && subtle.ConstantTimeCompare([]byte(provided), []byte(s.viewerToken)) == 1:
+		return roleViewer
+	}
+	return roleNone
+}
+
+// redaction returns the redaction of inventories shown to the caller, nil for admins
+func (s *Server) redaction(r *http.Request) *database.Redaction {
+	if caller, _ := r.Context().Value(roleKey{}).(role); caller == roleAdmin {
+		return nil
+	}
+	return s.redact
+}
+
+// requireToken refuses a route that hands out the whole database or destroys data when no token is
+// configured, a loopback address alone does not keep out other users of the host
+func (s *Server) requireToken(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if s.token == "" {
+			http.Error(w, "this endpoint needs ADMIN_TOKEN to be set", http.StatusForbidden)
+			return
+		}
+		handler(w, r)
+	}
+}
+
+// version returns the build of this node
+func (s *Server) version(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, buildinfo.Get())
+}
+
+// listPeers returns handshaked peers with their world settings mismatches
+func (s *Server) listPeers(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, s.peers.List())
+}
+
+// connectivityStatus reports whether the node is syncing with peers or running local-only
+func (s *Server) connectivityStatus(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, s.connectivity.Status())
+}
+
+// listOrigins returns accepted, rejected and conflicting update counts per origin server
+func (s *Server) listOrigins(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, s.db.OriginStats())
+}
+
+// banStatus is the local ban list and how the lists of peers differ from it
+type banStatus struct {
+	Banned  []string            `json:"banned"`
+	Entries []network.BanEntry  `json:"entries"`
+	Reports []network.BanReport `json:"reports"`
+}
+
+// keyStatus is the usage of the keys this node signed and verified with, the anomalies flagged
+// and the age of its key and of the pinned keys of its peers
+type keyStatus struct {
+	Usage       []keys.KeyUsage   `json:"usage"`
+	Anomalies   []keys.KeyAnomaly `json:"anomalies"`
+	Credentials []keys.Credential `json:"credentials"`
+}
+
+// keyUsage returns signature counts and timings per key with the anomalies flagged since the node
+// started, and when each key is due for rotation
+func (s *Server) keyUsage(w http.ResponseWriter, r *http.Request) {
+	credentials, err := keys.Credentials(time.Now())
+	if err != nil {
+		logger.Errorf("Failed to list key ages: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, keyStatus{Usage: keys.Usage(), Anomalies: keys.Anomalies(), Credentials: credentials})
+}
+
+// databaseLatency returns the latency histograms of database calls
+func (s *Server) databaseLatency(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, s.db.LatencyStats())
+}
+
+// listCrashes returns the panics recovered since the node started, counted per task
+func (s *Server) listCrashes(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, crash.CurrentStats())
+}
+
+// packStatus returns the content log warnings and errors of the pack since the server started
+func (s *Server) packStatus(w http.ResponseWriter, r *http.Request) {
+	if s.packHealth == nil {
+		http.Error(w, "pack health is not available", http.StatusNotFound)
+		return
+	}
+
+	writeJSON(w, s.packHealth())
+}
+
+// listBans returns the servers banned here and the latest ban reconciliation with each peer
+func (s *Server) listBans(w http.ResponseWriter, r *http.Request) {
+	bans := s.peers.Bans()
+	if bans == nil {
+		http.Error(w, "ban reconciliation is disabled", http.StatusNotFound)
+		return
+	}
+
+	writeJSON(w, banStatus{Banned: bans.List(), Entries: bans.Entries(), Reports: bans.Reports()})
+}
+
+// exportBans returns the local bans as a ban list signed by this node, for allied networks
+func (s *Server) exportBans(w http.ResponseWriter, r *http.Request) {
+	bans := s.peers.Bans()
+	if bans == nil {
+		http.Error(w, "ban reconciliation is disabled", http.StatusNotFound)
+		return
+	}
+
+	list, err := bans.Export()
+	if errors.Is(err, network.ErrBanListsDisabled) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		logger.Errorf("Failed to export bans: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, list)
+}
+
+// importBans verifies a ban list exported by an allied network and bans the servers it lists
+func (s *Server) importBans(w http.ResponseWriter, r *http.Request) {
+	bans := s.peers.Bans()
+	if bans == nil {
+		http.Error(w, "ban reconciliation is disabled", http.StatusNotFound)
+		return
+	}
+
+	var list network.BanList
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20)).Decode(&list); err != nil {
+		http.Error(w, "invalid ban list: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	report, err := bans.Import(&list)
+	switch {
+	case errors.Is(err, network.ErrBanListsDisabled):
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case errors.Is(err, network.ErrInvalidBanList):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	case errors.Is(err, network.ErrUntrustedBanList):
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	case err != nil:
+		logger.Errorf("Failed to import bans of %s: %v", list.Issuer, err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, report)
+}
+
+// startupReport returns how long each startup phase took and which one failed
+func (s *Server) startupReport(w http.ResponseWriter, r *http.Request) {
+	if s.startup == nil {
+		http.Error(w, "no startup report", http.StatusNotFound)
+		return
+	}
+
+	writeJSON(w, s.startup.Snapshot())
+}
+
+// MaintenanceRequest switches maintenance mode through POST /api/maintenance
+type MaintenanceRequest struct {
+	Enabled bool   `json:"enabled"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// maintenanceStatus reports whether the node is in maintenance
+func (s *Server) maintenanceStatus(w http.ResponseWriter, r *http.Request) {
+	if s.maintenance == nil {
+		http.Error(w, "maintenance mode is not available", http.StatusNotFound)
+		return
+	}
+
+	writeJSON(w, s.maintenance.Status())
+}
+
+// setMaintenance enters or leaves maintenance mode, returning the new status
+func (s *Server) setMaintenance(w http.ResponseWriter, r *http.Request) {
+	if s.maintenance == nil {
+		http.Error(w, "maintenance mode is not available", http.StatusNotFound)
+		return
+	}
+
+	var req MaintenanceRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 4096)).Decode(&req); err != nil {
+		http.Error(w, "invalid maintenance request: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := s.maintenance.Set(req.Enabled, req.Reason); err != nil {
+		logger.Errorf("Failed to switch maintenance mode: %v", err)
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	writeJSON(w, s.maintenance.Status())
+}
+
+// NoticeRequest sends an operator notice to peers through POST /api/notices
+type NoticeRequest struct {
+	Author string `json:"author"`
+	Text   string `json:"text"`
+}
+
+// listNotices returns the operator notices sent and received, newest first
+func (s *Server) listNotices(w http.ResponseWriter, r *http.Request) {
+	notices := s.peers.Notices()
+	if notices == nil {
+		http.Error(w, "operator notices are disabled", http.StatusNotFound)
+		return
+	}
+
+	writeJSON(w, notices.List())
+}
+
+// sendNotice signs a notice and queues it for every peer, returning the queued notice
+func (s *Server) sendNotice(w http.ResponseWriter, r *http.Request) {
+	notices := s.peers.Notices()
+	if notices == nil {
+		http.Error(w, "operator notices are disabled", http.StatusNotFound)
+		return
+	}
+
+	var req NoticeRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 4096)).Decode(&req); err != nil {
+		http.Error(w, "invalid notice request: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	notice, err := notices.Send(req.Author, req.Text)
+	if errors.Is(err, network.ErrInvalidNotice) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		logger.Errorf("Failed to send notice: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, notice)
+}
+
+// FreezeRequest proposes to freeze or unfreeze inventory updates network-wide through POST /api/freeze
+type FreezeRequest struct {
+	Frozen bool   `json:"frozen"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// freezeStatus reports the freeze order in effect and the proposals waiting for signatures
+func (s *Server) freezeStatus(w http.ResponseWriter, r *http.Request) {
+	freeze := s.peers.Freeze()
+	if freeze == nil {
+		http.Error(w, "freeze is not available", http.StatusNotFound)
+		return
+	}
+
+	writeJSON(w, freeze.Status())
+}
+
+// proposeFreeze signs a new freeze or unfreeze order, returning the proposal
+func (s *Server) proposeFreeze(w http.ResponseWriter, r *http.Request) {
+	freeze := s.peers.Freeze()
+	if freeze == nil {
+		http.Error(w, "freeze is not available", http.StatusNotFound)
+		return
+	}
+
+	var req FreezeRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 4096)).Decode(&req); err != nil {
+		http.Error(w, "invalid freeze request: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	order, err := freeze.Propose(req.Frozen, req.Reason)
+	if err != nil {
+		writeFreezeError(w, err)
+		return
+	}
+	writeJSON(w, order)
+}
+
+// endorseFreeze adds this node's signature to a pending proposal, returning it
+func (s *Server) endorseFreeze(w http.ResponseWriter, r *http.Request) {
+	freeze := s.peers.Freeze()
+	if freeze == nil {
+		http.Error(w, "freeze is not available", http.StatusNotFound)
+		return
+	}
+
+	order, err := freeze.Endorse(r.PathValue("id"))
+	if err != nil {
+		writeFreezeError(w, err)
+		return
+	}
+	writeJSON(w, order)
+}
+
+func writeFreezeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, network.ErrFreezeDisabled), errors.Is(err, network.ErrNotFreezeSigner):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case errors.Is(err, network.ErrUnknownProposal):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, network.ErrInvalidFreeze):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	default:
+		logger.Errorf("Failed to sign freeze order: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// networkConfigStatus reports the accepted network configuration and the version awaiting review
+func (s *Server) networkConfigStatus(w http.ResponseWriter, r *http.Request) {
+	configs := s.peers.NetworkConfigs()
+	if configs == nil {
+		http.Error(w, "network configuration is not available", http.StatusNotFound)
+		return
+	}
+
+	writeJSON(w, configs.Status())
+}
+
+// publishNetworkConfig signs the settings in the body as the next version of the network
+// configuration, returning it
+func (s *Server) publishNetworkConfig(w http.ResponseWriter, r *http.Request) {
+	configs := s.peers.NetworkConfigs()
+	if configs == nil {
+		http.Error(w, "network configuration is not available", http.StatusNotFound)
+		return
+	}
+
+	var settings network.NetworkSettings
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<16))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&settings); err != nil {
+		http.Error(w, "invalid network configuration: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	config, err := configs.Publish(settings)
+	if err != nil {
+		writeNetworkConfigError(w, err)
+		return
+	}
+	writeJSON(w, config)
+}
+
+// acceptNetworkConfig applies the version of the network configuration awaiting review, returning it
+func (s *Server) acceptNetworkConfig(w http.ResponseWriter, r *http.Request) {
+	configs := s.peers.NetworkConfigs()
+	if configs == nil {
+		http.Error(w, "network configuration is not available", http.StatusNotFound)
+		return
+	}
+
+	version, err := strconv.ParseUint(r.PathValue("version"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid version: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	config, err := configs.Accept(version)
+	if err != nil {
+		writeNetworkConfigError(w, err)
+		return
+	}
+	writeJSON(w, config)
+}
+
+func writeNetworkConfigError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, network.ErrNetworkConfigDisabled), errors.Is(err, network.ErrNotConfigSigner):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case errors.Is(err, network.ErrNoPendingConfig):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, network.ErrInvalidNetworkConfig):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	default:
+		logger.Errorf("Failed to update network configuration: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		logger.Errorf("Failed to write admin response: %v", err)
+	}
+}
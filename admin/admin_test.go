@@ -0,0 +1,711 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/bds"
+	"github.com/d1nch8g/consensuscraft/buildinfo"
+	"github.com/d1nch8g/consensuscraft/crash"
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/keys"
+	"github.com/d1nch8g/consensuscraft/network"
+	"github.com/d1nch8g/consensuscraft/startup"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPeers() *network.Peers {
+	peers := network.NewPeers(&bds.WorldSettings{SeedHash: "seed", Difficulty: "normal", Gamemode: "survival"})
+	peers.Record("good.example.com", nil, &bds.WorldSettings{SeedHash: "seed", Difficulty: "normal", Gamemode: "survival"})
+	peers.Record("bad.example.com", nil, &bds.WorldSettings{SeedHash: "seed", Difficulty: "peaceful", Gamemode: "survival"})
+	return peers
+}
+
+func TestServer_Authorization(t *testing.T) {
+	server := New(Parameters{
+		Peers:        newTestPeers(),
+		Connectivity: network.NewConnectivity(time.Minute, nil),
+		Token:        "secret",
+		ViewerToken:  "community",
+	})
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		header string
+		status int
+	}{
+		{name: "missing token", path: "/api/peers", status: http.StatusUnauthorized},
+		{name: "wrong token", path: "/api/peers", header: "Bearer nope", status: http.StatusUnauthorized},
+		{name: "bearer token", path: "/api/peers", header: "Bearer secret", status: http.StatusOK},
+		{name: "query token", path: "/?token=secret", status: http.StatusOK},
+		{name: "viewer reads", path: "/api/peers", header: "Bearer community", status: http.StatusOK},
+		{name: "viewer writes", method: http.MethodPost, path: "/api/notices", header: "Bearer community", status: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			method := tt.method
+			if method == "" {
+				method = http.MethodGet
+			}
+			req := httptest.NewRequest(method, tt.path, nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			rec := httptest.NewRecorder()
+
+			server.ServeHTTP(rec, req)
+			assert.Equal(t, tt.status, rec.Code)
+		})
+	}
+}
+
+func TestServer_ListPeers(t *testing.T) {
+	server := New(Parameters{Peers: newTestPeers(), Connectivity: network.NewConnectivity(time.Minute, nil)})
+
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/peers", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var peers []network.Peer
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &peers))
+	require.Len(t, peers, 2)
+	assert.Equal(t, "bad.example.com", peers[0].WebAddress)
+	assert.Equal(t, []string{`difficulty "normal" != "peaceful"`}, peers[0].Mismatches)
+	assert.Empty(t, peers[1].Mismatches)
+}
+
+func TestServer_Version(t *testing.T) {
+	server := New(Parameters{Peers: newTestPeers(), Connectivity: network.NewConnectivity(time.Minute, nil)})
+
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/version", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var info buildinfo.Info
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &info))
+	assert.Equal(t, buildinfo.Get().Version, info.Version)
+	assert.Equal(t, buildinfo.Protocols, info.Protocols)
+	assert.NotEmpty(t, info.PackVersion)
+}
+
+func TestServer_KeyUsage(t *testing.T) {
+	server := New(Parameters{Peers: newTestPeers(), Connectivity: network.NewConnectivity(time.Minute, nil)})
+
+	public, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	assert.Error(t, keys.VerifyPublic(public, "alice", []byte(`[]`), make([]byte, ed25519.SignatureSize)))
+
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/keys", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var status keyStatus
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	require.NotEmpty(t, status.Usage)
+	assert.NotNil(t, status.Anomalies)
+
+	failures := uint64(0)
+	for _, usage := range status.Usage {
+		failures += usage.Failures
+	}
+	assert.NotZero(t, failures)
+}
+
+func TestServer_Dashboard(t *testing.T) {
+	server := New(Parameters{Peers: newTestPeers(), Connectivity: network.NewConnectivity(time.Minute, nil)})
+
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	body := rec.Body.String()
+	assert.Contains(t, body, "good.example.com")
+	assert.Contains(t, body, `class="mismatch"`)
+	assert.Contains(t, body, "difficulty &#34;normal&#34; != &#34;peaceful&#34;")
+	assert.Contains(t, body, "<td>unknown</td>") // Peers recorded without a build
+
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/missing", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestServer_KeyExpiry(t *testing.T) {
+	originalDir, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(t.TempDir()))
+	t.Cleanup(func() { os.Chdir(originalDir) })
+
+	km, err := keys.New("a.example.com")
+	require.NoError(t, err)
+	public, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	require.NoError(t, km.Save("b.example.com", public))
+	old := time.Now().Add(-100 * 24 * time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join("keys", "b.example.com.public.key"), old, old))
+
+	keys.SetKeyLifetime(90*24*time.Hour, 30*24*time.Hour)
+	defer keys.SetKeyLifetime(0, 0)
+	server := New(Parameters{Peers: newTestPeers(), Connectivity: network.NewConnectivity(time.Minute, nil)})
+
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/keys", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var status keyStatus
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	require.Len(t, status.Credentials, 2)
+	assert.True(t, status.Credentials[0].Own)
+	assert.Equal(t, keys.CredentialOK, status.Credentials[0].Status)
+	assert.Equal(t, keys.CredentialExpired, status.Credentials[1].Status)
+
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Pinned key of b.example.com expired on")
+	assert.NotContains(t, rec.Body.String(), "Key of this node")
+}
+
+func TestServer_PackHealth(t *testing.T) {
+	rec := httptest.NewRecorder()
+	New(Parameters{Peers: newTestPeers(), Connectivity: network.NewConnectivity(time.Minute, nil)}).
+		ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/pack", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	health := bds.PackHealth{Status: bds.PackUnreliable, Issues: []bds.PackIssue{{
+		Kind: "script", Level: "error", Count: 3, Message: "main.js ran with error", LastSeen: time.Now(), Capture: true,
+	}}}
+	server := New(Parameters{
+		Peers:        newTestPeers(),
+		Connectivity: network.NewConnectivity(time.Minute, nil),
+		PackHealth:   func() bds.PackHealth { return health },
+	})
+
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/pack", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var status bds.PackHealth
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	assert.Equal(t, bds.PackUnreliable, status.Status)
+	require.Len(t, status.Issues, 1)
+	assert.Equal(t, 3, status.Issues[0].Count)
+
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "may keep ender chest updates from being captured")
+	assert.Contains(t, rec.Body.String(), "main.js ran with error")
+}
+
+func TestServer_Connectivity(t *testing.T) {
+	connectivity := network.NewConnectivity(time.Minute, nil)
+	server := New(Parameters{Peers: newTestPeers(), Connectivity: connectivity})
+
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.NotContains(t, rec.Body.String(), "Local-only mode")
+
+	connectivity.Unreachable(errors.New("connection refused"))
+	connectivity.Queue("alice")
+
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/connectivity", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var status network.ConnectivityStatus
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	assert.True(t, status.LocalOnly)
+	assert.Equal(t, "connection refused", status.LastError)
+	assert.Equal(t, 1, status.Queued)
+
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Contains(t, rec.Body.String(), "Local-only mode")
+	assert.Contains(t, rec.Body.String(), "1 player updates queued")
+}
+
+func TestServer_Origins(t *testing.T) {
+	db, err := database.NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, db.Put("alice", []byte(`[]`), "good.example.com"))
+	db.RecordRejected("bad.example.com", "signature")
+
+	server := New(Parameters{Peers: newTestPeers(), Connectivity: network.NewConnectivity(time.Minute, nil), DB: db})
+
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/origins", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var origins []database.OriginStats
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &origins))
+	require.Len(t, origins, 2)
+	assert.Equal(t, map[string]int{"signature": 1}, origins[0].Rejected)
+	assert.Equal(t, 1, origins[1].Accepted)
+
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Contains(t, rec.Body.String(), "Origin servers")
+	assert.Contains(t, rec.Body.String(), "signature: 1")
+}
+
+func TestServer_Latency(t *testing.T) {
+	db, err := database.NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, db.Put("alice", []byte(`[]`), "a.example.com"))
+	_, err = db.Get("alice")
+	require.NoError(t, err)
+
+	server := New(Parameters{Peers: newTestPeers(), Connectivity: network.NewConnectivity(time.Minute, nil), DB: db})
+
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/latency", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var latency []database.LatencyStats
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &latency))
+	require.Len(t, latency, 2)
+	assert.Equal(t, "get", latency[0].Operation)
+	assert.Equal(t, "put", latency[1].Operation)
+	assert.Equal(t, 1, latency[1].Count)
+}
+
+func TestServer_Crashes(t *testing.T) {
+	server := New(Parameters{Peers: newTestPeers(), Connectivity: network.NewConnectivity(time.Minute, nil)})
+
+	before := crash.CurrentStats().ByTask["admin test"]
+	func() {
+		defer crash.Guard("admin test")
+		panic("boom")
+	}()
+
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/crashes", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var stats crash.Stats
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &stats))
+	assert.Equal(t, before+1, stats.ByTask["admin test"])
+	require.NotEmpty(t, stats.Recent)
+	assert.Equal(t, "boom", stats.Recent[0].Panic)
+}
+
+func TestServer_Bans(t *testing.T) {
+	peers := newTestPeers()
+	server := New(Parameters{Peers: peers, Connectivity: network.NewConnectivity(time.Minute, nil)})
+
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/bans", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	bans := network.NewBans(network.BanManual, []string{"griefers.example.com"}, func(string) error { return nil })
+	bans.Reconcile("good.example.com", []string{"cheaters.example.com"})
+	peers.SetBans(bans)
+
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/bans", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var status struct {
+		Banned  []string            `json:"banned"`
+		Reports []network.BanReport `json:"reports"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	assert.Equal(t, []string{"griefers.example.com"}, status.Banned)
+	require.Len(t, status.Reports, 1)
+	assert.Equal(t, []string{"cheaters.example.com"}, status.Reports[0].Pending)
+	assert.Equal(t, []string{"griefers.example.com"}, status.Reports[0].NotBannedBy)
+}
+
+func TestServer_BanLists(t *testing.T) {
+	originalDir, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(t.TempDir()))
+	t.Cleanup(func() { os.Chdir(originalDir) })
+
+	allyKeys, err := keys.New("ally.example.com")
+	require.NoError(t, err)
+	ally := network.NewBans(network.BanManual, []string{"griefers.example.com"}, func(string) error { return nil })
+	require.NoError(t, ally.EnableExchange(allyKeys, "ally.example.com", nil, "ally_bans.json"))
+	allyPeers := newTestPeers()
+	allyPeers.SetBans(ally)
+	allyServer := New(Parameters{Peers: allyPeers, Connectivity: network.NewConnectivity(time.Minute, nil)})
+
+	rec := httptest.NewRecorder()
+	allyServer.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/bans/export", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	exported := rec.Body.Bytes()
+
+	km, err := keys.New("local.example.com")
+	require.NoError(t, err)
+	bans := network.NewBans(network.BanManual, nil, func(string) error { return nil })
+	require.NoError(t, bans.EnableExchange(km, "local.example.com", nil, "imported_bans.json"))
+	peers := newTestPeers()
+	peers.SetBans(bans)
+	server := New(Parameters{Peers: peers, Connectivity: network.NewConnectivity(time.Minute, nil)})
+
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/bans/import", bytes.NewReader(exported)))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var report network.BanImport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+	assert.Equal(t, []string{"griefers.example.com"}, report.Adopted)
+	assert.Equal(t, []string{"griefers.example.com"}, bans.List())
+
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/bans/import", strings.NewReader(`{"issuer":"stranger.example.com","signature":"AAAA"}`)))
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/bans/import", strings.NewReader(`{"issuer":"ally.example.com"}`)))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestServer_Startup(t *testing.T) {
+	server := New(Parameters{Peers: newTestPeers(), Connectivity: network.NewConnectivity(time.Minute, nil)})
+
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/startup", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	report := startup.NewReport()
+	require.NoError(t, report.Run(startup.Phase{Name: "keys", Run: func() error { return nil }}))
+	server = New(Parameters{Peers: newTestPeers(), Connectivity: network.NewConnectivity(time.Minute, nil), Startup: report})
+
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/startup", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var snapshot startup.Snapshot
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &snapshot))
+	require.Len(t, snapshot.Phases, 1)
+	assert.Equal(t, "keys", snapshot.Phases[0].Name)
+	assert.Equal(t, startup.StatusOK, snapshot.Phases[0].Status)
+}
+
+func TestServer_Maintenance(t *testing.T) {
+	server := New(Parameters{Peers: newTestPeers(), Connectivity: network.NewConnectivity(time.Minute, nil)})
+
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/maintenance", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	var switched []string
+	maintenance := network.NewMaintenance(func(enabled bool, reason string) error {
+		if reason == "fail" {
+			return errors.New("server is not running")
+		}
+		switched = append(switched, fmt.Sprintf("%t %s", enabled, reason))
+		return nil
+	})
+	server = New(Parameters{Peers: newTestPeers(), Connectivity: network.NewConnectivity(time.Minute, nil), Maintenance: maintenance})
+
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/maintenance", strings.NewReader(`{"enabled":true,"reason":"upgrade"}`)))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var status network.MaintenanceStatus
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	assert.True(t, status.Enabled)
+	assert.Equal(t, "upgrade", status.Reason)
+	assert.Equal(t, []string{"true upgrade"}, switched)
+
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/maintenance", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	assert.True(t, status.Enabled)
+
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/maintenance", strings.NewReader(`{"enabled":`)))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// A switch the server could not apply leaves the mode unchanged
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/maintenance", strings.NewReader(`{"enabled":true,"reason":"fail"}`)))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Equal(t, "upgrade", maintenance.Status().Reason)
+}
+
+func TestServer_Notices(t *testing.T) {
+	originalDir, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(t.TempDir()))
+	t.Cleanup(func() { os.Chdir(originalDir) })
+
+	peers := newTestPeers()
+	server := New(Parameters{Peers: peers, Connectivity: network.NewConnectivity(time.Minute, nil)})
+
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/notices", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	km, err := keys.New("local.example.com")
+	require.NoError(t, err)
+	peers.SetNotices(network.NewNotices(km, "local.example.com"))
+
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/notices", strings.NewReader(`{"author":"alice","text":"Downtime at 22:00 UTC"}`)))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var sent network.Notice
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &sent))
+	assert.Equal(t, "alice", sent.Author)
+	assert.True(t, sent.Local)
+
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/notices", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var notices []network.Notice
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &notices))
+	require.Len(t, notices, 1)
+	assert.Equal(t, "Downtime at 22:00 UTC", notices[0].Text)
+
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Contains(t, rec.Body.String(), "Downtime at 22:00 UTC")
+
+	for _, body := range []string{`{"author":`, `{"author":"alice","text":""}`} {
+		rec = httptest.NewRecorder()
+		server.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/notices", strings.NewReader(body)))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	}
+}
+
+func TestServer_Freeze(t *testing.T) {
+	originalDir, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(t.TempDir()))
+	t.Cleanup(func() { os.Chdir(originalDir) })
+
+	peers := newTestPeers()
+	server := New(Parameters{Peers: peers, Connectivity: network.NewConnectivity(time.Minute, nil)})
+
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/freeze", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	km, err := keys.New("local.example.com")
+	require.NoError(t, err)
+	freeze, err := network.NewFreeze(km, "local.example.com", []string{"local.example.com", "good.example.com"}, 2, nil)
+	require.NoError(t, err)
+	peers.SetFreeze(freeze)
+
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/freeze", strings.NewReader(`{"frozen":true,"reason":"dupe exploit"}`)))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var order network.FreezeOrder
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &order))
+	assert.True(t, order.Frozen)
+	assert.Equal(t, []string{"local.example.com"}, order.Signers)
+
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/freeze", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var status network.FreezeStatus
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	assert.False(t, status.Frozen)
+	assert.Equal(t, 2, status.Quorum)
+	require.Len(t, status.Pending, 1)
+	assert.Equal(t, order.ID, status.Pending[0].ID)
+
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Contains(t, rec.Body.String(), "Freeze proposal "+order.ID)
+
+	// Endorsing twice on one node adds no signature
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/freeze/"+order.ID+"/endorse", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.False(t, freeze.Frozen())
+
+	tests := map[string]struct {
+		path string
+		body string
+		code int
+	}{
+		"unknown proposal": {path: "/api/freeze/unknown/endorse", code: http.StatusNotFound},
+		"invalid body":     {path: "/api/freeze", body: `{"frozen":`, code: http.StatusBadRequest},
+		"invalid reason":   {path: "/api/freeze", body: `{"frozen":true,"reason":"a\nb"}`, code: http.StatusBadRequest},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			server.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body)))
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+
+	outsider, err := network.NewFreeze(km, "local.example.com", []string{"good.example.com"}, 1, nil)
+	require.NoError(t, err)
+	peers.SetFreeze(outsider)
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/freeze", strings.NewReader(`{"frozen":true,"reason":"dupe"}`)))
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestServer_NetworkConfig(t *testing.T) {
+	originalDir, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(t.TempDir()))
+	t.Cleanup(func() { os.Chdir(originalDir) })
+
+	peers := newTestPeers()
+	server := New(Parameters{Peers: peers, Connectivity: network.NewConnectivity(time.Minute, nil)})
+
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/netconfig", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	km, err := keys.New("local.example.com")
+	require.NoError(t, err)
+	configs, err := network.NewNetworkConfigs(km, "local.example.com", []string{"local.example.com"}, network.ConfigReview, "network_config.json", nil)
+	require.NoError(t, err)
+	peers.SetNetworkConfigs(configs)
+
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/netconfig", strings.NewReader(`{"banned_nodes":["griefers.example.com"],"origin_quorum":2}`)))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var published network.NetworkConfig
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &published))
+	assert.Equal(t, uint64(1), published.Version)
+	assert.Equal(t, "local.example.com", published.Issuer)
+	assert.NotEmpty(t, published.Signature)
+
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/netconfig", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var status network.NetworkConfigStatus
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	assert.Equal(t, network.ConfigReview, status.Policy)
+	require.NotNil(t, status.Current)
+	assert.Equal(t, []string{"griefers.example.com"}, status.Current.Settings.BannedNodes)
+	assert.Nil(t, status.Pending)
+
+	tests := map[string]struct {
+		path string
+		body string
+		code int
+	}{
+		"nothing to accept": {path: "/api/netconfig/2/accept", code: http.StatusNotFound},
+		"invalid version":   {path: "/api/netconfig/latest/accept", code: http.StatusBadRequest},
+		"invalid body":      {path: "/api/netconfig", body: `{"origin_quorum":`, code: http.StatusBadRequest},
+		"unknown setting":   {path: "/api/netconfig", body: `{"max_players":10}`, code: http.StatusBadRequest},
+		"invalid setting":   {path: "/api/netconfig", body: `{"namespace_action":"drop"}`, code: http.StatusBadRequest},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			server.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body)))
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+
+	outsider, err := network.NewNetworkConfigs(km, "local.example.com", []string{"good.example.com"}, network.ConfigAccept, "outsider.json", nil)
+	require.NoError(t, err)
+	peers.SetNetworkConfigs(outsider)
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/netconfig", strings.NewReader(`{"origin_quorum":2}`)))
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestServer_Export(t *testing.T) {
+	db, err := database.NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+	for _, player := range []string{"alice", "bob", "carol"} {
+		require.NoError(t, db.Put(player, []byte(`[]`), "good.example.com"))
+	}
+	server := New(Parameters{Peers: newTestPeers(), Connectivity: network.NewConnectivity(time.Minute, nil), DB: db, ExportRate: 1000, Token: "secret"})
+
+	export := func(query string) (*httptest.ResponseRecorder, []database.ExportRecord) {
+		req := httptest.NewRequest(http.MethodGet, "/api/export"+query, nil)
+		req.Header.Set("Authorization", "Bearer secret")
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+
+		var records []database.ExportRecord
+		if rec.Code != http.StatusOK {
+			return rec, records
+		}
+		decoder := json.NewDecoder(strings.NewReader(rec.Body.String()))
+		for decoder.More() {
+			var record database.ExportRecord
+			require.NoError(t, decoder.Decode(&record))
+			records = append(records, record)
+		}
+		return rec, records
+	}
+
+	rec, records := export("?limit=2")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/x-ndjson", rec.Header().Get("Content-Type"))
+	assert.Equal(t, 2, strings.Count(rec.Body.String(), "\n"))
+	require.Len(t, records, 2)
+	assert.Equal(t, "alice", records[0].Player)
+	assert.Equal(t, "bob", records[1].Player)
+
+	// An interrupted export resumes after the last record received
+	_, records = export("?cursor=" + records[1].Cursor)
+	require.Len(t, records, 1)
+	assert.Equal(t, "carol", records[0].Player)
+
+	_, records = export("?server=other.example.com")
+	assert.Empty(t, records)
+
+	rec, _ = export("?cursor=not-base64!")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec, _ = export("?since=yesterday")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// A second export waits for the running one to finish
+	server.exports <- struct{}{}
+	rec, _ = export("")
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.NotEmpty(t, rec.Header().Get("Retry-After"))
+	<-server.exports
+
+	// Without a token the database is not handed out, whatever address the API listens on
+	open := New(Parameters{Peers: newTestPeers(), Connectivity: network.NewConnectivity(time.Minute, nil), DB: db})
+	rec = httptest.NewRecorder()
+	open.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/export", nil))
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestPacer(t *testing.T) {
+	pace := newPacer(100)
+	start := time.Now()
+	for range 5 {
+		require.NoError(t, pace.wait(context.Background()))
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	slow := newPacer(1)
+	require.NoError(t, slow.wait(ctx))
+	assert.ErrorIs(t, slow.wait(ctx), context.Canceled)
+
+	unlimited := newPacer(0)
+	assert.NoError(t, unlimited.wait(ctx))
+}
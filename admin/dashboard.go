@@ -0,0 +1,265 @@
+package admin
+
+import (
+	"errors"
+	"html/template"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/bds"
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/keys"
+	"github.com/d1nch8g/consensuscraft/logger"
+	"github.com/d1nch8g/consensuscraft/network"
+)
+
+var dashboardTemplate = template.Must(template.New("dashboard").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Consensuscraft</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 0.3em 0.8em; text-align: left; }
+.mismatch { background: #fdd; }
+.banner { background: #fd8; border: 1px solid #c90; padding: 0.5em 1em; }
+.chest { display: grid; grid-template-columns: repeat(9, 40px); gap: 2px; margin: 0.5em 0; }
+.slot { position: relative; width: 40px; height: 40px; background: #8b8b8b; border: 2px inset #ccc; box-sizing: border-box; }
+.slot img { width: 32px; height: 32px; margin: 2px; image-rendering: pixelated; }
+.slot .label { display: block; text-align: center; line-height: 36px; font-size: 0.8em; color: #fff; }
+.slot .amount { position: absolute; right: 2px; bottom: 0; color: #fff; font-size: 0.8em; text-shadow: 1px 1px #000; }
+.slot details { width: 100%; height: 100%; }
+.slot summary { list-style: none; cursor: pointer; height: 100%; }
+.slot details[open] > .chest { position: absolute; z-index: 1; background: #c6c6c6; border: 2px solid #555; padding: 4px; }
+</style>
+</head>
+<body>
+<h1>Consensuscraft</h1>
+{{with .Connectivity}}{{if .LocalOnly}}
+<p class="banner">Local-only mode since {{.Since.Format "2006-01-02 15:04:05"}}: no peers reachable{{with .LastError}} ({{.}}){{end}}, {{.Queued}} player updates queued</p>
+{{end}}{{end}}
+{{with .Freeze}}{{if .Frozen}}
+<p class="banner">Inventory updates frozen network-wide since {{.Since.Format "2006-01-02 15:04:05"}}{{with .Reason}}: {{.}}{{end}}</p>
+{{end}}{{range .Pending}}
+<p class="banner">Freeze proposal {{.ID}} from {{.Issuer}} to {{if .Frozen}}freeze{{else}}unfreeze{{end}} inventory updates{{with .Reason}} ({{.}}){{end}}, signed by {{range .Signers}}{{.}} {{end}}</p>
+{{end}}{{end}}
+{{range .Credentials}}{{if ne .Status "ok"}}
+<p class="banner">{{if .Own}}Key of this node{{else}}Pinned key of {{.Server}}{{end}} {{if eq .Status "expired"}}expired{{else}}expires{{end}} on {{.ExpiresAt.Format "2006-01-02"}},
+{{if .Own}}run consensuscraft rotate-keys and restart the node{{else}}ask its operator to rotate it{{end}}</p>
+{{end}}{{end}}
+{{with .Pack}}{{if eq .Status "unreliable"}}
+<p class="banner">The pack reported errors that may keep ender chest updates from being captured, see Pack health below</p>
+{{end}}{{end}}
+<h2>World</h2>
+{{with .Local}}
+<p>Port {{.Port}}, difficulty {{.Difficulty}}, gamemode {{.Gamemode}}{{if .ForceGamemode}} (forced){{end}}{{if .AllowCheats}}, cheats allowed{{end}}</p>
+{{else}}
+<p>World settings unavailable</p>
+{{end}}
+<h2>Peers</h2>
+<table>
+<tr><th>Server</th><th>Connected</th><th>Version</th><th>Port</th><th>Difficulty</th><th>Gamemode</th><th>Ruleset</th></tr>
+{{range .Peers}}
+<tr{{if .Mismatches}} class="mismatch"{{end}}>
+<td>{{.WebAddress}}</td>
+<td>{{.ConnectedAt.Format "2006-01-02 15:04:05"}}</td>
+<td>{{with .Build}}{{.Version}}{{with .PackVersion}}, pack {{.}}{{end}}{{else}}unknown{{end}}</td>
+<td>{{with .World}}{{with .Port}}{{.}}{{end}}{{end}}</td>
+<td>{{with .World}}{{.Difficulty}}{{end}}</td>
+<td>{{with .World}}{{.Gamemode}}{{end}}</td>
+<td>{{if .Mismatches}}{{range .Mismatches}}{{.}}<br>{{end}}{{else}}matches{{end}}</td>
+</tr>
+{{else}}
+<tr><td colspan="7">No peers connected</td></tr>
+{{end}}
+</table>
+<h2>Keys</h2>
+<table>
+<tr><th>Server</th><th>Key</th><th>Since</th><th>Rotate by</th></tr>
+{{range .Credentials}}
+<tr{{if ne .Status "ok"}} class="mismatch"{{end}}>
+<td>{{if .Own}}this server{{else}}{{.Server}}{{end}}</td>
+<td>{{.Key}}</td>
+<td>{{.Since.Format "2006-01-02"}}</td>
+<td>{{if .ExpiresAt.IsZero}}never{{else}}{{.ExpiresAt.Format "2006-01-02"}}{{end}}</td>
+</tr>
+{{else}}
+<tr><td colspan="4">No keys</td></tr>
+{{end}}
+</table>
+{{with .Pack}}
+<h2>Pack health</h2>
+<p>Status: {{.Status}}</p>
+{{if .Issues}}
+<table>
+<tr><th>Kind</th><th>Level</th><th>Count</th><th>Last seen</th><th>Latest message</th></tr>
+{{range .Issues}}
+<tr{{if .Capture}} class="mismatch"{{end}}>
+<td>{{.Kind}}</td>
+<td>{{.Level}}</td>
+<td>{{.Count}}</td>
+<td>{{.LastSeen.Format "2006-01-02 15:04:05"}}</td>
+<td>{{.Message}}</td>
+</tr>
+{{end}}
+</table>
+{{end}}
+{{end}}
+<h2>Origin servers</h2>
+<table>
+<tr><th>Server</th><th>Accepted</th><th>Rejected</th><th>Conflicts</th><th>Last seen</th></tr>
+{{range .Origins}}
+<tr{{if or .RejectedTotal .Conflicts}} class="mismatch"{{end}}>
+<td>{{.Server}}</td>
+<td>{{.Accepted}}</td>
+<td>{{.RejectedTotal}}{{range $reason, $count := .Rejected}}<br>{{$reason}}: {{$count}}{{end}}</td>
+<td>{{.Conflicts}}</td>
+<td>{{.LastSeen.Format "2006-01-02 15:04:05"}}</td>
+</tr>
+{{else}}
+<tr><td colspan="5">No updates received yet</td></tr>
+{{end}}
+</table>
+<h2>Players</h2>
+<form method="get" action="/">
+<input name="player" placeholder="Player name" value="{{.Player}}">
+{{with .Token}}<input type="hidden" name="token" value="{{.}}">{{end}}
+<button type="submit">Search</button>
+</form>
+{{with .Matches}}
+<p>Matching players: {{range .}}<a href="{{.URL}}">{{.Name}}</a> {{end}}</p>
+{{end}}
+{{with .Inventory}}
+<h3>Ender chest of {{$.Player}}</h3>
+<p>Stored by {{.Entry.Server}} at {{.Entry.Timestamp.Format "2006-01-02 15:04:05"}}</p>
+{{template "slots" .Slots}}
+{{end}}
+{{with .Trail}}
+<p>{{.Player}} was last seen on {{.LastServer}} at {{.LastSeen.Format "2006-01-02 15:04:05"}}</p>
+<table>
+<tr><th>Server</th><th>First seen</th><th>Last seen</th><th>Entries</th></tr>
+{{range .Stops}}
+<tr>
+<td>{{.Server}}</td>
+<td>{{.FirstSeen.Format "2006-01-02 15:04:05"}}</td>
+<td>{{.LastSeen.Format "2006-01-02 15:04:05"}}</td>
+<td>{{.Entries}}</td>
+</tr>
+{{end}}
+</table>
+{{else}}{{if .Player}}
+<p>No entries recorded for {{.Player}}</p>
+{{end}}{{end}}
+{{if .Notices}}
+<h2>Operator notices</h2>
+<table>
+<tr><th>Sent</th><th>Server</th><th>Author</th><th>Notice</th></tr>
+{{range .Notices}}
+<tr>
+<td>{{.SentAt.Format "2006-01-02 15:04:05"}}</td>
+<td>{{if .Local}}this server{{else}}{{.From}}{{end}}</td>
+<td>{{.Author}}</td>
+<td>{{.Text}}</td>
+</tr>
+{{end}}
+</table>
+{{end}}
+</body>
+</html>
+{{define "slots"}}<div class="chest">{{range .}}<div class="slot"{{with .Title}} title="{{.}}"{{end}}>
+{{- if .Contents}}<details><summary>{{template "item" .}}</summary>{{template "slots" .Contents}}</details>
+{{- else if .TypeID}}{{template "item" .}}{{end -}}
+</div>{{end}}</div>{{end}}
+{{define "item"}}{{if .Icon}}<img src="{{.Icon}}" alt="{{.TypeID}}">{{else}}<span class="label">{{.Label}}</span>{{end}}{{if gt .Amount 1}}<span class="amount">{{.Amount}}</span>{{end}}{{end}}
+`))
+
+// playerLink is a search result of the dashboard
+type playerLink struct {
+	Name string
+	URL  string
+}
+
+// dashboard renders the operator overview page
+func (s *Server) dashboard(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+	var origins []database.OriginStats
+	if s.db != nil {
+		origins = s.db.OriginStats()
+	}
+
+	// The players matching ?player=, and the latest inventory and trail of the one named exactly,
+	// nil when they have no entries
+	player, token := r.URL.Query().Get("player"), r.URL.Query().Get("token")
+	var matches []playerLink
+	var inventory *inventoryView
+	var trail *database.PlayerTrail
+	if s.db != nil && player != "" {
+		names, err := s.db.SearchPlayers(player, searchLimit)
+		if err != nil {
+			logger.Errorf("Failed to search players matching %s: %v", player, err)
+		}
+		for _, name := range names {
+			if name != player {
+				query := url.Values{"player": {name}}
+				if token != "" {
+					query.Set("token", token)
+				}
+				matches = append(matches, playerLink{Name: name, URL: "/?" + query.Encode()})
+			}
+		}
+
+		if entry, err := s.db.Latest(player); err == nil {
+			entry = s.redaction(r).Entry(entry)
+			inventory = &inventoryView{Entry: entry, Slots: s.renderSlots(entry.Inventory, token)}
+		} else if !errors.Is(err, database.ErrPlayerNotFound) {
+			logger.Errorf("Failed to read the inventory of %s: %v", player, err)
+		}
+		if trail, err = s.db.PlayerTrail(player); err != nil && !errors.Is(err, database.ErrPlayerNotFound) {
+			logger.Errorf("Failed to read the trail of %s: %v", player, err)
+		}
+	}
+
+	credentials, err := keys.Credentials(time.Now())
+	if err != nil {
+		logger.Errorf("Failed to list key ages: %v", err)
+	}
+
+	var notices []network.Notice
+	if s.peers.Notices() != nil {
+		notices = s.peers.Notices().List()
+	}
+
+	var freeze *network.FreezeStatus
+	if s.peers.Freeze() != nil {
+		status := s.peers.Freeze().Status()
+		freeze = &status
+	}
+
+	var pack *bds.PackHealth
+	if s.packHealth != nil {
+		health := s.packHealth()
+		pack = &health
+	}
+
+	err = dashboardTemplate.Execute(w, map[string]any{
+		"Local":        s.peers.Local(),
+		"Peers":        s.peers.List(),
+		"Connectivity": s.connectivity.Status(),
+		"Origins":      origins,
+		"Credentials":  credentials,
+		"Notices":      notices,
+		"Freeze":       freeze,
+		"Pack":         pack,
+		"Player":       player,
+		"Token":        token,
+		"Matches":      matches,
+		"Inventory":    inventory,
+		"Trail":        trail,
+	})
+	if err != nil {
+		logger.Errorf("Failed to render dashboard: %v", err)
+	}
+}
@@ -0,0 +1,116 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// exportFlushEvery is how many records are buffered before the response is flushed
+const exportFlushEvery = 100
+
+// exportDatabase streams player records as newline-delimited JSON, each line carries the cursor
+// resuming the export after it, only one export runs at a time and records are paced to the
+// configured rate so analytics pipelines do not starve the node
+// Query parameters: limit, cursor, server, since and until as RFC3339 timestamps
+func (s *Server) exportDatabase(w http.ResponseWriter, r *http.Request) {
+	history, err := historyQuery(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	query := database.ExportQuery{
+		Server: history.Server,
+		Since:  history.Since,
+		Until:  history.Until,
+		Limit:  history.Limit,
+		Cursor: history.Cursor,
+	}
+
+	select {
+	case s.exports <- struct{}{}:
+		defer func() { <-s.exports }()
+	default:
+		w.Header().Set("Retry-After", "60")
+		http.Error(w, "an export is already running", http.StatusTooManyRequests)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	controller := http.NewResponseController(w)
+	encoder := json.NewEncoder(w)
+	pace := newPacer(s.exportRate)
+	redaction := s.redaction(r)
+	written := 0
+
+	err = s.db.Export(r.Context(), query, func(record database.ExportRecord) error {
+		if err := pace.wait(r.Context()); err != nil {
+			return err
+		}
+		record.Entries = redaction.Entries(record.Entries)
+		if err := encoder.Encode(record); err != nil {
+			return err
+		}
+		written++
+		if written%exportFlushEvery == 0 {
+			return controller.Flush()
+		}
+		return nil
+	})
+	switch {
+	case errors.Is(err, database.ErrInvalidExportCursor) && written == 0:
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, context.Canceled):
+		logger.Infof("Export cancelled by the client after %d records", written)
+	case err != nil && written == 0:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	case err != nil:
+		// The status is sent already, the client resumes from the last cursor it received
+		logger.Errorf("Export failed after %d records: %v", written, err)
+	default:
+		controller.Flush()
+	}
+}
+
+// pacer spaces out records to at most rate per second, a rate of 0 does not wait
+type pacer struct {
+	interval time.Duration
+	next     time.Time
+}
+
+func newPacer(rate int) *pacer {
+	if rate <= 0 {
+		return &pacer{}
+	}
+	return &pacer{interval: time.Second / time.Duration(rate)}
+}
+
+func (p *pacer) wait(ctx context.Context) error {
+	if p.interval == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	if p.next.Before(now) {
+		p.next = now
+	}
+	delay := p.next.Sub(now)
+	p.next = p.next.Add(p.interval)
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
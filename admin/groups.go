@@ -0,0 +1,97 @@
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// maxGroupInventory bounds the body of PUT /api/groups/{group}/inventory
+const maxGroupInventory = 1 << 20
+
+// GroupMembersRequest replaces the members of a shared inventory through PUT /api/groups/{group}/members
+type GroupMembersRequest struct {
+	Members []string `json:"members"`
+}
+
+// groupInfo returns a shared inventory with its members and access audit
+func (s *Server) groupInfo(w http.ResponseWriter, r *http.Request) {
+	info, err := s.db.GroupInfo(r.PathValue("group"))
+	if err != nil {
+		writeGroupError(w, err)
+		return
+	}
+	if info.Latest != nil {
+		latest := s.redaction(r).Entry(*info.Latest)
+		info.Latest = &latest
+	}
+
+	writeJSON(w, info)
+}
+
+// setGroupMembers creates a shared inventory or replaces its members, returning the group
+func (s *Server) setGroupMembers(w http.ResponseWriter, r *http.Request) {
+	var req GroupMembersRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 64<<10)).Decode(&req); err != nil {
+		http.Error(w, "invalid members request: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	group, err := s.db.SetGroupMembers(r.PathValue("group"), req.Members, s.webAddress)
+	if err != nil {
+		writeGroupError(w, err)
+		return
+	}
+
+	writeJSON(w, group)
+}
+
+// groupInventory returns the latest inventory of a shared inventory to the member in the member query
+// parameter, recording the access
+func (s *Server) groupInventory(w http.ResponseWriter, r *http.Request) {
+	inventory, err := s.db.GetGroup(r.PathValue("group"), r.URL.Query().Get("member"), s.webAddress)
+	if err != nil {
+		writeGroupError(w, err)
+		return
+	}
+	if inventory == nil {
+		inventory = []byte("[]")
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(s.redaction(r).Inventory(inventory))
+}
+
+// putGroupInventory stores the inventory in the body as written by the member in the member query parameter
+func (s *Server) putGroupInventory(w http.ResponseWriter, r *http.Request) {
+	inventory, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxGroupInventory))
+	if err != nil {
+		http.Error(w, "invalid inventory: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := s.db.PutGroup(r.PathValue("group"), r.URL.Query().Get("member"), inventory, s.webAddress); err != nil {
+		writeGroupError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeGroupError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, database.ErrGroupNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, database.ErrNotGroupMember):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case errors.Is(err, database.ErrInvalidGroup), errors.Is(err, database.ErrInventoryRejected):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	default:
+		logger.Errorf("Failed to access shared inventory: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
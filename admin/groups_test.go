@@ -0,0 +1,61 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_Groups(t *testing.T) {
+	db, err := database.NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+
+	server := New(Parameters{Peers: newTestPeers(), Connectivity: network.NewConnectivity(time.Minute, nil), DB: db, WebAddress: "a.example.com"})
+
+	do := func(method, path, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, httptest.NewRequest(method, path, strings.NewReader(body)))
+		return rec
+	}
+
+	assert.Equal(t, http.StatusNotFound, do(http.MethodGet, "/api/groups/guild", "").Code)
+	assert.Equal(t, http.StatusNotFound, do(http.MethodPut, "/api/groups/guild/inventory?member=alice", `[]`).Code)
+	assert.Equal(t, http.StatusBadRequest, do(http.MethodPut, "/api/groups/guild/members", `{"members":[]}`).Code)
+
+	rec := do(http.MethodPut, "/api/groups/guild/members", `{"members":["alice","bob"]}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var group database.Group
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &group))
+	assert.Equal(t, "a.example.com", group.UpdatedBy)
+
+	assert.JSONEq(t, `[]`, do(http.MethodGet, "/api/groups/guild/inventory?member=bob", "").Body.String())
+
+	vault := `[{"typeId":"minecraft:emerald","amount":5}]`
+	assert.Equal(t, http.StatusNoContent, do(http.MethodPut, "/api/groups/guild/inventory?member=alice", vault).Code)
+	assert.Equal(t, http.StatusForbidden, do(http.MethodPut, "/api/groups/guild/inventory?member=mallory", vault).Code)
+	assert.Equal(t, http.StatusBadRequest, do(http.MethodPut, "/api/groups/guild/inventory?member=alice", `not json`).Code)
+
+	rec = do(http.MethodGet, "/api/groups/guild/inventory?member=bob", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, vault, rec.Body.String())
+	assert.Equal(t, http.StatusForbidden, do(http.MethodGet, "/api/groups/guild/inventory?member=mallory", "").Code)
+
+	rec = do(http.MethodGet, "/api/groups/guild", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var info database.GroupRecord
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &info))
+	assert.Equal(t, []string{"alice", "bob"}, info.Group.Members)
+	assert.Equal(t, 1, info.Entries)
+	require.Len(t, info.Access, 3)
+	assert.Equal(t, "bob", info.Access[0].Member)
+	assert.Equal(t, database.GroupRead, info.Access[0].Action)
+}
@@ -0,0 +1,191 @@
+package admin
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/d1nch8g/consensuscraft/gen/xendchest"
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// Icons maps item type IDs to PNG textures read from the resource pack of an mcpack
+// Identifiers declared by the behavior pack use the icon or block texture they name, other type IDs
+// use the item or terrain texture named after their identifier without its namespace
+type Icons struct {
+	names    map[string]string // Texture short name of each identifier declared by the behavior pack
+	textures map[string][]byte // PNG of each texture short name
+}
+
+// LoadIcons indexes the textures of an mcpack with behavior_pack/ and resource_pack/ directories
+func LoadIcons(mcpack []byte) (*Icons, error) {
+	reader, err := zip.NewReader(bytes.NewReader(mcpack), int64(len(mcpack)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open mcpack: %w", err)
+	}
+
+	files := make(map[string]*zip.File, len(reader.File))
+	for _, file := range reader.File {
+		files[file.Name] = file
+	}
+
+	icons := &Icons{names: make(map[string]string), textures: make(map[string][]byte)}
+	for _, atlas := range []string{"resource_pack/textures/item_texture.json", "resource_pack/textures/terrain_texture.json"} {
+		var textures struct {
+			Data map[string]struct {
+				Textures any `json:"textures"`
+			} `json:"texture_data"`
+		}
+		if err := readPackJSON(files[atlas], &textures); err != nil {
+			return nil, err
+		}
+		for name, texture := range textures.Data {
+			file := files["resource_pack/"+texturePath(texture.Textures)+".png"]
+			if file == nil {
+				continue
+			}
+			png, err := readPackFile(file)
+			if err != nil {
+				return nil, err
+			}
+			icons.textures[name] = png
+		}
+	}
+
+	for name, file := range files {
+		switch path.Dir(name) {
+		case "behavior_pack/items", "behavior_pack/blocks":
+			var definition struct {
+				Item  *packDefinition `json:"minecraft:item"`
+				Block *packDefinition `json:"minecraft:block"`
+			}
+			if err := readPackJSON(file, &definition); err != nil {
+				logger.Warnf("Skipped %s for item icons: %v", name, err)
+				continue
+			}
+			if definition.Item != nil {
+				icons.names[definition.Item.Description.Identifier] = iconName(definition.Item.Components["minecraft:icon"])
+			}
+			if definition.Block != nil {
+				var materials map[string]struct {
+					Texture string `json:"texture"`
+				}
+				json.Unmarshal(definition.Block.Components["minecraft:material_instances"], &materials)
+				icons.names[definition.Block.Description.Identifier] = materials["*"].Texture
+			}
+		}
+	}
+
+	return icons, nil
+}
+
+// packDefinition is the part of an item or block definition naming its texture
+type packDefinition struct {
+	Description struct {
+		Identifier string `json:"identifier"`
+	} `json:"description"`
+	Components map[string]json.RawMessage `json:"components"`
+}
+
+// iconName reads the minecraft:icon component, either a texture name or an object holding one
+func iconName(component json.RawMessage) string {
+	var name string
+	if json.Unmarshal(component, &name) == nil {
+		return name
+	}
+
+	var icon struct {
+		Texture  string            `json:"texture"`
+		Textures map[string]string `json:"textures"`
+	}
+	json.Unmarshal(component, &icon)
+	if icon.Texture != "" {
+		return icon.Texture
+	}
+	return icon.Textures["default"]
+}
+
+// texturePath returns the first path of a texture_data entry, a path or a list of paths or variations
+func texturePath(textures any) string {
+	switch value := textures.(type) {
+	case string:
+		return value
+	case []any:
+		if len(value) > 0 {
+			return texturePath(value[0])
+		}
+	case map[string]any:
+		return texturePath(value["path"])
+	}
+	return ""
+}
+
+// readPackJSON decodes a pack file, a missing file leaves v untouched
+func readPackJSON(file *zip.File, v any) error {
+	if file == nil {
+		return nil
+	}
+	data, err := readPackFile(file)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("invalid %s: %w", file.Name, err)
+	}
+	return nil
+}
+
+func readPackFile(file *zip.File) ([]byte, error) {
+	rc, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", file.Name, err)
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
+
+// Icon returns the PNG of an item type, false when the pack has no texture for it
+func (i *Icons) Icon(typeID string) ([]byte, bool) {
+	if i == nil {
+		return nil, false
+	}
+
+	name, ok := i.names[typeID]
+	if !ok {
+		name = typeID[strings.Index(typeID, ":")+1:]
+	}
+	png, ok := i.textures[name]
+	return png, ok
+}
+
+// embeddedIcons indexes the embedded mcpack once, without icons when it cannot be read
+var embeddedIcons = sync.OnceValue(func() *Icons {
+	mcpack, err := xendchest.Asset("x_ender_chest.mcpack")
+	if err == nil {
+		var icons *Icons
+		if icons, err = LoadIcons(mcpack); err == nil {
+			return icons
+		}
+	}
+	logger.Warnf("Inventories are rendered without item icons: %v", err)
+	return nil
+})
+
+// itemIcon serves the PNG texture of an item type
+func (s *Server) itemIcon(w http.ResponseWriter, r *http.Request) {
+	png, ok := s.icons.Icon(r.PathValue("type"))
+	if !ok {
+		http.Error(w, "no icon for "+r.PathValue("type"), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "image/png")
+	w.Header().Set("Cache-Control", "max-age=86400")
+	w.Write(png)
+}
@@ -0,0 +1,78 @@
+package admin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIcons_Embedded(t *testing.T) {
+	icons := embeddedIcons()
+	require.NotNil(t, icons)
+
+	png, ok := icons.Icon("x_ender_chest:x_ender_chest")
+	require.True(t, ok, "the block texture named by the behavior pack")
+	assert.Equal(t, "\x89PNG", string(png[:4]))
+
+	_, ok = icons.Icon("minecraft:diamond")
+	assert.False(t, ok)
+}
+
+func TestServer_PlayerSearchAndInventory(t *testing.T) {
+	db, err := database.NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, db.Put("Alice", []byte(`[{"typeId":"x_ender_chest:x_ender_chest","amount":2},null,
+		{"typeId":"minecraft:shulker_box","amount":1,"shulkerContents":[{"typeId":"minecraft:diamond_sword","amount":1,"nameTag":"Excalibur"}]}]`), "a.example.com"))
+	require.NoError(t, db.Put("alicia", []byte(`[]`), "a.example.com"))
+	require.NoError(t, db.Put("bob", []byte(`[]`), "a.example.com"))
+
+	redaction, err := database.NewRedaction([]string{database.RedactNameTag})
+	require.NoError(t, err)
+	server := New(Parameters{
+		Peers:        newTestPeers(),
+		Connectivity: network.NewConnectivity(time.Minute, nil),
+		DB:           db,
+		Token:        "secret",
+		ViewerToken:  "viewer",
+		Redaction:    redaction,
+	})
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	rec := get("/api/players?search=ALI&token=secret")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `["Alice","alicia"]`, rec.Body.String())
+	assert.JSONEq(t, `["Alice"]`, get("/api/players?search=ali&limit=1&token=secret").Body.String())
+	assert.Equal(t, http.StatusBadRequest, get("/api/players?limit=x&token=secret").Code)
+
+	rec = get("/api/icons/x_ender_chest:x_ender_chest?token=viewer")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "image/png", rec.Header().Get("Content-Type"))
+	assert.Equal(t, http.StatusNotFound, get("/api/icons/minecraft:diamond?token=viewer").Code)
+
+	body := get("/?player=ali&token=secret").Body.String()
+	assert.Contains(t, body, `<a href="/?player=Alice&amp;token=secret">Alice</a>`)
+	assert.Contains(t, body, `<a href="/?player=alicia&amp;token=secret">alicia</a>`)
+	assert.NotContains(t, body, "Ender chest of")
+
+	body = get("/?player=Alice&token=secret").Body.String()
+	assert.Contains(t, body, `<img src="/api/icons/x_ender_chest:x_ender_chest?token=secret"`)
+	assert.Contains(t, body, `<span class="amount">2</span>`)
+	assert.Contains(t, body, `<details><summary><span class="label">SB</span></summary>`)
+	assert.Contains(t, body, "Excalibur")
+	assert.NotContains(t, body, `>Alice</a>`)
+
+	body = get("/?player=Alice&token=viewer").Body.String()
+	assert.NotContains(t, body, "Excalibur")
+	assert.Contains(t, body, database.Redacted)
+}
@@ -0,0 +1,179 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// searchLimit bounds the players returned by a search when the request sets no limit
+const searchLimit = 20
+
+// searchPlayers returns the names of players containing a search text, sorted
+// Query parameters: search, limit
+func (s *Server) searchPlayers(w http.ResponseWriter, r *http.Request) {
+	limit := searchLimit
+	if value := r.URL.Query().Get("limit"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			http.Error(w, "limit must be a non-negative integer", http.StatusBadRequest)
+			return
+		}
+		limit = n
+	}
+
+	players, err := s.db.SearchPlayers(r.URL.Query().Get("search"), limit)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, players)
+}
+
+// playerHistory returns a page of a player's inventory history
+// Query parameters: limit, cursor, server, since and until as RFC3339 timestamps
+func (s *Server) playerHistory(w http.ResponseWriter, r *http.Request) {
+	query, err := historyQuery(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	page, err := s.db.GetPlayerInventoriesPage(r.PathValue("player"), query)
+	switch {
+	case errors.Is(err, database.ErrPlayerNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case errors.Is(err, database.ErrInvalidCursor):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	page.Entries = s.redaction(r).Entries(page.Entries)
+	writeJSON(w, page)
+}
+
+// playerInventoryAt returns the inventory entry of a player in force at a point in time
+// Query parameters: at as an RFC3339 timestamp, required
+func (s *Server) playerInventoryAt(w http.ResponseWriter, r *http.Request) {
+	at, err := time.Parse(time.RFC3339, r.URL.Query().Get("at"))
+	if err != nil {
+		http.Error(w, "at must be an RFC3339 timestamp", http.StatusBadRequest)
+		return
+	}
+
+	entry, err := s.db.GetPlayerInventoryAt(r.PathValue("player"), at)
+	switch {
+	case errors.Is(err, database.ErrPlayerNotFound), errors.Is(err, database.ErrNoInventoryAt):
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, s.redaction(r).Entry(*entry))
+}
+
+// playerTrail returns the servers a player appeared on, oldest first
+func (s *Server) playerTrail(w http.ResponseWriter, r *http.Request) {
+	trail, err := s.db.PlayerTrail(r.PathValue("player"))
+	switch {
+	case errors.Is(err, database.ErrPlayerNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, trail)
+}
+
+// revalidatePlayer re-runs the item validator on every stored entry of a player and returns the report
+// Query parameters: quarantine=true moves the failing entries out of the player record
+func (s *Server) revalidatePlayer(w http.ResponseWriter, r *http.Request) {
+	dir := ""
+	if value := r.URL.Query().Get("quarantine"); value != "" {
+		quarantine, err := strconv.ParseBool(value)
+		if err != nil {
+			http.Error(w, "quarantine must be a boolean", http.StatusBadRequest)
+			return
+		}
+		if quarantine {
+			if s.quarantine == "" {
+				http.Error(w, "quarantining is not available", http.StatusNotFound)
+				return
+			}
+			dir = s.quarantine
+		}
+	}
+
+	report, err := s.db.Revalidate(r.PathValue("player"), dir)
+	switch {
+	case errors.Is(err, database.ErrPlayerNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case err != nil:
+		logger.Errorf("Failed to revalidate %s: %v", r.PathValue("player"), err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, report)
+}
+
+// deletePlayer removes every inventory entry of a player, leaving a tombstone peers delete the player by
+func (s *Server) deletePlayer(w http.ResponseWriter, r *http.Request) {
+	player := r.PathValue("player")
+	err := s.db.DeletePlayer(player, s.webAddress)
+	switch {
+	case errors.Is(err, database.ErrPlayerNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case err != nil:
+		logger.Errorf("Failed to delete player %s: %v", player, err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	logger.Infof("Audit: player %s deleted through the admin API from %s", player, r.RemoteAddr)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// historyQuery parses the history filters from the request query string
+func historyQuery(r *http.Request) (database.HistoryQuery, error) {
+	values := r.URL.Query()
+	query := database.HistoryQuery{
+		Server: values.Get("server"),
+		Cursor: values.Get("cursor"),
+	}
+
+	if limit := values.Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n < 0 {
+			return query, errors.New("limit must be a non-negative integer")
+		}
+		query.Limit = n
+	}
+
+	for name, target := range map[string]*time.Time{"since": &query.Since, "until": &query.Until} {
+		if value := values.Get(name); value != "" {
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return query, errors.New(name + " must be an RFC3339 timestamp")
+			}
+			*target = t
+		}
+	}
+
+	return query, nil
+}
@@ -0,0 +1,259 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_PlayerHistory(t *testing.T) {
+	db, err := database.NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, db.Put("alice", []byte(`[]`), "a.example.com"))
+	}
+	require.NoError(t, db.Put("alice", []byte(`[]`), "b.example.com"))
+
+	server := New(Parameters{Peers: newTestPeers(), Connectivity: network.NewConnectivity(time.Minute, nil), DB: db})
+
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	t.Run("pages", func(t *testing.T) {
+		rec := get("/api/players/alice/inventories?limit=2&server=a.example.com")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var page database.HistoryPage
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+		assert.Len(t, page.Entries, 2)
+		require.NotEmpty(t, page.NextCursor)
+
+		rec = get("/api/players/alice/inventories?limit=2&server=a.example.com&cursor=" + page.NextCursor)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		page = database.HistoryPage{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+		assert.Len(t, page.Entries, 1)
+		assert.Empty(t, page.NextCursor)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotFound, get("/api/players/nobody/inventories").Code)
+		assert.Equal(t, http.StatusBadRequest, get("/api/players/alice/inventories?limit=x").Code)
+		assert.Equal(t, http.StatusBadRequest, get("/api/players/alice/inventories?since=yesterday").Code)
+		assert.Equal(t, http.StatusBadRequest, get("/api/players/alice/inventories?cursor=%21%21").Code)
+	})
+}
+
+func TestServer_PlayerInventoryAt(t *testing.T) {
+	db, err := database.NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, db.Put("alice", []byte(`[{"typeId":"minecraft:elytra","amount":1}]`), "a.example.com"))
+	recorded := time.Now()
+
+	server := New(Parameters{Peers: newTestPeers(), Connectivity: network.NewConnectivity(time.Minute, nil), DB: db})
+
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	rec := get("/api/players/alice/inventory?at=" + recorded.Add(time.Minute).UTC().Format(time.RFC3339))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var entry database.InventoryEntry
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &entry))
+	assert.Equal(t, "a.example.com", entry.Server)
+	assert.JSONEq(t, `[{"typeId":"minecraft:elytra","amount":1}]`, string(entry.Inventory))
+
+	assert.Equal(t, http.StatusNotFound, get("/api/players/alice/inventory?at=2000-01-01T00:00:00Z").Code)
+	assert.Equal(t, http.StatusNotFound, get("/api/players/nobody/inventory?at=2000-01-01T00:00:00Z").Code)
+	assert.Equal(t, http.StatusBadRequest, get("/api/players/alice/inventory").Code)
+}
+
+func TestServer_PlayerTrail(t *testing.T) {
+	db, err := database.NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, db.Put("alice", []byte(`[]`), "a.example.com"))
+	require.NoError(t, db.Put("alice", []byte(`[]`), "b.example.com"))
+
+	server := New(Parameters{Peers: newTestPeers(), Connectivity: network.NewConnectivity(time.Minute, nil), DB: db})
+
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	rec := get("/api/players/alice/trail")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var trail database.PlayerTrail
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &trail))
+	assert.Equal(t, "b.example.com", trail.LastServer)
+	require.Len(t, trail.Stops, 2)
+	assert.Equal(t, "a.example.com", trail.Stops[0].Server)
+
+	assert.Equal(t, http.StatusNotFound, get("/api/players/nobody/trail").Code)
+
+	t.Run("dashboard", func(t *testing.T) {
+		body := get("/?player=alice").Body.String()
+		assert.Contains(t, body, "alice was last seen on b.example.com")
+		assert.Contains(t, body, "<td>a.example.com</td>")
+
+		assert.Contains(t, get("/?player=nobody").Body.String(), "No entries recorded for nobody")
+	})
+}
+
+func TestServer_RevalidatePlayer(t *testing.T) {
+	db, err := database.NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, db.Put("alice", []byte(`[{"typeId":"minecraft:diamond","amount":65,"lore":["Origin: a.example.com"]}]`), "a.example.com"))
+
+	post := func(server *Server, path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, nil))
+		return rec
+	}
+	params := Parameters{Peers: newTestPeers(), Connectivity: network.NewConnectivity(time.Minute, nil), DB: db}
+
+	t.Run("without quarantine directory", func(t *testing.T) {
+		server := New(params)
+
+		rec := post(server, "/api/players/alice/revalidate")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var report database.RevalidationReport
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+		assert.Equal(t, 1, report.Checked)
+		assert.Len(t, report.Failing, 1)
+
+		assert.Equal(t, http.StatusNotFound, post(server, "/api/players/alice/revalidate?quarantine=true").Code)
+		assert.Equal(t, http.StatusBadRequest, post(server, "/api/players/alice/revalidate?quarantine=maybe").Code)
+		assert.Equal(t, http.StatusNotFound, post(server, "/api/players/nobody/revalidate").Code)
+	})
+
+	t.Run("quarantines", func(t *testing.T) {
+		params := params
+		params.Quarantine = t.TempDir()
+		server := New(params)
+
+		rec := post(server, "/api/players/alice/revalidate?quarantine=true")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var report database.RevalidationReport
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+		assert.Equal(t, 1, report.Quarantined)
+
+		entries, err := db.GetPlayerInventories("alice")
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+}
+
+func TestServer_DeletePlayer(t *testing.T) {
+	db, err := database.NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, db.Put("alice", []byte(`[{"typeId":"minecraft:dirt","amount":1}]`), "a.example.com"))
+	require.NoError(t, db.Put("bob", []byte(`[{"typeId":"minecraft:dirt","amount":1}]`), "a.example.com"))
+
+	server := New(Parameters{
+		Peers:        newTestPeers(),
+		Connectivity: network.NewConnectivity(time.Minute, nil),
+		DB:           db,
+		Token:        "secret",
+		ViewerToken:  "community",
+		WebAddress:   "a.example.com",
+	})
+	del := func(path, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+		return rec
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, del("/api/players/alice", "nope").Code)
+	assert.Equal(t, http.StatusForbidden, del("/api/players/alice", "community").Code)
+	_, err = db.Get("alice")
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusNoContent, del("/api/players/alice", "secret").Code)
+	_, err = db.Get("alice")
+	assert.ErrorIs(t, err, database.ErrPlayerNotFound)
+	_, err = db.Get("bob")
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.StatusNotFound, del("/api/players/alice", "secret").Code)
+	assert.Equal(t, http.StatusNotFound, del("/api/players/nobody", "secret").Code)
+
+	// Players are not deleted through an API without a token
+	open := New(Parameters{Peers: newTestPeers(), Connectivity: network.NewConnectivity(time.Minute, nil), DB: db})
+	rec := httptest.NewRecorder()
+	open.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/api/players/bob", nil))
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	_, err = db.Get("bob")
+	assert.NoError(t, err)
+}
+
+func TestServer_Redaction(t *testing.T) {
+	db, err := database.NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+	inventory := `[{"typeId":"minecraft:diamond_sword","amount":1,"nameTag":"Secret","lore":["Origin: a.example.com","For Bob only"]}]`
+	require.NoError(t, db.Put("alice", []byte(inventory), "a.example.com"))
+
+	redaction, err := database.NewRedaction([]string{database.RedactNameTag, database.RedactLore})
+	require.NoError(t, err)
+	server := New(Parameters{
+		Peers:        newTestPeers(),
+		Connectivity: network.NewConnectivity(time.Minute, nil),
+		DB:           db,
+		Token:        "secret",
+		ViewerToken:  "community",
+		Redaction:    redaction,
+	})
+
+	get := func(path, token string) database.HistoryPage {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var page database.HistoryPage
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+		require.Len(t, page.Entries, 1)
+		return page
+	}
+
+	page := get("/api/players/alice/inventories", "community")
+	assert.NotContains(t, string(page.Entries[0].Inventory), "Secret")
+	assert.NotContains(t, string(page.Entries[0].Inventory), "For Bob only")
+	assert.Contains(t, string(page.Entries[0].Inventory), "Origin: a.example.com")
+
+	page = get("/api/players/alice/inventories", "secret")
+	assert.Contains(t, string(page.Entries[0].Inventory), "Secret")
+	assert.Contains(t, string(page.Entries[0].Inventory), "For Bob only")
+
+	// Storage keeps the full inventory
+	stored, err := db.Get("alice")
+	require.NoError(t, err)
+	assert.Contains(t, string(stored), "For Bob only")
+}
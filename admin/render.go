@@ -0,0 +1,105 @@
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/d1nch8g/consensuscraft/database"
+)
+
+// slotView is an ender chest or shulker box slot as the dashboard draws it, empty slots have no TypeID
+type slotView struct {
+	TypeID   string
+	Amount   int
+	Icon     string     // URL of the item icon, empty when the pack has none
+	Label    string     // Shown instead of a missing icon
+	Title    string     // Name, type, lore and enchantments shown on hover
+	Contents []slotView // Slots of a shulker box, nil for other items
+}
+
+// inventoryView is the latest inventory of a player drawn by the dashboard
+type inventoryView struct {
+	Entry database.InventoryEntry
+	Slots []slotView
+}
+
+// renderSlots turns an inventory payload into slots, icon URLs carry the dashboard token
+// Payloads that are not a JSON array render as no slots
+func (s *Server) renderSlots(payload []byte, token string) []slotView {
+	var slots []any
+	if err := json.Unmarshal(payload, &slots); err != nil {
+		return nil
+	}
+	return s.slotViews(slots, token)
+}
+
+func (s *Server) slotViews(slots []any, token string) []slotView {
+	views := make([]slotView, len(slots))
+	for i, slot := range slots {
+		fields, ok := slot.(map[string]any)
+		if !ok {
+			continue
+		}
+		var item database.Item
+		raw, _ := json.Marshal(fields)
+		if json.Unmarshal(raw, &item) != nil || item.TypeID == "" {
+			continue
+		}
+
+		view := slotView{
+			TypeID: item.TypeID,
+			Amount: item.Amount,
+			Label:  itemLabel(item.TypeID),
+			Title:  itemTitle(&item),
+		}
+		if _, ok := s.icons.Icon(item.TypeID); ok {
+			view.Icon = "/api/icons/" + url.PathEscape(item.TypeID) + tokenQuery(token)
+		}
+		if item.ShulkerContents != nil {
+			view.Contents = s.slotViews(item.ShulkerContents, token)
+		}
+		views[i] = view
+	}
+	return views
+}
+
+// itemLabel abbreviates a type ID for slots without an icon, minecraft:diamond_sword becomes DS
+func itemLabel(typeID string) string {
+	name := typeID[strings.Index(typeID, ":")+1:]
+	label := ""
+	for _, word := range strings.Split(name, "_") {
+		if word != "" {
+			label += strings.ToUpper(word[:1])
+		}
+	}
+	if len(label) > 3 {
+		label = label[:3]
+	}
+	return label
+}
+
+// itemTitle describes an item on hover, one property per line
+func itemTitle(item *database.Item) string {
+	lines := []string{item.TypeID}
+	if item.NameTag != "" {
+		lines = []string{item.NameTag, item.TypeID}
+	}
+	lines = append(lines, item.Lore...)
+	for _, enchantment := range item.Enchantments {
+		lines = append(lines, fmt.Sprintf("%v %v", enchantment["type"], enchantment["level"]))
+	}
+	if len(item.ShulkerContents) > 0 {
+		lines = append(lines, "Click to open")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// tokenQuery returns the query string authorizing a link from the dashboard, empty without a token
+func tokenQuery(token string) string {
+	if token == "" {
+		return ""
+	}
+	return "?" + url.Values{"token": {token}}.Encode()
+}
@@ -0,0 +1,39 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/d1nch8g/consensuscraft/bds"
+)
+
+// listSessions returns the sessions of players with their real client addresses, online ones first
+// then ended ones newest first, for abuse investigations across the network
+// Query parameters: player filters by player name, address by client host whatever the port
+func (s *Server) listSessions(w http.ResponseWriter, r *http.Request) {
+	if s.sessions == nil && s.online == nil {
+		http.Error(w, "session tracking is disabled", http.StatusNotFound)
+		return
+	}
+
+	player, address := r.URL.Query().Get("player"), r.URL.Query().Get("address")
+
+	sessions := []bds.Session{}
+	if s.online != nil {
+		for _, session := range s.online() {
+			if session.Matches(player, address) {
+				sessions = append(sessions, session)
+			}
+		}
+	}
+
+	if s.sessions != nil {
+		ended, err := s.sessions.Search(player, address)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		sessions = append(sessions, ended...)
+	}
+
+	writeJSON(w, sessions)
+}
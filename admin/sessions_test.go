@@ -0,0 +1,62 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/bds"
+	"github.com/d1nch8g/consensuscraft/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_Sessions(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		server := New(Parameters{Peers: newTestPeers(), Connectivity: network.NewConnectivity(time.Minute, nil)})
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/sessions", nil))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	start := time.Date(2026, time.January, 10, 12, 0, 0, 0, time.UTC)
+	log := bds.OpenSessionLog(filepath.Join(t.TempDir(), "sessions.jsonl"))
+	require.NoError(t, log.Append(bds.Session{Player: "alice", Address: "203.0.113.7:1000", ConnectedAt: start, DisconnectedAt: start.Add(time.Hour)}))
+	require.NoError(t, log.Append(bds.Session{Player: "bob", Address: "198.51.100.1:1000", ConnectedAt: start.Add(time.Hour)}))
+
+	server := New(Parameters{
+		Peers:        newTestPeers(),
+		Connectivity: network.NewConnectivity(time.Minute, nil),
+		Sessions:     log,
+		Online: func() []bds.Session {
+			return []bds.Session{{Player: "mallory", Address: "203.0.113.7:2000", ConnectedAt: start.Add(2 * time.Hour)}}
+		},
+	})
+
+	get := func(path string) []bds.Session {
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var sessions []bds.Session
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &sessions))
+		return sessions
+	}
+
+	sessions := get("/api/sessions")
+	require.Len(t, sessions, 3)
+	assert.Equal(t, "mallory", sessions[0].Player)
+	assert.Equal(t, "bob", sessions[1].Player)
+
+	sessions = get("/api/sessions?address=203.0.113.7")
+	require.Len(t, sessions, 2)
+	assert.Equal(t, "mallory", sessions[0].Player)
+	assert.Equal(t, "alice", sessions[1].Player)
+
+	sessions = get("/api/sessions?player=Alice")
+	require.Len(t, sessions, 1)
+	assert.Equal(t, start.Add(time.Hour), sessions[0].DisconnectedAt)
+}
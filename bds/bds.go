@@ -2,9 +2,13 @@ package bds
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sync/atomic"
 
 	"github.com/d1nch8g/consensuscraft/logger"
 )
@@ -13,30 +17,103 @@ import (
 type InventoryReceiveCallback func(playerName string) ([]byte, error)
 type InventoryUpdateCallback func(playerName string, inventory []byte) error
 
+// InventoryPositionCallback is an inventory update callback that also receives where the player was
+// Position is nil when the pack did not report it
+type InventoryPositionCallback func(playerName string, inventory []byte, position *Position) error
+
+// InventoryEntryCallback is an inventory update callback receiving the whole update, with the
+// ID deduplicating it when the pack logged one, ctx carries the trace of the log line it was read from
+type InventoryEntryCallback func(ctx context.Context, update InventoryUpdate) error
+
+// Position is the player location reported by the pack with an ender chest update
+type Position struct {
+	X         float64
+	Y         float64
+	Z         float64
+	Dimension string
+}
+
 // InventoryUpdate represents an inventory update event
 type InventoryUpdate struct {
 	PlayerName string
 	Inventory  []byte
 	Server     string
+	Position   *Position
+	UpdateID   string // Unique per update of a pack run, empty for packs that do not number their updates
 }
 
 // Parameters defines the configuration parameters for the BDS
 type Parameters struct {
-	InventoryReceiveCallback InventoryReceiveCallback
-	InventoryUpdateCallback  InventoryUpdateCallback
-	StartTrigger             chan struct{}
-	WebAddress               string // Server web address for origin tracking
+	InventoryReceiveCallback  InventoryReceiveCallback
+	InventoryUpdateCallback   InventoryUpdateCallback
+	InventoryPositionCallback InventoryPositionCallback // Takes precedence over InventoryUpdateCallback
+	InventoryEntryCallback    InventoryEntryCallback    // Takes precedence over InventoryPositionCallback
+	WorldSavedCallback        WorldSavedCallback        // Called when the server reports a completed world save
+	StartTrigger              chan struct{}
+	UpdateQueueSize           int    // Pending updates kept per player before coalescing, defaults to 16
+	ShedThreshold             int    // Pending updates over all players past which only the latest per player is kept, defaults to 1000
+	WebAddress                string // Server web address for origin tracking
+	OriginFormat              string // Origin lore format handed to the pack, empty keeps the pack default
+
+	// Console operators mapped to keys.HashSecret of their secret, when set
+	// op, deop, ban and give typed on stdin require a login
+	ConsoleOperators   map[string]string
+	ConfirmDestructive bool // Prompt before sending ban and deop from stdin
+
+	// keys.HashSecret of the passphrase unlocking stdin, the console starts locked when set
+	ConsolePIN string
+	// Commands stdin sends without an operator login, every command when empty
+	ConsoleCommands []string
+
+	// Hex ed25519 keys trusted to sign the embedded mcpack manifest, signatures are not checked when empty
+	TrustedPackKeys []string
+
+	// Executable of a server already set up with NewSetup, New sets the server up when empty
+	ServerPath string
+
+	// Resource limits of the bedrock_server process, the server is restarted before
+	// reaching Limits.MemoryMax
+	Limits ResourceLimits
+
+	// Templates of in-game messages, DefaultMessages when nil
+	Messages *Messages
+
+	// File remembering the last applied ender chest update across wrapper restarts, memory only when empty
+	IngestOffsetsPath string
+
+	// Output lines reporting the real address of a player, with the named groups player and address,
+	// see ParseAddressPattern, addresses are not recorded when nil
+	AddressPattern *regexp.Regexp
+	// Called with every session once the player disconnected or the server exited
+	SessionEnded func(Session)
+
+	// Ports the server is moved to when the ports in server.properties are taken,
+	// when empty the server is not started on a taken port
+	PortRange PortRange
 }
 
 // Bds represents the Bedrock Dedicated Server instance
 type Bds struct {
-	// Public channel for inventory updates
-	InventoryUpdate chan InventoryUpdate
-
 	// Internal components
 	server       *Server
 	outputParser *OutputParser
 	stdinWrapper *StdinWrapper
+	console      atomic.Pointer[StdinWrapper] // Running wrapper, for commands sent from other goroutines
+
+	trustedPackKeys []string
+	messages        *Messages
+
+	// Controlled restart after log monitoring is lost or the memory limit is reached, with the reason
+	restart        chan string
+	pendingRestart atomic.Bool
+	restarts       atomic.Int32
+
+	// IPv4 port of the server, checked before every start
+	port atomic.Int32
+
+	// Shutdown of the management loop, done is closed once it returned
+	cancel context.CancelFunc
+	done   chan struct{}
 }
 
 // New creates a new Bedrock Dedicated Server instance and starts the management loop
@@ -49,30 +126,70 @@ func New(params Parameters) (*Bds, error) {
 		return nil, fmt.Errorf("start trigger channel cannot be nil")
 	}
 
-	// Setup server based on current directory state
-	setup := NewSetup()
-	serverPath, err := setup.EnsureServer()
+	if err := params.Limits.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid resource limits: %w", err)
+	}
+
+	// Setup server based on current directory state, unless the caller already did
+	var err error
+	serverPath := params.ServerPath
+	if serverPath == "" {
+		setup := NewSetup()
+		setup.TrustPackKeys(params.TrustedPackKeys)
+		if serverPath, err = setup.EnsureServer(); err != nil {
+			return nil, fmt.Errorf("failed to setup server: %w", err)
+		}
+	}
+
+	// Fail early on a taken port, the check is repeated before every start
+	propertiesPath := filepath.Join(filepath.Dir(serverPath), "server.properties")
+	port, err := EnsurePorts(propertiesPath, params.PortRange)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select server port: %w", err)
+	}
+
+	offsets, err := OpenIngestOffsets(params.IngestOffsetsPath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to setup server: %w", err)
+		return nil, err
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
 
 	bds := &Bds{
-		InventoryUpdate: make(chan InventoryUpdate, 100),
+		restart:         make(chan string, 1),
+		cancel:          cancel,
+		done:            make(chan struct{}),
+		trustedPackKeys: params.TrustedPackKeys,
+		messages:        params.Messages,
 		outputParser: NewOutputParser(
 			params.InventoryReceiveCallback,
 			params.InventoryUpdateCallback,
 		),
 	}
 
+	if bds.messages == nil {
+		bds.messages = DefaultMessages()
+	}
+	bds.port.Store(int32(port))
+
+	bds.outputParser.positionCallback = params.InventoryPositionCallback
+	bds.outputParser.entryCallback = params.InventoryEntryCallback
+	bds.outputParser.addressRegex = params.AddressPattern
+	bds.outputParser.sessionEnded = params.SessionEnded
+	bds.outputParser.offsets = offsets
+	bds.outputParser.readerLost = func(err error) {
+		bds.requestRestart("losing log monitoring")
+	}
+
 	// Create server manager with WebAddress for origin tracking
 	bds.server = NewServer(serverPath, ctx, cancel, params.WebAddress)
+	bds.server.originFormat = params.OriginFormat
+	bds.server.limits = params.Limits
 
 	// Start the management loop in a goroutine
 	go func() {
+		defer close(bds.done)
 		defer cancel()
-		defer close(bds.InventoryUpdate)
 
 		var serverProcess *exec.Cmd
 
@@ -85,6 +202,7 @@ func New(params Parameters) (*Bds, error) {
 				if bds.stdinWrapper != nil {
 					bds.stdinWrapper.Stop()
 					bds.stdinWrapper = nil
+					bds.console.Store(nil)
 				}
 				if serverProcess != nil {
 					bds.server.Stop(serverProcess)
@@ -92,6 +210,20 @@ func New(params Parameters) (*Bds, error) {
 				logger.Println("Shutdown complete")
 				return
 
+			case reason := <-bds.restart:
+				if serverProcess == nil {
+					continue
+				}
+
+				// Restart to get fresh pipes or release the memory held by the server
+				logger.Printf("Restarting server after %s", reason)
+				if err := bds.Announce(MessageRestart, "reason", reason); err != nil {
+					logger.Warnf("Failed to warn players about the restart: %v", err)
+				}
+				bds.pendingRestart.Store(true)
+				bds.restarts.Add(1)
+				bds.server.Stop(serverProcess)
+
 			case <-params.StartTrigger:
 				if serverProcess != nil {
 					logger.Println("Server is already running")
@@ -100,6 +232,15 @@ func New(params Parameters) (*Bds, error) {
 
 				logger.Println("Starting Bedrock Dedicated Server")
 
+				port, err := EnsurePorts(propertiesPath, params.PortRange)
+				if err != nil {
+					logger.Errorf("Not starting the server: %v", err)
+					continue
+				}
+				if previous := bds.port.Swap(int32(port)); previous != int32(port) {
+					logger.Warnf("Server port %d is taken, moved the server to port %d", previous, port)
+				}
+
 				// For requirement #5 (pipe stdin/stdout/stderr), we use StartWithPipes
 				// to enable both direct I/O piping AND log parsing for player events
 				var stdin io.WriteCloser
@@ -115,28 +256,61 @@ func New(params Parameters) (*Bds, error) {
 				logger.Printf("Server started with PID %d", serverProcess.Process.Pid)
 
 				// Start output parsing with pipes that also output to stdout/stderr
-				bds.outputParser.Start(serverProcess, bds, params, stdout, stderr, stdin)
+				bds.outputParser.Start(serverProcess, params, stdout, stderr, stdin)
 
 				// Start stdin wrapper for interactive command input
 				bds.stdinWrapper = NewStdinWrapper(stdin)
+				bds.stdinWrapper.operators = params.ConsoleOperators
+				bds.stdinWrapper.confirmDestructive = params.ConfirmDestructive
+				bds.stdinWrapper.restrict(params.ConsolePIN, params.ConsoleCommands)
 				bds.stdinWrapper.Start()
+				bds.console.Store(bds.stdinWrapper)
+
+				// Restart the server before the kernel kills it at the memory limit
+				watchCtx, stopWatch := context.WithCancel(ctx)
+				if params.Limits.MemoryMax > 0 {
+					go watchMemory(watchCtx, serverProcess.Process.Pid, params.Limits.MemoryMax, memoryWatchInterval, processMemory, func(used int64) {
+						logger.Warnf("Server uses %d MiB of its %d MiB memory limit", used>>20, params.Limits.MemoryMax>>20)
+						bds.requestRestart("exceeding its memory limit")
+					})
+				}
 
 				// Monitor server process in a separate goroutine
 				go func(proc *exec.Cmd) {
 					err := proc.Wait()
 					serverProcess = nil
+					for _, session := range bds.outputParser.online.clear() {
+						bds.outputParser.endSession(session)
+					}
+					stopWatch()
+					bds.server.release()
 
 					// Stop stdin wrapper when server exits
 					if bds.stdinWrapper != nil {
 						bds.stdinWrapper.Stop()
 						bds.stdinWrapper = nil
 					}
+					bds.console.Store(nil)
 
 					if err != nil {
 						logger.Printf("Server process exited unexpectedly: %v", err)
 					} else {
 						logger.Println("Server process exited")
 					}
+
+					// A server killed under a memory limit was most likely killed for running out of it
+					if params.Limits.MemoryMax > 0 && killedBySignal(err) && ctx.Err() == nil && !bds.pendingRestart.Load() {
+						logger.Println("Server was killed while running under a memory limit, restarting")
+						bds.restarts.Add(1)
+						bds.pendingRestart.Store(true)
+					}
+
+					if bds.pendingRestart.Swap(false) {
+						select {
+						case params.StartTrigger <- struct{}{}:
+						case <-ctx.Done():
+						}
+					}
 				}(serverProcess)
 			}
 		}
@@ -152,3 +326,44 @@ func New(params Parameters) (*Bds, error) {
 
 	return bds, nil
 }
+
+// Port returns the IPv4 port players connect to
+func (b *Bds) Port() int {
+	return int(b.port.Load())
+}
+
+// Stop stops the running server and the management loop, the instance cannot be started again
+func (b *Bds) Stop() {
+	b.cancel()
+	<-b.done
+}
+
+// requestRestart asks the management loop to restart the running server
+func (b *Bds) requestRestart(reason string) {
+	select {
+	case b.restart <- reason:
+	default:
+	}
+}
+
+// killedBySignal reports whether a process exit error was caused by a signal
+func killedBySignal(err error) bool {
+	var exitErr *exec.ExitError
+	return errors.As(err, &exitErr) && exitErr.ExitCode() == -1
+}
+
+// Say broadcasts a message to all players on the running server
+func (b *Bds) Say(message string) error {
+	console := b.console.Load()
+	if console == nil {
+		return fmt.Errorf("server is not running")
+	}
+	return console.sendCommand("say " + message)
+}
+
+// Health reports whether the server output is still being monitored
+func (b *Bds) Health() MonitorHealth {
+	health := b.outputParser.Health()
+	health.Restarts = int(b.restarts.Load())
+	return health
+}
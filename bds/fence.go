@@ -0,0 +1,70 @@
+package bds
+
+import (
+	"sync"
+	"time"
+)
+
+// fenceTimeout bounds how long a spawning player waits for their pending update to be stored
+const fenceTimeout = 5 * time.Second
+
+// writeFence tracks inventory updates still being stored per player, so the inventory read
+// on spawn is never taken before the update made when the player left
+type writeFence struct {
+	mu      sync.Mutex
+	pending map[string]*fenceState
+}
+
+type fenceState struct {
+	writes int
+	done   chan struct{}
+}
+
+func newWriteFence() *writeFence {
+	return &writeFence{pending: make(map[string]*fenceState)}
+}
+
+// begin marks an update of player as in flight, the returned func marks it stored
+func (f *writeFence) begin(player string) func() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	state, ok := f.pending[player]
+	if !ok {
+		state = &fenceState{done: make(chan struct{})}
+		f.pending[player] = state
+	}
+	state.writes++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+
+			state.writes--
+			if state.writes == 0 {
+				close(state.done)
+				delete(f.pending, player)
+			}
+		})
+	}
+}
+
+// wait blocks until no update of player is in flight, reporting false on timeout
+func (f *writeFence) wait(player string, timeout time.Duration) bool {
+	f.mu.Lock()
+	state, ok := f.pending[player]
+	f.mu.Unlock()
+
+	if !ok {
+		return true
+	}
+
+	select {
+	case <-state.done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
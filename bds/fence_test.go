@@ -0,0 +1,48 @@
+package bds
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteFence(t *testing.T) {
+	t.Run("no pending update", func(t *testing.T) {
+		fence := newWriteFence()
+		assert.True(t, fence.wait("alice", time.Millisecond))
+	})
+
+	t.Run("waits for the update to be stored", func(t *testing.T) {
+		fence := newWriteFence()
+		stored := fence.begin("alice")
+
+		done := make(chan bool)
+		go func() {
+			done <- fence.wait("alice", time.Second)
+		}()
+
+		select {
+		case <-done:
+			t.Fatal("wait returned before the update was stored")
+		case <-time.After(20 * time.Millisecond):
+		}
+
+		stored()
+		stored() // Marking twice is harmless
+		assert.True(t, <-done)
+		assert.True(t, fence.wait("alice", time.Millisecond))
+	})
+
+	t.Run("other players are not held back", func(t *testing.T) {
+		fence := newWriteFence()
+		defer fence.begin("alice")()
+		assert.True(t, fence.wait("bob", time.Millisecond))
+	})
+
+	t.Run("times out", func(t *testing.T) {
+		fence := newWriteFence()
+		defer fence.begin("alice")()
+		assert.False(t, fence.wait("alice", 10*time.Millisecond))
+	})
+}
@@ -0,0 +1,60 @@
+package bds
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// fingerprintEvent is the script event the pack listens on for item fingerprints
+const fingerprintEvent = "consensuscraft:fingerprints"
+
+// fingerprintsPerEvent keeps each script event message well below the command length limit
+const fingerprintsPerEvent = 16
+
+// fingerprintMessage tells the pack the fingerprints of slots of a player's ender chest
+type fingerprintMessage struct {
+	Player string            `json:"player"`
+	Slots  map[string]string `json:"slots"`
+}
+
+// SendFingerprints hands the fingerprints of a player's ender chest slots to the pack,
+// which keeps them on unique items so scripts can tell those apart after lore edits
+func (b *Bds) SendFingerprints(player string, fingerprints map[int]string) error {
+	console := b.console.Load()
+	if console == nil {
+		return fmt.Errorf("server is not running")
+	}
+
+	for _, command := range fingerprintCommands(player, fingerprints) {
+		if err := console.sendCommand(command); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fingerprintCommands builds the script event commands carrying fingerprints, in slot order
+func fingerprintCommands(player string, fingerprints map[int]string) []string {
+	slots := make([]int, 0, len(fingerprints))
+	for slot := range fingerprints {
+		slots = append(slots, slot)
+	}
+	sort.Ints(slots)
+
+	var commands []string
+	for start := 0; start < len(slots); start += fingerprintsPerEvent {
+		message := fingerprintMessage{Player: player, Slots: make(map[string]string)}
+		for _, slot := range slots[start:min(start+fingerprintsPerEvent, len(slots))] {
+			message.Slots[strconv.Itoa(slot)] = fingerprints[slot]
+		}
+
+		data, err := json.Marshal(message)
+		if err != nil {
+			continue
+		}
+		commands = append(commands, fmt.Sprintf("scriptevent %s %s", fingerprintEvent, data))
+	}
+	return commands
+}
@@ -0,0 +1,44 @@
+package bds
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFingerprintCommands(t *testing.T) {
+	fingerprints := make(map[int]string)
+	for slot := 0; slot < 20; slot++ {
+		fingerprints[slot] = fmt.Sprintf("%032x", slot)
+	}
+
+	commands := fingerprintCommands("Steve Jobs", fingerprints)
+	require.Len(t, commands, 2)
+
+	received := make(map[string]string)
+	for _, command := range commands {
+		prefix := "scriptevent consensuscraft:fingerprints "
+		require.True(t, strings.HasPrefix(command, prefix), command)
+		assert.Less(t, len(command), 2048)
+
+		var message fingerprintMessage
+		require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(command, prefix)), &message))
+		assert.Equal(t, "Steve Jobs", message.Player)
+		for slot, fingerprint := range message.Slots {
+			received[slot] = fingerprint
+		}
+	}
+	assert.Len(t, received, 20)
+	assert.Equal(t, fmt.Sprintf("%032x", 19), received["19"])
+
+	assert.Empty(t, fingerprintCommands("Steve", nil))
+}
+
+func TestBds_SendFingerprintsNotRunning(t *testing.T) {
+	b := &Bds{}
+	assert.ErrorContains(t, b.SendFingerprints("Steve", map[int]string{0: "abc"}), "not running")
+}
@@ -0,0 +1,129 @@
+package bds
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// identityEvent is the script event asking the pack which server name it labels item origins with
+const identityEvent = "consensuscraft:identity"
+
+// identityRegex matches the pack's answer to identityEvent, the server name followed by the origin
+// format the pack stamps, which packs built before custom origin formats do not report
+var identityRegex = regexp.MustCompile(`\[X_SERVER_NAME\]\[([^\]]*)\](\[(.*)\])?`)
+
+// packOriginFormat is the origin format the pack stamps when the wrapper hands it none
+const packOriginFormat = "Origin: <server>"
+
+// serverNameCommands stores a server name in the world scoreboard the pack reads it from
+func serverNameCommands(name string) []string {
+	return []string{
+		"scoreboard objectives add serverName dummy",
+		fmt.Sprintf("scoreboard players set \"%s\" serverName 1", strings.ReplaceAll(name, `"`, `\"`)),
+	}
+}
+
+// serverIdentity compares the server name the pack reports with the configured web address
+// A world copied from another server keeps that server's name in its scoreboard, the pack may
+// then keep labelling new items with the other server as their origin
+// The origin format the pack reports is compared with the configured one, a pack installed by an
+// older build stamps another format and every new item it labels would be rejected as forged
+type serverIdentity struct {
+	mu       sync.Mutex
+	expected string
+	reported string
+	repaired bool
+
+	expectedFormat string
+	reportedFormat string
+	formatMismatch bool
+}
+
+// reset starts verifying a new server run, an empty expected name is never verified and an
+// empty format is the pack default
+func (i *serverIdentity) reset(expected, format string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if format == "" {
+		format = packOriginFormat
+	}
+	i.expected = expected
+	i.reported = ""
+	i.repaired = false
+	i.expectedFormat = format
+	i.reportedFormat = ""
+	i.formatMismatch = false
+}
+
+// reportFormat handles the origin format the pack reported along with its name, reported is
+// false for packs too old to report it
+func (i *serverIdentity) reportFormat(format string, reported bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if !reported {
+		format = packOriginFormat
+	}
+	i.reportedFormat = format
+	i.formatMismatch = format != i.expectedFormat
+
+	switch {
+	case !i.formatMismatch:
+		logger.Printf("Pack stamps item origins as %q", format)
+	case !reported:
+		logger.Errorf("The installed pack predates custom origin formats and stamps %q instead of %q, "+
+			"every item it labels will be rejected: reinstall the pack or unset ORIGIN_FORMAT", format, i.expectedFormat)
+	default:
+		logger.Errorf("Pack stamps item origins as %q instead of %q, every item it labels will be rejected: "+
+			"check the originFormat scoreboard of the world", format, i.expectedFormat)
+	}
+}
+
+// report handles the name the pack reported, rewriting the scoreboard once when it is not the
+// expected one and asking the pack again
+func (i *serverIdentity) report(name string, stdin io.Writer) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.reported = name
+	if i.expected == "" || name == i.expected {
+		logger.Printf("Pack labels item origins as %s", name)
+		return
+	}
+
+	if i.repaired {
+		logger.Errorf("Pack still labels item origins as %s instead of %s, check the serverName scoreboard of the world", name, i.expected)
+		return
+	}
+	i.repaired = true
+
+	logger.Warnf("Pack labels item origins as %s instead of %s, the world was likely copied from another server, resetting its serverName scoreboard", name, i.expected)
+	commands := append([]string{"scoreboard objectives remove serverName"}, serverNameCommands(i.expected)...)
+	commands = append(commands, "scriptevent "+identityEvent)
+	for _, command := range commands {
+		if _, err := io.WriteString(stdin, command+"\n"); err != nil {
+			logger.Errorf("Failed to reset the serverName scoreboard: %v", err)
+			return
+		}
+	}
+}
+
+// status returns the last reported name and whether it differs from the expected one
+func (i *serverIdentity) status() (string, bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.reported, i.expected != "" && i.reported != "" && i.reported != i.expected
+}
+
+// formatStatus returns the last reported origin format and whether it differs from the expected one
+func (i *serverIdentity) formatStatus() (string, bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.reportedFormat, i.formatMismatch
+}
@@ -0,0 +1,113 @@
+package bds
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerIdentity(t *testing.T) {
+	t.Run("Matching", func(t *testing.T) {
+		identity := &serverIdentity{}
+		identity.reset("a.example.com", "")
+
+		var stdin bytes.Buffer
+		identity.report("a.example.com", &stdin)
+
+		assert.Empty(t, stdin.String())
+		reported, mismatch := identity.status()
+		assert.Equal(t, "a.example.com", reported)
+		assert.False(t, mismatch)
+	})
+
+	t.Run("CopiedWorldIsResetOnce", func(t *testing.T) {
+		identity := &serverIdentity{}
+		identity.reset("a.example.com", "")
+
+		var stdin bytes.Buffer
+		identity.report("b.example.com", &stdin)
+
+		assert.Equal(t, "scoreboard objectives remove serverName\n"+
+			"scoreboard objectives add serverName dummy\n"+
+			"scoreboard players set \"a.example.com\" serverName 1\n"+
+			"scriptevent consensuscraft:identity\n", stdin.String())
+		_, mismatch := identity.status()
+		assert.True(t, mismatch)
+
+		stdin.Reset()
+		identity.report("b.example.com", &stdin)
+		assert.Empty(t, stdin.String())
+
+		identity.report("a.example.com", &stdin)
+		_, mismatch = identity.status()
+		assert.False(t, mismatch)
+	})
+
+	t.Run("NoWebAddress", func(t *testing.T) {
+		identity := &serverIdentity{}
+		identity.reset("", "")
+
+		var stdin bytes.Buffer
+		identity.report("server-123", &stdin)
+
+		assert.Empty(t, stdin.String())
+		_, mismatch := identity.status()
+		assert.False(t, mismatch)
+	})
+}
+
+func TestServerIdentity_OriginFormat(t *testing.T) {
+	t.Run("Matching", func(t *testing.T) {
+		identity := &serverIdentity{}
+		identity.reset("a.example.com", "Forged on <server>")
+
+		identity.reportFormat("Forged on <server>", true)
+		format, mismatch := identity.formatStatus()
+		assert.Equal(t, "Forged on <server>", format)
+		assert.False(t, mismatch)
+	})
+
+	t.Run("DefaultFormat", func(t *testing.T) {
+		identity := &serverIdentity{}
+		identity.reset("a.example.com", "")
+
+		identity.reportFormat("", false)
+		_, mismatch := identity.formatStatus()
+		assert.False(t, mismatch)
+	})
+
+	t.Run("StalePack", func(t *testing.T) {
+		identity := &serverIdentity{}
+		identity.reset("a.example.com", "Forged on <server>")
+
+		identity.reportFormat("", false)
+		format, mismatch := identity.formatStatus()
+		assert.Equal(t, "Origin: <server>", format)
+		assert.True(t, mismatch)
+
+		// A new server run starts over
+		identity.reset("a.example.com", "Forged on <server>")
+		format, mismatch = identity.formatStatus()
+		assert.Empty(t, format)
+		assert.False(t, mismatch)
+	})
+
+	t.Run("OtherFormat", func(t *testing.T) {
+		identity := &serverIdentity{}
+		identity.reset("a.example.com", "Forged on <server>")
+
+		identity.reportFormat("Origin: <server>", true)
+		_, mismatch := identity.formatStatus()
+		assert.True(t, mismatch)
+	})
+}
+
+func TestIdentityRegex(t *testing.T) {
+	matches := identityRegex.FindStringSubmatch("[Scripting] [X_SERVER_NAME][a.example.com]")
+	assert.Equal(t, []string{"[X_SERVER_NAME][a.example.com]", "a.example.com", "", ""}, matches)
+
+	matches = identityRegex.FindStringSubmatch("[Scripting] [X_SERVER_NAME][a.example.com][Forged on <server>]")
+	assert.Equal(t, []string{"[X_SERVER_NAME][a.example.com][Forged on <server>]", "a.example.com",
+		"[Forged on <server>]", "Forged on <server>"}, matches)
+}
@@ -0,0 +1,59 @@
+package bds
+
+import "time"
+
+// IngestStall is a stretch of time players were online without a single inventory update reaching the
+// wrapper, the sign of a broken pack or log parser that otherwise stays silent until items go missing
+type IngestStall struct {
+	Players []string      // Players online during the stall
+	Since   time.Time     // Last ingested update, or the start of the oldest session when later
+	For     time.Duration // How long nothing was ingested
+}
+
+// ingested records that a well-formed inventory update was read from the server
+func (op *OutputParser) ingested(at time.Time) {
+	op.healthMu.Lock()
+	defer op.healthMu.Unlock()
+	op.lastIngest = at
+}
+
+// oldestSession returns the start of the longest running session, zero while nobody is online
+func (o *onlinePlayers) oldestSession() time.Time {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	var oldest time.Time
+	for _, session := range o.players {
+		if oldest.IsZero() || session.ConnectedAt.Before(oldest) {
+			oldest = session.ConnectedAt
+		}
+	}
+	return oldest
+}
+
+// stall reports an ingest stall when players have been online for at least period since the last
+// update, false while nobody is online
+func (op *OutputParser) stall(period time.Duration, now time.Time) (IngestStall, bool) {
+	oldest := op.online.oldestSession()
+	if oldest.IsZero() {
+		return IngestStall{}, false
+	}
+
+	since := oldest
+	if last := op.Health().LastIngest; last.After(since) {
+		since = last
+	}
+	if now.Sub(since) < period {
+		return IngestStall{}, false
+	}
+	return IngestStall{Players: op.online.list(), Since: since, For: now.Sub(since)}, true
+}
+
+// IngestStalled reports whether players have been online for period without any inventory update
+// being ingested, a period of zero disables the check
+func (b *Bds) IngestStalled(period time.Duration) (IngestStall, bool) {
+	if period <= 0 {
+		return IngestStall{}, false
+	}
+	return b.outputParser.stall(period, time.Now())
+}
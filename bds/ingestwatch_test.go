@@ -0,0 +1,69 @@
+package bds
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutputParser_Stall(t *testing.T) {
+	op := NewOutputParser(
+		func(playerName string) ([]byte, error) { return nil, nil },
+		func(playerName string, inventory []byte) error { return nil },
+	)
+	now := time.Now()
+
+	t.Run("nobody online", func(t *testing.T) {
+		_, stalled := op.stall(time.Minute, now)
+		assert.False(t, stalled)
+	})
+
+	op.online.players["Steve"] = &Session{Player: "Steve", ConnectedAt: now.Add(-time.Hour)}
+	op.online.players["Alex"] = &Session{Player: "Alex", ConnectedAt: now.Add(-10 * time.Minute)}
+
+	t.Run("players online without updates", func(t *testing.T) {
+		stall, stalled := op.stall(30*time.Minute, now)
+		require.True(t, stalled)
+		assert.Equal(t, []string{"Alex", "Steve"}, stall.Players)
+		assert.Equal(t, now.Add(-time.Hour), stall.Since)
+		assert.Equal(t, time.Hour, stall.For)
+	})
+
+	t.Run("recent update", func(t *testing.T) {
+		op.ingested(now.Add(-5 * time.Minute))
+		_, stalled := op.stall(30*time.Minute, now)
+		assert.False(t, stalled)
+
+		stall, stalled := op.stall(time.Minute, now)
+		require.True(t, stalled)
+		assert.Equal(t, 5*time.Minute, stall.For)
+	})
+
+	t.Run("session shorter than the period", func(t *testing.T) {
+		op.online.remove("Steve")
+		op.ingested(time.Time{})
+		_, stalled := op.stall(30*time.Minute, now)
+		assert.False(t, stalled)
+	})
+}
+
+func TestOutputParser_Ingested(t *testing.T) {
+	op := NewOutputParser(
+		func(playerName string) ([]byte, error) { return nil, nil },
+		func(playerName string, inventory []byte) error { return nil },
+	)
+	assert.True(t, op.Health().LastIngest.IsZero())
+
+	before := time.Now()
+	op.ingested(before)
+	assert.Equal(t, before, op.Health().LastIngest)
+
+	t.Run("disabled", func(t *testing.T) {
+		server := &Bds{outputParser: op}
+		op.online.add("Steve", "")
+		_, stalled := server.IngestStalled(0)
+		assert.False(t, stalled)
+	})
+}
@@ -0,0 +1,179 @@
+package bds
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// NBT tag types of the little endian format Bedrock saves level.dat in
+const (
+	tagEnd byte = iota
+	tagByte
+	tagShort
+	tagInt
+	tagLong
+	tagFloat
+	tagDouble
+	tagByteArray
+	tagString
+	tagList
+	tagCompound
+	tagIntArray
+	tagLongArray
+)
+
+// levelDatHeaderSize is the storage version and payload length written before the NBT of level.dat
+const levelDatHeaderSize = 8
+
+// maxNBTDepth bounds the nesting of compounds and lists read from level.dat
+const maxNBTDepth = 64
+
+var errSeedNotFound = errors.New("level.dat holds no RandomSeed")
+
+// readLevelSeed reads the seed a world was generated with from its level.dat, which is the seed
+// BDS drew when level-seed was left blank
+func readLevelSeed(path string) (int64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	if len(data) < levelDatHeaderSize {
+		return 0, fmt.Errorf("level.dat is truncated")
+	}
+
+	r := bytes.NewReader(data[levelDatHeaderSize:])
+	tag, err := r.ReadByte()
+	if err != nil {
+		return 0, fmt.Errorf("level.dat is truncated")
+	}
+	if tag != tagCompound {
+		return 0, fmt.Errorf("level.dat does not start with a compound")
+	}
+	if _, err := readNBTString(r); err != nil {
+		return 0, err
+	}
+
+	for {
+		tag, err := r.ReadByte()
+		if err != nil {
+			return 0, fmt.Errorf("level.dat is truncated")
+		}
+		if tag == tagEnd {
+			return 0, errSeedNotFound
+		}
+
+		name, err := readNBTString(r)
+		if err != nil {
+			return 0, err
+		}
+		if name == "RandomSeed" && tag == tagLong {
+			var seed int64
+			if err := binary.Read(r, binary.LittleEndian, &seed); err != nil {
+				return 0, fmt.Errorf("level.dat is truncated")
+			}
+			return seed, nil
+		}
+		if err := skipNBT(r, tag, 0); err != nil {
+			return 0, err
+		}
+	}
+}
+
+// readNBTString reads a length prefixed NBT string
+func readNBTString(r *bytes.Reader) (string, error) {
+	var length uint16
+	if err := binary.Read(r, binary.LittleEndian, &length); err != nil {
+		return "", fmt.Errorf("level.dat is truncated")
+	}
+	name := make([]byte, length)
+	if _, err := io.ReadFull(r, name); err != nil {
+		return "", fmt.Errorf("level.dat is truncated")
+	}
+	return string(name), nil
+}
+
+// skipNBT skips the payload of a tag
+func skipNBT(r *bytes.Reader, tag byte, depth int) error {
+	if depth > maxNBTDepth {
+		return fmt.Errorf("level.dat nests too deep")
+	}
+
+	skip := func(n int64) error {
+		if n < 0 || n > int64(r.Len()) {
+			return fmt.Errorf("level.dat is truncated")
+		}
+		_, err := r.Seek(n, io.SeekCurrent)
+		return err
+	}
+	count := func() (int64, error) {
+		var n int32
+		if err := binary.Read(r, binary.LittleEndian, &n); err != nil {
+			return 0, fmt.Errorf("level.dat is truncated")
+		}
+		return int64(n), nil
+	}
+
+	switch tag {
+	case tagByte:
+		return skip(1)
+	case tagShort:
+		return skip(2)
+	case tagInt, tagFloat:
+		return skip(4)
+	case tagLong, tagDouble:
+		return skip(8)
+	case tagString:
+		_, err := readNBTString(r)
+		return err
+	case tagByteArray, tagIntArray, tagLongArray:
+		n, err := count()
+		if err != nil {
+			return err
+		}
+		size := map[byte]int64{tagByteArray: 1, tagIntArray: 4, tagLongArray: 8}[tag]
+		if n < 0 || n > int64(r.Len())/size {
+			return fmt.Errorf("level.dat is truncated")
+		}
+		return skip(n * size)
+	case tagList:
+		element, err := r.ReadByte()
+		if err != nil {
+			return fmt.Errorf("level.dat is truncated")
+		}
+		n, err := count()
+		if err != nil {
+			return err
+		}
+		if n < 0 || n > int64(r.Len()) {
+			return fmt.Errorf("level.dat is truncated")
+		}
+		for i := int64(0); i < n; i++ {
+			if err := skipNBT(r, element, depth+1); err != nil {
+				return err
+			}
+		}
+		return nil
+	case tagCompound:
+		for {
+			next, err := r.ReadByte()
+			if err != nil {
+				return fmt.Errorf("level.dat is truncated")
+			}
+			if next == tagEnd {
+				return nil
+			}
+			if _, err := readNBTString(r); err != nil {
+				return err
+			}
+			if err := skipNBT(r, next, depth+1); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("level.dat holds unknown tag %d", tag)
+	}
+}
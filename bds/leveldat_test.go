@@ -0,0 +1,71 @@
+package bds
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeLevelDat writes a level.dat holding a few tags around RandomSeed, the way BDS saves it
+func writeLevelDat(t *testing.T, path string, seed int64) {
+	var nbt bytes.Buffer
+	name := func(s string) {
+		binary.Write(&nbt, binary.LittleEndian, uint16(len(s)))
+		nbt.WriteString(s)
+	}
+
+	nbt.WriteByte(tagCompound)
+	name("")
+	nbt.WriteByte(tagString)
+	name("LevelName")
+	name("Bedrock level")
+	nbt.WriteByte(tagList)
+	name("lastOpenedWithVersion")
+	nbt.WriteByte(tagInt)
+	binary.Write(&nbt, binary.LittleEndian, int32(2))
+	binary.Write(&nbt, binary.LittleEndian, []int32{1, 21})
+	nbt.WriteByte(tagCompound)
+	name("abilities")
+	nbt.WriteByte(tagByte)
+	name("flying")
+	nbt.WriteByte(0)
+	nbt.WriteByte(tagEnd)
+	nbt.WriteByte(tagLong)
+	name("RandomSeed")
+	binary.Write(&nbt, binary.LittleEndian, seed)
+	nbt.WriteByte(tagEnd)
+
+	var data bytes.Buffer
+	binary.Write(&data, binary.LittleEndian, int32(10))
+	binary.Write(&data, binary.LittleEndian, int32(nbt.Len()))
+	data.Write(nbt.Bytes())
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, data.Bytes(), 0644))
+}
+
+func TestReadLevelSeed(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "level.dat")
+	writeLevelDat(t, path, -4172144997902289642)
+
+	seed, err := readLevelSeed(path)
+	require.NoError(t, err)
+	assert.Equal(t, int64(-4172144997902289642), seed)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	// Cut anywhere before the seed is read, level.dat is refused rather than misread
+	for i := 0; i < len(data)-1; i++ {
+		require.NoError(t, os.WriteFile(path, data[:i], 0644))
+		_, err := readLevelSeed(path)
+		assert.Error(t, err, "truncated to %d bytes", i)
+	}
+
+	_, err = readLevelSeed(filepath.Join(t.TempDir(), "level.dat"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
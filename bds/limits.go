@@ -0,0 +1,80 @@
+package bds
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// defaultCgroupRoot is the cgroup v2 directory server cgroups are created in
+const defaultCgroupRoot = "/sys/fs/cgroup/consensuscraft"
+
+// memoryRestartRatio is the share of MemoryMax at which the server is restarted, before
+// the kernel kills it at the hard limit
+const memoryRestartRatio = 0.9
+
+// memoryWatchInterval is how often the server's memory usage is sampled
+const memoryWatchInterval = 10 * time.Second
+
+// ResourceLimits caps the resources of the bedrock_server process so co-hosted nodes stay
+// stable, zero values leave a limit unset
+type ResourceLimits struct {
+	MemoryMax  int64  // Bytes, enforced with a cgroup when available and watched for restarts
+	CPUWeight  int    // cgroup v2 cpu.weight relative to other cgroups, 1 to 10000
+	Nice       int    // Scheduling niceness of the process, -20 to 19
+	CgroupRoot string // cgroup v2 directory the server cgroup is created in, defaults to /sys/fs/cgroup/consensuscraft
+}
+
+// Enabled reports whether any limit is set
+func (l ResourceLimits) Enabled() bool {
+	return l.MemoryMax > 0 || l.CPUWeight > 0 || l.Nice != 0
+}
+
+// Validate checks that the limits are within the ranges accepted by the kernel
+func (l ResourceLimits) Validate() error {
+	if l.MemoryMax < 0 {
+		return fmt.Errorf("memory limit cannot be negative, got %d", l.MemoryMax)
+	}
+	if l.CPUWeight < 0 || l.CPUWeight > 10000 {
+		return fmt.Errorf("cpu weight must be between 1 and 10000, got %d", l.CPUWeight)
+	}
+	if l.Nice < -20 || l.Nice > 19 {
+		return fmt.Errorf("niceness must be between -20 and 19, got %d", l.Nice)
+	}
+	return nil
+}
+
+func (l ResourceLimits) cgroupRoot() string {
+	if l.CgroupRoot == "" {
+		return defaultCgroupRoot
+	}
+	return l.CgroupRoot
+}
+
+// watchMemory samples the memory used by pid until ctx is done, calling exceeded once
+// usage reaches memoryRestartRatio of limit
+func watchMemory(ctx context.Context, pid int, limit int64, interval time.Duration, usage func(pid int) (int64, error), exceeded func(used int64)) {
+	threshold := int64(float64(limit) * memoryRestartRatio)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		used, err := usage(pid)
+		if err != nil {
+			logger.Debugf("Failed to read memory usage of PID %d: %v", pid, err)
+			continue
+		}
+		if used >= threshold {
+			exceeded(used)
+			return
+		}
+	}
+}
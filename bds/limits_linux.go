@@ -0,0 +1,98 @@
+package bds
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// applyLimits applies the limits to a started process, returning a func removing its cgroup
+// Niceness is applied even when the cgroup cannot be created
+func applyLimits(pid int, limits ResourceLimits) (func(), error) {
+	var errs []error
+
+	if limits.Nice != 0 {
+		if err := syscall.Setpriority(syscall.PRIO_PROCESS, pid, limits.Nice); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set niceness: %w", err))
+		}
+	}
+
+	release := func() {}
+	if limits.MemoryMax > 0 || limits.CPUWeight > 0 {
+		dir, err := createCgroup(pid, limits)
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			release = func() { os.Remove(dir) }
+		}
+	}
+
+	return release, errors.Join(errs...)
+}
+
+// createCgroup moves pid into a new cgroup v2 under the limits' root with the memory and cpu limits set
+func createCgroup(pid int, limits ResourceLimits) (string, error) {
+	root := limits.cgroupRoot()
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return "", fmt.Errorf("failed to create cgroup root: %w", err)
+	}
+
+	// Controllers must be enabled for the children of root, this fails when they already are
+	os.WriteFile(filepath.Join(root, "cgroup.subtree_control"), []byte("+memory +cpu"), 0644)
+
+	dir := filepath.Join(root, "bds-"+strconv.Itoa(pid))
+	if err := os.Mkdir(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create cgroup: %w", err)
+	}
+
+	// The process joins last, once its limits are in place
+	settings := [][2]string{}
+	if limits.MemoryMax > 0 {
+		settings = append(settings, [2]string{"memory.max", strconv.FormatInt(limits.MemoryMax, 10)})
+	}
+	if limits.CPUWeight > 0 {
+		settings = append(settings, [2]string{"cpu.weight", strconv.Itoa(limits.CPUWeight)})
+	}
+	settings = append(settings, [2]string{"cgroup.procs", strconv.Itoa(pid)})
+
+	for _, setting := range settings {
+		if err := os.WriteFile(filepath.Join(dir, setting[0]), []byte(setting[1]), 0644); err != nil {
+			os.Remove(dir)
+			return "", fmt.Errorf("failed to set %s: %w", setting[0], err)
+		}
+	}
+
+	return dir, nil
+}
+
+// processMemory returns the resident memory of pid in bytes
+func processMemory(pid int) (int64, error) {
+	file, err := os.Open(filepath.Join("/proc", strconv.Itoa(pid), "status"))
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		value, ok := strings.CutPrefix(scanner.Text(), "VmRSS:")
+		if !ok {
+			continue
+		}
+		kilobytes, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimSpace(value), " kB"), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid VmRSS %q: %w", value, err)
+		}
+		return kilobytes * 1024, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+
+	return 0, fmt.Errorf("no VmRSS in process status")
+}
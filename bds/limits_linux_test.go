@@ -0,0 +1,73 @@
+package bds
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessMemory(t *testing.T) {
+	used, err := processMemory(os.Getpid())
+	require.NoError(t, err)
+	assert.Positive(t, used)
+
+	_, err = processMemory(-1)
+	assert.Error(t, err)
+}
+
+func TestApplyLimits(t *testing.T) {
+	cmd := exec.Command("sleep", "5")
+	require.NoError(t, cmd.Start())
+	defer cmd.Process.Kill()
+
+	t.Run("niceness", func(t *testing.T) {
+		release, err := applyLimits(cmd.Process.Pid, ResourceLimits{Nice: 10})
+		require.NoError(t, err)
+		release()
+
+		priority, err := syscall.Getpriority(syscall.PRIO_PROCESS, cmd.Process.Pid)
+		require.NoError(t, err)
+		assert.Equal(t, 10, 20-priority) // The raw syscall returns 20 - nice
+	})
+
+	t.Run("cgroup files", func(t *testing.T) {
+		root := t.TempDir()
+		dir, err := createCgroup(cmd.Process.Pid, ResourceLimits{MemoryMax: 1 << 30, CPUWeight: 50, CgroupRoot: root})
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(root, "bds-"+strconv.Itoa(cmd.Process.Pid)), dir)
+
+		for file, want := range map[string]string{
+			"memory.max":   "1073741824",
+			"cpu.weight":   "50",
+			"cgroup.procs": strconv.Itoa(cmd.Process.Pid),
+		} {
+			data, err := os.ReadFile(filepath.Join(dir, file))
+			require.NoError(t, err)
+			assert.Equal(t, want, string(data))
+		}
+	})
+
+	t.Run("unusable cgroup root", func(t *testing.T) {
+		root := filepath.Join(t.TempDir(), "file")
+		require.NoError(t, os.WriteFile(root, nil, 0644))
+
+		_, err := applyLimits(cmd.Process.Pid, ResourceLimits{MemoryMax: 1 << 30, CgroupRoot: root})
+		assert.Error(t, err)
+	})
+}
+
+func TestKilledBySignal(t *testing.T) {
+	cmd := exec.Command("sleep", "5")
+	require.NoError(t, cmd.Start())
+	require.NoError(t, cmd.Process.Kill())
+	assert.True(t, killedBySignal(cmd.Wait()))
+
+	assert.False(t, killedBySignal(exec.Command("false").Run()))
+	assert.False(t, killedBySignal(nil))
+}
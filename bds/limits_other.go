@@ -0,0 +1,18 @@
+//go:build !linux
+
+package bds
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// applyLimits is only supported on linux
+func applyLimits(pid int, limits ResourceLimits) (func(), error) {
+	return func() {}, fmt.Errorf("resource limits are not supported on %s", runtime.GOOS)
+}
+
+// processMemory is only supported on linux
+func processMemory(pid int) (int64, error) {
+	return 0, fmt.Errorf("memory usage is not available on %s", runtime.GOOS)
+}
@@ -0,0 +1,63 @@
+package bds
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceLimits_Validate(t *testing.T) {
+	assert.False(t, ResourceLimits{}.Enabled())
+	assert.NoError(t, ResourceLimits{}.Validate())
+
+	limits := ResourceLimits{MemoryMax: 4 << 30, CPUWeight: 50, Nice: 5}
+	assert.True(t, limits.Enabled())
+	assert.NoError(t, limits.Validate())
+
+	assert.Error(t, ResourceLimits{MemoryMax: -1}.Validate())
+	assert.Error(t, ResourceLimits{CPUWeight: 10001}.Validate())
+	assert.Error(t, ResourceLimits{Nice: 20}.Validate())
+	assert.Error(t, ResourceLimits{Nice: -21}.Validate())
+
+	assert.Equal(t, defaultCgroupRoot, ResourceLimits{}.cgroupRoot())
+}
+
+func TestWatchMemory(t *testing.T) {
+	t.Run("calls exceeded close to the limit", func(t *testing.T) {
+		samples := []int64{100, 500, 950, 2000}
+		usage := func(pid int) (int64, error) {
+			used := samples[0]
+			samples = samples[1:]
+			return used, nil
+		}
+
+		var exceeded int64
+		watchMemory(context.Background(), 1, 1000, time.Millisecond, usage, func(used int64) {
+			exceeded = used
+		})
+		assert.Equal(t, int64(950), exceeded)
+	})
+
+	t.Run("stops with the context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			watchMemory(ctx, 1, 1000, time.Millisecond, func(pid int) (int64, error) {
+				return 0, errors.New("no such process")
+			}, func(int64) {
+				t.Error("exceeded called without usage")
+			})
+			close(done)
+		}()
+
+		cancel()
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("watchMemory did not stop")
+		}
+	})
+}
@@ -0,0 +1,65 @@
+package bds
+
+import (
+	"bufio"
+	"io"
+	"os"
+
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// maxLogLine is the longest server output line parsed, an ender chest full of written books in
+// shulker boxes is logged on one line of a few hundred kilobytes
+const maxLogLine = 16 << 20
+
+// logLines reads the lines of a server output pipe, echoing the output to stdout
+// It outlives the monitor reading it, so lines read ahead are kept when a monitor that panicked is
+// re-attached, only the line being processed is lost
+type logLines struct {
+	reader  *bufio.Reader
+	max     int
+	skipped int // Lines longer than max skipped so far
+}
+
+// newLogLines reads the lines of reader, skipping lines longer than maxLogLine
+func newLogLines(reader io.Reader) *logLines {
+	return &logLines{reader: bufio.NewReaderSize(io.TeeReader(reader, os.Stdout), 64<<10), max: maxLogLine}
+}
+
+// next returns the next line without its line ending, lines over the limit are skipped with a warning
+// rather than failing the pipe, which would restart the server over and over on the same line
+func (l *logLines) next() (string, error) {
+	for {
+		line, tooLong, err := l.read()
+		if err != nil {
+			return "", err
+		}
+		if !tooLong {
+			return line, nil
+		}
+
+		l.skipped++
+		logger.Warnf("Skipped a server output line longer than %d bytes, %d skipped so far", l.max, l.skipped)
+	}
+}
+
+// read reads one line, reporting lines over the limit without keeping them
+func (l *logLines) read() (string, bool, error) {
+	var line []byte
+	tooLong := false
+	for {
+		chunk, more, err := l.reader.ReadLine()
+		if err != nil {
+			return "", false, err
+		}
+		if !tooLong && len(line)+len(chunk) > l.max {
+			tooLong, line = true, nil
+		}
+		if !tooLong {
+			line = append(line, chunk...)
+		}
+		if !more {
+			return string(line), tooLong, nil
+		}
+	}
+}
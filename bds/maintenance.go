@@ -0,0 +1,57 @@
+package bds
+
+import (
+	"fmt"
+	"strings"
+)
+
+// OnlinePlayers returns the players currently connected to the server
+func (b *Bds) OnlinePlayers() []string {
+	return b.outputParser.online.list()
+}
+
+// EnterMaintenance turns the allowlist on with only the allowed players on it and kicks everyone else
+func (b *Bds) EnterMaintenance(allowed []string, message string) error {
+	console := b.console.Load()
+	if console == nil {
+		return fmt.Errorf("server is not running")
+	}
+
+	for _, command := range maintenanceCommands(allowed, b.OnlinePlayers(), message) {
+		if err := console.sendCommand(command); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LeaveMaintenance turns the allowlist off so every player can join again
+func (b *Bds) LeaveMaintenance() error {
+	console := b.console.Load()
+	if console == nil {
+		return fmt.Errorf("server is not running")
+	}
+	return console.sendCommand("allowlist off")
+}
+
+// maintenanceCommands builds the commands restricting the server to the allowed players
+func maintenanceCommands(allowed, online []string, message string) []string {
+	commands := []string{"allowlist on"}
+	isAllowed := make(map[string]bool, len(allowed))
+	for _, player := range allowed {
+		isAllowed[strings.ToLower(player)] = true
+		commands = append(commands, fmt.Sprintf("allowlist add %s", quotePlayer(player)))
+	}
+
+	for _, player := range online {
+		if !isAllowed[strings.ToLower(player)] {
+			commands = append(commands, fmt.Sprintf("kick %s %s", quotePlayer(player), message))
+		}
+	}
+	return commands
+}
+
+// quotePlayer quotes a player name for a server command
+func quotePlayer(player string) string {
+	return `"` + strings.ReplaceAll(player, `"`, `\"`) + `"`
+}
@@ -0,0 +1,48 @@
+package bds
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutputParser_OnlinePlayers(t *testing.T) {
+	op := NewOutputParser(
+		func(playerName string) ([]byte, error) { return nil, nil },
+		func(playerName string, inventory []byte) error { return nil },
+	)
+
+	input := strings.Join([]string{
+		"[2025-01-01 10:00:00:000 INFO] Player connected: Steve, xuid: 2535400000000001",
+		"[2025-01-01 10:00:01:000 INFO] Player connected: Big Alex, xuid: 2535400000000002",
+		"[2025-01-01 10:00:02:000 INFO] Player connected: Notch, xuid: 2535400000000003",
+		"[2025-01-01 10:00:03:000 INFO] Player disconnected: Notch, xuid: 2535400000000003, pfid: 1",
+	}, "\n") + "\n"
+
+	require.NoError(t, op.monitorServerLogs(strings.NewReader(input), Parameters{}, nil))
+	assert.Equal(t, []string{"Big Alex", "Steve"}, op.online.list())
+
+	op.online.clear()
+	assert.Empty(t, op.online.list())
+}
+
+func TestMaintenanceCommands(t *testing.T) {
+	commands := maintenanceCommands([]string{"Admin", "Big Alex"}, []string{"admin", "Big Alex", "Steve"}, "Server under maintenance")
+
+	assert.Equal(t, []string{
+		"allowlist on",
+		`allowlist add "Admin"`,
+		`allowlist add "Big Alex"`,
+		`kick "Steve" Server under maintenance`,
+	}, commands)
+
+	assert.Equal(t, []string{"allowlist on"}, maintenanceCommands(nil, nil, "bye"))
+}
+
+func TestBds_MaintenanceNotRunning(t *testing.T) {
+	b := &Bds{outputParser: NewOutputParser(nil, nil)}
+	assert.ErrorContains(t, b.EnterMaintenance(nil, "bye"), "not running")
+	assert.ErrorContains(t, b.LeaveMaintenance(), "not running")
+}
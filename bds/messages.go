@@ -0,0 +1,143 @@
+package bds
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Keys of the messages the wrapper sends in-game
+const (
+	MessageLocalOnly            = "local_only"
+	MessageReconnected          = "reconnected"
+	MessageMaintenance          = "maintenance"
+	MessageMaintenanceReason    = "maintenance_reason"
+	MessageMaintenanceOver      = "maintenance_over"
+	MessageRestart              = "restart"
+	MessageScheduledRestart     = "scheduled_restart"
+	MessageItemsStripped        = "items_stripped"
+	MessageInvalidItemsStripped = "invalid_items_stripped"
+	MessageFrozen               = "frozen"
+	MessageUnfrozen             = "unfrozen"
+	MessageFrozenChange         = "frozen_change"
+)
+
+// defaultMessages are the English templates, used for every key a messages file leaves out
+var defaultMessages = map[string]string{
+	MessageLocalOnly:            "No peer servers reachable, running in local-only mode. Ender chest changes will sync when the network returns",
+	MessageReconnected:          "Network reconnected, inventories are syncing again",
+	MessageMaintenance:          "Server is under maintenance",
+	MessageMaintenanceReason:    "Server is under maintenance: <reason>",
+	MessageMaintenanceOver:      "Maintenance is over, the server is open again",
+	MessageRestart:              "Server is restarting after <reason>, please reconnect in a minute",
+	MessageScheduledRestart:     "Server restarts in <time> for its scheduled restart, please get to a safe place",
+	MessageItemsStripped:        "Items from mods not accepted on <server> were removed from your ender chest",
+	MessageInvalidItemsStripped: "Invalid items in your ender chest from <server> were removed",
+	MessageFrozen:               "Ender chest sync is frozen on all servers while operators investigate: <reason>. Changes made now will not be saved",
+	MessageUnfrozen:             "Ender chest sync is running again, changes are saved as usual",
+	MessageFrozenChange:         "Ender chest sync is frozen, this change was not saved",
+}
+
+// messagePlaceholders are the placeholders each template may use
+var messagePlaceholders = map[string][]string{
+	MessageMaintenanceReason:    {"reason"},
+	MessageRestart:              {"reason"},
+	MessageScheduledRestart:     {"time"},
+	MessageItemsStripped:        {"player", "server"},
+	MessageInvalidItemsStripped: {"player", "server"},
+	MessageFrozen:               {"reason"},
+}
+
+var placeholderRegex = regexp.MustCompile(`<([a-z_]+)>`)
+
+// Messages are the templates of in-game messages, with <name> placeholders filled by Format
+type Messages struct {
+	templates map[string]string
+}
+
+// DefaultMessages returns the built-in English messages
+func DefaultMessages() *Messages {
+	templates := make(map[string]string, len(defaultMessages))
+	for key, template := range defaultMessages {
+		templates[key] = template
+	}
+	return &Messages{templates: templates}
+}
+
+// NewMessages overrides the default templates, rejecting unknown keys and placeholders
+// Templates are sent with say, kick and tell so they must fit on a single line
+func NewMessages(overrides map[string]string) (*Messages, error) {
+	messages := DefaultMessages()
+	for _, key := range sortedKeys(overrides) {
+		template := overrides[key]
+		if _, ok := defaultMessages[key]; !ok {
+			return nil, fmt.Errorf("unknown message %q", key)
+		}
+		if strings.TrimSpace(template) == "" || strings.ContainsAny(template, "\r\n") {
+			return nil, fmt.Errorf("message %s must be a non-empty single line", key)
+		}
+		for _, match := range placeholderRegex.FindAllStringSubmatch(template, -1) {
+			if !slices.Contains(messagePlaceholders[key], match[1]) {
+				return nil, fmt.Errorf("message %s has unknown placeholder %s", key, match[0])
+			}
+		}
+		messages.templates[key] = template
+	}
+
+	return messages, nil
+}
+
+// LoadMessages reads message overrides from a JSON object of key to template, e.g. a translation
+func LoadMessages(path string) (*Messages, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read messages: %w", err)
+	}
+
+	var overrides map[string]string
+	if err := json.Unmarshal(data, &overrides); err != nil {
+		return nil, fmt.Errorf("invalid messages file %s: %w", path, err)
+	}
+	return NewMessages(overrides)
+}
+
+// Format fills the placeholders of the template of key, values are given as name, value pairs
+func (m *Messages) Format(key string, values ...string) string {
+	template := m.templates[key]
+	for i := 0; i+1 < len(values); i += 2 {
+		template = strings.ReplaceAll(template, "<"+values[i]+">", values[i+1])
+	}
+	return template
+}
+
+// Message formats an in-game message with the templates the server was created with
+func (b *Bds) Message(key string, values ...string) string {
+	return b.messages.Format(key, values...)
+}
+
+// Announce broadcasts a templated message to all players
+func (b *Bds) Announce(key string, values ...string) error {
+	return b.Say(b.Message(key, values...))
+}
+
+// Tell sends a message to one player on the running server
+func (b *Bds) Tell(player, message string) error {
+	console := b.console.Load()
+	if console == nil {
+		return fmt.Errorf("server is not running")
+	}
+	return console.sendCommand(fmt.Sprintf("tell %s %s", quotePlayer(player), message))
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,63 @@
+package bds
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessages_Format(t *testing.T) {
+	messages := DefaultMessages()
+
+	assert.Equal(t, "Server is restarting after exceeding its memory limit, please reconnect in a minute",
+		messages.Format(MessageRestart, "reason", "exceeding its memory limit"))
+	assert.Equal(t, "Server is under maintenance", messages.Format(MessageMaintenance))
+
+	// Every message has a default
+	for key := range defaultMessages {
+		assert.NotEmpty(t, messages.Format(key), key)
+	}
+}
+
+func TestNewMessages(t *testing.T) {
+	messages, err := NewMessages(map[string]string{
+		MessageItemsStripped: "Gegenstände von <server> wurden entfernt, <player>",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Gegenstände von a.example.com wurden entfernt, Steve",
+		messages.Format(MessageItemsStripped, "player", "Steve", "server", "a.example.com"))
+	assert.Equal(t, defaultMessages[MessageReconnected], messages.Format(MessageReconnected))
+
+	tests := map[string]map[string]string{
+		"unknown key":         {"motd": "Welcome"},
+		"unknown placeholder": {MessageRestart: "Restart in <minutes> minutes"},
+		"no placeholders":     {MessageLocalOnly: "Offline, <reason>"},
+		"multiple lines":      {MessageReconnected: "Back\nonline"},
+		"empty":               {MessageReconnected: " "},
+	}
+	for name, overrides := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewMessages(overrides)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestLoadMessages(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "messages.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"maintenance_over":"Wartung beendet"}`), 0644))
+
+	messages, err := LoadMessages(path)
+	require.NoError(t, err)
+	assert.Equal(t, "Wartung beendet", messages.Format(MessageMaintenanceOver))
+
+	require.NoError(t, os.WriteFile(path, []byte(`["not an object"]`), 0644))
+	_, err = LoadMessages(path)
+	assert.Error(t, err)
+
+	_, err = LoadMessages(filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,133 @@
+package bds
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"sync"
+)
+
+// sequenceRegex matches the "#run:seq][" prefix the pack logs after the player name
+var sequenceRegex = regexp.MustCompile(`^#([0-9a-z]+):(\d+)\]\[(.*)$`)
+
+// EventSequence identifies a logged ender chest update: the pack run that logged it, a new one
+// every time the server loads the pack, and the number of the update within that run
+type EventSequence struct {
+	Run string `json:"run"`
+	Seq uint64 `json:"seq"`
+}
+
+// ID identifies the update for deduplication, the run makes it unique across server restarts
+func (e EventSequence) ID() string {
+	return fmt.Sprintf("%s:%d", e.Run, e.Seq)
+}
+
+// parseSequence splits the optional sequence prefix from an ender chest payload, ok is false
+// for packs that do not log sequences
+func parseSequence(data string) (EventSequence, string, bool) {
+	matches := sequenceRegex.FindStringSubmatch(data)
+	if len(matches) != 4 {
+		return EventSequence{}, data, false
+	}
+
+	seq, err := strconv.ParseUint(matches[2], 10, 64)
+	if err != nil {
+		return EventSequence{}, data, false
+	}
+	return EventSequence{Run: matches[1], Seq: seq}, matches[3], true
+}
+
+// IngestOffsets remembers the last applied ender chest update, so a wrapper restarted while the
+// server keeps running neither applies updates twice nor misses some unnoticed
+type IngestOffsets struct {
+	mu         sync.Mutex
+	path       string
+	last       EventSequence
+	read       EventSequence // Last update read, ahead of last while updates wait to be stored
+	duplicates int
+	missed     uint64
+}
+
+// OpenIngestOffsets loads the offsets stored at path, an empty path keeps them in memory only
+func OpenIngestOffsets(path string) (*IngestOffsets, error) {
+	offsets := &IngestOffsets{path: path}
+	if path == "" {
+		return offsets, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return offsets, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ingest offsets: %w", err)
+	}
+	if err := json.Unmarshal(data, &offsets.last); err != nil {
+		return nil, fmt.Errorf("invalid ingest offsets %s: %w", path, err)
+	}
+	offsets.read = offsets.last
+	return offsets, nil
+}
+
+// Last returns the last applied update
+func (o *IngestOffsets) Last() EventSequence {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.last
+}
+
+// check reports whether an update still has to be applied and how many updates of its run
+// were lost since the last one read, which it becomes when applied
+func (o *IngestOffsets) check(event EventSequence) (bool, uint64) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if event.Run != o.read.Run {
+		// The pack restarted counting, updates of the previous run can no longer arrive
+		o.read = event
+		return true, 0
+	}
+	if event.Seq <= o.read.Seq {
+		o.duplicates++
+		return false, 0
+	}
+
+	missed := event.Seq - o.read.Seq - 1
+	o.missed += missed
+	o.read = event
+	return true, missed
+}
+
+// commit records an update as applied
+func (o *IngestOffsets) commit(event EventSequence) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if event.Run == o.last.Run && event.Seq <= o.last.Seq {
+		return nil
+	}
+	o.last = event
+	if o.path == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(o.last)
+	if err != nil {
+		return err
+	}
+	tmp := o.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("failed to write ingest offsets: %w", err)
+	}
+	return os.Rename(tmp, o.path)
+}
+
+// counters returns the updates skipped as already applied and the updates lost
+func (o *IngestOffsets) counters() (int, uint64) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.duplicates, o.missed
+}
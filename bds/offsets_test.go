@@ -0,0 +1,119 @@
+package bds
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSequence(t *testing.T) {
+	event, payload, ok := parseSequence(`#lq2x9k:42][@1.00,2.00,3.00,minecraft:overworld][[]`)
+	require.True(t, ok)
+	assert.Equal(t, EventSequence{Run: "lq2x9k", Seq: 42}, event)
+	assert.Equal(t, `@1.00,2.00,3.00,minecraft:overworld][[]`, payload)
+
+	_, payload, ok = parseSequence(`[{"item":"stone"}]`)
+	assert.False(t, ok)
+	assert.Equal(t, `[{"item":"stone"}]`, payload)
+}
+
+func TestIngestOffsets(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ingest_offsets.json")
+
+	offsets, err := OpenIngestOffsets(path)
+	require.NoError(t, err)
+
+	apply, missed := offsets.check(EventSequence{Run: "a", Seq: 1})
+	assert.True(t, apply)
+	assert.Zero(t, missed)
+	require.NoError(t, offsets.commit(EventSequence{Run: "a", Seq: 1}))
+
+	apply, missed = offsets.check(EventSequence{Run: "a", Seq: 4})
+	assert.True(t, apply)
+	assert.Equal(t, uint64(2), missed)
+	require.NoError(t, offsets.commit(EventSequence{Run: "a", Seq: 4}))
+
+	// A restarted wrapper reads the offsets back and skips what it applied
+	reopened, err := OpenIngestOffsets(path)
+	require.NoError(t, err)
+	assert.Equal(t, EventSequence{Run: "a", Seq: 4}, reopened.Last())
+
+	apply, _ = reopened.check(EventSequence{Run: "a", Seq: 3})
+	assert.False(t, apply)
+	apply, _ = reopened.check(EventSequence{Run: "b", Seq: 1})
+	assert.True(t, apply, "a new pack run starts over")
+
+	duplicates, missedTotal := reopened.counters()
+	assert.Equal(t, 1, duplicates)
+	assert.Zero(t, missedTotal)
+
+	memory, err := OpenIngestOffsets("")
+	require.NoError(t, err)
+	require.NoError(t, memory.commit(EventSequence{Run: "a", Seq: 1}))
+	assert.Equal(t, EventSequence{Run: "a", Seq: 1}, memory.Last())
+}
+
+func TestOutputParser_SkipsAppliedUpdates(t *testing.T) {
+	var applied []string
+	lm := NewOutputParser(
+		func(playerName string) ([]byte, error) { return nil, nil },
+		func(playerName string, inventory []byte) error {
+			applied = append(applied, playerName+string(inventory))
+			return nil
+		},
+	)
+
+	input := strings.Join([]string{
+		`[X_ENDER_CHEST][Alice][#run1:1][[1]]`,
+		`[X_ENDER_CHEST][Alice][#run1:2][@1.00,2.00,3.00,minecraft:overworld][[2]]`,
+		`[X_ENDER_CHEST][Alice][#run1:2][[2]]`,
+		`[X_ENDER_CHEST][Bob][#run1:5][[5]]`,
+		`[X_ENDER_CHEST][Carol][[unsequenced]]`,
+	}, "\n") + "\n"
+
+	_, stdin := io.Pipe()
+	require.NoError(t, lm.monitorServerLogs(strings.NewReader(input), Parameters{}, stdin))
+
+	// Players are served in turn, each player's updates in order
+	assert.ElementsMatch(t, []string{"Alice[1]", "Alice[2]", "Bob[5]", "Carol[unsequenced]"}, applied)
+	assert.Less(t, slices.Index(applied, "Alice[1]"), slices.Index(applied, "Alice[2]"))
+	health := lm.Health()
+	assert.Equal(t, 1, health.Duplicates)
+	assert.Equal(t, uint64(2), health.Missed)
+	assert.Equal(t, EventSequence{Run: "run1", Seq: 5}, lm.offsets.Last())
+}
+
+func TestOutputParser_UpdateIDs(t *testing.T) {
+	var updates []InventoryUpdate
+	lm := NewOutputParser(
+		func(playerName string) ([]byte, error) { return nil, nil },
+		func(playerName string, inventory []byte) error {
+			t.Error("update callback should not be used when entry callback is set")
+			return nil
+		},
+	)
+	lm.entryCallback = func(_ context.Context, update InventoryUpdate) error {
+		updates = append(updates, update)
+		return nil
+	}
+
+	input := strings.Join([]string{
+		`[X_ENDER_CHEST][Alice][#run1:7][@1.00,2.00,3.00,minecraft:overworld][[7]]`,
+		`[X_ENDER_CHEST][Bob][[unsequenced]]`,
+	}, "\n") + "\n"
+
+	_, stdin := io.Pipe()
+	require.NoError(t, lm.monitorServerLogs(strings.NewReader(input), Parameters{}, stdin))
+
+	require.Len(t, updates, 2)
+	assert.Equal(t, "run1:7", updates[0].UpdateID)
+	assert.Equal(t, "[7]", string(updates[0].Inventory))
+	require.NotNil(t, updates[0].Position)
+	assert.Empty(t, updates[1].UpdateID)
+}
@@ -1,41 +1,122 @@
 package bds
 
 import (
-	"bufio"
+	"context"
+	"errors"
 	"fmt"
 	"io"
-	"os"
 	"os/exec"
 	"regexp"
+	"runtime/debug"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/d1nch8g/consensuscraft/crash"
 	"github.com/d1nch8g/consensuscraft/logger"
+	"github.com/d1nch8g/consensuscraft/tracing"
 )
 
 // OutputParser handles server log monitoring, parsing, and inventory operations
 type OutputParser struct {
 	// Compiled regex patterns for log parsing
-	playerSpawnedRegex *regexp.Regexp
-	enderChestRegex    *regexp.Regexp
+	playerSpawnedRegex      *regexp.Regexp
+	playerConnectedRegex    *regexp.Regexp
+	playerDisconnectedRegex *regexp.Regexp
+	enderChestRegex         *regexp.Regexp
+	positionRegex           *regexp.Regexp
 
 	// Inventory callbacks
-	receiveCallback InventoryReceiveCallback
-	updateCallback  InventoryUpdateCallback
+	receiveCallback  InventoryReceiveCallback
+	updateCallback   InventoryUpdateCallback
+	positionCallback InventoryPositionCallback
+	entryCallback    InventoryEntryCallback
+
+	// updates stores inventory updates in order per player off the log readers
+	updates     *updateQueue
+	updatesOnce sync.Once
+
+	// online tracks the sessions of connected players, for kicking them in maintenance mode
+	online *onlinePlayers
+	// addressRegex reads real client addresses from the output, nil when none are logged
+	addressRegex *regexp.Regexp
+	// sessionEnded receives sessions once the player disconnected or the server exited
+	sessionEnded func(Session)
+
+	// fence holds back inventory reads on spawn while an update of the player is being stored
+	fence *writeFence
+
+	// offsets skip updates applied before the wrapper restarted and count the lost ones
+	offsets *IngestOffsets
+
+	// identity verifies the server name the pack labels item origins with
+	identity *serverIdentity
+
+	// pack aggregates the content log warnings and errors of the pack
+	pack *packHealth
+
+	// readerLost is called when a pipe fails while the server may still be running
+	readerLost func(err error)
+
+	// Reader supervision state
+	healthMu      sync.Mutex
+	activeReaders int
+	reattachments int
+	lastError     error
+	lastIngest    time.Time
+}
+
+// MonitorHealth reports whether the server output is still being parsed
+type MonitorHealth struct {
+	ActiveReaders int       // Pipe readers currently attached, 2 when fully monitored
+	Reattachments int       // Readers re-attached after a panic
+	Restarts      int       // Server restarts after a pipe was lost
+	Coalesced     int       // Inventory updates replaced by a newer one because a player's queue was full
+	Backlog       int       // Inventory updates waiting to be stored
+	Shed          int       // Inventory updates dropped for the latest one of their player while the backlog was too long
+	Shedding      bool      // The backlog is too long, only the latest update of each player is kept
+	Duplicates    int       // Inventory updates skipped because they were applied before
+	Missed        uint64    // Inventory updates the pack logged that never reached the wrapper
+	LastError     string    // Last reader failure
+	LastIngest    time.Time // Last well-formed inventory update read from the server, zero before the first one
+
+	Identity         string // Server name the pack labels item origins with, empty until it answered
+	IdentityMismatch bool   // The pack labels item origins with another server than the web address
+
+	OriginFormat         string // Origin format the pack stamps, empty until it answered
+	OriginFormatMismatch bool   // The pack stamps another origin format than the configured one
+}
+
+// Healthy reports whether both stdout and stderr are being monitored
+func (h MonitorHealth) Healthy() bool {
+	return h.ActiveReaders == 2
 }
 
+// errReaderPanic marks a reader that stopped because processing a line panicked
+var errReaderPanic = errors.New("log reader panicked")
+
 // NewOutputParser creates a new output parser
 func NewOutputParser(rc InventoryReceiveCallback, uc InventoryUpdateCallback) *OutputParser {
 	return &OutputParser{
-		playerSpawnedRegex: regexp.MustCompile(`Player Spawned: ([^,\s]+)`),
-		enderChestRegex:    regexp.MustCompile(`\[X_ENDER_CHEST\]\[([^\]]+)\]\[(.+)\]`),
-		receiveCallback:    rc,
-		updateCallback:     uc,
+		playerSpawnedRegex:      regexp.MustCompile(`Player Spawned: ([^,\s]+)`),
+		playerConnectedRegex:    regexp.MustCompile(`Player connected: (.+?), xuid: ?(\d*)`),
+		playerDisconnectedRegex: regexp.MustCompile(`Player disconnected: (.+?), xuid`),
+		enderChestRegex:         regexp.MustCompile(`\[X_ENDER_CHEST\]\[([^\]]+)\]\[(.+)\]`),
+		positionRegex:           regexp.MustCompile(`^@(-?[\d.]+),(-?[\d.]+),(-?[\d.]+),([^\]]+)\]\[(.*)$`),
+		receiveCallback:         rc,
+		updateCallback:          uc,
+		online:                  newOnlinePlayers(),
+		fence:                   newWriteFence(),
+		offsets:                 &IngestOffsets{},
+		identity:                &serverIdentity{},
+		pack:                    &packHealth{},
 	}
 }
 
 // Start starts monitoring server logs with flexible I/O handling
 // It can handle both direct I/O piping and separate pipes for parsing
-func (op *OutputParser) Start(serverProcess *exec.Cmd, bds *Bds, params Parameters, pipes ...interface{}) {
+func (op *OutputParser) Start(serverProcess *exec.Cmd, params Parameters, pipes ...interface{}) {
 	var stdout, stderr io.ReadCloser
 	var stdin io.WriteCloser
 
@@ -64,19 +145,127 @@ func (op *OutputParser) Start(serverProcess *exec.Cmd, bds *Bds, params Paramete
 		return
 	}
 
-	// Start monitoring stdout and stderr in separate goroutines
-	go op.monitorServerLogs(stdout, bds, params, stdin)
-	go op.monitorServerLogs(stderr, bds, params, stdin)
+	// Start supervised monitoring of stdout and stderr in separate goroutines
+	op.identity.reset(params.WebAddress, params.OriginFormat)
+	op.pack.reset()
+	op.setActiveReaders(2)
+	go op.supervise("stdout", stdout, params, stdin)
+	go op.supervise("stderr", stderr, params, stdin)
+}
+
+// supervise keeps a reader attached to a pipe, re-attaching it after a panic
+// A failed pipe cannot be re-attached, readerLost is called instead so the server can be restarted
+// Lines read ahead of the one that panicked are kept for the re-attached reader
+func (op *OutputParser) supervise(name string, reader io.Reader, params Parameters, stdin io.WriteCloser) {
+	defer op.setActiveReaders(-1)
+
+	lines := newLogLines(reader)
+	for {
+		err := op.monitorOnce(lines, params, stdin)
+		if err == nil {
+			// Clean end of output, the server exited
+			return
+		}
+
+		op.healthMu.Lock()
+		op.lastError = fmt.Errorf("%s: %w", name, err)
+		op.healthMu.Unlock()
+
+		if !errors.Is(err, errReaderPanic) {
+			logger.Errorf("Lost %s monitoring: %v", name, err)
+			if op.readerLost != nil {
+				op.readerLost(err)
+			}
+			return
+		}
+
+		logger.Errorf("Re-attaching %s monitoring after failure: %v", name, err)
+		op.healthMu.Lock()
+		op.reattachments++
+		op.healthMu.Unlock()
+	}
+}
+
+// monitorOnce runs monitorServerLogs, converting a panic into errReaderPanic
+func (op *OutputParser) monitorOnce(lines *logLines, params Parameters, stdin io.WriteCloser) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			crash.Record("bds log monitor", r, debug.Stack())
+			err = fmt.Errorf("%w: %v", errReaderPanic, r)
+		}
+	}()
+
+	return op.monitorLines(lines, params, stdin)
+}
+
+// setActiveReaders adjusts the number of attached readers
+func (op *OutputParser) setActiveReaders(delta int) {
+	op.healthMu.Lock()
+	defer op.healthMu.Unlock()
+	op.activeReaders += delta
+}
+
+// Health returns the current reader supervision state
+func (op *OutputParser) Health() MonitorHealth {
+	op.healthMu.Lock()
+	defer op.healthMu.Unlock()
+
+	health := MonitorHealth{
+		ActiveReaders: op.activeReaders,
+		Reattachments: op.reattachments,
+		LastIngest:    op.lastIngest,
+	}
+	health.Duplicates, health.Missed = op.offsets.counters()
+	health.Identity, health.IdentityMismatch = op.identity.status()
+	health.OriginFormat, health.OriginFormatMismatch = op.identity.formatStatus()
+	if op.lastError != nil {
+		health.LastError = op.lastError.Error()
+	}
+	if op.updates != nil {
+		health.Coalesced = op.updates.coalescedUpdates()
+		health.Backlog, health.Shed, health.Shedding = op.updates.load()
+	}
+	return health
+}
+
+// monitorServerLogs monitors server output and processes events until the reader ends
+func (op *OutputParser) monitorServerLogs(reader io.Reader, params Parameters, stdin io.WriteCloser) error {
+	return op.monitorLines(newLogLines(reader), params, stdin)
 }
 
-// monitorServerLogs monitors server output and processes events
-func (op *OutputParser) monitorServerLogs(reader io.Reader, bds *Bds, params Parameters, stdin io.WriteCloser) {
-	// Create a TeeReader to duplicate output to stdout while parsing
-	teeReader := io.TeeReader(reader, os.Stdout)
-	scanner := bufio.NewScanner(teeReader)
+// monitorLines processes the events of server output lines until the output ends
+func (op *OutputParser) monitorLines(lines *logLines, params Parameters, stdin io.WriteCloser) error {
+	saves := &worldSaveTracker{}
+	defer func() { op.worldSaved(params, saves.flush()) }()
+
+	// Updates read before the output ended are stored before the reader returns
+	updates := op.queue(params)
+	defer updates.wait()
+
+	for {
+		line, err := lines.next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			logger.Printf("Error reading server logs: %v", err)
+			return err
+		}
+
+		op.worldSaved(params, saves.line(line, time.Now()))
+		op.pack.line(line, time.Now())
 
-	for scanner.Scan() {
-		line := scanner.Text()
+		if matches := op.playerConnectedRegex.FindStringSubmatch(line); len(matches) > 1 {
+			op.online.add(strings.TrimSpace(matches[1]), matches[2])
+		}
+		if matches := op.playerDisconnectedRegex.FindStringSubmatch(line); len(matches) > 1 {
+			if session, ok := op.online.remove(strings.TrimSpace(matches[1])); ok {
+				op.endSession(session)
+			}
+		}
+		if player, address, ok := parseAddress(op.addressRegex, line); ok {
+			op.online.setAddress(player, address)
+		}
 
 		// Parse player spawned events - trigger inventory restoration
 		if matches := op.playerSpawnedRegex.FindStringSubmatch(line); len(matches) > 1 {
@@ -85,6 +274,10 @@ func (op *OutputParser) monitorServerLogs(reader io.Reader, bds *Bds, params Par
 
 			// Get inventory data from callback and restore it via tags
 			go func(name string) {
+				defer crash.Guard("inventory restore")
+				if !op.fence.wait(name, fenceTimeout) {
+					logger.Printf("Timed out waiting for the last update of %s to be stored", name)
+				}
 				if inventoryData, err := params.InventoryReceiveCallback(name); err == nil {
 					if err := op.restorePlayerInventory(name, inventoryData, stdin); err != nil {
 						logger.Printf("Failed to restore inventory for %s: %v", name, err)
@@ -95,32 +288,78 @@ func (op *OutputParser) monitorServerLogs(reader io.Reader, bds *Bds, params Par
 			}(playerName)
 		}
 
+		if matches := identityRegex.FindStringSubmatch(line); len(matches) > 1 {
+			op.identity.report(matches[1], stdin)
+			op.identity.reportFormat(matches[3], matches[2] != "")
+			continue
+		}
+
 		// Parse ender chest inventory updates
-		if matches := op.enderChestRegex.FindStringSubmatch(line); len(matches) > 2 {
-			playerName := strings.TrimSpace(matches[1])
-			inventoryData := matches[2]
+		if !strings.Contains(line, "[X_ENDER_CHEST]") {
+			continue
+		}
 
-			logger.Printf("Inventory update for %s", playerName)
+		ctx, span := tracing.Start(context.Background(), "bds.ingest")
+		_, parseSpan := tracing.Start(ctx, "bds.parse")
+		matches := op.enderChestRegex.FindStringSubmatch(line)
+		parseSpan.Finish()
 
-			// The inventory data is already a valid JSON array from JavaScript
-			// Don't wrap it in additional brackets
-			jsonInventoryData := inventoryData
+		if len(matches) <= 2 {
+			span.SetAttribute("malformed", "true")
+			span.Finish()
+			continue
+		}
 
-			op.updatePlayerInventory(playerName, []byte(jsonInventoryData))
+		op.ingested(time.Now())
+		playerName := strings.TrimSpace(matches[1])
+		event, payload, sequenced := parseSequence(matches[2])
+		position, inventoryData := op.parsePosition(payload)
+		updateID := ""
+		if sequenced {
+			updateID = event.ID()
+		}
 
-			select {
-			case bds.InventoryUpdate <- InventoryUpdate{
-				PlayerName: playerName,
-				Inventory:  []byte(jsonInventoryData),
-			}:
-			default:
-				logger.Printf("InventoryUpdate channel full, dropping event for %s", playerName)
+		if sequenced {
+			apply, missed := op.offsets.check(event)
+			if !apply {
+				logger.Printf("Skipping update %d of run %s for %s, it was applied before", event.Seq, event.Run, playerName)
+				span.SetAttribute("duplicate", "true")
+				span.Finish()
+				continue
+			}
+			if missed > 0 {
+				logger.Warnf("Lost %d inventory updates of run %s before update %d, the players affected keep their previous ender chest", missed, event.Run, event.Seq)
 			}
 		}
-	}
 
-	if err := scanner.Err(); err != nil {
-		logger.Printf("Error reading server logs: %v", err)
+		logger.Printf("Inventory update for %s", playerName)
+		span.SetAttribute("player", playerName)
+		span.SetAttribute("inventory.bytes", fmt.Sprint(len(inventoryData)))
+		if position != nil {
+			span.SetAttribute("dimension", position.Dimension)
+		}
+
+		// The inventory data is already a valid JSON array from JavaScript
+		// Don't wrap it in additional brackets
+		jsonInventoryData := inventoryData
+
+		queued := &queuedUpdate{
+			update: InventoryUpdate{
+				PlayerName: playerName,
+				Inventory:  []byte(jsonInventoryData),
+				Position:   position,
+				UpdateID:   updateID,
+			},
+			event:     event,
+			sequenced: sequenced,
+			ctx:       ctx,
+			release:   op.fence.begin(playerName),
+		}
+		if updates.push(queued) {
+			logger.Printf("Update queue full for %s, coalesced with the latest pending update", playerName)
+			span.SetAttribute("coalesced", "true")
+		}
+		span.Finish()
 	}
 }
 
@@ -161,9 +400,71 @@ func (op *OutputParser) restorePlayerInventory(playerName string, inventoryData
 	return nil
 }
 
-func (op *OutputParser) updatePlayerInventory(playerName string, inventoryData []byte) error {
+// parsePosition splits the optional "@x,y,z,dimension][" prefix from the inventory payload
+func (op *OutputParser) parsePosition(data string) (*Position, string) {
+	matches := op.positionRegex.FindStringSubmatch(data)
+	if len(matches) != 6 {
+		return nil, data
+	}
+
+	var coordinates [3]float64
+	for i := range coordinates {
+		value, err := strconv.ParseFloat(matches[i+1], 64)
+		if err != nil {
+			return nil, data
+		}
+		coordinates[i] = value
+	}
+
+	return &Position{
+		X:         coordinates[0],
+		Y:         coordinates[1],
+		Z:         coordinates[2],
+		Dimension: matches[4],
+	}, matches[5]
+}
+
+// ParseEnderChest reads an ender chest line of the pack the way the log monitor does, without
+// storing the update, to check that the output of a pack is understood
+func (op *OutputParser) ParseEnderChest(line string) (InventoryUpdate, error) {
+	matches := op.enderChestRegex.FindStringSubmatch(line)
+	if len(matches) <= 2 {
+		return InventoryUpdate{}, fmt.Errorf("not an ender chest update: %q", line)
+	}
+
+	event, payload, sequenced := parseSequence(matches[2])
+	position, inventoryData := op.parsePosition(payload)
+	update := InventoryUpdate{
+		PlayerName: strings.TrimSpace(matches[1]),
+		Inventory:  []byte(inventoryData),
+		Position:   position,
+	}
+	if sequenced {
+		update.UpdateID = event.ID()
+	}
+	return update, nil
+}
+
+// worldSaved hands a completed world save to the callback without blocking the reader
+func (op *OutputParser) worldSaved(params Parameters, save *WorldSave) {
+	if save == nil {
+		return
+	}
+	logger.Printf("World saved, %d files ready to be copied", len(save.Files))
+	if params.WorldSavedCallback != nil {
+		go params.WorldSavedCallback(*save)
+	}
+}
+
+func (op *OutputParser) updatePlayerInventory(ctx context.Context, update InventoryUpdate) error {
+	if op.entryCallback != nil {
+		return op.entryCallback(ctx, update)
+	}
+	if op.positionCallback != nil {
+		return op.positionCallback(update.PlayerName, update.Inventory, update.Position)
+	}
 	if op.updateCallback != nil {
-		return op.updateCallback(playerName, inventoryData)
+		return op.updateCallback(update.PlayerName, update.Inventory)
 	}
 	return nil
 }
@@ -1,6 +1,7 @@
 package bds
 
 import (
+	"fmt"
 	"io"
 	"os/exec"
 	"strings"
@@ -8,6 +9,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // TestNewOutputParser tests the constructor function
@@ -42,16 +44,13 @@ func TestOutputParser_Start(t *testing.T) {
 			func(playerName string) ([]byte, error) { return nil, nil },
 			func(playerName string, inventory []byte) error { return nil },
 		)
-		bds := &Bds{
-			InventoryUpdate: make(chan InventoryUpdate, 100),
-		}
 		params := Parameters{
 			StartTrigger: make(chan struct{}, 1),
 		}
 		cmd := &exec.Cmd{}
 
 		// Should log direct I/O message and return early
-		lm.Start(cmd, bds, params)
+		lm.Start(cmd, params)
 		// No pipes provided, so it should use direct I/O and return
 	})
 
@@ -60,9 +59,6 @@ func TestOutputParser_Start(t *testing.T) {
 			func(playerName string) ([]byte, error) { return nil, nil },
 			func(playerName string, inventory []byte) error { return nil },
 		)
-		bds := &Bds{
-			InventoryUpdate: make(chan InventoryUpdate, 100),
-		}
 		params := Parameters{
 			StartTrigger: make(chan struct{}, 1),
 		}
@@ -74,7 +70,7 @@ func TestOutputParser_Start(t *testing.T) {
 		stdinReader, stdinWriter := io.Pipe()
 
 		// Start monitoring with pipes
-		lm.Start(cmd, bds, params, stdoutReader, stderrReader, stdinWriter)
+		lm.Start(cmd, params, stdoutReader, stderrReader, stdinWriter)
 
 		// Close pipes to clean up
 		stdoutReader.Close()
@@ -90,16 +86,13 @@ func TestOutputParser_Start(t *testing.T) {
 			func(playerName string) ([]byte, error) { return nil, nil },
 			func(playerName string, inventory []byte) error { return nil },
 		)
-		bds := &Bds{
-			InventoryUpdate: make(chan InventoryUpdate, 100),
-		}
 		params := Parameters{
 			StartTrigger: make(chan struct{}, 1),
 		}
 		cmd := &exec.Cmd{}
 
 		// Should handle invalid pipe types gracefully
-		lm.Start(cmd, bds, params, "invalid", "pipes", "here")
+		lm.Start(cmd, params, "invalid", "pipes", "here")
 	})
 
 	t.Run("StartWithInsufficientPipes", func(t *testing.T) {
@@ -107,9 +100,6 @@ func TestOutputParser_Start(t *testing.T) {
 			func(playerName string) ([]byte, error) { return nil, nil },
 			func(playerName string, inventory []byte) error { return nil },
 		)
-		bds := &Bds{
-			InventoryUpdate: make(chan InventoryUpdate, 100),
-		}
 		params := Parameters{
 			StartTrigger: make(chan struct{}, 1),
 		}
@@ -120,7 +110,7 @@ func TestOutputParser_Start(t *testing.T) {
 		defer stdoutReader.Close()
 		defer stdoutWriter.Close()
 
-		lm.Start(cmd, bds, params, stdoutReader)
+		lm.Start(cmd, params, stdoutReader)
 	})
 }
 
@@ -133,10 +123,6 @@ func TestOutputParser_monitorServerLogs(t *testing.T) {
 		)
 
 		// Create mock BDS and parameters
-		bds := &Bds{
-			InventoryUpdate: make(chan InventoryUpdate, 100),
-		}
-
 		params := Parameters{
 			InventoryReceiveCallback: func(playerName string) ([]byte, error) {
 				assert.Equal(t, "TestPlayer", playerName)
@@ -155,27 +141,63 @@ func TestOutputParser_monitorServerLogs(t *testing.T) {
 		reader := strings.NewReader(input)
 
 		// Start monitoring in a goroutine
-		go lm.monitorServerLogs(reader, bds, params, stdinWriter)
+		go lm.monitorServerLogs(reader, params, stdinWriter)
 
 		// Give it time to process
 		time.Sleep(100 * time.Millisecond)
 	})
 
-	t.Run("MonitorEnderChestEvent", func(t *testing.T) {
+	t.Run("MonitorEnderChestEventWithPosition", func(t *testing.T) {
 		lm := NewOutputParser(
 			func(playerName string) ([]byte, error) { return nil, nil },
-			func(playerName string, inventory []byte) error { return nil },
+			func(playerName string, inventory []byte) error {
+				t.Error("update callback should not be used when position callback is set")
+				return nil
+			},
 		)
 
-		// Create mock BDS and parameters
-		bds := &Bds{
-			InventoryUpdate: make(chan InventoryUpdate, 100),
+		var callbackPosition *Position
+		var callbackInventory string
+		lm.positionCallback = func(playerName string, inventory []byte, position *Position) error {
+			callbackInventory = string(inventory)
+			callbackPosition = position
+			return nil
 		}
 
 		params := Parameters{
 			StartTrigger: make(chan struct{}, 1),
 		}
 
+		stdinReader, stdinWriter := io.Pipe()
+		defer stdinReader.Close()
+		defer stdinWriter.Close()
+
+		input := "[X_ENDER_CHEST][TestPlayer][@12.50,-60.00,3.25,minecraft:nether][[{\"item\":\"stone\"}]]\n"
+		reader := strings.NewReader(input)
+
+		// Updates read are stored before the reader returns
+		require.NoError(t, lm.monitorServerLogs(reader, params, stdinWriter))
+
+		require.NotNil(t, callbackPosition)
+		assert.Equal(t, Position{X: 12.5, Y: -60, Z: 3.25, Dimension: "minecraft:nether"}, *callbackPosition)
+		assert.Equal(t, `[{"item":"stone"}]`, callbackInventory)
+	})
+
+	t.Run("MonitorEnderChestEvent", func(t *testing.T) {
+		stored := make(chan InventoryUpdate, 1)
+		lm := NewOutputParser(
+			func(playerName string) ([]byte, error) { return nil, nil },
+			func(playerName string, inventory []byte) error {
+				stored <- InventoryUpdate{PlayerName: playerName, Inventory: inventory}
+				return nil
+			},
+		)
+
+		// Create mock BDS and parameters
+		params := Parameters{
+			StartTrigger: make(chan struct{}, 1),
+		}
+
 		// Create mock stdin
 		stdinReader, stdinWriter := io.Pipe()
 		defer stdinReader.Close()
@@ -186,11 +208,11 @@ func TestOutputParser_monitorServerLogs(t *testing.T) {
 		reader := strings.NewReader(input)
 
 		// Start monitoring in a goroutine
-		go lm.monitorServerLogs(reader, bds, params, stdinWriter)
+		go lm.monitorServerLogs(reader, params, stdinWriter)
 
 		// Wait for inventory update
 		select {
-		case update := <-bds.InventoryUpdate:
+		case update := <-stored:
 			assert.Equal(t, "TestPlayer", update.PlayerName)
 			assert.Equal(t, `[{"item":"stone"}]`, string(update.Inventory))
 		case <-time.After(100 * time.Millisecond):
@@ -199,17 +221,17 @@ func TestOutputParser_monitorServerLogs(t *testing.T) {
 	})
 
 	t.Run("MonitorMultipleEvents", func(t *testing.T) {
+		stored := make(chan InventoryUpdate, 1)
 		lm := NewOutputParser(
 			func(playerName string) ([]byte, error) { return nil, nil },
-			func(playerName string, inventory []byte) error { return nil },
+			func(playerName string, inventory []byte) error {
+				stored <- InventoryUpdate{PlayerName: playerName, Inventory: inventory}
+				return nil
+			},
 		)
 
 		// Create mock BDS and parameters
 		inventoryCallbackCalled := false
-		bds := &Bds{
-			InventoryUpdate: make(chan InventoryUpdate, 100),
-		}
-
 		params := Parameters{
 			InventoryReceiveCallback: func(playerName string) ([]byte, error) {
 				inventoryCallbackCalled = true
@@ -232,13 +254,13 @@ Regular log message
 		reader := strings.NewReader(input)
 
 		// Start monitoring in a goroutine
-		go lm.monitorServerLogs(reader, bds, params, stdinWriter)
+		go lm.monitorServerLogs(reader, params, stdinWriter)
 
 		// Wait for inventory updates
 		updates := 0
 		for updates < 1 {
 			select {
-			case update := <-bds.InventoryUpdate:
+			case update := <-stored:
 				assert.Equal(t, "Player2", update.PlayerName)
 				assert.Equal(t, `[{"item":"diamond"}]`, string(update.Inventory))
 				updates++
@@ -258,10 +280,6 @@ Regular log message
 		)
 
 		// Create mock BDS and parameters
-		bds := &Bds{
-			InventoryUpdate: make(chan InventoryUpdate, 100),
-		}
-
 		params := Parameters{
 			StartTrigger: make(chan struct{}, 1),
 		}
@@ -275,46 +293,47 @@ Regular log message
 		reader := &logsErrorReader{}
 
 		// Start monitoring - should handle scanner error gracefully
-		lm.monitorServerLogs(reader, bds, params, stdinWriter)
+		lm.monitorServerLogs(reader, params, stdinWriter)
 	})
 
-	t.Run("MonitorWithFullChannel", func(t *testing.T) {
+	t.Run("MonitorWithSlowStore", func(t *testing.T) {
+		release := make(chan struct{})
 		lm := NewOutputParser(
 			func(playerName string) ([]byte, error) { return nil, nil },
-			func(playerName string, inventory []byte) error { return nil },
+			func(playerName string, inventory []byte) error {
+				<-release
+				return nil
+			},
 		)
 
-		// Create BDS with full channel
-		bds := &Bds{
-			InventoryUpdate: make(chan InventoryUpdate, 1), // Small buffer
-		}
-
-		// Fill the channel
-		bds.InventoryUpdate <- InventoryUpdate{
-			PlayerName: "Dummy",
-			Inventory:  []byte("[]"),
-		}
-
+		spawned := make(chan string, 1)
 		params := Parameters{
+			InventoryReceiveCallback: func(playerName string) ([]byte, error) {
+				spawned <- playerName
+				return nil, nil
+			},
 			StartTrigger: make(chan struct{}, 1),
 		}
 
-		// Create mock stdin
-		stdinReader, stdinWriter := io.Pipe()
-		defer stdinReader.Close()
-		defer stdinWriter.Close()
-
-		// Create input with multiple ender chest events
+		// Storing Player1 blocks, reading the output goes on
 		input := `[X_ENDER_CHEST][Player1][[{"item":"stone"}]]
 [X_ENDER_CHEST][Player2][[{"item":"diamond"}]]
+Player Spawned: Player3
 `
-		reader := strings.NewReader(input)
+		done := make(chan error, 1)
+		go func() {
+			done <- lm.monitorServerLogs(strings.NewReader(input), params, nil)
+		}()
 
-		// Start monitoring - should handle full channel gracefully
-		go lm.monitorServerLogs(reader, bds, params, stdinWriter)
+		select {
+		case player := <-spawned:
+			assert.Equal(t, "Player3", player)
+		case <-time.After(time.Second):
+			t.Fatal("reader blocked on a slow store")
+		}
 
-		// Give it time to process
-		time.Sleep(100 * time.Millisecond)
+		close(release)
+		require.NoError(t, <-done)
 	})
 }
 
@@ -328,10 +347,6 @@ func TestOutputParser_Integration(t *testing.T) {
 
 		// Create mock BDS and parameters
 		eventsProcessed := 0
-		bds := &Bds{
-			InventoryUpdate: make(chan InventoryUpdate, 100),
-		}
-
 		params := Parameters{
 			InventoryReceiveCallback: func(playerName string) ([]byte, error) {
 				eventsProcessed++
@@ -347,7 +362,7 @@ func TestOutputParser_Integration(t *testing.T) {
 
 		// Start monitoring
 		cmd := &exec.Cmd{}
-		lm.Start(cmd, bds, params, stdoutReader, stderrReader, stdinWriter)
+		lm.Start(cmd, params, stdoutReader, stderrReader, stdinWriter)
 
 		// Send test data through pipes
 		go func() {
@@ -382,10 +397,6 @@ func TestOutputParser_EdgeCases(t *testing.T) {
 			func(playerName string, inventory []byte) error { return nil },
 		)
 
-		bds := &Bds{
-			InventoryUpdate: make(chan InventoryUpdate, 100),
-		}
-
 		params := Parameters{
 			StartTrigger: make(chan struct{}, 1),
 		}
@@ -398,7 +409,7 @@ func TestOutputParser_EdgeCases(t *testing.T) {
 		input := "[X_ENDER_CHEST][][[{\"item\":\"stone\"}]]\n"
 		reader := strings.NewReader(input)
 
-		go lm.monitorServerLogs(reader, bds, params, stdinWriter)
+		go lm.monitorServerLogs(reader, params, stdinWriter)
 		time.Sleep(100 * time.Millisecond)
 	})
 
@@ -408,10 +419,6 @@ func TestOutputParser_EdgeCases(t *testing.T) {
 			func(playerName string, inventory []byte) error { return nil },
 		)
 
-		bds := &Bds{
-			InventoryUpdate: make(chan InventoryUpdate, 100),
-		}
-
 		params := Parameters{
 			StartTrigger: make(chan struct{}, 1),
 		}
@@ -424,7 +431,7 @@ func TestOutputParser_EdgeCases(t *testing.T) {
 		input := "[X_ENDER_CHEST][Player1]\n" // Missing inventory data
 		reader := strings.NewReader(input)
 
-		go lm.monitorServerLogs(reader, bds, params, stdinWriter)
+		go lm.monitorServerLogs(reader, params, stdinWriter)
 		time.Sleep(100 * time.Millisecond)
 	})
 
@@ -434,10 +441,6 @@ func TestOutputParser_EdgeCases(t *testing.T) {
 			func(playerName string, inventory []byte) error { return nil },
 		)
 
-		bds := &Bds{
-			InventoryUpdate: make(chan InventoryUpdate, 100),
-		}
-
 		params := Parameters{
 			InventoryReceiveCallback: func(playerName string) ([]byte, error) {
 				return nil, assert.AnError // Simulate callback error
@@ -453,7 +456,7 @@ func TestOutputParser_EdgeCases(t *testing.T) {
 		input := "Player Spawned: ErrorPlayer\n"
 		reader := strings.NewReader(input)
 
-		go lm.monitorServerLogs(reader, bds, params, stdinWriter)
+		go lm.monitorServerLogs(reader, params, stdinWriter)
 		time.Sleep(100 * time.Millisecond)
 	})
 }
@@ -464,3 +467,233 @@ type logsErrorReader struct{}
 func (e *logsErrorReader) Read(p []byte) (n int, err error) {
 	return 0, assert.AnError
 }
+
+// TestOutputParser_parsePosition tests splitting the position prefix from inventory payloads
+func TestOutputParser_parsePosition(t *testing.T) {
+	lm := NewOutputParser(nil, nil)
+
+	tests := []struct {
+		name      string
+		data      string
+		position  *Position
+		inventory string
+	}{
+		{
+			name:      "legacy payload without position",
+			data:      `[{"item":"stone"}]`,
+			position:  nil,
+			inventory: `[{"item":"stone"}]`,
+		},
+		{
+			name:      "overworld position",
+			data:      `@0.00,64.00,-10.75,minecraft:overworld][[null]`,
+			position:  &Position{X: 0, Y: 64, Z: -10.75, Dimension: "minecraft:overworld"},
+			inventory: `[null]`,
+		},
+		{
+			name:      "malformed coordinates are kept as payload",
+			data:      `@1.2.3,4,5,minecraft:the_end][[]`,
+			position:  nil,
+			inventory: `@1.2.3,4,5,minecraft:the_end][[]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			position, inventory := lm.parsePosition(tt.data)
+			assert.Equal(t, tt.position, position)
+			assert.Equal(t, tt.inventory, inventory)
+		})
+	}
+}
+
+func TestOutputParser_ParseEnderChest(t *testing.T) {
+	lm := NewOutputParser(nil, nil)
+
+	update, err := lm.ParseEnderChest(`[X_ENDER_CHEST][ Alice ][#run1:7][@1.00,2.00,3.00,minecraft:overworld][[null]]`)
+	require.NoError(t, err)
+	assert.Equal(t, "Alice", update.PlayerName)
+	assert.Equal(t, `[null]`, string(update.Inventory))
+	assert.Equal(t, &Position{X: 1, Y: 2, Z: 3, Dimension: "minecraft:overworld"}, update.Position)
+	assert.Equal(t, EventSequence{Run: "run1", Seq: 7}.ID(), update.UpdateID)
+
+	update, err = lm.ParseEnderChest(`[X_ENDER_CHEST][Bob][[]]`)
+	require.NoError(t, err)
+	assert.Equal(t, "Bob", update.PlayerName)
+	assert.Empty(t, update.UpdateID)
+
+	_, err = lm.ParseEnderChest(`Player Spawned: Bob`)
+	assert.Error(t, err)
+}
+
+// failingReader returns its data and then a read error, like a pipe closed under the reader
+type failingReader struct {
+	data io.Reader
+	err  error
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	n, err := r.data.Read(p)
+	if err == io.EOF {
+		return n, r.err
+	}
+	return n, err
+}
+
+// panicReader hands out one chunk per read, panicking on empty chunks, like a reader failing mid-line
+type panicReader struct {
+	chunks []string
+}
+
+func (r *panicReader) Read(p []byte) (int, error) {
+	if len(r.chunks) == 0 {
+		return 0, io.EOF
+	}
+	chunk := r.chunks[0]
+	r.chunks = r.chunks[1:]
+	if chunk == "" {
+		panic("bad read")
+	}
+	return copy(p, chunk), nil
+}
+
+// TestOutputParser_supervise tests reader supervision and health reporting
+func TestOutputParser_supervise(t *testing.T) {
+	t.Run("ReattachesAfterPanic", func(t *testing.T) {
+		stored := make(chan string, 1)
+		lm := NewOutputParser(
+			func(playerName string) ([]byte, error) { return nil, nil },
+			func(playerName string, inventory []byte) error {
+				stored <- playerName
+				return nil
+			},
+		)
+
+		params := Parameters{StartTrigger: make(chan struct{}, 1)}
+
+		lm.setActiveReaders(1)
+		reader := &panicReader{chunks: []string{"", "[X_ENDER_CHEST][TestPlayer][[]]\n"}}
+		lm.supervise("stdout", reader, params, nil)
+
+		select {
+		case player := <-stored:
+			assert.Equal(t, "TestPlayer", player)
+		case <-time.After(time.Second):
+			t.Fatal("reader was not re-attached after panic")
+		}
+
+		health := lm.Health()
+		assert.Equal(t, 1, health.Reattachments)
+		assert.Contains(t, health.LastError, "bad read")
+
+		// Clean end of output detaches the reader
+		assert.Equal(t, 0, health.ActiveReaders)
+	})
+
+	t.Run("KeepsLinesReadAheadAcrossReattach", func(t *testing.T) {
+		var stored []string
+		lm := NewOutputParser(
+			func(playerName string) ([]byte, error) { return nil, nil },
+			func(playerName string, inventory []byte) error {
+				stored = append(stored, playerName)
+				return nil
+			},
+		)
+
+		// The second line is half read when the reader panics
+		lm.setActiveReaders(1)
+		reader := &panicReader{chunks: []string{"[X_ENDER_CHEST][First][[]]\n[X_ENDER_CHEST][Second][[", "", "]]\n"}}
+		lm.supervise("stdout", reader, Parameters{}, nil)
+
+		assert.Equal(t, []string{"First", "Second"}, stored)
+		assert.Equal(t, 1, lm.Health().Reattachments)
+	})
+
+	t.Run("SkipsOverlongLines", func(t *testing.T) {
+		var stored []string
+		lm := NewOutputParser(
+			func(playerName string) ([]byte, error) { return nil, nil },
+			func(playerName string, inventory []byte) error {
+				stored = append(stored, playerName)
+				return nil
+			},
+		)
+
+		// Lines past bufio.Scanner's 64 KiB default are parsed
+		large := "[X_ENDER_CHEST][Large][[" + strings.Repeat(" ", 128<<10) + "]]\n"
+		require.NoError(t, lm.monitorServerLogs(strings.NewReader(large+"[X_ENDER_CHEST][TestPlayer][[]]\n"), Parameters{}, nil))
+		assert.Equal(t, []string{"Large", "TestPlayer"}, stored)
+
+		// and lines past the limit are skipped without losing the pipe
+		lines := newLogLines(strings.NewReader(large + "[X_ENDER_CHEST][TestPlayer][[]]\n"))
+		lines.max = 64 << 10
+		stored = nil
+		lm.setActiveReaders(1)
+		require.NoError(t, lm.monitorOnce(lines, Parameters{}, nil))
+		assert.Equal(t, []string{"TestPlayer"}, stored)
+		assert.Equal(t, 1, lines.skipped)
+	})
+
+	t.Run("ShedsWithConfiguredThreshold", func(t *testing.T) {
+		storing := make(chan struct{}, 1)
+		gate := make(chan struct{})
+		var stored []string
+		lm := NewOutputParser(
+			func(playerName string) ([]byte, error) { return nil, nil },
+			func(playerName string, inventory []byte) error {
+				storing <- struct{}{}
+				<-gate
+				stored = append(stored, string(inventory))
+				return nil
+			},
+		)
+
+		reader, writer := io.Pipe()
+		done := make(chan error, 1)
+		go func() {
+			done <- lm.monitorServerLogs(reader, Parameters{ShedThreshold: 4}, nil)
+		}()
+
+		// The store is held on the first update while the next ones pile up
+		_, err := io.WriteString(writer, "[X_ENDER_CHEST][Alice][[0]]\n")
+		require.NoError(t, err)
+		<-storing
+		for i := 1; i < 10; i++ {
+			_, err := fmt.Fprintf(writer, "[X_ENDER_CHEST][Alice][[%d]]\n", i)
+			require.NoError(t, err)
+		}
+		assert.Eventually(t, func() bool { return lm.Health().Shedding }, time.Second, 10*time.Millisecond)
+
+		close(gate)
+		writer.Close()
+		require.NoError(t, <-done)
+
+		health := lm.Health()
+		assert.False(t, health.Shedding)
+		assert.Positive(t, health.Shed)
+		assert.Equal(t, []string{"[0]", "[9]"}, stored)
+	})
+
+	t.Run("ReportsLostPipe", func(t *testing.T) {
+		lm := NewOutputParser(
+			func(playerName string) ([]byte, error) { return nil, nil },
+			func(playerName string, inventory []byte) error { return nil },
+		)
+
+		var lost error
+		lm.readerLost = func(err error) { lost = err }
+
+		params := Parameters{StartTrigger: make(chan struct{}, 1)}
+
+		lm.setActiveReaders(2)
+		reader := &failingReader{data: strings.NewReader("Server started\n"), err: io.ErrClosedPipe}
+		lm.supervise("stderr", reader, params, nil)
+
+		assert.ErrorIs(t, lost, io.ErrClosedPipe)
+
+		health := lm.Health()
+		assert.False(t, health.Healthy())
+		assert.Equal(t, 1, health.ActiveReaders)
+		assert.Contains(t, health.LastError, "stderr")
+	})
+}
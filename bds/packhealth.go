@@ -0,0 +1,159 @@
+package bds
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// PackStatus summarizes the content log of the x_ender_chest pack
+type PackStatus string
+
+const (
+	PackHealthy    PackStatus = "ok"
+	PackDegraded   PackStatus = "degraded"   // Warnings or errors that do not affect inventory capture
+	PackUnreliable PackStatus = "unreliable" // Script or load errors, ender chest updates may be lost
+)
+
+// PackIssue aggregates the content log lines of one kind and level
+type PackIssue struct {
+	Kind      string    `json:"kind"`  // texture, deprecation, script, pack load or the content log category
+	Level     string    `json:"level"` // warning or error
+	Count     int       `json:"count"`
+	Message   string    `json:"message"` // Latest line of the kind
+	FirstSeen time.Time `json:"first_seen"`
+	LastSeen  time.Time `json:"last_seen"`
+	Capture   bool      `json:"capture"` // The issue may keep ender chest updates from being captured
+}
+
+// PackHealth is the status of the pack in the current server run with the issues behind it
+type PackHealth struct {
+	Status PackStatus  `json:"status"`
+	Issues []PackIssue `json:"issues"`
+}
+
+// packMarkerRegex matches content log lines about the x_ender_chest pack, by name or by one of its
+// scripts, main.js is left out as every scripted pack has one
+var packMarkerRegex = regexp.MustCompile(`(?i)x[_ ]ender[_ ]chest|84c09f65-3d0b-4859-9e51-d0c981d17358|(?:inventory_restoration|shulker_box|block_place|vanilla_ender_chest_replacement)\.js`)
+
+// contentLogRegex reads the category and level of a content log line, either in the console form
+// "ERROR] [Scripting] message" or in the content log form "[Texture][warning]-message"
+var contentLogRegex = regexp.MustCompile(`(?:(WARN|ERROR)\]\s*\[([A-Za-z ]+)\]|\[([A-Za-z ]+)\]\[(warning|error)\])\s*-?\s*(.*)$`)
+
+// packLoadRegex matches errors of a pack failing to load, its scripts never run then
+var packLoadRegex = regexp.MustCompile(`(?i)failed to load|could not load|missing dependenc|dependency .* not found|invalid manifest|unable to find .*module`)
+
+// packHealth aggregates content log issues of the pack during a server run
+type packHealth struct {
+	mu     sync.Mutex
+	issues map[string]*PackIssue
+	status PackStatus
+}
+
+// reset forgets the issues of the previous server run
+func (p *packHealth) reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.issues = nil
+	p.status = PackHealthy
+}
+
+// line records a content log line about the pack, an error line when the status becomes unreliable
+func (p *packHealth) line(line string, at time.Time) {
+	if strings.Contains(line, "[X_ENDER_CHEST]") || !packMarkerRegex.MatchString(line) {
+		return
+	}
+	matches := contentLogRegex.FindStringSubmatch(line)
+	if matches == nil {
+		return
+	}
+
+	level, category := "warning", matches[2]
+	if matches[1] == "ERROR" || matches[4] == "error" {
+		level = "error"
+	}
+	if category == "" {
+		category = matches[3]
+	}
+	message := strings.TrimSpace(matches[5])
+
+	kind, capture := classifyPackIssue(category, level, message)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.issues == nil {
+		p.issues = make(map[string]*PackIssue)
+	}
+	key := kind + "/" + level
+	issue, ok := p.issues[key]
+	if !ok {
+		issue = &PackIssue{Kind: kind, Level: level, FirstSeen: at, Capture: capture}
+		p.issues[key] = issue
+	}
+	issue.Count++
+	issue.Message = message
+	issue.LastSeen = at
+
+	previous := p.status
+	switch {
+	case capture:
+		p.status = PackUnreliable
+	case p.status != PackUnreliable:
+		p.status = PackDegraded
+	}
+	if p.status == PackUnreliable && previous != PackUnreliable {
+		logger.Errorf("Pack reported a %s %s, ender chest updates may not be captured: %s", kind, level, message)
+	}
+}
+
+// classifyPackIssue names the kind of a content log line and whether it may break inventory capture
+func classifyPackIssue(category, level, message string) (string, bool) {
+	lower := strings.ToLower(message)
+	switch {
+	case strings.Contains(lower, "deprecat"):
+		return "deprecation", false
+	case packLoadRegex.MatchString(message):
+		return "pack load", level == "error"
+	case strings.EqualFold(category, "Scripting"):
+		return "script", level == "error"
+	case strings.EqualFold(category, "Texture"):
+		return "texture", false
+	}
+	return strings.ToLower(category), false
+}
+
+// snapshot returns the status with the issues, those breaking capture first and then by count
+func (p *packHealth) snapshot() PackHealth {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	health := PackHealth{Status: p.status, Issues: []PackIssue{}}
+	if health.Status == "" {
+		health.Status = PackHealthy
+	}
+	for _, issue := range p.issues {
+		health.Issues = append(health.Issues, *issue)
+	}
+	sort.Slice(health.Issues, func(i, j int) bool {
+		a, b := health.Issues[i], health.Issues[j]
+		if a.Capture != b.Capture {
+			return a.Capture
+		}
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		return a.Kind+a.Level < b.Kind+b.Level
+	})
+	return health
+}
+
+// PackHealth reports the content log issues of the pack since the server started
+func (b *Bds) PackHealth() PackHealth {
+	return b.outputParser.pack.snapshot()
+}
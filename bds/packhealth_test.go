@@ -0,0 +1,64 @@
+package bds
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackHealth(t *testing.T) {
+	pack := &packHealth{}
+	pack.reset()
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	assert.Equal(t, PackHealth{Status: PackHealthy, Issues: []PackIssue{}}, pack.snapshot())
+
+	// Lines of other packs, regular output and inventory updates are ignored
+	pack.line(`[2026-01-02 03:04:05:000 WARN] [Texture][warning]-The block named other_pack:lamp used in a "blocks.json" file does not exist`, at)
+	pack.line(`[2026-01-02 03:04:05:000 INFO] [Scripting] X Ender Chest loaded`, at)
+	pack.line(`[2026-01-02 03:04:05:000 INFO] [Scripting] [X_ENDER_CHEST][Alice][[{"typeId":"x_ender_chest:x_ender_chest","lore":["[error]"]}]]`, at)
+	assert.Equal(t, PackHealthy, pack.snapshot().Status)
+
+	pack.line(`[2026-01-02 03:04:05:000 WARN] [Texture][warning]-The block named x_ender_chest:x_ender_chest used in a "blocks.json" file does not have a texture`, at)
+	pack.line(`[Texture][warning]-x_ender_chest: missing texture textures/blocks/x_ender_chest_top`, at.Add(time.Second))
+	pack.line(`[2026-01-02 03:04:07:000 WARN] [Scripting] [X Ender Chest - 1.0.0] world.afterEvents.worldInitialize is deprecated`, at.Add(2*time.Second))
+
+	health := pack.snapshot()
+	assert.Equal(t, PackDegraded, health.Status)
+	require.Len(t, health.Issues, 2)
+	assert.Equal(t, "texture", health.Issues[0].Kind)
+	assert.Equal(t, 2, health.Issues[0].Count)
+	assert.Equal(t, at, health.Issues[0].FirstSeen)
+	assert.Equal(t, at.Add(time.Second), health.Issues[0].LastSeen)
+	assert.Equal(t, "x_ender_chest: missing texture textures/blocks/x_ender_chest_top", health.Issues[0].Message)
+	assert.Equal(t, "deprecation", health.Issues[1].Kind)
+
+	t.Run("ScriptErrorsMakeCaptureUnreliable", func(t *testing.T) {
+		pack.line(`[2026-01-02 03:04:08:000 ERROR] [Scripting] Plugin [X Ender Chest - 1.0.0] - [main.js] ran with error: [TypeError: cannot read property 'container' of undefined at <anonymous> (inventory_restoration.js:42)]`, at.Add(3*time.Second))
+
+		health := pack.snapshot()
+		assert.Equal(t, PackUnreliable, health.Status)
+		assert.Equal(t, "script", health.Issues[0].Kind)
+		assert.Equal(t, "error", health.Issues[0].Level)
+		assert.True(t, health.Issues[0].Capture)
+
+		// Later warnings do not lower the status
+		pack.line(`[Texture][warning]-x_ender_chest: missing texture`, at.Add(4*time.Second))
+		assert.Equal(t, PackUnreliable, pack.snapshot().Status)
+	})
+
+	t.Run("PackLoadErrors", func(t *testing.T) {
+		pack.reset()
+		pack.line(`[2026-01-02 03:04:05:000 ERROR] [Pack] X Ender Chest: missing dependency @minecraft/server 2.0.0`, at)
+		health := pack.snapshot()
+		assert.Equal(t, PackUnreliable, health.Status)
+		assert.Equal(t, "pack load", health.Issues[0].Kind)
+	})
+
+	t.Run("ResetOnServerStart", func(t *testing.T) {
+		pack.reset()
+		assert.Equal(t, PackHealth{Status: PackHealthy, Issues: []PackIssue{}}, pack.snapshot())
+	})
+}
@@ -0,0 +1,213 @@
+package bds
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/d1nch8g/consensuscraft/gen/xendchest"
+)
+
+// ErrPackTampered is returned when the mcpack does not match its signed manifest
+var ErrPackTampered = errors.New("mcpack does not match its signed manifest")
+
+// ErrUntrustedPackKey is returned when the manifest is not signed by a trusted key
+var ErrUntrustedPackKey = errors.New("mcpack manifest is not signed by a trusted key")
+
+// PackManifest is the signed release manifest embedded alongside the mcpack, it lets every
+// node prove it runs the identical, untampered pack
+type PackManifest struct {
+	Version    string            `json:"version"`               // Behavior pack version from its manifest.json
+	Files      map[string]string `json:"files"`                 // SHA-256 of every pack file by path
+	PackHash   string            `json:"pack_hash"`             // SHA-256 over the sorted file hashes, published in the handshake
+	SigningKey string            `json:"signing_key,omitempty"` // Hex encoded ed25519 public key, empty when unsigned
+	Signature  string            `json:"signature,omitempty"`   // Hex encoded signature of the version and pack hash
+}
+
+// HashPackManifest builds the unsigned manifest of an mcpack, signatures are only checked by
+// nodes trusting a signing key so development builds run unsigned
+func HashPackManifest(mcpack []byte) (*PackManifest, error) {
+	files, version, err := hashPackFiles(mcpack)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PackManifest{
+		Version:  version,
+		Files:    files,
+		PackHash: packHash(files),
+	}, nil
+}
+
+// SignPackManifest builds and signs the manifest of an mcpack
+func SignPackManifest(mcpack []byte, key ed25519.PrivateKey) (*PackManifest, error) {
+	manifest, err := HashPackManifest(mcpack)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest.SigningKey = hex.EncodeToString(key.Public().(ed25519.PublicKey))
+	manifest.Signature = hex.EncodeToString(ed25519.Sign(key, manifest.message()))
+	return manifest, nil
+}
+
+// Signed reports whether the manifest carries a signature
+func (m *PackManifest) Signed() bool {
+	return m.Signature != ""
+}
+
+// Verify checks that the mcpack matches the manifest
+// With trustedKeys the manifest must carry a valid signature of one of them, without any the
+// signature is not checked at all: a self-signed manifest proves nothing, see SignatureVerified
+func (m *PackManifest) Verify(mcpack []byte, trustedKeys []string) error {
+	if len(trustedKeys) > 0 {
+		if !m.Signed() {
+			return fmt.Errorf("%w: the manifest is unsigned", ErrUntrustedPackKey)
+		}
+		if !containsFold(trustedKeys, m.SigningKey) {
+			return fmt.Errorf("%w: %s", ErrUntrustedPackKey, m.SigningKey)
+		}
+		publicKey, err := hex.DecodeString(m.SigningKey)
+		if err != nil || len(publicKey) != ed25519.PublicKeySize {
+			return fmt.Errorf("%w: invalid signing key", ErrPackTampered)
+		}
+		signature, err := hex.DecodeString(m.Signature)
+		if err != nil || !ed25519.Verify(publicKey, m.message(), signature) {
+			return fmt.Errorf("%w: invalid signature", ErrPackTampered)
+		}
+	}
+
+	files, version, err := hashPackFiles(mcpack)
+	if err != nil {
+		return err
+	}
+	if version != m.Version {
+		return fmt.Errorf("%w: version %s, manifest lists %s", ErrPackTampered, version, m.Version)
+	}
+	for name, hash := range files {
+		if expected, ok := m.Files[name]; !ok || expected != hash {
+			return fmt.Errorf("%w: %s", ErrPackTampered, name)
+		}
+	}
+	for name := range m.Files {
+		if _, ok := files[name]; !ok {
+			return fmt.Errorf("%w: %s is missing", ErrPackTampered, name)
+		}
+	}
+	if packHash(files) != m.PackHash {
+		return fmt.Errorf("%w: pack hash", ErrPackTampered)
+	}
+
+	return nil
+}
+
+// message is the signed part of the manifest, the file hashes are covered by the pack hash
+func (m *PackManifest) message() []byte {
+	return []byte("consensuscraft-pack\n" + m.Version + "\n" + m.PackHash)
+}
+
+// SignatureVerified describes how an mcpack verified with trustedKeys was checked, for logs
+func (m *PackManifest) SignatureVerified(trustedKeys []string) (string, bool) {
+	if len(trustedKeys) == 0 {
+		return "signature verification is off as PACK_TRUSTED_KEYS is empty, only the file hashes of the manifest were checked", false
+	}
+	return "signed by trusted key " + m.SigningKey, true
+}
+
+// EmbeddedPackManifest returns the manifest embedded with the mcpack after verifying the pack against it
+func EmbeddedPackManifest(trustedKeys []string) (*PackManifest, error) {
+	var manifest PackManifest
+	if err := json.Unmarshal(xendchest.Manifest, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse embedded pack manifest: %w", err)
+	}
+
+	mcpack, err := xendchest.Asset("x_ender_chest.mcpack")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get embedded mcpack: %w", err)
+	}
+
+	if err := manifest.Verify(mcpack, trustedKeys); err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
+
+// hashPackFiles hashes every file of an mcpack and reads the behavior pack version
+func hashPackFiles(mcpack []byte) (map[string]string, string, error) {
+	reader, err := zip.NewReader(bytes.NewReader(mcpack), int64(len(mcpack)))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to open mcpack: %w", err)
+	}
+
+	files := make(map[string]string)
+	var version string
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+
+		rc, err := file.Open()
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to open %s: %w", file.Name, err)
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to read %s: %w", file.Name, err)
+		}
+
+		sum := sha256.Sum256(data)
+		files[file.Name] = hex.EncodeToString(sum[:])
+
+		if file.Name == "behavior_pack/manifest.json" {
+			var manifest Manifest
+			if err := json.Unmarshal(data, &manifest); err != nil {
+				return nil, "", fmt.Errorf("failed to parse %s: %w", file.Name, err)
+			}
+			version = formatVersion(manifest.Header.Version)
+		}
+	}
+
+	return files, version, nil
+}
+
+// packHash hashes the sorted list of file hashes
+func packHash(files map[string]string) string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	hash := sha256.New()
+	for _, name := range names {
+		fmt.Fprintf(hash, "%s:%s\n", name, files[name])
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func formatVersion(version []int) string {
+	parts := make([]string, len(version))
+	for i, part := range version {
+		parts[i] = strconv.Itoa(part)
+	}
+	return strings.Join(parts, ".")
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
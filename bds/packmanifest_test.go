@@ -0,0 +1,155 @@
+package bds
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func buildPack(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func TestEmbeddedPackManifest(t *testing.T) {
+	manifest, err := EmbeddedPackManifest(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "1.0.0", manifest.Version)
+	assert.Len(t, manifest.PackHash, 64)
+	assert.Contains(t, manifest.Files, "behavior_pack/manifest.json")
+	_, verified := manifest.SignatureVerified(nil)
+	assert.False(t, verified)
+
+	// Release builds sign the manifest with PACK_SIGNING_KEY, source builds are refused by
+	// nodes trusting a key
+	if !manifest.Signed() {
+		_, err = EmbeddedPackManifest([]string{"0000"})
+		assert.ErrorIs(t, err, ErrUntrustedPackKey)
+		return
+	}
+	_, err = EmbeddedPackManifest([]string{"0000", manifest.SigningKey})
+	assert.NoError(t, err)
+	_, err = EmbeddedPackManifest([]string{"0000"})
+	assert.ErrorIs(t, err, ErrUntrustedPackKey)
+}
+
+// TestEmbeddedPackManifest_MatchesSources fails when mod/ changed without running make gen
+func TestEmbeddedPackManifest_MatchesSources(t *testing.T) {
+	manifest, err := EmbeddedPackManifest(nil)
+	require.NoError(t, err)
+
+	sources := map[string]string{}
+	for _, pack := range []string{"behavior_pack", "resource_pack"} {
+		root := filepath.Join("..", "mod")
+		err := filepath.WalkDir(filepath.Join(root, pack), func(path string, entry fs.DirEntry, err error) error {
+			if err != nil || entry.IsDir() || entry.Name() == ".DS_Store" {
+				return err
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			name, err := filepath.Rel(root, path)
+			if err != nil {
+				return err
+			}
+			sum := sha256.Sum256(data)
+			sources[filepath.ToSlash(name)] = hex.EncodeToString(sum[:])
+			return nil
+		})
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, sources, manifest.Files, "gen/xendchest is stale, run make gen")
+}
+
+func TestPackManifest_Verify(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	files := map[string]string{
+		"behavior_pack/manifest.json":      `{"header":{"uuid":"b","version":[1,2,3]}}`,
+		"behavior_pack/scripts/main.js":    `import "./x_ender_chest.js";`,
+		"resource_pack/manifest.json":      `{"header":{"uuid":"r","version":[1,2,3]}}`,
+		"resource_pack/textures/chest.png": "png",
+	}
+	pack := buildPack(t, files)
+
+	manifest, err := SignPackManifest(pack, key)
+	require.NoError(t, err)
+	assert.Equal(t, "1.2.3", manifest.Version)
+	require.NoError(t, manifest.Verify(pack, nil))
+	require.NoError(t, manifest.Verify(pack, []string{manifest.SigningKey}))
+	how, verified := manifest.SignatureVerified([]string{manifest.SigningKey})
+	assert.True(t, verified)
+	assert.Contains(t, how, manifest.SigningKey)
+
+	t.Run("unsigned", func(t *testing.T) {
+		unsigned, err := HashPackManifest(pack)
+		require.NoError(t, err)
+		assert.False(t, unsigned.Signed())
+		assert.Equal(t, manifest.PackHash, unsigned.PackHash)
+		assert.NoError(t, unsigned.Verify(pack, nil))
+		assert.ErrorIs(t, unsigned.Verify(pack, []string{manifest.SigningKey}), ErrUntrustedPackKey)
+	})
+
+	t.Run("modified file", func(t *testing.T) {
+		modified := map[string]string{}
+		for name, content := range files {
+			modified[name] = content
+		}
+		modified["behavior_pack/scripts/main.js"] = `import "./steal_items.js";`
+		assert.ErrorIs(t, manifest.Verify(buildPack(t, modified), nil), ErrPackTampered)
+	})
+
+	t.Run("added and removed files", func(t *testing.T) {
+		added := map[string]string{"behavior_pack/scripts/extra.js": ""}
+		removed := map[string]string{}
+		for name, content := range files {
+			added[name] = content
+			if name != "resource_pack/textures/chest.png" {
+				removed[name] = content
+			}
+		}
+		assert.ErrorIs(t, manifest.Verify(buildPack(t, added), nil), ErrPackTampered)
+		assert.ErrorIs(t, manifest.Verify(buildPack(t, removed), nil), ErrPackTampered)
+	})
+
+	t.Run("edited manifest", func(t *testing.T) {
+		edited := *manifest
+		edited.Version = "9.9.9"
+		assert.ErrorIs(t, edited.Verify(pack, nil), ErrPackTampered)
+
+		// Any key signs a self-consistent manifest, only trusted keys are accepted
+		_, other, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		resigned, err := SignPackManifest(pack, other)
+		require.NoError(t, err)
+		assert.ErrorIs(t, resigned.Verify(pack, []string{manifest.SigningKey}), ErrUntrustedPackKey)
+
+		forged := *manifest
+		forged.Signature = hex.EncodeToString(make([]byte, ed25519.SignatureSize))
+		assert.ErrorIs(t, forged.Verify(pack, []string{manifest.SigningKey}), ErrPackTampered)
+	})
+
+	_, err = SignPackManifest([]byte("not a zip"), key)
+	assert.Error(t, err)
+}
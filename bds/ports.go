@@ -0,0 +1,164 @@
+package bds
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Ports the server listens on when server.properties does not set them
+const (
+	DefaultPort   = 19132
+	DefaultPortV6 = 19133
+)
+
+var ErrPortInUse = errors.New("server port is already in use")
+
+// PortRange is an inclusive range of UDP ports the server may move to when its ports are taken
+type PortRange struct {
+	Min int
+	Max int
+}
+
+// ParsePortRange parses a range such as "19132-19200", an empty string is the empty range
+func ParsePortRange(value string) (PortRange, error) {
+	if value == "" {
+		return PortRange{}, nil
+	}
+
+	low, high, ok := strings.Cut(value, "-")
+	if !ok {
+		high = low
+	}
+	first, errFirst := strconv.Atoi(strings.TrimSpace(low))
+	last, errLast := strconv.Atoi(strings.TrimSpace(high))
+	if errFirst != nil || errLast != nil || first < 1 || last > 65535 || first > last {
+		return PortRange{}, fmt.Errorf("invalid port range %q, expected e.g. 19132-19200", value)
+	}
+	return PortRange{Min: first, Max: last}, nil
+}
+
+// Empty reports whether the range holds no ports, the server is then never moved
+func (r PortRange) Empty() bool {
+	return r.Min == 0
+}
+
+// portFree reports whether a UDP port can be bound, replaced in tests
+var portFree = func(port int) bool {
+	conn, err := net.ListenPacket("udp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return false
+	}
+	conn.Close()
+	return true
+}
+
+// EnsurePorts checks that the IPv4 and IPv6 ports configured in server.properties are free
+// Taken ports are replaced with free ports of the range and saved to the file, with an empty
+// range a taken port fails with ErrPortInUse, the IPv4 port the server will use is returned
+func EnsurePorts(propertiesPath string, ports PortRange) (int, error) {
+	properties, err := readProperties(propertiesPath)
+	if errors.Is(err, os.ErrNotExist) {
+		properties = map[string]string{}
+	} else if err != nil {
+		return 0, err
+	}
+
+	keys := []string{"server-port", "server-portv6"}
+	current := []int{
+		propertyPort(properties, keys[0], DefaultPort),
+		propertyPort(properties, keys[1], DefaultPortV6),
+	}
+
+	updates := make(map[string]string)
+	taken := make(map[int]bool)
+	for i, port := range current {
+		// Both ports set to the same value count as a conflict of the IPv6 port
+		if !taken[port] && portFree(port) {
+			taken[port] = true
+			continue
+		}
+		if ports.Empty() {
+			return 0, fmt.Errorf("%w: %s %d", ErrPortInUse, keys[i], port)
+		}
+
+		free := 0
+		for candidate := ports.Min; candidate <= ports.Max; candidate++ {
+			if !taken[candidate] && candidate != current[1-i] && portFree(candidate) {
+				free = candidate
+				break
+			}
+		}
+		if free == 0 {
+			return 0, fmt.Errorf("%w: %s %d and no free port in %d-%d", ErrPortInUse, keys[i], port, ports.Min, ports.Max)
+		}
+
+		current[i] = free
+		taken[free] = true
+		updates[keys[i]] = strconv.Itoa(free)
+	}
+
+	if len(updates) > 0 {
+		if err := writeProperties(propertiesPath, updates); err != nil {
+			return 0, err
+		}
+	}
+	return current[0], nil
+}
+
+// propertyPort returns a port property, or def when it is missing or invalid
+func propertyPort(properties map[string]string, key string, def int) int {
+	port, err := strconv.Atoi(properties[key])
+	if err != nil || port < 1 || port > 65535 {
+		return def
+	}
+	return port
+}
+
+// writeProperties replaces the values of keys in server.properties, appending missing keys,
+// comments and other settings are kept as they are
+func writeProperties(path string, updates map[string]string) error {
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to read server properties: %w", err)
+	}
+
+	var out bytes.Buffer
+	written := make(map[string]bool)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		key, _, ok := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if value, update := updates[key]; ok && update && !strings.HasPrefix(key, "#") {
+			line = key + "=" + value
+			written[key] = true
+		}
+		out.WriteString(line + "\n")
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read server properties: %w", err)
+	}
+	missing := make([]string, 0, len(updates))
+	for key := range updates {
+		if !written[key] {
+			missing = append(missing, key)
+		}
+	}
+	sort.Strings(missing)
+	for _, key := range missing {
+		out.WriteString(key + "=" + updates[key] + "\n")
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, out.Bytes(), 0644); err != nil {
+		return fmt.Errorf("failed to write server properties: %w", err)
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,119 @@
+package bds
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubPorts marks ports as taken for the duration of a test
+func stubPorts(t *testing.T, taken ...int) {
+	t.Helper()
+	original := portFree
+	t.Cleanup(func() { portFree = original })
+
+	busy := make(map[int]bool)
+	for _, port := range taken {
+		busy[port] = true
+	}
+	portFree = func(port int) bool { return !busy[port] }
+}
+
+func TestParsePortRange(t *testing.T) {
+	ports, err := ParsePortRange("19132-19200")
+	require.NoError(t, err)
+	assert.Equal(t, PortRange{Min: 19132, Max: 19200}, ports)
+
+	ports, err = ParsePortRange("19150")
+	require.NoError(t, err)
+	assert.Equal(t, PortRange{Min: 19150, Max: 19150}, ports)
+
+	ports, err = ParsePortRange("")
+	require.NoError(t, err)
+	assert.True(t, ports.Empty())
+
+	for _, value := range []string{"abc", "19200-19132", "0-10", "19132-70000", "19132-"} {
+		_, err := ParsePortRange(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestEnsurePorts(t *testing.T) {
+	properties := `# Comment line
+server-name=Dedicated Server
+server-port=19132
+server-portv6=19133
+gamemode=survival
+`
+
+	t.Run("FreePorts", func(t *testing.T) {
+		stubPorts(t)
+		path := filepath.Join(t.TempDir(), "server.properties")
+		require.NoError(t, os.WriteFile(path, []byte(properties), 0644))
+
+		port, err := EnsurePorts(path, PortRange{})
+		require.NoError(t, err)
+		assert.Equal(t, 19132, port)
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, properties, string(data))
+	})
+
+	t.Run("TakenPortWithoutRange", func(t *testing.T) {
+		stubPorts(t, 19132)
+		path := filepath.Join(t.TempDir(), "server.properties")
+		require.NoError(t, os.WriteFile(path, []byte(properties), 0644))
+
+		_, err := EnsurePorts(path, PortRange{})
+		assert.ErrorIs(t, err, ErrPortInUse)
+	})
+
+	t.Run("MovesTakenPorts", func(t *testing.T) {
+		stubPorts(t, 19132, 19133, 19134)
+		path := filepath.Join(t.TempDir(), "server.properties")
+		require.NoError(t, os.WriteFile(path, []byte(properties), 0644))
+
+		port, err := EnsurePorts(path, PortRange{Min: 19132, Max: 19140})
+		require.NoError(t, err)
+		assert.Equal(t, 19135, port)
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, `# Comment line
+server-name=Dedicated Server
+server-port=19135
+server-portv6=19136
+gamemode=survival
+`, string(data))
+
+		settings, err := ReadWorldSettings(path)
+		require.NoError(t, err)
+		assert.Equal(t, 19135, settings.Port)
+	})
+
+	t.Run("RangeExhausted", func(t *testing.T) {
+		stubPorts(t, 19132, 19133)
+		path := filepath.Join(t.TempDir(), "server.properties")
+		require.NoError(t, os.WriteFile(path, []byte(properties), 0644))
+
+		_, err := EnsurePorts(path, PortRange{Min: 19133, Max: 19133})
+		assert.ErrorIs(t, err, ErrPortInUse)
+	})
+
+	t.Run("MissingFile", func(t *testing.T) {
+		stubPorts(t, 19133)
+		path := filepath.Join(t.TempDir(), "server.properties")
+
+		port, err := EnsurePorts(path, PortRange{Min: 19200, Max: 19210})
+		require.NoError(t, err)
+		assert.Equal(t, DefaultPort, port)
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "server-portv6=19200\n", string(data))
+	})
+}
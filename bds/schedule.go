@@ -0,0 +1,130 @@
+package bds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// restartWarnings are how long before a scheduled restart players are warned, longest first
+var restartWarnings = []time.Duration{
+	10 * time.Minute,
+	5 * time.Minute,
+	time.Minute,
+	30 * time.Second,
+	10 * time.Second,
+}
+
+var ErrInvalidSchedule = errors.New("invalid restart schedule")
+
+// RestartSchedule is the times of day the server is restarted at, in local time, to release the
+// memory a long running Bedrock server accumulates
+type RestartSchedule struct {
+	times []time.Duration // Offsets from midnight, sorted
+}
+
+// ParseRestartSchedule parses comma separated times of day in 24 hour HH:MM format, e.g. "05:00,17:30"
+func ParseRestartSchedule(spec string) (*RestartSchedule, error) {
+	schedule := &RestartSchedule{}
+	seen := make(map[time.Duration]bool)
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		at, err := time.Parse("15:04", part)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q is not an HH:MM time", ErrInvalidSchedule, part)
+		}
+		offset := time.Duration(at.Hour())*time.Hour + time.Duration(at.Minute())*time.Minute
+		if !seen[offset] {
+			seen[offset] = true
+			schedule.times = append(schedule.times, offset)
+		}
+	}
+	if len(schedule.times) == 0 {
+		return nil, fmt.Errorf("%w: no restart time", ErrInvalidSchedule)
+	}
+
+	sort.Slice(schedule.times, func(i, j int) bool { return schedule.times[i] < schedule.times[j] })
+	return schedule, nil
+}
+
+// Next returns the first scheduled restart strictly after the given time
+func (s *RestartSchedule) Next(after time.Time) time.Time {
+	year, month, day := after.Date()
+	for days := 0; ; days++ {
+		for _, offset := range s.times {
+			hours, minutes := int(offset/time.Hour), int(offset%time.Hour/time.Minute)
+			at := time.Date(year, month, day+days, hours, minutes, 0, 0, after.Location())
+			if at.After(after) {
+				return at
+			}
+		}
+	}
+}
+
+// pendingWarnings returns the warnings still ahead of a restart at restartAt, longest first
+func pendingWarnings(restartAt, now time.Time) []time.Duration {
+	var pending []time.Duration
+	for _, warning := range restartWarnings {
+		if !restartAt.Add(-warning).Before(now) {
+			pending = append(pending, warning)
+		}
+	}
+	return pending
+}
+
+// formatCountdown renders the time left before a restart for players, e.g. "5 minutes" or "30 seconds"
+func formatCountdown(d time.Duration) string {
+	unit, count := "second", int(d.Round(time.Second)/time.Second)
+	if d >= time.Minute && d%time.Minute == 0 {
+		unit, count = "minute", int(d/time.Minute)
+	}
+	if count != 1 {
+		unit += "s"
+	}
+	return fmt.Sprintf("%d %s", count, unit)
+}
+
+// RunRestartSchedule restarts the server at every scheduled time until ctx is done, warning players
+// with say at decreasing intervals beforehand
+// The restart goes through the controlled restart of the management loop, its graceful stop saves the world
+func (b *Bds) RunRestartSchedule(ctx context.Context, schedule *RestartSchedule) {
+	for {
+		restartAt := schedule.Next(time.Now())
+		logger.Printf("Next scheduled server restart at %s", restartAt.Format(time.DateTime))
+
+		for _, warning := range pendingWarnings(restartAt, time.Now()) {
+			if !sleepUntil(ctx, restartAt.Add(-warning)) {
+				return
+			}
+			if err := b.Announce(MessageScheduledRestart, "time", formatCountdown(warning)); err != nil {
+				logger.Warnf("Failed to warn players about the scheduled restart: %v", err)
+			}
+		}
+
+		if !sleepUntil(ctx, restartAt) {
+			return
+		}
+		b.requestRestart("reaching its scheduled restart time")
+	}
+}
+
+// sleepUntil waits until t, reporting false when ctx is done first
+func sleepUntil(ctx context.Context, t time.Time) bool {
+	timer := time.NewTimer(time.Until(t))
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,70 @@
+package bds
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRestartSchedule(t *testing.T) {
+	schedule, err := ParseRestartSchedule("17:30, 05:00,05:00")
+	require.NoError(t, err)
+	assert.Equal(t, []time.Duration{5 * time.Hour, 17*time.Hour + 30*time.Minute}, schedule.times)
+
+	for _, spec := range []string{"", " , ", "5am", "25:00", "05:00,noon"} {
+		_, err := ParseRestartSchedule(spec)
+		assert.ErrorIs(t, err, ErrInvalidSchedule, spec)
+	}
+}
+
+func TestRestartSchedule_Next(t *testing.T) {
+	schedule, err := ParseRestartSchedule("05:00,17:30")
+	require.NoError(t, err)
+
+	day := func(d, hour, minute int) time.Time {
+		return time.Date(2026, time.January, d, hour, minute, 0, 0, time.UTC)
+	}
+
+	assert.Equal(t, day(10, 5, 0), schedule.Next(day(10, 1, 0)))
+	assert.Equal(t, day(10, 17, 30), schedule.Next(day(10, 5, 0)), "strictly after")
+	assert.Equal(t, day(11, 5, 0), schedule.Next(day(10, 18, 0)))
+	assert.Equal(t, time.Date(2027, time.January, 1, 5, 0, 0, 0, time.UTC), schedule.Next(time.Date(2026, time.December, 31, 20, 0, 0, 0, time.UTC)))
+}
+
+func TestPendingWarnings(t *testing.T) {
+	restartAt := time.Date(2026, time.January, 10, 5, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, restartWarnings, pendingWarnings(restartAt, restartAt.Add(-time.Hour)))
+	assert.Equal(t, []time.Duration{time.Minute, 30 * time.Second, 10 * time.Second}, pendingWarnings(restartAt, restartAt.Add(-2*time.Minute)))
+	assert.Empty(t, pendingWarnings(restartAt, restartAt.Add(-5*time.Second)))
+}
+
+func TestFormatCountdown(t *testing.T) {
+	assert.Equal(t, "10 minutes", formatCountdown(10*time.Minute))
+	assert.Equal(t, "1 minute", formatCountdown(time.Minute))
+	assert.Equal(t, "90 seconds", formatCountdown(90*time.Second))
+	assert.Equal(t, "1 second", formatCountdown(time.Second))
+}
+
+func TestBds_RunRestartSchedule(t *testing.T) {
+	schedule, err := ParseRestartSchedule("05:00")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		(&Bds{messages: DefaultMessages(), restart: make(chan string, 1)}).RunRestartSchedule(ctx, schedule)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("schedule did not stop with its context")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/d1nch8g/consensuscraft/logger"
@@ -18,7 +19,10 @@ type Server struct {
 	ctx           context.Context
 	cancel        context.CancelFunc
 	webAddress    string
+	originFormat  string
 	scheduleDelay time.Duration // Configurable delay for scheduled commands
+	limits        ResourceLimits
+	releaseLimits func() // Removes the cgroup of the running process
 }
 
 // NewServer creates a new server manager
@@ -59,6 +63,7 @@ func (s *Server) Start() (*exec.Cmd, error) {
 	if err := serverProcess.Start(); err != nil {
 		return nil, fmt.Errorf("failed to start server process: %w", err)
 	}
+	s.limitProcess(serverProcess.Process.Pid)
 
 	return serverProcess, nil
 }
@@ -82,6 +87,30 @@ func (s *Server) Stop(serverProcess *exec.Cmd) {
 	}
 }
 
+// limitProcess applies the resource limits to a started server process, a limit that cannot
+// be applied is logged and the server keeps running without it
+func (s *Server) limitProcess(pid int) {
+	if !s.limits.Enabled() {
+		return
+	}
+
+	release, err := applyLimits(pid, s.limits)
+	s.releaseLimits = release
+	if err != nil {
+		logger.Warnf("Resource limits of PID %d not fully applied: %v", pid, err)
+		return
+	}
+	logger.Printf("Applied resource limits to PID %d", pid)
+}
+
+// release frees what was set up for the limits of an exited server process
+func (s *Server) release() {
+	if s.releaseLimits != nil {
+		s.releaseLimits()
+		s.releaseLimits = nil
+	}
+}
+
 // StartWithPipes starts the server with separate pipes for monitoring (alternative approach)
 func (s *Server) StartWithPipes() (*exec.Cmd, io.WriteCloser, io.ReadCloser, io.ReadCloser, error) {
 	// Get absolute path to avoid path issues
@@ -124,6 +153,7 @@ func (s *Server) StartWithPipes() (*exec.Cmd, io.WriteCloser, io.ReadCloser, io.
 		stderr.Close()
 		return nil, nil, nil, nil, fmt.Errorf("failed to start server process: %w", err)
 	}
+	s.limitProcess(serverProcess.Process.Pid)
 
 	// Schedule gamerule command with access to stdin
 	go s.scheduleGameruleCommandWithPipe(stdin)
@@ -173,5 +203,37 @@ func (s *Server) scheduleGameruleCommandWithPipe(stdin io.WriteCloser) {
 		} else {
 			logger.Printf("Successfully set server name in scoreboard: %s", serverName)
 		}
+
+		if s.originFormat != "" {
+			s.sendOriginFormat(stdin)
+		}
+
+		// Ask the pack which name it labels origins with, the output parser compares the answer
+		if s.webAddress != "" {
+			time.Sleep(50 * time.Millisecond)
+			if _, err := stdin.Write([]byte("scriptevent " + identityEvent + "\n")); err != nil {
+				logger.Printf("Failed to request the server identity: %v", err)
+			}
+		}
+	}
+}
+
+// sendOriginFormat hands the origin lore format to the pack through the originFormat scoreboard
+func (s *Server) sendOriginFormat(stdin io.Writer) {
+	time.Sleep(50 * time.Millisecond)
+
+	if _, err := stdin.Write([]byte("scoreboard objectives add originFormat dummy\n")); err != nil {
+		logger.Printf("Failed to send origin format objective command: %v", err)
+		return
+	}
+
+	time.Sleep(50 * time.Millisecond)
+
+	format := strings.ReplaceAll(s.originFormat, `"`, `\"`)
+	if _, err := fmt.Fprintf(stdin, "scoreboard players set \"%s\" originFormat 1\n", format); err != nil {
+		logger.Printf("Failed to send origin format: %v", err)
+		return
 	}
+
+	logger.Printf("Successfully set origin format in scoreboard: %s", s.originFormat)
 }
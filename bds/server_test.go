@@ -289,6 +289,7 @@ func TestServer_ScheduleGameruleCommandWithPipe(t *testing.T) {
 		assert.Contains(t, output, "gamerule showcoordinates true")
 		assert.Contains(t, output, "scoreboard objectives add serverName dummy")
 		assert.Contains(t, output, "scoreboard players set \"test-server.example.com\" serverName 1")
+		assert.Contains(t, output, "scriptevent consensuscraft:identity")
 	})
 
 	t.Run("ScheduleGameruleCommandWithPipeEmptyWebAddress", func(t *testing.T) {
@@ -316,6 +317,28 @@ func TestServer_ScheduleGameruleCommandWithPipe(t *testing.T) {
 		assert.Contains(t, output, "gamerule showcoordinates true")
 		assert.Contains(t, output, "scoreboard objectives add serverName dummy")
 		assert.Contains(t, output, "scoreboard players set \"unknown-server\" serverName 1")
+		assert.NotContains(t, output, "scriptevent consensuscraft:identity")
+	})
+
+	t.Run("ScheduleOriginFormat", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		server := NewServer("mock_server", ctx, cancel, "test-server.example.com")
+		server.originFormat = `⚒ Forged on "<server>"`
+		server.scheduleDelay = 100 * time.Millisecond
+
+		var capturedData bytes.Buffer
+		mockStdin := &mockWriteCloser{writer: &capturedData}
+
+		go server.scheduleGameruleCommandWithPipe(mockStdin)
+
+		// Wait for the server name commands plus the two origin format commands
+		time.Sleep(600 * time.Millisecond)
+
+		output := capturedData.String()
+		assert.Contains(t, output, "scoreboard objectives add originFormat dummy")
+		assert.Contains(t, output, `scoreboard players set "⚒ Forged on \"<server>\"" originFormat 1`)
 	})
 }
 
@@ -0,0 +1,256 @@
+package bds
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// addressTimeout is how long an address logged before its player connected is kept for them
+const addressTimeout = time.Minute
+
+var ErrInvalidAddressPattern = errors.New("invalid player address pattern")
+
+// Session is a connection of a player to the server, for abuse investigations
+type Session struct {
+	Player         string    `json:"player"`
+	XUID           string    `json:"xuid,omitempty"`
+	Address        string    `json:"address,omitempty"` // Real client address, empty unless a proxy or the server logged it
+	ConnectedAt    time.Time `json:"connected_at"`
+	DisconnectedAt time.Time `json:"disconnected_at"` // Zero while the player is online
+}
+
+// pendingAddress is a client address logged before the connection of its player
+type pendingAddress struct {
+	address string
+	at      time.Time
+}
+
+// onlinePlayers tracks the sessions of players connected to the running server from its log
+type onlinePlayers struct {
+	mu        sync.Mutex
+	players   map[string]*Session
+	addresses map[string]pendingAddress
+}
+
+func newOnlinePlayers() *onlinePlayers {
+	return &onlinePlayers{players: make(map[string]*Session), addresses: make(map[string]pendingAddress)}
+}
+
+func (o *onlinePlayers) add(player, xuid string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if _, ok := o.players[player]; ok {
+		return
+	}
+
+	session := &Session{Player: player, XUID: xuid, ConnectedAt: time.Now()}
+	if pending, ok := o.addresses[player]; ok && session.ConnectedAt.Sub(pending.at) < addressTimeout {
+		session.Address = pending.address
+	}
+	delete(o.addresses, player)
+	o.players[player] = session
+}
+
+// setAddress records the client address of a player, kept for their connection when they are not online yet
+func (o *onlinePlayers) setAddress(player, address string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if session, ok := o.players[player]; ok {
+		session.Address = address
+		return
+	}
+
+	now := time.Now()
+	for name, pending := range o.addresses {
+		if now.Sub(pending.at) >= addressTimeout {
+			delete(o.addresses, name)
+		}
+	}
+	o.addresses[player] = pendingAddress{address: address, at: now}
+}
+
+// remove ends the session of a player, returning it
+func (o *onlinePlayers) remove(player string) (Session, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	session, ok := o.players[player]
+	if !ok {
+		return Session{}, false
+	}
+	delete(o.players, player)
+	session.DisconnectedAt = time.Now()
+	return *session, true
+}
+
+// clear ends every session when the server process exits, returning them
+func (o *onlinePlayers) clear() []Session {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	now := time.Now()
+	ended := make([]Session, 0, len(o.players))
+	for _, session := range o.players {
+		session.DisconnectedAt = now
+		ended = append(ended, *session)
+	}
+	o.players = make(map[string]*Session)
+	o.addresses = make(map[string]pendingAddress)
+	return ended
+}
+
+// list returns the online players sorted by name
+func (o *onlinePlayers) list() []string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	players := make([]string, 0, len(o.players))
+	for player := range o.players {
+		players = append(players, player)
+	}
+	sort.Strings(players)
+	return players
+}
+
+// sessions returns the sessions of online players sorted by player
+func (o *onlinePlayers) sessions() []Session {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	sessions := make([]Session, 0, len(o.players))
+	for _, session := range o.players {
+		sessions = append(sessions, *session)
+	}
+	sort.Slice(sessions, func(i, j int) bool { return sessions[i].Player < sessions[j].Player })
+	return sessions
+}
+
+// endSession hands an ended session to the session callback
+func (op *OutputParser) endSession(session Session) {
+	if op.sessionEnded != nil {
+		op.sessionEnded(session)
+	}
+}
+
+// Sessions returns the sessions of the players currently connected to the server
+func (b *Bds) Sessions() []Session {
+	return b.outputParser.online.sessions()
+}
+
+// ParseAddressPattern compiles the pattern of server output lines reporting the real address of a
+// player, e.g. logged by a proxy plugin, it must have the named groups player and address
+func ParseAddressPattern(pattern string) (*regexp.Regexp, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidAddressPattern, err)
+	}
+	if re.SubexpIndex("player") < 0 || re.SubexpIndex("address") < 0 {
+		return nil, fmt.Errorf("%w: named groups player and address are required", ErrInvalidAddressPattern)
+	}
+	return re, nil
+}
+
+// parseAddress returns the player and address of an output line matching the address pattern
+func parseAddress(re *regexp.Regexp, line string) (string, string, bool) {
+	if re == nil {
+		return "", "", false
+	}
+	matches := re.FindStringSubmatch(line)
+	if matches == nil {
+		return "", "", false
+	}
+	player := strings.TrimSpace(matches[re.SubexpIndex("player")])
+	address := strings.TrimSpace(matches[re.SubexpIndex("address")])
+	return player, address, player != "" && address != ""
+}
+
+// SessionLog appends ended sessions to a JSON lines file and searches them
+type SessionLog struct {
+	mu   sync.Mutex
+	path string
+}
+
+// OpenSessionLog returns the session log kept at path, created on the first session
+func OpenSessionLog(path string) *SessionLog {
+	return &SessionLog{path: path}
+}
+
+// Append records an ended session
+func (l *SessionLog) Append(session Session) error {
+	line, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	file, err := os.OpenFile(l.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open session log: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write session log: %w", err)
+	}
+	return nil
+}
+
+// Search returns the logged sessions of a player and from an address, newest first, empty filters
+// match everything and addresses match by host whatever the port
+func (l *SessionLog) Search(player, address string) ([]Session, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	file, err := os.Open(l.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return []Session{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open session log: %w", err)
+	}
+	defer file.Close()
+
+	sessions := []Session{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var session Session
+		if err := json.Unmarshal(scanner.Bytes(), &session); err != nil {
+			continue // A line cut short by a crash
+		}
+		if session.Matches(player, address) {
+			sessions = append(sessions, session)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read session log: %w", err)
+	}
+
+	sort.SliceStable(sessions, func(i, j int) bool { return sessions[i].ConnectedAt.After(sessions[j].ConnectedAt) })
+	return sessions, nil
+}
+
+// Matches reports whether the session is of player, case insensitive, and from the host of address,
+// empty filters match every session
+func (s Session) Matches(player, address string) bool {
+	if player != "" && !strings.EqualFold(s.Player, player) {
+		return false
+	}
+	return address == "" || addressHost(s.Address) == addressHost(address)
+}
+
+// addressHost strips the port of an address
+func addressHost(address string) string {
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		return host
+	}
+	return strings.Trim(address, "[]")
+}
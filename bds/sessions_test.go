@@ -0,0 +1,97 @@
+package bds
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutputParser_Sessions(t *testing.T) {
+	op := NewOutputParser(
+		func(playerName string) ([]byte, error) { return nil, nil },
+		func(playerName string, inventory []byte) error { return nil },
+	)
+	pattern, err := ParseAddressPattern(`\[Proxy\] (?P<player>.+?) joined from (?P<address>\S+)`)
+	require.NoError(t, err)
+	op.addressRegex = pattern
+
+	var ended []Session
+	op.sessionEnded = func(session Session) { ended = append(ended, session) }
+
+	input := strings.Join([]string{
+		"[2025-01-01 10:00:00:000 INFO] [Proxy] Steve joined from 203.0.113.7:51234",
+		"[2025-01-01 10:00:00:100 INFO] Player connected: Steve, xuid: 2535400000000001",
+		"[2025-01-01 10:00:01:000 INFO] Player connected: Big Alex, xuid: 2535400000000002",
+		"[2025-01-01 10:00:01:100 INFO] [Proxy] Big Alex joined from [2001:db8::1]:19132",
+		"[2025-01-01 10:00:02:000 INFO] Player disconnected: Steve, xuid: 2535400000000001, pfid: 1",
+	}, "\n") + "\n"
+
+	require.NoError(t, op.monitorServerLogs(strings.NewReader(input), Parameters{}, nil))
+
+	require.Len(t, ended, 1)
+	assert.Equal(t, "Steve", ended[0].Player)
+	assert.Equal(t, "2535400000000001", ended[0].XUID)
+	assert.Equal(t, "203.0.113.7:51234", ended[0].Address)
+	assert.False(t, ended[0].DisconnectedAt.IsZero())
+
+	online := op.online.sessions()
+	require.Len(t, online, 1)
+	assert.Equal(t, "[2001:db8::1]:19132", online[0].Address)
+	assert.True(t, online[0].DisconnectedAt.IsZero())
+
+	t.Run("server exit ends every session", func(t *testing.T) {
+		closed := op.online.clear()
+		require.Len(t, closed, 1)
+		assert.Equal(t, "Big Alex", closed[0].Player)
+		assert.Empty(t, op.online.sessions())
+	})
+}
+
+func TestParseAddressPattern(t *testing.T) {
+	_, err := ParseAddressPattern(`(?P<player>\w+) from (?P<address>\S+)`)
+	assert.NoError(t, err)
+
+	for _, pattern := range []string{`(`, `(?P<player>\w+) from (\S+)`} {
+		_, err := ParseAddressPattern(pattern)
+		assert.ErrorIs(t, err, ErrInvalidAddressPattern, pattern)
+	}
+}
+
+func TestSessionLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sessions.jsonl")
+	log := OpenSessionLog(path)
+
+	sessions, err := log.Search("", "")
+	require.NoError(t, err)
+	assert.Empty(t, sessions)
+
+	start := time.Date(2026, time.January, 10, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, log.Append(Session{Player: "Steve", Address: "203.0.113.7:51234", ConnectedAt: start}))
+	require.NoError(t, log.Append(Session{Player: "Alex", Address: "203.0.113.7:40000", ConnectedAt: start.Add(time.Hour)}))
+	require.NoError(t, log.Append(Session{Player: "Steve", Address: "198.51.100.1:1000", ConnectedAt: start.Add(2 * time.Hour)}))
+
+	// A line cut short by a crash is skipped
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+	require.NoError(t, err)
+	file.WriteString(`{"player":"Trunc`)
+	file.Close()
+
+	sessions, err = log.Search("steve", "")
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	assert.Equal(t, "198.51.100.1:1000", sessions[0].Address, "newest first")
+
+	sessions, err = log.Search("", "203.0.113.7")
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	assert.Equal(t, "Alex", sessions[0].Player)
+
+	sessions, err = log.Search("Alex", "198.51.100.1:5")
+	require.NoError(t, err)
+	assert.Empty(t, sessions)
+}
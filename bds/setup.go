@@ -2,6 +2,7 @@ package bds
 
 import (
 	"archive/zip"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -13,7 +14,11 @@ import (
 )
 
 // Setup handles server setup scenarios
-type Setup struct{}
+type Setup struct {
+	trustedPackKeys []string // Keys allowed to sign the mcpack manifest
+	worldTemplate   string   // .mcworld imported on first start, none when empty
+	worldChecksum   string   // Expected hex SHA-256 of worldTemplate, unchecked when empty
+}
 
 // NewSetup creates a new setup manager
 func NewSetup() *Setup {
@@ -43,6 +48,20 @@ func init() {
 
 // EnsureServer ensures the bedrock server is available based on current directory state
 func (s *Setup) EnsureServer() (string, error) {
+	serverPath, err := s.EnsureExecutable()
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.EnsurePack(); err != nil {
+		return "", err
+	}
+
+	return serverPath, nil
+}
+
+// EnsureExecutable finds, extracts or downloads the server executable and returns its path
+func (s *Setup) EnsureExecutable() (string, error) {
 	logger.Println("Checking server setup scenarios...")
 
 	var serverPath string
@@ -72,15 +91,28 @@ func (s *Setup) EnsureServer() (string, error) {
 		serverPath = serverExecutable
 	}
 
-	// Always ensure mcpack is installed on server startup
+	return serverPath, nil
+}
+
+// EnsurePack installs the x_ender_chest mcpack, only a tampered or untrusted pack is an error
+func (s *Setup) EnsurePack() error {
 	logger.Println("Ensuring x_ender_chest mcpack is installed...")
 	mcpackInstaller := NewMcpackInstaller()
+	mcpackInstaller.trustedKeys = s.trustedPackKeys
 	if err := mcpackInstaller.EnsureMcpackInstalled(); err != nil {
+		// A tampered pack must not run, other installation failures don't fail server startup
+		if errors.Is(err, ErrPackTampered) || errors.Is(err, ErrUntrustedPackKey) {
+			return err
+		}
 		logger.Printf("Warning - failed to install mcpack: %v", err)
-		// Don't fail server startup if mcpack installation fails
 	}
 
-	return serverPath, nil
+	return nil
+}
+
+// TrustPackKeys restricts the keys allowed to sign the mcpack manifest
+func (s *Setup) TrustPackKeys(keys []string) {
+	s.trustedPackKeys = keys
 }
 
 // checkCurrentDirectory checks if bedrock_server executable exists in current directory
@@ -15,6 +15,18 @@ type StdinWrapper struct {
 	serverStdin io.WriteCloser
 	reader      *bufio.Reader
 	enabled     bool
+
+	// Operator audit, operators map names to keys.HashSecret of their secret
+	operators          map[string]string
+	operator           string
+	confirmDestructive bool
+	pending            string
+
+	// Console gate, pin is keys.HashSecret of the passphrase unlocking the console and allowed
+	// the commands sent without an operator login, every command when empty
+	pin     string
+	locked  bool
+	allowed map[string]bool
 }
 
 // NewStdinWrapper creates a new stdin wrapper
@@ -65,13 +77,23 @@ func (sw *StdinWrapper) inputLoop() {
 			continue
 		}
 		
+		// Nothing but unlock passes a locked console
+		if sw.handleLock(command) {
+			continue
+		}
+		
 		// Handle special commands
 		if sw.handleSpecialCommands(command) {
 			continue
 		}
 		
+		// Refuse or hold back dangerous commands
+		if !sw.authorize(command) {
+			continue
+		}
+		
 		// Send command to server
-		if err := sw.sendCommand(command); err != nil {
+		if err := sw.auditedSend(command); err != nil {
 			logger.Printf("Failed to send command to server: %v", err)
 		}
 	}
@@ -79,12 +101,19 @@ func (sw *StdinWrapper) inputLoop() {
 
 // handleSpecialCommands processes special wrapper commands
 func (sw *StdinWrapper) handleSpecialCommands(command string) bool {
+	if sw.handleOperatorCommands(command) {
+		return true
+	}
+
 	switch strings.ToLower(command) {
 	case "exit", "quit":
+		if !sw.authorize("stop") {
+			return true
+		}
 		logger.Println("Exit command received, stopping server...")
 		sw.enabled = false
 		// Send stop command to server
-		sw.sendCommand("stop")
+		sw.auditedSend("stop")
 		return true
 	case "help":
 		sw.showHelp()
@@ -115,6 +144,11 @@ func (sw *StdinWrapper) showHelp() {
 	fmt.Println("BDS Stdin Wrapper Commands:")
 	fmt.Println("  help          - Show this help message")
 	fmt.Println("  exit/quit     - Stop the server and exit")
+	fmt.Println("  login <operator> <secret> - Authenticate for op, deop, ban and give")
+	fmt.Println("  logout        - Drop the operator identity")
+	fmt.Println("  whoami        - Show the identity commands are audited as")
+	fmt.Println("  unlock <passphrase> - Unlock a console locked with a PIN")
+	fmt.Println("  lock          - Lock the console and log out")
 	fmt.Println("  <any command> - Send command directly to bedrock server")
 	fmt.Println("")
 	fmt.Println("Common Bedrock Server Commands:")
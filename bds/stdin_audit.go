@@ -0,0 +1,171 @@
+package bds
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/d1nch8g/consensuscraft/keys"
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// consoleIdentity is recorded in the audit log for commands typed without logging in
+const consoleIdentity = "console"
+
+// dangerousCommands require a logged in operator when operators are configured
+var dangerousCommands = map[string]bool{
+	"op":   true,
+	"deop": true,
+	"ban":  true,
+	"give": true,
+}
+
+// destructiveCommands ask for confirmation when confirmation prompts are enabled
+var destructiveCommands = map[string]bool{
+	"ban":  true,
+	"deop": true,
+}
+
+// checkSecret checks a secret typed on the console against its keys.HashSecret hash
+func checkSecret(hash, secret, what string) error {
+	ok, err := keys.VerifySecret(hash, secret)
+	if err != nil {
+		return fmt.Errorf("%s hash: %w", what, err)
+	}
+	if !ok {
+		return fmt.Errorf("invalid secret")
+	}
+	return nil
+}
+
+// commandNames returns the lowercased name of a console command without a leading slash, followed
+// for execute by the name after every run, so a command wrapped in execute is gated like the bare
+// command. Every run is taken rather than parsing the subcommands, a run inside a selector or text
+// gates one name too many instead of hiding the command that follows
+func commandNames(command string) []string {
+	fields := strings.Fields(command)
+	if len(fields) == 0 {
+		return []string{""}
+	}
+
+	names := []string{strings.ToLower(strings.TrimPrefix(fields[0], "/"))}
+	if names[0] != "execute" {
+		return names
+	}
+	for i := 1; i < len(fields)-1; i++ {
+		if strings.ToLower(fields[i]) == "run" {
+			names = append(names, strings.ToLower(strings.TrimPrefix(fields[i+1], "/")))
+		}
+	}
+	return names
+}
+
+// identity returns the operator the next command is attributed to
+func (sw *StdinWrapper) identity() string {
+	if sw.operator == "" {
+		return consoleIdentity
+	}
+	return sw.operator
+}
+
+// handleOperatorCommands processes login, logout, whoami and pending confirmations
+func (sw *StdinWrapper) handleOperatorCommands(command string) bool {
+	if sw.pending != "" {
+		pending := sw.pending
+		sw.pending = ""
+		if answer := strings.ToLower(command); answer != "y" && answer != "yes" {
+			logger.Infof("Audit: %s cancelled %q", sw.identity(), pending)
+			fmt.Println("Cancelled")
+			return true
+		}
+		if err := sw.auditedSend(pending); err != nil {
+			logger.Printf("Failed to send command to server: %v", err)
+		}
+		return true
+	}
+
+	fields := strings.Fields(command)
+	if len(fields) == 0 {
+		return false
+	}
+
+	switch strings.ToLower(fields[0]) {
+	case "login":
+		if len(fields) != 3 {
+			fmt.Println("Usage: login <operator> <secret>")
+			return true
+		}
+		if err := sw.login(fields[1], fields[2]); err != nil {
+			logger.Infof("Audit: failed login as %s: %v", fields[1], err)
+			fmt.Println("Login failed")
+			return true
+		}
+		logger.Infof("Audit: %s logged in", sw.operator)
+		fmt.Printf("Logged in as %s\n", sw.operator)
+		return true
+	case "logout":
+		if sw.operator != "" {
+			logger.Infof("Audit: %s logged out", sw.operator)
+			sw.operator = ""
+		}
+		return true
+	case "whoami":
+		fmt.Println(sw.identity())
+		return true
+	default:
+		return false
+	}
+}
+
+// login checks an operator secret against its configured hash
+func (sw *StdinWrapper) login(operator, secret string) error {
+	expected, ok := sw.operators[operator]
+	if !ok {
+		return fmt.Errorf("unknown operator")
+	}
+
+	if err := checkSecret(expected, secret, "secret of operator "+operator); err != nil {
+		return err
+	}
+
+	sw.operator = operator
+	return nil
+}
+
+// authorize decides whether a command can be sent now, refusing dangerous and non whitelisted
+// commands without a logged in operator and holding destructive ones until confirmed
+// Commands run through execute are checked along with execute itself
+func (sw *StdinWrapper) authorize(command string) bool {
+	names := commandNames(command)
+
+	for _, name := range names {
+		if dangerousCommands[name] && len(sw.operators) > 0 && sw.operator == "" {
+			logger.Infof("Audit: refused %q from unauthenticated console", command)
+			fmt.Printf("'%s' requires an operator, use: login <operator> <secret>\n", name)
+			return false
+		}
+	}
+
+	for _, name := range names {
+		if !sw.allowedCommand(name) {
+			logger.Infof("Audit: refused %q outside the console whitelist", command)
+			fmt.Printf("'%s' is not allowed from the console, use: login <operator> <secret>\n", name)
+			return false
+		}
+	}
+
+	for _, name := range names {
+		if destructiveCommands[name] && sw.confirmDestructive {
+			sw.pending = command
+			fmt.Printf("Run '%s'? [y/N] ", command)
+			return false
+		}
+	}
+
+	return true
+}
+
+// auditedSend records a command with the operator identity and sends it to the server
+func (sw *StdinWrapper) auditedSend(command string) error {
+	logger.Infof("Audit: %s ran %q", sw.identity(), command)
+	return sw.sendCommand(command)
+}
@@ -0,0 +1,128 @@
+package bds
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/d1nch8g/consensuscraft/keys"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// secretHash hashes a console secret as CONSOLE_OPERATORS and CONSOLE_PIN hold it
+func secretHash(t *testing.T, secret string) string {
+	hash, err := keys.HashSecret(secret)
+	require.NoError(t, err)
+	return hash
+}
+
+// runConsole feeds input lines through the wrapper until EOF and returns what reached the server
+func runConsole(wrapper *StdinWrapper, input string) string {
+	mockStdin := &stdinMockWriteCloser{}
+	wrapper.serverStdin = mockStdin
+	wrapper.reader = bufio.NewReader(strings.NewReader(input))
+	wrapper.inputLoop()
+	return string(mockStdin.writtenData)
+}
+
+func TestStdinWrapper_OperatorAudit(t *testing.T) {
+	operators := map[string]string{"alice": secretHash(t, "hunter2")}
+
+	t.Run("NoOperatorsConfigured", func(t *testing.T) {
+		wrapper := NewStdinWrapper(nil)
+
+		sent := runConsole(wrapper, "op steve\nlist\n")
+
+		assert.Equal(t, "op steve\nlist\n", sent)
+	})
+
+	t.Run("DangerousCommandRequiresLogin", func(t *testing.T) {
+		wrapper := NewStdinWrapper(nil)
+		wrapper.operators = operators
+
+		sent := runConsole(wrapper, "op steve\n/give steve diamond 64\nsay hello\n")
+
+		assert.Equal(t, "say hello\n", sent)
+	})
+
+	t.Run("LoginAllowsDangerousCommands", func(t *testing.T) {
+		wrapper := NewStdinWrapper(nil)
+		wrapper.operators = operators
+
+		sent := runConsole(wrapper, "login alice hunter2\nban griefer\n")
+
+		assert.Equal(t, "ban griefer\n", sent)
+		assert.Equal(t, "alice", wrapper.identity())
+	})
+
+	t.Run("WrongSecretRejected", func(t *testing.T) {
+		wrapper := NewStdinWrapper(nil)
+		wrapper.operators = operators
+
+		sent := runConsole(wrapper, "login alice wrong\nlogin mallory hunter2\ndeop alice\n")
+
+		assert.Empty(t, sent)
+		assert.Equal(t, consoleIdentity, wrapper.identity())
+	})
+
+	t.Run("LogoutDropsIdentity", func(t *testing.T) {
+		wrapper := NewStdinWrapper(nil)
+		wrapper.operators = operators
+
+		sent := runConsole(wrapper, "login alice hunter2\nlogout\nop steve\n")
+
+		assert.Empty(t, sent)
+		assert.Equal(t, consoleIdentity, wrapper.identity())
+	})
+
+	t.Run("ConfirmDestructive", func(t *testing.T) {
+		wrapper := NewStdinWrapper(nil)
+		wrapper.confirmDestructive = true
+
+		sent := runConsole(wrapper, "ban griefer\nn\ndeop steve\ny\ngive steve dirt\n")
+
+		assert.Equal(t, "deop steve\ngive steve dirt\n", sent)
+	})
+
+	t.Run("ExecuteIsGatedLikeItsCommand", func(t *testing.T) {
+		wrapper := NewStdinWrapper(nil)
+		wrapper.operators = operators
+
+		sent := runConsole(wrapper, "execute as @a run op steve\n"+
+			"execute if entity @e[name=run] run execute at @s run /give steve diamond\n"+
+			"execute as @a at @s run say hello\n")
+
+		assert.Equal(t, "execute as @a at @s run say hello\n", sent)
+	})
+
+	t.Run("ExecuteNeedsWhitelistedCommand", func(t *testing.T) {
+		wrapper := NewStdinWrapper(nil)
+		wrapper.restrict("", []string{"execute", "say"})
+
+		sent := runConsole(wrapper, "execute run stop\nexecute as @a run say hi\n")
+
+		assert.Equal(t, "execute as @a run say hi\n", sent)
+	})
+
+	t.Run("UnsaltedHashIsRefused", func(t *testing.T) {
+		sum := sha256.Sum256([]byte("hunter2"))
+		wrapper := NewStdinWrapper(nil)
+		wrapper.operators = map[string]string{"alice": hex.EncodeToString(sum[:])}
+
+		sent := runConsole(wrapper, "login alice hunter2\nop steve\n")
+
+		assert.Empty(t, sent)
+	})
+}
+
+func TestCommandNames(t *testing.T) {
+	assert.Equal(t, []string{"op"}, commandNames("OP steve"))
+	assert.Equal(t, []string{"give"}, commandNames("/give steve diamond"))
+	assert.Equal(t, []string{""}, commandNames("   "))
+	assert.Equal(t, []string{"execute", "ban"}, commandNames("execute as @p RUN /Ban griefer"))
+	assert.Equal(t, []string{"execute", "execute", "deop"}, commandNames("execute run execute positioned 0 0 0 run deop steve"))
+	assert.Equal(t, []string{"execute"}, commandNames("execute as @a run"))
+}
@@ -0,0 +1,66 @@
+package bds
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// restrict locks the console behind a passphrase and limits the commands it sends without an
+// operator login, pin is the keys.HashSecret hash of the passphrase and an empty pin never locks,
+// empty commands allow every command
+func (sw *StdinWrapper) restrict(pin string, commands []string) {
+	sw.pin = pin
+	sw.locked = pin != ""
+
+	sw.allowed = nil
+	for _, command := range commands {
+		if sw.allowed == nil {
+			sw.allowed = make(map[string]bool)
+		}
+		sw.allowed[strings.ToLower(strings.TrimPrefix(command, "/"))] = true
+	}
+}
+
+// handleLock processes lock and unlock, and swallows everything else while the console is locked
+func (sw *StdinWrapper) handleLock(command string) bool {
+	if sw.pin == "" {
+		return false
+	}
+
+	fields := strings.Fields(command)
+	switch {
+	case strings.ToLower(fields[0]) == "unlock":
+		if len(fields) != 2 {
+			fmt.Println("Usage: unlock <passphrase>")
+			return true
+		}
+		if err := checkSecret(sw.pin, fields[1], "console pin"); err != nil {
+			logger.Infof("Audit: failed console unlock: %v", err)
+			fmt.Println("Unlock failed")
+			return true
+		}
+		if sw.locked {
+			logger.Infof("Audit: console unlocked")
+			sw.locked = false
+		}
+		return true
+	case strings.ToLower(fields[0]) == "lock":
+		logger.Infof("Audit: console locked by %s", sw.identity())
+		sw.locked = true
+		sw.operator = ""
+		sw.pending = ""
+		return true
+	case sw.locked:
+		fmt.Println("Console is locked, use: unlock <passphrase>")
+		return true
+	default:
+		return false
+	}
+}
+
+// allowedCommand reports whether a command may be sent without a logged in operator
+func (sw *StdinWrapper) allowedCommand(name string) bool {
+	return len(sw.allowed) == 0 || sw.allowed[name] || sw.operator != ""
+}
@@ -0,0 +1,72 @@
+package bds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStdinWrapper_Gate(t *testing.T) {
+	pin := secretHash(t, "s3cret")
+
+	t.Run("LockedUntilUnlocked", func(t *testing.T) {
+		wrapper := NewStdinWrapper(nil)
+		wrapper.restrict(pin, nil)
+
+		sent := runConsole(wrapper, "stop\nunlock wrong\nop steve\nunlock s3cret\nsay hello\n")
+
+		assert.Equal(t, "say hello\n", sent)
+		assert.False(t, wrapper.locked)
+	})
+
+	t.Run("ExitNeedsUnlock", func(t *testing.T) {
+		wrapper := NewStdinWrapper(nil)
+		wrapper.restrict(pin, nil)
+
+		sent := runConsole(wrapper, "exit\n")
+
+		assert.Empty(t, sent)
+		assert.True(t, wrapper.enabled)
+	})
+
+	t.Run("LockLogsOut", func(t *testing.T) {
+		wrapper := NewStdinWrapper(nil)
+		wrapper.operators = map[string]string{"alice": secretHash(t, "hunter2")}
+		wrapper.restrict(pin, nil)
+
+		sent := runConsole(wrapper, "unlock s3cret\nlogin alice hunter2\nlock\nban griefer\n")
+
+		assert.Empty(t, sent)
+		assert.True(t, wrapper.locked)
+		assert.Equal(t, consoleIdentity, wrapper.identity())
+	})
+
+	t.Run("Whitelist", func(t *testing.T) {
+		wrapper := NewStdinWrapper(nil)
+		wrapper.restrict("", []string{"list", "/Say"})
+
+		sent := runConsole(wrapper, "list\nsay hi\nstop\nop steve\nexit\n")
+
+		assert.Equal(t, "list\nsay hi\n", sent)
+		assert.True(t, wrapper.enabled)
+	})
+
+	t.Run("OperatorBypassesWhitelist", func(t *testing.T) {
+		wrapper := NewStdinWrapper(nil)
+		wrapper.operators = map[string]string{"alice": secretHash(t, "hunter2")}
+		wrapper.restrict("", []string{"list"})
+
+		sent := runConsole(wrapper, "login alice hunter2\nstop\n")
+
+		assert.Equal(t, "stop\n", sent)
+	})
+
+	t.Run("NoGate", func(t *testing.T) {
+		wrapper := NewStdinWrapper(nil)
+		wrapper.restrict("", nil)
+
+		sent := runConsole(wrapper, "unlock x\nstop\n")
+
+		assert.Equal(t, "unlock x\nstop\n", sent)
+	})
+}
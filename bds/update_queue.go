@@ -0,0 +1,290 @@
+package bds
+
+import (
+	"context"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/crash"
+	"github.com/d1nch8g/consensuscraft/logger"
+	"github.com/d1nch8g/consensuscraft/tracing"
+)
+
+// defaultUpdateQueueSize is the number of pending updates kept per player before coalescing
+const defaultUpdateQueueSize = 16
+
+// defaultShedThreshold is the number of pending updates over all players past which load is shed
+const defaultShedThreshold = 1000
+
+// queuedUpdate is an inventory update read from the server output and waiting to be stored
+type queuedUpdate struct {
+	update    InventoryUpdate
+	event     EventSequence
+	sequenced bool
+	ctx       context.Context // Trace of the ingested line
+	release   func()          // Lifts the write fence of the player once stored or replaced
+
+	superseded []*queuedUpdate // Pending updates of the player this one replaced
+	settled    bool            // Stored or superseded by a stored update
+	applied    bool            // Stored without error
+}
+
+// updateQueue stores inventory updates in order per player, off the log readers
+// When a player's queue is full the newest pending update is replaced, so the latest
+// ender chest state is never lost and never overtaken by an older one
+// When storing falls behind and the backlog passes the shed threshold, only the latest
+// update of each player is kept until the backlog is down to half the threshold
+type updateQueue struct {
+	mu        sync.Mutex
+	pending   map[string][]*queuedUpdate
+	players   []string // Players with pending updates, served round robin
+	size      int
+	coalesced int
+	store     func(*queuedUpdate)
+	storing   bool       // A goroutine is draining the queue
+	idle      *sync.Cond // Signalled when draining stops
+
+	backlog   int // Pending updates over all players
+	threshold int
+	shedding  time.Time // Start of the current shedding, zero when not shedding
+	shed      int       // Updates dropped for a newer one of their player while shedding
+
+	// Sequenced updates in the order they were read, until they and all before them settled
+	sequence []*queuedUpdate
+}
+
+// newUpdateQueue creates a queue handing updates to store one at a time
+func newUpdateQueue(size, threshold int, store func(*queuedUpdate)) *updateQueue {
+	if size <= 0 {
+		size = defaultUpdateQueueSize
+	}
+	if threshold <= 0 {
+		threshold = defaultShedThreshold
+	}
+
+	q := &updateQueue{
+		pending:   make(map[string][]*queuedUpdate),
+		size:      size,
+		threshold: threshold,
+		store:     store,
+	}
+	q.idle = sync.NewCond(&q.mu)
+	return q
+}
+
+// push queues an update, reporting whether it replaced a pending one because the player's queue was full
+func (q *updateQueue) push(queued *queuedUpdate) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	player := queued.update.PlayerName
+	queue, ok := q.pending[player]
+	if !ok {
+		q.players = append(q.players, player)
+	}
+	if queued.sequenced {
+		q.sequence = append(q.sequence, queued)
+	}
+
+	coalesced := false
+	switch {
+	case !q.shedding.IsZero() && len(queue) > 0:
+		q.shed += len(queue)
+		q.backlog -= len(queue) - 1
+		queued.supersede(queue...)
+		queue = append(queue[:0], queued)
+	case len(queue) >= q.size:
+		queued.supersede(queue[len(queue)-1])
+		queue[len(queue)-1] = queued
+		q.coalesced++
+		coalesced = true
+	default:
+		queue = append(queue, queued)
+		q.backlog++
+	}
+	q.pending[player] = queue
+
+	if q.shedding.IsZero() && q.backlog > q.threshold {
+		q.startShedding()
+	}
+
+	if !q.storing {
+		q.storing = true
+		go q.drain()
+	}
+
+	return coalesced
+}
+
+// supersede makes an update stand in for pending ones it replaces, lifting their write fences
+func (u *queuedUpdate) supersede(replaced ...*queuedUpdate) {
+	for _, old := range replaced {
+		u.superseded = append(u.superseded, old.superseded...)
+		old.superseded = nil
+		u.superseded = append(u.superseded, old)
+		if old.release != nil {
+			old.release()
+		}
+	}
+}
+
+// pop takes the oldest update of the next player in turn, stopping the drain when none is left
+func (q *updateQueue) pop() (*queuedUpdate, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.players) == 0 {
+		q.storing = false
+		q.idle.Broadcast()
+		return nil, false
+	}
+
+	player := q.players[0]
+	q.players = q.players[1:]
+
+	queue := q.pending[player]
+	queued := queue[0]
+	if len(queue) == 1 {
+		delete(q.pending, player)
+	} else {
+		q.pending[player] = queue[1:]
+		q.players = append(q.players, player)
+	}
+
+	q.backlog--
+	if !q.shedding.IsZero() && q.backlog <= q.threshold/2 {
+		logger.Infof("Inventory update backlog down to %d after shedding load for %s, %d updates shed in total",
+			q.backlog, time.Since(q.shedding).Round(time.Second), q.shed)
+		q.shedding = time.Time{}
+	}
+
+	return queued, true
+}
+
+// startShedding coalesces every player's pending updates into their latest one, q.mu must be held
+func (q *updateQueue) startShedding() {
+	q.shedding = time.Now()
+	before := q.backlog
+	for player, queue := range q.pending {
+		if len(queue) > 1 {
+			q.shed += len(queue) - 1
+			latest := queue[len(queue)-1]
+			latest.supersede(queue[:len(queue)-1]...)
+			q.pending[player] = append(queue[:0], latest)
+		}
+	}
+	q.backlog = len(q.pending)
+
+	logger.Errorf("Inventory update backlog of %d passed %d, storing is falling behind: "+
+		"keeping only the latest update of each of %d players", before, q.threshold, len(q.pending))
+}
+
+// drain stores queued updates until the queue is empty
+func (q *updateQueue) drain() {
+	for {
+		queued, ok := q.pop()
+		if !ok {
+			return
+		}
+		q.storeOne(queued)
+	}
+}
+
+// storeOne stores an update, a panicking store loses that update but not the ones after it
+func (q *updateQueue) storeOne(queued *queuedUpdate) {
+	defer func() {
+		if r := recover(); r != nil {
+			crash.Record("bds inventory store", r, debug.Stack())
+			logger.Errorf("Storing the inventory update of %s panicked: %v", queued.update.PlayerName, r)
+			if queued.release != nil {
+				queued.release()
+			}
+			q.settle(queued)
+		}
+	}()
+
+	q.store(queued)
+}
+
+// settle marks an update and those it replaced as done, returning the newest sequenced update
+// that, with every update read before it, is done and can be committed as applied
+func (q *updateQueue) settle(queued *queuedUpdate) (EventSequence, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	queued.settled = true
+	for _, old := range queued.superseded {
+		old.settled = true
+		old.applied = queued.applied
+	}
+
+	var last EventSequence
+	advanced := false
+	for len(q.sequence) > 0 && q.sequence[0].settled {
+		if q.sequence[0].applied {
+			last = q.sequence[0].event
+			advanced = true
+		}
+		q.sequence = q.sequence[1:]
+	}
+	return last, advanced
+}
+
+// wait blocks until every queued update was handed to store
+func (q *updateQueue) wait() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for q.storing {
+		q.idle.Wait()
+	}
+}
+
+// coalescedUpdates returns how many updates were replaced by a newer one because a queue was full
+func (q *updateQueue) coalescedUpdates() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.coalesced
+}
+
+// load returns the pending updates, the updates shed so far and whether load is being shed
+func (q *updateQueue) load() (backlog, shed int, shedding bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.backlog, q.shed, !q.shedding.IsZero()
+}
+
+// queue returns the update queue of the parser, created with the sizes of the first parameters used
+func (op *OutputParser) queue(params Parameters) *updateQueue {
+	op.updatesOnce.Do(func() {
+		op.healthMu.Lock()
+		defer op.healthMu.Unlock()
+		op.updates = newUpdateQueue(params.UpdateQueueSize, params.ShedThreshold, op.store)
+	})
+
+	op.healthMu.Lock()
+	defer op.healthMu.Unlock()
+	return op.updates
+}
+
+// store hands a queued update to the inventory callback, committing its offset once every
+// update read before it is stored too
+func (op *OutputParser) store(queued *queuedUpdate) {
+	ctx, span := tracing.Start(queued.ctx, "bds.inventory_update")
+	defer span.Finish()
+
+	err := op.updatePlayerInventory(ctx, queued.update)
+	if err != nil {
+		span.RecordError(err)
+	}
+	queued.applied = err == nil
+	if queued.release != nil {
+		queued.release()
+	}
+
+	if event, ok := op.updates.settle(queued); ok {
+		if err := op.offsets.commit(event); err != nil {
+			logger.Errorf("Failed to record ingest offset: %v", err)
+		}
+	}
+}
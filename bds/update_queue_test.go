@@ -0,0 +1,208 @@
+package bds
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newHeldQueue creates a queue whose store is busy, so updates stay queued until popped
+func newHeldQueue(size, threshold int) *updateQueue {
+	q := newUpdateQueue(size, threshold, nil)
+	q.storing = true
+	return q
+}
+
+// queued creates a queued update of player
+func queued(player, inventory string) *queuedUpdate {
+	return &queuedUpdate{update: InventoryUpdate{PlayerName: player, Inventory: []byte(inventory)}}
+}
+
+// TestUpdateQueue tests ordering and coalescing of queued updates
+func TestUpdateQueue(t *testing.T) {
+	t.Run("OrderedPerPlayerRoundRobin", func(t *testing.T) {
+		q := newHeldQueue(4, 0)
+		q.push(queued("alice", "a1"))
+		q.push(queued("alice", "a2"))
+		q.push(queued("bob", "b1"))
+		q.push(queued("alice", "a3"))
+
+		var got []string
+		for {
+			update, ok := q.pop()
+			if !ok {
+				break
+			}
+			got = append(got, string(update.update.Inventory))
+		}
+
+		assert.Equal(t, []string{"a1", "b1", "a2", "a3"}, got)
+	})
+
+	t.Run("CoalescesToLatestWhenFull", func(t *testing.T) {
+		q := newHeldQueue(2, 0)
+		released := 0
+		for i := 1; i <= 4; i++ {
+			update := queued("alice", fmt.Sprint(i))
+			update.release = func() { released++ }
+			assert.Equal(t, i > 2, q.push(update))
+		}
+		assert.Equal(t, 2, q.coalescedUpdates())
+		assert.Equal(t, 2, released, "replaced updates lift their write fence")
+
+		first, _ := q.pop()
+		last, _ := q.pop()
+		_, ok := q.pop()
+
+		assert.Equal(t, "1", string(first.update.Inventory))
+		assert.Equal(t, "4", string(last.update.Inventory))
+		assert.Len(t, last.superseded, 2)
+		assert.False(t, ok)
+	})
+
+	t.Run("ShedsToLatestPerPlayerPastThreshold", func(t *testing.T) {
+		q := newHeldQueue(100, 4)
+		q.push(queued("alice", "a1"))
+		q.push(queued("alice", "a2"))
+		q.push(queued("alice", "a3"))
+		q.push(queued("bob", "b1"))
+		q.push(queued("bob", "b2"))
+
+		backlog, shed, shedding := q.load()
+		assert.Equal(t, 2, backlog)
+		assert.Equal(t, 3, shed)
+		assert.True(t, shedding)
+
+		q.push(queued("alice", "a4"))
+		backlog, shed, _ = q.load()
+		assert.Equal(t, 2, backlog)
+		assert.Equal(t, 4, shed)
+
+		first, _ := q.pop()
+		assert.Equal(t, "a4", string(first.update.Inventory))
+		_, _, shedding = q.load()
+		assert.False(t, shedding)
+
+		second, _ := q.pop()
+		assert.Equal(t, "b2", string(second.update.Inventory))
+		_, ok := q.pop()
+		assert.False(t, ok)
+	})
+
+	t.Run("StoresInOrderPerPlayer", func(t *testing.T) {
+		var mu sync.Mutex
+		got := map[string][]string{}
+		q := newUpdateQueue(100, 0, func(u *queuedUpdate) {
+			mu.Lock()
+			defer mu.Unlock()
+			got[u.update.PlayerName] = append(got[u.update.PlayerName], string(u.update.Inventory))
+		})
+
+		var want []string
+		for i := 0; i < 50; i++ {
+			want = append(want, fmt.Sprint(i))
+			q.push(queued("alice", fmt.Sprint(i)))
+			q.push(queued("bob", fmt.Sprint(i)))
+		}
+		q.wait()
+
+		assert.Equal(t, want, got["alice"])
+		assert.Equal(t, want, got["bob"])
+		backlog, _, _ := q.load()
+		assert.Zero(t, backlog)
+	})
+
+	t.Run("ShedsWhileStoringLagsAndRecovers", func(t *testing.T) {
+		gate := make(chan struct{})
+		var mu sync.Mutex
+		got := map[string][]string{}
+		q := newUpdateQueue(100, 10, func(u *queuedUpdate) {
+			<-gate
+			mu.Lock()
+			defer mu.Unlock()
+			got[u.update.PlayerName] = append(got[u.update.PlayerName], string(u.update.Inventory))
+		})
+
+		// The store is held on the first update while 3 players log 20 updates each
+		for i := 0; i < 20; i++ {
+			for _, player := range []string{"alice", "bob", "carol"} {
+				q.push(queued(player, fmt.Sprint(i)))
+			}
+		}
+		backlog, shed, shedding := q.load()
+		assert.True(t, shedding)
+		assert.LessOrEqual(t, backlog, 10)
+		assert.Positive(t, shed)
+
+		close(gate)
+		q.wait()
+
+		backlog, _, shedding = q.load()
+		assert.Zero(t, backlog)
+		assert.False(t, shedding, "shedding ends once the store caught up")
+		for _, player := range []string{"alice", "bob", "carol"} {
+			stored := got[player]
+			require.NotEmpty(t, stored)
+			assert.Equal(t, "19", stored[len(stored)-1], "the latest update of %s is stored", player)
+			assert.Less(t, len(stored), 20)
+		}
+
+		// Once recovered updates are no longer shed
+		_, shedBefore, _ := q.load()
+		q.push(queued("alice", "20"))
+		q.push(queued("alice", "21"))
+		q.wait()
+		_, shedAfter, _ := q.load()
+		assert.Equal(t, shedBefore, shedAfter)
+		assert.Equal(t, []string{"20", "21"}, got["alice"][len(got["alice"])-2:])
+	})
+
+	t.Run("StoreSurvivesPanic", func(t *testing.T) {
+		var stored []string
+		q := newUpdateQueue(100, 0, func(u *queuedUpdate) {
+			if u.update.PlayerName == "Crasher" {
+				panic("bad payload")
+			}
+			stored = append(stored, u.update.PlayerName)
+		})
+
+		q.push(queued("Crasher", "[]"))
+		q.push(queued("TestPlayer", "[]"))
+		q.wait()
+
+		assert.Equal(t, []string{"TestPlayer"}, stored)
+	})
+
+	t.Run("SettlesInReadOrder", func(t *testing.T) {
+		q := newHeldQueue(1, 0)
+		sequenced := func(player string, seq uint64) *queuedUpdate {
+			u := queued(player, "[]")
+			u.event, u.sequenced = EventSequence{Run: "run1", Seq: seq}, true
+			return u
+		}
+		alice1, bob2, alice3, alice4 := sequenced("alice", 1), sequenced("bob", 2), sequenced("alice", 3), sequenced("alice", 4)
+		for _, u := range []*queuedUpdate{alice1, bob2, alice3, alice4} {
+			q.push(u)
+		}
+
+		// Bob's update waits for Alice's first one read before it
+		bob2.applied = true
+		_, ok := q.settle(bob2)
+		assert.False(t, ok)
+
+		alice1.applied = true
+		event, ok := q.settle(alice1)
+		require.True(t, ok)
+		assert.Equal(t, uint64(2), event.Seq)
+
+		// Storing the update that replaced the third settles it too
+		alice4.applied = true
+		event, ok = q.settle(alice4)
+		require.True(t, ok)
+		assert.Equal(t, uint64(4), event.Seq)
+		assert.Empty(t, q.sequence)
+	})
+}
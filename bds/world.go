@@ -0,0 +1,144 @@
+package bds
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// WorldSettings is the world metadata published to peers so the network can verify
+// every member runs the agreed ruleset
+type WorldSettings struct {
+	SeedHash      string // SHA-256 of the world seed, the seed itself is not revealed, empty when unknown
+	Difficulty    string
+	Gamemode      string
+	ForceGamemode bool
+	AllowCheats   bool
+	PackHash      string // Hash of the verified mcpack, see PackManifest
+	Port          int    // IPv4 port players connect to, advertised only and not compared between worlds
+}
+
+// ReadWorldSettings reads world settings from a BDS server.properties file
+func ReadWorldSettings(path string) (*WorldSettings, error) {
+	properties, err := readProperties(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WorldSettings{
+		SeedHash:      worldSeedHash(filepath.Dir(path), properties),
+		Difficulty:    properties["difficulty"],
+		Gamemode:      properties["gamemode"],
+		ForceGamemode: properties["force-gamemode"] == "true",
+		AllowCheats:   properties["allow-cheats"] == "true",
+		Port:          propertyPort(properties, "server-port", DefaultPort),
+	}, nil
+}
+
+// worldSeedHash hashes the seed of the selected world: the seed saved in its level.dat once it was
+// generated, since BDS ignores level-seed for existing worlds and draws a random seed when it is
+// blank, and level-seed before that, empty when neither tells the seed
+func worldSeedHash(dir string, properties map[string]string) string {
+	levelName := properties["level-name"]
+	if levelName == "" {
+		levelName = "Bedrock level"
+	}
+
+	seed := properties["level-seed"]
+	levelDat := filepath.Join(dir, worldsDir, levelName, "level.dat")
+	saved, err := readLevelSeed(levelDat)
+	switch {
+	case err == nil:
+		seed = strconv.FormatInt(saved, 10)
+	case !errors.Is(err, os.ErrNotExist):
+		logger.Warnf("Unable to read the seed of world %s: %v", levelName, err)
+	}
+	if seed == "" {
+		logger.Warnf("The seed of world %s is unknown until it is generated, peers cannot check it", levelName)
+		return ""
+	}
+
+	seedHash := sha256.Sum256([]byte(seed))
+	return hex.EncodeToString(seedHash[:])
+}
+
+// readProperties reads the key=value pairs of a server.properties file
+func readProperties(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open server properties: %w", err)
+	}
+	defer file.Close()
+
+	properties := make(map[string]string)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		properties[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read server properties: %w", err)
+	}
+	return properties, nil
+}
+
+// Mismatches lists the settings that differ from another world, empty when both agree
+func (w *WorldSettings) Mismatches(other *WorldSettings) []string {
+	var mismatches []string
+
+	if w.SeedHash == "" || other.SeedHash == "" {
+		mismatches = append(mismatches, "seed unknown")
+	} else if w.SeedHash != other.SeedHash {
+		mismatches = append(mismatches, "seed")
+	}
+	if w.Difficulty != other.Difficulty {
+		mismatches = append(mismatches, fmt.Sprintf("difficulty %q != %q", w.Difficulty, other.Difficulty))
+	}
+	if w.Gamemode != other.Gamemode {
+		mismatches = append(mismatches, fmt.Sprintf("gamemode %q != %q", w.Gamemode, other.Gamemode))
+	}
+	if w.ForceGamemode != other.ForceGamemode {
+		mismatches = append(mismatches, fmt.Sprintf("force-gamemode %t != %t", w.ForceGamemode, other.ForceGamemode))
+	}
+	if w.AllowCheats != other.AllowCheats {
+		mismatches = append(mismatches, fmt.Sprintf("allow-cheats %t != %t", w.AllowCheats, other.AllowCheats))
+	}
+	if w.PackHash != other.PackHash {
+		mismatches = append(mismatches, fmt.Sprintf("pack %q != %q", w.PackHash, other.PackHash))
+	}
+
+	return mismatches
+}
+
+// WorldSettings reads the settings of the managed server along with the hash of its mcpack
+func (b *Bds) WorldSettings() (*WorldSettings, error) {
+	settings, err := ReadWorldSettings(filepath.Join(filepath.Dir(b.server.serverPath), "server.properties"))
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, err := EmbeddedPackManifest(b.trustedPackKeys)
+	if err != nil {
+		return nil, err
+	}
+	settings.PackHash = manifest.PackHash
+
+	return settings, nil
+}
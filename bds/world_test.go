@@ -0,0 +1,92 @@
+package bds
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadWorldSettings(t *testing.T) {
+	t.Run("ParsesProperties", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "server.properties")
+		require.NoError(t, os.WriteFile(path, []byte(`# Comment line
+server-name=Dedicated Server
+gamemode=survival
+force-gamemode=true
+difficulty=normal
+allow-cheats=false
+level-seed=12345
+server-port=19140
+`), 0644))
+
+		settings, err := ReadWorldSettings(path)
+		require.NoError(t, err)
+
+		assert.Equal(t, "survival", settings.Gamemode)
+		assert.Equal(t, "normal", settings.Difficulty)
+		assert.True(t, settings.ForceGamemode)
+		assert.False(t, settings.AllowCheats)
+		assert.Equal(t, 19140, settings.Port)
+		assert.Len(t, settings.SeedHash, 64)
+		assert.NotContains(t, settings.SeedHash, "12345")
+	})
+
+	t.Run("GeneratedWorldSeed", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "server.properties")
+		require.NoError(t, os.WriteFile(path, []byte("level-name=Survival\nlevel-seed=\n"), 0644))
+
+		// A blank seed is unknown until the world is generated
+		settings, err := ReadWorldSettings(path)
+		require.NoError(t, err)
+		assert.Empty(t, settings.SeedHash)
+
+		// then the seed BDS drew is read from level.dat
+		writeLevelDat(t, filepath.Join(dir, "worlds", "Survival", "level.dat"), 12345)
+		drawn, err := ReadWorldSettings(path)
+		require.NoError(t, err)
+		assert.Len(t, drawn.SeedHash, 64)
+
+		// which matches a node that set that seed explicitly
+		other := filepath.Join(t.TempDir(), "server.properties")
+		require.NoError(t, os.WriteFile(other, []byte("level-seed=12345\n"), 0644))
+		explicit, err := ReadWorldSettings(other)
+		require.NoError(t, err)
+		assert.Equal(t, explicit.SeedHash, drawn.SeedHash)
+
+		// and level-seed no longer counts once the world exists
+		require.NoError(t, os.WriteFile(path, []byte("level-name=Survival\nlevel-seed=999\n"), 0644))
+		changed, err := ReadWorldSettings(path)
+		require.NoError(t, err)
+		assert.Equal(t, drawn.SeedHash, changed.SeedHash)
+	})
+
+	t.Run("MissingFile", func(t *testing.T) {
+		settings, err := ReadWorldSettings(filepath.Join(t.TempDir(), "server.properties"))
+		assert.Error(t, err)
+		assert.Nil(t, settings)
+	})
+}
+
+func TestWorldSettings_Mismatches(t *testing.T) {
+	local := &WorldSettings{SeedHash: "a", Difficulty: "normal", Gamemode: "survival", ForceGamemode: true}
+
+	assert.Empty(t, local.Mismatches(&WorldSettings{SeedHash: "a", Difficulty: "normal", Gamemode: "survival", ForceGamemode: true, Port: 19140}))
+
+	mismatches := local.Mismatches(&WorldSettings{SeedHash: "b", Difficulty: "peaceful", Gamemode: "creative", AllowCheats: true, PackHash: "c0ffee"})
+	assert.Equal(t, []string{
+		"seed",
+		`difficulty "normal" != "peaceful"`,
+		`gamemode "survival" != "creative"`,
+		"force-gamemode true != false",
+		"allow-cheats false != true",
+		`pack "" != "c0ffee"`,
+	}, mismatches)
+
+	// Worlds whose seed is unknown never agree on it
+	unknown := &WorldSettings{Difficulty: "normal", Gamemode: "survival", ForceGamemode: true}
+	assert.Equal(t, []string{"seed unknown"}, unknown.Mismatches(unknown))
+}
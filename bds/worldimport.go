@@ -0,0 +1,228 @@
+package bds
+
+import (
+	"archive/zip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// Limits on a world template, a template over them is refused rather than filling the disk
+const (
+	maxWorldFiles = 100000
+	maxWorldBytes = 8 << 30
+)
+
+// worldsDir holds the worlds of the server, next to its executable in the working directory
+const worldsDir = "worlds"
+
+var ErrInvalidWorldTemplate = errors.New("invalid world template")
+
+// SetWorldTemplate imports the .mcworld at path on first start, checksum is its expected hex
+// SHA-256 and is not checked when empty
+func (s *Setup) SetWorldTemplate(path, checksum string) {
+	s.worldTemplate = path
+	s.worldChecksum = strings.ToLower(checksum)
+}
+
+// EnsureWorld imports the world template into worlds/ and selects it with level-name, unless a
+// world already exists, so a new network member starts from the network's standard world
+// It must run before EnsurePack, which creates a default world when there is none
+func (s *Setup) EnsureWorld() error {
+	if s.worldTemplate == "" {
+		return nil
+	}
+
+	existing, err := os.ReadDir(worldsDir)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to read worlds directory: %w", err)
+	}
+	for _, entry := range existing {
+		if entry.IsDir() && !strings.HasPrefix(entry.Name(), ".") {
+			logger.Printf("World template skipped, %s already holds world %s", worldsDir, entry.Name())
+			return nil
+		}
+	}
+
+	if err := verifyChecksum(s.worldTemplate, s.worldChecksum); err != nil {
+		return err
+	}
+
+	name, err := importWorld(s.worldTemplate, worldsDir)
+	if err != nil {
+		return err
+	}
+
+	if err := writeProperties("server.properties", map[string]string{"level-name": name}); err != nil {
+		return err
+	}
+	logger.Printf("Imported world %s from %s", name, s.worldTemplate)
+	return nil
+}
+
+// verifyChecksum compares the SHA-256 of a file with the expected hex digest, an empty digest is not checked
+func verifyChecksum(path, checksum string) error {
+	if checksum == "" {
+		return nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open world template: %w", err)
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return fmt.Errorf("failed to read world template: %w", err)
+	}
+	if sum := hex.EncodeToString(hash.Sum(nil)); sum != checksum {
+		return fmt.Errorf("%w: sha256 %s, expected %s", ErrInvalidWorldTemplate, sum, checksum)
+	}
+	return nil
+}
+
+// importWorld extracts a .mcworld into a new directory of dir named after the world, returning the name
+// The archive is checked before anything is written: level.dat must be at its root, no path may leave
+// the world directory and its size is bounded, it is extracted to a hidden directory renamed once complete
+func importWorld(template, dir string) (string, error) {
+	reader, err := zip.OpenReader(template)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidWorldTemplate, err)
+	}
+	defer reader.Close()
+
+	name, err := checkWorldArchive(&reader.Reader, template)
+	if err != nil {
+		return "", err
+	}
+
+	target := filepath.Join(dir, name)
+	staging := filepath.Join(dir, "."+name+".import")
+	if err := os.RemoveAll(staging); err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(staging, 0755); err != nil {
+		return "", fmt.Errorf("failed to create world directory: %w", err)
+	}
+
+	for _, file := range reader.File {
+		destPath := filepath.Join(staging, filepath.FromSlash(file.Name))
+		if file.FileInfo().IsDir() {
+			if err := os.MkdirAll(destPath, 0755); err != nil {
+				os.RemoveAll(staging)
+				return "", fmt.Errorf("failed to create directory %s: %w", file.Name, err)
+			}
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(destPath), 0755); err != nil {
+			os.RemoveAll(staging)
+			return "", fmt.Errorf("failed to create directory for %s: %w", file.Name, err)
+		}
+		if err := extractWorldFile(file, destPath); err != nil {
+			os.RemoveAll(staging)
+			return "", fmt.Errorf("failed to extract %s: %w", file.Name, err)
+		}
+	}
+
+	if err := os.Rename(staging, target); err != nil {
+		os.RemoveAll(staging)
+		return "", fmt.Errorf("failed to move imported world in place: %w", err)
+	}
+	return name, nil
+}
+
+// checkWorldArchive validates the entries of a .mcworld and returns the world name, read from
+// levelname.txt and falling back to the template file name
+func checkWorldArchive(reader *zip.Reader, template string) (string, error) {
+	if len(reader.File) > maxWorldFiles {
+		return "", fmt.Errorf("%w: more than %d files", ErrInvalidWorldTemplate, maxWorldFiles)
+	}
+
+	var total uint64
+	hasLevel := false
+	name := ""
+	for _, file := range reader.File {
+		clean := path.Clean(file.Name)
+		if path.IsAbs(file.Name) || clean == ".." || strings.HasPrefix(clean, "../") || strings.Contains(file.Name, `\`) {
+			return "", fmt.Errorf("%w: unsafe path %s", ErrInvalidWorldTemplate, file.Name)
+		}
+		if !file.Mode().IsRegular() && !file.FileInfo().IsDir() {
+			return "", fmt.Errorf("%w: %s is not a regular file", ErrInvalidWorldTemplate, file.Name)
+		}
+
+		total += file.UncompressedSize64
+		if total > maxWorldBytes {
+			return "", fmt.Errorf("%w: larger than %d bytes", ErrInvalidWorldTemplate, uint64(maxWorldBytes))
+		}
+
+		switch clean {
+		case "level.dat":
+			hasLevel = true
+		case "levelname.txt":
+			levelName, err := readWorldName(file)
+			if err != nil {
+				return "", err
+			}
+			name = levelName
+		}
+	}
+	if !hasLevel {
+		return "", fmt.Errorf("%w: no level.dat at the root of the archive", ErrInvalidWorldTemplate)
+	}
+
+	if name == "" {
+		name = strings.TrimSuffix(filepath.Base(template), filepath.Ext(template))
+	}
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\:`) || strings.HasPrefix(name, ".") {
+		return "", fmt.Errorf("%w: unusable world name %q", ErrInvalidWorldTemplate, name)
+	}
+	return name, nil
+}
+
+// readWorldName reads the world name stored in levelname.txt
+func readWorldName(file *zip.File) (string, error) {
+	rc, err := file.Open()
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidWorldTemplate, err)
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(io.LimitReader(rc, 256))
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidWorldTemplate, err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// extractWorldFile writes a single world file, reading no more than its declared size
+func extractWorldFile(file *zip.File, destPath string) error {
+	rc, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	outFile, err := os.OpenFile(destPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	defer outFile.Close()
+
+	written, err := io.Copy(outFile, io.LimitReader(rc, int64(file.UncompressedSize64)+1))
+	if err != nil {
+		return err
+	}
+	if uint64(written) != file.UncompressedSize64 {
+		return fmt.Errorf("%w: %s does not match its declared size", ErrInvalidWorldTemplate, file.Name)
+	}
+	return nil
+}
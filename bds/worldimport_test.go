@@ -0,0 +1,134 @@
+package bds
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeWorldTemplate writes a .mcworld holding files and returns its path and SHA-256
+func writeWorldTemplate(t *testing.T, dir string, files map[string]string) (string, string) {
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := archive.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, archive.Close())
+
+	path := filepath.Join(dir, "network.mcworld")
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0644))
+	sum := sha256.Sum256(buf.Bytes())
+	return path, hex.EncodeToString(sum[:])
+}
+
+func TestSetup_EnsureWorld(t *testing.T) {
+	world := map[string]string{
+		"level.dat":       "level",
+		"levelname.txt":   "Network World\n",
+		"db/CURRENT":      "MANIFEST-000001",
+		"db/000001.ldb":   "data",
+		"world_icon.jpeg": "icon",
+	}
+
+	setupDir := func(t *testing.T) string {
+		originalDir, err := os.Getwd()
+		require.NoError(t, err)
+		dir := t.TempDir()
+		require.NoError(t, os.Chdir(dir))
+		t.Cleanup(func() { os.Chdir(originalDir) })
+		require.NoError(t, os.WriteFile("server.properties", []byte("level-name=Bedrock level\nserver-port=19132\n"), 0644))
+		return dir
+	}
+
+	t.Run("imports the template on first start", func(t *testing.T) {
+		dir := setupDir(t)
+		template, sum := writeWorldTemplate(t, t.TempDir(), world)
+
+		setup := NewSetup()
+		setup.SetWorldTemplate(template, sum)
+		require.NoError(t, setup.EnsureWorld())
+
+		data, err := os.ReadFile(filepath.Join(dir, "worlds", "Network World", "db", "000001.ldb"))
+		require.NoError(t, err)
+		assert.Equal(t, "data", string(data))
+
+		properties, err := readProperties("server.properties")
+		require.NoError(t, err)
+		assert.Equal(t, "Network World", properties["level-name"])
+		assert.Equal(t, "19132", properties["server-port"])
+
+		entries, err := os.ReadDir("worlds")
+		require.NoError(t, err)
+		assert.Len(t, entries, 1)
+	})
+
+	t.Run("existing world is kept", func(t *testing.T) {
+		setupDir(t)
+		require.NoError(t, os.MkdirAll(filepath.Join("worlds", "Bedrock level"), 0755))
+		template, _ := writeWorldTemplate(t, t.TempDir(), world)
+
+		setup := NewSetup()
+		setup.SetWorldTemplate(template, "")
+		require.NoError(t, setup.EnsureWorld())
+
+		_, err := os.Stat(filepath.Join("worlds", "Network World"))
+		assert.ErrorIs(t, err, os.ErrNotExist)
+		properties, err := readProperties("server.properties")
+		require.NoError(t, err)
+		assert.Equal(t, "Bedrock level", properties["level-name"])
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		setupDir(t)
+		template, _ := writeWorldTemplate(t, t.TempDir(), world)
+
+		setup := NewSetup()
+		setup.SetWorldTemplate(template, "00")
+		assert.ErrorIs(t, setup.EnsureWorld(), ErrInvalidWorldTemplate)
+		_, err := os.Stat("worlds")
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	t.Run("no template configured", func(t *testing.T) {
+		setupDir(t)
+		assert.NoError(t, NewSetup().EnsureWorld())
+	})
+}
+
+func TestImportWorld_Invalid(t *testing.T) {
+	for name, files := range map[string]map[string]string{
+		"missing level.dat": {"levelname.txt": "World"},
+		"path traversal":    {"level.dat": "level", "../escape.txt": "x"},
+		"absolute path":     {"level.dat": "level", "/etc/passwd": "x"},
+		"unusable name":     {"level.dat": "level", "levelname.txt": "../World"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			template, _ := writeWorldTemplate(t, t.TempDir(), files)
+			dir := t.TempDir()
+
+			_, err := importWorld(template, dir)
+			assert.ErrorIs(t, err, ErrInvalidWorldTemplate)
+
+			entries, err := os.ReadDir(dir)
+			require.NoError(t, err)
+			assert.Empty(t, entries)
+		})
+	}
+
+	t.Run("name from the file name", func(t *testing.T) {
+		template, _ := writeWorldTemplate(t, t.TempDir(), map[string]string{"level.dat": "level"})
+		name, err := importWorld(template, t.TempDir())
+		require.NoError(t, err)
+		assert.Equal(t, "network", name)
+	})
+}
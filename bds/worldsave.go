@@ -0,0 +1,90 @@
+package bds
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WorldSavedCallback is called when the server reports a completed world save
+type WorldSavedCallback func(save WorldSave)
+
+// WorldSave describes a world save reported by the server, e.g. after save hold and save query
+type WorldSave struct {
+	StartedAt   time.Time   // "Saving..." was logged, world changes are held from here, zero when not seen
+	CompletedAt time.Time   // "Data saved" was logged
+	Files       []WorldFile // Files ready to be copied, as listed by save query
+}
+
+// At returns the time the saved world state corresponds to
+func (s WorldSave) At() time.Time {
+	if s.StartedAt.IsZero() {
+		return s.CompletedAt
+	}
+	return s.StartedAt
+}
+
+// WorldFile is a world file with the length that belongs to the save, longer files must be truncated
+type WorldFile struct {
+	Path string
+	Size int64
+}
+
+var (
+	saveStartedRegex   = regexp.MustCompile(`\bSaving\.\.\.`)
+	saveCompletedRegex = regexp.MustCompile(`\bData saved\b`)
+	saveFileRegex      = regexp.MustCompile(`^(.+):(\d+)$`)
+)
+
+// worldSaveTracker follows the save messages of one output stream, the file list follows
+// "Data saved" on the next line so a completed save is only reported with the line after it
+type worldSaveTracker struct {
+	started   time.Time
+	completed *WorldSave
+}
+
+// line processes a log line and returns the save it completes, if any
+func (t *worldSaveTracker) line(line string, now time.Time) *WorldSave {
+	var done *WorldSave
+	if t.completed != nil {
+		done, t.completed = t.completed, nil
+		if files, ok := parseSaveFiles(line); ok {
+			done.Files = files
+			return done
+		}
+	}
+
+	switch {
+	case saveStartedRegex.MatchString(line):
+		t.started = now
+	case saveCompletedRegex.MatchString(line):
+		t.completed = &WorldSave{StartedAt: t.started, CompletedAt: now}
+		t.started = time.Time{}
+	}
+	return done
+}
+
+// flush returns a completed save still waiting for its file list
+func (t *worldSaveTracker) flush() *WorldSave {
+	save := t.completed
+	t.completed = nil
+	return save
+}
+
+// parseSaveFiles parses the "path:size, path:size" list printed by save query
+func parseSaveFiles(line string) ([]WorldFile, bool) {
+	var files []WorldFile
+	for _, part := range strings.Split(strings.TrimSpace(line), ", ") {
+		matches := saveFileRegex.FindStringSubmatch(part)
+		if matches == nil {
+			return nil, false
+		}
+		size, err := strconv.ParseInt(matches[2], 10, 64)
+		if err != nil {
+			return nil, false
+		}
+		files = append(files, WorldFile{Path: matches[1], Size: size})
+	}
+	return files, len(files) > 0
+}
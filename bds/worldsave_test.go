@@ -0,0 +1,67 @@
+package bds
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorldSaveTracker(t *testing.T) {
+	start := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	tracker := &worldSaveTracker{}
+
+	assert.Nil(t, tracker.line("[2025-03-01 12:00:00:000 INFO] Saving...", start))
+	assert.Nil(t, tracker.line("[2025-03-01 12:00:02:000 INFO] Data saved. Files are now ready to be copied.", start.Add(2*time.Second)))
+
+	save := tracker.line("Bedrock level/db/000005.ldb:1234, Bedrock level/level.dat:2345", start.Add(2*time.Second))
+	require.NotNil(t, save)
+	assert.Equal(t, start, save.StartedAt)
+	assert.Equal(t, start, save.At())
+	assert.Equal(t, []WorldFile{
+		{Path: "Bedrock level/db/000005.ldb", Size: 1234},
+		{Path: "Bedrock level/level.dat", Size: 2345},
+	}, save.Files)
+
+	// A save without a file list is reported with the next line, which is still parsed
+	assert.Nil(t, tracker.line("Data saved", start.Add(time.Minute)))
+	save = tracker.line("Saving...", start.Add(time.Minute))
+	require.NotNil(t, save)
+	assert.Empty(t, save.Files)
+	assert.Equal(t, start.Add(time.Minute), save.At(), "without Saving... the completion time is used")
+	assert.Nil(t, tracker.line("Data saved", start.Add(2*time.Minute)))
+	save = tracker.flush()
+	require.NotNil(t, save)
+	assert.Equal(t, start.Add(time.Minute), save.StartedAt)
+	assert.Nil(t, tracker.flush())
+}
+
+func TestOutputParser_WorldSaved(t *testing.T) {
+	lm := NewOutputParser(
+		func(playerName string) ([]byte, error) { return nil, nil },
+		func(playerName string, inventory []byte) error { return nil },
+	)
+
+	saves := make(chan WorldSave, 1)
+	params := Parameters{
+		WorldSavedCallback: func(save WorldSave) { saves <- save },
+	}
+
+	input := "Saving...\nData saved. Files are now ready to be copied.\nBedrock level/level.dat:2345\n"
+	require.NoError(t, lm.monitorServerLogs(strings.NewReader(input), params, nopWriteCloser{io.Discard}))
+
+	select {
+	case save := <-saves:
+		assert.Equal(t, []WorldFile{{Path: "Bedrock level/level.dat", Size: 2345}}, save.Files)
+		assert.False(t, save.StartedAt.IsZero())
+	case <-time.After(time.Second):
+		t.Fatal("world save was not reported")
+	}
+}
+
+type nopWriteCloser struct{ io.Writer }
+
+func (nopWriteCloser) Close() error { return nil }
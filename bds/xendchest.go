@@ -2,11 +2,14 @@ package bds
 
 import (
 	"archive/zip"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/d1nch8g/consensuscraft/logger"
@@ -32,6 +35,7 @@ type Manifest struct {
 type McpackInstaller struct {
 	behaviorPackUUID string
 	resourcePackUUID string
+	trustedKeys      []string // Keys allowed to sign the pack manifest, any valid signature when empty
 }
 
 // NewMcpackInstaller creates a new mcpack installer
@@ -353,8 +357,50 @@ func (mi *McpackInstaller) addPackToWorldConfig(configFile string, packUUID stri
 	return nil
 }
 
+// installedPackDiffers returns the first installed file not matching the manifest, empty when the
+// installed pack is the embedded one
+func installedPackDiffers(manifest *PackManifest, behaviorDir, resourceDir string) string {
+	names := make([]string, 0, len(manifest.Files))
+	for name := range manifest.Files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		var path string
+		if after, ok := strings.CutPrefix(name, "behavior_pack/"); ok {
+			path = filepath.Join(behaviorDir, after)
+		} else if after, ok := strings.CutPrefix(name, "resource_pack/"); ok {
+			path = filepath.Join(resourceDir, after)
+		} else {
+			continue
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return path
+		}
+		sum := sha256.Sum256(data)
+		if hex.EncodeToString(sum[:]) != manifest.Files[name] {
+			return path
+		}
+	}
+	return ""
+}
+
 // EnsureMcpackInstalled ensures the mcpack is installed and activated
 func (mi *McpackInstaller) EnsureMcpackInstalled() error {
+	// Never install a pack that does not match its release manifest
+	manifest, err := EmbeddedPackManifest(mi.trustedKeys)
+	if err != nil {
+		return fmt.Errorf("refusing to install mcpack: %w", err)
+	}
+	if how, ok := manifest.SignatureVerified(mi.trustedKeys); ok {
+		logger.Printf("Verified mcpack %s %s, pack hash %s", manifest.Version, how, manifest.PackHash)
+	} else {
+		logger.Warnf("Installing mcpack %s unverified: %s, pack hash %s", manifest.Version, how, manifest.PackHash)
+	}
+
 	// First, get the current UUIDs from the embedded mcpack
 	if err := mi.getPackUUIDs(); err != nil {
 		return fmt.Errorf("failed to get pack UUIDs: %w", err)
@@ -409,6 +455,15 @@ func (mi *McpackInstaller) EnsureMcpackInstalled() error {
 		needsReinstall = true
 	}
 
+	// Same UUIDs do not mean the same scripts, a pack installed by an older build would keep
+	// stamping items in the format of that build
+	if !needsReinstall {
+		if stale := installedPackDiffers(manifest, behaviorDir, resourceDir); stale != "" {
+			logger.Warnf("Installed pack differs from the embedded one at %s - reinstalling...", stale)
+			needsReinstall = true
+		}
+	}
+
 	if needsReinstall {
 		logger.Println("Pack UUIDs don't match or packs missing - reinstalling...")
 		// Clean up old pack directories
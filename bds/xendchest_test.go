@@ -480,4 +480,25 @@ func TestMcpackInstaller_RealWorld(t *testing.T) {
 		assert.NotEqual(t, "different-behavior-uuid", finalBehaviorHeader["uuid"])
 		assert.NotEqual(t, "different-resource-uuid", finalResourceHeader["uuid"])
 	})
+
+	t.Run("EnsureMcpackInstalled_StaleScripts", func(t *testing.T) {
+		tempDir := t.TempDir()
+		originalDir, _ := os.Getwd()
+		os.Chdir(tempDir)
+		defer os.Chdir(originalDir)
+
+		installer := NewMcpackInstaller()
+		require.NoError(t, installer.EnsureMcpackInstalled())
+
+		// A pack of an older build keeps its UUIDs but stamps the default origin format
+		script := filepath.Join("behavior_packs", "x_ender_chest", "scripts", "shulker_box.js")
+		fresh, err := os.ReadFile(script)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(script, []byte(`const ORIGIN = "Origin: ";`), 0644))
+
+		require.NoError(t, installer.EnsureMcpackInstalled())
+		installed, err := os.ReadFile(script)
+		require.NoError(t, err)
+		assert.Equal(t, fresh, installed)
+	})
 }
@@ -0,0 +1,84 @@
+// Package buildinfo describes the running build, so operators can see which peers need to upgrade
+// Release builds set Version, Commit and Date with
+// -ldflags "-X github.com/d1nch8g/consensuscraft/buildinfo.Version=v1.2.3 ..."
+package buildinfo
+
+import (
+	"encoding/json"
+	"runtime"
+	"runtime/debug"
+
+	"github.com/d1nch8g/consensuscraft/gen/xendchest"
+)
+
+// Set at link time, Commit and Date fall back to the version control stamp of the build
+var (
+	Version = "dev"
+	Commit  = ""
+	Date    = ""
+)
+
+// Protocol is the peer protocol version spoken by this build, version 2 runs over TLS with node key
+// certificates and signs a nonce and time into the handshake
+const Protocol = 2
+
+// Protocols are the peer protocol versions this build can talk, oldest first
+var Protocols = []uint32{Protocol}
+
+// Info is the identity of a build
+type Info struct {
+	Version     string   `json:"version"`
+	Commit      string   `json:"commit,omitempty"`
+	BuildDate   string   `json:"build_date,omitempty"`
+	PackVersion string   `json:"pack_version,omitempty"` // Version of the embedded x_ender_chest pack
+	Protocols   []uint32 `json:"protocols"`
+	GoVersion   string   `json:"go_version,omitempty"`
+}
+
+// Get returns the identity of the running build
+func Get() Info {
+	info := Info{
+		Version:     Version,
+		Commit:      Commit,
+		BuildDate:   Date,
+		PackVersion: packVersion(),
+		Protocols:   Protocols,
+		GoVersion:   runtime.Version(),
+	}
+
+	if build, ok := debug.ReadBuildInfo(); ok {
+		if info.Version == "dev" && build.Main.Version != "" && build.Main.Version != "(devel)" {
+			info.Version = build.Main.Version
+		}
+		for _, setting := range build.Settings {
+			switch {
+			case setting.Key == "vcs.revision" && info.Commit == "":
+				info.Commit = setting.Value
+			case setting.Key == "vcs.time" && info.BuildDate == "":
+				info.BuildDate = setting.Value
+			}
+		}
+	}
+	return info
+}
+
+// Supports reports whether the build speaks a protocol version
+func (i Info) Supports(protocol uint32) bool {
+	for _, supported := range i.Protocols {
+		if supported == protocol {
+			return true
+		}
+	}
+	return false
+}
+
+// packVersion reads the version of the embedded pack from its release manifest
+func packVersion() string {
+	var manifest struct {
+		Version string `json:"version"`
+	}
+	if err := json.Unmarshal(xendchest.Manifest, &manifest); err != nil {
+		return ""
+	}
+	return manifest.Version
+}
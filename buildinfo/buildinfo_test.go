@@ -0,0 +1,32 @@
+package buildinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGet(t *testing.T) {
+	info := Get()
+	assert.NotEmpty(t, info.Version)
+	assert.Equal(t, "1.0.0", info.PackVersion)
+	assert.Contains(t, info.Protocols, uint32(Protocol))
+	assert.NotEmpty(t, info.GoVersion)
+
+	t.Run("link time values", func(t *testing.T) {
+		previous := [3]string{Version, Commit, Date}
+		t.Cleanup(func() { Version, Commit, Date = previous[0], previous[1], previous[2] })
+
+		Version, Commit, Date = "v1.2.3", "abc123", "2026-01-02T03:04:05Z"
+		info := Get()
+		assert.Equal(t, "v1.2.3", info.Version)
+		assert.Equal(t, "abc123", info.Commit)
+		assert.Equal(t, "2026-01-02T03:04:05Z", info.BuildDate)
+	})
+}
+
+func TestInfo_Supports(t *testing.T) {
+	info := Info{Protocols: []uint32{1, 2}}
+	assert.True(t, info.Supports(2))
+	assert.False(t, info.Supports(3))
+}
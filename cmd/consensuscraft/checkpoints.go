@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/d1nch8g/consensuscraft/config"
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/node"
+)
+
+// checkpointsCommand lists checkpoints or restores the inventories of one
+func checkpointsCommand(cfg *config.Config, args []string) error {
+	checkpoints := database.OpenCheckpoints(node.CheckpointsFile, cfg.CheckpointRetention)
+
+	switch {
+	case len(args) == 0:
+		list, err := checkpoints.List()
+		if err != nil {
+			return err
+		}
+		if len(list) == 0 {
+			fmt.Println("No world save checkpoints recorded")
+			return nil
+		}
+		for _, checkpoint := range list {
+			fmt.Printf("%s  saved %s  %d world files\n", checkpoint.ID, checkpoint.At.Local().Format("2006-01-02 15:04:05"), len(checkpoint.WorldFiles))
+		}
+		return nil
+	case len(args) == 2 && args[0] == "restore":
+	default:
+		return errUsage
+	}
+
+	checkpoint, err := checkpoints.Get(args[1])
+	if err != nil {
+		return err
+	}
+
+	db, err := database.New(node.DatabasePath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	report, err := db.RestoreCheckpoint(*checkpoint)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Set %d ender chests back to checkpoint %s\n", len(report.Restored), checkpoint.ID)
+	for _, player := range report.Restored {
+		fmt.Printf("  %s\n", player)
+	}
+	if len(report.Moved) > 0 {
+		fmt.Printf("%d players were updated on other servers since, review them by hand:\n", len(report.Moved))
+		for _, player := range report.Moved {
+			fmt.Printf("  %s\n", player)
+		}
+	}
+	if len(report.Archived) > 0 {
+		fmt.Printf("%d players have their state at the checkpoint in the cold store and were left as they are:\n", len(report.Archived))
+		for _, player := range report.Archived {
+			fmt.Printf("  %s\n", player)
+		}
+	}
+	if len(checkpoint.WorldFiles) > 0 {
+		fmt.Println("World files of the save, truncate each copy to its size:")
+		for _, file := range checkpoint.WorldFiles {
+			fmt.Printf("  %s:%d\n", file.Path, file.Size)
+		}
+	}
+	return nil
+}
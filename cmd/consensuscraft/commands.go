@@ -0,0 +1,185 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/d1nch8g/consensuscraft/config"
+)
+
+// command is a maintenance subcommand run instead of the node
+type command struct {
+	usage       string
+	description string
+	run         func(cfg *config.Config, args []string) error
+}
+
+// errUsage is returned by commands invoked with wrong arguments
+var errUsage = errors.New("invalid arguments")
+
+var commands = map[string]command{
+	"bans": {
+		usage:       "bans [export <file> | import <file>]",
+		description: "List bans of the running node, export them as a signed ban list or import the ban list of an allied network",
+		run:         bans,
+	},
+	"checkpoints": {
+		usage:       "checkpoints [restore <id>]",
+		description: "List world save checkpoints, or set ender chests back to a checkpoint after restoring its world files, the node must be stopped",
+		run:         checkpointsCommand,
+	},
+	"db": {
+		usage:       "db repair",
+		description: "Recover a corrupted database and quarantine unreadable player records, the node must be stopped",
+		run:         dbCommand,
+	},
+	"delete-player": {
+		usage:       "delete-player <player>",
+		description: "Remove all inventory records of one player, other players are untouched",
+		run:         deletePlayer,
+	},
+	"diff": {
+		usage:       "diff <player> --peer <address>",
+		description: "Compare the latest inventory of a player on the running node with the one held by the peer at address, slot by slot and item totals",
+		run:         diffPlayer,
+	},
+	"economy-snapshot": {
+		usage:       "economy-snapshot <file>",
+		description: "Save current item totals per origin server to a JSON file",
+		run:         economySnapshot,
+	},
+	"economy-diff": {
+		usage:       "economy-diff <snapshot file|date> <snapshot file|date> [max growth]",
+		description: "List net item creation by server and item type, flagging growth above max growth (default 0.5)",
+		run:         economyDiff,
+	},
+	"export": {
+		usage:       "export <file> [--server <server>] [--since <RFC3339>] [--until <RFC3339>]",
+		description: "Append player records of the running node to an NDJSON file, resuming after the last record when the file exists",
+		run:         export,
+	},
+	"freeze": {
+		usage:       "freeze <on <reason>|off|endorse <id>|status>",
+		description: "Propose or endorse an emergency freeze of inventory updates on all nodes, effective once FREEZE_QUORUM of FREEZE_SIGNERS signed it",
+		run:         freezeCommand,
+	},
+	"group": {
+		usage:       "group <group> [members <player>...]",
+		description: "Show the members and access audit of a shared inventory of the running node, or create it and set its members",
+		run:         group,
+	},
+	"inventory-at": {
+		usage:       "inventory-at <player> <RFC3339|YYYY-MM-DD>",
+		description: "Show the inventory a player had at a point in time in the history of the running node, for settling disputes after rollbacks",
+		run:         inventoryAt,
+	},
+	"maintenance": {
+		usage:       "maintenance <on [reason]|off|status>",
+		description: "Switch maintenance mode of the running node through the admin API, only MAINTENANCE_PLAYERS stay on the server",
+		run:         maintenance,
+	},
+	"netconfig": {
+		usage:       "netconfig [publish <file> | accept <version>]",
+		description: "Show the network configuration of the running node, publish the settings in a JSON file as its next version from a NETWORK_CONFIG_SIGNERS node, or accept a version awaiting review",
+		run:         netconfig,
+	},
+	"notices": {
+		usage:       "notices [text]",
+		description: "List operator notices of this node and its peers, or sign and send one to every peer",
+		run:         notices,
+	},
+	"reshard": {
+		usage:       "reshard <web address=dial address,...> [--delete]",
+		description: "Push local players owned by other members of a new shard layout to them, --delete removes them here afterwards",
+		run:         reshard,
+	},
+	"split-key": {
+		usage:       "split-key <threshold> <shares> <output dir>",
+		description: "Split the node private key into Shamir shares for operators",
+		run:         splitKey,
+	},
+	"combine-key": {
+		usage:       "combine-key <share file> <share file> [share file...]",
+		description: "Reconstitute the node private key from operator shares",
+		run:         combineKey,
+	},
+	"backup-identity": {
+		usage:       "backup-identity <file>",
+		description: "Write the node keys, pinned peer keys and .env to a passphrase-encrypted bundle, IDENTITY_PASSPHRASE skips the prompt",
+		run:         backupIdentity,
+	},
+	"hash-secret": {
+		usage:       "hash-secret",
+		description: "Prompt for an operator secret or console passphrase and print its salted hash for CONSOLE_OPERATORS or CONSOLE_PIN",
+		run:         hashSecret,
+	},
+	"restore-identity": {
+		usage:       "restore-identity <file> [--force]",
+		description: "Restore a bundle written by backup-identity, --force replaces existing keys and .env",
+		run:         restoreIdentity,
+	},
+	"restore-server": {
+		usage:       "restore-server <server>",
+		description: "Reinstate the items removed by the ban of a server within BAN_SNAPSHOT_DAYS of the ban, the node must be stopped",
+		run:         restoreServer,
+	},
+	"rotate-keys": {
+		usage:       "rotate-keys [--yes]",
+		description: "Replace the node key pair with a new one signed by the current key, peers pin it on their next handshake",
+		run:         rotateKeys,
+	},
+	"selftest": {
+		usage:       "selftest",
+		description: "Check keys, database, log parsing, item validation and the handshake with CONNECTED_NODE, printing a report for support requests",
+		run:         selftest,
+	},
+	"shell": {
+		usage:       "shell [admin address]",
+		description: "Open an interactive shell over the admin API with history and tab completion",
+		run:         shell,
+	},
+	"version": {
+		usage:       "version",
+		description: "Print the version, commit, build date, pack version and peer protocols of this build",
+		run:         version,
+	},
+}
+
+// runCommand executes a subcommand by name
+func runCommand(cfg *config.Config, name string, args []string) error {
+	if name == "help" || name == "-h" || name == "--help" {
+		printUsage()
+		return nil
+	}
+
+	cmd, ok := commands[name]
+	if !ok {
+		printUsage()
+		return fmt.Errorf("unknown command")
+	}
+
+	err := cmd.run(cfg, args)
+	if errors.Is(err, errUsage) {
+		fmt.Fprintf(os.Stderr, "Usage: consensuscraft %s\n", cmd.usage)
+	}
+	return err
+}
+
+// printUsage lists every available subcommand
+func printUsage() {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(os.Stderr, "Usage: consensuscraft [command]")
+	fmt.Fprintln(os.Stderr, "Without a command the node is started.")
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "Commands:")
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %s\n      %s\n", commands[name].usage, commands[name].description)
+	}
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/d1nch8g/consensuscraft/config"
+)
+
+// subcommandWords are the fixed second words of commands that take them
+var subcommandWords = map[string][]string{
+	"db":          {"repair"},
+	"completion":  {"bash", "zsh"},
+	"freeze":      {"on", "off", "endorse", "status"},
+	"maintenance": {"on", "off", "status"},
+}
+
+// The completion command lists the commands map, so it is registered once the map exists
+func init() {
+	commands["completion"] = command{
+		usage:       "completion <bash|zsh>",
+		description: "Print a shell completion script, e.g. source <(consensuscraft completion bash)",
+		run:         completion,
+	}
+}
+
+// completion prints a completion script for the given shell
+func completion(_ *config.Config, args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+
+	switch args[0] {
+	case "bash":
+		writeBashCompletion(os.Stdout)
+	case "zsh":
+		writeZshCompletion(os.Stdout)
+	default:
+		return errUsage
+	}
+	return nil
+}
+
+func commandNames() []string {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func writeBashCompletion(out io.Writer) {
+	fmt.Fprintln(out, "# bash completion for consensuscraft")
+	fmt.Fprintln(out, "_consensuscraft() {")
+	fmt.Fprintln(out, `	local cur="${COMP_WORDS[COMP_CWORD]}"`)
+	fmt.Fprintln(out, "	if [ \"$COMP_CWORD\" -eq 1 ]; then")
+	fmt.Fprintf(out, "\t\tCOMPREPLY=($(compgen -W %q -- \"$cur\"))\n", strings.Join(append(commandNames(), "help"), " "))
+	fmt.Fprintln(out, "\t\treturn")
+	fmt.Fprintln(out, "\tfi")
+	fmt.Fprintln(out, `	case "${COMP_WORDS[1]}" in`)
+	for _, name := range sortedKeys(subcommandWords) {
+		fmt.Fprintf(out, "\t%s) [ \"$COMP_CWORD\" -eq 2 ] && COMPREPLY=($(compgen -W %q -- \"$cur\")) ;;\n", name, strings.Join(subcommandWords[name], " "))
+	}
+	fmt.Fprintln(out, "\t*) COMPREPLY=($(compgen -f -- \"$cur\")) ;;")
+	fmt.Fprintln(out, "\tesac")
+	fmt.Fprintln(out, "}")
+	fmt.Fprintln(out, "complete -F _consensuscraft consensuscraft")
+}
+
+func writeZshCompletion(out io.Writer) {
+	fmt.Fprintln(out, "#compdef consensuscraft")
+	fmt.Fprintln(out, "_consensuscraft() {")
+	fmt.Fprintln(out, "\tlocal -a cmds")
+	fmt.Fprintln(out, "\tcmds=(")
+	for _, name := range commandNames() {
+		fmt.Fprintf(out, "\t\t%s\n", zshQuote(name+":"+commands[name].description))
+	}
+	fmt.Fprintln(out, "\t\t'help:Show usage'")
+	fmt.Fprintln(out, "\t)")
+	fmt.Fprintln(out, "\tif (( CURRENT == 2 )); then")
+	fmt.Fprintln(out, "\t\t_describe 'command' cmds")
+	fmt.Fprintln(out, "\t\treturn")
+	fmt.Fprintln(out, "\tfi")
+	fmt.Fprintln(out, "\tcase ${words[2]} in")
+	for _, name := range sortedKeys(subcommandWords) {
+		fmt.Fprintf(out, "\t%s) (( CURRENT == 3 )) && compadd -- %s ;;\n", name, strings.Join(subcommandWords[name], " "))
+	}
+	fmt.Fprintln(out, "\t*) _files ;;")
+	fmt.Fprintln(out, "\tesac")
+	fmt.Fprintln(out, "}")
+	fmt.Fprintln(out, "compdef _consensuscraft consensuscraft")
+}
+
+// zshQuote single quotes a word for zsh
+func zshQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/d1nch8g/consensuscraft/config"
+	"github.com/d1nch8g/consensuscraft/database"
+)
+
+// dbCommand runs database maintenance, currently only repair
+func dbCommand(cfg *config.Config, args []string) error {
+	if len(args) != 1 || args[0] != "repair" {
+		return errUsage
+	}
+
+	report, err := database.Repair("inventories.ldb")
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Scanned %d player records\n", report.Scanned)
+	if len(report.Quarantined) == 0 {
+		fmt.Println("No unreadable records found")
+		return nil
+	}
+
+	fmt.Printf("Quarantined %d unreadable records to %s:\n", len(report.Quarantined), database.QuarantineDir("inventories.ldb"))
+	for _, player := range report.Quarantined {
+		fmt.Printf("  %s\n", player)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/config"
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/keys"
+	"github.com/d1nch8g/consensuscraft/network"
+)
+
+// diffTimeout bounds the request of the player record to the peer
+const diffTimeout = 30 * time.Second
+
+// diffPlayer compares the latest inventory of a player on the running node with the latest one a peer
+// holds, for debugging sync divergence reported by players
+func diffPlayer(cfg *config.Config, args []string) error {
+	if len(args) != 3 || args[1] != "--peer" {
+		return errUsage
+	}
+	player, peer := args[0], args[2]
+	if cfg.AdminAddress == "" {
+		return fmt.Errorf("no admin address configured")
+	}
+
+	var local database.InventoryEntry
+	query := url.Values{"at": {time.Now().Format(time.RFC3339)}}
+	if err := newAdminClient(cfg.AdminAddress, cfg.AdminToken).get("/api/players/"+url.PathEscape(player)+"/inventory", query, &local); err != nil {
+		return fmt.Errorf("unable to read %s on this node: %w", player, err)
+	}
+
+	km, err := keys.New(cfg.WebAddress)
+	if err != nil {
+		return fmt.Errorf("unable to load node keys: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), diffTimeout)
+	defer cancel()
+	records, err := network.GetPlayers(ctx, peer, cfg.WebAddress, km, []string{player})
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		return fmt.Errorf("%s holds no record of %s", peer, player)
+	}
+	remote, err := database.LatestRecordEntry(records[0].GetValue())
+	if err != nil {
+		return fmt.Errorf("unable to read the record of %s on %s: %w", player, peer, err)
+	}
+
+	diff, err := database.DiffInventories(local.Inventory, remote.Inventory)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Local:  latest entry of %s recorded by %s at %s\n", player, local.Server, local.Timestamp.Format(time.RFC3339))
+	fmt.Printf("Remote: latest entry of %s on %s recorded by %s at %s\n", player, peer, remote.Server, remote.Timestamp.Format(time.RFC3339))
+	if diff.Empty() {
+		fmt.Println("\nBoth views hold the same items")
+		return nil
+	}
+
+	fmt.Printf("\nSlots (%d differ):\n", len(diff.Slots))
+	for _, slot := range diff.Slots {
+		fmt.Printf("  slot %d", slot.Slot)
+		if len(slot.Fields) > 0 {
+			fmt.Printf(", fields differing: %v", slot.Fields)
+		}
+		fmt.Printf("\n    local:  %s\n    remote: %s\n", slot.Local, slot.Remote)
+	}
+
+	fmt.Printf("\nItem totals by origin (%d differ):\n", len(diff.Counts))
+	for _, count := range diff.Counts {
+		fmt.Printf("  %-50s local %6d  remote %6d  %+6d\n", count.Server+" "+count.TypeID, count.Local, count.Remote, count.Remote-count.Local)
+	}
+	return nil
+}
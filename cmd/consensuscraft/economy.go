@@ -0,0 +1,137 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/config"
+	"github.com/d1nch8g/consensuscraft/database"
+)
+
+// economySnapshot saves the current item totals to a JSON file for later comparison
+func economySnapshot(cfg *config.Config, args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+
+	db, err := database.New("inventories.ldb")
+	if err != nil {
+		return fmt.Errorf("unable to open inventories database: %w", err)
+	}
+	defer db.Close()
+
+	snapshot, err := db.EconomySnapshot(time.Now())
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(args[0], data, 0644); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+
+	fmt.Printf("Saved snapshot of %d players to %s\n", snapshot.Players, args[0])
+	return nil
+}
+
+// economyDiff prints net item creation between two snapshot files or dates
+func economyDiff(cfg *config.Config, args []string) error {
+	if len(args) < 2 || len(args) > 3 {
+		return errUsage
+	}
+
+	maxGrowth := 0.5
+	if len(args) == 3 {
+		value, err := strconv.ParseFloat(args[2], 64)
+		if err != nil {
+			return fmt.Errorf("invalid max growth %q: %w", args[2], err)
+		}
+		maxGrowth = value
+	}
+
+	var db *database.DB
+	defer func() {
+		if db != nil {
+			db.Close()
+		}
+	}()
+
+	load := func(arg string) (*database.EconomySnapshot, error) {
+		if data, err := os.ReadFile(arg); err == nil {
+			var snapshot database.EconomySnapshot
+			if err := json.Unmarshal(data, &snapshot); err != nil {
+				return nil, fmt.Errorf("invalid snapshot %s: %w", arg, err)
+			}
+			return &snapshot, nil
+		}
+
+		at, err := parseTime(arg)
+		if err != nil {
+			return nil, err
+		}
+
+		if db == nil {
+			if db, err = database.New("inventories.ldb"); err != nil {
+				return nil, fmt.Errorf("unable to open inventories database: %w", err)
+			}
+		}
+		return db.EconomySnapshot(at)
+	}
+
+	before, err := load(args[0])
+	if err != nil {
+		return err
+	}
+
+	after, err := load(args[1])
+	if err != nil {
+		return err
+	}
+
+	diff := database.DiffEconomy(before, after, maxGrowth)
+
+	fmt.Printf("Economy changes from %s to %s (anomaly above %+.0f%%)\n", diff.From.Format(time.RFC3339), diff.To.Format(time.RFC3339), maxGrowth*100)
+	printEconomyChanges("By server", diff.ByServer, func(c database.EconomyChange) string { return c.Server })
+	printEconomyChanges("By item", diff.ByItem, func(c database.EconomyChange) string { return c.TypeID })
+	printEconomyChanges("Details", diff.Changes, func(c database.EconomyChange) string { return c.Server + " " + c.TypeID })
+
+	return nil
+}
+
+func printEconomyChanges(title string, changes []database.EconomyChange, label func(database.EconomyChange) string) {
+	fmt.Printf("\n%s:\n", title)
+	for _, change := range changes {
+		growth := "new"
+		if !math.IsInf(change.Growth, 1) {
+			growth = fmt.Sprintf("%+.1f%%", change.Growth*100)
+		}
+
+		marker := ""
+		if change.Anomaly {
+			marker = "  ANOMALY"
+		}
+
+		fmt.Printf("  %-50s %8d -> %8d  net %+8d  %9s%s\n", label(change), change.Before, change.After, change.Net, growth, marker)
+	}
+}
+
+// parseTime accepts RFC3339 timestamps or plain dates
+func parseTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	if t, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		return t, nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time %q, expected RFC3339 or YYYY-MM-DD", value)
+}
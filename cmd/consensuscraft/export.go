@@ -0,0 +1,125 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/d1nch8g/consensuscraft/config"
+	"github.com/d1nch8g/consensuscraft/database"
+)
+
+// exportFlags are the filters accepted by the export command
+var exportFlags = []string{"--server", "--since", "--until"}
+
+// export appends the NDJSON export of the running node to a file, an existing file is resumed
+// after its last complete record so an interrupted export is simply run again
+func export(cfg *config.Config, args []string) error {
+	if len(args) == 0 || strings.HasPrefix(args[0], "--") {
+		return errUsage
+	}
+	if cfg.AdminAddress == "" {
+		return fmt.Errorf("no admin address configured")
+	}
+
+	query := url.Values{}
+	for i := 1; i < len(args); i += 2 {
+		if !slices.Contains(exportFlags, args[i]) || i+1 == len(args) {
+			return errUsage
+		}
+		query.Set(strings.TrimPrefix(args[i], "--"), args[i+1])
+	}
+
+	file, err := os.OpenFile(args[0], os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	cursor, err := resumeExport(file)
+	if err != nil {
+		return err
+	}
+	if cursor != "" {
+		query.Set("cursor", cursor)
+		fmt.Printf("Resuming export into %s\n", args[0])
+	}
+
+	client := newAdminClient(cfg.AdminAddress, cfg.AdminToken)
+	req, err := http.NewRequest(http.MethodGet, client.base+"/api/export?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	if client.token != "" {
+		req.Header.Set("Authorization", "Bearer "+client.token)
+	}
+
+	// Exports are paced by the node and take as long as they take
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	// Only complete lines are written, so the file always ends on a record
+	records := 0
+	reader := bufio.NewReader(resp.Body)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 && line[len(line)-1] == '\n' {
+			if _, err := file.Write(line); err != nil {
+				return err
+			}
+			records++
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("export interrupted after %d records, run it again to resume: %w", records, err)
+		}
+	}
+
+	fmt.Printf("Exported %d player records to %s\n", records, args[0])
+	return nil
+}
+
+// resumeExport returns the cursor of the last record in an export file, cutting off a partial
+// last line, and leaves the file positioned at its end
+func resumeExport(file *os.File) (string, error) {
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return "", err
+	}
+
+	end := bytes.LastIndexByte(data, '\n') + 1
+	if end < len(data) {
+		if err := file.Truncate(int64(end)); err != nil {
+			return "", err
+		}
+	}
+	if _, err := file.Seek(int64(end), io.SeekStart); err != nil {
+		return "", err
+	}
+	if end == 0 {
+		return "", nil
+	}
+
+	last := data[bytes.LastIndexByte(data[:end-1], '\n')+1 : end]
+	var record database.ExportRecord
+	if err := json.Unmarshal(last, &record); err != nil || record.Cursor == "" {
+		return "", fmt.Errorf("%s does not end with an export record", file.Name())
+	}
+	return record.Cursor, nil
+}
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/config"
+	"github.com/d1nch8g/consensuscraft/keys"
+)
+
+// passphraseEnv supplies the bundle passphrase to scripted backups instead of the prompt
+const passphraseEnv = "IDENTITY_PASSPHRASE"
+
+// configFile is bundled with the keys, it holds WEB_ADDRESS and the rest of the node settings
+const configFile = ".env"
+
+// backupIdentity writes an encrypted bundle of the node keys, pinned peer keys and configuration
+func backupIdentity(cfg *config.Config, args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+
+	bundle, err := keys.CollectIdentity(cfg.WebAddress, configFile)
+	if err != nil {
+		return err
+	}
+
+	stdin := bufio.NewReader(os.Stdin)
+	passphrase, err := readPassphrase("Passphrase: ", stdin)
+	if err != nil {
+		return err
+	}
+	if os.Getenv(passphraseEnv) == "" {
+		confirm, err := readPassphrase("Repeat passphrase: ", stdin)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(passphrase, confirm) {
+			return fmt.Errorf("passphrases do not match")
+		}
+	}
+
+	sealed, err := keys.SealBundle(bundle, passphrase)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(args[0], sealed, 0600); err != nil {
+		return fmt.Errorf("failed to write bundle: %w", err)
+	}
+
+	fmt.Printf("Backed up the identity of %s to %s:\n", cfg.WebAddress, args[0])
+	for _, path := range bundle.Paths() {
+		fmt.Printf("  %s\n", path)
+	}
+	return nil
+}
+
+// restoreIdentity unpacks a bundle written by backup-identity into the node directory
+func restoreIdentity(_ *config.Config, args []string) error {
+	force := len(args) == 2 && args[1] == "--force"
+	if len(args) != 1 && !force {
+		return errUsage
+	}
+
+	sealed, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to read bundle: %w", err)
+	}
+
+	passphrase, err := readPassphrase("Passphrase: ", bufio.NewReader(os.Stdin))
+	if err != nil {
+		return err
+	}
+
+	bundle, err := keys.OpenBundle(sealed, passphrase)
+	if err != nil {
+		return err
+	}
+	if err := bundle.Restore(force); err != nil {
+		if errors.Is(err, keys.ErrBundleExists) {
+			return fmt.Errorf("%w, pass --force to replace them", err)
+		}
+		return err
+	}
+
+	fmt.Printf("Restored the identity of %s from %s, backed up %s\n", bundle.WebAddress, args[0], bundle.CreatedAt.Format("2006-01-02 15:04:05"))
+	for _, path := range bundle.Paths() {
+		fmt.Printf("  %s\n", path)
+	}
+	return nil
+}
+
+// readPassphrase takes the passphrase from IDENTITY_PASSPHRASE, or prompts for it without echo
+// on a terminal and reads a plain line otherwise
+func readPassphrase(prompt string, stdin *bufio.Reader) ([]byte, error) {
+	if passphrase := os.Getenv(passphraseEnv); passphrase != "" {
+		return []byte(passphrase), nil
+	}
+	return readSecret(prompt, stdin)
+}
+
+// readSecret prompts for a secret without echo on a terminal and reads a plain line otherwise
+func readSecret(prompt string, stdin *bufio.Reader) ([]byte, error) {
+	fmt.Print(prompt)
+	restore, err := makeRaw(int(os.Stdin.Fd()))
+	if err != nil {
+		line, err := stdin.ReadString('\n')
+		if err != nil && (!errors.Is(err, io.EOF) || line == "") {
+			return nil, fmt.Errorf("failed to read passphrase: %w", err)
+		}
+		return []byte(strings.TrimRight(line, "\r\n")), nil
+	}
+	defer fmt.Print("\r\n")
+	defer restore()
+
+	var passphrase []byte
+	for {
+		b, err := stdin.ReadByte()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read passphrase: %w", err)
+		}
+		switch b {
+		case '\r', '\n':
+			return passphrase, nil
+		case 3, 4: // Ctrl-C and Ctrl-D
+			return nil, fmt.Errorf("cancelled")
+		case 127, 8:
+			if len(passphrase) > 0 {
+				passphrase = passphrase[:len(passphrase)-1]
+			}
+		default:
+			passphrase = append(passphrase, b)
+		}
+	}
+}
+
+// hashSecret prints the hash of an operator secret or console passphrase for CONSOLE_OPERATORS
+// and CONSOLE_PIN, the secret is prompted for so it stays out of the shell history
+func hashSecret(_ *config.Config, args []string) error {
+	if len(args) != 0 {
+		return errUsage
+	}
+
+	stdin := bufio.NewReader(os.Stdin)
+	secret, err := readSecret("Secret: ", stdin)
+	if err != nil {
+		return err
+	}
+	if len(secret) == 0 {
+		return fmt.Errorf("secret is empty")
+	}
+	confirm, err := readSecret("Repeat secret: ", stdin)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(secret, confirm) {
+		return fmt.Errorf("secrets do not match")
+	}
+
+	hash, err := keys.HashSecret(string(secret))
+	if err != nil {
+		return err
+	}
+	fmt.Println(hash)
+	return nil
+}
+
+// rotateKeys replaces the node key pair after showing its age and what rotating does, peers pin the
+// new key from the rotation it presents in its handshakes
+func rotateKeys(cfg *config.Config, args []string) error {
+	yes := len(args) == 1 && args[0] == "--yes"
+	if len(args) > 1 || (len(args) == 1 && !yes) {
+		return errUsage
+	}
+
+	km, err := keys.New(cfg.WebAddress)
+	if err != nil {
+		return fmt.Errorf("failed to load keys: %w", err)
+	}
+
+	keys.SetKeyLifetime(time.Duration(cfg.KeyMaxAgeDays)*24*time.Hour, time.Duration(cfg.KeyRotationWarnDays)*24*time.Hour)
+	credentials, err := keys.Credentials(time.Now())
+	if err != nil {
+		return err
+	}
+	for _, credential := range credentials {
+		if credential.Own {
+			fmt.Printf("Key %s of %s is in use since %s", credential.Key, cfg.WebAddress, credential.Since.Format("2006-01-02"))
+			if !credential.ExpiresAt.IsZero() {
+				fmt.Printf(", rotate by %s", credential.ExpiresAt.Format("2006-01-02"))
+			}
+			fmt.Println()
+		}
+	}
+
+	fmt.Println("Rotating generates a new key pair and signs it with the current key, peers that pinned the")
+	fmt.Println("current key pin the new one on their next handshake with this node. The current pair is kept")
+	fmt.Println("in keys/ with a .previous suffix.")
+	if !yes {
+		fmt.Printf("Rotate the key of %s? [y/N] ", cfg.WebAddress)
+		answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+		if !strings.EqualFold(strings.TrimSpace(answer), "y") {
+			fmt.Println("Key left unchanged")
+			return nil
+		}
+	}
+
+	rotation, err := km.Rotate()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Rotated the key of %s to %x at %s. Next:\n", cfg.WebAddress, rotation.Current[:8], rotation.At.Format("2006-01-02 15:04:05"))
+	fmt.Println("  1. Restart the node, it signs with the previous key until then")
+	fmt.Println("  2. Watch the peers reconnect, a peer that missed an earlier rotation refuses the new key")
+	fmt.Println("     until its operator removes the pinned key of this node from its keys/")
+	fmt.Println("  3. Run backup-identity again, and split-key if the key is split, older backups and shares")
+	fmt.Println("     restore the previous key")
+	return nil
+}
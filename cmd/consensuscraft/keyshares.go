@@ -0,0 +1,83 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/d1nch8g/consensuscraft/config"
+	"github.com/d1nch8g/consensuscraft/keys"
+)
+
+// splitKey writes one hex encoded share file per operator
+func splitKey(cfg *config.Config, args []string) error {
+	if len(args) != 3 {
+		return errUsage
+	}
+
+	threshold, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid threshold %q: %w", args[0], err)
+	}
+
+	count, err := strconv.Atoi(args[1])
+	if err != nil {
+		return fmt.Errorf("invalid shares %q: %w", args[1], err)
+	}
+
+	km, err := keys.New(cfg.WebAddress)
+	if err != nil {
+		return fmt.Errorf("failed to load keys: %w", err)
+	}
+
+	shares, err := km.Split(threshold, count)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(args[2], 0700); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	for i, share := range shares {
+		path := filepath.Join(args[2], fmt.Sprintf("share-%d-of-%d.txt", i+1, count))
+		if err := os.WriteFile(path, []byte(hex.EncodeToString(share)+"\n"), 0600); err != nil {
+			return fmt.Errorf("failed to write share: %w", err)
+		}
+		fmt.Printf("Wrote %s\n", path)
+	}
+
+	fmt.Printf("Any %d of %d shares restore the identity of %s\n", threshold, count, cfg.WebAddress)
+	return nil
+}
+
+// combineKey restores the node identity from share files
+func combineKey(cfg *config.Config, args []string) error {
+	if len(args) < 2 {
+		return errUsage
+	}
+
+	var shares [][]byte
+	for _, path := range args {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read share: %w", err)
+		}
+
+		share, err := hex.DecodeString(strings.TrimSpace(string(data)))
+		if err != nil {
+			return fmt.Errorf("invalid share %s: %w", path, err)
+		}
+		shares = append(shares, share)
+	}
+
+	if _, err := keys.Restore(cfg.WebAddress, shares); err != nil {
+		return err
+	}
+
+	fmt.Printf("Restored identity of %s into keys/\n", cfg.WebAddress)
+	return nil
+}
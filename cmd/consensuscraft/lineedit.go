@@ -0,0 +1,173 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// maxHistory caps the number of lines kept in the shell history
+const maxHistory = 500
+
+// lineEditor reads lines from a terminal in raw mode, with history on the arrow keys and
+// tab completion of the word being typed
+type lineEditor struct {
+	in      *bufio.Reader
+	out     io.Writer
+	history []string
+
+	// complete returns the candidates for word, args are the words typed before it
+	complete func(args []string, word string) []string
+}
+
+func newLineEditor(in io.Reader, out io.Writer, complete func(args []string, word string) []string) *lineEditor {
+	return &lineEditor{in: bufio.NewReader(in), out: out, complete: complete}
+}
+
+// readLine reads one line, returning io.EOF on ctrl-d at an empty prompt
+func (e *lineEditor) readLine(prompt string) (string, error) {
+	var line []rune
+	recall := len(e.history)
+	fmt.Fprint(e.out, prompt)
+
+	for {
+		r, _, err := e.in.ReadRune()
+		if err != nil {
+			return "", err
+		}
+
+		switch r {
+		case '\r', '\n':
+			fmt.Fprint(e.out, "\r\n")
+			e.remember(string(line))
+			return string(line), nil
+
+		case 3: // ctrl-c drops the line
+			fmt.Fprint(e.out, "^C\r\n"+prompt)
+			line = nil
+			recall = len(e.history)
+
+		case 4: // ctrl-d
+			if len(line) == 0 {
+				fmt.Fprint(e.out, "\r\n")
+				return "", io.EOF
+			}
+
+		case 21: // ctrl-u clears the line
+			line = nil
+			e.redraw(prompt, line)
+
+		case 127, 8: // backspace
+			if len(line) > 0 {
+				line = line[:len(line)-1]
+				fmt.Fprint(e.out, "\b \b")
+			}
+
+		case '\t':
+			line = e.completeLine(prompt, line)
+
+		case 27: // escape sequences, only the up and down arrows are handled
+			if next, _, err := e.in.ReadRune(); err != nil || next != '[' {
+				continue
+			}
+			key, _, err := e.in.ReadRune()
+			if err != nil {
+				continue
+			}
+			switch {
+			case key == 'A' && recall > 0:
+				recall--
+				line = []rune(e.history[recall])
+			case key == 'B' && recall < len(e.history):
+				recall++
+				line = nil
+				if recall < len(e.history) {
+					line = []rune(e.history[recall])
+				}
+			}
+			e.redraw(prompt, line)
+
+		default:
+			if r >= 32 {
+				line = append(line, r)
+				fmt.Fprint(e.out, string(r))
+			}
+		}
+	}
+}
+
+// completeLine completes the last word of line, listing the candidates when it is ambiguous
+func (e *lineEditor) completeLine(prompt string, line []rune) []rune {
+	if e.complete == nil {
+		return line
+	}
+
+	text := string(line)
+	args := strings.Fields(text)
+	word := ""
+	if len(args) > 0 && !strings.HasSuffix(text, " ") {
+		word = args[len(args)-1]
+		args = args[:len(args)-1]
+	}
+
+	candidates := e.complete(args, word)
+	switch len(candidates) {
+	case 0:
+		return line
+	case 1:
+		text = strings.TrimSuffix(text, word) + candidates[0] + " "
+	default:
+		prefix := commonPrefix(candidates)
+		if len(prefix) > len(word) {
+			text = strings.TrimSuffix(text, word) + prefix
+		} else {
+			fmt.Fprint(e.out, "\r\n"+strings.Join(candidates, "  ")+"\r\n")
+		}
+	}
+
+	line = []rune(text)
+	e.redraw(prompt, line)
+	return line
+}
+
+func (e *lineEditor) redraw(prompt string, line []rune) {
+	fmt.Fprint(e.out, "\r\x1b[K"+prompt+string(line))
+}
+
+// remember appends a line to the history, skipping blank lines and repeats
+func (e *lineEditor) remember(line string) {
+	if strings.TrimSpace(line) == "" {
+		return
+	}
+	if len(e.history) > 0 && e.history[len(e.history)-1] == line {
+		return
+	}
+
+	e.history = append(e.history, line)
+	if len(e.history) > maxHistory {
+		e.history = e.history[len(e.history)-maxHistory:]
+	}
+}
+
+// commonPrefix returns the longest prefix shared by all words
+func commonPrefix(words []string) string {
+	prefix := words[0]
+	for _, word := range words[1:] {
+		for !strings.HasPrefix(word, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	return prefix
+}
+
+// matching returns the candidates starting with word, in the given order
+func matching(candidates []string, word string) []string {
+	var matches []string
+	for _, candidate := range candidates {
+		if strings.HasPrefix(candidate, word) {
+			matches = append(matches, candidate)
+		}
+	}
+	return matches
+}
@@ -1,47 +1,46 @@
 package main
 
 import (
-	"time"
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
 
-	"github.com/d1nch8g/consensuscraft/bds"
 	"github.com/d1nch8g/consensuscraft/config"
-	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/crash"
+	"github.com/d1nch8g/consensuscraft/node"
+	"github.com/d1nch8g/consensuscraft/tracing"
 	"github.com/sirupsen/logrus"
 )
 
 func main() {
 	cfg := config.New()
 
-	inventories, err := database.New("inventories.ldb")
-	if err != nil {
-		logrus.Fatalf("unable to open inventories database: %v", err)
+	if len(os.Args) > 1 {
+		if err := runCommand(cfg, os.Args[1], os.Args[2:]); err != nil {
+			logrus.Fatalf("%s: %v", os.Args[1], err)
+		}
+		return
 	}
 
-	for _, bn := range cfg.BannedNodes {
-		inventories.Delete(bn, true)
-	}
+	crash.Init(cfg.CrashDir, cfg.CrashReportURL)
 
-	runBDS := make(chan struct{})
-
-	bds, err := bds.New(bds.Parameters{
-		InventoryReceiveCallback: func(playerName string) ([]byte, error) {
-			return inventories.Get(playerName)
-		},
-		InventoryUpdateCallback: func(playerName string, inventory []byte) error {
-			return inventories.Put(playerName, inventory, cfg.WebAddress)
-		},
-		StartTrigger: runBDS,
-		WebAddress:   cfg.WebAddress,
-	})
-	if err != nil {
-		logrus.Fatalf("unable to launch bedrock dedicated server: %v", err)
+	if cfg.OTLPEndpoint != "" {
+		tracing.Init(tracing.NewOTLPExporter(cfg.OTLPEndpoint, "consensuscraft", cfg.OTLPHeaders))
+		defer tracing.Shutdown()
 	}
 
-	runBDS <- struct{}{}
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
 
-	_ = bds
+	n := node.New(cfg)
+	if err := n.Start(ctx); err != nil {
+		logrus.Fatalf("%v", err)
+	}
 
-	for {
-		time.Sleep(time.Hour * 284)
+	<-ctx.Done()
+	logrus.Info("shutting down")
+	if err := n.Stop(); err != nil {
+		logrus.Errorf("unable to stop cleanly: %v", err)
 	}
 }
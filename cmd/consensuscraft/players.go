@@ -0,0 +1,28 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/d1nch8g/consensuscraft/config"
+	"github.com/d1nch8g/consensuscraft/database"
+)
+
+// deletePlayer removes a single player's inventory history from the local database
+func deletePlayer(cfg *config.Config, args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+
+	db, err := database.New("inventories.ldb")
+	if err != nil {
+		return fmt.Errorf("unable to open inventories database: %w", err)
+	}
+	defer db.Close()
+
+	if err := db.DeletePlayer(args[0], cfg.WebAddress); err != nil {
+		return fmt.Errorf("failed to delete player %s: %w", args[0], err)
+	}
+
+	fmt.Printf("Deleted player %s\n", args[0])
+	return nil
+}
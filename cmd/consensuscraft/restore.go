@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/d1nch8g/consensuscraft/config"
+	"github.com/d1nch8g/consensuscraft/database"
+)
+
+// restoreServer reinstates the items a ban of the server removed from the local database, the
+// node must be stopped and the server removed from BANNED_NODES first
+func restoreServer(cfg *config.Config, args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+	server := args[0]
+
+	if slices.Contains(cfg.BannedNodes, server) {
+		return fmt.Errorf("%s is still in BANNED_NODES, remove it first or the node deletes its items again on start", server)
+	}
+
+	db, err := database.New("inventories.ldb")
+	if err != nil {
+		return fmt.Errorf("unable to open inventories database: %w", err)
+	}
+	defer db.Close()
+
+	db.SetBanSnapshotDir(database.BanSnapshotDir("inventories.ldb"))
+	report, err := db.RestoreServer(server)
+	if err != nil {
+		return fmt.Errorf("failed to restore %s: %w", server, err)
+	}
+
+	fmt.Printf("Restored %d entries of %d players from %d ban snapshots of %s\n", report.Entries, report.Players, report.Snapshots, server)
+	fmt.Println("Peers that still ban the server, and imported ban lists naming it, delete these items again")
+	return nil
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/bds"
+	"github.com/d1nch8g/consensuscraft/buildinfo"
+	"github.com/d1nch8g/consensuscraft/config"
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/keys"
+	"github.com/d1nch8g/consensuscraft/network"
+)
+
+// selftestTimeout bounds the handshake with each configured peer
+const selftestTimeout = 10 * time.Second
+
+// selftestServer labels the sample data of the checks, it never reaches the node database
+const selftestServer = "selftest.invalid"
+
+// selftestCheck is one step of the self test, it returns a detail shown when it passes
+type selftestCheck struct {
+	name string
+	run  func() (string, error)
+}
+
+// selftest runs the node components end to end against temporary data and the configured peers,
+// printing a report to attach to support requests
+func selftest(cfg *config.Config, args []string) error {
+	if len(args) != 0 {
+		return errUsage
+	}
+
+	dir, err := os.MkdirTemp("", "consensuscraft-selftest-")
+	if err != nil {
+		return fmt.Errorf("unable to create a temporary directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	inventory, err := sampleInventory()
+	if err != nil {
+		return err
+	}
+
+	checks := []selftestCheck{
+		{"keys", func() (string, error) { return selftestKeys(dir) }},
+		{"database", func() (string, error) { return selftestDatabase(dir, inventory) }},
+		{"log parsing", func() (string, error) { return selftestParse(inventory) }},
+		{"validation", func() (string, error) { return selftestValidate(inventory) }},
+	}
+	if cfg.ConnectedNode == "" {
+		checks = append(checks, selftestCheck{"handshake", func() (string, error) { return "skipped, CONNECTED_NODE is not set", nil }})
+	} else {
+		checks = append(checks, selftestCheck{"handshake " + cfg.ConnectedNode, func() (string, error) { return selftestHandshake(cfg, cfg.ConnectedNode) }})
+	}
+
+	info := buildinfo.Get()
+	fmt.Printf("consensuscraft %s (%s), node %s\n\n", info.Version, info.Commit, cfg.WebAddress)
+
+	failed := 0
+	for _, check := range checks {
+		detail, err := check.run()
+		if err != nil {
+			failed++
+			fmt.Printf("FAIL  %-20s %v\n", check.name, err)
+			continue
+		}
+		fmt.Printf("PASS  %-20s %s\n", check.name, detail)
+	}
+
+	fmt.Printf("\n%d of %d checks passed\n", len(checks)-failed, len(checks))
+	if failed > 0 {
+		return fmt.Errorf("%d checks failed", failed)
+	}
+	return nil
+}
+
+// sampleInventory builds an ender chest holding one item labelled with the origin of the sample server
+func sampleInventory() ([]byte, error) {
+	item := &database.Item{TypeID: "minecraft:diamond", Amount: 3}
+	database.NewItemValidator().AddOriginToItem(item, selftestServer)
+	return json.Marshal([]any{item, nil})
+}
+
+// selftestKeys generates a key pair in dir, signs a sample inventory and verifies the signature
+// Key files are written relative to the working directory, which is restored afterwards
+func selftestKeys(dir string) (string, error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	if err := os.Chdir(dir); err != nil {
+		return "", err
+	}
+	defer os.Chdir(wd)
+
+	km, err := keys.New(selftestServer)
+	if err != nil {
+		return "", fmt.Errorf("unable to generate a key: %w", err)
+	}
+	signature, err := km.Sign("Steve", []byte(`[]`))
+	if err != nil {
+		return "", fmt.Errorf("unable to sign: %w", err)
+	}
+	if err := km.Verify("Steve", []byte(`[]`), signature); err != nil {
+		return "", err
+	}
+	if km.Verify("Steve", []byte(`[null]`), signature) == nil {
+		return "", fmt.Errorf("a tampered inventory passed verification")
+	}
+	return "generated a key, signed and verified an inventory", nil
+}
+
+// selftestDatabase stores, reads and deletes the sample inventory in a database created in dir
+func selftestDatabase(dir string, inventory []byte) (string, error) {
+	db, err := database.New(filepath.Join(dir, "inventories.ldb"))
+	if err != nil {
+		return "", fmt.Errorf("unable to open a database: %w", err)
+	}
+	defer db.Close()
+
+	if err := db.Put("Steve", inventory, selftestServer); err != nil {
+		return "", fmt.Errorf("put: %w", err)
+	}
+	stored, err := db.Get("Steve")
+	if err != nil {
+		return "", fmt.Errorf("get: %w", err)
+	}
+	if string(stored) != string(inventory) {
+		return "", fmt.Errorf("get returned %s, stored %s", stored, inventory)
+	}
+	if err := db.Delete(selftestServer, false); err != nil {
+		return "", fmt.Errorf("delete: %w", err)
+	}
+	if _, err := db.Get("Steve"); !errors.Is(err, database.ErrPlayerNotFound) {
+		return "", fmt.Errorf("player still readable after delete: %v", err)
+	}
+	return "put, get and delete on a temporary database", nil
+}
+
+// selftestParse reads a sample X_ENDER_CHEST line as the pack logs it
+func selftestParse(inventory []byte) (string, error) {
+	line := fmt.Sprintf("[X_ENDER_CHEST][Steve][#selftest:1][@0.50,64.00,-3.25,minecraft:overworld][%s]", inventory)
+	update, err := bds.NewOutputParser(nil, nil).ParseEnderChest(line)
+	if err != nil {
+		return "", err
+	}
+	if update.PlayerName != "Steve" || string(update.Inventory) != string(inventory) || update.Position == nil || update.UpdateID == "" {
+		return "", fmt.Errorf("parsed %q with inventory %s, position %v and update %q", update.PlayerName, update.Inventory, update.Position, update.UpdateID)
+	}
+	return "read player, update, position and inventory of an ender chest line", nil
+}
+
+// selftestValidate runs the item rules on the sample inventory
+func selftestValidate(inventory []byte) (string, error) {
+	if errs := database.NewItemValidator().ValidateInventory(inventory, selftestServer, "Steve"); len(errs) > 0 {
+		return "", fmt.Errorf("sample inventory rejected: %s", errs[0].Message)
+	}
+	return "sample inventory accepted by the item rules", nil
+}
+
+// selftestHandshake registers with a peer using the node keys without syncing its database
+// The peer shows the world settings of this node as not published until the node joins it again
+func selftestHandshake(cfg *config.Config, address string) (string, error) {
+	km, err := keys.New(cfg.WebAddress)
+	if err != nil {
+		return "", fmt.Errorf("unable to load node keys: %w", err)
+	}
+	handshake, err := network.NewHandshake(km, cfg.WebAddress, nil, cfg.BannedNodes)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), selftestTimeout)
+	defer cancel()
+	peer, rtt, err := network.Probe(ctx, address, handshake, km)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s answered in %s", peer, rtt.Round(time.Millisecond)), nil
+}
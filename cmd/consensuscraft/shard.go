@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/d1nch8g/consensuscraft/config"
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/keys"
+	"github.com/d1nch8g/consensuscraft/network"
+)
+
+// reshard moves the local players owned by other nodes under a new shard layout to their owners
+func reshard(cfg *config.Config, args []string) error {
+	if len(args) < 1 || len(args) > 2 || (len(args) == 2 && args[1] != "--delete") {
+		return errUsage
+	}
+
+	members, err := parseMembers(args[0])
+	if err != nil {
+		return err
+	}
+
+	router, err := network.NewRouter(cfg.WebAddress, members, cfg.ShardReplicas)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.New("inventories.ldb")
+	if err != nil {
+		return fmt.Errorf("unable to open inventories database: %w", err)
+	}
+	defer db.Close()
+
+	var players []string
+	for entry := range db.StreamAll() {
+		players = append(players, string(entry.Key))
+	}
+	moves := router.Group(players)
+
+	owners := make([]string, 0, len(moves))
+	for owner := range moves {
+		owners = append(owners, owner)
+	}
+	sort.Strings(owners)
+
+	fmt.Printf("%d players stay on %s\n", len(players)-countMoves(moves), cfg.WebAddress)
+	if len(owners) == 0 {
+		return nil
+	}
+
+	km, err := keys.New(cfg.WebAddress)
+	if err != nil {
+		return fmt.Errorf("unable to load node keys: %w", err)
+	}
+	handshake, err := network.NewHandshake(km, cfg.WebAddress, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, owner := range owners {
+		address := router.Address(owner)
+		if _, err := network.Join(context.Background(), address, handshake, km, db, network.NewPeers(nil)); err != nil {
+			return fmt.Errorf("unable to reach %s: %w", owner, err)
+		}
+
+		pushed, err := network.Push(context.Background(), address, cfg.WebAddress, km, db, moves[owner])
+		if err != nil {
+			return fmt.Errorf("failed to move players to %s: %w", owner, err)
+		}
+		fmt.Printf("%d players moved to %s\n", pushed, owner)
+
+		if len(args) == 2 {
+			for _, player := range moves[owner] {
+				if err := db.ForgetPlayer(player); err != nil {
+					return fmt.Errorf("failed to delete moved player %s: %w", player, err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseMembers reads shard members written as comma separated web address=dial address pairs
+func parseMembers(value string) (map[string]string, error) {
+	members := make(map[string]string)
+	for _, pair := range strings.Split(value, ",") {
+		webAddress, address, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok || webAddress == "" || address == "" {
+			return nil, fmt.Errorf("invalid shard member %q, expected web address=dial address", pair)
+		}
+		members[webAddress] = address
+	}
+	return members, nil
+}
+
+func countMoves(moves map[string][]string) int {
+	count := 0
+	for _, players := range moves {
+		count += len(players)
+	}
+	return count
+}
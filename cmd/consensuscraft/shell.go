@@ -0,0 +1,867 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/admin"
+	"github.com/d1nch8g/consensuscraft/config"
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/network"
+	"github.com/d1nch8g/consensuscraft/startup"
+)
+
+// historyFile keeps the shell history between sessions, in the home directory
+const historyFile = ".consensuscraft_history"
+
+// adminClient queries the admin API of a running node
+type adminClient struct {
+	base    string
+	token   string
+	client  *http.Client
+	servers []string // Origin servers, cached for completion
+}
+
+func newAdminClient(address, token string) *adminClient {
+	if !strings.Contains(address, "://") {
+		address = "http://" + address
+	}
+	return &adminClient{
+		base:   strings.TrimSuffix(address, "/"),
+		token:  token,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// get decodes the JSON response of an admin API path
+func (c *adminClient) get(path string, query url.Values, v any) error {
+	target := c.base + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequest(http.MethodGet, target, nil)
+	if err != nil {
+		return err
+	}
+	return c.do(req, v)
+}
+
+// post sends body as JSON to an admin API path and decodes the JSON response
+func (c *adminClient) post(path string, body, v any) error {
+	return c.send(http.MethodPost, path, body, v)
+}
+
+// put replaces the resource at an admin API path with body as JSON and decodes the JSON response
+func (c *adminClient) put(path string, body, v any) error {
+	return c.send(http.MethodPut, path, body, v)
+}
+
+func (c *adminClient) send(method, path string, body, v any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(method, c.base+path, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return c.do(req, v)
+}
+
+func (c *adminClient) do(req *http.Request, v any) error {
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// originServers returns the origin server names, fetched once per session
+func (c *adminClient) originServers() []string {
+	if c.servers != nil {
+		return c.servers
+	}
+
+	var origins []database.OriginStats
+	if err := c.get("/api/origins", nil, &origins); err != nil {
+		return nil
+	}
+	c.servers = []string{}
+	for _, origin := range origins {
+		c.servers = append(c.servers, origin.Server)
+	}
+	return c.servers
+}
+
+// shellCommand is a command of the interactive shell
+type shellCommand struct {
+	usage       string
+	description string
+	run         func(c *adminClient, out io.Writer, args []string) error
+}
+
+// historyFlags are the filters accepted by the players command
+var historyFlags = []string{"--server", "--since", "--until", "--limit", "--cursor"}
+
+var shellCommands = map[string]shellCommand{
+	"peers": {
+		usage:       "peers",
+		description: "List handshaked peers and their world settings mismatches",
+		run:         shellPeers,
+	},
+	"servers": {
+		usage:       "servers",
+		description: "List origin servers with accepted, rejected and conflicting updates",
+		run:         shellServers,
+	},
+	"connectivity": {
+		usage:       "connectivity",
+		description: "Show whether the node syncs with peers or runs local-only",
+		run:         shellConnectivity,
+	},
+	"bans": {
+		usage:       "bans [export <file> | import <file>]",
+		description: "List servers banned here and how the ban lists of peers differ, or exchange signed ban lists with allied networks",
+		run:         shellBans,
+	},
+	"startup": {
+		usage:       "startup",
+		description: "Show how long each startup phase took and which one failed",
+		run:         shellStartup,
+	},
+	"freeze": {
+		usage:       "freeze [on <reason> | off | endorse <id>]",
+		description: "Show, propose or endorse an emergency freeze of inventory updates on all nodes",
+		run:         shellFreeze,
+	},
+	"maintenance": {
+		usage:       "maintenance [on [reason] | off]",
+		description: "Show or switch maintenance mode, which keeps only operators on the server and pauses sync",
+		run:         shellMaintenance,
+	},
+	"notices": {
+		usage:       "notices [text]",
+		description: "List operator notices of this node and its peers, or sign and send one to every peer",
+		run:         shellNotices,
+	},
+	"netconfig": {
+		usage:       "netconfig [publish <file> | accept <version>]",
+		description: "Show the network configuration, publish the settings in a JSON file as its next version or accept a version awaiting review",
+		run:         shellNetworkConfig,
+	},
+	"group": {
+		usage:       "group <group> [members <player>...]",
+		description: "Show the members and access audit of a shared inventory, or create it and set its members",
+		run:         shellGroup,
+	},
+	"players": {
+		usage:       "players <player> [--server <server>] [--since <RFC3339>] [--until <RFC3339>] [--limit <n>] [--cursor <cursor>]",
+		description: "Show a page of a player's inventory history",
+		run:         shellPlayers,
+	},
+	"inventory-at": {
+		usage:       "inventory-at <player> <RFC3339|YYYY-MM-DD>",
+		description: "Show the inventory a player had at a point in time, as recorded in their history",
+		run:         shellInventoryAt,
+	},
+}
+
+// shell runs an interactive shell over the admin API of a running node
+func shell(cfg *config.Config, args []string) error {
+	address := cfg.AdminAddress
+	switch len(args) {
+	case 0:
+	case 1:
+		address = args[0]
+	default:
+		return errUsage
+	}
+	if address == "" {
+		return fmt.Errorf("no admin address configured")
+	}
+
+	client := newAdminClient(address, cfg.AdminToken)
+	editor := newLineEditor(os.Stdin, os.Stdout, client.complete)
+	editor.history = loadHistory()
+	defer saveHistory(editor.history)
+
+	fmt.Printf("Connected to %s, type help for commands and tab to complete\n", client.base)
+
+	plain := bufio.NewScanner(os.Stdin)
+	for {
+		line, err := readShellLine(editor, plain)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch name := fields[0]; name {
+		case "exit", "quit":
+			return nil
+		case "help":
+			printShellHelp(os.Stdout)
+		default:
+			cmd, ok := shellCommands[name]
+			if !ok {
+				fmt.Printf("Unknown command %s, type help for commands\n", name)
+				continue
+			}
+			if err := cmd.run(client, os.Stdout, fields[1:]); err != nil {
+				if errors.Is(err, errUsage) {
+					fmt.Printf("Usage: %s\n", cmd.usage)
+				} else {
+					fmt.Printf("Error: %v\n", err)
+				}
+			}
+		}
+	}
+}
+
+// maintenance switches maintenance mode of the running node configured by ADMIN_ADDRESS
+func maintenance(cfg *config.Config, args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+	if cfg.AdminAddress == "" {
+		return fmt.Errorf("no admin address configured")
+	}
+
+	client := newAdminClient(cfg.AdminAddress, cfg.AdminToken)
+	if args[0] == "status" {
+		if len(args) != 1 {
+			return errUsage
+		}
+		args = nil
+	}
+	return shellMaintenance(client, os.Stdout, args)
+}
+
+// freezeCommand proposes or endorses a freeze through the running node configured by ADMIN_ADDRESS
+func freezeCommand(cfg *config.Config, args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+	if cfg.AdminAddress == "" {
+		return fmt.Errorf("no admin address configured")
+	}
+
+	client := newAdminClient(cfg.AdminAddress, cfg.AdminToken)
+	if args[0] == "status" {
+		if len(args) != 1 {
+			return errUsage
+		}
+		args = nil
+	}
+	return shellFreeze(client, os.Stdout, args)
+}
+
+// bans lists the bans of the running node configured by ADMIN_ADDRESS, or exports and imports ban lists
+func bans(cfg *config.Config, args []string) error {
+	if cfg.AdminAddress == "" {
+		return fmt.Errorf("no admin address configured")
+	}
+
+	return shellBans(newAdminClient(cfg.AdminAddress, cfg.AdminToken), os.Stdout, args)
+}
+
+// inventoryAt shows a player's inventory at a point in time through the running node configured by ADMIN_ADDRESS
+func inventoryAt(cfg *config.Config, args []string) error {
+	if cfg.AdminAddress == "" {
+		return fmt.Errorf("no admin address configured")
+	}
+
+	return shellInventoryAt(newAdminClient(cfg.AdminAddress, cfg.AdminToken), os.Stdout, args)
+}
+
+// netconfig shows, publishes or accepts the network configuration through the running node configured by ADMIN_ADDRESS
+func netconfig(cfg *config.Config, args []string) error {
+	if cfg.AdminAddress == "" {
+		return fmt.Errorf("no admin address configured")
+	}
+
+	return shellNetworkConfig(newAdminClient(cfg.AdminAddress, cfg.AdminToken), os.Stdout, args)
+}
+
+// notices lists or sends operator notices through the running node configured by ADMIN_ADDRESS
+func notices(cfg *config.Config, args []string) error {
+	if cfg.AdminAddress == "" {
+		return fmt.Errorf("no admin address configured")
+	}
+
+	return shellNotices(newAdminClient(cfg.AdminAddress, cfg.AdminToken), os.Stdout, args)
+}
+
+// group shows or sets up a shared inventory through the running node configured by ADMIN_ADDRESS
+func group(cfg *config.Config, args []string) error {
+	if cfg.AdminAddress == "" {
+		return fmt.Errorf("no admin address configured")
+	}
+
+	return shellGroup(newAdminClient(cfg.AdminAddress, cfg.AdminToken), os.Stdout, args)
+}
+
+// readShellLine reads with the line editor on a terminal, and plain lines otherwise
+func readShellLine(editor *lineEditor, plain *bufio.Scanner) (string, error) {
+	restore, err := makeRaw(int(os.Stdin.Fd()))
+	if err != nil {
+		fmt.Print("> ")
+		if !plain.Scan() {
+			if err := plain.Err(); err != nil {
+				return "", err
+			}
+			return "", io.EOF
+		}
+		return plain.Text(), nil
+	}
+	defer restore()
+
+	return editor.readLine("> ")
+}
+
+// complete suggests shell commands, then the flags and origin servers of the players command
+func (c *adminClient) complete(args []string, word string) []string {
+	if len(args) == 0 {
+		names := []string{"exit", "help"}
+		for name := range shellCommands {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return matching(names, word)
+	}
+
+	switch args[0] {
+	case "help":
+		if len(args) == 1 {
+			return c.complete(nil, word)
+		}
+	case "maintenance":
+		if len(args) == 1 {
+			return matching(maintenanceModes, word)
+		}
+	case "freeze":
+		if len(args) == 1 {
+			return matching(freezeModes, word)
+		}
+	case "bans":
+		if len(args) == 1 {
+			return matching(banActions, word)
+		}
+	case "netconfig":
+		if len(args) == 1 {
+			return matching(networkConfigActions, word)
+		}
+	case "players":
+		if len(args) == 1 {
+			return nil // Player names are not listed by the admin API
+		}
+		if args[len(args)-1] == "--server" {
+			return matching(c.originServers(), word)
+		}
+		return matching(historyFlags, word)
+	}
+	return nil
+}
+
+func printShellHelp(out io.Writer) {
+	names := make([]string, 0, len(shellCommands))
+	for name := range shellCommands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(out, "Commands:")
+	for _, name := range names {
+		fmt.Fprintf(out, "  %s\n      %s\n", shellCommands[name].usage, shellCommands[name].description)
+	}
+	fmt.Fprintln(out, "  exit\n      Leave the shell")
+}
+
+func shellPeers(c *adminClient, out io.Writer, args []string) error {
+	if len(args) != 0 {
+		return errUsage
+	}
+
+	var peers []network.Peer
+	if err := c.get("/api/peers", nil, &peers); err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "PEER\tCONNECTED\tMISMATCHES")
+	for _, peer := range peers {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", peer.WebAddress, peer.ConnectedAt.Format(time.RFC3339), strings.Join(peer.Mismatches, ", "))
+	}
+	return w.Flush()
+}
+
+func shellServers(c *adminClient, out io.Writer, args []string) error {
+	if len(args) != 0 {
+		return errUsage
+	}
+
+	var origins []database.OriginStats
+	if err := c.get("/api/origins", nil, &origins); err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "SERVER\tACCEPTED\tREJECTED\tCONFLICTS\tLAST SEEN")
+	for _, origin := range origins {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%s\n", origin.Server, origin.Accepted, origin.RejectedTotal(), origin.Conflicts, origin.LastSeen.Format(time.RFC3339))
+	}
+	return w.Flush()
+}
+
+func shellConnectivity(c *adminClient, out io.Writer, args []string) error {
+	if len(args) != 0 {
+		return errUsage
+	}
+
+	var status network.ConnectivityStatus
+	if err := c.get("/api/connectivity", nil, &status); err != nil {
+		return err
+	}
+
+	mode := "syncing with peers"
+	if status.LocalOnly {
+		mode = "local-only"
+	}
+	fmt.Fprintf(out, "Mode: %s since %s\n", mode, status.Since.Format(time.RFC3339))
+	fmt.Fprintf(out, "Queued players: %d\n", status.Queued)
+	if status.LastError != "" {
+		fmt.Fprintf(out, "Last error: %s\n", status.LastError)
+	}
+	return nil
+}
+
+func shellBans(c *adminClient, out io.Writer, args []string) error {
+	switch {
+	case len(args) == 2 && args[0] == "export":
+		return exportBans(c, out, args[1])
+	case len(args) == 2 && args[0] == "import":
+		return importBans(c, out, args[1])
+	case len(args) != 0:
+		return errUsage
+	}
+
+	var status struct {
+		Banned  []string            `json:"banned"`
+		Entries []network.BanEntry  `json:"entries"`
+		Reports []network.BanReport `json:"reports"`
+	}
+	if err := c.get("/api/bans", nil, &status); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Banned here: %s\n", strings.Join(status.Banned, ", "))
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	if len(status.Entries) > 0 {
+		fmt.Fprintln(w, "SERVER\tBANNED AT\tSOURCE\tREASON")
+		for _, entry := range status.Entries {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", entry.Server, entry.BannedAt.Format(time.RFC3339), entry.Source, entry.Reason)
+		}
+		fmt.Fprintln(w)
+	}
+	fmt.Fprintln(w, "PEER\tADOPTED\tPENDING\tNOT BANNED BY PEER")
+	for _, report := range status.Reports {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", report.Peer, strings.Join(report.Adopted, ", "), strings.Join(report.Pending, ", "), strings.Join(report.NotBannedBy, ", "))
+	}
+	return w.Flush()
+}
+
+// banActions are the ban list exchanges accepted by the bans command
+var banActions = []string{"export", "import"}
+
+// exportBans saves the ban list of the node, signed with its key, to a file for allied networks
+func exportBans(c *adminClient, out io.Writer, path string) error {
+	var list network.BanList
+	if err := c.get("/api/bans/export", nil, &list); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(list, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Exported %d bans signed by %s to %s\n", len(list.Bans), list.Issuer, path)
+	return nil
+}
+
+// importBans sends a ban list exported by an allied network to the node, which checks its signature
+func importBans(c *adminClient, out io.Writer, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var list network.BanList
+	if err := json.Unmarshal(data, &list); err != nil {
+		return fmt.Errorf("invalid ban list %s: %w", path, err)
+	}
+
+	var report network.BanImport
+	if err := c.post("/api/bans/import", list, &report); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Imported bans of %s\n", report.Issuer)
+	fmt.Fprintf(out, "Adopted: %s\n", strings.Join(report.Adopted, ", "))
+	fmt.Fprintf(out, "Already banned: %s\n", strings.Join(report.Known, ", "))
+	if len(report.Failed) > 0 {
+		fmt.Fprintf(out, "Failed: %s\n", strings.Join(report.Failed, ", "))
+	}
+	return nil
+}
+
+func shellStartup(c *adminClient, out io.Writer, args []string) error {
+	if len(args) != 0 {
+		return errUsage
+	}
+
+	var report startup.Snapshot
+	if err := c.get("/api/startup", nil, &report); err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "PHASE\tSTATUS\tATTEMPTS\tDURATION\tERROR")
+	for _, phase := range report.Phases {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n", phase.Name, phase.Status, phase.Attempts, time.Duration(phase.DurationMS)*time.Millisecond, phase.Error)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Completed: %t after %s\n", report.Completed, time.Duration(report.DurationMS)*time.Millisecond)
+	return nil
+}
+
+// maintenanceModes are the switches accepted by the maintenance command
+var maintenanceModes = []string{"on", "off"}
+
+func shellMaintenance(c *adminClient, out io.Writer, args []string) error {
+	var status network.MaintenanceStatus
+	if len(args) == 0 {
+		if err := c.get("/api/maintenance", nil, &status); err != nil {
+			return err
+		}
+	} else {
+		req := admin.MaintenanceRequest{Reason: strings.Join(args[1:], " ")}
+		switch args[0] {
+		case "on":
+			req.Enabled = true
+		case "off":
+			if len(args) != 1 {
+				return errUsage
+			}
+		default:
+			return errUsage
+		}
+		if err := c.post("/api/maintenance", req, &status); err != nil {
+			return err
+		}
+	}
+
+	mode := "off"
+	if status.Enabled {
+		mode = "on"
+	}
+	fmt.Fprintf(out, "Maintenance: %s since %s\n", mode, status.Since.Format(time.RFC3339))
+	if status.Reason != "" {
+		fmt.Fprintf(out, "Reason: %s\n", status.Reason)
+	}
+	return nil
+}
+
+// freezeModes are the actions accepted by the freeze command
+var freezeModes = []string{"on", "off", "endorse"}
+
+func shellFreeze(c *adminClient, out io.Writer, args []string) error {
+	if len(args) > 0 {
+		var order network.FreezeOrder
+		var err error
+		switch {
+		case args[0] == "on" && len(args) > 1:
+			err = c.post("/api/freeze", admin.FreezeRequest{Frozen: true, Reason: strings.Join(args[1:], " ")}, &order)
+		case args[0] == "off" && len(args) == 1:
+			err = c.post("/api/freeze", admin.FreezeRequest{}, &order)
+		case args[0] == "endorse" && len(args) == 2:
+			err = c.post("/api/freeze/"+url.PathEscape(args[1])+"/endorse", nil, &order)
+		default:
+			return errUsage
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Signed freeze order %s, signed by %s\n", order.ID, strings.Join(order.Signers, ", "))
+	}
+
+	var status network.FreezeStatus
+	if err := c.get("/api/freeze", nil, &status); err != nil {
+		return err
+	}
+
+	mode := "off"
+	if status.Frozen {
+		mode = "on"
+	}
+	fmt.Fprintf(out, "Freeze: %s since %s, %d of %v must sign\n", mode, status.Since.Format(time.RFC3339), status.Quorum, status.Signers)
+	if status.Reason != "" {
+		fmt.Fprintf(out, "Reason: %s\n", status.Reason)
+	}
+	for _, order := range status.Pending {
+		action := "unfreeze"
+		if order.Frozen {
+			action = "freeze"
+		}
+		fmt.Fprintf(out, "Pending %s %s from %s, signed by %s %s\n", action, order.ID, order.Issuer, strings.Join(order.Signers, ", "), order.Reason)
+	}
+	return nil
+}
+
+// networkConfigActions are the actions accepted by the netconfig command
+var networkConfigActions = []string{"publish", "accept"}
+
+func shellNetworkConfig(c *adminClient, out io.Writer, args []string) error {
+	switch {
+	case len(args) == 2 && args[0] == "publish":
+		data, err := os.ReadFile(args[1])
+		if err != nil {
+			return err
+		}
+		var settings network.NetworkSettings
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&settings); err != nil {
+			return fmt.Errorf("invalid network configuration %s: %w", args[1], err)
+		}
+
+		var published network.NetworkConfig
+		if err := c.post("/api/netconfig", settings, &published); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Published network configuration version %d, peers receive it on their next sync\n", published.Version)
+		return nil
+	case len(args) == 2 && args[0] == "accept":
+		var accepted network.NetworkConfig
+		if err := c.post("/api/netconfig/"+url.PathEscape(args[1])+"/accept", nil, &accepted); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Accepted network configuration version %d from %s, restart the node to apply settings other than bans\n", accepted.Version, accepted.Issuer)
+		return nil
+	case len(args) != 0:
+		return errUsage
+	}
+
+	var status network.NetworkConfigStatus
+	if err := c.get("/api/netconfig", nil, &status); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Policy: %s, signers: %s\n", status.Policy, strings.Join(status.Signers, ", "))
+	if status.Current == nil {
+		fmt.Fprintln(out, "No network configuration accepted")
+	} else if err := printNetworkConfig(out, "Accepted", status.Current); err != nil {
+		return err
+	}
+	if status.Pending != nil {
+		return printNetworkConfig(out, "Awaiting review", status.Pending)
+	}
+	return nil
+}
+
+// printNetworkConfig prints a version of the network configuration with its settings
+func printNetworkConfig(out io.Writer, label string, config *network.NetworkConfig) error {
+	settings, err := json.MarshalIndent(config.Settings, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "%s: version %d from %s issued %s\n%s\n", label, config.Version, config.Issuer, config.IssuedAt.Format(time.RFC3339), settings)
+	return nil
+}
+
+func shellNotices(c *adminClient, out io.Writer, args []string) error {
+	if len(args) > 0 {
+		author := os.Getenv("USER")
+		if author == "" {
+			author = "operator"
+		}
+
+		var notice network.Notice
+		if err := c.post("/api/notices", admin.NoticeRequest{Author: author, Text: strings.Join(args, " ")}, &notice); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Sent notice %s, peers receive it on their next sync\n", notice.ID)
+		return nil
+	}
+
+	var notices []network.Notice
+	if err := c.get("/api/notices", nil, &notices); err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "SENT\tSERVER\tAUTHOR\tNOTICE")
+	for _, notice := range notices {
+		from := notice.From
+		if notice.Local {
+			from += " (here)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", notice.SentAt.Local().Format(time.DateTime), from, notice.Author, notice.Text)
+	}
+	return w.Flush()
+}
+
+func shellGroup(c *adminClient, out io.Writer, args []string) error {
+	if len(args) == 0 || (len(args) > 1 && (args[1] != "members" || len(args) == 2)) {
+		return errUsage
+	}
+	path := "/api/groups/" + url.PathEscape(args[0])
+
+	if len(args) > 1 {
+		var group database.Group
+		if err := c.put(path+"/members", admin.GroupMembersRequest{Members: args[2:]}, &group); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Group %s has %d members, peers receive it on their next sync\n", group.ID, len(group.Members))
+		return nil
+	}
+
+	var info database.GroupRecord
+	if err := c.get(path, nil, &info); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Members: %s\n", strings.Join(info.Group.Members, ", "))
+	fmt.Fprintf(out, "Set by %s at %s, %d inventory entries\n\n", info.Group.UpdatedBy, info.Group.UpdatedAt.Local().Format(time.DateTime), info.Entries)
+
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "AT\tMEMBER\tACTION\tSERVER")
+	for _, access := range info.Access {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", access.At.Local().Format(time.DateTime), access.Member, access.Action, access.Server)
+	}
+	return w.Flush()
+}
+
+func shellPlayers(c *adminClient, out io.Writer, args []string) error {
+	if len(args) == 0 || strings.HasPrefix(args[0], "--") {
+		return errUsage
+	}
+
+	query := url.Values{}
+	for i := 1; i < len(args); i += 2 {
+		flag := args[i]
+		if !slices.Contains(historyFlags, flag) || i+1 == len(args) {
+			return errUsage
+		}
+		query.Set(strings.TrimPrefix(flag, "--"), args[i+1])
+	}
+
+	var page database.HistoryPage
+	if err := c.get("/api/players/"+url.PathEscape(args[0])+"/inventories", query, &page); err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "TIME\tSERVER\tBYTES")
+	for _, entry := range page.Entries {
+		fmt.Fprintf(w, "%s\t%s\t%d\n", entry.Timestamp.Format(time.RFC3339), entry.Server, len(entry.Inventory))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if page.NextCursor != "" {
+		fmt.Fprintf(out, "More entries: --cursor %s\n", page.NextCursor)
+	}
+	return nil
+}
+
+func shellInventoryAt(c *adminClient, out io.Writer, args []string) error {
+	if len(args) != 2 {
+		return errUsage
+	}
+	at, err := parseTime(args[1])
+	if err != nil {
+		return err
+	}
+
+	var entry database.InventoryEntry
+	query := url.Values{"at": {at.Format(time.RFC3339)}}
+	if err := c.get("/api/players/"+url.PathEscape(args[0])+"/inventory", query, &entry); err != nil {
+		return err
+	}
+
+	var inventory bytes.Buffer
+	if err := json.Indent(&inventory, entry.Inventory, "", "  "); err != nil {
+		inventory.Reset()
+		inventory.Write(entry.Inventory)
+	}
+
+	fmt.Fprintf(out, "Inventory of %s at %s, recorded by %s at %s:\n", args[0], at.Format(time.RFC3339), entry.Server, entry.Timestamp.Format(time.RFC3339))
+	fmt.Fprintln(out, inventory.String())
+	return nil
+}
+
+// loadHistory reads the shell history of earlier sessions
+func loadHistory() []string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil
+	}
+	data, err := os.ReadFile(filepath.Join(home, historyFile))
+	if err != nil {
+		return nil
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) > maxHistory {
+		lines = lines[len(lines)-maxHistory:]
+	}
+	return lines
+}
+
+// saveHistory stores the shell history for the next session
+func saveHistory(history []string) {
+	home, err := os.UserHomeDir()
+	if err != nil || len(history) == 0 {
+		return
+	}
+	os.WriteFile(filepath.Join(home, historyFile), []byte(strings.Join(history, "\n")+"\n"), 0600)
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+// makeRaw switches a terminal to raw mode so keys are read one at a time without echo,
+// the returned func restores the previous mode
+func makeRaw(fd int) (func(), error) {
+	var original syscall.Termios
+	if err := termios(fd, syscall.TCGETS, &original); err != nil {
+		return nil, err
+	}
+
+	raw := original
+	raw.Iflag &^= syscall.IGNBRK | syscall.BRKINT | syscall.PARMRK | syscall.ISTRIP | syscall.INLCR | syscall.IGNCR | syscall.ICRNL | syscall.IXON
+	raw.Oflag &^= syscall.OPOST
+	raw.Lflag &^= syscall.ECHO | syscall.ECHONL | syscall.ICANON | syscall.ISIG | syscall.IEXTEN
+	raw.Cflag &^= syscall.CSIZE | syscall.PARENB
+	raw.Cflag |= syscall.CS8
+	raw.Cc[syscall.VMIN] = 1
+	raw.Cc[syscall.VTIME] = 0
+
+	if err := termios(fd, syscall.TCSETS, &raw); err != nil {
+		return nil, err
+	}
+
+	return func() { termios(fd, syscall.TCSETS, &original) }, nil
+}
+
+func termios(fd int, request uintptr, value *syscall.Termios) error {
+	if _, _, errno := syscall.Syscall(syscall.SYS_IOCTL, uintptr(fd), request, uintptr(unsafe.Pointer(value))); errno != 0 {
+		return errno
+	}
+	return nil
+}
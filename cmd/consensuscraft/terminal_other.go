@@ -0,0 +1,13 @@
+//go:build !linux
+
+package main
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// makeRaw is only supported on linux, the shell falls back to plain line input elsewhere
+func makeRaw(fd int) (func(), error) {
+	return nil, fmt.Errorf("raw terminal mode is not supported on %s", runtime.GOOS)
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/d1nch8g/consensuscraft/buildinfo"
+	"github.com/d1nch8g/consensuscraft/config"
+)
+
+// version prints the build of this binary, as served on /version
+func version(cfg *config.Config, args []string) error {
+	if len(args) != 0 {
+		return errUsage
+	}
+
+	info := buildinfo.Get()
+	fmt.Printf("consensuscraft %s\n", info.Version)
+	fmt.Printf("commit:    %s\n", info.Commit)
+	fmt.Printf("built:     %s\n", info.BuildDate)
+	fmt.Printf("pack:      %s\n", info.PackVersion)
+	fmt.Printf("protocols: %v\n", info.Protocols)
+	fmt.Printf("go:        %s\n", info.GoVersion)
+	return nil
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"os"
+
+	"github.com/d1nch8g/consensuscraft/bds"
+)
+
+// packsign writes the release manifest of an mcpack
+// The ed25519 seed of the release key is read hex encoded from PACK_SIGNING_KEY, without it the
+// manifest only lists the file hashes and nodes with PACK_TRUSTED_KEYS refuse to install the pack
+func main() {
+	if len(os.Args) != 3 {
+		log.Fatalf("Usage: %s <mcpack> <manifest>", os.Args[0])
+	}
+
+	mcpack, err := os.ReadFile(os.Args[1])
+	if err != nil {
+		log.Fatalf("Error reading mcpack: %v", err)
+	}
+
+	var manifest *bds.PackManifest
+	if signingKey := os.Getenv("PACK_SIGNING_KEY"); signingKey == "" {
+		log.Printf("PACK_SIGNING_KEY is not set, writing an unsigned manifest")
+		manifest, err = bds.HashPackManifest(mcpack)
+	} else {
+		seed, decodeErr := hex.DecodeString(signingKey)
+		if decodeErr != nil || len(seed) != ed25519.SeedSize {
+			log.Fatalf("PACK_SIGNING_KEY must hold a hex encoded %d byte ed25519 seed", ed25519.SeedSize)
+		}
+		manifest, err = bds.SignPackManifest(mcpack, ed25519.NewKeyFromSeed(seed))
+	}
+	if err != nil {
+		log.Fatalf("Error hashing mcpack: %v", err)
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		log.Fatalf("Error encoding manifest: %v", err)
+	}
+
+	if err := os.WriteFile(os.Args[2], append(data, '\n'), 0644); err != nil {
+		log.Fatalf("Error writing manifest: %v", err)
+	}
+
+	log.Printf("Wrote the manifest of %s version %s, pack hash %s", os.Args[1], manifest.Version, manifest.PackHash)
+}
@@ -0,0 +1,13 @@
+// Package coldstore provides object stores for inventory entries archived out of the database
+package coldstore
+
+import "errors"
+
+// ErrNotFound is returned when an object does not exist
+var ErrNotFound = errors.New("object not found")
+
+// Store keeps named objects, names are slash separated paths
+type Store interface {
+	Put(name string, data []byte) error
+	Get(name string) ([]byte, error)
+}
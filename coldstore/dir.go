@@ -0,0 +1,64 @@
+package coldstore
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Dir stores objects as files under a local directory
+type Dir struct {
+	root string
+}
+
+// NewDir creates a store in root, creating the directory if needed
+func NewDir(root string) (*Dir, error) {
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create archive directory: %w", err)
+	}
+	return &Dir{root: root}, nil
+}
+
+// path maps an object name to a file, refusing names escaping the root
+func (d *Dir) path(name string) (string, error) {
+	clean := filepath.Clean(filepath.FromSlash(name))
+	if clean == "." || filepath.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid object name %q", name)
+	}
+	return filepath.Join(d.root, clean), nil
+}
+
+// Put implements Store, writing through a temporary file so readers never see partial objects
+func (d *Dir) Put(name string, data []byte) error {
+	path, err := d.path(name)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Get implements Store
+func (d *Dir) Get(name string) ([]byte, error) {
+	path, err := d.path(name)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%s: %w", name, ErrNotFound)
+	}
+	return data, err
+}
@@ -0,0 +1,31 @@
+package coldstore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDir(t *testing.T) {
+	store, err := NewDir(t.TempDir())
+	require.NoError(t, err)
+
+	require.NoError(t, store.Put("2025/alice.tar.gz", []byte("archive")))
+	data, err := store.Get("2025/alice.tar.gz")
+	require.NoError(t, err)
+	assert.Equal(t, "archive", string(data))
+
+	// Objects are replaced as a whole
+	require.NoError(t, store.Put("2025/alice.tar.gz", []byte("newer")))
+	data, err = store.Get("2025/alice.tar.gz")
+	require.NoError(t, err)
+	assert.Equal(t, "newer", string(data))
+
+	_, err = store.Get("2025/bob.tar.gz")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	for _, name := range []string{"../escape", "/etc/passwd", ".", ""} {
+		assert.Error(t, store.Put(name, []byte("x")), name)
+	}
+}
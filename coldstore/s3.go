@@ -0,0 +1,193 @@
+package coldstore
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// S3Config locates a bucket on an S3 compatible endpoint
+type S3Config struct {
+	Endpoint  string // Base URL such as https://s3.eu-central-1.amazonaws.com or http://minio:9000
+	Bucket    string
+	Region    string // Defaults to us-east-1, which most compatible servers accept
+	AccessKey string
+	SecretKey string
+	Prefix    string // Prepended to every object name
+}
+
+// S3 stores objects in a bucket of an S3 compatible endpoint, using path style requests
+// signed with AWS signature version 4
+type S3 struct {
+	config S3Config
+	base   *url.URL
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewS3 creates a store for the configured bucket
+func NewS3(config S3Config) (*S3, error) {
+	base, err := url.Parse(config.Endpoint)
+	if err != nil || base.Host == "" || (base.Scheme != "http" && base.Scheme != "https") {
+		return nil, fmt.Errorf("invalid S3 endpoint %q", config.Endpoint)
+	}
+	if config.Bucket == "" {
+		return nil, fmt.Errorf("S3 bucket cannot be empty")
+	}
+	if config.Region == "" {
+		config.Region = "us-east-1"
+	}
+
+	return &S3{
+		config: config,
+		base:   base,
+		client: &http.Client{Timeout: time.Minute},
+		now:    time.Now,
+	}, nil
+}
+
+// Put implements Store
+func (s *S3) Put(name string, data []byte) error {
+	resp, err := s.do(http.MethodPut, name, data)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return s.statusError(resp, name)
+	}
+	return nil
+}
+
+// Get implements Store
+func (s *S3) Get(name string) ([]byte, error) {
+	resp, err := s.do(http.MethodGet, name, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return io.ReadAll(resp.Body)
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("%s: %w", name, ErrNotFound)
+	default:
+		return nil, s.statusError(resp, name)
+	}
+}
+
+func (s *S3) statusError(resp *http.Response, name string) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return fmt.Errorf("S3 %s %s: %s: %s", resp.Request.Method, name, resp.Status, strings.TrimSpace(string(body)))
+}
+
+func (s *S3) do(method, name string, body []byte) (*http.Response, error) {
+	target := *s.base
+	target.Path = strings.TrimSuffix(s.base.Path, "/") + "/" + s.config.Bucket + "/" + s.config.Prefix + name
+	target.RawPath = escapePath(target.Path)
+
+	req, err := http.NewRequest(method, target.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if body == nil {
+		req.Body = nil
+	}
+
+	s.sign(req, body)
+	return s.client.Do(req)
+}
+
+// sign adds AWS signature version 4 headers, signing the host and every header already set
+func (s *S3) sign(req *http.Request, body []byte) {
+	now := s.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	payloadHash := sha256Hex(body)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for key, values := range req.Header {
+		headers[strings.ToLower(key)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		escapePath(req.URL.Path),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.config.Region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+
+	key := hmacSHA256([]byte("AWS4"+s.config.SecretKey), date)
+	key = hmacSHA256(key, s.config.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.config.AccessKey, scope, signedHeaders, signature))
+}
+
+// escapePath encodes a path the way AWS expects, keeping only unreserved characters and slashes
+func escapePath(path string) string {
+	var escaped strings.Builder
+	for _, b := range []byte(path) {
+		if b == '/' || b == '-' || b == '_' || b == '.' || b == '~' ||
+			('a' <= b && b <= 'z') || ('A' <= b && b <= 'Z') || ('0' <= b && b <= '9') {
+			escaped.WriteByte(b)
+			continue
+		}
+		fmt.Fprintf(&escaped, "%%%02X", b)
+	}
+	return escaped.String()
+}
+
+func canonicalQuery(query url.Values) string {
+	pairs := make([]string, 0, len(query))
+	for key, values := range query {
+		for _, value := range values {
+			pairs = append(pairs, escapePath(key)+"="+strings.ReplaceAll(escapePath(value), "/", "%2F"))
+		}
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "&")
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -14,6 +14,189 @@ type Config struct {
 	WebAddress    string
 	GRPCPort      int
 	BannedNodes   []string
+	OTLPEndpoint  string
+	OTLPHeaders   map[string]string
+	OriginFormat  string
+	AdminAddress  string
+	AdminToken    string
+
+	// Records per second streamed by the admin NDJSON export, 0 for no limit
+	AdminExportRate int
+
+	// Token granting read-only admin access, inventories shown to it hide AdminRedactFields
+	AdminViewerToken  string
+	AdminRedactFields []string // nameTag, lore
+
+	// Ports BDS is moved to when its configured ports are taken, e.g. 19132-19200, empty refuses to start
+	BDSPortRange string
+
+	// World save checkpoints kept for coordinated world and inventory restores, 0 keeps all
+	CheckpointRetention int
+
+	// Tries of the retryable startup phases (db, server download, network) and seconds between them
+	StartupAttempts   int
+	StartupRetryDelay int
+
+	// How bans announced by peers in their handshake are adopted: union, intersection or manual
+	BanPolicy string
+	// Keys of allied networks whose exported ban lists can be imported, as issuer=hex ed25519 public key
+	// pairs, issuers with a pinned peer key are trusted too
+	BanListKeys map[string]string
+
+	// Players kept on the server in maintenance mode, everyone else is kicked until it ends
+	MaintenancePlayers []string
+
+	// JSON file of in-game message templates by key, e.g. a translation, built-in English when empty
+	MessagesFile string
+
+	// Largest peer protocol message accepted or sent, larger frames are refused
+	PeerMaxMessageBytes int
+
+	// Web addresses of the peers given the database snapshot and player records, * for every peer,
+	// other peers still handshake and push signed updates, shard members are always allowed
+	PeerAllowlist []string
+
+	// Operator nodes whose signatures count toward an emergency freeze of inventory updates,
+	// and how many of them must sign a freeze or unfreeze, 0 for a majority
+	FreezeSigners []string
+	FreezeQuorum  int
+
+	// Nodes whose administrators publish the network configuration, whether configurations received
+	// from peers are applied (accept), wait for the operator (review) or are ignored, and the
+	// settings kept as configured here, named as in the configuration document
+	NetworkConfigSigners []string
+	NetworkConfigPolicy  string
+	NetworkConfigKeep    []string
+
+	// Local-only fallback, seconds between attempts to reach ConnectedNode and
+	// how long it may stay unreachable after a successful join
+	PeerRetryInterval int
+	LocalOnlyGrace    int
+
+	// Milliseconds a spawning player's missing inventory may be fetched from each peer for,
+	// lowest latency peer first, zero waits for the next sync instead
+	PeerFetchTimeout int
+
+	// Minutes players may stay online without any inventory update being ingested before the
+	// pipeline is reported as stalled, zero disables the watchdog
+	IngestStallTimeout int
+
+	// A key signing or verifying more than KeyAnomalyFactor times its usual count for the hour of the
+	// day, and at least KeyAnomalyMinimum times within the hour, is reported as possibly stolen
+	// Zero KeyAnomalyFactor disables the detection
+	KeyAnomalyFactor  int
+	KeyAnomalyMinimum int
+
+	// Keys of this node and pinned keys of peers should be rotated once they are KeyMaxAgeDays old,
+	// warnings start KeyRotationWarnDays before, zero KeyMaxAgeDays keeps keys forever
+	KeyMaxAgeDays       int
+	KeyRotationWarnDays int
+
+	// Hash algorithms this node supports for fingerprints and data compared with peers, most
+	// preferred first, the first one computes item fingerprints and must match across the network
+	HashAlgorithms []string
+
+	// Peer protocol over WebSocket, disabled when WebSocketAddress is empty
+	// Without a certificate plain HTTP is served, for TLS terminated by a reverse proxy
+	WebSocketAddress string
+	WebSocketPath    string
+	WebSocketTLSCert string
+	WebSocketTLSKey  string
+
+	// Console operators as name=hash pairs, hashes printed by the hash-secret command, required for
+	// op, deop, ban and give on stdin, also when run through execute
+	ConsoleOperators          map[string]string
+	ConsoleConfirmDestructive bool
+	ConsolePIN                string   // hash-secret hash of the passphrase unlocking stdin, the console starts locked when set
+	ConsoleCommands           []string // Commands stdin sends without an operator login, every command when empty
+
+	// Sharding, web address=dial address of every member including this node, disabled when empty
+	ShardNodes    map[string]string
+	ShardReplicas int // Virtual points per member on the hash ring
+
+	// Item namespaces accepted into the database, empty allows every namespace
+	// Items from other namespaces are stripped, or the inventory is rejected with NamespaceAction "reject"
+	AllowedNamespaces []string
+	NamespaceAction   string
+
+	// Hex ed25519 keys trusted to sign the embedded mcpack manifest, the manifest must be signed by
+	// one of them; when empty signatures are not checked and startup warns that verification is off
+	PackTrustedKeys []string
+
+	// .mcworld imported into worlds/ on first start so new members begin from the network's
+	// standard world, and its expected hex SHA-256, unchecked when empty
+	WorldTemplate       string
+	WorldTemplateSHA256 string
+
+	// Resource limits of the bedrock_server process, zero leaves a limit unset
+	// Memory and cpu weight are enforced through a cgroup v2 created under BDSCgroupRoot
+	BDSMemoryMax  int // MiB, the server is restarted when it gets close to the limit
+	BDSCPUWeight  int
+	BDSNice       int
+	BDSCgroupRoot string
+
+	// Inventory updates waiting to be stored: kept per player before coalescing into the latest,
+	// and over all players past which only the latest of each player is kept, zero keeps 16 and 1000
+	UpdateQueueSize     int
+	UpdateShedThreshold int
+
+	// Local times of day BDS is restarted at, e.g. "05:00" or "05:00,17:00", disabled when empty
+	RestartSchedule string
+
+	// Pattern of server output lines reporting the real address of a player behind a proxy, with the
+	// named groups player and address, e.g. `\[Proxy\] (?P<player>.+) joined from (?P<address>\S+)`
+	// Sessions are recorded without addresses when empty
+	PlayerAddressPattern string
+
+	// Archive of inventory entries older than ArchiveAfterDays, disabled when zero
+	// Entries go to an S3 compatible bucket when ArchiveS3Endpoint is set, to ArchiveDir otherwise
+	ArchiveAfterDays   int
+	ArchiveDir         string
+	ArchiveS3Endpoint  string
+	ArchiveS3Bucket    string
+	ArchiveS3Region    string
+	ArchiveS3AccessKey string
+	ArchiveS3SecretKey string
+	ArchiveS3Prefix    string
+
+	// Audit records and rejected updates are journaled and exported every EventExportInterval minutes as csv or
+	// parquet files, to the archive bucket under EventExportS3Prefix when ArchiveS3Endpoint is set and to
+	// EventExportDir otherwise, disabled when zero
+	EventExportInterval int
+	EventExportFormat   string
+	EventExportDir      string
+	EventExportS3Prefix string
+
+	// Peer entries are re-validated against the origins their items claim and refused unless those servers
+	// were seen online at the time of the entry, sightings UptimeTolerance seconds apart count as online
+	VerifyPeerEntries bool
+	UptimeTolerance   int
+	// What verified peer entries holding invalid items become: reject, strip or quarantine
+	PeerInvalidItems string
+	// Verified peer entries holding items that claim an origin server seen online by fewer than OriginQuorum
+	// nodes within OriginQuorumWindow seconds count as invalid, an origin stays admitted once it met the quorum
+	// Zero OriginQuorum admits every origin
+	OriginQuorum       int
+	OriginQuorumWindow int
+
+	// Days tombstones of deleted players are kept, peers offline for longer may restore them
+	TombstoneGraceDays int
+	// Days the data removed by a ban is kept for restore-server, zero deletes it right away
+	BanSnapshotDays int
+
+	// Database Put, Get, Delete and StreamAll calls slower than DBSlowThreshold milliseconds are logged, zero disables the log
+	DBSlowThreshold int
+
+	// Debug dump of received inventory payloads, disabled when DebugDumpDir is empty
+	DebugDumpDir            string
+	DebugDumpMaxBytes       int
+	DebugDumpInterval       int // Seconds between dumps per player
+	DebugDumpRedactNameTags bool
+
+	// Stack traces of recovered panics are written to CrashDir, and anonymized reports of them
+	// posted to CrashReportURL when it is set
+	CrashDir       string
+	CrashReportURL string
 }
 
 func New() *Config {
@@ -27,6 +210,123 @@ func New() *Config {
 		WebAddress:    getEnvString("WEB_ADDRESS", "localhost"),
 		GRPCPort:      getEnvInt("GRPC_PORT", 32842),
 		BannedNodes:   getEnvStringSlice("BANNED_NODES", []string{}),
+		OTLPEndpoint:  getEnvString("OTEL_EXPORTER_OTLP_ENDPOINT", ""),
+		OTLPHeaders:   getEnvStringMap("OTEL_EXPORTER_OTLP_HEADERS"),
+		OriginFormat:  getEnvString("ORIGIN_FORMAT", "Origin: <server>"),
+		AdminAddress:  getEnvString("ADMIN_ADDRESS", "127.0.0.1:32843"),
+		AdminToken:    getEnvString("ADMIN_TOKEN", ""),
+
+		AdminExportRate: getEnvInt("ADMIN_EXPORT_RATE", 1000),
+
+		AdminViewerToken:  getEnvString("ADMIN_VIEWER_TOKEN", ""),
+		AdminRedactFields: getEnvStringSlice("ADMIN_REDACT_FIELDS", []string{"nameTag", "lore"}),
+
+		BDSPortRange: getEnvString("BDS_PORT_RANGE", ""),
+
+		CheckpointRetention: getEnvInt("CHECKPOINT_RETENTION", 50),
+
+		StartupAttempts:   getEnvInt("STARTUP_ATTEMPTS", 3),
+		StartupRetryDelay: getEnvInt("STARTUP_RETRY_DELAY", 5),
+
+		BanPolicy:   getEnvString("BAN_POLICY", "manual"),
+		BanListKeys: getEnvStringMap("BAN_LIST_KEYS"),
+
+		MaintenancePlayers: getEnvStringSlice("MAINTENANCE_PLAYERS", []string{}),
+
+		MessagesFile: getEnvString("MESSAGES_FILE", ""),
+
+		PeerMaxMessageBytes: getEnvInt("PEER_MAX_MESSAGE_BYTES", 4<<20),
+
+		PeerAllowlist: getEnvStringSlice("PEER_ALLOWLIST", []string{}),
+
+		FreezeSigners: getEnvStringSlice("FREEZE_SIGNERS", []string{}),
+		FreezeQuorum:  getEnvInt("FREEZE_QUORUM", 0),
+
+		NetworkConfigSigners: getEnvStringSlice("NETWORK_CONFIG_SIGNERS", []string{}),
+		NetworkConfigPolicy:  getEnvString("NETWORK_CONFIG_POLICY", "accept"),
+		NetworkConfigKeep:    getEnvStringSlice("NETWORK_CONFIG_KEEP", []string{}),
+
+		PeerRetryInterval: getEnvInt("PEER_RETRY_INTERVAL", 60),
+		LocalOnlyGrace:    getEnvInt("LOCAL_ONLY_GRACE", 300),
+
+		PeerFetchTimeout: getEnvInt("PEER_FETCH_TIMEOUT", 2000),
+
+		IngestStallTimeout: getEnvInt("INGEST_STALL_TIMEOUT", 60),
+
+		KeyAnomalyFactor:  getEnvInt("KEY_ANOMALY_FACTOR", 10),
+		KeyAnomalyMinimum: getEnvInt("KEY_ANOMALY_MINIMUM", 100),
+
+		KeyMaxAgeDays:       getEnvInt("KEY_MAX_AGE_DAYS", 0),
+		KeyRotationWarnDays: getEnvInt("KEY_ROTATION_WARN_DAYS", 30),
+
+		HashAlgorithms: getEnvStringSlice("HASH_ALGORITHMS", []string{"sha256", "blake3"}),
+
+		WebSocketAddress: getEnvString("WEBSOCKET_ADDRESS", ""),
+		WebSocketPath:    getEnvString("WEBSOCKET_PATH", "/consensuscraft"),
+		WebSocketTLSCert: getEnvString("WEBSOCKET_TLS_CERT", ""),
+		WebSocketTLSKey:  getEnvString("WEBSOCKET_TLS_KEY", ""),
+
+		ConsoleOperators:          getEnvStringMap("CONSOLE_OPERATORS"),
+		ConsoleConfirmDestructive: getEnvBool("CONSOLE_CONFIRM_DESTRUCTIVE", false),
+		ConsolePIN:                getEnvString("CONSOLE_PIN", ""),
+		ConsoleCommands:           getEnvStringSlice("CONSOLE_COMMANDS", []string{}),
+
+		ShardNodes:    getEnvStringMap("SHARD_NODES"),
+		ShardReplicas: getEnvInt("SHARD_REPLICAS", 128),
+
+		AllowedNamespaces: getEnvStringSlice("ALLOWED_NAMESPACES", []string{}),
+		NamespaceAction:   getEnvString("NAMESPACE_ACTION", "strip"),
+
+		PackTrustedKeys: getEnvStringSlice("PACK_TRUSTED_KEYS", []string{}),
+
+		WorldTemplate:       getEnvString("WORLD_TEMPLATE", ""),
+		WorldTemplateSHA256: getEnvString("WORLD_TEMPLATE_SHA256", ""),
+
+		BDSMemoryMax:  getEnvInt("BDS_MEMORY_MAX", 0),
+		BDSCPUWeight:  getEnvInt("BDS_CPU_WEIGHT", 0),
+		BDSNice:       getEnvInt("BDS_NICE", 0),
+		BDSCgroupRoot: getEnvString("BDS_CGROUP_ROOT", ""),
+
+		UpdateQueueSize:     getEnvInt("UPDATE_QUEUE_SIZE", 0),
+		UpdateShedThreshold: getEnvInt("UPDATE_SHED_THRESHOLD", 0),
+
+		RestartSchedule: getEnvString("RESTART_SCHEDULE", ""),
+
+		PlayerAddressPattern: getEnvString("PLAYER_ADDRESS_PATTERN", ""),
+
+		ArchiveAfterDays:   getEnvInt("ARCHIVE_AFTER_DAYS", 0),
+		ArchiveDir:         getEnvString("ARCHIVE_DIR", "inventories.archive"),
+		ArchiveS3Endpoint:  getEnvString("ARCHIVE_S3_ENDPOINT", ""),
+		ArchiveS3Bucket:    getEnvString("ARCHIVE_S3_BUCKET", ""),
+		ArchiveS3Region:    getEnvString("ARCHIVE_S3_REGION", ""),
+		ArchiveS3AccessKey: getEnvString("ARCHIVE_S3_ACCESS_KEY", ""),
+		ArchiveS3SecretKey: getEnvString("ARCHIVE_S3_SECRET_KEY", ""),
+		ArchiveS3Prefix:    getEnvString("ARCHIVE_S3_PREFIX", ""),
+
+		EventExportInterval: getEnvInt("EVENT_EXPORT_INTERVAL", 0),
+		EventExportFormat:   getEnvString("EVENT_EXPORT_FORMAT", "csv"),
+		EventExportDir:      getEnvString("EVENT_EXPORT_DIR", "events.export"),
+		EventExportS3Prefix: getEnvString("EVENT_EXPORT_S3_PREFIX", "events/"),
+
+		VerifyPeerEntries: getEnvBool("VERIFY_PEER_ENTRIES", true),
+		UptimeTolerance:   getEnvInt("UPTIME_TOLERANCE", 600),
+		PeerInvalidItems:  getEnvString("PEER_INVALID_ITEMS", "reject"),
+
+		OriginQuorum:       getEnvInt("ORIGIN_QUORUM", 0),
+		OriginQuorumWindow: getEnvInt("ORIGIN_QUORUM_WINDOW", 3600),
+
+		TombstoneGraceDays: getEnvInt("TOMBSTONE_GRACE_DAYS", 30),
+		BanSnapshotDays:    getEnvInt("BAN_SNAPSHOT_DAYS", 30),
+
+		DBSlowThreshold: getEnvInt("DB_SLOW_THRESHOLD", 250),
+
+		DebugDumpDir:            getEnvString("DEBUG_DUMP_DIR", ""),
+		DebugDumpMaxBytes:       getEnvInt("DEBUG_DUMP_MAX_BYTES", 1<<20),
+		DebugDumpInterval:       getEnvInt("DEBUG_DUMP_INTERVAL", 1),
+		DebugDumpRedactNameTags: getEnvBool("DEBUG_DUMP_REDACT_NAME_TAGS", false),
+
+		CrashDir:       getEnvString("CRASH_DIR", "crashes"),
+		CrashReportURL: getEnvString("CRASH_REPORT_URL", ""),
 	}
 }
 
@@ -47,6 +347,16 @@ func getEnvInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+func getEnvBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolValue, err := strconv.ParseBool(value); err == nil {
+			return boolValue
+		}
+		log.Printf("Warning: Invalid boolean value for %s: %s, using default: %t", key, value, defaultValue)
+	}
+	return defaultValue
+}
+
 func getEnvStringSlice(key string, defaultValue []string) []string {
 	if value := os.Getenv(key); value != "" {
 		// Split by comma and trim whitespace from each element
@@ -61,3 +371,17 @@ func getEnvStringSlice(key string, defaultValue []string) []string {
 	}
 	return defaultValue
 }
+
+// getEnvStringMap parses comma separated key=value pairs
+func getEnvStringMap(key string) map[string]string {
+	result := make(map[string]string)
+	for _, pair := range getEnvStringSlice(key, nil) {
+		k, v, ok := strings.Cut(pair, "=")
+		if !ok {
+			log.Printf("Warning: Invalid key=value pair in %s: %s", key, pair)
+			continue
+		}
+		result[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+	return result
+}
@@ -126,3 +126,619 @@ func TestBannedNodesNotSet(t *testing.T) {
 	config := New()
 	assert.Empty(t, config.BannedNodes, "BannedNodes should be empty when env var not set")
 }
+
+func TestOTLPSettings(t *testing.T) {
+	os.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://collector:4318")
+	os.Setenv("OTEL_EXPORTER_OTLP_HEADERS", "Authorization=Bearer token, X-Tenant = survival,invalid")
+
+	defer os.Clearenv()
+
+	config := New()
+
+	assert.Equal(t, "http://collector:4318", config.OTLPEndpoint)
+	assert.Equal(t, map[string]string{
+		"Authorization": "Bearer token",
+		"X-Tenant":      "survival",
+	}, config.OTLPHeaders)
+}
+
+func TestOriginFormat(t *testing.T) {
+	os.Clearenv()
+	assert.Equal(t, "Origin: <server>", New().OriginFormat)
+
+	os.Setenv("ORIGIN_FORMAT", "⚒ Forged on <server>")
+	defer os.Clearenv()
+
+	assert.Equal(t, "⚒ Forged on <server>", New().OriginFormat)
+}
+
+func TestDebugDumpSettings(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Empty(t, config.DebugDumpDir)
+	assert.Equal(t, 1<<20, config.DebugDumpMaxBytes)
+	assert.Equal(t, 1, config.DebugDumpInterval)
+	assert.False(t, config.DebugDumpRedactNameTags)
+
+	os.Setenv("DEBUG_DUMP_DIR", "dumps")
+	os.Setenv("DEBUG_DUMP_MAX_BYTES", "4096")
+	os.Setenv("DEBUG_DUMP_INTERVAL", "10")
+	os.Setenv("DEBUG_DUMP_REDACT_NAME_TAGS", "true")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, "dumps", config.DebugDumpDir)
+	assert.Equal(t, 4096, config.DebugDumpMaxBytes)
+	assert.Equal(t, 10, config.DebugDumpInterval)
+	assert.True(t, config.DebugDumpRedactNameTags)
+
+	os.Setenv("DEBUG_DUMP_REDACT_NAME_TAGS", "maybe")
+	assert.False(t, New().DebugDumpRedactNameTags)
+}
+
+func TestAdminSettings(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Equal(t, "127.0.0.1:32843", config.AdminAddress)
+	assert.Empty(t, config.AdminToken)
+
+	os.Setenv("ADMIN_ADDRESS", ":8080")
+	os.Setenv("ADMIN_TOKEN", "secret")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, ":8080", config.AdminAddress)
+	assert.Equal(t, "secret", config.AdminToken)
+}
+
+func TestAdminViewer(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Empty(t, config.AdminViewerToken)
+	assert.Equal(t, []string{"nameTag", "lore"}, config.AdminRedactFields)
+
+	os.Setenv("ADMIN_VIEWER_TOKEN", "community")
+	os.Setenv("ADMIN_REDACT_FIELDS", "lore")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, "community", config.AdminViewerToken)
+	assert.Equal(t, []string{"lore"}, config.AdminRedactFields)
+}
+
+func TestConsoleOperators(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Empty(t, config.ConsoleOperators)
+	assert.False(t, config.ConsoleConfirmDestructive)
+
+	os.Setenv("CONSOLE_OPERATORS", "alice=abc123,bob=def456")
+	os.Setenv("CONSOLE_CONFIRM_DESTRUCTIVE", "true")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, map[string]string{"alice": "abc123", "bob": "def456"}, config.ConsoleOperators)
+	assert.True(t, config.ConsoleConfirmDestructive)
+}
+
+func TestConsoleGate(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Empty(t, config.ConsolePIN)
+	assert.Empty(t, config.ConsoleCommands)
+
+	os.Setenv("CONSOLE_PIN", "abc123")
+	os.Setenv("CONSOLE_COMMANDS", "list,say")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, "abc123", config.ConsolePIN)
+	assert.Equal(t, []string{"list", "say"}, config.ConsoleCommands)
+}
+
+func TestWebSocketSettings(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Empty(t, config.WebSocketAddress)
+	assert.Equal(t, "/consensuscraft", config.WebSocketPath)
+
+	os.Setenv("WEBSOCKET_ADDRESS", ":443")
+	os.Setenv("WEBSOCKET_PATH", "/sync")
+	os.Setenv("WEBSOCKET_TLS_CERT", "cert.pem")
+	os.Setenv("WEBSOCKET_TLS_KEY", "key.pem")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, ":443", config.WebSocketAddress)
+	assert.Equal(t, "/sync", config.WebSocketPath)
+	assert.Equal(t, "cert.pem", config.WebSocketTLSCert)
+	assert.Equal(t, "key.pem", config.WebSocketTLSKey)
+}
+
+func TestLocalOnlySettings(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Equal(t, 60, config.PeerRetryInterval)
+	assert.Equal(t, 300, config.LocalOnlyGrace)
+
+	os.Setenv("PEER_RETRY_INTERVAL", "10")
+	os.Setenv("LOCAL_ONLY_GRACE", "30")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, 10, config.PeerRetryInterval)
+	assert.Equal(t, 30, config.LocalOnlyGrace)
+}
+
+func TestAllowedNamespaces(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Empty(t, config.AllowedNamespaces)
+	assert.Equal(t, "strip", config.NamespaceAction)
+
+	os.Setenv("ALLOWED_NAMESPACES", "minecraft,mymod")
+	os.Setenv("NAMESPACE_ACTION", "reject")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, []string{"minecraft", "mymod"}, config.AllowedNamespaces)
+	assert.Equal(t, "reject", config.NamespaceAction)
+}
+
+func TestShardSettings(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Empty(t, config.ShardNodes)
+	assert.Equal(t, 128, config.ShardReplicas)
+
+	os.Setenv("SHARD_NODES", "a.example.com=10.0.0.1:32842,b.example.com=wss://b.example.com/consensuscraft")
+	os.Setenv("SHARD_REPLICAS", "64")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, map[string]string{
+		"a.example.com": "10.0.0.1:32842",
+		"b.example.com": "wss://b.example.com/consensuscraft",
+	}, config.ShardNodes)
+	assert.Equal(t, 64, config.ShardReplicas)
+}
+
+func TestBDSLimits(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Zero(t, config.BDSMemoryMax)
+	assert.Zero(t, config.BDSCPUWeight)
+	assert.Zero(t, config.BDSNice)
+	assert.Empty(t, config.BDSCgroupRoot)
+
+	os.Setenv("BDS_MEMORY_MAX", "4096")
+	os.Setenv("BDS_CPU_WEIGHT", "50")
+	os.Setenv("BDS_NICE", "5")
+	os.Setenv("BDS_CGROUP_ROOT", "/sys/fs/cgroup/games")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, 4096, config.BDSMemoryMax)
+	assert.Equal(t, 50, config.BDSCPUWeight)
+	assert.Equal(t, 5, config.BDSNice)
+	assert.Equal(t, "/sys/fs/cgroup/games", config.BDSCgroupRoot)
+}
+
+func TestUpdateQueue(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Zero(t, config.UpdateQueueSize)
+	assert.Zero(t, config.UpdateShedThreshold)
+
+	os.Setenv("UPDATE_QUEUE_SIZE", "32")
+	os.Setenv("UPDATE_SHED_THRESHOLD", "5000")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, 32, config.UpdateQueueSize)
+	assert.Equal(t, 5000, config.UpdateShedThreshold)
+}
+
+func TestArchiveSettings(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Zero(t, config.ArchiveAfterDays)
+	assert.Equal(t, "inventories.archive", config.ArchiveDir)
+	assert.Empty(t, config.ArchiveS3Endpoint)
+
+	os.Setenv("ARCHIVE_AFTER_DAYS", "90")
+	os.Setenv("ARCHIVE_S3_ENDPOINT", "https://s3.example.com")
+	os.Setenv("ARCHIVE_S3_BUCKET", "inventories")
+	os.Setenv("ARCHIVE_S3_REGION", "eu-central-1")
+	os.Setenv("ARCHIVE_S3_ACCESS_KEY", "key")
+	os.Setenv("ARCHIVE_S3_SECRET_KEY", "secret")
+	os.Setenv("ARCHIVE_S3_PREFIX", "node1/")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, 90, config.ArchiveAfterDays)
+	assert.Equal(t, "https://s3.example.com", config.ArchiveS3Endpoint)
+	assert.Equal(t, "inventories", config.ArchiveS3Bucket)
+	assert.Equal(t, "eu-central-1", config.ArchiveS3Region)
+	assert.Equal(t, "key", config.ArchiveS3AccessKey)
+	assert.Equal(t, "secret", config.ArchiveS3SecretKey)
+	assert.Equal(t, "node1/", config.ArchiveS3Prefix)
+}
+
+func TestEventExportSettings(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Zero(t, config.EventExportInterval)
+	assert.Equal(t, "csv", config.EventExportFormat)
+	assert.Equal(t, "events.export", config.EventExportDir)
+	assert.Equal(t, "events/", config.EventExportS3Prefix)
+
+	os.Setenv("EVENT_EXPORT_INTERVAL", "60")
+	os.Setenv("EVENT_EXPORT_FORMAT", "parquet")
+	os.Setenv("EVENT_EXPORT_DIR", "/var/lib/consensuscraft/events")
+	os.Setenv("EVENT_EXPORT_S3_PREFIX", "node1/events/")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, 60, config.EventExportInterval)
+	assert.Equal(t, "parquet", config.EventExportFormat)
+	assert.Equal(t, "/var/lib/consensuscraft/events", config.EventExportDir)
+	assert.Equal(t, "node1/events/", config.EventExportS3Prefix)
+}
+
+func TestPackTrustedKeys(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Empty(t, config.PackTrustedKeys)
+
+	os.Setenv("PACK_TRUSTED_KEYS", "abc123,def456")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, []string{"abc123", "def456"}, config.PackTrustedKeys)
+}
+
+func TestWorldTemplate(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Empty(t, config.WorldTemplate)
+	assert.Empty(t, config.WorldTemplateSHA256)
+
+	os.Setenv("WORLD_TEMPLATE", "network.mcworld")
+	os.Setenv("WORLD_TEMPLATE_SHA256", "c0ffee")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, "network.mcworld", config.WorldTemplate)
+	assert.Equal(t, "c0ffee", config.WorldTemplateSHA256)
+}
+
+func TestBanPolicy(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Equal(t, "manual", config.BanPolicy)
+
+	os.Setenv("BAN_POLICY", "union")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, "union", config.BanPolicy)
+}
+
+func TestBanListKeys(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Empty(t, config.BanListKeys)
+
+	os.Setenv("BAN_LIST_KEYS", "ally.example.com=abcd")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, map[string]string{"ally.example.com": "abcd"}, config.BanListKeys)
+}
+
+func TestStartupSettings(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Equal(t, 3, config.StartupAttempts)
+	assert.Equal(t, 5, config.StartupRetryDelay)
+
+	os.Setenv("STARTUP_ATTEMPTS", "5")
+	os.Setenv("STARTUP_RETRY_DELAY", "10")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, 5, config.StartupAttempts)
+	assert.Equal(t, 10, config.StartupRetryDelay)
+}
+
+func TestMaintenancePlayers(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Empty(t, config.MaintenancePlayers)
+
+	os.Setenv("MAINTENANCE_PLAYERS", "alice,bob")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, []string{"alice", "bob"}, config.MaintenancePlayers)
+}
+
+func TestMessagesFile(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Empty(t, config.MessagesFile)
+
+	os.Setenv("MESSAGES_FILE", "messages.de.json")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, "messages.de.json", config.MessagesFile)
+}
+
+func TestPeerMaxMessageBytes(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Equal(t, 4<<20, config.PeerMaxMessageBytes)
+
+	os.Setenv("PEER_MAX_MESSAGE_BYTES", "1048576")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, 1<<20, config.PeerMaxMessageBytes)
+}
+
+func TestPeerAllowlist(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Empty(t, config.PeerAllowlist)
+
+	os.Setenv("PEER_ALLOWLIST", "a.example.com,b.example.com")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, []string{"a.example.com", "b.example.com"}, config.PeerAllowlist)
+}
+
+func TestFreeze(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Empty(t, config.FreezeSigners)
+	assert.Zero(t, config.FreezeQuorum)
+
+	os.Setenv("FREEZE_SIGNERS", "a.example.com,b.example.com,c.example.com")
+	os.Setenv("FREEZE_QUORUM", "2")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, []string{"a.example.com", "b.example.com", "c.example.com"}, config.FreezeSigners)
+	assert.Equal(t, 2, config.FreezeQuorum)
+}
+
+func TestNetworkConfig(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Empty(t, config.NetworkConfigSigners)
+	assert.Equal(t, "accept", config.NetworkConfigPolicy)
+	assert.Empty(t, config.NetworkConfigKeep)
+
+	os.Setenv("NETWORK_CONFIG_SIGNERS", "a.example.com,b.example.com")
+	os.Setenv("NETWORK_CONFIG_POLICY", "review")
+	os.Setenv("NETWORK_CONFIG_KEEP", "origin_format,peer_max_message_bytes")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, []string{"a.example.com", "b.example.com"}, config.NetworkConfigSigners)
+	assert.Equal(t, "review", config.NetworkConfigPolicy)
+	assert.Equal(t, []string{"origin_format", "peer_max_message_bytes"}, config.NetworkConfigKeep)
+}
+
+func TestAdminExportRate(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Equal(t, 1000, config.AdminExportRate)
+
+	os.Setenv("ADMIN_EXPORT_RATE", "0")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Zero(t, config.AdminExportRate)
+}
+
+func TestCheckpointRetention(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Equal(t, 50, config.CheckpointRetention)
+
+	os.Setenv("CHECKPOINT_RETENTION", "5")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, 5, config.CheckpointRetention)
+}
+
+func TestRestartSchedule(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Empty(t, config.RestartSchedule)
+
+	os.Setenv("RESTART_SCHEDULE", "05:00,17:00")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, "05:00,17:00", config.RestartSchedule)
+}
+
+func TestPlayerAddressPattern(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Empty(t, config.PlayerAddressPattern)
+
+	os.Setenv("PLAYER_ADDRESS_PATTERN", `(?P<player>.+) joined from (?P<address>\S+)`)
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, `(?P<player>.+) joined from (?P<address>\S+)`, config.PlayerAddressPattern)
+}
+
+func TestBDSPortRange(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Empty(t, config.BDSPortRange)
+
+	os.Setenv("BDS_PORT_RANGE", "19132-19200")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, "19132-19200", config.BDSPortRange)
+}
+
+func TestTombstoneGraceDays(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Equal(t, 30, config.TombstoneGraceDays)
+
+	os.Setenv("TOMBSTONE_GRACE_DAYS", "7")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, 7, config.TombstoneGraceDays)
+}
+
+func TestBanSnapshotDays(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Equal(t, 30, config.BanSnapshotDays)
+
+	os.Setenv("BAN_SNAPSHOT_DAYS", "0")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Zero(t, config.BanSnapshotDays)
+}
+
+func TestDBSlowThreshold(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Equal(t, 250, config.DBSlowThreshold)
+
+	os.Setenv("DB_SLOW_THRESHOLD", "0")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Zero(t, config.DBSlowThreshold)
+}
+
+func TestPeerFetchTimeout(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Equal(t, 2000, config.PeerFetchTimeout)
+
+	os.Setenv("PEER_FETCH_TIMEOUT", "0")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Zero(t, config.PeerFetchTimeout)
+}
+
+func TestKeyAnomaly(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Equal(t, 10, config.KeyAnomalyFactor)
+	assert.Equal(t, 100, config.KeyAnomalyMinimum)
+
+	os.Setenv("KEY_ANOMALY_FACTOR", "0")
+	os.Setenv("KEY_ANOMALY_MINIMUM", "20")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Zero(t, config.KeyAnomalyFactor)
+	assert.Equal(t, 20, config.KeyAnomalyMinimum)
+}
+
+func TestKeyLifetime(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Zero(t, config.KeyMaxAgeDays)
+	assert.Equal(t, 30, config.KeyRotationWarnDays)
+
+	os.Setenv("KEY_MAX_AGE_DAYS", "365")
+	os.Setenv("KEY_ROTATION_WARN_DAYS", "14")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, 365, config.KeyMaxAgeDays)
+	assert.Equal(t, 14, config.KeyRotationWarnDays)
+}
+
+func TestIngestStallTimeout(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Equal(t, 60, config.IngestStallTimeout)
+
+	os.Setenv("INGEST_STALL_TIMEOUT", "0")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Zero(t, config.IngestStallTimeout)
+}
+
+func TestHashAlgorithms(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Equal(t, []string{"sha256", "blake3"}, config.HashAlgorithms)
+
+	os.Setenv("HASH_ALGORITHMS", "blake3,sha256")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, []string{"blake3", "sha256"}, config.HashAlgorithms)
+}
+
+func TestCrashReporting(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Equal(t, "crashes", config.CrashDir)
+	assert.Empty(t, config.CrashReportURL)
+
+	os.Setenv("CRASH_DIR", "/var/log/consensuscraft")
+	os.Setenv("CRASH_REPORT_URL", "https://crashes.example.com/report")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, "/var/log/consensuscraft", config.CrashDir)
+	assert.Equal(t, "https://crashes.example.com/report", config.CrashReportURL)
+}
+
+func TestVerifyPeerEntries(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.True(t, config.VerifyPeerEntries)
+	assert.Equal(t, 600, config.UptimeTolerance)
+	assert.Equal(t, "reject", config.PeerInvalidItems)
+
+	os.Setenv("VERIFY_PEER_ENTRIES", "false")
+	os.Setenv("UPTIME_TOLERANCE", "120")
+	os.Setenv("PEER_INVALID_ITEMS", "quarantine")
+	defer os.Clearenv()
+
+	config = New()
+	assert.False(t, config.VerifyPeerEntries)
+	assert.Equal(t, 120, config.UptimeTolerance)
+	assert.Equal(t, "quarantine", config.PeerInvalidItems)
+}
+
+func TestOriginQuorum(t *testing.T) {
+	os.Clearenv()
+	config := New()
+	assert.Zero(t, config.OriginQuorum)
+	assert.Equal(t, 3600, config.OriginQuorumWindow)
+
+	os.Setenv("ORIGIN_QUORUM", "3")
+	os.Setenv("ORIGIN_QUORUM_WINDOW", "900")
+	defer os.Clearenv()
+
+	config = New()
+	assert.Equal(t, 3, config.OriginQuorum)
+	assert.Equal(t, 900, config.OriginQuorumWindow)
+}
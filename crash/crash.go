@@ -0,0 +1,255 @@
+package crash
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// Restart backoff of tasks run with Run, doubled after every crash in a row
+const (
+	minBackoff = time.Second
+	maxBackoff = time.Minute
+)
+
+// recentCrashes is how many crashes Stats reports in full
+const recentCrashes = 20
+
+// Crash is a recovered panic of a task
+type Crash struct {
+	Task  string    `json:"task"`
+	Panic string    `json:"panic"`
+	Stack string    `json:"stack"`
+	At    time.Time `json:"at"`
+	File  string    `json:"file,omitempty"` // Crash file the stack trace was written to
+}
+
+// Stats counts the crashes since the process started
+type Stats struct {
+	Total  int            `json:"total"`
+	ByTask map[string]int `json:"by_task"`
+	Recent []Crash        `json:"recent"` // Newest first
+}
+
+// Report is the anonymized crash report submitted to the crash endpoint, it carries the type
+// of the panic and the functions on the stack but no panic message, arguments or file paths
+type Report struct {
+	Task      string    `json:"task"`
+	PanicType string    `json:"panic_type"`
+	Functions []string  `json:"functions"`
+	GoVersion string    `json:"go_version"`
+	Platform  string    `json:"platform"`
+	At        time.Time `json:"at"`
+}
+
+// Reporter records crashes, writing them to crash files and submitting reports when configured
+type Reporter struct {
+	dir      string
+	endpoint string
+	client   *http.Client
+
+	mu     sync.Mutex
+	total  int
+	byTask map[string]int
+	recent []Crash
+}
+
+var (
+	globalMu sync.RWMutex
+	global   = NewReporter("", "")
+)
+
+// NewReporter creates a reporter writing crash files to dir and posting anonymized reports to
+// endpoint, either is disabled when empty
+func NewReporter(dir, endpoint string) *Reporter {
+	return &Reporter{
+		dir:      dir,
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: 10 * time.Second},
+		byTask:   make(map[string]int),
+	}
+}
+
+// Init installs the global reporter used by Guard, Run and Record
+func Init(dir, endpoint string) {
+	globalMu.Lock()
+	defer globalMu.Unlock()
+	global = NewReporter(dir, endpoint)
+}
+
+// reporter returns the global reporter
+func reporter() *Reporter {
+	globalMu.RLock()
+	defer globalMu.RUnlock()
+	return global
+}
+
+// Record records a recovered panic of task with the global reporter
+func Record(task string, value any, stack []byte) {
+	reporter().Record(task, value, stack)
+}
+
+// CurrentStats returns the crash counters of the global reporter
+func CurrentStats() Stats {
+	return reporter().Stats()
+}
+
+// Guard recovers a panic of the calling goroutine and records it as a crash of task, it must be deferred
+func Guard(task string) {
+	if r := recover(); r != nil {
+		Record(task, r, debug.Stack())
+	}
+}
+
+// Run runs fn until it returns, restarting it with a growing backoff after each crash until ctx is done
+// Only use it for tasks that hold no state across a crash, such as retry and sync loops
+func Run(ctx context.Context, task string, fn func()) {
+	backoff := minBackoff
+	for {
+		if !runOnce(task, fn) || ctx.Err() != nil {
+			return
+		}
+
+		logger.Warnf("Restarting %s in %s after a crash", task, backoff)
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		}
+		backoff = min(backoff*2, maxBackoff)
+	}
+}
+
+// runOnce runs fn, reporting whether it crashed
+func runOnce(task string, fn func()) (crashed bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			Record(task, r, debug.Stack())
+			crashed = true
+		}
+	}()
+
+	fn()
+	return false
+}
+
+// Record logs a recovered panic, counts it, writes its crash file and submits its report
+func (r *Reporter) Record(task string, value any, stack []byte) {
+	crash := Crash{Task: task, Panic: fmt.Sprint(value), Stack: string(stack), At: time.Now()}
+	logger.Errorf("Recovered from panic in %s: %v\n%s", task, value, stack)
+
+	if r.dir != "" {
+		file, err := r.write(crash)
+		if err != nil {
+			logger.Errorf("Failed to write crash file: %v", err)
+		}
+		crash.File = file
+	}
+
+	r.mu.Lock()
+	r.total++
+	r.byTask[task]++
+	r.recent = append([]Crash{crash}, r.recent...)
+	if len(r.recent) > recentCrashes {
+		r.recent = r.recent[:recentCrashes]
+	}
+	r.mu.Unlock()
+
+	if r.endpoint != "" {
+		go r.submit(anonymize(task, value, stack, crash.At))
+	}
+}
+
+// Stats returns the crash counters and the most recent crashes
+func (r *Reporter) Stats() Stats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stats := Stats{Total: r.total, ByTask: make(map[string]int, len(r.byTask)), Recent: append([]Crash{}, r.recent...)}
+	for task, count := range r.byTask {
+		stats.ByTask[task] = count
+	}
+	return stats
+}
+
+// unsafeName matches characters kept out of crash file names
+var unsafeName = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+
+// write stores a crash in its own file in the crash directory, returning the file path
+func (r *Reporter) write(crash Crash) (string, error) {
+	if err := os.MkdirAll(r.dir, 0755); err != nil {
+		return "", err
+	}
+
+	name := fmt.Sprintf("crash-%s-%s.log", crash.At.UTC().Format("20060102T150405.000000000"), unsafeName.ReplaceAllString(crash.Task, "_"))
+	path := filepath.Join(r.dir, name)
+	content := fmt.Sprintf("task: %s\ntime: %s\npanic: %s\n\n%s", crash.Task, crash.At.UTC().Format(time.RFC3339Nano), crash.Panic, crash.Stack)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// submit posts a crash report, failures are only logged
+func (r *Reporter) submit(report Report) {
+	body, err := json.Marshal(report)
+	if err != nil {
+		return
+	}
+
+	resp, err := r.client.Post(r.endpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		logger.Warnf("Failed to submit crash report: %v", err)
+		return
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		logger.Warnf("Crash endpoint answered %s", resp.Status)
+	}
+}
+
+// anonymize strips a crash down to what identifies the bug, the panic message may hold player
+// names or addresses and the stack holds argument values and paths of the build machine
+func anonymize(task string, value any, stack []byte, at time.Time) Report {
+	report := Report{
+		Task:      task,
+		PanicType: fmt.Sprintf("%T", value),
+		GoVersion: runtime.Version(),
+		Platform:  runtime.GOOS + "/" + runtime.GOARCH,
+		At:        at.UTC().Truncate(time.Minute),
+	}
+
+	seen := make(map[string]bool)
+	for _, line := range strings.Split(string(stack), "\n") {
+		// Frames are a function line followed by a tab indented file:line, goroutine headers end with a colon
+		if line == "" || strings.HasPrefix(line, "\t") || strings.HasPrefix(line, "goroutine ") {
+			continue
+		}
+		function := strings.TrimPrefix(line, "created by ")
+		if i := strings.Index(function, " in goroutine"); i > 0 {
+			function = function[:i]
+		}
+		if i := strings.LastIndex(function, "("); i > 0 && strings.HasSuffix(function, ")") {
+			function = function[:i]
+		}
+		if !seen[function] {
+			seen[function] = true
+			report.Functions = append(report.Functions, function)
+		}
+	}
+	return report
+}
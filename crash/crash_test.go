@@ -0,0 +1,123 @@
+package crash
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"runtime/debug"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// install replaces the global reporter for the duration of a test
+func install(t *testing.T, dir, endpoint string) {
+	previous := reporter()
+	Init(dir, endpoint)
+	t.Cleanup(func() {
+		globalMu.Lock()
+		global = previous
+		globalMu.Unlock()
+	})
+}
+
+func TestGuard(t *testing.T) {
+	dir := t.TempDir()
+	install(t, dir, "")
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer Guard("worker")
+		panic("player alice broke it")
+	}()
+	<-done
+
+	stats := CurrentStats()
+	assert.Equal(t, 1, stats.Total)
+	assert.Equal(t, map[string]int{"worker": 1}, stats.ByTask)
+	require.Len(t, stats.Recent, 1)
+	assert.Equal(t, "player alice broke it", stats.Recent[0].Panic)
+	assert.Contains(t, stats.Recent[0].Stack, "TestGuard")
+
+	content, err := os.ReadFile(stats.Recent[0].File)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "task: worker")
+	assert.Contains(t, string(content), "panic: player alice broke it")
+}
+
+func TestRun(t *testing.T) {
+	install(t, "", "")
+
+	t.Run("crashed task is restarted", func(t *testing.T) {
+		runs := 0
+		Run(context.Background(), "loop", func() {
+			runs++
+			if runs == 1 {
+				panic("first run")
+			}
+		})
+		assert.Equal(t, 2, runs)
+		assert.Equal(t, 1, CurrentStats().ByTask["loop"])
+	})
+
+	t.Run("no restart once the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		runs := 0
+		Run(ctx, "stopping", func() {
+			runs++
+			cancel()
+			panic("while stopping")
+		})
+		assert.Equal(t, 1, runs)
+	})
+}
+
+func TestRecord_Submit(t *testing.T) {
+	reports := make(chan Report, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var report Report
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&report))
+		reports <- report
+	}))
+	defer server.Close()
+
+	reporter := NewReporter("", server.URL)
+	reporter.Record("sync", "failed for alice at 10.0.0.1", debug.Stack())
+
+	select {
+	case report := <-reports:
+		assert.Equal(t, "sync", report.Task)
+		assert.Equal(t, "string", report.PanicType)
+		assert.Contains(t, report.Functions, "github.com/d1nch8g/consensuscraft/crash.TestRecord_Submit")
+
+		body, err := json.Marshal(report)
+		require.NoError(t, err)
+		assert.NotContains(t, string(body), "alice")
+		assert.NotContains(t, string(body), ".go:")
+	case <-time.After(5 * time.Second):
+		t.Fatal("crash report was not submitted")
+	}
+}
+
+func TestAnonymize(t *testing.T) {
+	stack := strings.Join([]string{
+		"goroutine 7 [running]:",
+		"github.com/d1nch8g/consensuscraft/node.(*Node).watch(0xc000123)",
+		"\t/home/builder/consensuscraft/node/node.go:400 +0x1d",
+		"created by github.com/d1nch8g/consensuscraft/node.(*Node).goRun in goroutine 1",
+		"\t/home/builder/consensuscraft/node/node.go:427 +0x5f",
+	}, "\n")
+
+	report := anonymize("watch", os.ErrClosed, []byte(stack), time.Now())
+	assert.Equal(t, "*errors.errorString", report.PanicType)
+	assert.Equal(t, []string{
+		"github.com/d1nch8g/consensuscraft/node.(*Node).watch",
+		"github.com/d1nch8g/consensuscraft/node.(*Node).goRun",
+	}, report.Functions)
+}
@@ -0,0 +1,303 @@
+package database
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/logger"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+// ColdStore keeps inventory entries archived out of the database, see the coldstore package
+type ColdStore interface {
+	Put(name string, data []byte) error
+	Get(name string) ([]byte, error)
+}
+
+// ArchiveRef points at inventory entries of a player moved to the cold store
+type ArchiveRef struct {
+	Object  string    `json:"object"`
+	Before  time.Time `json:"before"` // Archived entries are older than Before
+	Entries int       `json:"entries"`
+}
+
+// ArchiveReport describes the result of an archive run
+type ArchiveReport struct {
+	Players int // Players with entries archived
+	Entries int // Entries moved to the cold store
+}
+
+// SetColdStore installs the store Archive moves entries to and GetPlayerInventories reads them back from
+func (db *DB) SetColdStore(store ColdStore) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.cold = store
+}
+
+// archivedBefore returns the time before which entries of a record were archived
+func (p *PlayerInventories) archivedBefore() time.Time {
+	var before time.Time
+	for _, ref := range p.Archives {
+		if ref.Before.After(before) {
+			before = ref.Before
+		}
+	}
+	return before
+}
+
+// Archive moves inventory entries older than before to the cold store, one tarball per player
+// The latest restorable entry of each player always stays in the database so Get is unaffected
+func (db *DB) Archive(before time.Time) (*ArchiveReport, error) {
+	db.mu.RLock()
+	if db.closed {
+		db.mu.RUnlock()
+		return nil, ErrClosed
+	}
+	if db.cold == nil {
+		db.mu.RUnlock()
+		return nil, fmt.Errorf("no cold store configured")
+	}
+
+	var players [][]byte
+	iter := db.leveldb.NewIterator(nil, nil)
+	for iter.Next() {
+		players = append(players, append([]byte{}, iter.Key()...))
+	}
+	iter.Release()
+	err := iter.Error()
+	db.mu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ArchiveReport{}
+	for _, key := range players {
+		archived, err := db.archivePlayer(key, before)
+		if err != nil {
+			return report, fmt.Errorf("failed to archive %s: %w", key, err)
+		}
+		if archived > 0 {
+			report.Players++
+			report.Entries += archived
+		}
+	}
+
+	return report, nil
+}
+
+// archivePlayer archives the old entries of one player, the upload happens without holding
+// the lock and only the uploaded entries are removed from the record afterwards
+func (db *DB) archivePlayer(key []byte, before time.Time) (int, error) {
+	db.mu.RLock()
+	playerInv, err := db.record(key)
+	cold := db.cold
+	db.mu.RUnlock()
+	if err != nil {
+		return 0, nil // Legacy and unreadable records are left to Quarantine
+	}
+
+	entries := archivable(playerInv.Entries, before)
+	if len(entries) == 0 {
+		return 0, nil
+	}
+
+	data, err := encodeArchive(entries)
+	if err != nil {
+		return 0, err
+	}
+
+	ref := ArchiveRef{
+		Object:  url.PathEscape(string(key)) + "/" + strconv.FormatInt(before.UnixNano(), 10) + ".tar.gz",
+		Before:  before,
+		Entries: len(entries),
+	}
+	if err := cold.Put(ref.Object, data); err != nil {
+		return 0, err
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if db.closed {
+		return 0, ErrClosed
+	}
+
+	playerInv, err = db.record(key)
+	if err != nil {
+		return 0, err
+	}
+
+	type entryKey struct {
+		server    string
+		timestamp int64
+	}
+	moved := make(map[entryKey]bool, len(entries))
+	for _, entry := range entries {
+		moved[entryKey{entry.Server, entry.Timestamp.UnixNano()}] = true
+	}
+
+	kept := playerInv.Entries[:0]
+	for _, entry := range playerInv.Entries {
+		if !moved[entryKey{entry.Server, entry.Timestamp.UnixNano()}] {
+			kept = append(kept, entry)
+		}
+	}
+	playerInv.Entries = kept
+	playerInv.Archives = append(playerInv.Archives, ref)
+
+	updated, err := json.Marshal(playerInv)
+	if err != nil {
+		return 0, err
+	}
+	if err := db.leveldb.Put(key, updated, nil); err != nil {
+		return 0, err
+	}
+
+	return len(entries), nil
+}
+
+// record reads and decodes a player record, db.mu must be held
+func (db *DB) record(key []byte) (*PlayerInventories, error) {
+	data, err := db.leveldb.Get(key, nil)
+	if err != nil {
+		if err == leveldb.ErrNotFound {
+			return nil, ErrPlayerNotFound
+		}
+		return nil, err
+	}
+
+	var playerInv PlayerInventories
+	if err := json.Unmarshal(data, &playerInv); err != nil {
+		return nil, err
+	}
+	return &playerInv, nil
+}
+
+// archivable returns the entries older than before that can leave the database, keeping
+// the latest restorable entry and everything newer, entries must be sorted newest first
+func archivable(entries []InventoryEntry, before time.Time) []InventoryEntry {
+	keep := 0
+	for i, entry := range entries {
+		if checkRestorable(entry.Inventory) == nil {
+			keep = i
+			break
+		}
+	}
+
+	var old []InventoryEntry
+	for _, entry := range entries[min(keep+1, len(entries)):] {
+		if entry.Timestamp.Before(before) {
+			old = append(old, entry)
+		}
+	}
+	return old
+}
+
+// archivedEntries reads the entries referenced by a record back from the cold store
+func archivedEntries(cold ColdStore, refs []ArchiveRef) ([]InventoryEntry, error) {
+	var entries []InventoryEntry
+	for _, ref := range refs {
+		data, err := cold.Get(ref.Object)
+		if err != nil {
+			return nil, err
+		}
+		archived, err := decodeArchive(data)
+		if err != nil {
+			return nil, fmt.Errorf("archive %s: %w", ref.Object, err)
+		}
+		entries = append(entries, archived...)
+	}
+	return entries, nil
+}
+
+// encodeArchive packs entries into a gzipped tarball holding one JSON file per entry
+func encodeArchive(entries []InventoryEntry) ([]byte, error) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+
+	for _, entry := range entries {
+		data, err := json.Marshal(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		header := &tar.Header{
+			Name:    fmt.Sprintf("%d-%s.json", entry.Timestamp.UnixNano(), unsafeFileChars.ReplaceAllString(entry.Server, "_")),
+			Mode:    0644,
+			Size:    int64(len(data)),
+			ModTime: entry.Timestamp,
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return nil, err
+		}
+		if _, err := tw.Write(data); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeArchive unpacks entries written by encodeArchive
+func decodeArchive(data []byte) ([]InventoryEntry, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	var entries []InventoryEntry
+	tr := tar.NewReader(gz)
+	for {
+		_, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var entry InventoryEntry
+		if err := json.NewDecoder(tr).Decode(&entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// withArchived appends the archived entries of a record to its entries, newest first
+// When the cold store cannot be read only the entries still in the database are returned
+func withArchived(player string, cold ColdStore, playerInv *PlayerInventories) []InventoryEntry {
+	if len(playerInv.Archives) == 0 {
+		return playerInv.Entries
+	}
+	if cold == nil {
+		logger.Warnf("Archived entries of %s are not available, no cold store is configured", player)
+		return playerInv.Entries
+	}
+
+	archived, err := archivedEntries(cold, playerInv.Archives)
+	if err != nil {
+		logger.Warnf("Failed to read archived entries of %s: %v", player, err)
+		return playerInv.Entries
+	}
+
+	entries := append(playerInv.Entries, archived...)
+	sortHistory(entries)
+	return entries
+}
@@ -0,0 +1,126 @@
+package database
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/coldstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func seedHistory(t *testing.T, db *DB, player string, entries ...InventoryEntry) {
+	value, err := json.Marshal(PlayerInventories{Entries: entries})
+	require.NoError(t, err)
+	_, err = db.Merge([]byte(player), value)
+	require.NoError(t, err)
+}
+
+func TestDB_Archive(t *testing.T) {
+	db, err := NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Archive(time.Now())
+	assert.Error(t, err, "archiving requires a cold store")
+
+	store, err := coldstore.NewDir(t.TempDir())
+	require.NoError(t, err)
+	db.SetColdStore(store)
+
+	day := func(d int) time.Time { return time.Date(2025, 1, d, 0, 0, 0, 0, time.UTC) }
+	seedHistory(t, db, "alice",
+		InventoryEntry{Inventory: []byte(`[{"typeId":"minecraft:dirt","amount":1}]`), Server: "a.example.com", Timestamp: day(1)},
+		InventoryEntry{Inventory: []byte(`[{"typeId":"minecraft:dirt","amount":2}]`), Server: "b.example.com", Timestamp: day(2)},
+		InventoryEntry{Inventory: []byte(`[{"typeId":"minecraft:dirt","amount":3}]`), Server: "a.example.com", Timestamp: day(3)},
+		InventoryEntry{Inventory: []byte(`[{"typeId":"minecraft:dirt","amount":4}]`), Server: "a.example.com", Timestamp: day(20)},
+	)
+	// The only entry of bob is old but stays, it is his latest inventory
+	seedHistory(t, db, "bob",
+		InventoryEntry{Inventory: []byte(`[]`), Server: "a.example.com", Timestamp: day(1)},
+	)
+
+	report, err := db.Archive(day(10))
+	require.NoError(t, err)
+	assert.Equal(t, &ArchiveReport{Players: 1, Entries: 3}, report)
+
+	// The hot record only keeps the recent entry and a reference to the archive
+	db.mu.RLock()
+	record, err := db.record([]byte("alice"))
+	db.mu.RUnlock()
+	require.NoError(t, err)
+	require.Len(t, record.Entries, 1)
+	require.Len(t, record.Archives, 1)
+	assert.Equal(t, 3, record.Archives[0].Entries)
+
+	inventory, err := db.Get("alice")
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"typeId":"minecraft:dirt","amount":4}]`, string(inventory))
+
+	// History reads archived entries back transparently, with their provenance
+	entries, err := db.GetPlayerInventories("alice")
+	require.NoError(t, err)
+	require.Len(t, entries, 4)
+	assert.True(t, entries[0].Timestamp.Equal(day(20)))
+	assert.Equal(t, "b.example.com", entries[2].Server)
+	assert.True(t, entries[3].Timestamp.Equal(day(1)))
+
+	page, err := db.GetPlayerInventoriesPage("alice", HistoryQuery{Server: "b.example.com"})
+	require.NoError(t, err)
+	assert.Len(t, page.Entries, 1)
+
+	// Archiving again moves nothing, and peers cannot bring archived entries back
+	report, err = db.Archive(day(10))
+	require.NoError(t, err)
+	assert.Zero(t, report.Entries)
+
+	seedHistory(t, db, "alice",
+		InventoryEntry{Inventory: []byte(`[{"typeId":"minecraft:dirt","amount":2}]`), Server: "b.example.com", Timestamp: day(2)},
+		InventoryEntry{Inventory: []byte(`[{"typeId":"minecraft:dirt","amount":5}]`), Server: "c.example.com", Timestamp: day(21)},
+	)
+	entries, err = db.GetPlayerInventories("alice")
+	require.NoError(t, err)
+	assert.Len(t, entries, 5)
+
+	// Without the cold store only hot entries are returned
+	db.SetColdStore(nil)
+	entries, err = db.GetPlayerInventories("alice")
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+}
+
+func TestArchivable(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2025, 1, d, 0, 0, 0, 0, time.UTC) }
+	entries := []InventoryEntry{
+		{Inventory: []byte(`[{"typeId"`), Timestamp: day(3)},
+		{Inventory: []byte(`[]`), Timestamp: day(2)},
+		{Inventory: []byte(`[]`), Timestamp: day(1)},
+	}
+
+	// The corrupted latest entry and the restorable one before it stay
+	old := archivable(entries, day(10))
+	require.Len(t, old, 1)
+	assert.True(t, old[0].Timestamp.Equal(day(1)))
+
+	assert.Empty(t, archivable(entries[:1], day(10)))
+}
+
+func TestArchiveEncoding(t *testing.T) {
+	entries := []InventoryEntry{
+		{Inventory: []byte(`[]`), Server: "a.example.com", Timestamp: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), Location: &Location{X: 1, Dimension: "overworld"}},
+		{Inventory: []byte(`[null]`), Server: "b/../example", Timestamp: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)},
+	}
+
+	data, err := encodeArchive(entries)
+	require.NoError(t, err)
+
+	decoded, err := decodeArchive(data)
+	require.NoError(t, err)
+	require.Len(t, decoded, 2)
+	assert.Equal(t, entries[0].Location, decoded[0].Location)
+	assert.Equal(t, "b/../example", decoded[1].Server)
+
+	_, err = decodeArchive([]byte("not gzip"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,280 @@
+package database
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+var ErrNoBanSnapshot = errors.New("no ban snapshot")
+
+// BanSnapshot holds the entries a ban removed or cleaned, as they were before Delete, so the
+// ban can be reversed with RestoreServer
+type BanSnapshot struct {
+	Server    string                      `json:"server"`
+	DeletedAt time.Time                   `json:"deleted_at"`
+	Entries   map[string][]InventoryEntry `json:"entries"` // Original entries by player
+}
+
+// RestoreReport describes the result of RestoreServer
+type RestoreReport struct {
+	Snapshots int // Snapshots restored and removed
+	Players   int // Players with entries reinstated
+	Entries   int // Entries reinstated
+}
+
+// BanSnapshotDir returns where the data removed by bans from the database at path is kept
+func BanSnapshotDir(path string) string {
+	return filepath.Clean(path) + ".banned"
+}
+
+// banReason is the tombstone reason of records emptied by the ban of server
+func banReason(server string) string {
+	return "banned server " + server
+}
+
+// SetBanSnapshotDir makes Delete keep the data it removes in dir, an empty dir stops keeping it
+func (db *DB) SetBanSnapshotDir(dir string) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.banSnapshots = dir
+}
+
+// saveBanSnapshot writes a gzipped snapshot to dir, named after the server and deletion time
+func saveBanSnapshot(dir string, snapshot *BanSnapshot) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(gz).Encode(snapshot); err != nil {
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%s-%d.json.gz", unsafeFileChars.ReplaceAllString(snapshot.Server, "_"), snapshot.DeletedAt.UnixNano())
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path+".tmp", buf.Bytes(), 0600); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+// loadBanSnapshot reads a snapshot written by saveBanSnapshot
+func loadBanSnapshot(path string) (*BanSnapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("ban snapshot %s: %w", path, err)
+	}
+	defer gz.Close()
+
+	var snapshot BanSnapshot
+	if err := json.NewDecoder(gz).Decode(&snapshot); err != nil {
+		return nil, fmt.Errorf("ban snapshot %s: %w", path, err)
+	}
+	return &snapshot, nil
+}
+
+// banSnapshotFiles lists the snapshot files in dir with their deletion time, oldest first
+// Snapshots of every server are listed when server is empty
+func banSnapshotFiles(dir, server string) ([]string, []time.Time, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "*.json.gz"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	prefix := unsafeFileChars.ReplaceAllString(server, "_") + "-"
+	type file struct {
+		path string
+		at   time.Time
+	}
+	var files []file
+	for _, match := range matches {
+		name := strings.TrimSuffix(filepath.Base(match), ".json.gz")
+		if server != "" && !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		nanos, err := strconv.ParseInt(name[strings.LastIndex(name, "-")+1:], 10, 64)
+		if err != nil {
+			continue // Not a snapshot
+		}
+		files = append(files, file{path: match, at: time.Unix(0, nanos)})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].at.Before(files[j].at) })
+
+	paths := make([]string, len(files))
+	times := make([]time.Time, len(files))
+	for i, f := range files {
+		paths[i], times[i] = f.path, f.at
+	}
+	return paths, times, nil
+}
+
+// RestoreServer reinstates the entries removed by the bans of server from its snapshots and
+// removes the snapshots, entries still in the database are replaced by their original version
+// The server must be unbanned first, otherwise the next ban deletes its entries again
+func (db *DB) RestoreServer(server string) (*RestoreReport, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if db.closed {
+		return nil, ErrClosed
+	}
+	if db.banSnapshots == "" {
+		return nil, fmt.Errorf("%w of %s: ban snapshots are disabled", ErrNoBanSnapshot, server)
+	}
+
+	paths, _, err := banSnapshotFiles(db.banSnapshots, server)
+	if err != nil {
+		return nil, err
+	}
+
+	// Snapshots of other servers sharing the sanitized name are skipped by their recorded server
+	var snapshots []*BanSnapshot
+	var restored []string
+	for _, path := range paths {
+		snapshot, err := loadBanSnapshot(path)
+		if err != nil {
+			return nil, err
+		}
+		if snapshot.Server != server {
+			continue
+		}
+		snapshots = append(snapshots, snapshot)
+		restored = append(restored, path)
+	}
+	if len(snapshots) == 0 {
+		return nil, fmt.Errorf("%w of %s", ErrNoBanSnapshot, server)
+	}
+
+	// Later snapshots hold entries as cleaned by earlier bans, the oldest version wins
+	original := make(map[string][]InventoryEntry)
+	for _, snapshot := range snapshots {
+		for player, entries := range snapshot.Entries {
+			for _, entry := range entries {
+				if !containsEntry(original[player], entry) {
+					original[player] = append(original[player], entry)
+				}
+			}
+		}
+	}
+
+	report := &RestoreReport{Snapshots: len(snapshots)}
+	for player, entries := range original {
+		playerInv, err := db.record([]byte(player))
+		if errors.Is(err, ErrPlayerNotFound) {
+			playerInv = &PlayerInventories{}
+		} else if err != nil {
+			return report, err
+		}
+
+		for _, entry := range entries {
+			replaced := false
+			for i := range playerInv.Entries {
+				if playerInv.Entries[i].Server == entry.Server && playerInv.Entries[i].Timestamp.Equal(entry.Timestamp) {
+					playerInv.Entries[i] = entry
+					replaced = true
+					break
+				}
+			}
+			if !replaced {
+				playerInv.Entries = append(playerInv.Entries, entry)
+			}
+		}
+
+		// The tombstone of the ban would hide the reinstated entries
+		if playerInv.Deleted != nil && playerInv.Deleted.Reason == banReason(server) {
+			playerInv.Deleted = nil
+		}
+
+		sort.Slice(playerInv.Entries, func(i, j int) bool {
+			return playerInv.Entries[i].Timestamp.After(playerInv.Entries[j].Timestamp)
+		})
+
+		data, err := json.Marshal(playerInv)
+		if err != nil {
+			return report, err
+		}
+		if err := db.leveldb.Put([]byte(player), data, nil); err != nil {
+			return report, err
+		}
+
+		db.changeLog = append(db.changeLog, ChangeEntry{
+			player:    player,
+			entry:     playerInv.Entries[0],
+			timestamp: time.Now(),
+		})
+		report.Players++
+		report.Entries += len(entries)
+	}
+
+	// Keep change log bounded
+	if len(db.changeLog) > 1000 {
+		db.changeLog = db.changeLog[len(db.changeLog)-1000:]
+	}
+
+	for _, path := range restored {
+		if err := os.Remove(path); err != nil {
+			logger.Warnf("Unable to remove restored ban snapshot %s: %v", path, err)
+		}
+	}
+
+	logger.Infof("Audit: restored %d entries of %d players removed by the ban of %s", report.Entries, report.Players, server)
+	return report, nil
+}
+
+// containsEntry reports whether entries hold an entry of the same server and time
+func containsEntry(entries []InventoryEntry, entry InventoryEntry) bool {
+	for _, e := range entries {
+		if e.Server == entry.Server && e.Timestamp.Equal(entry.Timestamp) {
+			return true
+		}
+	}
+	return false
+}
+
+// CollectBanSnapshots removes the snapshots of bans made before the given time and returns how
+// many were removed, their bans cannot be reversed afterwards
+func (db *DB) CollectBanSnapshots(before time.Time) (int, error) {
+	db.mu.RLock()
+	dir := db.banSnapshots
+	db.mu.RUnlock()
+
+	if dir == "" {
+		return 0, nil
+	}
+
+	paths, times, err := banSnapshotFiles(dir, "")
+	if err != nil {
+		return 0, err
+	}
+
+	collected := 0
+	for i, path := range paths {
+		if !times[i].Before(before) {
+			break
+		}
+		if err := os.Remove(path); err != nil {
+			return collected, err
+		}
+		collected++
+	}
+	return collected, nil
+}
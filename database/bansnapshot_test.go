@@ -0,0 +1,100 @@
+package database
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_RestoreServer(t *testing.T) {
+	db, err := NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+
+	dir := filepath.Join(t.TempDir(), "inventories.ldb.banned")
+	db.SetBanSnapshotDir(dir)
+
+	mixed := []byte(`[{"typeId":"minecraft:diamond","amount":1,"lore":["Origin: banned"]},{"typeId":"minecraft:bread","amount":2,"lore":["Origin: server1"]}]`)
+	require.NoError(t, db.Put("alice", mixed, "server1"))
+	require.NoError(t, db.Put("bob", []byte(`[]`), "banned"))
+
+	_, err = db.RestoreServer("banned")
+	assert.ErrorIs(t, err, ErrNoBanSnapshot)
+
+	require.NoError(t, db.Delete("banned", false))
+
+	cleaned, err := db.Get("alice")
+	require.NoError(t, err)
+	assert.NotContains(t, string(cleaned), "minecraft:diamond")
+	_, err = db.Get("bob")
+	assert.ErrorIs(t, err, ErrPlayerNotFound)
+
+	snapshots, err := filepath.Glob(filepath.Join(dir, "banned-*.json.gz"))
+	require.NoError(t, err)
+	assert.Len(t, snapshots, 1)
+
+	t.Run("NothingLeftToDelete", func(t *testing.T) {
+		require.NoError(t, db.Delete("banned", false))
+		again, err := filepath.Glob(filepath.Join(dir, "*.json.gz"))
+		require.NoError(t, err)
+		assert.Len(t, again, 1)
+	})
+
+	t.Run("ReinstatesRemovedData", func(t *testing.T) {
+		report, err := db.RestoreServer("banned")
+		require.NoError(t, err)
+		assert.Equal(t, &RestoreReport{Snapshots: 1, Players: 2, Entries: 2}, report)
+
+		restored, err := db.Get("alice")
+		require.NoError(t, err)
+		assert.JSONEq(t, string(mixed), string(restored))
+
+		restored, err = db.Get("bob")
+		require.NoError(t, err)
+		assert.Equal(t, []byte(`[]`), restored)
+
+		tombstone, err := db.Tombstone("bob")
+		require.NoError(t, err)
+		assert.Nil(t, tombstone)
+
+		_, err = db.RestoreServer("banned")
+		assert.ErrorIs(t, err, ErrNoBanSnapshot)
+	})
+}
+
+func TestDB_CollectBanSnapshots(t *testing.T) {
+	db, err := NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+
+	collected, err := db.CollectBanSnapshots(time.Now())
+	require.NoError(t, err)
+	assert.Zero(t, collected)
+
+	dir := t.TempDir()
+	db.SetBanSnapshotDir(dir)
+
+	require.NoError(t, db.Put("alice", []byte(`[]`), "old.example.com"))
+	require.NoError(t, db.Delete("old.example.com", false))
+	cutoff := time.Now()
+	require.NoError(t, db.Put("bob", []byte(`[]`), "new.example.com"))
+	require.NoError(t, db.Delete("new.example.com", false))
+
+	collected, err = db.CollectBanSnapshots(cutoff)
+	require.NoError(t, err)
+	assert.Equal(t, 1, collected)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Contains(t, entries[0].Name(), "new.example.com-")
+
+	_, err = db.RestoreServer("old.example.com")
+	assert.ErrorIs(t, err, ErrNoBanSnapshot)
+	_, err = db.RestoreServer("new.example.com")
+	assert.NoError(t, err)
+}
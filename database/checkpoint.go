@@ -0,0 +1,215 @@
+package database
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// checkpointIDFormat names checkpoints by the time of their world save
+const checkpointIDFormat = "20060102T150405.000Z"
+
+var ErrCheckpointNotFound = errors.New("checkpoint not found")
+
+// Checkpoint correlates a completed world save with the inventory history, restoring the world
+// files of the save together with RestoreCheckpoint brings worlds and ender chests back to the same moment
+type Checkpoint struct {
+	ID          string           `json:"id"`
+	Server      string           `json:"server"`       // Web address of the node whose world was saved
+	At          time.Time        `json:"at"`           // Time of the saved world state
+	CompletedAt time.Time        `json:"completed_at"` // The save was reported complete
+	WorldFiles  []CheckpointFile `json:"world_files,omitempty"`
+}
+
+// CheckpointFile is a world file of a save with the length that belongs to it
+type CheckpointFile struct {
+	Path string `json:"path"`
+	Size int64  `json:"size"`
+}
+
+// NewCheckpoint creates a checkpoint for a world save of server
+func NewCheckpoint(server string, at, completedAt time.Time, files []CheckpointFile) Checkpoint {
+	return Checkpoint{
+		ID:          at.UTC().Format(checkpointIDFormat),
+		Server:      server,
+		At:          at,
+		CompletedAt: completedAt,
+		WorldFiles:  files,
+	}
+}
+
+// Checkpoints keeps the latest checkpoints in a JSON file, the inventory state itself is read
+// from the database history when a checkpoint is restored
+type Checkpoints struct {
+	path string
+	keep int
+	mu   sync.Mutex
+}
+
+// OpenCheckpoints uses the checkpoint log at path, keeping at most keep checkpoints, 0 keeps all
+func OpenCheckpoints(path string, keep int) *Checkpoints {
+	return &Checkpoints{path: path, keep: keep}
+}
+
+// Add records a checkpoint, dropping the oldest ones beyond the retention
+func (c *Checkpoints) Add(checkpoint Checkpoint) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	checkpoints, err := c.read()
+	if err != nil {
+		return err
+	}
+	checkpoints = append(checkpoints, checkpoint)
+	sort.SliceStable(checkpoints, func(i, j int) bool {
+		return checkpoints[i].At.After(checkpoints[j].At)
+	})
+	if c.keep > 0 && len(checkpoints) > c.keep {
+		checkpoints = checkpoints[:c.keep]
+	}
+
+	data, err := json.MarshalIndent(checkpoints, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return err
+	}
+	tmp := c.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("failed to write checkpoints: %w", err)
+	}
+	if err := os.Rename(tmp, c.path); err != nil {
+		return fmt.Errorf("failed to write checkpoints: %w", err)
+	}
+	return nil
+}
+
+// List returns the recorded checkpoints, newest first
+func (c *Checkpoints) List() ([]Checkpoint, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.read()
+}
+
+// Get returns the checkpoint with the given ID
+func (c *Checkpoints) Get(id string) (*Checkpoint, error) {
+	checkpoints, err := c.List()
+	if err != nil {
+		return nil, err
+	}
+	for _, checkpoint := range checkpoints {
+		if checkpoint.ID == id {
+			return &checkpoint, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrCheckpointNotFound, id)
+}
+
+// read loads the checkpoint log, c.mu must be held
+func (c *Checkpoints) read() ([]Checkpoint, error) {
+	data, err := os.ReadFile(c.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checkpoints: %w", err)
+	}
+
+	var checkpoints []Checkpoint
+	if err := json.Unmarshal(data, &checkpoints); err != nil {
+		return nil, fmt.Errorf("failed to read checkpoints: %w", err)
+	}
+	return checkpoints, nil
+}
+
+// CheckpointRestore describes the result of RestoreCheckpoint
+type CheckpointRestore struct {
+	Restored []string // Ender chests set back to their state at the checkpoint
+	Moved    []string // Players updated on other servers since the checkpoint, left for the operator to review
+	Archived []string // Players whose state at the checkpoint is in the cold store
+}
+
+// RestoreCheckpoint sets the ender chests changed on the checkpoint server since its world save back
+// to their state at the save, so items moved between the world and the ender chest after the save
+// are neither lost nor duplicated once the world files of the save are restored
+// The old state is written as a new entry of the checkpoint server, peers take it as the latest update
+func (db *DB) RestoreCheckpoint(checkpoint Checkpoint) (*CheckpointRestore, error) {
+	db.mu.RLock()
+	if db.closed {
+		db.mu.RUnlock()
+		return nil, ErrClosed
+	}
+	snapshot, err := db.leveldb.GetSnapshot()
+	db.mu.RUnlock()
+	if err != nil {
+		return nil, fmt.Errorf("failed to take snapshot: %w", err)
+	}
+
+	report := &CheckpointRestore{}
+	restore := make(map[string][]byte)
+
+	iter := snapshot.NewIterator(nil, nil)
+	for iter.Next() {
+		var record PlayerInventories
+		if err := json.Unmarshal(iter.Value(), &record); err != nil || len(record.Entries) == 0 {
+			continue
+		}
+		player := string(iter.Key())
+
+		changed := false
+		moved := false
+		for _, entry := range record.Entries {
+			if !entry.Timestamp.After(checkpoint.At) {
+				break
+			}
+			changed = true
+			moved = moved || entry.Server != checkpoint.Server
+		}
+		switch {
+		case !changed:
+			continue
+		case moved:
+			report.Moved = append(report.Moved, player)
+			continue
+		}
+
+		// A player without entries at the checkpoint had an empty ender chest
+		inventory := []byte("[]")
+		if entry, ok := entryAt(record.Entries, checkpoint.At); ok {
+			inventory = entry.Inventory
+		} else if record.archivedBefore().After(checkpoint.At) {
+			report.Archived = append(report.Archived, player)
+			continue
+		}
+		if !bytes.Equal(inventory, record.Entries[0].Inventory) {
+			restore[player] = inventory
+		}
+	}
+	iter.Release()
+	snapshot.Release()
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+
+	players := make([]string, 0, len(restore))
+	for player := range restore {
+		players = append(players, player)
+	}
+	sort.Strings(players)
+
+	for _, player := range players {
+		if err := db.Put(player, restore[player], checkpoint.Server); err != nil {
+			return report, fmt.Errorf("failed to restore %s: %w", player, err)
+		}
+		report.Restored = append(report.Restored, player)
+	}
+
+	return report, nil
+}
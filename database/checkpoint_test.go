@@ -0,0 +1,83 @@
+package database
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckpoints(t *testing.T) {
+	checkpoints := OpenCheckpoints(filepath.Join(t.TempDir(), "checkpoints.json"), 2)
+
+	list, err := checkpoints.List()
+	require.NoError(t, err)
+	assert.Empty(t, list)
+
+	start := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	for i := range 3 {
+		at := start.Add(time.Duration(i) * time.Minute)
+		files := []CheckpointFile{{Path: "Bedrock level/level.dat", Size: int64(100 + i)}}
+		require.NoError(t, checkpoints.Add(NewCheckpoint("server1", at, at.Add(time.Second), files)))
+	}
+
+	list, err = checkpoints.List()
+	require.NoError(t, err)
+	require.Len(t, list, 2, "the oldest checkpoint is dropped")
+	assert.Equal(t, "20250301T120200.000Z", list[0].ID)
+	assert.Equal(t, "20250301T120100.000Z", list[1].ID)
+
+	checkpoint, err := checkpoints.Get("20250301T120100.000Z")
+	require.NoError(t, err)
+	assert.Equal(t, int64(101), checkpoint.WorldFiles[0].Size)
+
+	_, err = checkpoints.Get("20250301T120000.000Z")
+	assert.ErrorIs(t, err, ErrCheckpointNotFound)
+}
+
+func TestDB_RestoreCheckpoint(t *testing.T) {
+	db, err := New(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, db.Put("alice", []byte(`[{"typeId":"minecraft:diamond","amount":1}]`), "server1"))
+	require.NoError(t, db.Put("bob", []byte(`[{"typeId":"minecraft:apple","amount":1}]`), "server1"))
+	require.NoError(t, db.Put("carol", []byte(`[{"typeId":"minecraft:stone","amount":1}]`), "server1"))
+	require.NoError(t, db.Put("dave", []byte(`[{"typeId":"minecraft:dirt","amount":1}]`), "server1"))
+
+	time.Sleep(5 * time.Millisecond)
+	checkpoint := NewCheckpoint("server1", time.Now(), time.Now(), nil)
+	time.Sleep(5 * time.Millisecond)
+
+	// alice stored items after the save, bob moved on to another server, carol joined after it
+	require.NoError(t, db.Put("alice", []byte(`[{"typeId":"minecraft:diamond","amount":64}]`), "server1"))
+	require.NoError(t, db.Put("bob", []byte(`[{"typeId":"minecraft:apple","amount":5}]`), "server2"))
+	require.NoError(t, db.Put("carol", []byte(`[{"typeId":"minecraft:stone","amount":1}]`), "server1"))
+	require.NoError(t, db.Put("erin", []byte(`[{"typeId":"minecraft:gold_ingot","amount":1}]`), "server1"))
+
+	report, err := db.RestoreCheckpoint(checkpoint)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alice", "erin"}, report.Restored)
+	assert.Equal(t, []string{"bob"}, report.Moved)
+	assert.Empty(t, report.Archived)
+
+	inventory, err := db.Get("alice")
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"typeId":"minecraft:diamond","amount":1}]`, string(inventory))
+
+	inventory, err = db.Get("erin")
+	require.NoError(t, err)
+	assert.JSONEq(t, `[]`, string(inventory))
+
+	inventory, err = db.Get("bob")
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"typeId":"minecraft:apple","amount":5}]`, string(inventory))
+
+	// The restored state is the newest entry, so it wins when merged on peers
+	entries, err := db.GetPlayerInventories("alice")
+	require.NoError(t, err)
+	assert.Len(t, entries, 3)
+	assert.Equal(t, "server1", entries[0].Server)
+}
@@ -1,19 +1,24 @@
 package database
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"maps"
 	"os"
 	"path/filepath"
-	"regexp"
 	"sort"
-	"strings"
+	"strconv"
 	"sync"
 	"time"
 
+	"github.com/d1nch8g/consensuscraft/crash"
+	"github.com/d1nch8g/consensuscraft/logger"
+	"github.com/d1nch8g/consensuscraft/tracing"
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/syndtr/goleveldb/leveldb/storage"
 	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
@@ -22,11 +27,26 @@ type InventoryEntry struct {
 	Inventory []byte    `json:"inventory"`
 	Server    string    `json:"server"`
 	Timestamp time.Time `json:"timestamp"`
+	Location  *Location `json:"location,omitempty"`
+	UpdateID  string    `json:"update_id,omitempty"` // Set by the origin so retried updates are stored once
+	Member    string    `json:"member,omitempty"`    // Player who wrote a shared inventory entry
+}
+
+// Location records where the player stood when an inventory update was made
+type Location struct {
+	X         float64 `json:"x"`
+	Y         float64 `json:"y"`
+	Z         float64 `json:"z"`
+	Dimension string  `json:"dimension"`
 }
 
 // PlayerInventories represents all inventory entries for a player
 type PlayerInventories struct {
-	Entries []InventoryEntry `json:"entries"`
+	Entries  []InventoryEntry `json:"entries"`
+	Archives []ArchiveRef     `json:"archives,omitempty"` // Older entries moved to the cold store
+	Deleted  *Tombstone       `json:"deleted,omitempty"`  // Deletion of the entries up to Deleted.At
+	Group    *Group           `json:"group,omitempty"`    // Set on shared inventories, see GroupKey
+	Access   []GroupAccess    `json:"access,omitempty"`   // Member accesses to a shared inventory, newest first
 }
 
 type ChangeEntry struct {
@@ -41,6 +61,19 @@ type DB struct {
 	mu        sync.RWMutex
 	changeLog []ChangeEntry
 	closed    bool
+	filter    InventoryFilter
+	filtered  func(player, server string)
+	stats     originStats
+	fences    writeFences
+	cold      ColdStore
+	verifier  *PeerVerifier
+	latency   latencyStats
+	rules     []ValidatorRule // Applied by Revalidate on top of the registered rules
+
+	// Directory of the data removed for banned servers, empty when it is not kept
+	banSnapshots string
+
+	peerStripped func(player, server string)
 }
 
 var ErrClosed = errors.New("database is closed")
@@ -105,52 +138,73 @@ func (i *Item) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	// Extract known fields
-	if v, ok := raw["typeId"].(string); ok {
-		i.TypeID = v
-		delete(raw, "typeId")
-	}
-	if v, ok := raw["amount"].(float64); ok {
-		i.Amount = int(v)
-		delete(raw, "amount")
-	}
-	if v, ok := raw["nameTag"].(string); ok {
-		i.NameTag = v
-		delete(raw, "nameTag")
-	}
-	if v, ok := raw["lore"].([]any); ok {
-		i.Lore = make([]string, len(v))
-		for idx, loreItem := range v {
-			if s, ok := loreItem.(string); ok {
-				i.Lore[idx] = s
+	i.fromMap(raw)
+	return nil
+}
+
+// fromMap fills the item from a decoded JSON object without modifying it, so items already
+// decoded as part of an inventory or shulker box are read without encoding them again
+// Amounts given as int are accepted too, for shulker contents built in Go
+func (i *Item) fromMap(raw map[string]any) {
+	for key, value := range raw {
+		switch key {
+		case "typeId":
+			if v, ok := value.(string); ok {
+				i.TypeID = v
+				continue
 			}
-		}
-		delete(raw, "lore")
-	}
-	if v, ok := raw["enchantments"].([]any); ok {
-		i.Enchantments = make([]map[string]any, len(v))
-		for idx, enchItem := range v {
-			if m, ok := enchItem.(map[string]any); ok {
-				i.Enchantments[idx] = m
+		case "amount":
+			if v, ok := value.(float64); ok {
+				i.Amount = int(v)
+				continue
+			}
+			if v, ok := value.(int); ok {
+				i.Amount = v
+				continue
+			}
+		case "nameTag":
+			if v, ok := value.(string); ok {
+				i.NameTag = v
+				continue
+			}
+		case "lore":
+			if v, ok := value.([]any); ok {
+				i.Lore = make([]string, len(v))
+				for idx, loreItem := range v {
+					if s, ok := loreItem.(string); ok {
+						i.Lore[idx] = s
+					}
+				}
+				continue
+			}
+		case "enchantments":
+			if v, ok := value.([]any); ok {
+				i.Enchantments = make([]map[string]any, len(v))
+				for idx, enchItem := range v {
+					if m, ok := enchItem.(map[string]any); ok {
+						i.Enchantments[idx] = m
+					}
+				}
+				continue
+			}
+		case "durability":
+			if v, ok := value.(map[string]any); ok {
+				i.Durability = v
+				continue
+			}
+		case "shulkerContents":
+			if v, ok := value.([]any); ok {
+				i.ShulkerContents = v
+				continue
 			}
 		}
-		delete(raw, "enchantments")
-	}
-	if v, ok := raw["durability"].(map[string]any); ok {
-		i.Durability = v
-		delete(raw, "durability")
-	}
-	if v, ok := raw["shulkerContents"].([]any); ok {
-		i.ShulkerContents = v
-		delete(raw, "shulkerContents")
-	}
 
-	// Store remaining fields in Extra
-	if len(raw) > 0 {
-		i.Extra = raw
+		// Store remaining fields in Extra
+		if i.Extra == nil {
+			i.Extra = make(map[string]any)
+		}
+		i.Extra[key] = value
 	}
-
-	return nil
 }
 
 // MarshalJSON implements custom marshaling for Item
@@ -189,21 +243,14 @@ func (i *Item) MarshalJSON() ([]byte, error) {
 
 // hasOriginFromServer checks if an item originates from a specific server
 func (i *Item) hasOriginFromServer(server string) bool {
-	if len(i.Lore) == 0 {
-		return false
-	}
+	origin, ok := CurrentOriginFormat().Origin(i.Lore)
+	return ok && origin == server
+}
 
-	// Check for origin lore pattern: "Origin: server" (simple pattern)
-	originPattern := regexp.MustCompile(`^Origin:\s+(.+)$`)
-	for _, lore := range i.Lore {
-		if matches := originPattern.FindStringSubmatch(lore); len(matches) == 2 {
-			originServer := strings.TrimSpace(matches[1])
-			if originServer == server {
-				return true
-			}
-		}
-	}
-	return false
+// origin returns the server named in the item's origin lore or an empty string
+func (i *Item) origin() string {
+	origin, _ := CurrentOriginFormat().Origin(i.Lore)
+	return origin
 }
 
 // cleanShulkerContents removes items from shulker contents that originate from a specific server
@@ -302,7 +349,33 @@ func New(path string) (*DB, error) {
 		return nil, err
 	}
 
-	ldb, err := leveldb.OpenFile(path, nil)
+	ldb, recovered, err := openOrRecover(path)
+	if err != nil {
+		return nil, err
+	}
+
+	db := &DB{
+		leveldb:   ldb,
+		changeLog: make([]ChangeEntry, 0),
+	}
+
+	// A recovered database may hold records that no longer decode
+	if recovered {
+		report, err := db.Quarantine(QuarantineDir(path))
+		if err != nil {
+			db.Close()
+			return nil, err
+		}
+		logger.Warnf("Recovered database %s, %d records scanned, %d quarantined", path, report.Scanned, len(report.Quarantined))
+	}
+
+	return db, nil
+}
+
+// NewMemory creates a database backed by in-memory storage, useful for tests
+// and simulated nodes that should not touch the filesystem
+func NewMemory() (*DB, error) {
+	ldb, err := leveldb.Open(storage.NewMemStorage(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -315,6 +388,36 @@ func New(path string) (*DB, error) {
 
 // Put adds a new inventory entry for a player
 func (db *DB) Put(player string, inventory []byte, server string) error {
+	return db.PutWithLocation(player, inventory, server, nil)
+}
+
+// PutWithLocation adds a new inventory entry for a player along with the player's location
+func (db *DB) PutWithLocation(player string, inventory []byte, server string, location *Location) error {
+	return db.PutWithID(player, inventory, server, location, "")
+}
+
+// PutWithID adds a new inventory entry for a player unless the record already holds the update
+// updateID from server, so retries and re-read logs do not duplicate history, an empty ID is never deduplicated
+func (db *DB) PutWithID(player string, inventory []byte, server string, location *Location, updateID string) error {
+	return db.PutWithIDContext(context.Background(), player, inventory, server, location, updateID)
+}
+
+// PutWithIDContext is PutWithID recording the write as a span of the trace in ctx
+func (db *DB) PutWithIDContext(ctx context.Context, player string, inventory []byte, server string, location *Location, updateID string) (err error) {
+	_, span := tracing.Start(ctx, "db.put")
+	span.SetAttribute("player", player)
+	span.SetAttribute("server", server)
+	defer func() {
+		span.RecordError(err)
+		span.Finish()
+	}()
+
+	if IsGroupKey(player) {
+		return &RejectionError{Reason: "group_key", Message: "shared inventories are only written by their members"}
+	}
+
+	defer db.latency.observe("put", player, time.Now())
+
 	db.mu.Lock()
 	defer db.mu.Unlock()
 
@@ -322,18 +425,25 @@ func (db *DB) Put(player string, inventory []byte, server string) error {
 		return ErrClosed
 	}
 
-	// Create new inventory entry
-	newEntry := InventoryEntry{
-		Inventory: append([]byte{}, inventory...),
-		Server:    server,
-		Timestamp: time.Now(),
+	return db.put(player, InventoryEntry{Inventory: inventory, Server: server, Location: location, UpdateID: updateID}, nil)
+}
+
+// put filters and stores a new entry in the record of key, check may refuse it once the record is
+// read and update it along with the entry, db.mu must be held
+func (db *DB) put(key string, newEntry InventoryEntry, check func(*PlayerInventories) error) error {
+	inventory, err := db.storeFiltered(key, newEntry.Inventory, newEntry.Server)
+	if err != nil {
+		return err
 	}
 
+	// Create new inventory entry
+	newEntry.Inventory = append([]byte{}, inventory...)
+	newEntry.Timestamp = time.Now()
+
 	// Get existing inventories for player
 	var playerInv PlayerInventories
-	key := []byte(player)
 
-	existingData, err := db.leveldb.Get(key, nil)
+	existingData, err := db.leveldb.Get([]byte(key), nil)
 	if err != nil && err != leveldb.ErrNotFound {
 		return err
 	}
@@ -345,6 +455,18 @@ func (db *DB) Put(player string, inventory []byte, server string) error {
 		}
 	}
 
+	if playerInv.hasUpdate(newEntry.Server, newEntry.UpdateID) {
+		logger.Infof("Skipped update %s of %s from %s, it was stored before", newEntry.UpdateID, key, newEntry.Server)
+		db.stats.duplicate(newEntry.Server)
+		return nil
+	}
+
+	if check != nil {
+		if err := check(&playerInv); err != nil {
+			return err
+		}
+	}
+
 	// Add new entry
 	playerInv.Entries = append(playerInv.Entries, newEntry)
 
@@ -359,14 +481,17 @@ func (db *DB) Put(player string, inventory []byte, server string) error {
 		return err
 	}
 
-	err = db.leveldb.Put(key, data, nil)
+	err = db.leveldb.Put([]byte(key), data, nil)
 	if err != nil {
 		return err
 	}
 
+	db.stats.accepted(newEntry.Server)
+	db.fences.set(key, newEntry)
+
 	// Log change for concurrent streaming
 	db.changeLog = append(db.changeLog, ChangeEntry{
-		player:    player,
+		player:    key,
 		entry:     newEntry,
 		timestamp: time.Now(),
 		deleted:   false,
@@ -380,22 +505,203 @@ func (db *DB) Put(player string, inventory []byte, server string) error {
 	return nil
 }
 
+// Merge combines a player record received from a peer with the local one, reporting whether entries were added
+// Entries are matched by server and timestamp, so merging the same record twice is a no-op
+// Empty values (deletion markers) are ignored, deletions travel as tombstones: the newer tombstone
+// of both records wins and removes the entries it covers from both sides
+// Shared inventories take the newer membership and every access of both sides, entries written by
+// players outside the group are skipped
+func (db *DB) Merge(key []byte, value []byte) (bool, error) {
+	return db.MergeContext(context.Background(), key, value)
+}
+
+// MergeContext is Merge recording the merge and the verification of the entries it takes as spans
+// of the trace in ctx
+func (db *DB) MergeContext(ctx context.Context, key []byte, value []byte) (merged bool, err error) {
+	ctx, span := tracing.Start(ctx, "db.merge")
+	span.SetAttribute("player", string(key))
+	defer func() {
+		span.SetAttribute("merged", strconv.FormatBool(merged))
+		span.RecordError(err)
+		span.Finish()
+	}()
+
+	if len(value) == 0 {
+		return false, nil
+	}
+
+	var remote PlayerInventories
+	if err := json.Unmarshal(value, &remote); err != nil {
+		return false, err
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if db.closed {
+		return false, ErrClosed
+	}
+
+	var local PlayerInventories
+	existing, err := db.leveldb.Get(key, nil)
+	if err != nil && err != leveldb.ErrNotFound {
+		return false, err
+	}
+	if err == nil {
+		if err := json.Unmarshal(existing, &local); err != nil {
+			return false, err
+		}
+	}
+
+	type entryKey struct {
+		server    string
+		timestamp int64
+	}
+	seen := make(map[entryKey][]byte, len(local.Entries))
+	for _, entry := range local.Entries {
+		seen[entryKey{entry.Server, entry.Timestamp.UnixNano()}] = entry.Inventory
+	}
+	// The same update stored by two nodes at different times is still one update
+	updates := make(map[updateKey]bool)
+	for _, entry := range local.Entries {
+		if entry.UpdateID != "" {
+			updates[updateKey{entry.Server, entry.UpdateID}] = true
+		}
+	}
+
+	// Entries older than the archive horizon were moved to the cold store and are not taken back
+	archivedBefore := local.archivedBefore()
+
+	// A newer remote tombstone deletes local entries as well
+	tombstone := newerTombstone(local.Deleted, remote.Deleted)
+	deleted := tombstone != local.Deleted
+	if deleted {
+		local.Deleted = tombstone
+		local.Entries = tombstone.uncovered(local.Entries)
+		if fence, ok := db.fences.get(string(key)); ok && tombstone.covers(fence) {
+			db.fences.drop(string(key))
+		}
+	}
+
+	group := IsGroupKey(string(key))
+	grouped := group && local.mergeGroup(&remote)
+
+	var added []InventoryEntry
+	for _, entry := range remote.Entries {
+		k := entryKey{entry.Server, entry.Timestamp.UnixNano()}
+		if stored, ok := seen[k]; ok {
+			if !bytes.Equal(stored, entry.Inventory) && db.conflicting(stored, entry) {
+				db.stats.conflict(entry.Server)
+			}
+			continue
+		}
+		if entry.Timestamp.Before(archivedBefore) || tombstone.covers(entry) {
+			continue
+		}
+		if group && !local.Group.HasMember(entry.Member) {
+			continue
+		}
+		if entry.UpdateID != "" {
+			if updates[updateKey{entry.Server, entry.UpdateID}] {
+				continue
+			}
+			updates[updateKey{entry.Server, entry.UpdateID}] = true
+		}
+		seen[k] = entry.Inventory
+
+		var err error
+		if entry, err = db.verifyPeerEntry(ctx, string(key), entry); err != nil {
+			continue
+		}
+
+		inventory, err := db.storeFiltered(string(key), entry.Inventory, entry.Server)
+		if err != nil {
+			logger.Warnf("Skipped merged entry of %s: %v", key, err)
+			continue
+		}
+		entry.Inventory = inventory
+		added = append(added, entry)
+	}
+
+	if len(added) == 0 && !deleted && !grouped {
+		return false, nil
+	}
+
+	local.Entries = append(local.Entries, added...)
+	sort.Slice(local.Entries, func(i, j int) bool {
+		return local.Entries[i].Timestamp.After(local.Entries[j].Timestamp)
+	})
+
+	data, err := json.Marshal(local)
+	if err != nil {
+		return false, err
+	}
+
+	if err := db.leveldb.Put(key, data, nil); err != nil {
+		return false, err
+	}
+
+	for _, entry := range added {
+		db.stats.accepted(entry.Server)
+		db.changeLog = append(db.changeLog, ChangeEntry{
+			player:    string(key),
+			entry:     entry,
+			timestamp: time.Now(),
+		})
+	}
+	if deleted {
+		logger.Infof("Audit: applied deletion of player %s by %s", key, tombstone.By)
+		db.changeLog = append(db.changeLog, ChangeEntry{
+			player:    string(key),
+			timestamp: time.Now(),
+		})
+	}
+	if grouped && len(added) == 0 && !deleted {
+		db.changeLog = append(db.changeLog, ChangeEntry{
+			player:    string(key),
+			timestamp: time.Now(),
+		})
+	}
+
+	// Keep change log bounded
+	if len(db.changeLog) > 1000 {
+		db.changeLog = db.changeLog[len(db.changeLog)-1000:]
+	}
+
+	return true, nil
+}
+
 // Get returns the latest inventory for a player from all servers
 func (db *DB) Get(player string) ([]byte, error) {
+	entry, err := db.Latest(player)
+	if err != nil {
+		return nil, err
+	}
+	return entry.Inventory, nil
+}
+
+// Latest returns the entry Get takes the latest inventory of a player from, records in the
+// legacy format return an entry holding only the inventory
+func (db *DB) Latest(player string) (InventoryEntry, error) {
+	defer db.latency.observe("get", player, time.Now())
+
 	db.mu.RLock()
 	defer db.mu.RUnlock()
 
 	if db.closed {
-		return nil, ErrClosed
+		return InventoryEntry{}, ErrClosed
 	}
 
 	key := []byte(player)
 	data, err := db.leveldb.Get(key, nil)
 	if err != nil {
 		if err == leveldb.ErrNotFound {
-			return nil, ErrPlayerNotFound
+			if entry, ok := db.fenced(player, nil); ok {
+				return entry, nil
+			}
+			return InventoryEntry{}, ErrPlayerNotFound
 		}
-		return nil, err
+		return InventoryEntry{}, err
 	}
 
 	// Try to unmarshal as PlayerInventories (new format)
@@ -406,25 +712,36 @@ func (db *DB) Get(player string) ([]byte, error) {
 		var rawArray []any
 		if arrayErr := json.Unmarshal(data, &rawArray); arrayErr != nil {
 			// Neither format worked, return the original error
-			return nil, err
+			return InventoryEntry{}, err
 		}
 
 		// It's old format, return the raw data directly
-		return data, nil
+		return InventoryEntry{Inventory: data}, nil
 	}
 
 	if len(playerInv.Entries) == 0 {
-		return nil, ErrPlayerNotFound
+		if entry, ok := db.fenced(player, nil); ok {
+			return entry, nil
+		}
+		return InventoryEntry{}, ErrPlayerNotFound
+	}
+
+	// A record rewritten concurrently may have lost the last local write
+	if entry, ok := db.fenced(player, &playerInv.Entries[0]); ok {
+		return entry, nil
 	}
 
 	// Entries are already sorted by timestamp (newest first)
-	return playerInv.Entries[0].Inventory, nil
+	return latestRestorable(player, playerInv.Entries), nil
 }
 
 // Delete removes all items originating from a specific server from all player inventories
 // This includes items in shulker boxes and nested containers
 // If force is true, it also removes all entries that came after the server's entries
+// With a ban snapshot directory set, the removed data is kept there first so RestoreServer can bring it back
 func (db *DB) Delete(server string, force bool) error {
+	defer db.latency.observe("delete", "", time.Now())
+
 	db.mu.Lock()
 	defer db.mu.Unlock()
 
@@ -436,6 +753,15 @@ func (db *DB) Delete(server string, force bool) error {
 	iter := db.leveldb.NewIterator(util.BytesPrefix(nil), nil)
 	defer iter.Release()
 
+	// Records are rewritten together once the snapshot of what they lose is saved
+	type deletion struct {
+		player          string
+		serverTimestamp time.Time
+	}
+	var deletions []deletion
+	batch := new(leveldb.Batch)
+	snapshot := &BanSnapshot{Server: server, DeletedAt: time.Now(), Entries: make(map[string][]InventoryEntry)}
+
 	for iter.Next() {
 		player := string(iter.Key())
 		data := iter.Value()
@@ -446,7 +772,7 @@ func (db *DB) Delete(server string, force bool) error {
 		}
 
 		var newEntries []InventoryEntry
-		var serverTimestamp time.Time
+		var serverTimestamp, removedTimestamp time.Time
 		modified := false
 
 		// Find the latest timestamp from the server to be deleted
@@ -460,14 +786,12 @@ func (db *DB) Delete(server string, force bool) error {
 
 		// Process each entry
 		for _, entry := range playerInv.Entries {
-			if entry.Server == server {
-				// Remove all entries from this server
-				modified = true
-				continue
-			}
-
-			if force && !serverTimestamp.IsZero() && entry.Timestamp.After(serverTimestamp) {
-				// Remove entries that came after the server's latest entry
+			if entry.Server == server || (force && !serverTimestamp.IsZero() && entry.Timestamp.After(serverTimestamp)) {
+				// Remove entries from this server, and with force the entries that came after its latest one
+				if entry.Timestamp.After(removedTimestamp) {
+					removedTimestamp = entry.Timestamp
+				}
+				snapshot.Entries[player] = append(snapshot.Entries[player], entry)
 				modified = true
 				continue
 			}
@@ -475,6 +799,7 @@ func (db *DB) Delete(server string, force bool) error {
 			// Parse and clean the inventory contents
 			cleanedEntry := entry
 			if cleanedInventory, inventoryModified := db.cleanInventoryContents(entry.Inventory, server); inventoryModified {
+				snapshot.Entries[player] = append(snapshot.Entries[player], entry)
 				cleanedEntry.Inventory = cleanedInventory
 				modified = true
 			}
@@ -484,45 +809,157 @@ func (db *DB) Delete(server string, force bool) error {
 
 		// Only update if something changed
 		if modified {
-			if len(newEntries) == 0 {
-				// No entries left, delete the player entirely
-				err := db.leveldb.Delete(iter.Key(), nil)
-				if err != nil {
-					return err
-				}
-			} else {
-				// Update with filtered entries
-				playerInv.Entries = newEntries
-
-				// Sort entries by timestamp (newest first)
-				sort.Slice(playerInv.Entries, func(i, j int) bool {
-					return playerInv.Entries[i].Timestamp.After(playerInv.Entries[j].Timestamp)
+			// No entries left, a tombstone up to the newest removed entry keeps peers from restoring them
+			if len(newEntries) == 0 && !removedTimestamp.IsZero() {
+				playerInv.Deleted = newerTombstone(playerInv.Deleted, &Tombstone{
+					At:        removedTimestamp,
+					DeletedAt: time.Now(),
+					Reason:    banReason(server),
 				})
+			}
 
-				newData, err := json.Marshal(playerInv)
-				if err != nil {
-					return err
-				}
+			// Update with filtered entries
+			playerInv.Entries = newEntries
 
-				err = db.leveldb.Put(iter.Key(), newData, nil)
-				if err != nil {
-					return err
-				}
+			// Sort entries by timestamp (newest first)
+			sort.Slice(playerInv.Entries, func(i, j int) bool {
+				return playerInv.Entries[i].Timestamp.After(playerInv.Entries[j].Timestamp)
+			})
+
+			newData, err := json.Marshal(playerInv)
+			if err != nil {
+				return err
 			}
 
-			// Log deletion for concurrent streaming
-			db.changeLog = append(db.changeLog, ChangeEntry{
-				player:    player,
-				entry:     InventoryEntry{Server: server},
-				timestamp: time.Now(),
-				deleted:   true,
-			})
+			batch.Put([]byte(player), newData)
+			deletions = append(deletions, deletion{player: player, serverTimestamp: serverTimestamp})
 		}
 	}
 
 	if err := iter.Error(); err != nil {
 		return err
 	}
+	if len(deletions) == 0 {
+		return nil
+	}
+
+	if db.banSnapshots != "" {
+		if err := saveBanSnapshot(db.banSnapshots, snapshot); err != nil {
+			return err
+		}
+	}
+	if err := db.leveldb.Write(batch, nil); err != nil {
+		return err
+	}
+
+	for _, d := range deletions {
+		// Fenced writes removed by the ban go with it, others lose the banned server's items
+		if fence, ok := db.fences.get(d.player); ok {
+			if fence.Server == server || (force && !d.serverTimestamp.IsZero() && fence.Timestamp.After(d.serverTimestamp)) {
+				db.fences.drop(d.player)
+			} else if cleaned, fenceModified := db.cleanInventoryContents(fence.Inventory, server); fenceModified {
+				db.fences.update(d.player, cleaned)
+			}
+		}
+
+		// Log deletion for concurrent streaming
+		db.changeLog = append(db.changeLog, ChangeEntry{
+			player:    d.player,
+			entry:     InventoryEntry{Server: server},
+			timestamp: time.Now(),
+			deleted:   true,
+		})
+	}
+
+	// Keep change log bounded
+	if len(db.changeLog) > 1000 {
+		db.changeLog = db.changeLog[len(db.changeLog)-1000:]
+	}
+
+	return nil
+}
+
+// DeletePlayer removes every inventory entry of a single player, leaving other players untouched
+// The record is replaced with a tombstone that replicates the deletion to peers, by names who deleted it
+func (db *DB) DeletePlayer(player, by string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if db.closed {
+		return ErrClosed
+	}
+
+	key := []byte(player)
+	data, err := db.leveldb.Get(key, nil)
+	if err != nil {
+		if err == leveldb.ErrNotFound {
+			return ErrPlayerNotFound
+		}
+		return err
+	}
+
+	// A deleted record is not deleted twice, legacy and unreadable records are replaced all the same
+	var playerInv PlayerInventories
+	if err := json.Unmarshal(data, &playerInv); err == nil && playerInv.Deleted != nil &&
+		len(playerInv.Entries) == 0 && len(playerInv.Archives) == 0 {
+		return ErrPlayerNotFound
+	}
+
+	now := time.Now()
+	tombstone := &PlayerInventories{Deleted: &Tombstone{At: now, DeletedAt: now, By: by}}
+	data, err = json.Marshal(tombstone)
+	if err != nil {
+		return err
+	}
+	if err := db.leveldb.Put(key, data, nil); err != nil {
+		return err
+	}
+	db.fences.drop(player)
+
+	logger.Infof("Audit: deleted player %s with %d inventory entries", player, len(playerInv.Entries))
+
+	// Stream the tombstone so connected peers delete the player too
+	db.changeLog = append(db.changeLog, ChangeEntry{
+		player:    player,
+		timestamp: now,
+	})
+
+	// Keep change log bounded
+	if len(db.changeLog) > 1000 {
+		db.changeLog = db.changeLog[len(db.changeLog)-1000:]
+	}
+
+	return nil
+}
+
+// ForgetPlayer removes a player's record from this node only, without a tombstone, for records
+// that live on elsewhere such as players moved to another shard
+func (db *DB) ForgetPlayer(player string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if db.closed {
+		return ErrClosed
+	}
+
+	key := []byte(player)
+	if _, err := db.leveldb.Get(key, nil); err != nil {
+		if err == leveldb.ErrNotFound {
+			return ErrPlayerNotFound
+		}
+		return err
+	}
+	if err := db.leveldb.Delete(key, nil); err != nil {
+		return err
+	}
+	db.fences.drop(player)
+
+	// Log deletion for concurrent streaming
+	db.changeLog = append(db.changeLog, ChangeEntry{
+		player:    player,
+		timestamp: time.Now(),
+		deleted:   true,
+	})
 
 	// Keep change log bounded
 	if len(db.changeLog) > 1000 {
@@ -607,30 +1044,38 @@ func (db *DB) cleanInventoryContents(inventoryData []byte, server string) ([]byt
 	return cleanedData, true
 }
 
-// GetPlayerInventories returns all inventory entries for a player
+// GetPlayerInventories returns all inventory entries for a player, including the ones
+// archived to the cold store
 func (db *DB) GetPlayerInventories(player string) ([]InventoryEntry, error) {
 	db.mu.RLock()
-	defer db.mu.RUnlock()
-
 	if db.closed {
+		db.mu.RUnlock()
 		return nil, ErrClosed
 	}
-
-	key := []byte(player)
-	data, err := db.leveldb.Get(key, nil)
+	playerInv, err := db.record([]byte(player))
+	cold := db.cold
+	db.mu.RUnlock()
 	if err != nil {
-		if err == leveldb.ErrNotFound {
-			return nil, ErrPlayerNotFound
-		}
 		return nil, err
 	}
 
-	var playerInv PlayerInventories
-	if err := json.Unmarshal(data, &playerInv); err != nil {
-		return nil, err
+	return withArchived(player, cold, playerInv), nil
+}
+
+// RawRecord returns the stored record of a player as StreamAll sends it to peers
+func (db *DB) RawRecord(player string) ([]byte, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	if db.closed {
+		return nil, ErrClosed
 	}
 
-	return playerInv.Entries, nil
+	data, err := db.leveldb.Get([]byte(player), nil)
+	if err == leveldb.ErrNotFound {
+		return nil, ErrPlayerNotFound
+	}
+	return data, err
 }
 
 func (db *DB) StreamAll() <-chan *DatabaseEntry {
@@ -638,9 +1083,11 @@ func (db *DB) StreamAll() <-chan *DatabaseEntry {
 
 	go func() {
 		defer close(ch)
+		defer crash.Guard("database stream")
 
 		// Mark sync start point
 		syncStart := time.Now()
+		defer db.latency.observe("stream_all", "", syncStart)
 
 		// Take snapshot for consistent read
 		snapshot, err := db.leveldb.GetSnapshot()
@@ -1,10 +1,13 @@
 package database
 
 import (
+	"encoding/json"
+	"fmt"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/d1nch8g/consensuscraft/fixtures"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -95,6 +98,25 @@ func TestDB_PutGet(t *testing.T) {
 	}
 }
 
+func TestDB_PutWithLocation(t *testing.T) {
+	db, err := New(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	location := &Location{X: 10.5, Y: 64, Z: -3, Dimension: "minecraft:overworld"}
+	require.NoError(t, db.PutWithLocation("player1", []byte("inventory1"), "server1", location))
+	require.NoError(t, db.Put("player1", []byte("inventory2"), "server1"))
+
+	entries, err := db.GetPlayerInventories("player1")
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	// Newest first, the plain Put carries no location
+	assert.Nil(t, entries[0].Location)
+	require.NotNil(t, entries[1].Location)
+	assert.Equal(t, *location, *entries[1].Location)
+}
+
 func TestDB_GetNonExistent(t *testing.T) {
 	db, err := New(t.TempDir())
 	require.NoError(t, err)
@@ -172,6 +194,70 @@ func TestDB_Delete(t *testing.T) {
 	assert.Equal(t, ErrPlayerNotFound, err)
 }
 
+func TestDB_DeletePlayer(t *testing.T) {
+	db, err := New(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, db.Put("player1", []byte("inventory1"), "server1"))
+	require.NoError(t, db.Put("player1", []byte("inventory2"), "server2"))
+	require.NoError(t, db.Put("player2", []byte("inventory3"), "server1"))
+
+	require.NoError(t, db.DeletePlayer("player1", "server1"))
+
+	_, err = db.Get("player1")
+	assert.Equal(t, ErrPlayerNotFound, err)
+
+	// Other players and servers are untouched
+	data, err := db.Get("player2")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("inventory3"), data)
+
+	assert.Equal(t, ErrPlayerNotFound, db.DeletePlayer("player1", "server1"))
+
+	require.NoError(t, db.Close())
+	assert.Equal(t, ErrClosed, db.DeletePlayer("player2", "server1"))
+}
+
+func TestDB_Merge(t *testing.T) {
+	source, err := New(t.TempDir())
+	require.NoError(t, err)
+	defer source.Close()
+
+	target, err := New(t.TempDir())
+	require.NoError(t, err)
+	defer target.Close()
+
+	require.NoError(t, source.Put("player1", []byte("inventory1"), "server1"))
+	require.NoError(t, target.Put("player1", []byte("inventory2"), "server2"))
+	require.NoError(t, source.Put("player1", []byte("inventory3"), "server1"))
+
+	for entry := range source.StreamAll() {
+		changed, err := target.Merge(entry.Key, entry.Value)
+		require.NoError(t, err)
+		assert.True(t, changed)
+
+		// Merging the same record again adds nothing
+		changed, err = target.Merge(entry.Key, entry.Value)
+		require.NoError(t, err)
+		assert.False(t, changed)
+	}
+
+	entries, err := target.GetPlayerInventories("player1")
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	assert.Equal(t, []byte("inventory3"), entries[0].Inventory)
+	assert.Equal(t, []byte("inventory2"), entries[1].Inventory)
+	assert.Equal(t, []byte("inventory1"), entries[2].Inventory)
+
+	changed, err := target.Merge([]byte("player1"), nil)
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	_, err = target.Merge([]byte("player1"), []byte("not json"))
+	assert.Error(t, err)
+}
+
 func TestDB_DeleteWithForce(t *testing.T) {
 	db, err := New(t.TempDir())
 	require.NoError(t, err)
@@ -451,6 +537,32 @@ func TestDB_GetPlayerInventories(t *testing.T) {
 	assert.Equal(t, "server1", entries[2].Server)
 }
 
+func TestDB_RawRecord(t *testing.T) {
+	db, err := New(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, db.Put("testplayer", []byte("inv1"), "server1"))
+
+	record, err := db.RawRecord("testplayer")
+	require.NoError(t, err)
+
+	other, err := NewMemory()
+	require.NoError(t, err)
+	defer other.Close()
+
+	merged, err := other.Merge([]byte("testplayer"), record)
+	require.NoError(t, err)
+	assert.True(t, merged)
+
+	inventory, err := other.Get("testplayer")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("inv1"), inventory)
+
+	_, err = db.RawRecord("nobody")
+	assert.ErrorIs(t, err, ErrPlayerNotFound)
+}
+
 func TestDB_DeleteComplexScenario(t *testing.T) {
 	db, err := New(t.TempDir())
 	require.NoError(t, err)
@@ -559,6 +671,45 @@ func TestDB_DeleteWithItemOriginCleaning(t *testing.T) {
 	assert.NotContains(t, cleanedStr, "minecraft:diamond")
 }
 
+func TestDB_DeleteGeneratedInventories(t *testing.T) {
+	db, err := NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Items of the banned server go, everything else stays, shulker boxes included
+	kept := make(map[string]int)
+	for seed := int64(0); seed < 50; seed++ {
+		generator := fixtures.New(seed, fixtures.Options{
+			Origin:        "server1",
+			Foreign:       []string{"banned", "server2"},
+			ForeignChance: 0.4,
+			Shulkers:      0.2,
+		})
+		inventory := generator.Inventory()
+		player := fmt.Sprintf("player%d", seed)
+		require.NoError(t, db.Put(player, fixtures.Marshal(inventory), "server1"))
+
+		for _, origin := range fixtures.Origins(inventory) {
+			if origin != "banned" {
+				kept[player]++
+			}
+		}
+	}
+
+	require.NoError(t, db.Delete("banned", false))
+
+	for player, count := range kept {
+		data, err := db.Get(player)
+		require.NoError(t, err)
+
+		var inventory []any
+		require.NoError(t, json.Unmarshal(data, &inventory))
+		origins := fixtures.Origins(inventory)
+		assert.NotContains(t, origins, "banned", player)
+		assert.Len(t, origins, count, player)
+	}
+}
+
 func TestDB_CrossServerItemValidation(t *testing.T) {
 	// This test demonstrates how validation would catch servers producing items from other servers
 	// Note: The actual validation happens at the application level using the validator
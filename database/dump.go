@@ -0,0 +1,117 @@
+package database
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"time"
+)
+
+// PayloadDumper writes received inventory payloads and their validation result to
+// per-player rolling files, for debugging pack serialization issues
+type PayloadDumper struct {
+	dir            string
+	maxBytes       int64
+	interval       time.Duration
+	redactNameTags bool
+	validator      *ItemValidator
+
+	mu      sync.Mutex
+	last    map[string]time.Time
+	dropped map[string]int
+}
+
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+
+// NewPayloadDumper creates a dumper writing to dir, rolling each player's file once it exceeds maxBytes
+// At most one payload per player is written every interval, the rest are counted as dropped
+func NewPayloadDumper(dir string, maxBytes int64, interval time.Duration, redactNameTags bool) (*PayloadDumper, error) {
+	if maxBytes <= 0 {
+		return nil, fmt.Errorf("max bytes must be positive, got %d", maxBytes)
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create dump directory: %w", err)
+	}
+
+	return &PayloadDumper{
+		dir:            dir,
+		maxBytes:       maxBytes,
+		interval:       interval,
+		redactNameTags: redactNameTags,
+		validator:      NewItemValidator(),
+		last:           make(map[string]time.Time),
+		dropped:        make(map[string]int),
+	}, nil
+}
+
+// Dump records a payload received from server for player, returning false when rate limited
+func (d *PayloadDumper) Dump(player string, payload []byte, server string) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := time.Now()
+	if last, ok := d.last[player]; ok && now.Sub(last) < d.interval {
+		d.dropped[player]++
+		return false, nil
+	}
+	d.last[player] = now
+	dropped := d.dropped[player]
+	delete(d.dropped, player)
+
+	errors := d.validator.ValidateInventory(payload, server, player)
+
+	var record bytes.Buffer
+	fmt.Fprintf(&record, "%s server=%s bytes=%d", now.Format(time.RFC3339Nano), server, len(payload))
+	if dropped > 0 {
+		fmt.Fprintf(&record, " dropped=%d", dropped)
+	}
+	if len(errors) == 0 {
+		record.WriteString(" validation=ok\n")
+	} else {
+		fmt.Fprintf(&record, " validation=%d errors\n", len(errors))
+		for _, e := range errors {
+			fmt.Fprintf(&record, "  slot %d %s: %s\n", e.ItemIndex, e.ErrorType, e.Message)
+		}
+	}
+
+	if d.redactNameTags {
+		payload = redactNameTags(payload)
+	}
+	record.Write(payload)
+	record.WriteString("\n\n")
+
+	return true, d.write(player, record.Bytes())
+}
+
+// write appends a record to the player's file, rolling it over to a .1 backup when full
+func (d *PayloadDumper) write(player string, record []byte) error {
+	path := filepath.Join(d.dir, unsafeFileChars.ReplaceAllString(player, "_")+".log")
+
+	if info, err := os.Stat(path); err == nil && info.Size()+int64(len(record)) > d.maxBytes {
+		if err := os.Rename(path, path+".1"); err != nil {
+			return fmt.Errorf("failed to roll dump file: %w", err)
+		}
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open dump file: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(record); err != nil {
+		return fmt.Errorf("failed to write dump file: %w", err)
+	}
+
+	return nil
+}
+
+// redactNameTags replaces every nameTag in the payload, including shulker contents
+// Payloads that are not valid JSON are returned unchanged so serialization bugs stay visible
+func redactNameTags(payload []byte) []byte {
+	return (&Redaction{nameTag: true}).Inventory(payload)
+}
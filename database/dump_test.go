@@ -0,0 +1,108 @@
+package database
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayloadDumper_Dump(t *testing.T) {
+	dir := t.TempDir()
+	dumper, err := NewPayloadDumper(dir, 1<<20, time.Hour, false)
+	require.NoError(t, err)
+
+	payload := []byte(`[{"typeId":"minecraft:diamond","amount":1,"lore":["Origin: server1"]}]`)
+	written, err := dumper.Dump("alice", payload, "server1")
+	require.NoError(t, err)
+	assert.True(t, written)
+
+	// Rate limited within the interval
+	written, err = dumper.Dump("alice", payload, "server1")
+	require.NoError(t, err)
+	assert.False(t, written)
+
+	// Other players have their own budget and file
+	written, err = dumper.Dump("bob/../x", []byte(`[{"typeId":"minecraft:diamond","amount":1}]`), "server1")
+	require.NoError(t, err)
+	assert.True(t, written)
+
+	data, err := os.ReadFile(filepath.Join(dir, "alice.log"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "server=server1")
+	assert.Contains(t, string(data), "validation=ok")
+	assert.Contains(t, string(data), string(payload))
+
+	data, err = os.ReadFile(filepath.Join(dir, "bob_.._x.log"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "validation=1 errors")
+	assert.Contains(t, string(data), "slot 0 missing_origin")
+}
+
+func TestPayloadDumper_RateLimitReportsDropped(t *testing.T) {
+	dir := t.TempDir()
+	dumper, err := NewPayloadDumper(dir, 1<<20, 20*time.Millisecond, false)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		_, err := dumper.Dump("alice", []byte(`[]`), "server1")
+		require.NoError(t, err)
+	}
+
+	time.Sleep(25 * time.Millisecond)
+	written, err := dumper.Dump("alice", []byte(`[]`), "server1")
+	require.NoError(t, err)
+	assert.True(t, written)
+
+	data, err := os.ReadFile(filepath.Join(dir, "alice.log"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "dropped=2")
+}
+
+func TestPayloadDumper_Rolling(t *testing.T) {
+	dir := t.TempDir()
+	dumper, err := NewPayloadDumper(dir, 200, 0, false)
+	require.NoError(t, err)
+
+	payload := []byte(`[{"typeId":"minecraft:apple","amount":1,"lore":["Origin: server1"]}]`)
+	for i := 0; i < 5; i++ {
+		_, err := dumper.Dump("alice", payload, "server1")
+		require.NoError(t, err)
+	}
+
+	current, err := os.Stat(filepath.Join(dir, "alice.log"))
+	require.NoError(t, err)
+	assert.LessOrEqual(t, current.Size(), int64(200))
+
+	backup, err := os.Stat(filepath.Join(dir, "alice.log.1"))
+	require.NoError(t, err)
+	assert.LessOrEqual(t, backup.Size(), int64(200))
+}
+
+func TestPayloadDumper_RedactNameTags(t *testing.T) {
+	dir := t.TempDir()
+	dumper, err := NewPayloadDumper(dir, 1<<20, 0, true)
+	require.NoError(t, err)
+
+	payload := []byte(`[{"typeId":"minecraft:shulker_box","amount":1,"nameTag":"Alice's stash","shulkerContents":[{"typeId":"minecraft:diamond_sword","amount":1,"nameTag":"Secret"}]},null]`)
+	_, err = dumper.Dump("alice", payload, "server1")
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join(dir, "alice.log"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "Alice's stash")
+	assert.NotContains(t, string(data), "Secret")
+	assert.Contains(t, string(data), `"nameTag":"[redacted]"`)
+
+	// Invalid JSON is kept as is
+	assert.Equal(t, []byte(`[{"nameTag":`), redactNameTags([]byte(`[{"nameTag":`)))
+}
+
+func TestNewPayloadDumper_InvalidMaxBytes(t *testing.T) {
+	dumper, err := NewPayloadDumper(t.TempDir(), 0, time.Second, false)
+	assert.Nil(t, dumper)
+	assert.Error(t, err)
+}
@@ -0,0 +1,212 @@
+package database
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// UnknownOrigin groups items without origin lore in economy reports
+const UnknownOrigin = "unknown"
+
+// EconomySnapshot holds item totals across all player inventories at a point in time
+type EconomySnapshot struct {
+	At      time.Time                 `json:"at"`
+	Players int                       `json:"players"`
+	Items   map[string]map[string]int `json:"items"` // origin server -> typeId -> amount
+}
+
+// EconomyChange describes how the amount of an item group changed between two snapshots
+type EconomyChange struct {
+	Server  string  `json:"server,omitempty"`
+	TypeID  string  `json:"type_id,omitempty"`
+	Before  int     `json:"before"`
+	After   int     `json:"after"`
+	Net     int     `json:"net"`
+	Growth  float64 `json:"growth"`
+	Anomaly bool    `json:"anomaly"`
+}
+
+// EconomyDiff is the net item creation between two snapshots grouped by server and by item type
+type EconomyDiff struct {
+	From     time.Time       `json:"from"`
+	To       time.Time       `json:"to"`
+	ByServer []EconomyChange `json:"by_server"`
+	ByItem   []EconomyChange `json:"by_item"`
+	Changes  []EconomyChange `json:"changes"`
+}
+
+// EconomySnapshot totals every item held by players using the inventory entry in force at the given time
+func (db *DB) EconomySnapshot(at time.Time) (*EconomySnapshot, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	if db.closed {
+		return nil, ErrClosed
+	}
+
+	snapshot := &EconomySnapshot{
+		At:    at,
+		Items: make(map[string]map[string]int),
+	}
+
+	iter := db.leveldb.NewIterator(util.BytesPrefix(nil), nil)
+	defer iter.Release()
+
+	for iter.Next() {
+		var playerInv PlayerInventories
+		if err := json.Unmarshal(iter.Value(), &playerInv); err != nil {
+			continue // Skip corrupted entries
+		}
+
+		entry, ok := entryAt(playerInv.Entries, at)
+		if !ok {
+			continue
+		}
+
+		snapshot.Players++
+		snapshot.add(entry.Inventory)
+	}
+
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// entryAt returns the newest entry recorded at or before the given time
+// Entries are expected sorted newest first
+func entryAt(entries []InventoryEntry, at time.Time) (InventoryEntry, bool) {
+	for _, entry := range entries {
+		if !entry.Timestamp.After(at) {
+			return entry, true
+		}
+	}
+	return InventoryEntry{}, false
+}
+
+// add counts items of an inventory including nested shulker contents
+func (s *EconomySnapshot) add(inventoryData []byte) {
+	var slots []any
+	if err := json.Unmarshal(inventoryData, &slots); err != nil {
+		return
+	}
+
+	for _, slot := range slots {
+		if slot == nil {
+			continue
+		}
+
+		slotBytes, err := json.Marshal(slot)
+		if err != nil {
+			continue
+		}
+
+		var item Item
+		if err := json.Unmarshal(slotBytes, &item); err != nil || item.TypeID == "" {
+			continue
+		}
+
+		server := item.origin()
+		if server == "" {
+			server = UnknownOrigin
+		}
+
+		if s.Items[server] == nil {
+			s.Items[server] = make(map[string]int)
+		}
+		s.Items[server][item.TypeID] += item.Amount
+
+		if len(item.ShulkerContents) > 0 {
+			if contents, err := json.Marshal(item.ShulkerContents); err == nil {
+				s.add(contents)
+			}
+		}
+	}
+}
+
+// DiffEconomy lists net item creation between two snapshots
+// Groups growing faster than maxGrowth (0.5 = +50%) are flagged as anomalies, zero disables flagging
+func DiffEconomy(before, after *EconomySnapshot, maxGrowth float64) *EconomyDiff {
+	diff := &EconomyDiff{From: before.At, To: after.At}
+
+	servers := make(map[string][2]int)
+	items := make(map[string][2]int)
+	details := make(map[[2]string][2]int)
+
+	collect := func(snapshot *EconomySnapshot, index int) {
+		for server, types := range snapshot.Items {
+			for typeID, amount := range types {
+				s := servers[server]
+				s[index] += amount
+				servers[server] = s
+
+				i := items[typeID]
+				i[index] += amount
+				items[typeID] = i
+
+				d := details[[2]string{server, typeID}]
+				d[index] += amount
+				details[[2]string{server, typeID}] = d
+			}
+		}
+	}
+	collect(before, 0)
+	collect(after, 1)
+
+	for server, amounts := range servers {
+		diff.ByServer = append(diff.ByServer, newEconomyChange(server, "", amounts, maxGrowth))
+	}
+	for typeID, amounts := range items {
+		diff.ByItem = append(diff.ByItem, newEconomyChange("", typeID, amounts, maxGrowth))
+	}
+	for key, amounts := range details {
+		if amounts[0] == amounts[1] {
+			continue
+		}
+		diff.Changes = append(diff.Changes, newEconomyChange(key[0], key[1], amounts, maxGrowth))
+	}
+
+	sortEconomyChanges(diff.ByServer)
+	sortEconomyChanges(diff.ByItem)
+	sortEconomyChanges(diff.Changes)
+
+	return diff
+}
+
+func newEconomyChange(server, typeID string, amounts [2]int, maxGrowth float64) EconomyChange {
+	change := EconomyChange{
+		Server: server,
+		TypeID: typeID,
+		Before: amounts[0],
+		After:  amounts[1],
+		Net:    amounts[1] - amounts[0],
+	}
+
+	switch {
+	case change.Before > 0:
+		change.Growth = float64(change.Net) / float64(change.Before)
+	case change.After > 0:
+		change.Growth = math.Inf(1)
+	}
+
+	change.Anomaly = maxGrowth > 0 && change.Growth > maxGrowth
+	return change
+}
+
+// sortEconomyChanges orders changes by largest net creation first
+func sortEconomyChanges(changes []EconomyChange) {
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Net != changes[j].Net {
+			return changes[i].Net > changes[j].Net
+		}
+		if changes[i].Server != changes[j].Server {
+			return changes[i].Server < changes[j].Server
+		}
+		return changes[i].TypeID < changes[j].TypeID
+	})
+}
@@ -0,0 +1,120 @@
+package database
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_EconomySnapshot(t *testing.T) {
+	db, err := New(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	beforeAny := time.Now()
+	time.Sleep(5 * time.Millisecond)
+
+	require.NoError(t, db.Put("alice", []byte(`[
+		{"typeId":"minecraft:diamond","amount":10,"lore":["Origin: server1"]},
+		{"typeId":"minecraft:shulker_box","amount":1,"lore":["Origin: server1"],"shulkerContents":[
+			{"typeId":"minecraft:diamond","amount":5,"lore":["Origin: server2"]}
+		]},
+		null
+	]`), "server1"))
+	require.NoError(t, db.Put("bob", []byte(`[{"typeId":"minecraft:apple","amount":3}]`), "server2"))
+
+	first := time.Now()
+	time.Sleep(5 * time.Millisecond)
+
+	require.NoError(t, db.Put("alice", []byte(`[{"typeId":"minecraft:diamond","amount":64,"lore":["Origin: server1"]}]`), "server1"))
+
+	t.Run("empty before any entry", func(t *testing.T) {
+		snapshot, err := db.EconomySnapshot(beforeAny)
+		require.NoError(t, err)
+		assert.Equal(t, 0, snapshot.Players)
+		assert.Empty(t, snapshot.Items)
+	})
+
+	t.Run("uses entries in force at the time", func(t *testing.T) {
+		snapshot, err := db.EconomySnapshot(first)
+		require.NoError(t, err)
+		assert.Equal(t, 2, snapshot.Players)
+		assert.Equal(t, map[string]map[string]int{
+			"server1":     {"minecraft:diamond": 10, "minecraft:shulker_box": 1},
+			"server2":     {"minecraft:diamond": 5},
+			UnknownOrigin: {"minecraft:apple": 3},
+		}, snapshot.Items)
+	})
+
+	t.Run("latest entries", func(t *testing.T) {
+		snapshot, err := db.EconomySnapshot(time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]map[string]int{
+			"server1":     {"minecraft:diamond": 64},
+			UnknownOrigin: {"minecraft:apple": 3},
+		}, snapshot.Items)
+	})
+
+	t.Run("closed database", func(t *testing.T) {
+		closed, err := New(t.TempDir())
+		require.NoError(t, err)
+		require.NoError(t, closed.Close())
+
+		_, err = closed.EconomySnapshot(time.Now())
+		assert.Equal(t, ErrClosed, err)
+	})
+}
+
+func TestDiffEconomy(t *testing.T) {
+	before := &EconomySnapshot{
+		At: time.Unix(0, 0),
+		Items: map[string]map[string]int{
+			"server1": {"minecraft:diamond": 100, "minecraft:bread": 10},
+			"server2": {"minecraft:diamond": 50},
+		},
+	}
+	after := &EconomySnapshot{
+		At: time.Unix(3600, 0),
+		Items: map[string]map[string]int{
+			"server1": {"minecraft:diamond": 110, "minecraft:bread": 10},
+			"server2": {"minecraft:diamond": 500},
+			"server3": {"minecraft:netherite_ingot": 4},
+		},
+	}
+
+	diff := DiffEconomy(before, after, 0.5)
+
+	assert.Equal(t, before.At, diff.From)
+	assert.Equal(t, after.At, diff.To)
+
+	require.Len(t, diff.ByServer, 3)
+	assert.Equal(t, EconomyChange{Server: "server2", Before: 50, After: 500, Net: 450, Growth: 9, Anomaly: true}, diff.ByServer[0])
+	assert.Equal(t, "server1", diff.ByServer[1].Server)
+	assert.Equal(t, 10, diff.ByServer[1].Net)
+	assert.False(t, diff.ByServer[1].Anomaly)
+	assert.Equal(t, "server3", diff.ByServer[2].Server)
+	assert.True(t, math.IsInf(diff.ByServer[2].Growth, 1))
+	assert.True(t, diff.ByServer[2].Anomaly)
+
+	require.Len(t, diff.ByItem, 3)
+	assert.Equal(t, "minecraft:diamond", diff.ByItem[0].TypeID)
+	assert.Equal(t, 460, diff.ByItem[0].Net)
+	assert.Equal(t, "minecraft:bread", diff.ByItem[2].TypeID)
+	assert.Equal(t, 0, diff.ByItem[2].Net)
+
+	// Unchanged groups are left out of the detailed changes
+	require.Len(t, diff.Changes, 3)
+	for _, change := range diff.Changes {
+		assert.NotEqual(t, "minecraft:bread", change.TypeID)
+	}
+
+	t.Run("zero threshold disables anomalies", func(t *testing.T) {
+		diff := DiffEconomy(before, after, 0)
+		for _, change := range diff.ByServer {
+			assert.False(t, change.Anomaly)
+		}
+	})
+}
@@ -0,0 +1,178 @@
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Equipment slots of a player, named as the EquipmentSlot values of the scripting API
+const (
+	SlotHead     = "Head"
+	SlotChest    = "Chest"
+	SlotLegs     = "Legs"
+	SlotFeet     = "Feet"
+	SlotOffhand  = "Offhand"
+	SlotMainhand = "Mainhand"
+)
+
+// equipmentSlots orders the slots, the position of a slot is the ItemIndex of its errors
+var equipmentSlots = []string{SlotHead, SlotChest, SlotLegs, SlotFeet, SlotOffhand, SlotMainhand}
+
+// elytraMaxDurability is the durability of an elytra, a worn one stops at 1 and is never fully damaged
+const elytraMaxDurability = 432
+
+var (
+	// Head items that are not helmets
+	headItems        = []string{"minecraft:carved_pumpkin"}
+	headItemSuffixes = []string{"_helmet", "_head", "_skull"}
+
+	// Items the game lets a player hold in the offhand
+	offhandItems = []string{
+		"minecraft:shield",
+		"minecraft:totem_of_undying",
+		"minecraft:arrow",
+		"minecraft:firework_rocket",
+		"minecraft:filled_map",
+		"minecraft:empty_map",
+		"minecraft:nautilus_shell",
+	}
+)
+
+// ValidateEquipment validates the equipped items of a player, given as a JSON object of slot to item
+// On top of the item checks of ValidateInventory, armor slots may only hold a single piece of the
+// matching armor, the offhand only items the game allows there and an elytra must not be worn out
+// beyond what the game allows, such loadouts cannot be made in game and point at injected data
+func (v *ItemValidator) ValidateEquipment(equipmentData []byte, server, player string) []ValidationError {
+	var equipment map[string]any
+	if err := json.Unmarshal(equipmentData, &equipment); err != nil {
+		return []ValidationError{{
+			Player:    player,
+			Server:    server,
+			ItemIndex: -1,
+			ErrorType: "invalid_equipment",
+			Message:   "Failed to parse equipment JSON",
+		}}
+	}
+
+	var allErrors []ValidationError
+	add := func(errs ...ValidationError) {
+		for _, err := range errs {
+			err.Player = player
+			err.Server = server
+			allErrors = append(allErrors, err)
+		}
+	}
+
+	for name := range equipment {
+		if !slices.Contains(equipmentSlots, name) {
+			add(ValidationError{
+				ItemIndex: -1,
+				ErrorType: "unknown_equipment_slot",
+				Message:   fmt.Sprintf("Unknown equipment slot %s", name),
+			})
+		}
+	}
+
+	for index, slot := range equipmentSlots {
+		value := equipment[slot]
+		if value == nil {
+			continue
+		}
+
+		fields, ok := value.(map[string]any)
+		if !ok {
+			add(ValidationError{
+				ItemIndex: index,
+				ErrorType: "unparseable_item",
+				Message:   fmt.Sprintf("Item in slot %s cannot be parsed", slot),
+			})
+			continue
+		}
+
+		var item Item
+		item.fromMap(fields)
+		add(v.ValidateItem(&item, server, index)...)
+		add(validateSlot(&item, slot, index)...)
+	}
+
+	return allErrors
+}
+
+// validateSlot checks that an item can be equipped in a slot
+func validateSlot(item *Item, slot string, index int) []ValidationError {
+	if item.TypeID == "" || slot == SlotMainhand {
+		return nil
+	}
+
+	var errors []ValidationError
+	if !slotAccepts(slot, item.TypeID) {
+		errors = append(errors, ValidationError{
+			ItemIndex: index,
+			ErrorType: "invalid_equipment_slot",
+			Message:   fmt.Sprintf("%s cannot be equipped in slot %s", item.TypeID, slot),
+		})
+	}
+
+	if slot != SlotOffhand && item.Amount > 1 {
+		errors = append(errors, ValidationError{
+			ItemIndex: index,
+			ErrorType: "invalid_equipped_amount",
+			Message:   fmt.Sprintf("Slot %s holds %d items, armor is worn one piece at a time", slot, item.Amount),
+		})
+	}
+
+	if item.TypeID == "minecraft:elytra" {
+		errors = append(errors, validateElytra(item.Durability, index)...)
+	}
+
+	return errors
+}
+
+// slotAccepts reports whether the game allows an item type in an armor or offhand slot
+func slotAccepts(slot, typeID string) bool {
+	switch slot {
+	case SlotHead:
+		if slices.Contains(headItems, typeID) {
+			return true
+		}
+		for _, suffix := range headItemSuffixes {
+			if strings.HasSuffix(typeID, suffix) {
+				return true
+			}
+		}
+		return false
+	case SlotChest:
+		return strings.HasSuffix(typeID, "_chestplate") || typeID == "minecraft:elytra"
+	case SlotLegs:
+		return strings.HasSuffix(typeID, "_leggings")
+	case SlotFeet:
+		return strings.HasSuffix(typeID, "_boots")
+	case SlotOffhand:
+		return slices.Contains(offhandItems, typeID)
+	}
+	return true
+}
+
+// validateElytra checks the durability of an elytra, which can take at most one less damage than its durability
+func validateElytra(durability map[string]any, index int) []ValidationError {
+	var errors []ValidationError
+
+	if maxDurability, ok := durability["maxDurability"].(float64); ok && int(maxDurability) != elytraMaxDurability {
+		errors = append(errors, ValidationError{
+			ItemIndex: index,
+			ErrorType: "invalid_max_durability",
+			Message:   fmt.Sprintf("Invalid max durability %d for minecraft:elytra (expected: %d)", int(maxDurability), elytraMaxDurability),
+		})
+	}
+	if damage, ok := durability["damage"].(float64); ok && int(damage) >= elytraMaxDurability {
+		errors = append(errors, ValidationError{
+			ItemIndex: index,
+			ErrorType: "invalid_elytra_durability",
+			Message:   fmt.Sprintf("Elytra damage %d is beyond the %d the game allows", int(damage), elytraMaxDurability-1),
+		})
+	}
+
+	return errors
+}
@@ -0,0 +1,75 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// equipmentErrorTypes returns the error types of an equipment validation, by slot index
+func equipmentErrorTypes(t *testing.T, equipment string) map[int][]string {
+	t.Helper()
+	types := make(map[int][]string)
+	for _, err := range NewItemValidator().ValidateEquipment([]byte(equipment), "server1", "alice") {
+		assert.Equal(t, "alice", err.Player)
+		types[err.ItemIndex] = append(types[err.ItemIndex], err.ErrorType)
+	}
+	return types
+}
+
+func TestItemValidator_ValidateEquipment(t *testing.T) {
+	t.Run("valid loadout", func(t *testing.T) {
+		types := equipmentErrorTypes(t, `{
+			"Head": {"typeId":"minecraft:turtle_helmet","amount":1,"lore":["Origin: server1"]},
+			"Chest": {"typeId":"minecraft:elytra","amount":1,"lore":["Origin: server1"],"durability":{"damage":431,"maxDurability":432}},
+			"Legs": {"typeId":"minecraft:iron_leggings","amount":1,"lore":["Origin: server1"]},
+			"Feet": null,
+			"Offhand": {"typeId":"minecraft:firework_rocket","amount":32,"lore":["Origin: server1"]},
+			"Mainhand": {"typeId":"minecraft:cobblestone","amount":64,"lore":["Origin: server1"]}
+		}`)
+		assert.Empty(t, types)
+	})
+
+	t.Run("items in the wrong slots", func(t *testing.T) {
+		types := equipmentErrorTypes(t, `{
+			"Head": {"typeId":"minecraft:diamond_boots","amount":1,"lore":["Origin: server1"]},
+			"Feet": {"typeId":"minecraft:carved_pumpkin","amount":1,"lore":["Origin: server1"]},
+			"Offhand": {"typeId":"minecraft:diamond_sword","amount":1,"lore":["Origin: server1"]}
+		}`)
+		assert.Equal(t, map[int][]string{
+			0: {"invalid_equipment_slot"},
+			3: {"invalid_equipment_slot"},
+			4: {"invalid_equipment_slot"},
+		}, types)
+	})
+
+	t.Run("stacked armor and worn out elytra", func(t *testing.T) {
+		types := equipmentErrorTypes(t, `{
+			"Head": {"typeId":"minecraft:iron_helmet","amount":2,"lore":["Origin: server1"]},
+			"Chest": {"typeId":"minecraft:elytra","amount":1,"lore":["Origin: server1"],"durability":{"damage":432,"maxDurability":432}}
+		}`)
+		assert.Equal(t, map[int][]string{
+			0: {"invalid_equipped_amount"},
+			1: {"invalid_elytra_durability"},
+		}, types)
+	})
+
+	t.Run("elytra with forged durability", func(t *testing.T) {
+		types := equipmentErrorTypes(t, `{
+			"Chest": {"typeId":"minecraft:elytra","amount":1,"lore":["Origin: server1"],"durability":{"damage":0,"maxDurability":5000}}
+		}`)
+		assert.Equal(t, map[int][]string{1: {"invalid_max_durability"}}, types)
+	})
+
+	t.Run("item checks still apply", func(t *testing.T) {
+		types := equipmentErrorTypes(t, `{
+			"Feet": {"typeId":"minecraft:diamond_boots","amount":1,"lore":["Origin: server2"],"enchantments":[{"type":"minecraft:frost_walker","level":9}]}
+		}`)
+		assert.Equal(t, map[int][]string{3: {"invalid_enchantment_level", "wrong_origin"}}, types)
+	})
+
+	t.Run("unknown slot and invalid JSON", func(t *testing.T) {
+		assert.Equal(t, map[int][]string{-1: {"unknown_equipment_slot"}}, equipmentErrorTypes(t, `{"Tail": null}`))
+		assert.Equal(t, map[int][]string{-1: {"invalid_equipment"}}, equipmentErrorTypes(t, `[]`))
+	})
+}
@@ -0,0 +1,109 @@
+package database
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// ErrInvalidExportCursor is returned for cursors not produced by Export
+var ErrInvalidExportCursor = errors.New("invalid export cursor")
+
+// ExportQuery selects the player records streamed by Export, records are ordered by player name
+type ExportQuery struct {
+	Server string    // Only entries from this server when not empty
+	Since  time.Time // Only entries at or after Since when not zero
+	Until  time.Time // Only entries before Until when not zero
+	Limit  int       // Stop after this many records, all of them when zero
+	Cursor string    // Cursor of the last record received, to resume an interrupted export
+}
+
+// ExportRecord is the inventory history of one player, archived entries are not included
+type ExportRecord struct {
+	Player  string           `json:"player"`
+	Entries []InventoryEntry `json:"entries"`
+	Cursor  string           `json:"cursor"` // Resumes the export after this record
+}
+
+// Export calls fn with every player record matching the query, read from a consistent snapshot
+// so writes made meanwhile are not blocked, it stops at the first error of fn or when ctx is done
+// Records without entries matching the filters are skipped, corrupted records too
+func (db *DB) Export(ctx context.Context, query ExportQuery, fn func(ExportRecord) error) error {
+	var start []byte
+	if query.Cursor != "" {
+		player, err := base64.RawURLEncoding.DecodeString(query.Cursor)
+		if err != nil || len(player) == 0 {
+			return ErrInvalidExportCursor
+		}
+		// The smallest key after the cursor
+		start = append(player, 0)
+	}
+
+	db.mu.RLock()
+	if db.closed {
+		db.mu.RUnlock()
+		return ErrClosed
+	}
+	snapshot, err := db.leveldb.GetSnapshot()
+	db.mu.RUnlock()
+	if err != nil {
+		return err
+	}
+	defer snapshot.Release()
+
+	iter := snapshot.NewIterator(&util.Range{Start: start}, nil)
+	defer iter.Release()
+
+	exported := 0
+	for iter.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var playerInv PlayerInventories
+		if err := json.Unmarshal(iter.Value(), &playerInv); err != nil {
+			continue
+		}
+
+		record := ExportRecord{
+			Player:  string(iter.Key()),
+			Entries: matchingEntries(playerInv.Entries, query),
+			Cursor:  base64.RawURLEncoding.EncodeToString(iter.Key()),
+		}
+		if len(record.Entries) == 0 {
+			continue
+		}
+
+		if err := fn(record); err != nil {
+			return err
+		}
+		exported++
+		if query.Limit > 0 && exported == query.Limit {
+			return nil
+		}
+	}
+
+	return iter.Error()
+}
+
+// matchingEntries returns the entries passing the server and time filters of the query
+func matchingEntries(entries []InventoryEntry, query ExportQuery) []InventoryEntry {
+	matching := make([]InventoryEntry, 0, len(entries))
+	for _, entry := range entries {
+		if query.Server != "" && entry.Server != query.Server {
+			continue
+		}
+		if !query.Since.IsZero() && entry.Timestamp.Before(query.Since) {
+			continue
+		}
+		if !query.Until.IsZero() && !entry.Timestamp.Before(query.Until) {
+			continue
+		}
+		matching = append(matching, entry)
+	}
+	return matching
+}
@@ -0,0 +1,98 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newExportDB stores one inventory for each player, alternating between two servers
+func newExportDB(t *testing.T, players ...string) *DB {
+	db, err := NewMemory()
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	for i, player := range players {
+		server := "a.example.com"
+		if i%2 == 1 {
+			server = "b.example.com"
+		}
+		require.NoError(t, db.Put(player, []byte(`[]`), server))
+	}
+	return db
+}
+
+func exportPlayers(t *testing.T, db *DB, query ExportQuery) ([]string, string) {
+	var players []string
+	var cursor string
+	err := db.Export(context.Background(), query, func(record ExportRecord) error {
+		players = append(players, record.Player)
+		cursor = record.Cursor
+		return nil
+	})
+	require.NoError(t, err)
+	return players, cursor
+}
+
+func TestDB_Export(t *testing.T) {
+	db := newExportDB(t, "carol", "alice", "dave", "bob")
+
+	t.Run("all records ordered by player", func(t *testing.T) {
+		players, _ := exportPlayers(t, db, ExportQuery{})
+		assert.Equal(t, []string{"alice", "bob", "carol", "dave"}, players)
+	})
+
+	t.Run("resumes after the cursor", func(t *testing.T) {
+		players, cursor := exportPlayers(t, db, ExportQuery{Limit: 2})
+		assert.Equal(t, []string{"alice", "bob"}, players)
+
+		players, _ = exportPlayers(t, db, ExportQuery{Cursor: cursor})
+		assert.Equal(t, []string{"carol", "dave"}, players)
+	})
+
+	t.Run("filters entries", func(t *testing.T) {
+		// Alice and bob were stored second and fourth, on b.example.com
+		players, _ := exportPlayers(t, db, ExportQuery{Server: "b.example.com"})
+		assert.Equal(t, []string{"alice", "bob"}, players)
+
+		players, _ = exportPlayers(t, db, ExportQuery{Since: time.Now().Add(time.Hour)})
+		assert.Empty(t, players)
+		players, _ = exportPlayers(t, db, ExportQuery{Until: time.Now().Add(time.Hour)})
+		assert.Len(t, players, 4)
+	})
+
+	t.Run("writes during the export are not seen", func(t *testing.T) {
+		var players []string
+		err := db.Export(context.Background(), ExportQuery{}, func(record ExportRecord) error {
+			players = append(players, record.Player)
+			return db.Put("erin", []byte(`[]`), "a.example.com")
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"alice", "bob", "carol", "dave"}, players)
+	})
+
+	t.Run("stops on errors", func(t *testing.T) {
+		stop := errors.New("client went away")
+		calls := 0
+		err := db.Export(context.Background(), ExportQuery{}, func(ExportRecord) error {
+			calls++
+			return stop
+		})
+		assert.ErrorIs(t, err, stop)
+		assert.Equal(t, 1, calls)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err = db.Export(ctx, ExportQuery{}, func(ExportRecord) error { return nil })
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		err := db.Export(context.Background(), ExportQuery{Cursor: "not base64!"}, func(ExportRecord) error { return nil })
+		assert.ErrorIs(t, err, ErrInvalidExportCursor)
+	})
+}
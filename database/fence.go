@@ -0,0 +1,83 @@
+package database
+
+import (
+	"sync"
+	"time"
+)
+
+// fenceTTL bounds how long a local write is remembered, fences only need to cover a rejoin
+const fenceTTL = 10 * time.Minute
+
+// writeFences remembers the last local write of each player, so Get keeps returning at least
+// that write even when a concurrent rewrite of the record, such as ban cleanup, drops it
+type writeFences struct {
+	mu      sync.Mutex
+	entries map[string]InventoryEntry
+}
+
+// set records a local write
+func (f *writeFences) set(player string, entry InventoryEntry) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.entries == nil {
+		f.entries = make(map[string]InventoryEntry)
+	}
+	f.entries[player] = entry
+
+	// Prune expired fences once in a while so the map stays bounded by active players
+	if len(f.entries)%256 == 0 {
+		for p, e := range f.entries {
+			if time.Since(e.Timestamp) > fenceTTL {
+				delete(f.entries, p)
+			}
+		}
+	}
+}
+
+// get returns the fenced write of a player if it has not expired
+func (f *writeFences) get(player string) (InventoryEntry, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	entry, ok := f.entries[player]
+	if !ok {
+		return InventoryEntry{}, false
+	}
+	if time.Since(entry.Timestamp) > fenceTTL {
+		delete(f.entries, player)
+		return InventoryEntry{}, false
+	}
+	return entry, true
+}
+
+// drop forgets the fenced write of a player
+func (f *writeFences) drop(player string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.entries, player)
+}
+
+// update replaces the fenced inventory of a player, keeping its timestamp
+func (f *writeFences) update(player string, inventory []byte) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if entry, ok := f.entries[player]; ok {
+		entry.Inventory = inventory
+		f.entries[player] = entry
+	}
+}
+
+// fenced returns the entry Get must return given the newest stored entry, which is the
+// fenced write when the record no longer holds it or anything newer
+func (db *DB) fenced(player string, newest *InventoryEntry) (InventoryEntry, bool) {
+	fence, ok := db.fences.get(player)
+	if !ok {
+		return InventoryEntry{}, false
+	}
+	if newest != nil && !newest.Timestamp.Before(fence.Timestamp) {
+		return InventoryEntry{}, false
+	}
+	return fence, true
+}
@@ -0,0 +1,87 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_WriteFence(t *testing.T) {
+	t.Run("survives a concurrent rewrite dropping the local write", func(t *testing.T) {
+		db, err := NewMemory()
+		require.NoError(t, err)
+		defer db.Close()
+
+		require.NoError(t, db.Put("alice", []byte(`[]`), "other.example.com"))
+		stale, err := db.leveldb.Get([]byte("alice"), nil)
+		require.NoError(t, err)
+
+		require.NoError(t, db.Put("alice", []byte(`[{"typeId":"minecraft:dirt","amount":1}]`), "local.example.com"))
+
+		// A rewrite based on a snapshot taken before the local write
+		require.NoError(t, db.leveldb.Put([]byte("alice"), stale, nil))
+
+		inventory, err := db.Get("alice")
+		require.NoError(t, err)
+		assert.JSONEq(t, `[{"typeId":"minecraft:dirt","amount":1}]`, string(inventory))
+
+		// Even when the rewrite removed the record altogether
+		require.NoError(t, db.leveldb.Delete([]byte("alice"), nil))
+		inventory, err = db.Get("alice")
+		require.NoError(t, err)
+		assert.JSONEq(t, `[{"typeId":"minecraft:dirt","amount":1}]`, string(inventory))
+	})
+
+	t.Run("forced ban cleanup drops the fenced write it removes", func(t *testing.T) {
+		db, err := NewMemory()
+		require.NoError(t, err)
+		defer db.Close()
+
+		require.NoError(t, db.Put("alice", []byte(`[]`), "banned.example.com"))
+		time.Sleep(time.Millisecond)
+		require.NoError(t, db.Put("alice", []byte(`[{"typeId":"minecraft:dirt","amount":1}]`), "local.example.com"))
+		require.NoError(t, db.Delete("banned.example.com", true))
+
+		_, err = db.Get("alice")
+		assert.ErrorIs(t, err, ErrPlayerNotFound)
+	})
+
+	t.Run("newer stored entries win over the fence", func(t *testing.T) {
+		db, err := NewMemory()
+		require.NoError(t, err)
+		defer db.Close()
+
+		require.NoError(t, db.Put("alice", []byte(`[]`), "local.example.com"))
+		require.NoError(t, db.Put("alice", []byte(`[{"typeId":"minecraft:stone","amount":2}]`), "other.example.com"))
+
+		inventory, err := db.Get("alice")
+		require.NoError(t, err)
+		assert.JSONEq(t, `[{"typeId":"minecraft:stone","amount":2}]`, string(inventory))
+	})
+
+	t.Run("ban of the fenced server drops the fence", func(t *testing.T) {
+		db, err := NewMemory()
+		require.NoError(t, err)
+		defer db.Close()
+
+		require.NoError(t, db.Put("alice", []byte(`[]`), "banned.example.com"))
+		require.NoError(t, db.Delete("banned.example.com", false))
+
+		_, err = db.Get("alice")
+		assert.ErrorIs(t, err, ErrPlayerNotFound)
+	})
+
+	t.Run("deleting the player drops the fence", func(t *testing.T) {
+		db, err := NewMemory()
+		require.NoError(t, err)
+		defer db.Close()
+
+		require.NoError(t, db.Put("alice", []byte(`[]`), "local.example.com"))
+		require.NoError(t, db.DeletePlayer("alice", "local.example.com"))
+
+		_, err = db.Get("alice")
+		assert.ErrorIs(t, err, ErrPlayerNotFound)
+	})
+}
@@ -0,0 +1,63 @@
+package database
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// ErrInventoryRejected is wrapped by filters refusing to store an inventory
+var ErrInventoryRejected = errors.New("inventory rejected")
+
+// InventoryFilter inspects an inventory from server before it is stored, returning the
+// inventory to store instead or an error wrapping ErrInventoryRejected to refuse it
+type InventoryFilter func(inventory []byte, server string) ([]byte, error)
+
+// SetFilter installs a filter applied by Put and Merge, nil removes it
+func (db *DB) SetFilter(filter InventoryFilter) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.filter = filter
+}
+
+// OnFiltered calls notify with the player and origin server of every inventory the filter
+// modified before it was stored, notify runs with the database locked and must not use it
+func (db *DB) OnFiltered(notify func(player, server string)) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.filtered = notify
+}
+
+// applyFilter runs the installed filter, db.mu must be held
+func (db *DB) applyFilter(inventory []byte, server string) ([]byte, error) {
+	if db.filter == nil {
+		return inventory, nil
+	}
+
+	filtered, err := db.filter(inventory, server)
+	if err != nil {
+		return nil, fmt.Errorf("inventory from %s: %w", server, err)
+	}
+	return filtered, nil
+}
+
+// storeFiltered runs the filter on an inventory about to be stored, logging when it was modified
+// Legacy origin lines are rewritten to the current format first
+func (db *DB) storeFiltered(player string, inventory []byte, server string) ([]byte, error) {
+	inventory, _ = normalizeOriginLore(inventory)
+	filtered, err := db.applyFilter(inventory, server)
+	if err != nil {
+		db.stats.reject(server, rejectionReason(err), err.Error())
+		return nil, err
+	}
+
+	if !bytes.Equal(filtered, inventory) {
+		logger.Warnf("Filtered inventory of %s from %s before storing it", player, server)
+		if db.filtered != nil {
+			db.filtered(player, server)
+		}
+	}
+	return filtered, nil
+}
@@ -0,0 +1,113 @@
+package database
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync/atomic"
+
+	"github.com/d1nch8g/consensuscraft/hashing"
+)
+
+// fingerprintIgnored are item fields left out of fingerprints: lore is edited by the pack and
+// operators, amount and durability change with normal use, fingerprint is the result itself
+var fingerprintIgnored = []string{"lore", "amount", "durability", "fingerprint"}
+
+// fingerprintHash is the algorithm fingerprints are computed with, the default one until set
+var fingerprintHash atomic.Value
+
+// SetFingerprintAlgorithm changes the hash algorithm of item fingerprints, every server of a network
+// must use the same one for fingerprints to match across servers
+func SetFingerprintAlgorithm(algorithm hashing.Algorithm) {
+	fingerprintHash.Store(algorithm)
+}
+
+// FingerprintAlgorithm returns the hash algorithm item fingerprints are computed with
+func FingerprintAlgorithm() hashing.Algorithm {
+	if algorithm, ok := fingerprintHash.Load().(hashing.Algorithm); ok {
+		return algorithm
+	}
+	return hashing.Default
+}
+
+// Fingerprint returns the stable identity of a serialized item, a hex hash over its canonical
+// form that survives lore edits, wear and stacking but changes with its type, name,
+// enchantments or shulker contents
+func Fingerprint(item []byte) (string, error) {
+	var decoded map[string]any
+	if err := json.Unmarshal(item, &decoded); err != nil {
+		return "", fmt.Errorf("invalid item: %w", err)
+	}
+
+	canonical, err := json.Marshal(canonicalItem(decoded, true))
+	if err != nil {
+		return "", err
+	}
+
+	sum := FingerprintAlgorithm().Sum(canonical)
+	return hex.EncodeToString(sum[:16]), nil
+}
+
+// InventoryFingerprints returns the fingerprint of every non-empty slot of an inventory by slot index
+func InventoryFingerprints(inventory []byte) (map[int]string, error) {
+	var slots []json.RawMessage
+	if err := json.Unmarshal(inventory, &slots); err != nil {
+		return nil, fmt.Errorf("invalid inventory: %w", err)
+	}
+
+	fingerprints := make(map[int]string)
+	for i, slot := range slots {
+		if len(slot) == 0 || string(slot) == "null" {
+			continue
+		}
+
+		fingerprint, err := Fingerprint(slot)
+		if err != nil {
+			return nil, fmt.Errorf("slot %d: %w", i, err)
+		}
+		fingerprints[i] = fingerprint
+	}
+
+	return fingerprints, nil
+}
+
+// canonicalItem drops ignored fields and orders enchantments, json.Marshal sorts the remaining keys
+// Items nested in a shulker box keep their amount, as it is part of what the box holds
+func canonicalItem(item map[string]any, top bool) map[string]any {
+	canonical := make(map[string]any, len(item))
+	for key, value := range item {
+		canonical[key] = value
+	}
+	for _, key := range fingerprintIgnored {
+		if key != "amount" || top {
+			delete(canonical, key)
+		}
+	}
+
+	if enchantments, ok := canonical["enchantments"].([]any); ok && len(enchantments) == 0 {
+		delete(canonical, "enchantments") // Written as an empty list by some pack versions
+	} else if ok {
+		sorted := append([]any{}, enchantments...)
+		sort.Slice(sorted, func(i, j int) bool {
+			a, _ := json.Marshal(sorted[i])
+			b, _ := json.Marshal(sorted[j])
+			return string(a) < string(b)
+		})
+		canonical["enchantments"] = sorted
+	}
+
+	if contents, ok := canonical["shulkerContents"].([]any); ok {
+		nested := make([]any, len(contents))
+		for i, content := range contents {
+			if m, ok := content.(map[string]any); ok {
+				nested[i] = canonicalItem(m, false)
+			} else {
+				nested[i] = content
+			}
+		}
+		canonical["shulkerContents"] = nested
+	}
+
+	return canonical
+}
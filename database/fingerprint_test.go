@@ -0,0 +1,101 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/d1nch8g/consensuscraft/hashing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFingerprint(t *testing.T) {
+	sword := `{"typeId":"minecraft:diamond_sword","amount":1,"nameTag":"Excalibur","enchantments":[{"type":"sharpness","level":5},{"type":"unbreaking","level":3}]}`
+
+	base, err := Fingerprint([]byte(sword))
+	require.NoError(t, err)
+	assert.Len(t, base, 32)
+
+	same := []struct {
+		name string
+		item string
+	}{
+		{name: "lore edited", item: `{"typeId":"minecraft:diamond_sword","amount":1,"nameTag":"Excalibur","lore":["Origin: node-0","Forged by Arthur"],"enchantments":[{"type":"sharpness","level":5},{"type":"unbreaking","level":3}]}`},
+		{name: "worn down", item: `{"typeId":"minecraft:diamond_sword","amount":1,"nameTag":"Excalibur","durability":{"damage":120,"maxDurability":1561},"enchantments":[{"type":"sharpness","level":5},{"type":"unbreaking","level":3}]}`},
+		{name: "enchantments reordered", item: `{"enchantments":[{"level":3,"type":"unbreaking"},{"type":"sharpness","level":5}],"nameTag":"Excalibur","typeId":"minecraft:diamond_sword","amount":1}`},
+		{name: "fingerprint attached", item: `{"typeId":"minecraft:diamond_sword","amount":1,"nameTag":"Excalibur","fingerprint":"abc","enchantments":[{"type":"sharpness","level":5},{"type":"unbreaking","level":3}]}`},
+	}
+	for _, tt := range same {
+		t.Run(tt.name, func(t *testing.T) {
+			fingerprint, err := Fingerprint([]byte(tt.item))
+			require.NoError(t, err)
+			assert.Equal(t, base, fingerprint)
+		})
+	}
+
+	different := []struct {
+		name string
+		item string
+	}{
+		{name: "renamed", item: `{"typeId":"minecraft:diamond_sword","amount":1,"nameTag":"Caliburn","enchantments":[{"type":"sharpness","level":5},{"type":"unbreaking","level":3}]}`},
+		{name: "enchantment level", item: `{"typeId":"minecraft:diamond_sword","amount":1,"nameTag":"Excalibur","enchantments":[{"type":"sharpness","level":4},{"type":"unbreaking","level":3}]}`},
+		{name: "item type", item: `{"typeId":"minecraft:netherite_sword","amount":1,"nameTag":"Excalibur","enchantments":[{"type":"sharpness","level":5},{"type":"unbreaking","level":3}]}`},
+	}
+	for _, tt := range different {
+		t.Run(tt.name, func(t *testing.T) {
+			fingerprint, err := Fingerprint([]byte(tt.item))
+			require.NoError(t, err)
+			assert.NotEqual(t, base, fingerprint)
+		})
+	}
+
+	_, err = Fingerprint([]byte(`not json`))
+	assert.Error(t, err)
+}
+
+func TestFingerprint_ShulkerContents(t *testing.T) {
+	box := func(contents string) string {
+		return `{"typeId":"minecraft:red_shulker_box","amount":1,"lore":["Origin: node-0"],"shulkerContents":` + contents + `}`
+	}
+
+	base, err := Fingerprint([]byte(box(`[{"typeId":"minecraft:diamond","amount":32,"lore":["Origin: node-0"]},null]`)))
+	require.NoError(t, err)
+
+	relored, err := Fingerprint([]byte(box(`[{"typeId":"minecraft:diamond","amount":32,"lore":["Origin: node-1"]},null]`)))
+	require.NoError(t, err)
+	assert.Equal(t, base, relored)
+
+	// The amount of nested items is part of the box's identity
+	emptied, err := Fingerprint([]byte(box(`[{"typeId":"minecraft:diamond","amount":1},null]`)))
+	require.NoError(t, err)
+	assert.NotEqual(t, base, emptied)
+}
+
+func TestInventoryFingerprints(t *testing.T) {
+	fingerprints, err := InventoryFingerprints([]byte(`[null,{"typeId":"minecraft:diamond","amount":3},null,{"typeId":"minecraft:bow","amount":1,"nameTag":"Longshot"}]`))
+	require.NoError(t, err)
+	require.Len(t, fingerprints, 2)
+	assert.Contains(t, fingerprints, 1)
+	assert.Contains(t, fingerprints, 3)
+	assert.NotEqual(t, fingerprints[1], fingerprints[3])
+
+	_, err = InventoryFingerprints([]byte(`[{"typeId":"minecraft:diamond"},"broken"]`))
+	assert.ErrorContains(t, err, "slot 1")
+
+	_, err = InventoryFingerprints([]byte(`{}`))
+	assert.Error(t, err)
+}
+
+func TestFingerprint_Algorithm(t *testing.T) {
+	item := []byte(`{"typeId":"minecraft:diamond","amount":1}`)
+	t.Cleanup(func() { SetFingerprintAlgorithm(hashing.Default) })
+
+	sha, err := Fingerprint(item)
+	require.NoError(t, err)
+	assert.Equal(t, hashing.SHA256, FingerprintAlgorithm())
+
+	SetFingerprintAlgorithm(hashing.BLAKE3)
+	blake, err := Fingerprint(item)
+	require.NoError(t, err)
+	assert.Len(t, blake, 32)
+	assert.NotEqual(t, sha, blake)
+}
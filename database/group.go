@@ -0,0 +1,307 @@
+package database
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// GroupPrefix starts the keys of shared inventories, gamertags cannot hold a colon so they never
+// clash with player records
+const GroupPrefix = "group:"
+
+// maxGroupID bounds the length of group IDs
+const maxGroupID = 64
+
+// maxGroupAccess bounds the access audit kept in a shared inventory record
+const maxGroupAccess = 500
+
+// Actions of members on a shared inventory
+const (
+	GroupRead  = "read"
+	GroupWrite = "write"
+)
+
+var (
+	ErrGroupNotFound  = errors.New("group not found")
+	ErrNotGroupMember = errors.New("player is not a member of the group")
+	ErrInvalidGroup   = errors.New("invalid group")
+)
+
+// Group is a shared inventory such as a guild vault, its members read and write it from every server
+// of the network, the record syncs and is validated like a player record
+type Group struct {
+	ID        string    `json:"id"`
+	Members   []string  `json:"members"`
+	UpdatedAt time.Time `json:"updated_at"` // The newest membership wins when records are merged
+	UpdatedBy string    `json:"updated_by,omitempty"`
+}
+
+// GroupAccess records a member reading or writing a shared inventory
+type GroupAccess struct {
+	Member string    `json:"member"`
+	Server string    `json:"server"`
+	Action string    `json:"action"`
+	At     time.Time `json:"at"`
+}
+
+// GroupRecord is a shared inventory with its members and access audit, for operators
+type GroupRecord struct {
+	Group   Group           `json:"group"`
+	Latest  *InventoryEntry `json:"latest,omitempty"` // Nil until a member stored the inventory
+	Entries int             `json:"entries"`
+	Access  []GroupAccess   `json:"access"`
+}
+
+// GroupKey returns the record key of a shared inventory
+func GroupKey(id string) string {
+	return GroupPrefix + id
+}
+
+// IsGroupKey reports whether a record key holds a shared inventory
+func IsGroupKey(key string) bool {
+	return strings.HasPrefix(key, GroupPrefix)
+}
+
+// HasMember reports whether a player is a member, gamertags are case insensitive
+func (g *Group) HasMember(player string) bool {
+	if g == nil {
+		return false
+	}
+	for _, member := range g.Members {
+		if strings.EqualFold(member, player) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateGroupID checks that an ID can key a record and travel in URLs
+func validateGroupID(id string) error {
+	if id == "" || len(id) > maxGroupID || strings.TrimSpace(id) != id || strings.ContainsAny(id, "/\n\r\t") {
+		return fmt.Errorf("%w: id %q must be 1 to %d characters without slashes or surrounding spaces", ErrInvalidGroup, id, maxGroupID)
+	}
+	return nil
+}
+
+// normalizeMembers trims the member names, dropping empty ones and duplicates, sorted
+func normalizeMembers(members []string) []string {
+	seen := make(map[string]bool)
+	normalized := []string{}
+	for _, member := range members {
+		member = strings.TrimSpace(member)
+		if member == "" || seen[strings.ToLower(member)] {
+			continue
+		}
+		seen[strings.ToLower(member)] = true
+		normalized = append(normalized, member)
+	}
+	sort.Strings(normalized)
+	return normalized
+}
+
+// SetGroupMembers creates a shared inventory or replaces its members, by is the server making the change
+func (db *DB) SetGroupMembers(id string, members []string, by string) (*Group, error) {
+	if err := validateGroupID(id); err != nil {
+		return nil, err
+	}
+	members = normalizeMembers(members)
+	if len(members) == 0 {
+		return nil, fmt.Errorf("%w: a group needs at least one member", ErrInvalidGroup)
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if db.closed {
+		return nil, ErrClosed
+	}
+
+	key := []byte(GroupKey(id))
+	record, err := db.record(key)
+	if errors.Is(err, ErrPlayerNotFound) {
+		record, err = &PlayerInventories{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	record.Group = &Group{ID: id, Members: members, UpdatedAt: time.Now(), UpdatedBy: by}
+	if err := db.storeRecord(key, record); err != nil {
+		return nil, err
+	}
+
+	logger.Infof("Audit: set members of group %s to %s by %s", id, strings.Join(members, ", "), by)
+	group := *record.Group
+	return &group, nil
+}
+
+// PutGroup stores a new inventory of a shared inventory written by one of its members on server
+func (db *DB) PutGroup(id, member string, inventory []byte, server string) error {
+	if err := validateGroupID(id); err != nil {
+		return err
+	}
+
+	var slots []json.RawMessage
+	if err := json.Unmarshal(inventory, &slots); err != nil {
+		db.stats.reject(server, "invalid_inventory", "inventory is not a JSON array")
+		return &RejectionError{Reason: "invalid_inventory", Message: "inventory is not a JSON array"}
+	}
+
+	key := GroupKey(id)
+	defer db.latency.observe("put", key, time.Now())
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if db.closed {
+		return ErrClosed
+	}
+
+	entry := InventoryEntry{Inventory: inventory, Server: server, Member: member}
+	return db.put(key, entry, func(record *PlayerInventories) error {
+		if err := record.checkMember(id, member); err != nil {
+			return err
+		}
+		record.recordAccess(GroupAccess{Member: member, Server: server, Action: GroupWrite, At: time.Now()})
+		return nil
+	})
+}
+
+// GetGroup returns the latest inventory of a shared inventory to one of its members on server,
+// recording the access, the inventory is nil until a member stored one
+func (db *DB) GetGroup(id, member, server string) ([]byte, error) {
+	key := []byte(GroupKey(id))
+	defer db.latency.observe("get", string(key), time.Now())
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if db.closed {
+		return nil, ErrClosed
+	}
+
+	record, err := db.record(key)
+	if errors.Is(err, ErrPlayerNotFound) {
+		return nil, fmt.Errorf("%w: %s", ErrGroupNotFound, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := record.checkMember(id, member); err != nil {
+		return nil, err
+	}
+
+	record.recordAccess(GroupAccess{Member: member, Server: server, Action: GroupRead, At: time.Now()})
+	if err := db.storeRecord(key, record); err != nil {
+		return nil, err
+	}
+
+	if len(record.Entries) == 0 {
+		return nil, nil
+	}
+	return latestRestorable(string(key), record.Entries).Inventory, nil
+}
+
+// GroupInfo returns a shared inventory with its members and access audit, without recording an access
+func (db *DB) GroupInfo(id string) (*GroupRecord, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	if db.closed {
+		return nil, ErrClosed
+	}
+
+	record, err := db.record([]byte(GroupKey(id)))
+	if errors.Is(err, ErrPlayerNotFound) || (err == nil && record.Group == nil) {
+		return nil, fmt.Errorf("%w: %s", ErrGroupNotFound, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	info := &GroupRecord{Group: *record.Group, Entries: len(record.Entries), Access: record.Access}
+	if info.Access == nil {
+		info.Access = []GroupAccess{}
+	}
+	if len(record.Entries) > 0 {
+		latest := latestRestorable(GroupKey(id), record.Entries)
+		info.Latest = &latest
+	}
+	return info, nil
+}
+
+// checkMember refuses players outside the group of the record
+func (p *PlayerInventories) checkMember(id, player string) error {
+	if p.Group == nil {
+		return fmt.Errorf("%w: %s", ErrGroupNotFound, id)
+	}
+	if !p.Group.HasMember(player) {
+		return fmt.Errorf("%w: %s is not in %s", ErrNotGroupMember, player, id)
+	}
+	return nil
+}
+
+// recordAccess adds an access to the audit, dropping the oldest ones past maxGroupAccess
+func (p *PlayerInventories) recordAccess(access GroupAccess) {
+	p.Access = append([]GroupAccess{access}, p.Access...)
+	if len(p.Access) > maxGroupAccess {
+		p.Access = p.Access[:maxGroupAccess]
+	}
+}
+
+// mergeGroup takes the newer membership and the accesses missing locally from a peer record,
+// reporting whether the local record changed
+func (p *PlayerInventories) mergeGroup(remote *PlayerInventories) bool {
+	changed := false
+	if remote.Group != nil && (p.Group == nil || remote.Group.UpdatedAt.After(p.Group.UpdatedAt)) {
+		p.Group = remote.Group
+		changed = true
+	}
+
+	type accessKey struct {
+		member, server, action string
+		at                     int64
+	}
+	seen := make(map[accessKey]bool, len(p.Access))
+	for _, access := range p.Access {
+		seen[accessKey{access.Member, access.Server, access.Action, access.At.UnixNano()}] = true
+	}
+	for _, access := range remote.Access {
+		k := accessKey{access.Member, access.Server, access.Action, access.At.UnixNano()}
+		if !seen[k] {
+			seen[k] = true
+			p.Access = append(p.Access, access)
+			changed = true
+		}
+	}
+	if changed {
+		sort.SliceStable(p.Access, func(i, j int) bool { return p.Access[i].At.After(p.Access[j].At) })
+		if len(p.Access) > maxGroupAccess {
+			p.Access = p.Access[:maxGroupAccess]
+		}
+	}
+	return changed
+}
+
+// storeRecord writes a record and notes the change for streaming, db.mu must be held
+func (db *DB) storeRecord(key []byte, record *PlayerInventories) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	if err := db.leveldb.Put(key, data, nil); err != nil {
+		return err
+	}
+
+	db.changeLog = append(db.changeLog, ChangeEntry{player: string(key), timestamp: time.Now()})
+	if len(db.changeLog) > 1000 {
+		db.changeLog = db.changeLog[len(db.changeLog)-1000:]
+	}
+	return nil
+}
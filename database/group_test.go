@@ -0,0 +1,110 @@
+package database
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_Group(t *testing.T) {
+	db, err := NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+
+	vault := []byte(`[{"typeId":"minecraft:diamond","amount":8}]`)
+
+	t.Run("unknown group", func(t *testing.T) {
+		assert.ErrorIs(t, db.PutGroup("guild", "alice", vault, "a.example.com"), ErrGroupNotFound)
+		_, err := db.GetGroup("guild", "alice", "a.example.com")
+		assert.ErrorIs(t, err, ErrGroupNotFound)
+		_, err = db.GroupInfo("guild")
+		assert.ErrorIs(t, err, ErrGroupNotFound)
+	})
+
+	t.Run("invalid groups", func(t *testing.T) {
+		_, err := db.SetGroupMembers("a/b", []string{"alice"}, "a.example.com")
+		assert.ErrorIs(t, err, ErrInvalidGroup)
+		_, err = db.SetGroupMembers("guild", []string{" ", ""}, "a.example.com")
+		assert.ErrorIs(t, err, ErrInvalidGroup)
+	})
+
+	group, err := db.SetGroupMembers("guild", []string{"bob", "Alice", "alice", " "}, "a.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Alice", "bob"}, group.Members)
+
+	inventory, err := db.GetGroup("guild", "bob", "b.example.com")
+	require.NoError(t, err)
+	assert.Nil(t, inventory, "nothing stored yet")
+
+	require.NoError(t, db.PutGroup("guild", "alice", vault, "a.example.com"))
+	assert.ErrorIs(t, db.PutGroup("guild", "mallory", []byte(`[]`), "a.example.com"), ErrNotGroupMember)
+
+	inventory, err = db.GetGroup("guild", "BOB", "b.example.com")
+	require.NoError(t, err)
+	assert.JSONEq(t, string(vault), string(inventory))
+
+	_, err = db.GetGroup("guild", "mallory", "b.example.com")
+	assert.ErrorIs(t, err, ErrNotGroupMember)
+
+	info, err := db.GroupInfo("guild")
+	require.NoError(t, err)
+	assert.Equal(t, 1, info.Entries)
+	require.NotNil(t, info.Latest)
+	assert.Equal(t, "alice", info.Latest.Member)
+	require.Len(t, info.Access, 3)
+	assert.Equal(t, GroupAccess{Member: "BOB", Server: "b.example.com", Action: GroupRead, At: info.Access[0].At}, info.Access[0])
+	assert.Equal(t, GroupWrite, info.Access[1].Action)
+
+	t.Run("group keys are not player records", func(t *testing.T) {
+		err := db.Put(GroupKey("guild"), []byte(`[]`), "a.example.com")
+		assert.ErrorIs(t, err, ErrInventoryRejected)
+	})
+}
+
+func TestDB_MergeGroup(t *testing.T) {
+	db, err := NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.SetGroupMembers("guild", []string{"alice"}, "a.example.com")
+	require.NoError(t, err)
+	require.NoError(t, db.PutGroup("guild", "alice", []byte(`[]`), "a.example.com"))
+
+	now := time.Now()
+	remote, err := json.Marshal(PlayerInventories{
+		Group: &Group{ID: "guild", Members: []string{"alice", "bob"}, UpdatedAt: now.Add(time.Minute), UpdatedBy: "b.example.com"},
+		Entries: []InventoryEntry{
+			{Inventory: []byte(`[]`), Server: "b.example.com", Timestamp: now.Add(2 * time.Minute), Member: "bob"},
+			{Inventory: []byte(`[]`), Server: "b.example.com", Timestamp: now.Add(3 * time.Minute), Member: "mallory"},
+			{Inventory: []byte(`[]`), Server: "b.example.com", Timestamp: now.Add(4 * time.Minute)},
+		},
+		Access: []GroupAccess{{Member: "bob", Server: "b.example.com", Action: GroupWrite, At: now.Add(2 * time.Minute)}},
+	})
+	require.NoError(t, err)
+
+	merged, err := db.Merge([]byte(GroupKey("guild")), remote)
+	require.NoError(t, err)
+	assert.True(t, merged)
+
+	info, err := db.GroupInfo("guild")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alice", "bob"}, info.Group.Members)
+	assert.Equal(t, 2, info.Entries, "entries of players outside the group are skipped")
+	require.Len(t, info.Access, 2)
+	assert.Equal(t, "bob", info.Access[0].Member)
+
+	merged, err = db.Merge([]byte(GroupKey("guild")), remote)
+	require.NoError(t, err)
+	assert.False(t, merged)
+
+	t.Run("older membership is ignored", func(t *testing.T) {
+		stale, err := json.Marshal(PlayerInventories{Group: &Group{ID: "guild", Members: []string{"mallory"}, UpdatedAt: now.Add(-time.Hour)}})
+		require.NoError(t, err)
+		merged, err := db.Merge([]byte(GroupKey("guild")), stale)
+		require.NoError(t, err)
+		assert.False(t, merged)
+	})
+}
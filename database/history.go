@@ -0,0 +1,156 @@
+package database
+
+import (
+	"encoding/base64"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultHistoryLimit is the page size used when a HistoryQuery has no limit
+const DefaultHistoryLimit = 50
+
+// MaxHistoryLimit caps the page size so a single response stays small
+const MaxHistoryLimit = 500
+
+var (
+	// ErrInvalidCursor is returned for cursors not produced by GetPlayerInventoriesPage
+	ErrInvalidCursor = errors.New("invalid history cursor")
+	// ErrNoInventoryAt is returned when a player has no entry recorded at or before the requested time
+	ErrNoInventoryAt = errors.New("no inventory recorded at that time")
+)
+
+// HistoryQuery selects a page of a player's inventory history, newest entries first
+type HistoryQuery struct {
+	Server string    // Only entries from this server when not empty
+	Since  time.Time // Only entries at or after Since when not zero
+	Until  time.Time // Only entries before Until when not zero
+	Limit  int       // Page size, DefaultHistoryLimit when zero, capped at MaxHistoryLimit
+	Cursor string    // NextCursor of the previous page, empty for the first page
+}
+
+// HistoryPage is one page of a player's inventory history
+type HistoryPage struct {
+	Entries    []InventoryEntry `json:"entries"`
+	NextCursor string           `json:"next_cursor,omitempty"` // Empty on the last page
+}
+
+// historyCursor points just past the last entry of a page, entries are ordered by
+// timestamp descending and then server ascending
+type historyCursor struct {
+	timestamp int64
+	server    string
+}
+
+func (c historyCursor) encode() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(c.timestamp, 10) + ":" + c.server))
+}
+
+func decodeHistoryCursor(cursor string) (historyCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return historyCursor{}, ErrInvalidCursor
+	}
+
+	timestamp, server, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return historyCursor{}, ErrInvalidCursor
+	}
+
+	nanos, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return historyCursor{}, ErrInvalidCursor
+	}
+
+	return historyCursor{timestamp: nanos, server: server}, nil
+}
+
+// after reports whether an entry sorts after the cursor
+func (c historyCursor) after(entry InventoryEntry) bool {
+	nanos := entry.Timestamp.UnixNano()
+	if nanos != c.timestamp {
+		return nanos < c.timestamp
+	}
+	return entry.Server > c.server
+}
+
+// GetPlayerInventoriesPage returns a filtered page of a player's inventory history
+// Cursors stay valid while new entries are added, since they point at a position in time
+func (db *DB) GetPlayerInventoriesPage(player string, query HistoryQuery) (*HistoryPage, error) {
+	limit := query.Limit
+	if limit <= 0 {
+		limit = DefaultHistoryLimit
+	}
+	if limit > MaxHistoryLimit {
+		limit = MaxHistoryLimit
+	}
+
+	var cursor *historyCursor
+	if query.Cursor != "" {
+		decoded, err := decodeHistoryCursor(query.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		cursor = &decoded
+	}
+
+	entries, err := db.GetPlayerInventories(player)
+	if err != nil {
+		return nil, err
+	}
+	sortHistory(entries)
+
+	page := &HistoryPage{Entries: []InventoryEntry{}}
+	for _, entry := range entries {
+		if cursor != nil && !cursor.after(entry) {
+			continue
+		}
+		if query.Server != "" && entry.Server != query.Server {
+			continue
+		}
+		if !query.Since.IsZero() && entry.Timestamp.Before(query.Since) {
+			continue
+		}
+		if !query.Until.IsZero() && !entry.Timestamp.Before(query.Until) {
+			continue
+		}
+
+		if len(page.Entries) == limit {
+			last := page.Entries[limit-1]
+			page.NextCursor = historyCursor{timestamp: last.Timestamp.UnixNano(), server: last.Server}.encode()
+			break
+		}
+		page.Entries = append(page.Entries, entry)
+	}
+
+	return page, nil
+}
+
+// GetPlayerInventoryAt returns the entry of a player in force at t, the newest one recorded at or
+// before t in history order, including entries archived to the cold store
+func (db *DB) GetPlayerInventoryAt(player string, t time.Time) (*InventoryEntry, error) {
+	entries, err := db.GetPlayerInventories(player)
+	if err != nil {
+		return nil, err
+	}
+	sortHistory(entries)
+
+	for _, entry := range entries {
+		if !entry.Timestamp.After(t) {
+			return &entry, nil
+		}
+	}
+	return nil, ErrNoInventoryAt
+}
+
+// sortHistory orders entries newest first, breaking timestamp ties by server
+func sortHistory(entries []InventoryEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		if !entries[i].Timestamp.Equal(entries[j].Timestamp) {
+			return entries[i].Timestamp.After(entries[j].Timestamp)
+		}
+		return entries[i].Server < entries[j].Server
+	})
+}
@@ -0,0 +1,139 @@
+package database
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newHistoryDB stores count entries for alice, one minute apart, alternating between two servers
+func newHistoryDB(t *testing.T, base time.Time, count int) *DB {
+	db, err := NewMemory()
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	var record PlayerInventories
+	for i := 0; i < count; i++ {
+		server := "a.example.com"
+		if i%2 == 1 {
+			server = "b.example.com"
+		}
+		record.Entries = append(record.Entries, InventoryEntry{
+			Inventory: []byte(`[]`),
+			Server:    server,
+			Timestamp: base.Add(time.Duration(i) * time.Minute),
+		})
+	}
+
+	value, err := json.Marshal(record)
+	require.NoError(t, err)
+	_, err = db.Merge([]byte("alice"), value)
+	require.NoError(t, err)
+
+	return db
+}
+
+func TestDB_GetPlayerInventoriesPage(t *testing.T) {
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	db := newHistoryDB(t, base, 7)
+
+	t.Run("walks all pages newest first", func(t *testing.T) {
+		var seen []time.Time
+		query := HistoryQuery{Limit: 3}
+		for pages := 0; ; pages++ {
+			require.Less(t, pages, 5)
+
+			page, err := db.GetPlayerInventoriesPage("alice", query)
+			require.NoError(t, err)
+			for _, entry := range page.Entries {
+				seen = append(seen, entry.Timestamp)
+			}
+			if page.NextCursor == "" {
+				break
+			}
+			query.Cursor = page.NextCursor
+		}
+
+		require.Len(t, seen, 7)
+		for i, ts := range seen {
+			assert.True(t, ts.Equal(base.Add(time.Duration(6-i)*time.Minute)))
+		}
+	})
+
+	t.Run("cursor is stable when new entries arrive", func(t *testing.T) {
+		first, err := db.GetPlayerInventoriesPage("alice", HistoryQuery{Limit: 2})
+		require.NoError(t, err)
+
+		require.NoError(t, db.Put("alice", []byte(`[]`), "a.example.com"))
+
+		second, err := db.GetPlayerInventoriesPage("alice", HistoryQuery{Limit: 2, Cursor: first.NextCursor})
+		require.NoError(t, err)
+		require.Len(t, second.Entries, 2)
+		assert.True(t, second.Entries[0].Timestamp.Equal(base.Add(4*time.Minute)))
+	})
+
+	t.Run("filters by server and time", func(t *testing.T) {
+		page, err := db.GetPlayerInventoriesPage("alice", HistoryQuery{
+			Server: "b.example.com",
+			Since:  base.Add(2 * time.Minute),
+			Until:  base.Add(6 * time.Minute),
+		})
+		require.NoError(t, err)
+		require.Len(t, page.Entries, 2)
+		assert.True(t, page.Entries[0].Timestamp.Equal(base.Add(5*time.Minute)))
+		assert.True(t, page.Entries[1].Timestamp.Equal(base.Add(3*time.Minute)))
+		assert.Empty(t, page.NextCursor)
+	})
+
+	t.Run("limit is capped", func(t *testing.T) {
+		large := newHistoryDB(t, base, MaxHistoryLimit+10)
+
+		page, err := large.GetPlayerInventoriesPage("alice", HistoryQuery{Limit: 10000})
+		require.NoError(t, err)
+		assert.Len(t, page.Entries, MaxHistoryLimit)
+		assert.NotEmpty(t, page.NextCursor)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := db.GetPlayerInventoriesPage("alice", HistoryQuery{Cursor: "not a cursor!"})
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+
+		_, err = db.GetPlayerInventoriesPage("nobody", HistoryQuery{})
+		assert.ErrorIs(t, err, ErrPlayerNotFound)
+	})
+}
+
+func TestDB_GetPlayerInventoryAt(t *testing.T) {
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	db := newHistoryDB(t, base, 4)
+
+	t.Run("entry in force between updates", func(t *testing.T) {
+		entry, err := db.GetPlayerInventoryAt("alice", base.Add(90*time.Second))
+		require.NoError(t, err)
+		assert.True(t, entry.Timestamp.Equal(base.Add(time.Minute)))
+		assert.Equal(t, "b.example.com", entry.Server)
+	})
+
+	t.Run("entry recorded exactly at the time", func(t *testing.T) {
+		entry, err := db.GetPlayerInventoryAt("alice", base.Add(2*time.Minute))
+		require.NoError(t, err)
+		assert.True(t, entry.Timestamp.Equal(base.Add(2*time.Minute)))
+	})
+
+	t.Run("latest entry after the last update", func(t *testing.T) {
+		entry, err := db.GetPlayerInventoryAt("alice", base.Add(time.Hour))
+		require.NoError(t, err)
+		assert.True(t, entry.Timestamp.Equal(base.Add(3*time.Minute)))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := db.GetPlayerInventoryAt("alice", base.Add(-time.Second))
+		assert.ErrorIs(t, err, ErrNoInventoryAt)
+
+		_, err = db.GetPlayerInventoryAt("nobody", base)
+		assert.ErrorIs(t, err, ErrPlayerNotFound)
+	})
+}
@@ -0,0 +1,146 @@
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// SlotDiff is a slot holding different items in two views of an inventory
+type SlotDiff struct {
+	Slot   int             `json:"slot"`
+	Local  json.RawMessage `json:"local"` // null for an empty slot
+	Remote json.RawMessage `json:"remote"`
+	Fields []string        `json:"fields,omitempty"` // Item fields that differ when both slots hold an item
+}
+
+// ItemCountDiff is an item type of an origin counted differently in two views, shulker contents included
+type ItemCountDiff struct {
+	Server string `json:"server"`
+	TypeID string `json:"type_id"`
+	Local  int    `json:"local"`
+	Remote int    `json:"remote"`
+}
+
+// InventoryDiff lists the discrepancies between two views of an inventory, such as the latest entries
+// of a player on two servers
+type InventoryDiff struct {
+	Slots  []SlotDiff      `json:"slots"`
+	Counts []ItemCountDiff `json:"counts"`
+}
+
+// Empty reports whether both views hold the same items in the same slots
+func (d *InventoryDiff) Empty() bool {
+	return len(d.Slots) == 0 && len(d.Counts) == 0
+}
+
+// DiffInventories compares two inventories slot by slot and item totals by origin and type,
+// fields are compared by value so the order of keys does not matter
+func DiffInventories(local, remote []byte) (*InventoryDiff, error) {
+	var localSlots, remoteSlots []any
+	if err := json.Unmarshal(local, &localSlots); err != nil {
+		return nil, fmt.Errorf("invalid local inventory: %w", err)
+	}
+	if err := json.Unmarshal(remote, &remoteSlots); err != nil {
+		return nil, fmt.Errorf("invalid remote inventory: %w", err)
+	}
+
+	diff := &InventoryDiff{Slots: []SlotDiff{}, Counts: []ItemCountDiff{}}
+	for i := 0; i < max(len(localSlots), len(remoteSlots)); i++ {
+		var l, r any
+		if i < len(localSlots) {
+			l = localSlots[i]
+		}
+		if i < len(remoteSlots) {
+			r = remoteSlots[i]
+		}
+		if reflect.DeepEqual(l, r) {
+			continue
+		}
+
+		slot := SlotDiff{Slot: i, Fields: differingFields(l, r)}
+		slot.Local, _ = json.Marshal(l)
+		slot.Remote, _ = json.Marshal(r)
+		diff.Slots = append(diff.Slots, slot)
+	}
+
+	localCounts := &EconomySnapshot{Items: make(map[string]map[string]int)}
+	localCounts.add(local)
+	remoteCounts := &EconomySnapshot{Items: make(map[string]map[string]int)}
+	remoteCounts.add(remote)
+
+	for _, key := range economyKeys(localCounts, remoteCounts) {
+		l, r := localCounts.Items[key.server][key.typeID], remoteCounts.Items[key.server][key.typeID]
+		if l != r {
+			diff.Counts = append(diff.Counts, ItemCountDiff{Server: key.server, TypeID: key.typeID, Local: l, Remote: r})
+		}
+	}
+
+	return diff, nil
+}
+
+// differingFields lists the fields that differ between two items, nil unless both slots hold one
+func differingFields(local, remote any) []string {
+	l, lok := local.(map[string]any)
+	r, rok := remote.(map[string]any)
+	if !lok || !rok {
+		return nil
+	}
+
+	var fields []string
+	for key, value := range l {
+		if !reflect.DeepEqual(value, r[key]) {
+			fields = append(fields, key)
+		}
+	}
+	for key := range r {
+		if _, ok := l[key]; !ok {
+			fields = append(fields, key)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+type economyKey struct {
+	server, typeID string
+}
+
+// economyKeys returns every origin and item type counted in either snapshot, sorted
+func economyKeys(snapshots ...*EconomySnapshot) []economyKey {
+	seen := make(map[economyKey]bool)
+	var keys []economyKey
+	for _, snapshot := range snapshots {
+		for server, items := range snapshot.Items {
+			for typeID := range items {
+				key := economyKey{server, typeID}
+				if !seen[key] {
+					seen[key] = true
+					keys = append(keys, key)
+				}
+			}
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].server != keys[j].server {
+			return keys[i].server < keys[j].server
+		}
+		return keys[i].typeID < keys[j].typeID
+	})
+	return keys
+}
+
+// LatestRecordEntry returns the newest entry of a raw player record, as sent by peers
+func LatestRecordEntry(record []byte) (InventoryEntry, error) {
+	var inventories PlayerInventories
+	if err := json.Unmarshal(record, &inventories); err != nil {
+		return InventoryEntry{}, fmt.Errorf("invalid player record: %w", err)
+	}
+	if len(inventories.Entries) == 0 {
+		return InventoryEntry{}, ErrPlayerNotFound
+	}
+
+	sortHistory(inventories.Entries)
+	return inventories.Entries[0], nil
+}
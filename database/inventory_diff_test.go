@@ -0,0 +1,71 @@
+package database
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffInventories(t *testing.T) {
+	local := []byte(`[{"typeId":"minecraft:diamond","amount":3,"lore":["Origin: a.example.com"]},null,
+		{"typeId":"minecraft:shulker_box","amount":1,"shulkerContents":[{"typeId":"minecraft:apple","amount":2}]}]`)
+
+	t.Run("same items", func(t *testing.T) {
+		// Keys in another order are the same item
+		remote := []byte(`[{"lore":["Origin: a.example.com"],"amount":3,"typeId":"minecraft:diamond"},null,
+			{"typeId":"minecraft:shulker_box","amount":1,"shulkerContents":[{"typeId":"minecraft:apple","amount":2}]}]`)
+
+		diff, err := DiffInventories(local, remote)
+		require.NoError(t, err)
+		assert.True(t, diff.Empty())
+	})
+
+	t.Run("discrepancies", func(t *testing.T) {
+		remote := []byte(`[{"typeId":"minecraft:diamond","amount":5,"lore":["Origin: a.example.com"]},null,
+			{"typeId":"minecraft:shulker_box","amount":1,"shulkerContents":[]},{"typeId":"minecraft:bread","amount":1}]`)
+
+		diff, err := DiffInventories(local, remote)
+		require.NoError(t, err)
+		assert.False(t, diff.Empty())
+
+		require.Len(t, diff.Slots, 3)
+		assert.Equal(t, 0, diff.Slots[0].Slot)
+		assert.Equal(t, []string{"amount"}, diff.Slots[0].Fields)
+		assert.Equal(t, []string{"shulkerContents"}, diff.Slots[1].Fields)
+		assert.Equal(t, 3, diff.Slots[2].Slot)
+		assert.JSONEq(t, `null`, string(diff.Slots[2].Local))
+		assert.Nil(t, diff.Slots[2].Fields)
+
+		assert.Equal(t, []ItemCountDiff{
+			{Server: "a.example.com", TypeID: "minecraft:diamond", Local: 3, Remote: 5},
+			{Server: UnknownOrigin, TypeID: "minecraft:apple", Local: 2, Remote: 0},
+			{Server: UnknownOrigin, TypeID: "minecraft:bread", Local: 0, Remote: 1},
+		}, diff.Counts)
+	})
+
+	t.Run("invalid inventory", func(t *testing.T) {
+		_, err := DiffInventories(local, []byte(`{}`))
+		assert.Error(t, err)
+	})
+}
+
+func TestLatestRecordEntry(t *testing.T) {
+	now := time.Now()
+	record, err := json.Marshal(PlayerInventories{Entries: []InventoryEntry{
+		{Inventory: []byte(`[]`), Server: "a.example.com", Timestamp: now.Add(-time.Minute)},
+		{Inventory: []byte(`[null]`), Server: "b.example.com", Timestamp: now},
+	}})
+	require.NoError(t, err)
+
+	entry, err := LatestRecordEntry(record)
+	require.NoError(t, err)
+	assert.Equal(t, "b.example.com", entry.Server)
+
+	_, err = LatestRecordEntry([]byte(`{"entries":[]}`))
+	assert.ErrorIs(t, err, ErrPlayerNotFound)
+	_, err = LatestRecordEntry([]byte(`nope`))
+	assert.Error(t, err)
+}
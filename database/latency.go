@@ -0,0 +1,128 @@
+package database
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// latencyBuckets are the upper bounds of the latency histogram buckets, slower calls land in the last bucket
+var latencyBuckets = []time.Duration{
+	time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	5 * time.Second,
+}
+
+// LatencyBucket counts the calls that took at most LeMillis
+type LatencyBucket struct {
+	LeMillis float64 `json:"le_ms"`
+	Count    int     `json:"count"` // Cumulative, as in a Prometheus histogram
+}
+
+// LatencyStats is the latency histogram of one database operation
+type LatencyStats struct {
+	Operation string          `json:"operation"`
+	Count     int             `json:"count"` // Every call, including those slower than the last bucket
+	SumMillis float64         `json:"sum_ms"`
+	MaxMillis float64         `json:"max_ms"`
+	Slow      int             `json:"slow"` // Calls slower than the slow threshold
+	Buckets   []LatencyBucket `json:"buckets"`
+}
+
+// operationLatency holds the counters of one operation
+type operationLatency struct {
+	counts []int // Per bucket, plus one for calls slower than the last bound
+	count  int
+	sum    time.Duration
+	max    time.Duration
+	slow   int
+}
+
+// latencyStats holds per operation histograms, updated on every instrumented call
+type latencyStats struct {
+	mu         sync.Mutex
+	threshold  time.Duration
+	operations map[string]*operationLatency
+}
+
+// observe records a call that started at start, logging it when it exceeds the slow threshold
+func (s *latencyStats) observe(operation, player string, start time.Time) {
+	elapsed := time.Since(start)
+
+	s.mu.Lock()
+	if s.operations == nil {
+		s.operations = make(map[string]*operationLatency)
+	}
+	op, ok := s.operations[operation]
+	if !ok {
+		op = &operationLatency{counts: make([]int, len(latencyBuckets)+1)}
+		s.operations[operation] = op
+	}
+
+	op.counts[sort.Search(len(latencyBuckets), func(i int) bool { return elapsed <= latencyBuckets[i] })]++
+	op.count++
+	op.sum += elapsed
+	op.max = max(op.max, elapsed)
+	slow := s.threshold > 0 && elapsed > s.threshold
+	if slow {
+		op.slow++
+	}
+	s.mu.Unlock()
+
+	if !slow {
+		return
+	}
+	if player != "" {
+		logger.Warnf("Slow database %s of %s took %s", operation, player, elapsed.Round(time.Millisecond))
+	} else {
+		logger.Warnf("Slow database %s took %s", operation, elapsed.Round(time.Millisecond))
+	}
+}
+
+// SetSlowThreshold logs Put, Get, Delete and StreamAll calls slower than threshold, zero disables the log
+func (db *DB) SetSlowThreshold(threshold time.Duration) {
+	db.latency.mu.Lock()
+	defer db.latency.mu.Unlock()
+	db.latency.threshold = threshold
+}
+
+// LatencyStats returns the latency histograms of the instrumented operations, sorted by operation
+func (db *DB) LatencyStats() []LatencyStats {
+	db.latency.mu.Lock()
+	defer db.latency.mu.Unlock()
+
+	stats := make([]LatencyStats, 0, len(db.latency.operations))
+	for name, op := range db.latency.operations {
+		s := LatencyStats{
+			Operation: name,
+			Count:     op.count,
+			SumMillis: millis(op.sum),
+			MaxMillis: millis(op.max),
+			Slow:      op.slow,
+			Buckets:   make([]LatencyBucket, 0, len(op.counts)),
+		}
+
+		cumulative := 0
+		for i, count := range op.counts[:len(latencyBuckets)] {
+			cumulative += count
+			s.Buckets = append(s.Buckets, LatencyBucket{LeMillis: millis(latencyBuckets[i]), Count: cumulative})
+		}
+		stats = append(stats, s)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Operation < stats[j].Operation
+	})
+	return stats
+}
+
+// millis converts a duration to fractional milliseconds
+func millis(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -0,0 +1,54 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatencyStats(t *testing.T) {
+	db := &DB{}
+	db.SetSlowThreshold(20 * time.Millisecond)
+
+	now := time.Now()
+	db.latency.observe("get", "alice", now.Add(-500*time.Microsecond))
+	db.latency.observe("get", "alice", now.Add(-30*time.Millisecond))
+	db.latency.observe("get", "", now.Add(-10*time.Second))
+
+	latency := db.LatencyStats()
+	require.Len(t, latency, 1)
+
+	get := latency[0]
+	assert.Equal(t, "get", get.Operation)
+	assert.Equal(t, 3, get.Count)
+	assert.Equal(t, 2, get.Slow)
+	assert.GreaterOrEqual(t, get.MaxMillis, 10000.0)
+	require.Len(t, get.Buckets, len(latencyBuckets))
+	assert.Equal(t, LatencyBucket{LeMillis: 1, Count: 1}, get.Buckets[0])
+	assert.Equal(t, 2, get.Buckets[3].Count) // 50ms
+	assert.Equal(t, 2, get.Buckets[len(get.Buckets)-1].Count)
+}
+
+func TestDB_LatencyStats(t *testing.T) {
+	db, err := NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+	db.SetSlowThreshold(time.Nanosecond)
+
+	require.NoError(t, db.Put("alice", []byte(`[]`), "a.example.com"))
+	_, err = db.Get("alice")
+	require.NoError(t, err)
+	require.NoError(t, db.Delete("a.example.com", false))
+	for range db.StreamAll() {
+	}
+
+	var operations []string
+	for _, stats := range db.LatencyStats() {
+		operations = append(operations, stats.Operation)
+		assert.Equal(t, 1, stats.Count, stats.Operation)
+		assert.Equal(t, 1, stats.Slow, stats.Operation)
+	}
+	assert.Equal(t, []string{"delete", "get", "put", "stream_all"}, operations)
+}
@@ -0,0 +1,155 @@
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// defaultNamespace is assumed for type ids written without a namespace
+const defaultNamespace = "minecraft"
+
+// NamespaceRule restricts items to an allow-list of namespaces, so a single modded
+// server cannot flood a vanilla-only network with unknown items
+type NamespaceRule struct {
+	allowed map[string]bool
+}
+
+// NewNamespaceRule creates a rule allowing the given namespaces, written as "minecraft" or "minecraft:"
+func NewNamespaceRule(namespaces []string) (*NamespaceRule, error) {
+	allowed := make(map[string]bool, len(namespaces))
+	for _, namespace := range namespaces {
+		namespace = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(namespace), ":"))
+		if namespace == "" || strings.Contains(namespace, ":") {
+			return nil, fmt.Errorf("invalid item namespace %q", namespace)
+		}
+		allowed[namespace] = true
+	}
+
+	if len(allowed) == 0 {
+		return nil, fmt.Errorf("at least one item namespace must be allowed")
+	}
+
+	return &NamespaceRule{allowed: allowed}, nil
+}
+
+// Name implements ValidatorRule
+func (r *NamespaceRule) Name() string {
+	return "item_namespace"
+}
+
+// Namespaces returns the allowed namespaces in sorted order
+func (r *NamespaceRule) Namespaces() []string {
+	namespaces := make([]string, 0, len(r.allowed))
+	for namespace := range r.allowed {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
+
+// Allowed reports whether an item type id belongs to an allowed namespace
+func (r *NamespaceRule) Allowed(typeID string) bool {
+	namespace, _, found := strings.Cut(typeID, ":")
+	if !found {
+		namespace = defaultNamespace
+	}
+	return r.allowed[strings.ToLower(namespace)]
+}
+
+// Apply implements ValidatorRule
+func (r *NamespaceRule) Apply(item *Item, ctx RuleContext) []ValidationError {
+	if item.TypeID == "" || r.Allowed(item.TypeID) {
+		return nil
+	}
+
+	return []ValidationError{{
+		ItemIndex: ctx.ItemIndex,
+		Message:   fmt.Sprintf("Item %s is not from an allowed namespace", item.TypeID),
+	}}
+}
+
+// Strip removes items from disallowed namespaces, emptying top level slots and dropping
+// them from shulker contents, and returns the inventory with the number of removed items
+// Inventories that are not valid JSON are returned unchanged
+func (r *NamespaceRule) Strip(inventory []byte) ([]byte, int) {
+	var slots []any
+	if err := json.Unmarshal(inventory, &slots); err != nil {
+		return inventory, 0
+	}
+
+	removed := 0
+	for i, slot := range slots {
+		if !r.allowedSlot(slot) {
+			slots[i] = nil
+			removed++
+			continue
+		}
+		removed += r.stripShulker(slot)
+	}
+
+	if removed == 0 {
+		return inventory, 0
+	}
+
+	stripped, err := json.Marshal(slots)
+	if err != nil {
+		return inventory, 0
+	}
+	return stripped, removed
+}
+
+// allowedSlot reports whether a decoded slot is empty or holds an allowed item
+func (r *NamespaceRule) allowedSlot(slot any) bool {
+	item, ok := slot.(map[string]any)
+	if !ok {
+		return true
+	}
+	typeID, _ := item["typeId"].(string)
+	return typeID == "" || r.Allowed(typeID)
+}
+
+// stripShulker drops disallowed items from a decoded shulker box, recursively
+func (r *NamespaceRule) stripShulker(slot any) int {
+	item, ok := slot.(map[string]any)
+	if !ok {
+		return 0
+	}
+	contents, ok := item["shulkerContents"].([]any)
+	if !ok {
+		return 0
+	}
+
+	removed := 0
+	kept := contents[:0]
+	for _, content := range contents {
+		if !r.allowedSlot(content) {
+			removed++
+			continue
+		}
+		removed += r.stripShulker(content)
+		kept = append(kept, content)
+	}
+	item["shulkerContents"] = kept
+
+	return removed
+}
+
+// Filter returns a database filter that strips disallowed items, or rejects the whole
+// inventory when strip is false
+func (r *NamespaceRule) Filter(strip bool) InventoryFilter {
+	return func(inventory []byte, server string) ([]byte, error) {
+		stripped, removed := r.Strip(inventory)
+		if removed == 0 {
+			return inventory, nil
+		}
+		if !strip {
+			return nil, &RejectionError{
+				Reason:  r.Name(),
+				Message: fmt.Sprintf("%d items outside the allowed namespaces", removed),
+			}
+		}
+		return stripped, nil
+	}
+}
@@ -0,0 +1,139 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNamespaceRule(t *testing.T) {
+	rule, err := NewNamespaceRule([]string{"minecraft:", " MyMod "})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"minecraft", "mymod"}, rule.Namespaces())
+
+	_, err = NewNamespaceRule(nil)
+	assert.Error(t, err)
+
+	_, err = NewNamespaceRule([]string{"minecraft:diamond"})
+	assert.Error(t, err)
+}
+
+func TestNamespaceRule_Allowed(t *testing.T) {
+	rule, err := NewNamespaceRule([]string{"minecraft"})
+	require.NoError(t, err)
+
+	assert.True(t, rule.Allowed("minecraft:diamond"))
+	assert.True(t, rule.Allowed("Minecraft:Diamond"))
+	assert.True(t, rule.Allowed("diamond"))
+	assert.False(t, rule.Allowed("mymod:ruby"))
+}
+
+func TestNamespaceRule_Validator(t *testing.T) {
+	rule, err := NewNamespaceRule([]string{"minecraft"})
+	require.NoError(t, err)
+
+	validator := NewItemValidator()
+	require.NoError(t, validator.RegisterRule(rule))
+
+	inventory := []byte(`[
+		{"typeId":"minecraft:diamond","amount":1},
+		{"typeId":"mymod:ruby","amount":1},
+		{"typeId":"minecraft:shulker_box","amount":1,"shulkerContents":[{"typeId":"mymod:sapphire","amount":1}]}
+	]`)
+
+	var namespaceErrors []ValidationError
+	for _, e := range validator.ValidateInventory(inventory, "server", "alice") {
+		if e.ErrorType == "item_namespace" {
+			namespaceErrors = append(namespaceErrors, e)
+		}
+	}
+
+	require.Len(t, namespaceErrors, 2)
+	assert.Equal(t, 1, namespaceErrors[0].ItemIndex)
+	assert.Equal(t, 2, namespaceErrors[1].ItemIndex)
+}
+
+func TestNamespaceRule_Strip(t *testing.T) {
+	rule, err := NewNamespaceRule([]string{"minecraft"})
+	require.NoError(t, err)
+
+	t.Run("strips top level and shulker items", func(t *testing.T) {
+		inventory := []byte(`[{"typeId":"mymod:ruby","amount":1},null,{"typeId":"minecraft:shulker_box","amount":1,"shulkerContents":[{"typeId":"mymod:sapphire","amount":2},{"typeId":"minecraft:dirt","amount":3}]}]`)
+
+		stripped, removed := rule.Strip(inventory)
+		assert.Equal(t, 2, removed)
+		assert.JSONEq(t, `[null,null,{"typeId":"minecraft:shulker_box","amount":1,"shulkerContents":[{"typeId":"minecraft:dirt","amount":3}]}]`, string(stripped))
+	})
+
+	t.Run("allowed inventory is unchanged", func(t *testing.T) {
+		inventory := []byte(`[{"typeId":"minecraft:dirt","amount":3}]`)
+
+		stripped, removed := rule.Strip(inventory)
+		assert.Zero(t, removed)
+		assert.Equal(t, inventory, stripped)
+	})
+
+	t.Run("invalid json is unchanged", func(t *testing.T) {
+		stripped, removed := rule.Strip([]byte("not json"))
+		assert.Zero(t, removed)
+		assert.Equal(t, []byte("not json"), stripped)
+	})
+}
+
+func TestNamespaceRule_Filter(t *testing.T) {
+	rule, err := NewNamespaceRule([]string{"minecraft"})
+	require.NoError(t, err)
+
+	modded := []byte(`[{"typeId":"mymod:ruby","amount":1},{"typeId":"minecraft:dirt","amount":3}]`)
+
+	t.Run("strip", func(t *testing.T) {
+		db, err := NewMemory()
+		require.NoError(t, err)
+		defer db.Close()
+		db.SetFilter(rule.Filter(true))
+		var notified []string
+		db.OnFiltered(func(player, server string) {
+			notified = append(notified, player+"@"+server)
+		})
+
+		require.NoError(t, db.Put("alice", modded, "modded.example.com"))
+		require.NoError(t, db.Put("bob", []byte(`[{"typeId":"minecraft:dirt","amount":1}]`), "modded.example.com"))
+
+		inventory, err := db.Get("alice")
+		require.NoError(t, err)
+		assert.JSONEq(t, `[null,{"typeId":"minecraft:dirt","amount":3}]`, string(inventory))
+		assert.Equal(t, []string{"alice@modded.example.com"}, notified)
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		db, err := NewMemory()
+		require.NoError(t, err)
+		defer db.Close()
+		db.SetFilter(rule.Filter(false))
+
+		err = db.Put("alice", modded, "modded.example.com")
+		assert.ErrorIs(t, err, ErrInventoryRejected)
+
+		_, err = db.Get("alice")
+		assert.ErrorIs(t, err, ErrPlayerNotFound)
+	})
+
+	t.Run("merge skips rejected entries", func(t *testing.T) {
+		source, err := NewMemory()
+		require.NoError(t, err)
+		defer source.Close()
+		require.NoError(t, source.Put("alice", modded, "modded.example.com"))
+
+		db, err := NewMemory()
+		require.NoError(t, err)
+		defer db.Close()
+		db.SetFilter(rule.Filter(false))
+
+		for entry := range source.StreamAll() {
+			merged, err := db.Merge(entry.Key, entry.Value)
+			require.NoError(t, err)
+			assert.False(t, merged)
+		}
+	})
+}
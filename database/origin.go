@@ -0,0 +1,157 @@
+package database
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync/atomic"
+)
+
+// OriginPlaceholder marks where the server name goes in an origin format
+const OriginPlaceholder = "<server>"
+
+// DefaultOriginFormat is the origin lore line stamped on items when no custom format is configured
+const DefaultOriginFormat = "Origin: " + OriginPlaceholder
+
+// OriginFormat renders and parses back origin lore lines such as "⚒ Forged on <server>"
+//
+// The grammar is strict: the literal text around the placeholder must match exactly,
+// except that any run of whitespace matches any run of whitespace, and the server name
+// must be non-empty without leading or trailing whitespace
+type OriginFormat struct {
+	format  string
+	pattern *regexp.Regexp
+
+	// Literal text around the placeholder, lines written exactly as Format does skip the regexp
+	prefix, suffix string
+}
+
+var originFormat atomic.Pointer[OriginFormat]
+
+func init() {
+	originFormat.Store(MustOriginFormat(DefaultOriginFormat))
+}
+
+// NewOriginFormat compiles an origin format containing exactly one <server> placeholder
+func NewOriginFormat(format string) (*OriginFormat, error) {
+	if strings.ContainsAny(format, "\r\n") {
+		return nil, fmt.Errorf("origin format must be a single line")
+	}
+
+	if count := strings.Count(format, OriginPlaceholder); count != 1 {
+		return nil, fmt.Errorf("origin format must contain %s exactly once, got %d", OriginPlaceholder, count)
+	}
+
+	prefix, suffix, _ := strings.Cut(format, OriginPlaceholder)
+	if strings.TrimSpace(prefix) == "" && strings.TrimSpace(suffix) == "" {
+		return nil, fmt.Errorf("origin format needs literal text around %s", OriginPlaceholder)
+	}
+
+	pattern := "^" + literalPattern(prefix) + `(\S(?:.*\S)?)` + literalPattern(suffix) + "$"
+
+	return &OriginFormat{
+		format:  format,
+		pattern: regexp.MustCompile(pattern),
+		prefix:  prefix,
+		suffix:  suffix,
+	}, nil
+}
+
+// MustOriginFormat is like NewOriginFormat but panics on an invalid format
+func MustOriginFormat(format string) *OriginFormat {
+	f, err := NewOriginFormat(format)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+var whitespaceRun = regexp.MustCompile(`\s+`)
+
+// literalPattern quotes text for a regexp, letting whitespace runs match any whitespace
+func literalPattern(text string) string {
+	parts := whitespaceRun.Split(text, -1)
+	for i := range parts {
+		parts[i] = regexp.QuoteMeta(parts[i])
+	}
+	return strings.Join(parts, `\s+`)
+}
+
+// SetOriginFormat replaces the origin format used by the validator, cleaner and adder
+func SetOriginFormat(f *OriginFormat) {
+	originFormat.Store(f)
+}
+
+// CurrentOriginFormat returns the origin format in use
+func CurrentOriginFormat() *OriginFormat {
+	return originFormat.Load()
+}
+
+// String returns the format template
+func (f *OriginFormat) String() string {
+	return f.format
+}
+
+// Format renders the origin lore line for a server
+func (f *OriginFormat) Format(server string) string {
+	return strings.Replace(f.format, OriginPlaceholder, server, 1)
+}
+
+// Parse returns the server named by an origin lore line
+func (f *OriginFormat) Parse(line string) (string, bool) {
+	if server, ok := f.parseExact(line); ok {
+		return server, true
+	}
+
+	matches := f.pattern.FindStringSubmatch(line)
+	if len(matches) != 2 {
+		return "", false
+	}
+	return matches[1], true
+}
+
+// parseExact matches lines using the literal text of the format, the common case validated
+// for every item, anything else such as differing whitespace is left to the regexp
+func (f *OriginFormat) parseExact(line string) (string, bool) {
+	if len(line) <= len(f.prefix)+len(f.suffix) || !strings.HasPrefix(line, f.prefix) || !strings.HasSuffix(line, f.suffix) {
+		return "", false
+	}
+
+	server := line[len(f.prefix) : len(line)-len(f.suffix)]
+	if strings.TrimSpace(server) != server || strings.ContainsAny(server, "\r\n") {
+		return "", false
+	}
+	return server, true
+}
+
+// Origin returns the server from the first origin line in the lore, without the timestamp of legacy lines
+func (f *OriginFormat) Origin(lore []string) (string, bool) {
+	for _, line := range lore {
+		if server, ok := f.Parse(line); ok {
+			if legacy := legacyOriginTimestamp.FindStringSubmatch(server); legacy != nil {
+				return legacy[1], true
+			}
+			return server, true
+		}
+	}
+	return "", false
+}
+
+// legacyOriginTimestamp matches the server of origin lines written by older builds, which appended
+// the time the item was stamped such as "Origin: server1 (2024-05-01 12:00:00)" or "Origin: server1 @ 1714564800"
+var legacyOriginTimestamp = regexp.MustCompile(`^(\S(?:.*?\S)?)(?:\s+[-@|]\s*|\s*,\s*|\s*\(\s*|\s+)` +
+	`(?:\d{4}-\d{2}-\d{2}(?:[T ]\d{2}:\d{2}(?::\d{2}(?:\.\d+)?)?)?(?:Z|[+-]\d{2}:?\d{2})?|\d{13}|\d{10})\s*\)?$`)
+
+// Normalize rewrites a legacy origin line with a timestamp to the canonical line of its server,
+// reporting whether the line was legacy
+func (f *OriginFormat) Normalize(line string) (string, bool) {
+	server, ok := f.Parse(line)
+	if !ok {
+		return line, false
+	}
+	legacy := legacyOriginTimestamp.FindStringSubmatch(server)
+	if legacy == nil {
+		return line, false
+	}
+	return f.Format(legacy[1]), true
+}
@@ -0,0 +1,135 @@
+package database
+
+import (
+	"encoding/json"
+	"regexp"
+
+	"github.com/d1nch8g/consensuscraft/logger"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// legacyOriginHint is found in every inventory holding a legacy origin line, inventories
+// without it are not decoded
+var legacyOriginHint = regexp.MustCompile(`\d{4}-\d{2}-\d{2}|\d{10}`)
+
+// OriginMigrationReport counts the legacy origin lines rewritten by MigrateOriginLore
+type OriginMigrationReport struct {
+	Records int `json:"records"` // Records scanned
+	Entries int `json:"entries"` // Entries rewritten
+	Lines   int `json:"lines"`
+}
+
+// normalizeOriginLore rewrites the legacy origin lines of an inventory to the current format,
+// including shulker contents, and returns the inventory with the number of lines rewritten
+// Inventories that are not valid JSON are returned unchanged
+func normalizeOriginLore(inventory []byte) ([]byte, int) {
+	if !legacyOriginHint.Match(inventory) {
+		return inventory, 0
+	}
+
+	var slots []any
+	if err := json.Unmarshal(inventory, &slots); err != nil {
+		return inventory, 0
+	}
+
+	format := CurrentOriginFormat()
+	var normalize func(slots []any) int
+	normalize = func(slots []any) int {
+		rewritten := 0
+		for _, slot := range slots {
+			item, ok := slot.(map[string]any)
+			if !ok {
+				continue
+			}
+			if lore, ok := item["lore"].([]any); ok {
+				for i, line := range lore {
+					text, ok := line.(string)
+					if !ok {
+						continue
+					}
+					if canonical, legacy := format.Normalize(text); legacy {
+						lore[i] = canonical
+						rewritten++
+					}
+				}
+			}
+			if contents, ok := item["shulkerContents"].([]any); ok {
+				rewritten += normalize(contents)
+			}
+		}
+		return rewritten
+	}
+
+	rewritten := normalize(slots)
+	if rewritten == 0 {
+		return inventory, 0
+	}
+
+	normalized, err := json.Marshal(slots)
+	if err != nil {
+		return inventory, 0
+	}
+	return normalized, rewritten
+}
+
+// MigrateOriginLore rewrites the legacy origin lines of every stored entry to the current format,
+// so items stamped by older builds match deletions by origin like any other
+// Entries stored afterwards are normalized as they arrive, the pass only needs to run once
+func (db *DB) MigrateOriginLore() (*OriginMigrationReport, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if db.closed {
+		return nil, ErrClosed
+	}
+
+	report := &OriginMigrationReport{}
+	iter := db.leveldb.NewIterator(util.BytesPrefix(nil), nil)
+	defer iter.Release()
+
+	for iter.Next() {
+		var record PlayerInventories
+		if err := json.Unmarshal(iter.Value(), &record); err != nil {
+			continue // Skip corrupted entries
+		}
+		report.Records++
+
+		player := string(iter.Key())
+		modified := false
+		for i, entry := range record.Entries {
+			normalized, lines := normalizeOriginLore(entry.Inventory)
+			if lines == 0 {
+				continue
+			}
+			record.Entries[i].Inventory = normalized
+			report.Entries++
+			report.Lines += lines
+			modified = true
+		}
+		if !modified {
+			continue
+		}
+
+		data, err := json.Marshal(record)
+		if err != nil {
+			return nil, err
+		}
+		if err := db.leveldb.Put(iter.Key(), data, nil); err != nil {
+			return nil, err
+		}
+		if fence, ok := db.fences.get(player); ok {
+			if normalized, lines := normalizeOriginLore(fence.Inventory); lines > 0 {
+				db.fences.update(player, normalized)
+			}
+		}
+	}
+
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+
+	if report.Lines > 0 {
+		logger.Infof("Audit: rewrote %d legacy origin lines in %d inventory entries to %q", report.Lines, report.Entries, CurrentOriginFormat())
+	}
+	return report, nil
+}
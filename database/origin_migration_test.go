@@ -0,0 +1,55 @@
+package database
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeOriginLore(t *testing.T) {
+	inventory := []byte(`[{"typeId":"minecraft:shulker_box","amount":1,"lore":["Origin: server1 (2024-05-01 12:00:00)"],` +
+		`"shulkerContents":[{"typeId":"minecraft:diamond","amount":2,"lore":["Origin: server2 @ 1714564800","Found 2024-05-01"]}]},null]`)
+
+	normalized, lines := normalizeOriginLore(inventory)
+	assert.Equal(t, 2, lines)
+	assert.JSONEq(t, `[{"typeId":"minecraft:shulker_box","amount":1,"lore":["Origin: server1"],`+
+		`"shulkerContents":[{"typeId":"minecraft:diamond","amount":2,"lore":["Origin: server2","Found 2024-05-01"]}]},null]`, string(normalized))
+
+	current := []byte(`[{"typeId":"minecraft:diamond","amount":2,"lore":["Origin: server1"]}]`)
+	normalized, lines = normalizeOriginLore(current)
+	assert.Zero(t, lines)
+	assert.Equal(t, current, normalized)
+}
+
+func TestDB_MigrateOriginLore(t *testing.T) {
+	db, err := NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+
+	legacy := []byte(`[{"typeId":"minecraft:diamond","amount":2,"lore":["Origin: server1 (2024-05-01 12:00:00)"]}]`)
+	record, err := json.Marshal(PlayerInventories{Entries: []InventoryEntry{{Inventory: legacy, Server: "server1", Timestamp: time.Now()}}})
+	require.NoError(t, err)
+	require.NoError(t, db.leveldb.Put([]byte("alice"), record, nil))
+	require.NoError(t, db.Put("bob", []byte(`[{"typeId":"minecraft:apple","amount":1,"lore":["Origin: server2"]}]`), "server2"))
+
+	report, err := db.MigrateOriginLore()
+	require.NoError(t, err)
+	assert.Equal(t, &OriginMigrationReport{Records: 2, Entries: 1, Lines: 1}, report)
+
+	inventory, err := db.Get("alice")
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"typeId":"minecraft:diamond","amount":2,"lore":["Origin: server1"]}]`, string(inventory))
+
+	// Entries stored later are normalized as they arrive
+	require.NoError(t, db.Put("carol", legacy, "server1"))
+	inventory, err = db.Get("carol")
+	require.NoError(t, err)
+	assert.NotContains(t, string(inventory), "2024-05-01")
+
+	report, err = db.MigrateOriginLore()
+	require.NoError(t, err)
+	assert.Zero(t, report.Lines)
+}
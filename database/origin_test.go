@@ -0,0 +1,133 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOriginFormat_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		errText string
+	}{
+		{name: "no placeholder", format: "Origin:", errText: "exactly once"},
+		{name: "two placeholders", format: "<server> from <server>", errText: "exactly once"},
+		{name: "placeholder only", format: " <server> ", errText: "literal text"},
+		{name: "multiline", format: "Origin:\n<server>", errText: "single line"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, err := NewOriginFormat(tt.format)
+			assert.Nil(t, format)
+			assert.ErrorContains(t, err, tt.errText)
+		})
+	}
+}
+
+func TestOriginFormat_ParseBack(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		line   string
+		server string
+		ok     bool
+	}{
+		{name: "default", format: DefaultOriginFormat, line: "Origin: server1", server: "server1", ok: true},
+		{name: "default extra whitespace", format: DefaultOriginFormat, line: "Origin:   server1", server: "server1", ok: true},
+		{name: "default missing whitespace", format: DefaultOriginFormat, line: "Origin:server1", ok: false},
+		{name: "default trailing whitespace", format: DefaultOriginFormat, line: "Origin: server1 ", ok: false},
+		{name: "default other lore", format: DefaultOriginFormat, line: "Legendary blade", ok: false},
+		{name: "branded", format: "⚒ Forged on <server>", line: "⚒ Forged on mc.example.com", server: "mc.example.com", ok: true},
+		{name: "branded with suffix", format: "[<server>] (origin)", line: "[play.example.com] (origin)", server: "play.example.com", ok: true},
+		{name: "branded suffix mismatch", format: "[<server>] (origin)", line: "[play.example.com] origin", ok: false},
+		{name: "regexp characters are literal", format: "Made.in <server>", line: "MadeXin server1", ok: false},
+		{name: "tab instead of space", format: DefaultOriginFormat, line: "Origin:\tserver1", server: "server1", ok: true},
+		{name: "suffix repeated", format: "[<server>] (origin)", line: "[a] (origin)] (origin)", server: "a] (origin)", ok: true},
+		{name: "leading non-breaking space", format: DefaultOriginFormat, line: "Origin: \u00a0server1", server: "\u00a0server1", ok: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, err := NewOriginFormat(tt.format)
+			require.NoError(t, err)
+
+			server, ok := format.Parse(tt.line)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.server, server)
+
+			// The literal fast path agrees with the regexp
+			matches := format.pattern.FindStringSubmatch(tt.line)
+			assert.Equal(t, tt.ok, len(matches) == 2)
+			if len(matches) == 2 {
+				assert.Equal(t, matches[1], server)
+			}
+
+			if tt.ok {
+				parsed, ok := format.Parse(format.Format(tt.server))
+				assert.True(t, ok)
+				assert.Equal(t, tt.server, parsed)
+			}
+		})
+	}
+}
+
+func TestSetOriginFormat(t *testing.T) {
+	defer SetOriginFormat(MustOriginFormat(DefaultOriginFormat))
+	SetOriginFormat(MustOriginFormat("⚒ Forged on <server>"))
+
+	validator := NewItemValidator()
+
+	item := &Item{TypeID: "minecraft:diamond", Amount: 1}
+	assert.True(t, validator.AddOriginToItem(item, "server1"))
+	assert.Equal(t, []string{"⚒ Forged on server1"}, item.Lore)
+	assert.False(t, validator.AddOriginToItem(item, "server2"))
+
+	assert.True(t, validator.HasOriginFromServer(item, "server1"))
+	assert.Empty(t, validator.validateOrigin(item.Lore, "server1", 0))
+
+	legacy := &Item{TypeID: "minecraft:diamond", Amount: 1, Lore: []string{"Origin: server1"}}
+	assert.False(t, validator.HasOriginFromServer(legacy, "server1"))
+	errors := validator.validateOrigin(legacy.Lore, "server1", 0)
+	require.Len(t, errors, 1)
+	assert.Equal(t, "missing_origin", errors[0].ErrorType)
+}
+
+func TestOriginFormat_Normalize(t *testing.T) {
+	format := MustOriginFormat(DefaultOriginFormat)
+
+	tests := []struct {
+		line   string
+		want   string
+		legacy bool
+	}{
+		{line: "Origin: server1 (2024-05-01 12:00:00)", want: "Origin: server1", legacy: true},
+		{line: "Origin: server1 @ 2024-05-01T12:00:00Z", want: "Origin: server1", legacy: true},
+		{line: "Origin: a.example.com - 2024-05-01", want: "Origin: a.example.com", legacy: true},
+		{line: "Origin: server1, 2024-05-01T12:00:00.123+02:00", want: "Origin: server1", legacy: true},
+		{line: "Origin: server1 | 1714564800", want: "Origin: server1", legacy: true},
+		{line: "Origin: server1 1714564800123", want: "Origin: server1", legacy: true},
+		{line: "Origin: server1", want: "Origin: server1"},
+		{line: "Origin: node-1714564800", want: "Origin: node-1714564800"},
+		{line: "Origin: server 2024", want: "Origin: server 2024"},
+		{line: "Forged by Arthur 2024-05-01", want: "Forged by Arthur 2024-05-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			got, legacy := format.Normalize(tt.line)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.legacy, legacy)
+		})
+	}
+
+	origin, ok := format.Origin([]string{"Forged by Arthur", "Origin: server1 (2024-05-01 12:00:00)"})
+	assert.True(t, ok)
+	assert.Equal(t, "server1", origin)
+
+	legacy := &Item{TypeID: "minecraft:diamond", Amount: 1, Lore: []string{"Origin: server1 @ 1714564800"}}
+	assert.True(t, legacy.hasOriginFromServer("server1"))
+}
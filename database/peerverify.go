@@ -0,0 +1,319 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/logger"
+	"github.com/d1nch8g/consensuscraft/tracing"
+)
+
+// peerSkippedErrors are validation errors that do not refuse peer entries: origins are checked
+// against the uptime and namespaces are enforced by the namespace filter
+var peerSkippedErrors = map[string]bool{
+	"missing_origin": true,
+	"wrong_origin":   true,
+	"item_namespace": true,
+}
+
+// InvalidItemPolicy is what the peer verifier does with entries holding invalid items
+type InvalidItemPolicy string
+
+const (
+	InvalidReject     InvalidItemPolicy = "reject"     // The whole entry is refused, peers keep sending it
+	InvalidStrip      InvalidItemPolicy = "strip"      // The invalid items are removed and the rest is stored
+	InvalidQuarantine InvalidItemPolicy = "quarantine" // The entry is acknowledged but kept out of the record, in the quarantine directory
+)
+
+// ErrEntryQuarantined is returned for peer entries moved to quarantine instead of being stored,
+// the peer does not need to send them again
+var ErrEntryQuarantined = errors.New("entry quarantined")
+
+// ParseInvalidItemPolicy parses reject, strip or quarantine
+func ParseInvalidItemPolicy(policy string) (InvalidItemPolicy, error) {
+	switch p := InvalidItemPolicy(policy); p {
+	case InvalidReject, InvalidStrip, InvalidQuarantine:
+		return p, nil
+	}
+	return "", fmt.Errorf("invalid item policy must be reject, strip or quarantine, got %q", policy)
+}
+
+// PeerVerifier checks inventory entries received from peers before they are stored
+// Every item is validated against the origin it claims, and items are invalid unless the uptime
+// record shows the origin they claim online at the time of the entry
+// With an origin quorum, items claiming an origin not admitted by it are invalid
+type PeerVerifier struct {
+	validator  *ItemValidator
+	uptime     *Uptime
+	quorum     *OriginQuorum
+	policy     InvalidItemPolicy
+	quarantine string
+}
+
+// NewPeerVerifier creates a verifier with the rules registered so far, uptime may be nil to
+// validate items only, entries with invalid items are rejected
+func NewPeerVerifier(uptime *Uptime) *PeerVerifier {
+	return &PeerVerifier{
+		validator: NewItemValidator(),
+		uptime:    uptime,
+		policy:    InvalidReject,
+	}
+}
+
+// SetInvalidItemPolicy changes what is done with entries holding invalid items, quarantined
+// entries are written to dir
+func (v *PeerVerifier) SetInvalidItemPolicy(policy InvalidItemPolicy, dir string) error {
+	if policy == InvalidQuarantine && dir == "" {
+		return fmt.Errorf("the quarantine policy needs a quarantine directory")
+	}
+	v.policy, v.quarantine = policy, dir
+	return nil
+}
+
+// SetOriginQuorum makes items claiming origins the quorum has not admitted invalid, nil admits every origin
+func (v *PeerVerifier) SetOriginQuorum(quorum *OriginQuorum) {
+	v.quorum = quorum
+}
+
+// Verify checks an entry, returning a RejectionError when it is refused
+func (v *PeerVerifier) Verify(entry InventoryEntry) error {
+	_, _, err := v.check(entry)
+	return err
+}
+
+// check verifies an entry, under the strip policy it returns the entry without its invalid items
+// along with the number of items removed
+func (v *PeerVerifier) check(entry InventoryEntry) (InventoryEntry, int, error) {
+	var inventory []any
+	if err := json.Unmarshal(entry.Inventory, &inventory); err != nil {
+		return entry, 0, &RejectionError{Reason: "invalid_inventory", Message: "inventory is not a JSON array"}
+	}
+
+	strip := v.policy == InvalidStrip
+	removed := 0
+	for i, slot := range inventory {
+		fields, ok := slot.(map[string]any)
+		if !ok {
+			continue
+		}
+		if strip {
+			removed += v.stripShulker(fields, entry.Server, entry.Timestamp, i)
+		}
+		var item Item
+		item.fromMap(fields)
+
+		// Items are checked as their origin server would check them, the origin itself is checked against the uptime
+		origin := item.origin()
+		if origin == "" {
+			origin = entry.Server
+		}
+		err := peerItemError(v.validator.ValidateItem(&item, origin, i))
+		if err == nil {
+			err = v.quorumError(&item, i)
+		}
+		if err == nil {
+			err = v.uptimeError(&item, entry.Timestamp, i)
+		}
+		if err != nil {
+			if !strip {
+				return entry, 0, err
+			}
+			inventory[i] = nil
+			removed++
+			continue
+		}
+	}
+
+	if removed > 0 {
+		stripped, err := json.Marshal(inventory)
+		if err != nil {
+			return entry, 0, err
+		}
+		entry.Inventory = stripped
+	}
+	return entry, removed, nil
+}
+
+// stripShulker drops the invalid items from a decoded shulker box, recursively, returning how many were dropped
+func (v *PeerVerifier) stripShulker(fields map[string]any, server string, at time.Time, index int) int {
+	contents, ok := fields["shulkerContents"].([]any)
+	if !ok {
+		return 0
+	}
+
+	removed := 0
+	kept := []any{}
+	for _, content := range contents {
+		nested, ok := content.(map[string]any)
+		if !ok {
+			if content != nil {
+				removed++
+			}
+			continue
+		}
+		removed += v.stripShulker(nested, server, at, index)
+
+		var item Item
+		item.fromMap(nested)
+		origin := item.origin()
+		if origin == "" {
+			origin = server
+		}
+		if peerItemError(v.validator.validateItem(&item, origin, index, true)) != nil || v.quorumError(&item, index) != nil || v.uptimeError(&item, at, index) != nil {
+			removed++
+			continue
+		}
+		kept = append(kept, content)
+	}
+	fields["shulkerContents"] = kept
+
+	return removed
+}
+
+// quorumError refuses an item when it or anything in it claims an origin the quorum has not admitted
+func (v *PeerVerifier) quorumError(item *Item, index int) error {
+	if v.quorum == nil {
+		return nil
+	}
+
+	if origin := item.origin(); origin != "" && !v.quorum.Admitted(origin, time.Now()) {
+		return &RejectionError{
+			Reason:  "origin_quorum",
+			Message: fmt.Sprintf("item %d: origin %s was not seen online by %d nodes", index, origin, v.quorum.required),
+		}
+	}
+	for _, content := range item.ShulkerContents {
+		fields, ok := content.(map[string]any)
+		if !ok {
+			continue
+		}
+		var nested Item
+		nested.fromMap(fields)
+		if err := v.quorumError(&nested, index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// peerItemError turns the first validation error refusing a peer item into a RejectionError
+func peerItemError(validationErrors []ValidationError) error {
+	for _, validationError := range validationErrors {
+		if peerSkippedErrors[validationError.ErrorType] {
+			continue
+		}
+		return &RejectionError{
+			Reason:  validationError.ErrorType,
+			Message: fmt.Sprintf("item %d: %s", validationError.ItemIndex, validationError.Message),
+		}
+	}
+	return nil
+}
+
+// uptimeError refuses an item when it or anything in it claims an origin the uptime record does not
+// show online at the given time, origins this node never saw then can not be verified
+func (v *PeerVerifier) uptimeError(item *Item, at time.Time, index int) error {
+	if v.uptime == nil {
+		return nil
+	}
+
+	if origin := item.origin(); origin != "" {
+		switch v.uptime.Status(origin, at) {
+		case UptimeOffline:
+			return &RejectionError{
+				Reason:  "origin_offline",
+				Message: fmt.Sprintf("item %d: origin %s was offline at %s", index, origin, at.Format("2006-01-02 15:04:05")),
+			}
+		case UptimeUnknown:
+			return &RejectionError{
+				Reason:  "origin_unverifiable",
+				Message: fmt.Sprintf("item %d: origin %s was not seen online around %s", index, origin, at.Format("2006-01-02 15:04:05")),
+			}
+		}
+	}
+	for _, content := range item.ShulkerContents {
+		fields, ok := content.(map[string]any)
+		if !ok {
+			continue
+		}
+		var nested Item
+		nested.fromMap(fields)
+		if err := v.uptimeError(&nested, at, index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetPeerVerifier installs the verifier of entries received from peers through Merge and
+// VerifyPeerEntry, nil accepts them as they are
+func (db *DB) SetPeerVerifier(verifier *PeerVerifier) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.verifier = verifier
+}
+
+// VerifyPeerEntry checks an entry pushed by a peer before it is stored, counting refused entries,
+// it returns the entry to store, without its invalid items under the strip policy
+// Entries moved to quarantine return an error wrapping ErrEntryQuarantined
+func (db *DB) VerifyPeerEntry(player string, entry InventoryEntry) (InventoryEntry, error) {
+	return db.VerifyPeerEntryContext(context.Background(), player, entry)
+}
+
+// VerifyPeerEntryContext is VerifyPeerEntry recording the verification as a span of the trace in ctx
+func (db *DB) VerifyPeerEntryContext(ctx context.Context, player string, entry InventoryEntry) (InventoryEntry, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	return db.verifyPeerEntry(ctx, player, entry)
+}
+
+// verifyPeerEntry runs the installed peer verifier, db.mu must be held
+func (db *DB) verifyPeerEntry(ctx context.Context, player string, entry InventoryEntry) (_ InventoryEntry, err error) {
+	if db.verifier == nil {
+		return entry, nil
+	}
+
+	_, span := tracing.Start(ctx, "db.validate")
+	span.SetAttribute("player", player)
+	span.SetAttribute("server", entry.Server)
+	defer func() {
+		span.RecordError(err)
+		span.Finish()
+	}()
+
+	verified, removed, err := db.verifier.check(entry)
+	if err != nil {
+		db.stats.reject(entry.Server, rejectionReason(err), err.Error())
+		if db.verifier.policy != InvalidQuarantine {
+			logger.Warnf("Rejected inventory of %s from %s: %v", player, entry.Server, err)
+			return entry, fmt.Errorf("inventory from %s: %w", entry.Server, err)
+		}
+
+		if qerr := quarantineEntry(db.verifier.quarantine, player, entry); qerr != nil {
+			logger.Errorf("Unable to quarantine inventory of %s from %s: %v", player, entry.Server, qerr)
+			return entry, fmt.Errorf("inventory from %s: %w", entry.Server, err)
+		}
+		logger.Warnf("Audit: quarantined inventory of %s from %s to %s: %v", player, entry.Server, db.verifier.quarantine, err)
+		return entry, fmt.Errorf("inventory from %s: %w: %w", entry.Server, ErrEntryQuarantined, err)
+	}
+
+	if removed > 0 {
+		db.stats.stripped(entry.Server, removed)
+		logger.Warnf("Audit: stripped %d invalid items from inventory of %s from %s", removed, player, entry.Server)
+		if db.peerStripped != nil {
+			db.peerStripped(player, entry.Server)
+		}
+	}
+	return verified, nil
+}
+
+// OnPeerStripped calls notify with the player and origin server of every peer entry stored without
+// its invalid items, notify runs with the database locked and must not use it
+func (db *DB) OnPeerStripped(notify func(player, server string)) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.peerStripped = notify
+}
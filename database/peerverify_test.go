@@ -0,0 +1,228 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerVerifier_Verify(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	uptime := NewUptime("self.example.com", 5*time.Minute)
+	for minute := 0; minute <= 60; minute += 3 {
+		uptime.Seen("witness.example.com", start.Add(time.Duration(minute)*time.Minute))
+	}
+	uptime.Seen("a.example.com", start)
+	uptime.Seen("a.example.com", start.Add(40*time.Minute))
+	uptime.Seen("b.example.com", start)
+	uptime.Seen("b.example.com", start.Add(10*time.Minute))
+	uptime.Seen("b.example.com", start.Add(40*time.Minute))
+	verifier := NewPeerVerifier(uptime)
+
+	entry := func(inventory string, minute int) InventoryEntry {
+		return InventoryEntry{
+			Inventory: []byte(inventory),
+			Server:    "a.example.com",
+			Timestamp: start.Add(time.Duration(minute) * time.Minute),
+		}
+	}
+	reason := func(err error) string {
+		var rejection *RejectionError
+		require.ErrorAs(t, err, &rejection)
+		return rejection.Reason
+	}
+
+	own := `[{"typeId":"minecraft:diamond","amount":3,"lore":["Origin: a.example.com"]}]`
+	relayed := `[{"typeId":"minecraft:diamond","amount":3,"lore":["Origin: b.example.com"]},null]`
+
+	assert.NoError(t, verifier.Verify(entry(own, 1)))
+	assert.NoError(t, verifier.Verify(entry(relayed, 8)), "items of other servers are checked against their own origin")
+	assert.Equal(t, "origin_offline", reason(verifier.Verify(entry(own, 20))))
+	assert.Equal(t, "origin_offline", reason(verifier.Verify(entry(relayed, 25))), "a third-party origin offline at the time")
+	assert.Equal(t, "origin_unverifiable", reason(verifier.Verify(entry(own, -30))), "before the first sighting")
+
+	ghost := `[{"typeId":"minecraft:diamond","amount":3,"lore":["Origin: ghost.example.com"]}]`
+	assert.Equal(t, "origin_unverifiable", reason(verifier.Verify(entry(ghost, 1))), "an origin never seen")
+
+	local := `[{"typeId":"minecraft:diamond","amount":3,"lore":["Origin: self.example.com"]}]`
+	assert.NoError(t, verifier.Verify(entry(local, -30)), "items of this node")
+
+	nested := `[{"typeId":"minecraft:shulker_box","amount":1,"lore":["Origin: b.example.com"],
+		"shulkerContents":[{"typeId":"minecraft:diamond","amount":1,"lore":["Origin: a.example.com"]}]}]`
+	assert.Equal(t, "origin_offline", reason(verifier.Verify(entry(nested, 20))))
+
+	oversized := `[{"typeId":"minecraft:ender_pearl","amount":64,"lore":["Origin: b.example.com"]}]`
+	assert.Equal(t, "stack_too_large", reason(verifier.Verify(entry(oversized, 1))))
+	assert.Equal(t, "invalid_inventory", reason(verifier.Verify(entry(`not json`, 1))))
+}
+
+func TestDB_MergeVerifiesPeerEntries(t *testing.T) {
+	db, err := NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+	db.SetPeerVerifier(NewPeerVerifier(nil))
+
+	now := time.Now()
+	record, err := json.Marshal(PlayerInventories{Entries: []InventoryEntry{
+		{Inventory: []byte(`[{"typeId":"minecraft:ender_pearl","amount":64}]`), Server: "a.example.com", Timestamp: now},
+		{Inventory: []byte(`[{"typeId":"minecraft:ender_pearl","amount":16}]`), Server: "a.example.com", Timestamp: now.Add(-time.Minute)},
+	}})
+	require.NoError(t, err)
+
+	changed, err := db.Merge([]byte("alice"), record)
+	require.NoError(t, err)
+	assert.True(t, changed)
+
+	entries, err := db.GetPlayerInventories("alice")
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, now.Add(-time.Minute).UnixNano(), entries[0].Timestamp.UnixNano())
+
+	stats := db.OriginStats()
+	require.Len(t, stats, 1)
+	assert.Equal(t, 1, stats[0].Rejected["stack_too_large"])
+
+	_, err = db.VerifyPeerEntry("alice", InventoryEntry{Inventory: []byte(`{}`), Server: "a.example.com", Timestamp: now})
+	assert.Error(t, err)
+}
+
+func TestParseInvalidItemPolicy(t *testing.T) {
+	for _, policy := range []string{"reject", "strip", "quarantine"} {
+		parsed, err := ParseInvalidItemPolicy(policy)
+		require.NoError(t, err)
+		assert.Equal(t, InvalidItemPolicy(policy), parsed)
+	}
+	_, err := ParseInvalidItemPolicy("ignore")
+	assert.Error(t, err)
+
+	assert.Error(t, NewPeerVerifier(nil).SetInvalidItemPolicy(InvalidQuarantine, ""))
+}
+
+func TestDB_InvalidItemPolicy(t *testing.T) {
+	now := time.Now()
+	inventory := `[{"typeId":"minecraft:ender_pearl","amount":64},{"typeId":"minecraft:diamond","amount":3},` +
+		`{"typeId":"minecraft:shulker_box","amount":1,"shulkerContents":[{"typeId":"minecraft:ender_pearl","amount":64},{"typeId":"minecraft:apple","amount":2}]}]`
+	record, err := json.Marshal(PlayerInventories{Entries: []InventoryEntry{
+		{Inventory: []byte(inventory), Server: "a.example.com", Timestamp: now},
+	}})
+	require.NoError(t, err)
+
+	open := func(policy InvalidItemPolicy, dir string) *DB {
+		db, err := NewMemory()
+		require.NoError(t, err)
+		t.Cleanup(func() { db.Close() })
+		verifier := NewPeerVerifier(nil)
+		require.NoError(t, verifier.SetInvalidItemPolicy(policy, dir))
+		db.SetPeerVerifier(verifier)
+		return db
+	}
+
+	t.Run("strip", func(t *testing.T) {
+		db := open(InvalidStrip, "")
+		var notified []string
+		db.OnPeerStripped(func(player, server string) { notified = append(notified, player+"@"+server) })
+
+		changed, err := db.Merge([]byte("alice"), record)
+		require.NoError(t, err)
+		assert.True(t, changed)
+
+		stored, err := db.Get("alice")
+		require.NoError(t, err)
+		assert.JSONEq(t, `[null,{"typeId":"minecraft:diamond","amount":3},`+
+			`{"typeId":"minecraft:shulker_box","amount":1,"shulkerContents":[{"typeId":"minecraft:apple","amount":2}]}]`, string(stored))
+		assert.Equal(t, []string{"alice@a.example.com"}, notified)
+
+		stats := db.OriginStats()
+		require.Len(t, stats, 1)
+		assert.Equal(t, 2, stats[0].Stripped)
+
+		// Merging the same record again is neither a new entry nor a conflict
+		changed, err = db.Merge([]byte("alice"), record)
+		require.NoError(t, err)
+		assert.False(t, changed)
+		assert.Zero(t, db.OriginStats()[0].Conflicts)
+	})
+
+	t.Run("quarantine", func(t *testing.T) {
+		dir := t.TempDir()
+		db := open(InvalidQuarantine, dir)
+
+		changed, err := db.Merge([]byte("alice"), record)
+		require.NoError(t, err)
+		assert.False(t, changed)
+		_, err = db.Get("alice")
+		assert.ErrorIs(t, err, ErrPlayerNotFound)
+
+		files, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Len(t, files, 1)
+
+		_, err = db.VerifyPeerEntry("bob", InventoryEntry{Inventory: []byte(inventory), Server: "a.example.com", Timestamp: now})
+		assert.ErrorIs(t, err, ErrEntryQuarantined)
+		assert.ErrorIs(t, err, ErrInventoryRejected)
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		db := open(InvalidReject, "")
+		_, err := db.VerifyPeerEntry("alice", InventoryEntry{Inventory: []byte(inventory), Server: "a.example.com", Timestamp: now})
+		assert.ErrorIs(t, err, ErrInventoryRejected)
+		assert.NotErrorIs(t, err, ErrEntryQuarantined)
+	})
+}
+
+// spanRecorder keeps the spans exported by the global tracer
+type spanRecorder struct {
+	mu    sync.Mutex
+	spans []*tracing.Span
+}
+
+func (r *spanRecorder) Export(spans []*tracing.Span) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.spans = append(r.spans, spans...)
+	return nil
+}
+
+func TestDB_MergeTracesVerification(t *testing.T) {
+	recorder := &spanRecorder{}
+	tracing.Init(recorder)
+
+	db, err := NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+	db.SetPeerVerifier(NewPeerVerifier(nil))
+
+	record, err := json.Marshal(PlayerInventories{Entries: []InventoryEntry{
+		{Inventory: []byte(`[{"typeId":"minecraft:ender_pearl","amount":64}]`), Server: "a.example.com", Timestamp: time.Now()},
+	}})
+	require.NoError(t, err)
+
+	ctx, parent := tracing.Start(context.Background(), "network.join")
+	_, err = db.MergeContext(ctx, []byte("alice"), record)
+	require.NoError(t, err)
+	require.NoError(t, db.PutWithIDContext(ctx, "bob", []byte(`[]`), "b.example.com", nil, ""))
+	parent.Finish()
+	tracing.Shutdown()
+
+	// Spans of the database join the trace of the caller
+	byName := make(map[string]*tracing.Span)
+	for _, span := range recorder.spans {
+		byName[span.Name] = span
+	}
+	require.Contains(t, byName, "db.merge")
+	require.Contains(t, byName, "db.validate")
+	require.Contains(t, byName, "db.put")
+	assert.Equal(t, parent.SpanID, byName["db.merge"].ParentID)
+	assert.Equal(t, byName["db.merge"].SpanID, byName["db.validate"].ParentID)
+	assert.Equal(t, parent.SpanID, byName["db.put"].ParentID)
+	assert.Equal(t, parent.TraceID, byName["db.validate"].TraceID)
+	assert.Error(t, byName["db.validate"].Err, "the oversized stack fails validation")
+	assert.Equal(t, "false", byName["db.merge"].Attributes["merged"])
+}
@@ -0,0 +1,218 @@
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/gen/pb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// ItemToProto converts an item to its protobuf form, shulker contents included
+func ItemToProto(item *Item) (*pb.Item, error) {
+	msg := &pb.Item{
+		TypeId:  item.TypeID,
+		Amount:  int32(item.Amount),
+		NameTag: item.NameTag,
+		Lore:    item.Lore,
+	}
+
+	for _, enchantment := range item.Enchantments {
+		s, err := structpb.NewStruct(enchantment)
+		if err != nil {
+			return nil, fmt.Errorf("enchantment of %s: %w", item.TypeID, err)
+		}
+		msg.Enchantments = append(msg.Enchantments, s)
+	}
+
+	var err error
+	if item.Durability != nil {
+		if msg.Durability, err = structpb.NewStruct(item.Durability); err != nil {
+			return nil, fmt.Errorf("durability of %s: %w", item.TypeID, err)
+		}
+	}
+	if item.Extra != nil {
+		if msg.Extra, err = structpb.NewStruct(item.Extra); err != nil {
+			return nil, fmt.Errorf("fields of %s: %w", item.TypeID, err)
+		}
+	}
+	if msg.ShulkerContents, err = slotsToProto(item.ShulkerContents); err != nil {
+		return nil, fmt.Errorf("shulker box %s: %w", item.TypeID, err)
+	}
+
+	return msg, nil
+}
+
+// ItemFromProto converts a protobuf item back, shulker contents are decoded as they are read from JSON
+func ItemFromProto(msg *pb.Item) (*Item, error) {
+	item := &Item{
+		TypeID:  msg.GetTypeId(),
+		Amount:  int(msg.GetAmount()),
+		NameTag: msg.GetNameTag(),
+		Lore:    msg.GetLore(),
+	}
+
+	for _, enchantment := range msg.GetEnchantments() {
+		item.Enchantments = append(item.Enchantments, enchantment.AsMap())
+	}
+	if msg.GetDurability() != nil {
+		item.Durability = msg.GetDurability().AsMap()
+	}
+	if msg.GetExtra() != nil {
+		item.Extra = msg.GetExtra().AsMap()
+	}
+
+	for _, slot := range msg.GetShulkerContents() {
+		if slot.GetItem() == nil {
+			item.ShulkerContents = append(item.ShulkerContents, nil)
+			continue
+		}
+
+		nested, err := ItemFromProto(slot.GetItem())
+		if err != nil {
+			return nil, err
+		}
+		data, err := json.Marshal(nested)
+		if err != nil {
+			return nil, err
+		}
+		var decoded any
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			return nil, err
+		}
+		item.ShulkerContents = append(item.ShulkerContents, decoded)
+	}
+
+	return item, nil
+}
+
+// slotsToProto converts decoded JSON slots, empty slots are null and the others item objects
+func slotsToProto(slots []any) ([]*pb.Slot, error) {
+	var msgs []*pb.Slot
+	for i, slot := range slots {
+		if slot == nil {
+			msgs = append(msgs, &pb.Slot{})
+			continue
+		}
+
+		object, ok := slot.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("slot %d is not an item", i)
+		}
+		var item Item
+		item.fromMap(object)
+		msg, err := ItemToProto(&item)
+		if err != nil {
+			return nil, fmt.Errorf("slot %d: %w", i, err)
+		}
+		msgs = append(msgs, &pb.Slot{Item: msg})
+	}
+	return msgs, nil
+}
+
+// InventoryToProto converts a JSON inventory array to slots
+func InventoryToProto(inventory []byte) ([]*pb.Slot, error) {
+	var slots []any
+	if err := json.Unmarshal(inventory, &slots); err != nil {
+		return nil, fmt.Errorf("invalid inventory JSON: %w", err)
+	}
+	return slotsToProto(slots)
+}
+
+// InventoryFromProto encodes slots as the JSON inventory array the pack reads
+func InventoryFromProto(msgs []*pb.Slot) ([]byte, error) {
+	slots := make([]any, len(msgs))
+	for i, msg := range msgs {
+		if msg.GetItem() == nil {
+			continue
+		}
+		item, err := ItemFromProto(msg.GetItem())
+		if err != nil {
+			return nil, fmt.Errorf("slot %d: %w", i, err)
+		}
+		slots[i] = item
+	}
+	return json.Marshal(slots)
+}
+
+// EntryToProto converts an inventory entry, an inventory that is not an array of items is kept
+// as raw bytes
+func EntryToProto(entry InventoryEntry) *pb.InventoryEntry {
+	msg := &pb.InventoryEntry{
+		Server:   entry.Server,
+		UpdateId: entry.UpdateID,
+		Member:   entry.Member,
+	}
+	if !entry.Timestamp.IsZero() {
+		msg.Timestamp = entry.Timestamp.UnixNano()
+	}
+	if entry.Location != nil {
+		msg.Location = &pb.Location{
+			X:         entry.Location.X,
+			Y:         entry.Location.Y,
+			Z:         entry.Location.Z,
+			Dimension: entry.Location.Dimension,
+		}
+	}
+
+	slots, err := InventoryToProto(entry.Inventory)
+	if err != nil {
+		msg.RawInventory = entry.Inventory
+	} else {
+		msg.Inventory = slots
+	}
+	return msg
+}
+
+// EntryFromProto converts a protobuf inventory entry back
+func EntryFromProto(msg *pb.InventoryEntry) (InventoryEntry, error) {
+	entry := InventoryEntry{
+		Inventory: msg.GetRawInventory(),
+		Server:    msg.GetServer(),
+		UpdateID:  msg.GetUpdateId(),
+		Member:    msg.GetMember(),
+	}
+	if msg.GetTimestamp() != 0 {
+		entry.Timestamp = time.Unix(0, msg.GetTimestamp())
+	}
+	if location := msg.GetLocation(); location != nil {
+		entry.Location = &Location{
+			X:         location.GetX(),
+			Y:         location.GetY(),
+			Z:         location.GetZ(),
+			Dimension: location.GetDimension(),
+		}
+	}
+
+	if entry.Inventory == nil {
+		inventory, err := InventoryFromProto(msg.GetInventory())
+		if err != nil {
+			return InventoryEntry{}, err
+		}
+		entry.Inventory = inventory
+	}
+	return entry, nil
+}
+
+// ValidationErrorToProto converts a validation error to its protobuf form
+func ValidationErrorToProto(err ValidationError) *pb.ValidationError {
+	return &pb.ValidationError{
+		Player:    err.Player,
+		Server:    err.Server,
+		ItemIndex: int32(err.ItemIndex),
+		ErrorType: err.ErrorType,
+		Message:   err.Message,
+	}
+}
+
+// ValidationErrorFromProto converts a protobuf validation error back
+func ValidationErrorFromProto(msg *pb.ValidationError) ValidationError {
+	return ValidationError{
+		Player:    msg.GetPlayer(),
+		Server:    msg.GetServer(),
+		ItemIndex: int(msg.GetItemIndex()),
+		ErrorType: msg.GetErrorType(),
+		Message:   msg.GetMessage(),
+	}
+}
@@ -0,0 +1,96 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/gen/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestInventoryToProto(t *testing.T) {
+	inventory := []byte(`[
+		{"typeId":"minecraft:diamond_sword","amount":1,"nameTag":"Edge","lore":["Origin: a.example.com"],
+		 "enchantments":[{"type":"sharpness","level":5}],"durability":{"damage":12,"maxDurability":1561},"dyeColor":"red"},
+		null,
+		{"typeId":"minecraft:shulker_box","amount":1,"shulkerContents":[null,{"typeId":"minecraft:bread","amount":16}]}
+	]`)
+
+	slots, err := InventoryToProto(inventory)
+	require.NoError(t, err)
+	require.Len(t, slots, 3)
+
+	sword := slots[0].GetItem()
+	assert.Equal(t, "minecraft:diamond_sword", sword.GetTypeId())
+	assert.Equal(t, int32(1), sword.GetAmount())
+	assert.Equal(t, []string{"Origin: a.example.com"}, sword.GetLore())
+	assert.Equal(t, float64(5), sword.GetEnchantments()[0].GetFields()["level"].GetNumberValue())
+	assert.Equal(t, "red", sword.GetExtra().GetFields()["dyeColor"].GetStringValue())
+	assert.Nil(t, slots[1].GetItem())
+	require.Len(t, slots[2].GetItem().GetShulkerContents(), 2)
+	assert.Equal(t, "minecraft:bread", slots[2].GetItem().GetShulkerContents()[1].GetItem().GetTypeId())
+
+	// Slots survive the wire and encode back to the same inventory
+	data, err := proto.Marshal(&pb.InventoryEntry{Inventory: slots})
+	require.NoError(t, err)
+	var decoded pb.InventoryEntry
+	require.NoError(t, proto.Unmarshal(data, &decoded))
+
+	back, err := InventoryFromProto(decoded.GetInventory())
+	require.NoError(t, err)
+	assert.JSONEq(t, string(inventory), string(back))
+
+	// Shulker contents are read back as the database reads them from JSON
+	var shulker Item
+	require.NoError(t, shulker.UnmarshalJSON([]byte(`{"typeId":"minecraft:shulker_box","shulkerContents":[{"typeId":"minecraft:bread","lore":["Origin: banned"]}]}`)))
+	msg, err := ItemToProto(&shulker)
+	require.NoError(t, err)
+	item, err := ItemFromProto(msg)
+	require.NoError(t, err)
+	require.Len(t, item.ShulkerContents, 1)
+	assert.IsType(t, map[string]any{}, item.ShulkerContents[0])
+	assert.Empty(t, extractValidItemsFromShulker(item.ShulkerContents, "banned"))
+	assert.NotEmpty(t, extractValidItemsFromShulker(item.ShulkerContents, "other"))
+
+	_, err = InventoryToProto([]byte(`[1]`))
+	assert.ErrorContains(t, err, "slot 0 is not an item")
+}
+
+func TestEntryToProto(t *testing.T) {
+	entry := InventoryEntry{
+		Inventory: []byte(`[{"amount":2,"typeId":"minecraft:bread"},null]`),
+		Server:    "a.example.com",
+		Timestamp: time.Unix(0, 1700000000123456789),
+		Location:  &Location{X: 1, Y: 64, Z: -3.5, Dimension: "minecraft:overworld"},
+		UpdateID:  "run1:7",
+		Member:    "Bob",
+	}
+
+	msg := EntryToProto(entry)
+	assert.Nil(t, msg.GetRawInventory())
+	assert.Len(t, msg.GetInventory(), 2)
+
+	converted, err := EntryFromProto(msg)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(entry.Inventory), string(converted.Inventory))
+	assert.True(t, entry.Timestamp.Equal(converted.Timestamp))
+	converted.Inventory, converted.Timestamp = entry.Inventory, entry.Timestamp
+	assert.Equal(t, entry, converted)
+
+	t.Run("UnreadableInventoryIsKeptRaw", func(t *testing.T) {
+		msg := EntryToProto(InventoryEntry{Inventory: []byte("inv1"), Server: "a.example.com"})
+		assert.Equal(t, []byte("inv1"), msg.GetRawInventory())
+
+		converted, err := EntryFromProto(msg)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("inv1"), converted.Inventory)
+		assert.True(t, converted.Timestamp.IsZero())
+	})
+}
+
+func TestValidationErrorToProto(t *testing.T) {
+	validationErr := ValidationError{Player: "alice", Server: "a.example.com", ItemIndex: -1, ErrorType: "invalid_inventory", Message: "Failed to parse inventory JSON"}
+	assert.Equal(t, validationErr, ValidationErrorFromProto(ValidationErrorToProto(validationErr)))
+}
@@ -0,0 +1,176 @@
+package database
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// OriginQuorum admits the item origins of peer entries only once enough nodes saw the origin server
+// online recently, so a fabricated server identity cannot inject items into the economy on its own
+// Witnesses are this node, through its handshakes with trusted peers, and the trusted peers reporting
+// the servers they handshaked with in theirs, an origin stays admitted once its quorum was met
+type OriginQuorum struct {
+	mu        sync.Mutex
+	self      string
+	required  int
+	window    time.Duration
+	path      string
+	sightings map[string]map[string]time.Time // Last sighting of a server by each witness
+	admitted  map[string]bool
+}
+
+// NewOriginQuorum creates a quorum of required witnesses within window, self is this node's web
+// address and always admitted, admitted origins are kept in the file at path when it is not empty
+func NewOriginQuorum(self string, required int, window time.Duration, path string) (*OriginQuorum, error) {
+	if required < 1 {
+		return nil, fmt.Errorf("origin quorum must be at least 1, got %d", required)
+	}
+
+	q := &OriginQuorum{
+		self:      self,
+		required:  required,
+		window:    window,
+		path:      path,
+		sightings: make(map[string]map[string]time.Time),
+		admitted:  map[string]bool{self: true},
+	}
+	if path == "" {
+		return q, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return q, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read admitted origins: %w", err)
+	}
+	var admitted []string
+	if err := json.Unmarshal(data, &admitted); err != nil {
+		return nil, fmt.Errorf("invalid admitted origins in %s: %w", path, err)
+	}
+	for _, server := range admitted {
+		q.admitted[server] = true
+	}
+	return q, nil
+}
+
+// Seen records that this node handshaked with a server it trusts
+func (q *OriginQuorum) Seen(server string, at time.Time) {
+	q.Witness(q.self, []string{server}, at)
+}
+
+// Witness records the servers a trusted peer reports having seen online, a server never witnesses itself
+func (q *OriginQuorum) Witness(witness string, servers []string, at time.Time) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for _, server := range servers {
+		if server == witness {
+			continue
+		}
+		witnesses, ok := q.sightings[server]
+		if !ok {
+			witnesses = make(map[string]time.Time)
+			q.sightings[server] = witnesses
+		}
+		if at.After(witnesses[witness]) {
+			witnesses[witness] = at
+		}
+	}
+}
+
+// Recent returns the servers this node saw within the window, to report to peers
+func (q *OriginQuorum) Recent(now time.Time) []string {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var servers []string
+	for server, witnesses := range q.sightings {
+		if seen, ok := witnesses[q.self]; ok && now.Sub(seen) <= q.window {
+			servers = append(servers, server)
+		}
+	}
+	sort.Strings(servers)
+	return servers
+}
+
+// Witnesses returns how many nodes saw a server within the window
+func (q *OriginQuorum) Witnesses(server string, now time.Time) int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.witnesses(server, now)
+}
+
+// witnesses counts the recent sightings of a server, q.mu must be held
+func (q *OriginQuorum) witnesses(server string, now time.Time) int {
+	count := 0
+	for _, seen := range q.sightings[server] {
+		if now.Sub(seen) <= q.window {
+			count++
+		}
+	}
+	return count
+}
+
+// Admitted reports whether items may claim a server as their origin, admitting it when its quorum is met
+func (q *OriginQuorum) Admitted(server string, now time.Time) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.admitted[server] {
+		return true
+	}
+	witnesses := q.witnesses(server, now)
+	if witnesses < q.required {
+		return false
+	}
+
+	q.admitted[server] = true
+	logger.Infof("Admitted item origin %s, seen online by %d nodes", server, witnesses)
+	if err := q.save(); err != nil {
+		logger.Warnf("Origin %s is admitted until the next restart: %v", server, err)
+	}
+	return true
+}
+
+// Origins returns the admitted origins sorted
+func (q *OriginQuorum) Origins() []string {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.origins()
+}
+
+// origins lists the admitted origins, q.mu must be held
+func (q *OriginQuorum) origins() []string {
+	origins := make([]string, 0, len(q.admitted))
+	for server := range q.admitted {
+		origins = append(origins, server)
+	}
+	sort.Strings(origins)
+	return origins
+}
+
+// save writes the admitted origins so they stay admitted after a restart, q.mu must be held
+func (q *OriginQuorum) save() error {
+	if q.path == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(q.origins(), "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := q.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("failed to save admitted origins: %w", err)
+	}
+	return os.Rename(tmp, q.path)
+}
@@ -0,0 +1,67 @@
+package database
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOriginQuorum_Admitted(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	path := filepath.Join(t.TempDir(), "admitted_origins.json")
+
+	quorum, err := NewOriginQuorum("self.example.com", 2, time.Hour, path)
+	require.NoError(t, err)
+	assert.True(t, quorum.Admitted("self.example.com", now))
+
+	quorum.Seen("a.example.com", now.Add(-2*time.Hour))
+	quorum.Witness("b.example.com", []string{"a.example.com"}, now)
+	assert.False(t, quorum.Admitted("a.example.com", now), "this node's sighting is too old")
+
+	quorum.Witness("ghost.example.com", []string{"ghost.example.com"}, now)
+	quorum.Seen("ghost.example.com", now)
+	assert.Equal(t, 1, quorum.Witnesses("ghost.example.com", now), "a server never witnesses itself")
+	assert.False(t, quorum.Admitted("ghost.example.com", now))
+
+	quorum.Seen("a.example.com", now)
+	assert.Equal(t, []string{"a.example.com", "ghost.example.com"}, quorum.Recent(now))
+	assert.True(t, quorum.Admitted("a.example.com", now))
+	assert.True(t, quorum.Admitted("a.example.com", now.Add(48*time.Hour)), "admitted origins stay admitted")
+
+	reloaded, err := NewOriginQuorum("self.example.com", 2, time.Hour, path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a.example.com", "self.example.com"}, reloaded.Origins())
+
+	_, err = NewOriginQuorum("self.example.com", 0, time.Hour, "")
+	assert.Error(t, err)
+}
+
+func TestPeerVerifier_OriginQuorum(t *testing.T) {
+	quorum, err := NewOriginQuorum("self.example.com", 1, time.Hour, "")
+	require.NoError(t, err)
+	quorum.Seen("a.example.com", time.Now())
+
+	verifier := NewPeerVerifier(nil)
+	verifier.SetOriginQuorum(quorum)
+	entry := func(inventory string) InventoryEntry {
+		return InventoryEntry{Inventory: []byte(inventory), Server: "a.example.com", Timestamp: time.Now()}
+	}
+
+	assert.NoError(t, verifier.Verify(entry(`[{"typeId":"minecraft:diamond","amount":3,"lore":["Origin: a.example.com"]}]`)))
+	assert.NoError(t, verifier.Verify(entry(`[{"typeId":"minecraft:diamond","amount":3}]`)))
+
+	nested := `[{"typeId":"minecraft:shulker_box","amount":1,"lore":["Origin: a.example.com"],
+		"shulkerContents":[{"typeId":"minecraft:diamond","amount":1,"lore":["Origin: ghost.example.com"]}]}]`
+	var rejection *RejectionError
+	require.ErrorAs(t, verifier.Verify(entry(nested)), &rejection)
+	assert.Equal(t, "origin_quorum", rejection.Reason)
+
+	require.NoError(t, verifier.SetInvalidItemPolicy(InvalidStrip, ""))
+	stripped, removed, err := verifier.check(entry(nested))
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+	assert.NotContains(t, string(stripped.Inventory), "ghost.example.com")
+}
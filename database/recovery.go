@@ -0,0 +1,149 @@
+package database
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/logger"
+	"github.com/syndtr/goleveldb/leveldb"
+	leveldberrors "github.com/syndtr/goleveldb/leveldb/errors"
+)
+
+// IntegrityReport describes the result of an integrity scan
+type IntegrityReport struct {
+	Recovered   bool     // The database was rebuilt from its table files
+	Scanned     int      // Player records read
+	Quarantined []string // Players whose records were unreadable and moved aside
+}
+
+// openOrRecover opens a leveldb database, rebuilding it with RecoverFile when it is corrupted
+func openOrRecover(path string) (*leveldb.DB, bool, error) {
+	ldb, err := leveldb.OpenFile(path, nil)
+	if err == nil {
+		return ldb, false, nil
+	}
+	if !leveldberrors.IsCorrupted(err) {
+		return nil, false, err
+	}
+
+	logger.Warnf("Database %s is corrupted, recovering: %v", path, err)
+	ldb, err = leveldb.RecoverFile(path, nil)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to recover corrupted database: %w", err)
+	}
+
+	return ldb, true, nil
+}
+
+// Repair recovers the database at path and quarantines unreadable player records,
+// it must not be used while a node has the database open
+func Repair(path string) (*IntegrityReport, error) {
+	if err := os.RemoveAll(filepath.Join(path, "LOCK")); err != nil {
+		return nil, err
+	}
+
+	ldb, err := leveldb.RecoverFile(path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover database: %w", err)
+	}
+
+	db := &DB{leveldb: ldb, changeLog: make([]ChangeEntry, 0)}
+	defer db.Close()
+
+	report, err := db.Quarantine(QuarantineDir(path))
+	if err != nil {
+		return nil, err
+	}
+	report.Recovered = true
+
+	return report, nil
+}
+
+// QuarantineDir returns where unreadable records of the database at path are moved
+func QuarantineDir(path string) string {
+	return filepath.Clean(path) + ".quarantine"
+}
+
+// Quarantine scans every player record and moves the unreadable ones out of the database
+// into files in dir, so a damaged record cannot stop the node from starting
+func (db *DB) Quarantine(dir string) (*IntegrityReport, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if db.closed {
+		return nil, ErrClosed
+	}
+
+	report := &IntegrityReport{}
+	var broken [][]byte
+	var values [][]byte
+
+	iter := db.leveldb.NewIterator(nil, nil)
+	for iter.Next() {
+		report.Scanned++
+		if readableRecord(iter.Value()) {
+			continue
+		}
+		broken = append(broken, append([]byte{}, iter.Key()...))
+		values = append(values, append([]byte{}, iter.Value()...))
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return nil, fmt.Errorf("integrity scan failed: %w", err)
+	}
+
+	if len(broken) == 0 {
+		return report, nil
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create quarantine directory: %w", err)
+	}
+
+	stamp := time.Now().UTC().Format("20060102T150405")
+	for i, key := range broken {
+		// Keys differing only in unsafe characters sanitize alike, the hash of the raw key keeps their files apart
+		sum := sha256.Sum256(key)
+		name := fmt.Sprintf("%s-%x-%s.record", unsafeFileChars.ReplaceAllString(string(key), "_"), sum[:8], stamp)
+		if err := writeNewFile(filepath.Join(dir, name), values[i]); err != nil {
+			return nil, fmt.Errorf("failed to quarantine %s: %w", key, err)
+		}
+		if err := db.leveldb.Delete(key, nil); err != nil {
+			return nil, fmt.Errorf("failed to remove quarantined %s: %w", key, err)
+		}
+
+		logger.Warnf("Quarantined unreadable record of %s to %s", key, dir)
+		report.Quarantined = append(report.Quarantined, string(key))
+	}
+
+	return report, nil
+}
+
+// writeNewFile writes data to a file that must not exist yet, so a quarantined record never
+// overwrites another one
+func writeNewFile(path string, data []byte) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// readableRecord reports whether a value decodes as player inventories or the legacy raw array
+func readableRecord(value []byte) bool {
+	var playerInv PlayerInventories
+	if err := json.Unmarshal(value, &playerInv); err == nil {
+		return true
+	}
+
+	var rawArray []any
+	return json.Unmarshal(value, &rawArray) == nil
+}
@@ -0,0 +1,121 @@
+package database
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_Quarantine(t *testing.T) {
+	db, err := NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, db.Put("alice", []byte(`[{"typeId":"minecraft:dirt","amount":1}]`), "server"))
+	require.NoError(t, db.leveldb.Put([]byte("legacy"), []byte(`[{"typeId":"minecraft:dirt","amount":1}]`), nil))
+	require.NoError(t, db.leveldb.Put([]byte("bob/../x"), []byte("\x00garbage"), nil))
+
+	dir := t.TempDir()
+	report, err := db.Quarantine(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 3, report.Scanned)
+	assert.Equal(t, []string{"bob/../x"}, report.Quarantined)
+
+	_, err = db.Get("bob/../x")
+	assert.ErrorIs(t, err, ErrPlayerNotFound)
+	_, err = db.Get("alice")
+	assert.NoError(t, err)
+	_, err = db.Get("legacy")
+	assert.NoError(t, err)
+
+	files, err := filepath.Glob(filepath.Join(dir, "bob_.._x-*.record"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	content, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	assert.Equal(t, []byte("\x00garbage"), content)
+}
+
+func TestDB_QuarantineKeepsCollidingKeysApart(t *testing.T) {
+	db, err := NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Both keys sanitize to bob_x
+	require.NoError(t, db.leveldb.Put([]byte("bob/x"), []byte("\x00first"), nil))
+	require.NoError(t, db.leveldb.Put([]byte("bob:x"), []byte("\x00second"), nil))
+
+	dir := t.TempDir()
+	report, err := db.Quarantine(dir)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"bob/x", "bob:x"}, report.Quarantined)
+
+	files, err := filepath.Glob(filepath.Join(dir, "bob_x-*.record"))
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	var contents []string
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		require.NoError(t, err)
+		contents = append(contents, string(content))
+	}
+	assert.ElementsMatch(t, []string{"\x00first", "\x00second"}, contents)
+}
+
+func TestDB_NewRecoversCorruption(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "inventories.ldb")
+
+	db, err := New(path)
+	require.NoError(t, err)
+	require.NoError(t, db.Put("alice", []byte(`[{"typeId":"minecraft:dirt","amount":1}]`), "server"))
+	require.NoError(t, db.leveldb.Put([]byte("bob"), []byte("{broken"), nil))
+	require.NoError(t, db.Close())
+
+	// Losing the manifest makes a plain open fail as corrupted
+	manifests, err := filepath.Glob(filepath.Join(path, "MANIFEST-*"))
+	require.NoError(t, err)
+	require.NotEmpty(t, manifests)
+	for _, manifest := range manifests {
+		require.NoError(t, os.Remove(manifest))
+	}
+
+	db, err = New(path)
+	require.NoError(t, err)
+	defer db.Close()
+
+	inventory, err := db.Get("alice")
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"typeId":"minecraft:dirt","amount":1}]`, string(inventory))
+
+	_, err = db.Get("bob")
+	assert.ErrorIs(t, err, ErrPlayerNotFound)
+
+	quarantined, err := filepath.Glob(filepath.Join(QuarantineDir(path), "bob-*.record"))
+	require.NoError(t, err)
+	assert.Len(t, quarantined, 1)
+}
+
+func TestRepair(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "inventories.ldb")
+
+	db, err := New(path)
+	require.NoError(t, err)
+	require.NoError(t, db.Put("alice", []byte(`[]`), "server"))
+	require.NoError(t, db.leveldb.Put([]byte("bob"), []byte("{broken"), nil))
+	require.NoError(t, db.Close())
+
+	report, err := Repair(path)
+	require.NoError(t, err)
+	assert.True(t, report.Recovered)
+	assert.Equal(t, 2, report.Scanned)
+	assert.Equal(t, []string{"bob"}, report.Quarantined)
+
+	// A second repair finds nothing left to quarantine
+	report, err = Repair(path)
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Scanned)
+	assert.Empty(t, report.Quarantined)
+}
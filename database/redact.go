@@ -0,0 +1,127 @@
+package database
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Item fields that can be redacted, both are written by players
+const (
+	RedactNameTag = "nameTag"
+	RedactLore    = "lore"
+)
+
+// Redacted replaces the text of redacted fields
+const Redacted = "[redacted]"
+
+var ErrInvalidRedaction = errors.New("invalid redacted field")
+
+// Redaction hides the text players wrote on items from views of inventories, the stored payloads
+// are left untouched, origin lore lines are kept as they are stamped by the network
+// A nil redaction hides nothing
+type Redaction struct {
+	nameTag bool
+	lore    bool
+}
+
+// NewRedaction redacts the given fields, nameTag and lore, field names are case insensitive
+func NewRedaction(fields []string) (*Redaction, error) {
+	r := &Redaction{}
+	for _, field := range fields {
+		switch {
+		case strings.EqualFold(field, RedactNameTag):
+			r.nameTag = true
+		case strings.EqualFold(field, RedactLore):
+			r.lore = true
+		default:
+			return nil, fmt.Errorf("%w: %q, expected %s or %s", ErrInvalidRedaction, field, RedactNameTag, RedactLore)
+		}
+	}
+	return r, nil
+}
+
+// Enabled reports whether the redaction hides any field
+func (r *Redaction) Enabled() bool {
+	return r != nil && (r.nameTag || r.lore)
+}
+
+// Inventory returns the payload with the redacted fields replaced, including shulker contents,
+// payloads that are not JSON are returned as they are
+func (r *Redaction) Inventory(payload []byte) []byte {
+	if !r.Enabled() {
+		return payload
+	}
+
+	var inventory any
+	if err := json.Unmarshal(payload, &inventory); err != nil {
+		return payload
+	}
+	r.redact(inventory)
+
+	redacted, err := json.Marshal(inventory)
+	if err != nil {
+		return payload
+	}
+	return redacted
+}
+
+// redact replaces the redacted fields of a decoded payload in place
+func (r *Redaction) redact(value any) {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			switch {
+			case key == RedactNameTag && r.nameTag:
+				v[key] = Redacted
+			case key == RedactLore && r.lore:
+				v[key] = redactLore(field)
+			default:
+				r.redact(field)
+			}
+		}
+	case []any:
+		for _, element := range v {
+			r.redact(element)
+		}
+	}
+}
+
+// redactLore replaces the custom lines of a lore, keeping its origin lines
+func redactLore(value any) any {
+	lines, ok := value.([]any)
+	if !ok {
+		return Redacted
+	}
+
+	format := CurrentOriginFormat()
+	redacted := make([]any, len(lines))
+	for i, line := range lines {
+		redacted[i] = Redacted
+		if text, ok := line.(string); ok {
+			if _, ok := format.Parse(text); ok {
+				redacted[i] = text
+			}
+		}
+	}
+	return redacted
+}
+
+// Entry returns a copy of the entry with its inventory redacted
+func (r *Redaction) Entry(entry InventoryEntry) InventoryEntry {
+	entry.Inventory = r.Inventory(entry.Inventory)
+	return entry
+}
+
+// Entries returns copies of the entries with their inventories redacted
+func (r *Redaction) Entries(entries []InventoryEntry) []InventoryEntry {
+	if !r.Enabled() {
+		return entries
+	}
+	redacted := make([]InventoryEntry, len(entries))
+	for i, entry := range entries {
+		redacted[i] = r.Entry(entry)
+	}
+	return redacted
+}
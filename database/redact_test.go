@@ -0,0 +1,68 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRedaction(t *testing.T) {
+	r, err := NewRedaction([]string{"NAMETAG", "lore"})
+	require.NoError(t, err)
+	assert.True(t, r.Enabled())
+
+	r, err = NewRedaction(nil)
+	require.NoError(t, err)
+	assert.False(t, r.Enabled())
+
+	_, err = NewRedaction([]string{"enchantments"})
+	assert.ErrorIs(t, err, ErrInvalidRedaction)
+
+	var none *Redaction
+	assert.False(t, none.Enabled())
+	assert.Equal(t, []byte(`[{"nameTag":"x"}]`), none.Inventory([]byte(`[{"nameTag":"x"}]`)))
+}
+
+func TestRedaction_Inventory(t *testing.T) {
+	payload := []byte(`[{"typeId":"minecraft:shulker_box","amount":1,"nameTag":"Alice's stash","lore":["Origin: server1","For Bob only"],` +
+		`"shulkerContents":[{"typeId":"minecraft:diamond_sword","amount":1,"nameTag":"Secret","lore":["home at 100 64 -20"]}]},null]`)
+
+	t.Run("name tags and lore", func(t *testing.T) {
+		r, err := NewRedaction([]string{RedactNameTag, RedactLore})
+		require.NoError(t, err)
+
+		redacted := string(r.Inventory(payload))
+		assert.NotContains(t, redacted, "Alice's stash")
+		assert.NotContains(t, redacted, "Secret")
+		assert.NotContains(t, redacted, "For Bob only")
+		assert.NotContains(t, redacted, "home at")
+		assert.Contains(t, redacted, `"lore":["Origin: server1","[redacted]"]`)
+		assert.Contains(t, redacted, `"typeId":"minecraft:diamond_sword"`)
+	})
+
+	t.Run("lore only", func(t *testing.T) {
+		r, err := NewRedaction([]string{RedactLore})
+		require.NoError(t, err)
+
+		redacted := string(r.Inventory(payload))
+		assert.Contains(t, redacted, "Alice's stash")
+		assert.NotContains(t, redacted, "For Bob only")
+	})
+
+	t.Run("entries are copied", func(t *testing.T) {
+		r, err := NewRedaction([]string{RedactNameTag})
+		require.NoError(t, err)
+
+		entries := []InventoryEntry{{Inventory: payload, Server: "server1"}}
+		redacted := r.Entries(entries)
+		assert.NotContains(t, string(redacted[0].Inventory), "Alice's stash")
+		assert.Contains(t, string(entries[0].Inventory), "Alice's stash")
+	})
+
+	t.Run("invalid JSON is kept", func(t *testing.T) {
+		r, err := NewRedaction([]string{RedactNameTag})
+		require.NoError(t, err)
+		assert.Equal(t, []byte(`[{"nameTag":`), r.Inventory([]byte(`[{"nameTag":`)))
+	})
+}
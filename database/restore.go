@@ -0,0 +1,54 @@
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// checkRestorable reports why an inventory cannot be restored to a player, checking that it
+// is a JSON array whose slots are empty or items with a type id
+func checkRestorable(inventory []byte) error {
+	var slots []any
+	if err := json.Unmarshal(inventory, &slots); err != nil {
+		return fmt.Errorf("invalid inventory JSON: %w", err)
+	}
+
+	for i, slot := range slots {
+		if slot == nil {
+			continue
+		}
+		item, ok := slot.(map[string]any)
+		if !ok {
+			return fmt.Errorf("slot %d is not an item", i)
+		}
+		if typeID, _ := item["typeId"].(string); typeID == "" {
+			return fmt.Errorf("slot %d has no typeId", i)
+		}
+	}
+
+	return nil
+}
+
+// latestRestorable returns the newest entry that can be restored, so one bad update does not
+// lock a player out of their items, entries must be sorted newest first
+// When no entry is restorable the newest one is returned unchanged
+func latestRestorable(player string, entries []InventoryEntry) InventoryEntry {
+	latestErr := checkRestorable(entries[0].Inventory)
+	if latestErr == nil {
+		return entries[0]
+	}
+
+	for i, entry := range entries[1:] {
+		if checkRestorable(entry.Inventory) == nil {
+			logger.Warnf("Latest inventory of %s from %s is unreadable (%v), restoring the entry of %s at %s and skipping %d newer entries",
+				player, entries[0].Server, latestErr, entry.Server, entry.Timestamp.Format(time.RFC3339), i+1)
+			return entry
+		}
+	}
+
+	logger.Warnf("No readable inventory entry for %s, returning the latest: %v", player, latestErr)
+	return entries[0]
+}
@@ -0,0 +1,61 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckRestorable(t *testing.T) {
+	assert.NoError(t, checkRestorable([]byte(`[]`)))
+	assert.NoError(t, checkRestorable([]byte(`[null,{"typeId":"minecraft:dirt","amount":1}]`)))
+	assert.Error(t, checkRestorable([]byte(`[{"typeId":"minecraft:dirt"`)))
+	assert.Error(t, checkRestorable([]byte(`{"typeId":"minecraft:dirt"}`)))
+	assert.Error(t, checkRestorable([]byte(`[42]`)))
+	assert.Error(t, checkRestorable([]byte(`[{"amount":1}]`)))
+}
+
+func TestDB_GetFallsBackToReadableEntry(t *testing.T) {
+	db, err := NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, db.Put("alice", []byte(`[{"typeId":"minecraft:diamond","amount":3}]`), "a.example.com"))
+	time.Sleep(time.Millisecond)
+	require.NoError(t, db.Put("alice", []byte(`[{"typeId":"minecraft:dirt","amount":1}]`), "b.example.com"))
+
+	inventory, err := db.Get("alice")
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"typeId":"minecraft:dirt","amount":1}]`, string(inventory))
+
+	// A truncated update and one without item types are skipped
+	time.Sleep(time.Millisecond)
+	require.NoError(t, db.Put("alice", []byte(`[{"typeId":"minecraft:dirt"`), "c.example.com"))
+	time.Sleep(time.Millisecond)
+	require.NoError(t, db.Put("alice", []byte(`[{"amount":5}]`), "c.example.com"))
+
+	inventory, err = db.Get("alice")
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"typeId":"minecraft:dirt","amount":1}]`, string(inventory))
+
+	// History keeps every entry
+	entries, err := db.GetPlayerInventories("alice")
+	require.NoError(t, err)
+	assert.Len(t, entries, 4)
+}
+
+func TestDB_GetWithoutReadableEntry(t *testing.T) {
+	db, err := NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, db.Put("alice", []byte("inv1"), "a.example.com"))
+	time.Sleep(time.Millisecond)
+	require.NoError(t, db.Put("alice", []byte("inv2"), "a.example.com"))
+
+	inventory, err := db.Get("alice")
+	require.NoError(t, err)
+	assert.Equal(t, "inv2", string(inventory))
+}
@@ -0,0 +1,110 @@
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// RevalidationReport lists the stored entries of a player that fail the current validator rules
+type RevalidationReport struct {
+	Player      string             `json:"player"`
+	Checked     int                `json:"checked"`
+	Failing     []RevalidatedEntry `json:"failing"`
+	Quarantined int                `json:"quarantined"`
+}
+
+// RevalidatedEntry is a stored entry failing validation and the errors found in it
+type RevalidatedEntry struct {
+	Server      string            `json:"server"`
+	Timestamp   time.Time         `json:"timestamp"`
+	Errors      []ValidationError `json:"errors"`
+	Quarantined bool              `json:"quarantined"`
+}
+
+// Revalidate runs the item validator over every stored entry of a player, so entries stored
+// before a rule update can be checked without scanning the whole database
+// When dir is not empty failing entries are moved out of the record into files in dir, this only
+// affects this node: peers holding them send them back unless peer entries are verified
+func (db *DB) Revalidate(player, dir string) (*RevalidationReport, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if db.closed {
+		return nil, ErrClosed
+	}
+
+	key := []byte(player)
+	playerInv, err := db.record(key)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &RevalidationReport{Player: player, Checked: len(playerInv.Entries), Failing: []RevalidatedEntry{}}
+	validator, err := newRuleValidator(db.rules)
+	if err != nil {
+		return nil, err
+	}
+	var kept []InventoryEntry
+	for _, entry := range playerInv.Entries {
+		errs := validator.ValidateInventory(entry.Inventory, entry.Server, player)
+		if len(errs) == 0 {
+			kept = append(kept, entry)
+			continue
+		}
+		report.Failing = append(report.Failing, RevalidatedEntry{
+			Server:      entry.Server,
+			Timestamp:   entry.Timestamp,
+			Errors:      errs,
+			Quarantined: dir != "",
+		})
+		if dir != "" {
+			if err := quarantineEntry(dir, player, entry); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if dir == "" || len(report.Failing) == 0 {
+		return report, nil
+	}
+
+	playerInv.Entries = kept
+	data, err := json.Marshal(playerInv)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.leveldb.Put(key, data, nil); err != nil {
+		return nil, err
+	}
+	db.fences.drop(player)
+
+	report.Quarantined = len(report.Failing)
+	logger.Warnf("Audit: quarantined %d inventory entries of %s failing revalidation to %s", report.Quarantined, player, dir)
+	return report, nil
+}
+
+// quarantineEntry writes an entry removed from a record to a file in dir
+func quarantineEntry(dir, player string, entry InventoryEntry) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create quarantine directory: %w", err)
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%s-%d-%s.entry",
+		unsafeFileChars.ReplaceAllString(player, "_"),
+		entry.Timestamp.UnixNano(),
+		unsafeFileChars.ReplaceAllString(entry.Server, "_"))
+	if err := os.WriteFile(filepath.Join(dir, name), data, 0644); err != nil {
+		return fmt.Errorf("failed to quarantine entry of %s: %w", player, err)
+	}
+	return nil
+}
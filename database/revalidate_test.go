@@ -0,0 +1,71 @@
+package database
+
+import (
+	"os"
+	"testing"
+
+	"github.com/d1nch8g/consensuscraft/fixtures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_Revalidate(t *testing.T) {
+	generator := fixtures.New(1, fixtures.Options{})
+	valid := generator.JSON()
+	forged := fixtures.Marshal([]any{generator.Mutated(fixtures.OversizedStack)})
+
+	setup := func(t *testing.T) *DB {
+		db, err := NewMemory()
+		require.NoError(t, err)
+		t.Cleanup(func() { db.Close() })
+
+		require.NoError(t, db.Put("alice", valid, "server1"))
+		require.NoError(t, db.Put("alice", forged, "server1"))
+		return db
+	}
+
+	t.Run("reports failing entries", func(t *testing.T) {
+		db := setup(t)
+
+		report, err := db.Revalidate("alice", "")
+		require.NoError(t, err)
+		assert.Equal(t, 2, report.Checked)
+		require.Len(t, report.Failing, 1)
+		assert.Equal(t, string(fixtures.OversizedStack), report.Failing[0].Errors[0].ErrorType)
+		assert.False(t, report.Failing[0].Quarantined)
+		assert.Zero(t, report.Quarantined)
+
+		entries, err := db.GetPlayerInventories("alice")
+		require.NoError(t, err)
+		assert.Len(t, entries, 2)
+	})
+
+	t.Run("quarantines failing entries", func(t *testing.T) {
+		db := setup(t)
+		dir := t.TempDir()
+
+		report, err := db.Revalidate("alice", dir)
+		require.NoError(t, err)
+		assert.Equal(t, 1, report.Quarantined)
+		assert.True(t, report.Failing[0].Quarantined)
+
+		entries, err := db.GetPlayerInventories("alice")
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.JSONEq(t, string(valid), string(entries[0].Inventory))
+
+		files, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Len(t, files, 1)
+
+		report, err = db.Revalidate("alice", dir)
+		require.NoError(t, err)
+		assert.Empty(t, report.Failing)
+	})
+
+	t.Run("unknown player", func(t *testing.T) {
+		db := setup(t)
+		_, err := db.Revalidate("nobody", "")
+		assert.ErrorIs(t, err, ErrPlayerNotFound)
+	})
+}
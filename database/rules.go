@@ -0,0 +1,194 @@
+package database
+
+import (
+	"fmt"
+	"sync"
+)
+
+// RuleContext describes where the item passed to a ValidatorRule sits
+type RuleContext struct {
+	Server    string // Server the inventory is validated for
+	ItemIndex int    // Top level slot of the item, shared by shulker contents
+	Nested    bool   // The item is inside a shulker box
+}
+
+// ValidatorRule is a custom validation rule a network adds without changing the validator
+type ValidatorRule interface {
+	// Name identifies the rule and is the default ErrorType of its errors
+	Name() string
+	// Apply returns the violations of a single item, including items inside shulker boxes
+	Apply(item *Item, ctx RuleContext) []ValidationError
+}
+
+// InventoryRule is optionally implemented by rules that check the inventory as a whole,
+// such as capping the total amount of an item
+// The items include the contents of shulker boxes, each following the box holding it
+type InventoryRule interface {
+	ValidatorRule
+	ApplyInventory(items []*Item, server string) []ValidationError
+}
+
+var (
+	rulesMu sync.RWMutex
+	rules   []ValidatorRule
+)
+
+// RegisterRule adds a rule to every validator created afterwards by NewItemValidator
+func RegisterRule(rule ValidatorRule) error {
+	rulesMu.Lock()
+	defer rulesMu.Unlock()
+
+	if err := checkRuleName(rules, rule); err != nil {
+		return err
+	}
+	rules = append(rules, rule)
+
+	return nil
+}
+
+// registeredRules returns a copy of the package level rules
+func registeredRules() []ValidatorRule {
+	rulesMu.RLock()
+	defer rulesMu.RUnlock()
+
+	return append([]ValidatorRule(nil), rules...)
+}
+
+// newRuleValidator creates a validator with the registered rules followed by the given ones
+func newRuleValidator(rules []ValidatorRule) (*ItemValidator, error) {
+	v := NewItemValidator()
+	for _, rule := range rules {
+		if err := v.RegisterRule(rule); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+// SetRules sets the rules revalidation applies on top of the registered ones, replacing those set
+// before, so setting them again is harmless and each database of a process keeps its own
+func (db *DB) SetRules(rules []ValidatorRule) error {
+	if _, err := newRuleValidator(rules); err != nil {
+		return err
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.rules = append([]ValidatorRule(nil), rules...)
+	return nil
+}
+
+// SetRules sets the rules the verifier applies on top of the registered ones, replacing those set before
+func (v *PeerVerifier) SetRules(rules []ValidatorRule) error {
+	validator, err := newRuleValidator(rules)
+	if err != nil {
+		return err
+	}
+	v.validator = validator
+	return nil
+}
+
+// SetRules sets the rules dumped payloads are validated with on top of the registered ones,
+// replacing those set before
+func (d *PayloadDumper) SetRules(rules []ValidatorRule) error {
+	validator, err := newRuleValidator(rules)
+	if err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.validator = validator
+	return nil
+}
+
+// RegisterRule adds a rule to this validator only, it must not be called while validating
+func (v *ItemValidator) RegisterRule(rule ValidatorRule) error {
+	if err := checkRuleName(v.rules, rule); err != nil {
+		return err
+	}
+	v.rules = append(v.rules, rule)
+
+	return nil
+}
+
+// Rules returns the names of the custom rules applied by this validator
+func (v *ItemValidator) Rules() []string {
+	names := make([]string, len(v.rules))
+	for i, rule := range v.rules {
+		names[i] = rule.Name()
+	}
+	return names
+}
+
+func checkRuleName(existing []ValidatorRule, rule ValidatorRule) error {
+	if rule == nil || rule.Name() == "" {
+		return fmt.Errorf("rule must have a name")
+	}
+
+	for _, r := range existing {
+		if r.Name() == rule.Name() {
+			return fmt.Errorf("rule %s is already registered", rule.Name())
+		}
+	}
+
+	return nil
+}
+
+// applyRules runs the custom rules against a single item
+func (v *ItemValidator) applyRules(item *Item, ctx RuleContext) []ValidationError {
+	var errors []ValidationError
+	for _, rule := range v.rules {
+		errors = append(errors, ruleErrors(rule, rule.Apply(item, ctx))...)
+	}
+	return errors
+}
+
+// applyInventoryRules runs the inventory wide custom rules against the top level items and,
+// so a cap cannot be dodged by packing items in a shulker box, everything inside them
+func (v *ItemValidator) applyInventoryRules(items []*Item, server string) []ValidationError {
+	var errors []ValidationError
+	var flattened []*Item
+	for _, rule := range v.rules {
+		if inventoryRule, ok := rule.(InventoryRule); ok {
+			if flattened == nil {
+				flattened = flattenItems(items)
+			}
+			errors = append(errors, ruleErrors(rule, inventoryRule.ApplyInventory(flattened, server))...)
+		}
+	}
+	return errors
+}
+
+// flattenItems returns the items followed, after each shulker box, by the items it holds,
+// recursively, contents that are not items are left to the shulker validation
+func flattenItems(items []*Item) []*Item {
+	flattened := make([]*Item, 0, len(items))
+	for _, item := range items {
+		flattened = append(flattened, item)
+		if len(item.ShulkerContents) == 0 {
+			continue
+		}
+
+		var nested []*Item
+		for _, content := range item.ShulkerContents {
+			if fields, ok := content.(map[string]any); ok {
+				contained := &Item{}
+				contained.fromMap(fields)
+				nested = append(nested, contained)
+			}
+		}
+		flattened = append(flattened, flattenItems(nested)...)
+	}
+	return flattened
+}
+
+// ruleErrors defaults the error type of rule violations to the rule name
+func ruleErrors(rule ValidatorRule, errors []ValidationError) []ValidationError {
+	for i := range errors {
+		if errors[i].ErrorType == "" {
+			errors[i].ErrorType = rule.Name()
+		}
+	}
+	return errors
+}
@@ -0,0 +1,186 @@
+package database
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// noRenamedItems rejects items carrying a custom name
+type noRenamedItems struct {
+	seen []RuleContext
+}
+
+func (r *noRenamedItems) Name() string { return "no_renamed_items" }
+
+func (r *noRenamedItems) Apply(item *Item, ctx RuleContext) []ValidationError {
+	r.seen = append(r.seen, ctx)
+	if item.NameTag == "" {
+		return nil
+	}
+	return []ValidationError{{ItemIndex: ctx.ItemIndex, Message: fmt.Sprintf("renamed item %q", item.NameTag)}}
+}
+
+// diamondCap limits the total diamonds in an inventory
+type diamondCap struct {
+	max int
+}
+
+func (r *diamondCap) Name() string { return "diamond_cap" }
+
+func (r *diamondCap) Apply(item *Item, ctx RuleContext) []ValidationError { return nil }
+
+func (r *diamondCap) ApplyInventory(items []*Item, server string) []ValidationError {
+	total := 0
+	for _, item := range items {
+		if item.TypeID == "minecraft:diamond" {
+			total += item.Amount
+		}
+	}
+	if total <= r.max {
+		return nil
+	}
+	return []ValidationError{{ItemIndex: -1, ErrorType: "too_many_diamonds", Message: fmt.Sprintf("%d diamonds exceed cap %d", total, r.max)}}
+}
+
+func TestItemValidator_RegisterRule(t *testing.T) {
+	validator := NewItemValidator()
+	renamed := &noRenamedItems{}
+
+	require.NoError(t, validator.RegisterRule(renamed))
+	require.NoError(t, validator.RegisterRule(&diamondCap{max: 64}))
+	assert.Equal(t, []string{"no_renamed_items", "diamond_cap"}, validator.Rules())
+
+	assert.ErrorContains(t, validator.RegisterRule(&noRenamedItems{}), "already registered")
+	assert.Error(t, validator.RegisterRule(nil))
+
+	inventory := []byte(`[
+		{"typeId":"minecraft:diamond","amount":64,"lore":["Origin: server1"]},
+		{"typeId":"minecraft:shulker_box","amount":1,"lore":["Origin: server1"],"shulkerContents":[
+			{"typeId":"minecraft:diamond_sword","amount":1,"nameTag":"Excalibur","lore":["Origin: server1"]}
+		]},
+		{"typeId":"minecraft:diamond","amount":1,"lore":["Origin: server1"]}
+	]`)
+
+	errors := validator.ValidateInventory(inventory, "server1", "alice")
+	require.Len(t, errors, 2)
+
+	assert.Equal(t, "no_renamed_items", errors[0].ErrorType)
+	assert.Equal(t, 1, errors[0].ItemIndex)
+	assert.Equal(t, "alice", errors[0].Player)
+	assert.Contains(t, errors[0].Message, "Excalibur")
+
+	assert.Equal(t, "too_many_diamonds", errors[1].ErrorType)
+	assert.Equal(t, "server1", errors[1].Server)
+
+	// Rules see shulker contents as nested items of their parent slot
+	require.Len(t, renamed.seen, 4)
+	assert.Equal(t, RuleContext{Server: "server1", ItemIndex: 1, Nested: true}, renamed.seen[2])
+	assert.False(t, renamed.seen[1].Nested)
+}
+
+func TestItemValidator_InventoryRuleSeesShulkerContents(t *testing.T) {
+	validator := NewItemValidator()
+	require.NoError(t, validator.RegisterRule(&diamondCap{max: 64}))
+
+	// 64 loose diamonds and 64 in a shulker box, one of them in a box nested in the box
+	inventory := []byte(`[
+		{"typeId":"minecraft:diamond","amount":64,"lore":["Origin: server1"]},
+		{"typeId":"minecraft:shulker_box","amount":1,"lore":["Origin: server1"],"shulkerContents":[
+			{"typeId":"minecraft:diamond","amount":63,"lore":["Origin: server1"]},
+			null,
+			{"typeId":"minecraft:shulker_box","amount":1,"lore":["Origin: server1"],"shulkerContents":[
+				{"typeId":"minecraft:diamond","amount":1,"lore":["Origin: server1"]}
+			]}
+		]}
+	]`)
+
+	errors := validator.ValidateInventory(inventory, "server1", "alice")
+	require.Len(t, errors, 1)
+	assert.Equal(t, "too_many_diamonds", errors[0].ErrorType)
+	assert.Contains(t, errors[0].Message, "128 diamonds")
+
+	items := flattenItems([]*Item{{TypeID: "minecraft:shulker_box", ShulkerContents: []any{
+		map[string]any{"typeId": "minecraft:shulker_box", "shulkerContents": []any{map[string]any{"typeId": "minecraft:dirt"}}},
+		map[string]any{"typeId": "minecraft:stone"},
+	}}, {TypeID: "minecraft:apple"}})
+	typeIDs := make([]string, len(items))
+	for i, item := range items {
+		typeIDs[i] = item.TypeID
+	}
+	assert.Equal(t, []string{"minecraft:shulker_box", "minecraft:shulker_box", "minecraft:dirt", "minecraft:stone", "minecraft:apple"}, typeIDs)
+}
+
+func TestRegisterRule(t *testing.T) {
+	defer func() {
+		rulesMu.Lock()
+		rules = nil
+		rulesMu.Unlock()
+	}()
+
+	before := NewItemValidator()
+	require.NoError(t, RegisterRule(&noRenamedItems{}))
+	assert.ErrorContains(t, RegisterRule(&noRenamedItems{}), "already registered")
+
+	assert.Empty(t, before.Rules())
+	assert.Equal(t, []string{"no_renamed_items"}, NewItemValidator().Rules())
+}
+
+func TestDB_SetRules(t *testing.T) {
+	db, err := NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+	other, err := NewMemory()
+	require.NoError(t, err)
+	defer other.Close()
+
+	inventory := []byte(`[{"typeId":"minecraft:diamond_sword","amount":1,"nameTag":"Excalibur","lore":["Origin: server1"]}]`)
+	require.NoError(t, db.Put("alice", inventory, "server1"))
+	require.NoError(t, other.Put("alice", inventory, "server1"))
+
+	// Setting the rules again, as a retried startup does, replaces them
+	require.NoError(t, db.SetRules([]ValidatorRule{&noRenamedItems{}}))
+	require.NoError(t, db.SetRules([]ValidatorRule{&noRenamedItems{}}))
+	assert.ErrorContains(t, db.SetRules([]ValidatorRule{&noRenamedItems{}, &noRenamedItems{}}), "already registered")
+
+	report, err := db.Revalidate("alice", "")
+	require.NoError(t, err)
+	require.Len(t, report.Failing, 1)
+	assert.Equal(t, "no_renamed_items", report.Failing[0].Errors[0].ErrorType)
+
+	// Another database of the process does not apply them
+	report, err = other.Revalidate("alice", "")
+	require.NoError(t, err)
+	assert.Empty(t, report.Failing)
+}
+
+func TestPayloadDumper_SetRules(t *testing.T) {
+	dir, otherDir := t.TempDir(), t.TempDir()
+	dumper, err := NewPayloadDumper(dir, 1<<20, 0, false)
+	require.NoError(t, err)
+	other, err := NewPayloadDumper(otherDir, 1<<20, 0, false)
+	require.NoError(t, err)
+
+	// Setting the rules again, as a retried startup does, replaces them
+	require.NoError(t, dumper.SetRules([]ValidatorRule{&noRenamedItems{}}))
+	require.NoError(t, dumper.SetRules([]ValidatorRule{&noRenamedItems{}}))
+	assert.ErrorContains(t, dumper.SetRules([]ValidatorRule{&noRenamedItems{}, &noRenamedItems{}}), "already registered")
+
+	inventory := []byte(`[{"typeId":"minecraft:diamond_sword","amount":1,"nameTag":"Excalibur","lore":["Origin: server1"]}]`)
+	_, err = dumper.Dump("alice", inventory, "server1")
+	require.NoError(t, err)
+	data, err := os.ReadFile(filepath.Join(dir, "alice.log"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "no_renamed_items")
+
+	// Another dumper of the process does not apply them
+	_, err = other.Dump("alice", inventory, "server1")
+	require.NoError(t, err)
+	data, err = os.ReadFile(filepath.Join(otherDir, "alice.log"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "validation=ok")
+}
@@ -0,0 +1,47 @@
+package database
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// SearchPlayers returns up to limit names of players holding entries whose name contains query,
+// case insensitively, sorted by name, shared inventories are left out, limit 0 returns every match
+// Names are read from a consistent snapshot so writes made meanwhile are not blocked
+func (db *DB) SearchPlayers(query string, limit int) ([]string, error) {
+	db.mu.RLock()
+	if db.closed {
+		db.mu.RUnlock()
+		return nil, ErrClosed
+	}
+	snapshot, err := db.leveldb.GetSnapshot()
+	db.mu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	defer snapshot.Release()
+
+	iter := snapshot.NewIterator(nil, nil)
+	defer iter.Release()
+
+	query = strings.ToLower(query)
+	players := []string{}
+	for iter.Next() {
+		player := string(iter.Key())
+		if IsGroupKey(player) || !strings.Contains(strings.ToLower(player), query) {
+			continue
+		}
+
+		var playerInv PlayerInventories
+		if err := json.Unmarshal(iter.Value(), &playerInv); err != nil || len(playerInv.Entries) == 0 {
+			continue
+		}
+
+		players = append(players, player)
+		if limit > 0 && len(players) == limit {
+			break
+		}
+	}
+
+	return players, iter.Error()
+}
@@ -0,0 +1,32 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_SearchPlayers(t *testing.T) {
+	db, err := NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+
+	for _, player := range []string{"Alice", "malice", "bob"} {
+		require.NoError(t, db.Put(player, []byte(`[]`), "a.example.com"))
+	}
+	_, err = db.SetGroupMembers("alliance", []string{"Alice"}, "a.example.com")
+	require.NoError(t, err)
+
+	players, err := db.SearchPlayers("ALI", 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Alice", "malice"}, players)
+
+	players, err = db.SearchPlayers("", 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Alice", "bob"}, players)
+
+	players, err = db.SearchPlayers("carol", 0)
+	require.NoError(t, err)
+	assert.Empty(t, players)
+}
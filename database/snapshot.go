@@ -0,0 +1,100 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+// snapshotBatchBytes bounds the size of a write batch when copying a snapshot
+const snapshotBatchBytes = 4 << 20
+
+var ErrSnapshotExists = errors.New("snapshot target already exists")
+
+// SnapshotReport describes a snapshot written by Snapshot
+type SnapshotReport struct {
+	Path    string    `json:"path"`
+	TakenAt time.Time `json:"taken_at"` // Writes made after this are not in the snapshot
+	Records int       `json:"records"`
+	Bytes   int64     `json:"bytes"` // Size of the copied keys and values
+}
+
+// Snapshot writes a consistent copy of the database to a new leveldb database at path
+// Writes continue while the copy is made, the snapshot holds the state at the time of the call
+// The copy is built next to path and renamed into place, so path never holds a partial snapshot
+func (db *DB) Snapshot(path string) (*SnapshotReport, error) {
+	if _, err := os.Stat(path); err == nil {
+		return nil, fmt.Errorf("%w: %s", ErrSnapshotExists, path)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	db.mu.RLock()
+	if db.closed {
+		db.mu.RUnlock()
+		return nil, ErrClosed
+	}
+	snapshot, err := db.leveldb.GetSnapshot()
+	db.mu.RUnlock()
+	if err != nil {
+		return nil, fmt.Errorf("failed to take snapshot: %w", err)
+	}
+	defer snapshot.Release()
+
+	report := &SnapshotReport{Path: path, TakenAt: time.Now()}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	tmp, err := os.MkdirTemp(filepath.Dir(path), filepath.Base(path)+".tmp-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmp)
+
+	target, err := leveldb.OpenFile(tmp, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create snapshot database: %w", err)
+	}
+
+	iter := snapshot.NewIterator(nil, nil)
+	batch := new(leveldb.Batch)
+	batchBytes := 0
+	for iter.Next() {
+		batch.Put(iter.Key(), iter.Value())
+		size := len(iter.Key()) + len(iter.Value())
+		report.Records++
+		report.Bytes += int64(size)
+
+		if batchBytes += size; batchBytes >= snapshotBatchBytes {
+			if err = target.Write(batch, nil); err != nil {
+				break
+			}
+			batch.Reset()
+			batchBytes = 0
+		}
+	}
+	iter.Release()
+	if err == nil {
+		err = iter.Error()
+	}
+	if err == nil && batch.Len() > 0 {
+		err = target.Write(batch, nil)
+	}
+	if closeErr := target.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy snapshot: %w", err)
+	}
+
+	if err := os.Rename(tmp, path); err != nil {
+		return nil, fmt.Errorf("failed to move snapshot into place: %w", err)
+	}
+
+	return report, nil
+}
@@ -0,0 +1,93 @@
+package database
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_Snapshot(t *testing.T) {
+	db, err := NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+
+	for i := 0; i < 50; i++ {
+		require.NoError(t, db.Put(fmt.Sprintf("player%d", i), []byte(`[{"typeId":"minecraft:diamond","amount":1}]`), "server1"))
+	}
+
+	// Writes keep going while the snapshot is copied
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 50; i++ {
+			assert.NoError(t, db.Put(fmt.Sprintf("late%d", i), []byte(`[]`), "server1"))
+		}
+	}()
+
+	path := filepath.Join(t.TempDir(), "backups", "snapshot.ldb")
+	report, err := db.Snapshot(path)
+	require.NoError(t, err)
+	wg.Wait()
+
+	assert.Equal(t, path, report.Path)
+	assert.GreaterOrEqual(t, report.Records, 50)
+	assert.Positive(t, report.Bytes)
+
+	copied, err := New(path)
+	require.NoError(t, err)
+	defer copied.Close()
+
+	inventory, err := copied.Get("player7")
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"typeId":"minecraft:diamond","amount":1}]`, string(inventory))
+
+	// The copy holds exactly the records present when the snapshot was taken
+	records := 0
+	for range copied.StreamAll() {
+		records++
+	}
+	assert.Equal(t, report.Records, records)
+
+	// No temporary directories are left next to the snapshot
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestDB_SnapshotExcludesLaterWrites(t *testing.T) {
+	db, err := NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, db.Put("alice", []byte(`[]`), "server1"))
+
+	path := filepath.Join(t.TempDir(), "snapshot.ldb")
+	_, err = db.Snapshot(path)
+	require.NoError(t, err)
+	require.NoError(t, db.Put("bob", []byte(`[]`), "server1"))
+
+	copied, err := New(path)
+	require.NoError(t, err)
+	defer copied.Close()
+
+	_, err = copied.Get("bob")
+	assert.ErrorIs(t, err, ErrPlayerNotFound)
+}
+
+func TestDB_SnapshotErrors(t *testing.T) {
+	db, err := NewMemory()
+	require.NoError(t, err)
+
+	existing := t.TempDir()
+	_, err = db.Snapshot(existing)
+	assert.ErrorIs(t, err, ErrSnapshotExists)
+
+	require.NoError(t, db.Close())
+	_, err = db.Snapshot(filepath.Join(t.TempDir(), "snapshot.ldb"))
+	assert.ErrorIs(t, err, ErrClosed)
+}
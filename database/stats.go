@@ -0,0 +1,159 @@
+package database
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+// OriginStats aggregates the updates received from one origin server
+type OriginStats struct {
+	Server     string         `json:"server"`
+	Accepted   int            `json:"accepted"`
+	Rejected   map[string]int `json:"rejected,omitempty"`   // Rejected updates by reason
+	Conflicts  int            `json:"conflicts"`            // Entries received with the same timestamp but different contents
+	Duplicates int            `json:"duplicates,omitempty"` // Updates received again with an update ID stored before
+	Stripped   int            `json:"stripped,omitempty"`   // Invalid items removed from stored peer entries
+	LastSeen   time.Time      `json:"last_seen"`
+}
+
+// RejectedTotal sums rejected updates over all reasons
+func (s OriginStats) RejectedTotal() int {
+	total := 0
+	for _, count := range s.Rejected {
+		total += count
+	}
+	return total
+}
+
+// RejectionError is returned by filters to reject an inventory for a named reason
+type RejectionError struct {
+	Reason  string
+	Message string
+}
+
+func (e *RejectionError) Error() string {
+	return ErrInventoryRejected.Error() + ": " + e.Message
+}
+
+// Unwrap makes rejections match ErrInventoryRejected
+func (e *RejectionError) Unwrap() error {
+	return ErrInventoryRejected
+}
+
+// rejectionReason returns the reason of a rejection error, or "rejected" when it has none
+func rejectionReason(err error) string {
+	var rejection *RejectionError
+	if errors.As(err, &rejection) && rejection.Reason != "" {
+		return rejection.Reason
+	}
+	return "rejected"
+}
+
+// originStats holds per origin server counters, updated incrementally as updates arrive
+type originStats struct {
+	mu       sync.Mutex
+	servers  map[string]*OriginStats
+	rejected func(server, reason, message string) // Set with OnRejected
+}
+
+// get returns the counters of a server, creating them on first use, s.mu must be held
+func (s *originStats) get(server string) *OriginStats {
+	if s.servers == nil {
+		s.servers = make(map[string]*OriginStats)
+	}
+
+	stats, ok := s.servers[server]
+	if !ok {
+		stats = &OriginStats{Server: server, Rejected: make(map[string]int)}
+		s.servers[server] = stats
+	}
+	stats.LastSeen = time.Now()
+	return stats
+}
+
+func (s *originStats) accepted(server string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.get(server).Accepted++
+}
+
+func (s *originStats) reject(server, reason, message string) {
+	s.mu.Lock()
+	s.get(server).Rejected[reason]++
+	notify := s.rejected
+	s.mu.Unlock()
+
+	if notify != nil {
+		notify(server, reason, message)
+	}
+}
+
+func (s *originStats) conflict(server string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.get(server).Conflicts++
+}
+
+func (s *originStats) duplicate(server string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.get(server).Duplicates++
+}
+
+func (s *originStats) stripped(server string, items int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.get(server).Stripped += items
+}
+
+// conflicting reports whether a received entry really differs from the stored one with the
+// same server and timestamp, the stored copy may have been stripped by the filter or the peer verifier
+// and its legacy origin lines normalized, db.mu must be held
+func (db *DB) conflicting(stored []byte, received InventoryEntry) bool {
+	if db.verifier != nil && db.verifier.policy == InvalidStrip {
+		if verified, _, err := db.verifier.check(received); err == nil {
+			received = verified
+		}
+	}
+	normalized, _ := normalizeOriginLore(received.Inventory)
+	filtered, err := db.applyFilter(normalized, received.Server)
+	return err != nil || !bytes.Equal(stored, filtered)
+}
+
+// RecordRejected counts an update from server that was refused before reaching the database,
+// such as one with an invalid signature
+func (db *DB) RecordRejected(server, reason string) {
+	db.stats.reject(server, reason, "")
+}
+
+// OnRejected calls notify with the origin server, reason and message of every rejected update as it
+// is counted, notify may run with the database locked and must not use it
+func (db *DB) OnRejected(notify func(server, reason, message string)) {
+	db.stats.mu.Lock()
+	defer db.stats.mu.Unlock()
+	db.stats.rejected = notify
+}
+
+// OriginStats returns the counters of every origin server seen, sorted by server
+func (db *DB) OriginStats() []OriginStats {
+	db.stats.mu.Lock()
+	defer db.stats.mu.Unlock()
+
+	stats := make([]OriginStats, 0, len(db.stats.servers))
+	for _, s := range db.stats.servers {
+		copied := *s
+		copied.Rejected = make(map[string]int, len(s.Rejected))
+		for reason, count := range s.Rejected {
+			copied.Rejected[reason] = count
+		}
+		stats = append(stats, copied)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Server < stats[j].Server
+	})
+
+	return stats
+}
@@ -0,0 +1,83 @@
+package database
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_OriginStats(t *testing.T) {
+	db, err := NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+
+	rule, err := NewNamespaceRule([]string{"minecraft"})
+	require.NoError(t, err)
+	db.SetFilter(rule.Filter(false))
+
+	require.NoError(t, db.Put("alice", []byte(`[{"typeId":"minecraft:dirt","amount":1}]`), "a.example.com"))
+	require.NoError(t, db.Put("bob", []byte(`[]`), "a.example.com"))
+	assert.ErrorIs(t, db.Put("alice", []byte(`[{"typeId":"mymod:ruby","amount":1}]`), "b.example.com"), ErrInventoryRejected)
+	db.RecordRejected("b.example.com", "signature")
+
+	// The same entry received twice is not a conflict, different contents under the same timestamp are
+	at := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	merge := func(inventory string) {
+		value, err := json.Marshal(PlayerInventories{Entries: []InventoryEntry{{Inventory: []byte(inventory), Server: "c.example.com", Timestamp: at}}})
+		require.NoError(t, err)
+		_, err = db.Merge([]byte("carol"), value)
+		require.NoError(t, err)
+	}
+	merge(`[]`)
+	merge(`[]`)
+	merge(`[{"typeId":"minecraft:diamond","amount":64}]`)
+
+	stats := db.OriginStats()
+	require.Len(t, stats, 3)
+
+	assert.Equal(t, "a.example.com", stats[0].Server)
+	assert.Equal(t, 2, stats[0].Accepted)
+	assert.Zero(t, stats[0].RejectedTotal())
+
+	assert.Equal(t, "b.example.com", stats[1].Server)
+	assert.Zero(t, stats[1].Accepted)
+	assert.Equal(t, map[string]int{"item_namespace": 1, "signature": 1}, stats[1].Rejected)
+	assert.Equal(t, 2, stats[1].RejectedTotal())
+
+	assert.Equal(t, "c.example.com", stats[2].Server)
+	assert.Equal(t, 1, stats[2].Accepted)
+	assert.Equal(t, 1, stats[2].Conflicts)
+	assert.False(t, stats[2].LastSeen.IsZero())
+
+	// Returned stats are copies
+	stats[1].Rejected["signature"] = 100
+	assert.Equal(t, 1, db.OriginStats()[1].Rejected["signature"])
+}
+
+func TestDB_OnRejected(t *testing.T) {
+	db, err := NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+
+	rule, err := NewNamespaceRule([]string{"minecraft"})
+	require.NoError(t, err)
+	db.SetFilter(rule.Filter(false))
+
+	var rejections [][3]string
+	db.OnRejected(func(server, reason, message string) {
+		rejections = append(rejections, [3]string{server, reason, message})
+	})
+
+	require.NoError(t, db.Put("alice", []byte(`[{"typeId":"minecraft:dirt","amount":1}]`), "a.example.com"))
+	assert.ErrorIs(t, db.Put("alice", []byte(`[{"typeId":"mymod:ruby","amount":1}]`), "b.example.com"), ErrInventoryRejected)
+	db.RecordRejected("c.example.com", "signature")
+
+	require.Len(t, rejections, 2)
+	assert.Equal(t, "b.example.com", rejections[0][0])
+	assert.Equal(t, "item_namespace", rejections[0][1])
+	assert.Contains(t, rejections[0][2], "outside the allowed namespaces")
+	assert.Equal(t, [3]string{"c.example.com", "signature", ""}, rejections[1])
+}
@@ -0,0 +1,105 @@
+package database
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// Tombstone marks the deletion of a player's entries, it replicates with the record so a peer
+// that missed the deletion cannot bring the deleted entries back
+type Tombstone struct {
+	At        time.Time `json:"at"`         // Entries up to At are deleted, newer ones are kept
+	DeletedAt time.Time `json:"deleted_at"` // When the deletion was made, the grace period starts here
+	By        string    `json:"by,omitempty"`
+	Reason    string    `json:"reason,omitempty"`
+}
+
+// covers reports whether an entry was deleted by the tombstone
+func (t *Tombstone) covers(entry InventoryEntry) bool {
+	return t != nil && !entry.Timestamp.After(t.At)
+}
+
+// newerTombstone returns the tombstone deleting more entries, a when both are equal
+func newerTombstone(a, b *Tombstone) *Tombstone {
+	if a == nil || (b != nil && b.At.After(a.At)) {
+		return b
+	}
+	return a
+}
+
+// uncovered returns the entries the tombstone does not delete
+func (t *Tombstone) uncovered(entries []InventoryEntry) []InventoryEntry {
+	if t == nil {
+		return entries
+	}
+
+	var kept []InventoryEntry
+	for _, entry := range entries {
+		if !t.covers(entry) {
+			kept = append(kept, entry)
+		}
+	}
+	return kept
+}
+
+// Tombstone returns the tombstone of a player record, nil when none of its entries were deleted
+func (db *DB) Tombstone(player string) (*Tombstone, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	if db.closed {
+		return nil, ErrClosed
+	}
+
+	playerInv, err := db.record([]byte(player))
+	if err != nil {
+		return nil, err
+	}
+	return playerInv.Deleted, nil
+}
+
+// CollectTombstones forgets tombstones of deletions made before the given time, removing the
+// records left without entries, and returns how many tombstones were collected
+// Peers that stayed offline longer than the grace period can bring deleted entries back
+func (db *DB) CollectTombstones(before time.Time) (int, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if db.closed {
+		return 0, ErrClosed
+	}
+
+	iter := db.leveldb.NewIterator(util.BytesPrefix(nil), nil)
+	defer iter.Release()
+
+	collected := 0
+	for iter.Next() {
+		var playerInv PlayerInventories
+		if err := json.Unmarshal(iter.Value(), &playerInv); err != nil {
+			continue // Skip corrupted entries
+		}
+		if playerInv.Deleted == nil || !playerInv.Deleted.DeletedAt.Before(before) {
+			continue
+		}
+
+		if len(playerInv.Entries) == 0 && len(playerInv.Archives) == 0 {
+			if err := db.leveldb.Delete(iter.Key(), nil); err != nil {
+				return collected, err
+			}
+		} else {
+			playerInv.Deleted = nil
+			data, err := json.Marshal(playerInv)
+			if err != nil {
+				return collected, err
+			}
+			if err := db.leveldb.Put(iter.Key(), data, nil); err != nil {
+				return collected, err
+			}
+		}
+		collected++
+	}
+
+	return collected, iter.Error()
+}
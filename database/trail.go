@@ -0,0 +1,62 @@
+package database
+
+import (
+	"sort"
+	"time"
+)
+
+// TrailStop is a stretch of consecutive entries of a player recorded by the same server
+type TrailStop struct {
+	Server    string    `json:"server"`
+	FirstSeen time.Time `json:"first_seen"`
+	LastSeen  time.Time `json:"last_seen"`
+	Entries   int       `json:"entries"`
+}
+
+// PlayerTrail is the servers a player appeared on in order, for tracing how items travelled
+type PlayerTrail struct {
+	Player     string      `json:"player"`
+	LastServer string      `json:"last_server"`
+	LastSeen   time.Time   `json:"last_seen"`
+	Stops      []TrailStop `json:"stops"` // Oldest first
+}
+
+// PlayerTrail derives the migration trail of a player from their entries, including the ones
+// archived to the cold store, each move to another server starts a new stop
+func (db *DB) PlayerTrail(player string) (*PlayerTrail, error) {
+	entries, err := db.GetPlayerInventories(player)
+	if err != nil {
+		return nil, err
+	}
+
+	// Oldest first, timestamp ties ordered by server as in the history
+	sort.SliceStable(entries, func(i, j int) bool {
+		if !entries[i].Timestamp.Equal(entries[j].Timestamp) {
+			return entries[i].Timestamp.Before(entries[j].Timestamp)
+		}
+		return entries[i].Server < entries[j].Server
+	})
+
+	trail := &PlayerTrail{Player: player, Stops: []TrailStop{}}
+	for _, entry := range entries {
+		last := len(trail.Stops) - 1
+		if last >= 0 && trail.Stops[last].Server == entry.Server {
+			trail.Stops[last].LastSeen = entry.Timestamp
+			trail.Stops[last].Entries++
+			continue
+		}
+		trail.Stops = append(trail.Stops, TrailStop{
+			Server:    entry.Server,
+			FirstSeen: entry.Timestamp,
+			LastSeen:  entry.Timestamp,
+			Entries:   1,
+		})
+	}
+
+	if len(trail.Stops) > 0 {
+		last := trail.Stops[len(trail.Stops)-1]
+		trail.LastServer = last.Server
+		trail.LastSeen = last.LastSeen
+	}
+	return trail, nil
+}
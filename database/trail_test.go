@@ -0,0 +1,48 @@
+package database
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_PlayerTrail(t *testing.T) {
+	db, err := NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	var record PlayerInventories
+	for i, server := range []string{"a.example.com", "a.example.com", "b.example.com", "a.example.com", "c.example.com", "c.example.com"} {
+		record.Entries = append(record.Entries, InventoryEntry{
+			Inventory: []byte(`[]`),
+			Server:    server,
+			Timestamp: base.Add(time.Duration(i) * time.Minute),
+		})
+	}
+	value, err := json.Marshal(record)
+	require.NoError(t, err)
+	_, err = db.Merge([]byte("alice"), value)
+	require.NoError(t, err)
+
+	trail, err := db.PlayerTrail("alice")
+	require.NoError(t, err)
+	assert.Equal(t, "c.example.com", trail.LastServer)
+	assert.True(t, trail.LastSeen.Equal(base.Add(5*time.Minute)))
+
+	require.Len(t, trail.Stops, 4)
+	var servers []string
+	for _, stop := range trail.Stops {
+		servers = append(servers, stop.Server)
+	}
+	assert.Equal(t, []string{"a.example.com", "b.example.com", "a.example.com", "c.example.com"}, servers)
+	assert.Equal(t, 2, trail.Stops[0].Entries)
+	assert.True(t, trail.Stops[0].FirstSeen.Equal(base))
+	assert.True(t, trail.Stops[0].LastSeen.Equal(base.Add(time.Minute)))
+
+	_, err = db.PlayerTrail("nobody")
+	assert.ErrorIs(t, err, ErrPlayerNotFound)
+}
@@ -0,0 +1,21 @@
+package database
+
+// updateKey identifies an update by its origin server and the ID that server gave it
+type updateKey struct {
+	server string
+	id     string
+}
+
+// hasUpdate reports whether the record holds the update id from server, never for an empty id
+// Archived entries are not searched, retries come long before entries are archived
+func (p *PlayerInventories) hasUpdate(server, id string) bool {
+	if id == "" {
+		return false
+	}
+	for _, entry := range p.Entries {
+		if entry.UpdateID == id && entry.Server == server {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+package database
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_PutWithID(t *testing.T) {
+	db, err := NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+
+	inventory := []byte(`[{"typeId":"minecraft:diamond","amount":1}]`)
+	require.NoError(t, db.PutWithID("alice", inventory, "a.example.com", nil, "run1:1"))
+	require.NoError(t, db.PutWithID("alice", inventory, "a.example.com", nil, "run1:1"), "retry")
+	require.NoError(t, db.PutWithID("alice", inventory, "b.example.com", nil, "run1:1"), "same ID from another origin")
+	require.NoError(t, db.PutWithID("alice", inventory, "a.example.com", nil, ""))
+	require.NoError(t, db.PutWithID("alice", inventory, "a.example.com", nil, ""))
+
+	entries, err := db.GetPlayerInventories("alice")
+	require.NoError(t, err)
+	assert.Len(t, entries, 4)
+
+	latest, err := db.Latest("alice")
+	require.NoError(t, err)
+	assert.JSONEq(t, string(inventory), string(latest.Inventory))
+
+	for _, stats := range db.OriginStats() {
+		if stats.Server == "a.example.com" {
+			assert.Equal(t, 1, stats.Duplicates)
+			assert.Equal(t, 3, stats.Accepted)
+		}
+	}
+}
+
+func TestDB_MergeDeduplicatesUpdateIDs(t *testing.T) {
+	db, err := NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+
+	inventory := []byte(`[]`)
+	require.NoError(t, db.PutWithID("alice", inventory, "a.example.com", nil, "run1:1"))
+
+	// A peer stored the same update at another time
+	now := time.Now()
+	remote, err := json.Marshal(PlayerInventories{Entries: []InventoryEntry{
+		{Inventory: inventory, Server: "a.example.com", Timestamp: now.Add(time.Second), UpdateID: "run1:1"},
+		{Inventory: inventory, Server: "a.example.com", Timestamp: now.Add(2 * time.Second), UpdateID: "run1:2"},
+		{Inventory: inventory, Server: "a.example.com", Timestamp: now.Add(3 * time.Second), UpdateID: "run1:2"},
+	}})
+	require.NoError(t, err)
+
+	merged, err := db.Merge([]byte("alice"), remote)
+	require.NoError(t, err)
+	assert.True(t, merged)
+
+	entries, err := db.GetPlayerInventories("alice")
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "run1:2", entries[0].UpdateID)
+	assert.Equal(t, "run1:1", entries[1].UpdateID)
+
+	merged, err = db.Merge([]byte("alice"), remote)
+	require.NoError(t, err)
+	assert.False(t, merged)
+}
@@ -0,0 +1,102 @@
+package database
+
+import (
+	"sync"
+	"time"
+)
+
+// uptimeWindows bounds the online windows remembered per server
+const uptimeWindows = 1000
+
+// uptimeWindow is a span of time a server was continuously seen online
+type uptimeWindow struct {
+	From time.Time
+	To   time.Time
+}
+
+// UptimeStatus is what the uptime record tells about a server at a given time
+type UptimeStatus int
+
+const (
+	UptimeUnknown UptimeStatus = iota // Never seen or not observed then, the server can not be verified
+	UptimeOnline                      // Seen online around that time
+	UptimeOffline                     // Silent then while this node kept seeing other servers
+)
+
+// Uptime records when peer servers were seen online, from the handshakes this node made with them
+// Sightings closer than the tolerance belong to the same online window
+type Uptime struct {
+	mu        sync.RWMutex
+	self      string
+	tolerance time.Duration
+	servers   map[string][]uptimeWindow
+}
+
+// NewUptime creates an empty uptime record, self is this node's web address and always online,
+// tolerance should exceed the peer retry interval
+func NewUptime(self string, tolerance time.Duration) *Uptime {
+	return &Uptime{
+		self:      self,
+		tolerance: tolerance,
+		servers:   make(map[string][]uptimeWindow),
+	}
+}
+
+// Seen records that a server was online at the given time
+func (u *Uptime) Seen(server string, at time.Time) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	windows := u.servers[server]
+	if last := len(windows) - 1; last >= 0 && !at.After(windows[last].To.Add(u.tolerance)) {
+		if at.After(windows[last].To) {
+			windows[last].To = at
+		}
+		return
+	}
+
+	windows = append(windows, uptimeWindow{From: at, To: at})
+	if len(windows) > uptimeWindows {
+		windows = windows[len(windows)-uptimeWindows:]
+	}
+	u.servers[server] = windows
+}
+
+// Status reports whether a server was online at the given time: seen within the tolerance of a
+// sighting, or offline when it fell silent before and came back after while this node kept seeing
+// other servers, anything else, including servers never seen, is unknown and can not be verified
+func (u *Uptime) Status(server string, at time.Time) UptimeStatus {
+	if server == u.self {
+		return UptimeOnline
+	}
+
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	windows := u.servers[server]
+	for i, window := range windows {
+		if !at.Before(window.From.Add(-u.tolerance)) && !at.After(window.To.Add(u.tolerance)) {
+			return UptimeOnline
+		}
+		if i > 0 && at.After(windows[i-1].To.Add(u.tolerance)) && at.Before(window.From.Add(-u.tolerance)) && u.connected(server, at) {
+			return UptimeOffline
+		}
+	}
+	return UptimeUnknown
+}
+
+// connected reports whether a server other than the given one was seen around a time, so this
+// node itself was online and reachable then, u.mu must be held
+func (u *Uptime) connected(except string, at time.Time) bool {
+	for server, windows := range u.servers {
+		if server == except {
+			continue
+		}
+		for _, window := range windows {
+			if !at.Before(window.From.Add(-u.tolerance)) && !at.After(window.To.Add(u.tolerance)) {
+				return true
+			}
+		}
+	}
+	return false
+}
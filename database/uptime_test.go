@@ -0,0 +1,37 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUptime_Status(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time { return start.Add(time.Duration(minutes) * time.Minute) }
+
+	uptime := NewUptime("self.example.com", 5*time.Minute)
+	for minute := 0; minute <= 60; minute += 3 {
+		uptime.Seen("witness.example.com", at(minute))
+	}
+	uptime.Seen("a.example.com", at(0))
+	uptime.Seen("a.example.com", at(4))
+	uptime.Seen("a.example.com", at(40))
+	uptime.Seen("a.example.com", at(42))
+
+	assert.Equal(t, UptimeOnline, uptime.Status("a.example.com", at(2)), "within a window")
+	assert.Equal(t, UptimeOnline, uptime.Status("a.example.com", at(8)), "within the tolerance")
+	assert.Equal(t, UptimeOffline, uptime.Status("a.example.com", at(20)), "between windows")
+	assert.Equal(t, UptimeUnknown, uptime.Status("a.example.com", at(-30)), "before the first sighting")
+	assert.Equal(t, UptimeUnknown, uptime.Status("a.example.com", at(90)), "after the last sighting")
+	assert.Equal(t, UptimeUnknown, uptime.Status("unknown.example.com", at(20)), "never seen")
+	assert.Equal(t, UptimeOnline, uptime.Status("self.example.com", at(-30)), "this node")
+
+	t.Run("without other servers this node may have been offline itself", func(t *testing.T) {
+		alone := NewUptime("self.example.com", 5*time.Minute)
+		alone.Seen("a.example.com", at(0))
+		alone.Seen("a.example.com", at(40))
+		assert.Equal(t, UptimeUnknown, alone.Status("a.example.com", at(20)))
+	})
+}
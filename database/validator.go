@@ -3,8 +3,7 @@ package database
 import (
 	"encoding/json"
 	"fmt"
-	"regexp"
-	"strings"
+	"slices"
 )
 
 // Minecraft item validation constants and maps
@@ -198,11 +197,13 @@ var (
 )
 
 // ItemValidator provides validation functionality for Minecraft items
-type ItemValidator struct{}
+type ItemValidator struct {
+	rules []ValidatorRule
+}
 
-// NewItemValidator creates a new item validator
+// NewItemValidator creates a new item validator with the rules registered through RegisterRule
 func NewItemValidator() *ItemValidator {
-	return &ItemValidator{}
+	return &ItemValidator{rules: registeredRules()}
 }
 
 // ValidateInventory validates an entire inventory for a specific server
@@ -219,26 +220,17 @@ func (v *ItemValidator) ValidateInventory(inventoryData []byte, server, player s
 	}
 
 	var allErrors []ValidationError
+
+	// Slots are read from the decoded inventory directly, one slab holds the items kept for inventory rules
+	slab := make([]Item, len(inventory))
+	items := make([]*Item, 0, len(inventory))
 	for i, slot := range inventory {
 		if slot == nil {
 			continue
 		}
 
-		// Try to parse as Item
-		slotBytes, err := json.Marshal(slot)
-		if err != nil {
-			allErrors = append(allErrors, ValidationError{
-				Player:    player,
-				Server:    server,
-				ItemIndex: i,
-				ErrorType: "invalid_item_data",
-				Message:   "Item contains invalid data",
-			})
-			continue
-		}
-
-		var item Item
-		if err := json.Unmarshal(slotBytes, &item); err != nil {
+		fields, ok := slot.(map[string]any)
+		if !ok {
 			allErrors = append(allErrors, ValidationError{
 				Player:    player,
 				Server:    server,
@@ -250,7 +242,10 @@ func (v *ItemValidator) ValidateInventory(inventoryData []byte, server, player s
 		}
 
 		// Validate the item
-		itemErrors := v.ValidateItem(&item, server, i)
+		item := &slab[i]
+		item.fromMap(fields)
+		items = append(items, item)
+		itemErrors := v.ValidateItem(item, server, i)
 		for _, itemError := range itemErrors {
 			itemError.Player = player
 			itemError.Server = server
@@ -258,11 +253,23 @@ func (v *ItemValidator) ValidateInventory(inventoryData []byte, server, player s
 		}
 	}
 
+	// Apply inventory wide custom rules
+	for _, ruleError := range v.applyInventoryRules(items, server) {
+		ruleError.Player = player
+		ruleError.Server = server
+		allErrors = append(allErrors, ruleError)
+	}
+
 	return allErrors
 }
 
 // ValidateItem performs comprehensive validation on a Minecraft item
 func (v *ItemValidator) ValidateItem(item *Item, server string, itemIndex int) []ValidationError {
+	return v.validateItem(item, server, itemIndex, false)
+}
+
+// validateItem validates an item, nested is set for items inside shulker boxes
+func (v *ItemValidator) validateItem(item *Item, server string, itemIndex int, nested bool) []ValidationError {
 	var errors []ValidationError
 
 	// Validate item type
@@ -312,6 +319,9 @@ func (v *ItemValidator) ValidateItem(item *Item, server string, itemIndex int) [
 	originErrors := v.validateOrigin(item.Lore, server, itemIndex)
 	errors = append(errors, originErrors...)
 
+	// Apply custom rules
+	errors = append(errors, v.applyRules(item, RuleContext{Server: server, ItemIndex: itemIndex, Nested: nested})...)
+
 	// Recursively validate shulker contents
 	if len(item.ShulkerContents) > 0 {
 		shulkerErrors := v.validateShulkerContents(item.ShulkerContents, server, itemIndex)
@@ -324,7 +334,8 @@ func (v *ItemValidator) ValidateItem(item *Item, server string, itemIndex int) [
 // validateEnchantments validates enchantment combinations and levels
 func (v *ItemValidator) validateEnchantments(enchantments []map[string]any, itemIndex int) []ValidationError {
 	var errors []ValidationError
-	seenEnchantments := make(map[string]int)
+	var seenBuffer [8]string
+	seenEnchantments := seenBuffer[:0] // Items carry a handful of enchantments, a slice beats a map
 
 	for enchIdx, enchant := range enchantments {
 		enchType, hasType := enchant["type"].(string)
@@ -371,19 +382,19 @@ func (v *ItemValidator) validateEnchantments(enchantments []map[string]any, item
 		}
 
 		// Check for duplicates
-		if _, exists := seenEnchantments[enchType]; exists {
+		if slices.Contains(seenEnchantments, enchType) {
 			errors = append(errors, ValidationError{
 				ItemIndex: itemIndex,
 				ErrorType: "duplicate_enchantment",
 				Message:   fmt.Sprintf("Duplicate enchantment: %s", enchType),
 			})
 		}
-		seenEnchantments[enchType] = level
+		seenEnchantments = append(seenEnchantments, enchType)
 
 		// Check incompatible enchantments
 		if incompatible, exists := incompatibleEnchantments[enchType]; exists {
 			for _, incompatibleEnch := range incompatible {
-				if _, hasIncompatible := seenEnchantments[incompatibleEnch]; hasIncompatible {
+				if slices.Contains(seenEnchantments, incompatibleEnch) {
 					errors = append(errors, ValidationError{
 						ItemIndex: itemIndex,
 						ErrorType: "incompatible_enchantments",
@@ -477,18 +488,7 @@ func (v *ItemValidator) validateDurability(durability map[string]any, itemType s
 func (v *ItemValidator) validateOrigin(lore []string, server string, itemIndex int) []ValidationError {
 	var errors []ValidationError
 	
-	// Simple origin pattern: "Origin: server"
-	originPattern := regexp.MustCompile(`^Origin:\s+(.+)$`)
-	hasOrigin := false
-	var originServer string
-
-	for _, line := range lore {
-		if matches := originPattern.FindStringSubmatch(line); len(matches) == 2 {
-			hasOrigin = true
-			originServer = strings.TrimSpace(matches[1])
-			break
-		}
-	}
+	originServer, hasOrigin := CurrentOriginFormat().Origin(lore)
 
 	if !hasOrigin {
 		errors = append(errors, ValidationError{
@@ -511,24 +511,15 @@ func (v *ItemValidator) validateOrigin(lore []string, server string, itemIndex i
 func (v *ItemValidator) validateShulkerContents(contents []any, server string, parentIndex int) []ValidationError {
 	var errors []ValidationError
 
+	// One item is reused for every slot, rules must not keep the items they are given
+	var item Item
 	for i, content := range contents {
 		if content == nil {
 			continue
 		}
 
-		// Try to parse as Item
-		contentBytes, err := json.Marshal(content)
-		if err != nil {
-			errors = append(errors, ValidationError{
-				ItemIndex: parentIndex,
-				ErrorType: "invalid_shulker_content",
-				Message:   fmt.Sprintf("Shulker slot %d contains invalid data", i),
-			})
-			continue
-		}
-
-		var item Item
-		if err := json.Unmarshal(contentBytes, &item); err != nil {
+		fields, ok := content.(map[string]any)
+		if !ok {
 			errors = append(errors, ValidationError{
 				ItemIndex: parentIndex,
 				ErrorType: "invalid_shulker_content",
@@ -536,9 +527,11 @@ func (v *ItemValidator) validateShulkerContents(contents []any, server string, p
 			})
 			continue
 		}
+		item = Item{}
+		item.fromMap(fields)
 
 		// Validate the nested item
-		itemErrors := v.ValidateItem(&item, server, parentIndex)
+		itemErrors := v.validateItem(&item, server, parentIndex, true)
 		for _, itemError := range itemErrors {
 			itemError.Message = fmt.Sprintf("Shulker slot %d: %s", i, itemError.Message)
 			errors = append(errors, itemError)
@@ -551,40 +544,16 @@ func (v *ItemValidator) validateShulkerContents(contents []any, server string, p
 // AddOriginToItem adds origin lore to an item if it doesn't have one
 func (v *ItemValidator) AddOriginToItem(item *Item, server string) bool {
 	// Check if item already has any origin
-	originPattern := regexp.MustCompile(`^Origin:\s+(.+)$`)
-	hasOrigin := false
-
-	for _, line := range item.Lore {
-		if originPattern.MatchString(line) {
-			hasOrigin = true
-			break
-		}
-	}
-
-	if !hasOrigin {
-		originLine := fmt.Sprintf("Origin: %s", server)
-		item.Lore = append(item.Lore, originLine)
-		return true
+	format := CurrentOriginFormat()
+	if _, hasOrigin := format.Origin(item.Lore); hasOrigin {
+		return false
 	}
 
-	return false
+	item.Lore = append(item.Lore, format.Format(server))
+	return true
 }
 
 // HasOriginFromServer checks if an item originates from a specific server
 func (v *ItemValidator) HasOriginFromServer(item *Item, server string) bool {
-	if len(item.Lore) == 0 {
-		return false
-	}
-
-	// Simple origin pattern: "Origin: server"
-	originPattern := regexp.MustCompile(`^Origin:\s+(.+)$`)
-	for _, lore := range item.Lore {
-		if matches := originPattern.FindStringSubmatch(lore); len(matches) == 2 {
-			originServer := strings.TrimSpace(matches[1])
-			if originServer == server {
-				return true
-			}
-		}
-	}
-	return false
+	return item.hasOriginFromServer(server)
 }
@@ -1,8 +1,10 @@
 package database
 
 import (
+	"encoding/json"
 	"testing"
 
+	"github.com/d1nch8g/consensuscraft/fixtures"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -937,6 +939,56 @@ func TestFutureProofing(t *testing.T) {
 	})
 }
 
+func TestItemValidator_ValidateItem_NoAllocations(t *testing.T) {
+	validator := NewItemValidator()
+	item := Item{
+		TypeID: "minecraft:diamond_sword",
+		Amount: 1,
+		Lore:   []string{"Origin: server1"},
+		Enchantments: []map[string]any{
+			{"type": "minecraft:sharpness", "level": 5},
+			{"type": "minecraft:unbreaking", "level": 3},
+		},
+		Durability: map[string]any{"damage": 100, "maxDurability": 1561},
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		validator.ValidateItem(&item, "server1", 0)
+	})
+	assert.Zero(t, allocs)
+}
+
+func TestItemValidator_ValidateInventory_NestedShulkers(t *testing.T) {
+	validator := NewItemValidator()
+
+	assert.Empty(t, validator.ValidateInventory(nestedShulkerInventory("server1"), "server1", "player1"))
+
+	// Every nested item reports its own wrong origin, prefixed with its shulker slot
+	errors := validator.ValidateInventory(nestedShulkerInventory("server2"), "server1", "player1")
+	assert.Len(t, errors, 27*(1+1+27+26))
+	assert.Equal(t, "Shulker slot 0: Shulker slot 0: Item origin 'server2' doesn't match server 'server1'", errors[2].Message)
+}
+
+func TestItemValidator_ValidateInventory_Generated(t *testing.T) {
+	validator := NewItemValidator()
+
+	for seed := int64(0); seed < 200; seed++ {
+		generator := fixtures.New(seed, fixtures.Options{Shulkers: 0.1, ShulkerDepth: 1})
+		assert.Empty(t, validator.ValidateInventory(generator.JSON(), "server1", "player1"), "seed %d", seed)
+
+		for _, mutation := range fixtures.Mutations {
+			inventory := generator.Inventory()
+			index := generator.Mutate(inventory, mutation)
+
+			found := false
+			for _, err := range validator.ValidateInventory(fixtures.Marshal(inventory), "server1", "player1") {
+				found = found || (err.ItemIndex == index && err.ErrorType == mutation.ErrorType())
+			}
+			assert.True(t, found, "seed %d: %s not reported at slot %d", seed, mutation, index)
+		}
+	}
+}
+
 // Benchmark tests
 func BenchmarkItemValidator_ValidateItem(b *testing.B) {
 	validator := NewItemValidator()
@@ -962,15 +1014,62 @@ func BenchmarkItemValidator_ValidateItem(b *testing.B) {
 
 func BenchmarkItemValidator_ValidateInventory(b *testing.B) {
 	validator := NewItemValidator()
-	inventoryJSON := `[
-		{"typeId": "minecraft:diamond_sword", "amount": 1, "lore": ["Origin: server1"]},
-		{"typeId": "minecraft:bread", "amount": 64, "lore": ["Origin: server1"]},
-		{"typeId": "minecraft:diamond", "amount": 32, "lore": ["Origin: server1"]},
-		null, null, null
-	]`
+	inventory := fixtures.New(1, fixtures.Options{}).JSON()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		validator.ValidateInventory(inventory, "server1", "player1")
+	}
+}
+
+// nestedShulkerInventory builds a full inventory of shulker boxes holding enchanted tools and
+// a second level shulker box, the worst case validated inline on every ender chest update
+func nestedShulkerInventory(server string) []byte {
+	origin := CurrentOriginFormat().Format(server)
+	sword := map[string]any{
+		"typeId": "minecraft:diamond_sword", "amount": 1, "lore": []string{origin},
+		"enchantments": []map[string]any{
+			{"type": "minecraft:sharpness", "level": 5},
+			{"type": "minecraft:unbreaking", "level": 3},
+			{"type": "minecraft:mending", "level": 1},
+		},
+		"durability": map[string]any{"damage": 100, "maxDurability": 1561},
+	}
+	bread := map[string]any{"typeId": "minecraft:bread", "amount": 64, "lore": []string{origin}}
+
+	inner := make([]any, 27)
+	for i := range inner {
+		inner[i] = bread
+	}
+	outer := make([]any, 27)
+	outer[0] = map[string]any{"typeId": "minecraft:shulker_box", "amount": 1, "lore": []string{origin}, "shulkerContents": inner}
+	for i := 1; i < len(outer); i++ {
+		outer[i] = sword
+	}
+	inventory := make([]any, 27)
+	for i := range inventory {
+		inventory[i] = map[string]any{"typeId": "minecraft:shulker_box", "amount": 1, "lore": []string{origin}, "shulkerContents": outer}
+	}
+
+	data, err := json.Marshal(inventory)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+func BenchmarkItemValidator_ValidateInventory_NestedShulkers(b *testing.B) {
+	validator := NewItemValidator()
+	inventory := nestedShulkerInventory("server1")
+
+	if errors := validator.ValidateInventory(inventory, "server1", "player1"); len(errors) > 0 {
+		b.Fatalf("benchmark inventory is invalid: %v", errors)
+	}
 
+	b.ReportAllocs()
+	b.SetBytes(int64(len(inventory)))
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		validator.ValidateInventory([]byte(inventoryJSON), "server1", "player1")
+		validator.ValidateInventory(inventory, "server1", "player1")
 	}
 }
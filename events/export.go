@@ -0,0 +1,101 @@
+package events
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/coldstore"
+)
+
+// Format is the file format events are exported in
+type Format string
+
+const (
+	FormatCSV     Format = "csv"
+	FormatParquet Format = "parquet"
+)
+
+// ParseFormat parses an export format, csv or parquet
+func ParseFormat(format string) (Format, error) {
+	switch Format(format) {
+	case FormatCSV, FormatParquet:
+		return Format(format), nil
+	}
+	return "", fmt.Errorf("invalid event export format %q, expected csv or parquet", format)
+}
+
+// columns are the exported fields of an event, in file order
+var columns = []string{"time", "kind", "level", "server", "reason", "message"}
+
+// Exporter moves journaled events to a store as CSV or Parquet files
+type Exporter struct {
+	journal *Journal
+	store   coldstore.Store
+	format  Format
+}
+
+// NewExporter creates an exporter of the events of journal to store
+func NewExporter(journal *Journal, store coldstore.Store, format Format) *Exporter {
+	return &Exporter{journal: journal, store: store, format: format}
+}
+
+// Export rotates the journal and writes every rotated journal to the store, removing it once written
+// Files are named after the day and time the journal was rotated at, such as
+// 2025-06-01/events-1748736000000000000.csv, journals left by a failed export are exported again
+// Returns how many events were exported
+func (e *Exporter) Export(now time.Time) (int, error) {
+	if err := e.journal.rotate(now); err != nil {
+		return 0, fmt.Errorf("failed to rotate event journal: %w", err)
+	}
+	journals, err := e.journal.rotated()
+	if err != nil {
+		return 0, err
+	}
+
+	exported := 0
+	for _, journal := range journals {
+		events, err := readEvents(journal.path)
+		if err != nil {
+			return exported, err
+		}
+
+		if len(events) > 0 {
+			data, err := e.encode(events)
+			if err != nil {
+				return exported, err
+			}
+			name := fmt.Sprintf("%s/events-%d.%s", journal.at.UTC().Format("2006-01-02"), journal.at.UnixNano(), e.format)
+			if err := e.store.Put(name, data); err != nil {
+				return exported, fmt.Errorf("failed to store %s: %w", name, err)
+			}
+		}
+
+		if err := os.Remove(journal.path); err != nil {
+			return exported, err
+		}
+		exported += len(events)
+	}
+	return exported, nil
+}
+
+func (e *Exporter) encode(events []Event) ([]byte, error) {
+	if e.format == FormatParquet {
+		return encodeParquet(events), nil
+	}
+	return encodeCSV(events)
+}
+
+// encodeCSV writes events with a header row, times in RFC 3339 with nanoseconds
+func encodeCSV(events []Event) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write(columns)
+	for _, event := range events {
+		w.Write([]string{event.Time.UTC().Format(time.RFC3339Nano), event.Kind, event.Level, event.Server, event.Reason, event.Message})
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
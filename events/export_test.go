@@ -0,0 +1,81 @@
+package events
+
+import (
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/coldstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFormat(t *testing.T) {
+	format, err := ParseFormat("parquet")
+	require.NoError(t, err)
+	assert.Equal(t, FormatParquet, format)
+
+	_, err = ParseFormat("xlsx")
+	assert.Error(t, err)
+}
+
+func TestExporter(t *testing.T) {
+	dir := t.TempDir()
+	journal := OpenJournal(filepath.Join(dir, "events.jsonl"))
+	store, err := coldstore.NewDir(filepath.Join(dir, "export"))
+	require.NoError(t, err)
+	exporter := NewExporter(journal, store, FormatCSV)
+
+	t.Run("NothingJournaled", func(t *testing.T) {
+		exported, err := exporter.Export(time.Now())
+		require.NoError(t, err)
+		assert.Zero(t, exported)
+	})
+
+	t.Run("ExportsAndRemovesJournal", func(t *testing.T) {
+		journal.Audit("INFO", "Audit: admin ran \"op griefer\"")
+		journal.Audit("INFO", "Started server")
+		journal.Rejected("b.example.com", "signature", "")
+
+		at := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+		exported, err := exporter.Export(at)
+		require.NoError(t, err)
+		assert.Equal(t, 2, exported)
+
+		data, err := store.Get("2025-06-01/events-" + "1748779200000000000" + ".csv")
+		require.NoError(t, err)
+		records, err := csv.NewReader(strings.NewReader(string(data))).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+		assert.Equal(t, columns, records[0])
+		assert.Equal(t, []string{KindAudit, "INFO", "", "", "admin ran \"op griefer\""}, records[1][1:])
+		assert.Equal(t, []string{KindValidation, "", "b.example.com", "signature", ""}, records[2][1:])
+
+		leftovers, _ := filepath.Glob(filepath.Join(dir, "events.jsonl*"))
+		assert.Empty(t, leftovers)
+	})
+
+	t.Run("RetriesJournalsLeftByFailedExport", func(t *testing.T) {
+		left := filepath.Join(dir, "events.jsonl.1748779200000000001")
+		require.NoError(t, os.WriteFile(left, []byte("{\"kind\":\"audit\",\"message\":\"left\"}\n{\"kind\":"), 0600))
+
+		exported, err := exporter.Export(time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, 1, exported, "the line cut short is skipped")
+		_, err = store.Get("2025-06-01/events-1748779200000000001.csv")
+		assert.NoError(t, err)
+		assert.NoFileExists(t, left)
+	})
+}
+
+func TestJournal_Dropped(t *testing.T) {
+	journal := OpenJournal(filepath.Join(t.TempDir(), "missing", "events.jsonl"))
+	journal.Rejected("b.example.com", "signature", "")
+	journal.Audit("WARN", "Audit: stripped 1 invalid items")
+
+	assert.Equal(t, 2, journal.Dropped())
+	assert.Zero(t, journal.Dropped())
+}
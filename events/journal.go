@@ -0,0 +1,162 @@
+// Package events journals audit and validation events and exports them to CSV or Parquet files for
+// analysis in external tools, away from the live inventories database
+package events
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// auditPrefix marks the log messages that are audit records
+const auditPrefix = "Audit: "
+
+// Kinds of events
+const (
+	KindAudit      = "audit"
+	KindValidation = "validation"
+)
+
+// Event is an audit log record or a rejected inventory update
+type Event struct {
+	Time    time.Time `json:"time"`
+	Kind    string    `json:"kind"`
+	Level   string    `json:"level,omitempty"`  // Log level of audit records
+	Server  string    `json:"server,omitempty"` // Origin server of rejected updates
+	Reason  string    `json:"reason,omitempty"` // Rejection reason, such as signature or item_namespace
+	Message string    `json:"message"`
+}
+
+// Journal appends events to a JSON lines file until the exporter rotates it away
+type Journal struct {
+	mu      sync.Mutex
+	path    string
+	dropped int // Events that could not be written since the last Dropped call
+}
+
+// OpenJournal returns the journal kept at path, created on the first event
+func OpenJournal(path string) *Journal {
+	return &Journal{path: path}
+}
+
+// Append records an event
+func (j *Journal) Append(event Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	file, err := os.OpenFile(j.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open event journal: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write event journal: %w", err)
+	}
+	return nil
+}
+
+// record appends an event for hooks that cannot report errors, failures are counted as dropped
+func (j *Journal) record(event Event) {
+	if err := j.Append(event); err != nil {
+		j.mu.Lock()
+		j.dropped++
+		j.mu.Unlock()
+	}
+}
+
+// Dropped returns how many events could not be journaled since the last call
+func (j *Journal) Dropped() int {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	dropped := j.dropped
+	j.dropped = 0
+	return dropped
+}
+
+// Audit is a logger hook journaling the messages prefixed with "Audit: "
+func (j *Journal) Audit(level, message string) {
+	if !strings.HasPrefix(message, auditPrefix) {
+		return
+	}
+	j.record(Event{Time: time.Now(), Kind: KindAudit, Level: level, Message: strings.TrimPrefix(message, auditPrefix)})
+}
+
+// Rejected is a database rejection hook journaling rejected inventory updates
+func (j *Journal) Rejected(server, reason, message string) {
+	j.record(Event{Time: time.Now(), Kind: KindValidation, Server: server, Reason: reason, Message: message})
+}
+
+// rotate moves the journal aside for export, named after the time it was rotated at
+func (j *Journal) rotate(at time.Time) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	err := os.Rename(j.path, j.path+"."+strconv.FormatInt(at.UnixNano(), 10))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// rotatedJournal is a journal file moved aside for export
+type rotatedJournal struct {
+	path string
+	at   time.Time
+}
+
+// rotated lists the journals moved aside and not exported yet, oldest first
+func (j *Journal) rotated() ([]rotatedJournal, error) {
+	matches, err := filepath.Glob(j.path + ".*")
+	if err != nil {
+		return nil, err
+	}
+
+	var journals []rotatedJournal
+	for _, match := range matches {
+		nanos, err := strconv.ParseInt(strings.TrimPrefix(match, j.path+"."), 10, 64)
+		if err != nil {
+			continue // Not a rotated journal
+		}
+		journals = append(journals, rotatedJournal{path: match, at: time.Unix(0, nanos)})
+	}
+	sort.Slice(journals, func(i, k int) bool { return journals[i].at.Before(journals[k].at) })
+	return journals, nil
+}
+
+// readEvents reads a journal file, skipping lines cut short by a crash
+func readEvents(path string) ([]Event, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open event journal: %w", err)
+	}
+	defer file.Close()
+
+	var events []Event
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1<<20)
+	for scanner.Scan() {
+		var event Event
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			continue
+		}
+		events = append(events, event)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read event journal: %w", err)
+	}
+	return events, nil
+}
@@ -0,0 +1,214 @@
+package events
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// Parquet is written without dependencies as a single row group of uncompressed PLAIN pages, one
+// required column per event field, which every Parquet reader understands
+// See https://github.com/apache/parquet-format for the layout and the Thrift definitions used below
+
+const parquetMagic = "PAR1"
+
+// Parquet physical types, converted types and enums
+const (
+	parquetInt64     = 2
+	parquetByteArray = 6
+
+	parquetUTF8            = 0
+	parquetTimestampMillis = 9
+
+	parquetRequired     = 0
+	parquetPlain        = 0
+	parquetRLE          = 3
+	parquetUncompressed = 0
+	parquetDataPage     = 0
+)
+
+// Thrift compact protocol field types
+const (
+	thriftStop   = 0
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// parquetColumn holds the PLAIN encoded values of a column
+type parquetColumn struct {
+	name      string
+	kind      int32
+	converted int32
+	values    bytes.Buffer
+}
+
+// encodeParquet writes events to a Parquet file with the columns of the CSV export, times in milliseconds
+func encodeParquet(events []Event) []byte {
+	cols := make([]*parquetColumn, len(columns))
+	for i, name := range columns {
+		cols[i] = &parquetColumn{name: name, kind: parquetByteArray, converted: parquetUTF8}
+	}
+	cols[0].kind, cols[0].converted = parquetInt64, parquetTimestampMillis
+
+	for _, event := range events {
+		binary.Write(&cols[0].values, binary.LittleEndian, event.Time.UnixMilli())
+		for i, value := range []string{event.Kind, event.Level, event.Server, event.Reason, event.Message} {
+			binary.Write(&cols[i+1].values, binary.LittleEndian, uint32(len(value)))
+			cols[i+1].values.WriteString(value)
+		}
+	}
+
+	var file bytes.Buffer
+	file.WriteString(parquetMagic)
+
+	offsets := make([]int64, len(cols))
+	sizes := make([]int64, len(cols))
+	for i, col := range cols {
+		header := newThriftWriter()
+		header.i32(1, parquetDataPage)
+		header.i32(2, int32(col.values.Len()))
+		header.i32(3, int32(col.values.Len()))
+		header.structField(5)
+		header.i32(1, int32(len(events)))
+		header.i32(2, parquetPlain)
+		header.i32(3, parquetRLE)
+		header.i32(4, parquetRLE)
+		header.endStruct()
+		header.endStruct()
+
+		offsets[i] = int64(file.Len())
+		file.Write(header.buf.Bytes())
+		file.Write(col.values.Bytes())
+		sizes[i] = int64(file.Len()) - offsets[i]
+	}
+
+	total := int64(0)
+	for _, size := range sizes {
+		total += size
+	}
+
+	footer := newThriftWriter()
+	footer.i32(1, 1)
+	footer.list(2, thriftStruct, len(cols)+1)
+	footer.beginStruct()
+	footer.binary(4, "schema")
+	footer.i32(5, int32(len(cols)))
+	footer.endStruct()
+	for _, col := range cols {
+		footer.beginStruct()
+		footer.i32(1, col.kind)
+		footer.i32(3, parquetRequired)
+		footer.binary(4, col.name)
+		footer.i32(6, col.converted)
+		footer.endStruct()
+	}
+	footer.i64(3, int64(len(events)))
+	footer.list(4, thriftStruct, 1)
+	footer.beginStruct()
+	footer.list(1, thriftStruct, len(cols))
+	for i, col := range cols {
+		footer.beginStruct()
+		footer.i64(2, offsets[i])
+		footer.structField(3)
+		footer.i32(1, col.kind)
+		footer.list(2, thriftI32, 2)
+		footer.varint(zigzag(parquetPlain))
+		footer.varint(zigzag(parquetRLE))
+		footer.list(3, thriftBinary, 1)
+		footer.varint(uint64(len(col.name)))
+		footer.buf.WriteString(col.name)
+		footer.i32(4, parquetUncompressed)
+		footer.i64(5, int64(len(events)))
+		footer.i64(6, sizes[i])
+		footer.i64(7, sizes[i])
+		footer.i64(9, offsets[i])
+		footer.endStruct()
+		footer.endStruct()
+	}
+	footer.i64(2, total)
+	footer.i64(3, int64(len(events)))
+	footer.endStruct()
+	footer.binary(6, "consensuscraft")
+	footer.endStruct()
+
+	file.Write(footer.buf.Bytes())
+	binary.Write(&file, binary.LittleEndian, uint32(footer.buf.Len()))
+	file.WriteString(parquetMagic)
+	return file.Bytes()
+}
+
+// thriftWriter encodes structs with the Thrift compact protocol, fields must be written in
+// ascending id order
+type thriftWriter struct {
+	buf  bytes.Buffer
+	last []int16 // Last field id of each open struct
+}
+
+// newThriftWriter starts a top level struct, closed with endStruct
+func newThriftWriter() *thriftWriter {
+	return &thriftWriter{last: []int16{0}}
+}
+
+func (w *thriftWriter) field(id int16, kind byte) {
+	top := len(w.last) - 1
+	if delta := id - w.last[top]; delta > 0 && delta <= 15 {
+		w.buf.WriteByte(byte(delta)<<4 | kind)
+	} else {
+		w.buf.WriteByte(kind)
+		w.varint(zigzag(int64(id)))
+	}
+	w.last[top] = id
+}
+
+func (w *thriftWriter) i32(id int16, v int32) {
+	w.field(id, thriftI32)
+	w.varint(zigzag(int64(v)))
+}
+
+func (w *thriftWriter) i64(id int16, v int64) {
+	w.field(id, thriftI64)
+	w.varint(zigzag(v))
+}
+
+func (w *thriftWriter) binary(id int16, s string) {
+	w.field(id, thriftBinary)
+	w.varint(uint64(len(s)))
+	w.buf.WriteString(s)
+}
+
+// list starts a list field, its elements are written next without field headers
+func (w *thriftWriter) list(id int16, elem byte, size int) {
+	w.field(id, thriftList)
+	if size < 15 {
+		w.buf.WriteByte(byte(size)<<4 | elem)
+		return
+	}
+	w.buf.WriteByte(0xf0 | elem)
+	w.varint(uint64(size))
+}
+
+// structField starts a struct field, closed with endStruct
+func (w *thriftWriter) structField(id int16) {
+	w.field(id, thriftStruct)
+	w.beginStruct()
+}
+
+// beginStruct starts a struct list element, closed with endStruct
+func (w *thriftWriter) beginStruct() {
+	w.last = append(w.last, 0)
+}
+
+func (w *thriftWriter) endStruct() {
+	w.buf.WriteByte(thriftStop)
+	w.last = w.last[:len(w.last)-1]
+}
+
+func (w *thriftWriter) varint(v uint64) {
+	w.buf.Write(binary.AppendUvarint(nil, v))
+}
+
+func zigzag(v int64) uint64 {
+	return uint64(v<<1) ^ uint64(v>>63)
+}
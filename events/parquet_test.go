@@ -0,0 +1,128 @@
+package events
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// thriftReader decodes Thrift compact structs into maps of field id to value, enough to check the
+// files encodeParquet writes
+type thriftReader struct {
+	t    *testing.T
+	data *bytes.Reader
+}
+
+func (r *thriftReader) varint() uint64 {
+	v, err := binary.ReadUvarint(r.data)
+	require.NoError(r.t, err)
+	return v
+}
+
+func (r *thriftReader) value(kind byte) any {
+	switch kind {
+	case thriftI32, thriftI64:
+		v := r.varint()
+		return int64(v>>1) ^ -int64(v&1)
+	case thriftBinary:
+		s := make([]byte, r.varint())
+		_, err := r.data.Read(s)
+		require.NoError(r.t, err)
+		return string(s)
+	case thriftList:
+		header, err := r.data.ReadByte()
+		require.NoError(r.t, err)
+		size := int(header >> 4)
+		if size == 15 {
+			size = int(r.varint())
+		}
+		list := make([]any, size)
+		for i := range list {
+			list[i] = r.value(header & 0x0f)
+		}
+		return list
+	case thriftStruct:
+		fields := map[int16]any{}
+		id := int16(0)
+		for {
+			header, err := r.data.ReadByte()
+			require.NoError(r.t, err)
+			if header == thriftStop {
+				return fields
+			}
+			id += int16(header >> 4)
+			fields[id] = r.value(header & 0x0f)
+		}
+	}
+	r.t.Fatalf("unexpected thrift type %d", kind)
+	return nil
+}
+
+func (r *thriftReader) readStruct() map[int16]any {
+	return r.value(thriftStruct).(map[int16]any)
+}
+
+func TestEncodeParquet(t *testing.T) {
+	at := time.Date(2025, 6, 1, 12, 30, 0, 250e6, time.UTC)
+	events := []Event{
+		{Time: at, Kind: KindAudit, Level: "INFO", Message: "admin ran \"ban griefer\""},
+		{Time: at.Add(time.Second), Kind: KindValidation, Server: "b.example.com", Reason: "signature"},
+	}
+	file := encodeParquet(events)
+
+	require.Equal(t, parquetMagic, string(file[:4]))
+	require.Equal(t, parquetMagic, string(file[len(file)-4:]))
+	footerLength := int(binary.LittleEndian.Uint32(file[len(file)-8:]))
+	footer := file[len(file)-8-footerLength : len(file)-8]
+
+	meta := (&thriftReader{t: t, data: bytes.NewReader(footer)}).readStruct()
+	assert.Equal(t, int64(2), meta[3], "num_rows")
+	assert.Equal(t, "consensuscraft", meta[6])
+
+	schema := meta[2].([]any)
+	require.Len(t, schema, len(columns)+1)
+	assert.Equal(t, int64(len(columns)), schema[0].(map[int16]any)[5])
+	for i, name := range columns {
+		assert.Equal(t, name, schema[i+1].(map[int16]any)[4])
+	}
+	assert.Equal(t, int64(parquetTimestampMillis), schema[1].(map[int16]any)[6])
+
+	rowGroup := meta[4].([]any)[0].(map[int16]any)
+	chunks := rowGroup[1].([]any)
+	require.Len(t, chunks, len(columns))
+
+	// Read the values of every column back from its page
+	values := make([][]any, len(columns))
+	for i, chunk := range chunks {
+		column := chunk.(map[int16]any)[3].(map[int16]any)
+		assert.Equal(t, []any{columns[i]}, column[3])
+		assert.Equal(t, int64(2), column[5])
+
+		page := bytes.NewReader(file[column[9].(int64):])
+		header := (&thriftReader{t: t, data: page}).readStruct()
+		assert.Equal(t, int64(2), header[5].(map[int16]any)[1])
+
+		for range events {
+			if column[1] == int64(parquetInt64) {
+				var millis int64
+				require.NoError(t, binary.Read(page, binary.LittleEndian, &millis))
+				values[i] = append(values[i], millis)
+				continue
+			}
+			var length uint32
+			require.NoError(t, binary.Read(page, binary.LittleEndian, &length))
+			value := make([]byte, length)
+			page.Read(value)
+			values[i] = append(values[i], string(value))
+		}
+	}
+
+	assert.Equal(t, []any{at.UnixMilli(), at.UnixMilli() + 1000}, values[0])
+	assert.Equal(t, []any{KindAudit, KindValidation}, values[1])
+	assert.Equal(t, []any{"", "b.example.com"}, values[3])
+	assert.Equal(t, []any{"admin ran \"ban griefer\"", ""}, values[5])
+}
@@ -0,0 +1,225 @@
+// Package fixtures generates realistic ender chest inventories for tests: stacks of materials,
+// enchanted tools and armor with durability and shulker boxes, all carrying origin lore, plus
+// malicious mutations the item validator must catch
+// Generators are seeded, so a failing property test is reproduced by its seed
+package fixtures
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math/rand"
+	"slices"
+)
+
+// Slots of an ender chest, the default inventory size
+const Slots = 27
+
+// Options shape generated inventories, zero values fall back to the defaults noted per field
+type Options struct {
+	Origin        string   // Server items originate from, "server1" when empty
+	Foreign       []string // Other origins mixed in, items from them are picked with ForeignChance
+	ForeignChance float64
+	Slots         int     // Inventory size, Slots when zero
+	Fill          float64 // Share of occupied slots, 0.6 when zero
+	Tools         float64 // Share of tools and armor among items, 0.3 when zero
+	Enchanted     float64 // Share of tools and armor carrying enchantments, 0.5 when zero
+	Shulkers      float64 // Share of shulker boxes among items, none when zero
+	ShulkerDepth  int     // Shulker boxes nested in shulker boxes, 0 keeps them flat
+}
+
+// material is a stackable item
+type material struct {
+	typeID   string
+	maxStack int
+}
+
+// gear is a tool or armor piece with durability and the enchantments it accepts at their maximum level
+type gear struct {
+	typeID        string
+	maxDurability int
+	enchantments  map[string]int
+}
+
+var materials = []material{
+	{"minecraft:diamond", 64},
+	{"minecraft:iron_ingot", 64},
+	{"minecraft:gold_ingot", 64},
+	{"minecraft:netherite_scrap", 64},
+	{"minecraft:coal", 64},
+	{"minecraft:bread", 64},
+	{"minecraft:apple", 64},
+	{"minecraft:ender_pearl", 16},
+	{"minecraft:snowball", 16},
+	{"minecraft:egg", 16},
+}
+
+var gears = []gear{
+	{"minecraft:diamond_sword", 1561, map[string]int{"minecraft:sharpness": 5, "minecraft:unbreaking": 3, "minecraft:looting": 3, "minecraft:fire_aspect": 2, "minecraft:mending": 1}},
+	{"minecraft:netherite_sword", 2031, map[string]int{"minecraft:smite": 5, "minecraft:unbreaking": 3, "minecraft:knockback": 2}},
+	{"minecraft:iron_sword", 250, map[string]int{"minecraft:bane_of_arthropods": 5, "minecraft:sweeping": 3}},
+	{"minecraft:diamond_pickaxe", 1561, map[string]int{"minecraft:efficiency": 5, "minecraft:unbreaking": 3, "minecraft:fortune": 3, "minecraft:mending": 1}},
+	{"minecraft:netherite_pickaxe", 2031, map[string]int{"minecraft:efficiency": 5, "minecraft:silk_touch": 1, "minecraft:unbreaking": 3}},
+	{"minecraft:iron_shovel", 250, map[string]int{"minecraft:efficiency": 5, "minecraft:unbreaking": 3}},
+	{"minecraft:diamond_axe", 1561, map[string]int{"minecraft:sharpness": 5, "minecraft:efficiency": 5}},
+	{"minecraft:diamond_helmet", 363, map[string]int{"minecraft:protection": 4, "minecraft:respiration": 3, "minecraft:aqua_affinity": 1}},
+	{"minecraft:iron_chestplate", 240, map[string]int{"minecraft:blast_protection": 4, "minecraft:thorns": 3}},
+	{"minecraft:diamond_leggings", 495, map[string]int{"minecraft:protection": 4, "minecraft:swift_sneak": 3}},
+	{"minecraft:diamond_boots", 429, map[string]int{"minecraft:feather_falling": 4, "minecraft:depth_strider": 3, "minecraft:soul_speed": 3}},
+}
+
+// Generator produces inventories from a seeded source, it is not safe for concurrent use
+type Generator struct {
+	rand *rand.Rand
+	opts Options
+}
+
+// New creates a generator, the same seed and options always produce the same inventories
+func New(seed int64, opts Options) *Generator {
+	if opts.Origin == "" {
+		opts.Origin = "server1"
+	}
+	if opts.Slots == 0 {
+		opts.Slots = Slots
+	}
+	if opts.Fill == 0 {
+		opts.Fill = 0.6
+	}
+	if opts.Tools == 0 {
+		opts.Tools = 0.3
+	}
+	if opts.Enchanted == 0 {
+		opts.Enchanted = 0.5
+	}
+
+	return &Generator{
+		rand: rand.New(rand.NewSource(seed)),
+		opts: opts,
+	}
+}
+
+// Lore returns the origin lore line of a server in the default origin format
+func Lore(server string) string {
+	return "Origin: " + server
+}
+
+// Inventory returns an inventory with nil for empty slots and one JSON object per item
+func (g *Generator) Inventory() []any {
+	inventory := make([]any, g.opts.Slots)
+	for i := range inventory {
+		if g.rand.Float64() < g.opts.Fill {
+			inventory[i] = g.slot(g.opts.ShulkerDepth)
+		}
+	}
+	return inventory
+}
+
+// JSON returns a generated inventory as the BDS addon sends it
+func (g *Generator) JSON() []byte {
+	return Marshal(g.Inventory())
+}
+
+// Marshal encodes an inventory, generated inventories always encode
+func Marshal(inventory []any) []byte {
+	data, err := json.Marshal(inventory)
+	if err != nil {
+		panic(fmt.Sprintf("fixtures: unable to encode inventory: %v", err))
+	}
+	return data
+}
+
+// Material returns a stack of a random material
+func (g *Generator) Material() map[string]any {
+	m := materials[g.rand.Intn(len(materials))]
+	return g.item(m.typeID, 1+g.rand.Intn(m.maxStack))
+}
+
+// Gear returns a random tool or armor piece, damaged and possibly enchanted
+func (g *Generator) Gear() map[string]any {
+	piece := gears[g.rand.Intn(len(gears))]
+	item := g.item(piece.typeID, 1)
+	item["durability"] = map[string]any{
+		"damage":        g.rand.Intn(piece.maxDurability),
+		"maxDurability": piece.maxDurability,
+	}
+
+	if g.rand.Float64() < g.opts.Enchanted {
+		var enchantments []any
+		for _, enchantment := range slices.Sorted(maps.Keys(piece.enchantments)) {
+			if g.rand.Intn(2) == 0 {
+				enchantments = append(enchantments, map[string]any{
+					"type":  enchantment,
+					"level": 1 + g.rand.Intn(piece.enchantments[enchantment]),
+				})
+			}
+		}
+		if len(enchantments) > 0 {
+			item["enchantments"] = enchantments
+		}
+	}
+	return item
+}
+
+// Shulker returns a shulker box holding generated items, with depth more levels of shulker boxes inside
+func (g *Generator) Shulker(depth int) map[string]any {
+	item := g.item("minecraft:shulker_box", 1)
+
+	contents := make([]any, Slots)
+	for i := range contents {
+		if g.rand.Float64() < g.opts.Fill {
+			contents[i] = g.slot(depth - 1)
+		}
+	}
+	item["shulkerContents"] = contents
+	return item
+}
+
+// slot returns a random item, shulker boxes only while depth is not negative
+func (g *Generator) slot(depth int) map[string]any {
+	roll := g.rand.Float64()
+	switch {
+	case depth >= 0 && roll < g.opts.Shulkers:
+		return g.Shulker(depth)
+	case roll < g.opts.Shulkers+g.opts.Tools:
+		return g.Gear()
+	default:
+		return g.Material()
+	}
+}
+
+// item returns an item carrying the origin lore of the generator's server or a foreign one
+func (g *Generator) item(typeID string, amount int) map[string]any {
+	origin := g.opts.Origin
+	if len(g.opts.Foreign) > 0 && g.rand.Float64() < g.opts.ForeignChance {
+		origin = g.opts.Foreign[g.rand.Intn(len(g.opts.Foreign))]
+	}
+
+	return map[string]any{
+		"typeId": typeID,
+		"amount": amount,
+		"lore":   []any{Lore(origin)},
+	}
+}
+
+// Origins returns the origins of every item of an inventory, shulker contents included
+func Origins(inventory []any) []string {
+	var origins []string
+	for _, slot := range inventory {
+		item, ok := slot.(map[string]any)
+		if !ok {
+			continue
+		}
+		if lore, ok := item["lore"].([]any); ok {
+			for _, line := range lore {
+				var origin string
+				if _, err := fmt.Sscanf(fmt.Sprint(line), "Origin: %s", &origin); err == nil {
+					origins = append(origins, origin)
+				}
+			}
+		}
+		if contents, ok := item["shulkerContents"].([]any); ok {
+			origins = append(origins, Origins(contents)...)
+		}
+	}
+	return origins
+}
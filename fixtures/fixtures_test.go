@@ -0,0 +1,64 @@
+package fixtures
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerator_Deterministic(t *testing.T) {
+	opts := Options{Shulkers: 0.2, ShulkerDepth: 1}
+	assert.Equal(t, New(7, opts).JSON(), New(7, opts).JSON())
+	assert.NotEqual(t, New(7, opts).JSON(), New(8, opts).JSON())
+}
+
+func TestGenerator_Inventory(t *testing.T) {
+	generator := New(1, Options{Origin: "a.example.com", Slots: 9, Fill: 1, Shulkers: 0.5})
+
+	data := generator.JSON()
+	var inventory []map[string]any
+	require.NoError(t, json.Unmarshal(data, &inventory))
+	require.Len(t, inventory, 9)
+
+	for _, item := range inventory {
+		require.NotNil(t, item)
+		assert.NotEmpty(t, item["typeId"])
+		assert.Positive(t, item["amount"])
+		assert.Equal(t, []any{"Origin: a.example.com"}, item["lore"])
+
+		// Shulker boxes stay flat without a depth
+		contents, _ := item["shulkerContents"].([]any)
+		for _, content := range contents {
+			if content, ok := content.(map[string]any); ok {
+				assert.NotContains(t, content, "shulkerContents")
+			}
+		}
+	}
+}
+
+func TestOrigins(t *testing.T) {
+	generator := New(3, Options{Foreign: []string{"b.example.com"}, ForeignChance: 0.5, Fill: 1, Shulkers: 0.3})
+	inventory := generator.Inventory()
+
+	origins := Origins(inventory)
+	assert.Contains(t, origins, "server1")
+	assert.Contains(t, origins, "b.example.com")
+	assert.GreaterOrEqual(t, len(origins), len(inventory))
+}
+
+func TestGenerator_Mutate(t *testing.T) {
+	generator := New(5, Options{})
+	for _, mutation := range Mutations {
+		inventory := generator.Inventory()
+		index := generator.Mutate(inventory, mutation)
+		require.IsType(t, map[string]any{}, inventory[index], mutation)
+		assert.NotEmpty(t, Marshal(inventory))
+	}
+
+	assert.NotContains(t, generator.Mutated(MissingType), "typeId")
+	assert.NotContains(t, generator.Mutated(MissingOrigin), "lore")
+	assert.Equal(t, []string{"forged.example.com"}, Origins([]any{generator.Mutated(WrongOrigin)}))
+	assert.Panics(t, func() { generator.Mutated("unknown") })
+}
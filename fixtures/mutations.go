@@ -0,0 +1,97 @@
+package fixtures
+
+// Mutation is a malicious change to an item the item validator must reject
+type Mutation string
+
+const (
+	OversizedStack           Mutation = "stack_too_large"
+	EnchantmentLevel         Mutation = "invalid_enchantment_level"
+	UnknownEnchantment       Mutation = "unknown_enchantment"
+	DuplicateEnchantment     Mutation = "duplicate_enchantment"
+	IncompatibleEnchantments Mutation = "incompatible_enchantments"
+	ForgedMaxDurability      Mutation = "invalid_max_durability"
+	NegativeDurability       Mutation = "negative_durability"
+	MissingOrigin            Mutation = "missing_origin"
+	WrongOrigin              Mutation = "wrong_origin"
+	MissingType              Mutation = "missing_type"
+)
+
+// Mutations lists every mutation, for property tests running all of them
+var Mutations = []Mutation{
+	OversizedStack,
+	EnchantmentLevel,
+	UnknownEnchantment,
+	DuplicateEnchantment,
+	IncompatibleEnchantments,
+	ForgedMaxDurability,
+	NegativeDurability,
+	MissingOrigin,
+	WrongOrigin,
+	MissingType,
+}
+
+// ErrorType returns the validation error type the mutation causes
+func (m Mutation) ErrorType() string {
+	return string(m)
+}
+
+// Mutate replaces a random slot of the inventory with a mutated item and returns the slot index
+func (g *Generator) Mutate(inventory []any, m Mutation) int {
+	index := g.rand.Intn(len(inventory))
+	inventory[index] = g.Mutated(m)
+	return index
+}
+
+// Mutated returns a freshly generated item carrying the mutation
+func (g *Generator) Mutated(m Mutation) map[string]any {
+	switch m {
+	case OversizedStack:
+		item := g.Material()
+		item["amount"] = 65 + g.rand.Intn(64)
+		return item
+	case EnchantmentLevel:
+		return g.enchanted(map[string]any{"type": "minecraft:sharpness", "level": 6 + g.rand.Intn(250)})
+	case UnknownEnchantment:
+		return g.enchanted(map[string]any{"type": "minecraft:godmode", "level": 1})
+	case DuplicateEnchantment:
+		return g.enchanted(
+			map[string]any{"type": "minecraft:unbreaking", "level": 3},
+			map[string]any{"type": "minecraft:unbreaking", "level": 3},
+		)
+	case IncompatibleEnchantments:
+		return g.enchanted(
+			map[string]any{"type": "minecraft:silk_touch", "level": 1},
+			map[string]any{"type": "minecraft:fortune", "level": 3},
+		)
+	case ForgedMaxDurability:
+		item := g.Gear()
+		durability := item["durability"].(map[string]any)
+		durability["maxDurability"] = durability["maxDurability"].(int) * 10
+		return item
+	case NegativeDurability:
+		item := g.Gear()
+		item["durability"].(map[string]any)["damage"] = -1 - g.rand.Intn(1000)
+		return item
+	case MissingOrigin:
+		item := g.Material()
+		delete(item, "lore")
+		return item
+	case WrongOrigin:
+		item := g.Material()
+		item["lore"] = []any{Lore("forged.example.com")}
+		return item
+	case MissingType:
+		item := g.Material()
+		delete(item, "typeId")
+		return item
+	}
+	panic("fixtures: unknown mutation " + string(m))
+}
+
+// enchanted returns an undamaged diamond pickaxe with the given enchantments
+func (g *Generator) enchanted(enchantments ...any) map[string]any {
+	item := g.item("minecraft:diamond_pickaxe", 1)
+	item["durability"] = map[string]any{"damage": 0, "maxDurability": 1561}
+	item["enchantments"] = enchantments
+	return item
+}
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.9
+// 	protoc-gen-go v1.36.8
 // 	protoc        v6.32.1
 // source: proto/consesnuscraft.proto
 
@@ -9,6 +9,7 @@ package pb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,12 +23,23 @@ const (
 )
 
 type RegisterNodeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	WebAddress    string                 `protobuf:"bytes,1,opt,name=web_address,json=webAddress,proto3" json:"web_address,omitempty"`
-	PublicKey     []byte                 `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	Signature     []byte                 `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	WebAddress     string                 `protobuf:"bytes,1,opt,name=web_address,json=webAddress,proto3" json:"web_address,omitempty"`
+	PublicKey      []byte                 `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Signature      []byte                 `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	World          *WorldSettings         `protobuf:"bytes,4,opt,name=world,proto3" json:"world,omitempty"`
+	BannedServers  []string               `protobuf:"bytes,5,rep,name=banned_servers,json=bannedServers,proto3" json:"banned_servers,omitempty"`
+	Notices        []*OperatorNotice      `protobuf:"bytes,6,rep,name=notices,proto3" json:"notices,omitempty"`
+	FreezeOrders   []*FreezeOrder         `protobuf:"bytes,7,rep,name=freeze_orders,json=freezeOrders,proto3" json:"freeze_orders,omitempty"`
+	HashAlgorithms []string               `protobuf:"bytes,8,rep,name=hash_algorithms,json=hashAlgorithms,proto3" json:"hash_algorithms,omitempty"`
+	Build          *BuildInfo             `protobuf:"bytes,9,opt,name=build,proto3" json:"build,omitempty"`
+	SeenServers    []string               `protobuf:"bytes,10,rep,name=seen_servers,json=seenServers,proto3" json:"seen_servers,omitempty"`
+	KeyRotation    []byte                 `protobuf:"bytes,11,opt,name=key_rotation,json=keyRotation,proto3" json:"key_rotation,omitempty"`
+	Nonce          []byte                 `protobuf:"bytes,12,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Timestamp      int64                  `protobuf:"varint,13,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Challenge      []byte                 `protobuf:"bytes,14,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *RegisterNodeRequest) Reset() {
@@ -81,28 +93,108 @@ func (x *RegisterNodeRequest) GetSignature() []byte {
 	return nil
 }
 
-type DatabaseEntry struct {
+func (x *RegisterNodeRequest) GetWorld() *WorldSettings {
+	if x != nil {
+		return x.World
+	}
+	return nil
+}
+
+func (x *RegisterNodeRequest) GetBannedServers() []string {
+	if x != nil {
+		return x.BannedServers
+	}
+	return nil
+}
+
+func (x *RegisterNodeRequest) GetNotices() []*OperatorNotice {
+	if x != nil {
+		return x.Notices
+	}
+	return nil
+}
+
+func (x *RegisterNodeRequest) GetFreezeOrders() []*FreezeOrder {
+	if x != nil {
+		return x.FreezeOrders
+	}
+	return nil
+}
+
+func (x *RegisterNodeRequest) GetHashAlgorithms() []string {
+	if x != nil {
+		return x.HashAlgorithms
+	}
+	return nil
+}
+
+func (x *RegisterNodeRequest) GetBuild() *BuildInfo {
+	if x != nil {
+		return x.Build
+	}
+	return nil
+}
+
+func (x *RegisterNodeRequest) GetSeenServers() []string {
+	if x != nil {
+		return x.SeenServers
+	}
+	return nil
+}
+
+func (x *RegisterNodeRequest) GetKeyRotation() []byte {
+	if x != nil {
+		return x.KeyRotation
+	}
+	return nil
+}
+
+func (x *RegisterNodeRequest) GetNonce() []byte {
+	if x != nil {
+		return x.Nonce
+	}
+	return nil
+}
+
+func (x *RegisterNodeRequest) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *RegisterNodeRequest) GetChallenge() []byte {
+	if x != nil {
+		return x.Challenge
+	}
+	return nil
+}
+
+type BuildInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           []byte                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value         []byte                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Commit        string                 `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	BuildDate     string                 `protobuf:"bytes,3,opt,name=build_date,json=buildDate,proto3" json:"build_date,omitempty"`
+	PackVersion   string                 `protobuf:"bytes,4,opt,name=pack_version,json=packVersion,proto3" json:"pack_version,omitempty"`
+	Protocols     []uint32               `protobuf:"varint,5,rep,packed,name=protocols,proto3" json:"protocols,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DatabaseEntry) Reset() {
-	*x = DatabaseEntry{}
+func (x *BuildInfo) Reset() {
+	*x = BuildInfo{}
 	mi := &file_proto_consesnuscraft_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DatabaseEntry) String() string {
+func (x *BuildInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DatabaseEntry) ProtoMessage() {}
+func (*BuildInfo) ProtoMessage() {}
 
-func (x *DatabaseEntry) ProtoReflect() protoreflect.Message {
+func (x *BuildInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_consesnuscraft_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -114,49 +206,71 @@ func (x *DatabaseEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DatabaseEntry.ProtoReflect.Descriptor instead.
-func (*DatabaseEntry) Descriptor() ([]byte, []int) {
+// Deprecated: Use BuildInfo.ProtoReflect.Descriptor instead.
+func (*BuildInfo) Descriptor() ([]byte, []int) {
 	return file_proto_consesnuscraft_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *DatabaseEntry) GetKey() []byte {
+func (x *BuildInfo) GetVersion() string {
 	if x != nil {
-		return x.Key
+		return x.Version
 	}
-	return nil
+	return ""
 }
 
-func (x *DatabaseEntry) GetValue() []byte {
+func (x *BuildInfo) GetCommit() string {
 	if x != nil {
-		return x.Value
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetBuildDate() string {
+	if x != nil {
+		return x.BuildDate
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetPackVersion() string {
+	if x != nil {
+		return x.PackVersion
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetProtocols() []uint32 {
+	if x != nil {
+		return x.Protocols
 	}
 	return nil
 }
 
-type InventoryMessage struct {
+type OperatorNotice struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerName    string                 `protobuf:"bytes,1,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
-	InventoryData []byte                 `protobuf:"bytes,2,opt,name=inventory_data,json=inventoryData,proto3" json:"inventory_data,omitempty"`
-	WebAddress    string                 `protobuf:"bytes,3,opt,name=web_address,json=webAddress,proto3" json:"web_address,omitempty"`
-	Signature     []byte                 `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	WebAddress    string                 `protobuf:"bytes,1,opt,name=web_address,json=webAddress,proto3" json:"web_address,omitempty"`
+	Author        string                 `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	SentAt        int64                  `protobuf:"varint,4,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	Signature     []byte                 `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InventoryMessage) Reset() {
-	*x = InventoryMessage{}
+func (x *OperatorNotice) Reset() {
+	*x = OperatorNotice{}
 	mi := &file_proto_consesnuscraft_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InventoryMessage) String() string {
+func (x *OperatorNotice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InventoryMessage) ProtoMessage() {}
+func (*OperatorNotice) ProtoMessage() {}
 
-func (x *InventoryMessage) ProtoReflect() protoreflect.Message {
+func (x *OperatorNotice) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_consesnuscraft_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -168,63 +282,1237 @@ func (x *InventoryMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InventoryMessage.ProtoReflect.Descriptor instead.
-func (*InventoryMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use OperatorNotice.ProtoReflect.Descriptor instead.
+func (*OperatorNotice) Descriptor() ([]byte, []int) {
 	return file_proto_consesnuscraft_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *InventoryMessage) GetPlayerName() string {
+func (x *OperatorNotice) GetWebAddress() string {
 	if x != nil {
-		return x.PlayerName
+		return x.WebAddress
 	}
 	return ""
 }
 
-func (x *InventoryMessage) GetInventoryData() []byte {
+func (x *OperatorNotice) GetAuthor() string {
 	if x != nil {
-		return x.InventoryData
+		return x.Author
+	}
+	return ""
+}
+
+func (x *OperatorNotice) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *OperatorNotice) GetSentAt() int64 {
+	if x != nil {
+		return x.SentAt
+	}
+	return 0
+}
+
+func (x *OperatorNotice) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
 	}
 	return nil
 }
 
-func (x *InventoryMessage) GetWebAddress() string {
+type OperatorNotices struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notices       []*OperatorNotice      `protobuf:"bytes,1,rep,name=notices,proto3" json:"notices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OperatorNotices) Reset() {
+	*x = OperatorNotices{}
+	mi := &file_proto_consesnuscraft_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OperatorNotices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperatorNotices) ProtoMessage() {}
+
+func (x *OperatorNotices) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_consesnuscraft_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperatorNotices.ProtoReflect.Descriptor instead.
+func (*OperatorNotices) Descriptor() ([]byte, []int) {
+	return file_proto_consesnuscraft_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OperatorNotices) GetNotices() []*OperatorNotice {
+	if x != nil {
+		return x.Notices
+	}
+	return nil
+}
+
+type FreezeOrder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Frozen        bool                   `protobuf:"varint,1,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	IssuedAt      int64                  `protobuf:"varint,3,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	Issuer        string                 `protobuf:"bytes,4,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Signatures    []*FreezeSignature     `protobuf:"bytes,5,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreezeOrder) Reset() {
+	*x = FreezeOrder{}
+	mi := &file_proto_consesnuscraft_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreezeOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeOrder) ProtoMessage() {}
+
+func (x *FreezeOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_consesnuscraft_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeOrder.ProtoReflect.Descriptor instead.
+func (*FreezeOrder) Descriptor() ([]byte, []int) {
+	return file_proto_consesnuscraft_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FreezeOrder) GetFrozen() bool {
+	if x != nil {
+		return x.Frozen
+	}
+	return false
+}
+
+func (x *FreezeOrder) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *FreezeOrder) GetIssuedAt() int64 {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return 0
+}
+
+func (x *FreezeOrder) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *FreezeOrder) GetSignatures() []*FreezeSignature {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+type FreezeSignature struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebAddress    string                 `protobuf:"bytes,1,opt,name=web_address,json=webAddress,proto3" json:"web_address,omitempty"`
+	Signature     []byte                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreezeSignature) Reset() {
+	*x = FreezeSignature{}
+	mi := &file_proto_consesnuscraft_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreezeSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeSignature) ProtoMessage() {}
+
+func (x *FreezeSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_consesnuscraft_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeSignature.ProtoReflect.Descriptor instead.
+func (*FreezeSignature) Descriptor() ([]byte, []int) {
+	return file_proto_consesnuscraft_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FreezeSignature) GetWebAddress() string {
 	if x != nil {
 		return x.WebAddress
 	}
 	return ""
 }
 
-func (x *InventoryMessage) GetSignature() []byte {
+func (x *FreezeSignature) GetSignature() []byte {
 	if x != nil {
 		return x.Signature
 	}
 	return nil
 }
 
-var File_proto_consesnuscraft_proto protoreflect.FileDescriptor
+type FreezeOrders struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*FreezeOrder         `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_proto_consesnuscraft_proto_rawDesc = "" +
-	"\n" +
-	"\x1aproto/consesnuscraft.proto\x12\x0econsensuscraft\"s\n" +
-	"\x13RegisterNodeRequest\x12\x1f\n" +
-	"\vweb_address\x18\x01 \x01(\tR\n" +
-	"webAddress\x12\x1d\n" +
-	"\n" +
-	"public_key\x18\x02 \x01(\fR\tpublicKey\x12\x1c\n" +
-	"\tsignature\x18\x03 \x01(\fR\tsignature\"7\n" +
-	"\rDatabaseEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\fR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\fR\x05value\"\x99\x01\n" +
-	"\x10InventoryMessage\x12\x1f\n" +
-	"\vplayer_name\x18\x01 \x01(\tR\n" +
-	"playerName\x12%\n" +
-	"\x0einventory_data\x18\x02 \x01(\fR\rinventoryData\x12\x1f\n" +
-	"\vweb_address\x18\x03 \x01(\tR\n" +
-	"webAddress\x12\x1c\n" +
-	"\tsignature\x18\x04 \x01(\fR\tsignature2\xc4\x01\n" +
-	"\x15ConsensusCraftService\x12T\n" +
-	"\fRegisterNode\x12#.consensuscraft.RegisterNodeRequest\x1a\x1d.consensuscraft.DatabaseEntry0\x01\x12U\n" +
-	"\vInventories\x12 .consensuscraft.InventoryMessage\x1a .consensuscraft.InventoryMessage(\x010\x01B\n" +
+func (x *FreezeOrders) Reset() {
+	*x = FreezeOrders{}
+	mi := &file_proto_consesnuscraft_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreezeOrders) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeOrders) ProtoMessage() {}
+
+func (x *FreezeOrders) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_consesnuscraft_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeOrders.ProtoReflect.Descriptor instead.
+func (*FreezeOrders) Descriptor() ([]byte, []int) {
+	return file_proto_consesnuscraft_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FreezeOrders) GetOrders() []*FreezeOrder {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type WorldSettings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeedHash      string                 `protobuf:"bytes,1,opt,name=seed_hash,json=seedHash,proto3" json:"seed_hash,omitempty"`
+	Difficulty    string                 `protobuf:"bytes,2,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	Gamemode      string                 `protobuf:"bytes,3,opt,name=gamemode,proto3" json:"gamemode,omitempty"`
+	ForceGamemode bool                   `protobuf:"varint,4,opt,name=force_gamemode,json=forceGamemode,proto3" json:"force_gamemode,omitempty"`
+	AllowCheats   bool                   `protobuf:"varint,5,opt,name=allow_cheats,json=allowCheats,proto3" json:"allow_cheats,omitempty"`
+	PackHash      string                 `protobuf:"bytes,6,opt,name=pack_hash,json=packHash,proto3" json:"pack_hash,omitempty"`
+	Port          uint32                 `protobuf:"varint,7,opt,name=port,proto3" json:"port,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldSettings) Reset() {
+	*x = WorldSettings{}
+	mi := &file_proto_consesnuscraft_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldSettings) ProtoMessage() {}
+
+func (x *WorldSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_consesnuscraft_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldSettings.ProtoReflect.Descriptor instead.
+func (*WorldSettings) Descriptor() ([]byte, []int) {
+	return file_proto_consesnuscraft_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WorldSettings) GetSeedHash() string {
+	if x != nil {
+		return x.SeedHash
+	}
+	return ""
+}
+
+func (x *WorldSettings) GetDifficulty() string {
+	if x != nil {
+		return x.Difficulty
+	}
+	return ""
+}
+
+func (x *WorldSettings) GetGamemode() string {
+	if x != nil {
+		return x.Gamemode
+	}
+	return ""
+}
+
+func (x *WorldSettings) GetForceGamemode() bool {
+	if x != nil {
+		return x.ForceGamemode
+	}
+	return false
+}
+
+func (x *WorldSettings) GetAllowCheats() bool {
+	if x != nil {
+		return x.AllowCheats
+	}
+	return false
+}
+
+func (x *WorldSettings) GetPackHash() string {
+	if x != nil {
+		return x.PackHash
+	}
+	return ""
+}
+
+func (x *WorldSettings) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+type DatabaseEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           []byte                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         []byte                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseEntry) Reset() {
+	*x = DatabaseEntry{}
+	mi := &file_proto_consesnuscraft_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseEntry) ProtoMessage() {}
+
+func (x *DatabaseEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_consesnuscraft_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseEntry.ProtoReflect.Descriptor instead.
+func (*DatabaseEntry) Descriptor() ([]byte, []int) {
+	return file_proto_consesnuscraft_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DatabaseEntry) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *DatabaseEntry) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type InventoryMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerName    string                 `protobuf:"bytes,1,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	InventoryData []byte                 `protobuf:"bytes,2,opt,name=inventory_data,json=inventoryData,proto3" json:"inventory_data,omitempty"`
+	WebAddress    string                 `protobuf:"bytes,3,opt,name=web_address,json=webAddress,proto3" json:"web_address,omitempty"`
+	Signature     []byte                 `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	UpdateId      string                 `protobuf:"bytes,5,opt,name=update_id,json=updateId,proto3" json:"update_id,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryMessage) Reset() {
+	*x = InventoryMessage{}
+	mi := &file_proto_consesnuscraft_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryMessage) ProtoMessage() {}
+
+func (x *InventoryMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_consesnuscraft_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryMessage.ProtoReflect.Descriptor instead.
+func (*InventoryMessage) Descriptor() ([]byte, []int) {
+	return file_proto_consesnuscraft_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *InventoryMessage) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *InventoryMessage) GetInventoryData() []byte {
+	if x != nil {
+		return x.InventoryData
+	}
+	return nil
+}
+
+func (x *InventoryMessage) GetWebAddress() string {
+	if x != nil {
+		return x.WebAddress
+	}
+	return ""
+}
+
+func (x *InventoryMessage) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *InventoryMessage) GetUpdateId() string {
+	if x != nil {
+		return x.UpdateId
+	}
+	return ""
+}
+
+func (x *InventoryMessage) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type GetPlayersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Players       []string               `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	WebAddress    string                 `protobuf:"bytes,2,opt,name=web_address,json=webAddress,proto3" json:"web_address,omitempty"`
+	Signature     []byte                 `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlayersRequest) Reset() {
+	*x = GetPlayersRequest{}
+	mi := &file_proto_consesnuscraft_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayersRequest) ProtoMessage() {}
+
+func (x *GetPlayersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_consesnuscraft_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayersRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_consesnuscraft_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetPlayersRequest) GetPlayers() []string {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *GetPlayersRequest) GetWebAddress() string {
+	if x != nil {
+		return x.WebAddress
+	}
+	return ""
+}
+
+func (x *GetPlayersRequest) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type GetPlayersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*DatabaseEntry       `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlayersResponse) Reset() {
+	*x = GetPlayersResponse{}
+	mi := &file_proto_consesnuscraft_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayersResponse) ProtoMessage() {}
+
+func (x *GetPlayersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_consesnuscraft_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayersResponse.ProtoReflect.Descriptor instead.
+func (*GetPlayersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_consesnuscraft_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetPlayersResponse) GetEntries() []*DatabaseEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type Slot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Item                  `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Slot) Reset() {
+	*x = Slot{}
+	mi := &file_proto_consesnuscraft_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Slot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Slot) ProtoMessage() {}
+
+func (x *Slot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_consesnuscraft_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Slot.ProtoReflect.Descriptor instead.
+func (*Slot) Descriptor() ([]byte, []int) {
+	return file_proto_consesnuscraft_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Slot) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type Item struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TypeId          string                 `protobuf:"bytes,1,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	Amount          int32                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	NameTag         string                 `protobuf:"bytes,3,opt,name=name_tag,json=nameTag,proto3" json:"name_tag,omitempty"`
+	Lore            []string               `protobuf:"bytes,4,rep,name=lore,proto3" json:"lore,omitempty"`
+	Enchantments    []*structpb.Struct     `protobuf:"bytes,5,rep,name=enchantments,proto3" json:"enchantments,omitempty"`
+	Durability      *structpb.Struct       `protobuf:"bytes,6,opt,name=durability,proto3" json:"durability,omitempty"`
+	ShulkerContents []*Slot                `protobuf:"bytes,7,rep,name=shulker_contents,json=shulkerContents,proto3" json:"shulker_contents,omitempty"`
+	Extra           *structpb.Struct       `protobuf:"bytes,8,opt,name=extra,proto3" json:"extra,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Item) Reset() {
+	*x = Item{}
+	mi := &file_proto_consesnuscraft_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Item) ProtoMessage() {}
+
+func (x *Item) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_consesnuscraft_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Item.ProtoReflect.Descriptor instead.
+func (*Item) Descriptor() ([]byte, []int) {
+	return file_proto_consesnuscraft_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Item) GetTypeId() string {
+	if x != nil {
+		return x.TypeId
+	}
+	return ""
+}
+
+func (x *Item) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Item) GetNameTag() string {
+	if x != nil {
+		return x.NameTag
+	}
+	return ""
+}
+
+func (x *Item) GetLore() []string {
+	if x != nil {
+		return x.Lore
+	}
+	return nil
+}
+
+func (x *Item) GetEnchantments() []*structpb.Struct {
+	if x != nil {
+		return x.Enchantments
+	}
+	return nil
+}
+
+func (x *Item) GetDurability() *structpb.Struct {
+	if x != nil {
+		return x.Durability
+	}
+	return nil
+}
+
+func (x *Item) GetShulkerContents() []*Slot {
+	if x != nil {
+		return x.ShulkerContents
+	}
+	return nil
+}
+
+func (x *Item) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type Location struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             float64                `protobuf:"fixed64,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,2,opt,name=y,proto3" json:"y,omitempty"`
+	Z             float64                `protobuf:"fixed64,3,opt,name=z,proto3" json:"z,omitempty"`
+	Dimension     string                 `protobuf:"bytes,4,opt,name=dimension,proto3" json:"dimension,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	mi := &file_proto_consesnuscraft_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_consesnuscraft_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_proto_consesnuscraft_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Location) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Location) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *Location) GetZ() float64 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+func (x *Location) GetDimension() string {
+	if x != nil {
+		return x.Dimension
+	}
+	return ""
+}
+
+type InventoryEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Inventory     []*Slot                `protobuf:"bytes,1,rep,name=inventory,proto3" json:"inventory,omitempty"`
+	Server        string                 `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Location      *Location              `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	UpdateId      string                 `protobuf:"bytes,5,opt,name=update_id,json=updateId,proto3" json:"update_id,omitempty"`
+	Member        string                 `protobuf:"bytes,6,opt,name=member,proto3" json:"member,omitempty"`
+	RawInventory  []byte                 `protobuf:"bytes,7,opt,name=raw_inventory,json=rawInventory,proto3" json:"raw_inventory,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryEntry) Reset() {
+	*x = InventoryEntry{}
+	mi := &file_proto_consesnuscraft_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryEntry) ProtoMessage() {}
+
+func (x *InventoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_consesnuscraft_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryEntry.ProtoReflect.Descriptor instead.
+func (*InventoryEntry) Descriptor() ([]byte, []int) {
+	return file_proto_consesnuscraft_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *InventoryEntry) GetInventory() []*Slot {
+	if x != nil {
+		return x.Inventory
+	}
+	return nil
+}
+
+func (x *InventoryEntry) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *InventoryEntry) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *InventoryEntry) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *InventoryEntry) GetUpdateId() string {
+	if x != nil {
+		return x.UpdateId
+	}
+	return ""
+}
+
+func (x *InventoryEntry) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+func (x *InventoryEntry) GetRawInventory() []byte {
+	if x != nil {
+		return x.RawInventory
+	}
+	return nil
+}
+
+type ValidationError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Player        string                 `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	Server        string                 `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
+	ItemIndex     int32                  `protobuf:"varint,3,opt,name=item_index,json=itemIndex,proto3" json:"item_index,omitempty"`
+	ErrorType     string                 `protobuf:"bytes,4,opt,name=error_type,json=errorType,proto3" json:"error_type,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidationError) Reset() {
+	*x = ValidationError{}
+	mi := &file_proto_consesnuscraft_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationError) ProtoMessage() {}
+
+func (x *ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_consesnuscraft_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationError.ProtoReflect.Descriptor instead.
+func (*ValidationError) Descriptor() ([]byte, []int) {
+	return file_proto_consesnuscraft_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ValidationError) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *ValidationError) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *ValidationError) GetItemIndex() int32 {
+	if x != nil {
+		return x.ItemIndex
+	}
+	return 0
+}
+
+func (x *ValidationError) GetErrorType() string {
+	if x != nil {
+		return x.ErrorType
+	}
+	return ""
+}
+
+func (x *ValidationError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type PeerInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebAddress    string                 `protobuf:"bytes,1,opt,name=web_address,json=webAddress,proto3" json:"web_address,omitempty"`
+	PublicKey     []byte                 `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	ConnectedAt   int64                  `protobuf:"varint,3,opt,name=connected_at,json=connectedAt,proto3" json:"connected_at,omitempty"`
+	World         *WorldSettings         `protobuf:"bytes,4,opt,name=world,proto3" json:"world,omitempty"`
+	Mismatches    []string               `protobuf:"bytes,5,rep,name=mismatches,proto3" json:"mismatches,omitempty"`
+	Banned        []string               `protobuf:"bytes,6,rep,name=banned,proto3" json:"banned,omitempty"`
+	Maintenance   string                 `protobuf:"bytes,7,opt,name=maintenance,proto3" json:"maintenance,omitempty"`
+	Address       string                 `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`
+	LatencyMs     float64                `protobuf:"fixed64,9,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	HashAlgorithm string                 `protobuf:"bytes,10,opt,name=hash_algorithm,json=hashAlgorithm,proto3" json:"hash_algorithm,omitempty"`
+	Build         *BuildInfo             `protobuf:"bytes,11,opt,name=build,proto3" json:"build,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeerInfo) Reset() {
+	*x = PeerInfo{}
+	mi := &file_proto_consesnuscraft_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerInfo) ProtoMessage() {}
+
+func (x *PeerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_consesnuscraft_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerInfo.ProtoReflect.Descriptor instead.
+func (*PeerInfo) Descriptor() ([]byte, []int) {
+	return file_proto_consesnuscraft_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PeerInfo) GetWebAddress() string {
+	if x != nil {
+		return x.WebAddress
+	}
+	return ""
+}
+
+func (x *PeerInfo) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *PeerInfo) GetConnectedAt() int64 {
+	if x != nil {
+		return x.ConnectedAt
+	}
+	return 0
+}
+
+func (x *PeerInfo) GetWorld() *WorldSettings {
+	if x != nil {
+		return x.World
+	}
+	return nil
+}
+
+func (x *PeerInfo) GetMismatches() []string {
+	if x != nil {
+		return x.Mismatches
+	}
+	return nil
+}
+
+func (x *PeerInfo) GetBanned() []string {
+	if x != nil {
+		return x.Banned
+	}
+	return nil
+}
+
+func (x *PeerInfo) GetMaintenance() string {
+	if x != nil {
+		return x.Maintenance
+	}
+	return ""
+}
+
+func (x *PeerInfo) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PeerInfo) GetLatencyMs() float64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *PeerInfo) GetHashAlgorithm() string {
+	if x != nil {
+		return x.HashAlgorithm
+	}
+	return ""
+}
+
+func (x *PeerInfo) GetBuild() *BuildInfo {
+	if x != nil {
+		return x.Build
+	}
+	return nil
+}
+
+var File_proto_consesnuscraft_proto protoreflect.FileDescriptor
+
+const file_proto_consesnuscraft_proto_rawDesc = "" +
+	"\n" +
+	"\x1aproto/consesnuscraft.proto\x12\x0econsensuscraft\x1a\x1cgoogle/protobuf/struct.proto\"\xbd\x04\n" +
+	"\x13RegisterNodeRequest\x12\x1f\n" +
+	"\vweb_address\x18\x01 \x01(\tR\n" +
+	"webAddress\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\fR\tpublicKey\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\fR\tsignature\x123\n" +
+	"\x05world\x18\x04 \x01(\v2\x1d.consensuscraft.WorldSettingsR\x05world\x12%\n" +
+	"\x0ebanned_servers\x18\x05 \x03(\tR\rbannedServers\x128\n" +
+	"\anotices\x18\x06 \x03(\v2\x1e.consensuscraft.OperatorNoticeR\anotices\x12@\n" +
+	"\rfreeze_orders\x18\a \x03(\v2\x1b.consensuscraft.FreezeOrderR\ffreezeOrders\x12'\n" +
+	"\x0fhash_algorithms\x18\b \x03(\tR\x0ehashAlgorithms\x12/\n" +
+	"\x05build\x18\t \x01(\v2\x19.consensuscraft.BuildInfoR\x05build\x12!\n" +
+	"\fseen_servers\x18\n" +
+	" \x03(\tR\vseenServers\x12!\n" +
+	"\fkey_rotation\x18\v \x01(\fR\vkeyRotation\x12\x14\n" +
+	"\x05nonce\x18\f \x01(\fR\x05nonce\x12\x1c\n" +
+	"\ttimestamp\x18\r \x01(\x03R\ttimestamp\x12\x1c\n" +
+	"\tchallenge\x18\x0e \x01(\fR\tchallenge\"\x9d\x01\n" +
+	"\tBuildInfo\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12\x16\n" +
+	"\x06commit\x18\x02 \x01(\tR\x06commit\x12\x1d\n" +
+	"\n" +
+	"build_date\x18\x03 \x01(\tR\tbuildDate\x12!\n" +
+	"\fpack_version\x18\x04 \x01(\tR\vpackVersion\x12\x1c\n" +
+	"\tprotocols\x18\x05 \x03(\rR\tprotocols\"\x94\x01\n" +
+	"\x0eOperatorNotice\x12\x1f\n" +
+	"\vweb_address\x18\x01 \x01(\tR\n" +
+	"webAddress\x12\x16\n" +
+	"\x06author\x18\x02 \x01(\tR\x06author\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x17\n" +
+	"\asent_at\x18\x04 \x01(\x03R\x06sentAt\x12\x1c\n" +
+	"\tsignature\x18\x05 \x01(\fR\tsignature\"K\n" +
+	"\x0fOperatorNotices\x128\n" +
+	"\anotices\x18\x01 \x03(\v2\x1e.consensuscraft.OperatorNoticeR\anotices\"\xb3\x01\n" +
+	"\vFreezeOrder\x12\x16\n" +
+	"\x06frozen\x18\x01 \x01(\bR\x06frozen\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x1b\n" +
+	"\tissued_at\x18\x03 \x01(\x03R\bissuedAt\x12\x16\n" +
+	"\x06issuer\x18\x04 \x01(\tR\x06issuer\x12?\n" +
+	"\n" +
+	"signatures\x18\x05 \x03(\v2\x1f.consensuscraft.FreezeSignatureR\n" +
+	"signatures\"P\n" +
+	"\x0fFreezeSignature\x12\x1f\n" +
+	"\vweb_address\x18\x01 \x01(\tR\n" +
+	"webAddress\x12\x1c\n" +
+	"\tsignature\x18\x02 \x01(\fR\tsignature\"C\n" +
+	"\fFreezeOrders\x123\n" +
+	"\x06orders\x18\x01 \x03(\v2\x1b.consensuscraft.FreezeOrderR\x06orders\"\xe3\x01\n" +
+	"\rWorldSettings\x12\x1b\n" +
+	"\tseed_hash\x18\x01 \x01(\tR\bseedHash\x12\x1e\n" +
+	"\n" +
+	"difficulty\x18\x02 \x01(\tR\n" +
+	"difficulty\x12\x1a\n" +
+	"\bgamemode\x18\x03 \x01(\tR\bgamemode\x12%\n" +
+	"\x0eforce_gamemode\x18\x04 \x01(\bR\rforceGamemode\x12!\n" +
+	"\fallow_cheats\x18\x05 \x01(\bR\vallowCheats\x12\x1b\n" +
+	"\tpack_hash\x18\x06 \x01(\tR\bpackHash\x12\x12\n" +
+	"\x04port\x18\a \x01(\rR\x04port\"7\n" +
+	"\rDatabaseEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\fR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value\"\xd4\x01\n" +
+	"\x10InventoryMessage\x12\x1f\n" +
+	"\vplayer_name\x18\x01 \x01(\tR\n" +
+	"playerName\x12%\n" +
+	"\x0einventory_data\x18\x02 \x01(\fR\rinventoryData\x12\x1f\n" +
+	"\vweb_address\x18\x03 \x01(\tR\n" +
+	"webAddress\x12\x1c\n" +
+	"\tsignature\x18\x04 \x01(\fR\tsignature\x12\x1b\n" +
+	"\tupdate_id\x18\x05 \x01(\tR\bupdateId\x12\x1c\n" +
+	"\ttimestamp\x18\x06 \x01(\x03R\ttimestamp\"l\n" +
+	"\x11GetPlayersRequest\x12\x18\n" +
+	"\aplayers\x18\x01 \x03(\tR\aplayers\x12\x1f\n" +
+	"\vweb_address\x18\x02 \x01(\tR\n" +
+	"webAddress\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\fR\tsignature\"M\n" +
+	"\x12GetPlayersResponse\x127\n" +
+	"\aentries\x18\x01 \x03(\v2\x1d.consensuscraft.DatabaseEntryR\aentries\"0\n" +
+	"\x04Slot\x12(\n" +
+	"\x04item\x18\x01 \x01(\v2\x14.consensuscraft.ItemR\x04item\"\xcc\x02\n" +
+	"\x04Item\x12\x17\n" +
+	"\atype_id\x18\x01 \x01(\tR\x06typeId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x05R\x06amount\x12\x19\n" +
+	"\bname_tag\x18\x03 \x01(\tR\anameTag\x12\x12\n" +
+	"\x04lore\x18\x04 \x03(\tR\x04lore\x12;\n" +
+	"\fenchantments\x18\x05 \x03(\v2\x17.google.protobuf.StructR\fenchantments\x127\n" +
+	"\n" +
+	"durability\x18\x06 \x01(\v2\x17.google.protobuf.StructR\n" +
+	"durability\x12?\n" +
+	"\x10shulker_contents\x18\a \x03(\v2\x14.consensuscraft.SlotR\x0fshulkerContents\x12-\n" +
+	"\x05extra\x18\b \x01(\v2\x17.google.protobuf.StructR\x05extra\"R\n" +
+	"\bLocation\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x01R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x01R\x01y\x12\f\n" +
+	"\x01z\x18\x03 \x01(\x01R\x01z\x12\x1c\n" +
+	"\tdimension\x18\x04 \x01(\tR\tdimension\"\x8a\x02\n" +
+	"\x0eInventoryEntry\x122\n" +
+	"\tinventory\x18\x01 \x03(\v2\x14.consensuscraft.SlotR\tinventory\x12\x16\n" +
+	"\x06server\x18\x02 \x01(\tR\x06server\x12\x1c\n" +
+	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\x124\n" +
+	"\blocation\x18\x04 \x01(\v2\x18.consensuscraft.LocationR\blocation\x12\x1b\n" +
+	"\tupdate_id\x18\x05 \x01(\tR\bupdateId\x12\x16\n" +
+	"\x06member\x18\x06 \x01(\tR\x06member\x12#\n" +
+	"\rraw_inventory\x18\a \x01(\fR\frawInventory\"\x99\x01\n" +
+	"\x0fValidationError\x12\x16\n" +
+	"\x06player\x18\x01 \x01(\tR\x06player\x12\x16\n" +
+	"\x06server\x18\x02 \x01(\tR\x06server\x12\x1d\n" +
+	"\n" +
+	"item_index\x18\x03 \x01(\x05R\titemIndex\x12\x1d\n" +
+	"\n" +
+	"error_type\x18\x04 \x01(\tR\terrorType\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"\x8d\x03\n" +
+	"\bPeerInfo\x12\x1f\n" +
+	"\vweb_address\x18\x01 \x01(\tR\n" +
+	"webAddress\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\fR\tpublicKey\x12!\n" +
+	"\fconnected_at\x18\x03 \x01(\x03R\vconnectedAt\x123\n" +
+	"\x05world\x18\x04 \x01(\v2\x1d.consensuscraft.WorldSettingsR\x05world\x12\x1e\n" +
+	"\n" +
+	"mismatches\x18\x05 \x03(\tR\n" +
+	"mismatches\x12\x16\n" +
+	"\x06banned\x18\x06 \x03(\tR\x06banned\x12 \n" +
+	"\vmaintenance\x18\a \x01(\tR\vmaintenance\x12\x18\n" +
+	"\aaddress\x18\b \x01(\tR\aaddress\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\t \x01(\x01R\tlatencyMs\x12%\n" +
+	"\x0ehash_algorithm\x18\n" +
+	" \x01(\tR\rhashAlgorithm\x12/\n" +
+	"\x05build\x18\v \x01(\v2\x19.consensuscraft.BuildInfoR\x05build2\x99\x02\n" +
+	"\x15ConsensusCraftService\x12T\n" +
+	"\fRegisterNode\x12#.consensuscraft.RegisterNodeRequest\x1a\x1d.consensuscraft.DatabaseEntry0\x01\x12U\n" +
+	"\vInventories\x12 .consensuscraft.InventoryMessage\x1a .consensuscraft.InventoryMessage(\x010\x01\x12S\n" +
+	"\n" +
+	"GetPlayers\x12!.consensuscraft.GetPlayersRequest\x1a\".consensuscraft.GetPlayersResponseB\n" +
 	"Z\b./gen/pbb\x06proto3"
 
 var (
@@ -239,22 +1527,57 @@ func file_proto_consesnuscraft_proto_rawDescGZIP() []byte {
 	return file_proto_consesnuscraft_proto_rawDescData
 }
 
-var file_proto_consesnuscraft_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_proto_consesnuscraft_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_proto_consesnuscraft_proto_goTypes = []any{
 	(*RegisterNodeRequest)(nil), // 0: consensuscraft.RegisterNodeRequest
-	(*DatabaseEntry)(nil),       // 1: consensuscraft.DatabaseEntry
-	(*InventoryMessage)(nil),    // 2: consensuscraft.InventoryMessage
+	(*BuildInfo)(nil),           // 1: consensuscraft.BuildInfo
+	(*OperatorNotice)(nil),      // 2: consensuscraft.OperatorNotice
+	(*OperatorNotices)(nil),     // 3: consensuscraft.OperatorNotices
+	(*FreezeOrder)(nil),         // 4: consensuscraft.FreezeOrder
+	(*FreezeSignature)(nil),     // 5: consensuscraft.FreezeSignature
+	(*FreezeOrders)(nil),        // 6: consensuscraft.FreezeOrders
+	(*WorldSettings)(nil),       // 7: consensuscraft.WorldSettings
+	(*DatabaseEntry)(nil),       // 8: consensuscraft.DatabaseEntry
+	(*InventoryMessage)(nil),    // 9: consensuscraft.InventoryMessage
+	(*GetPlayersRequest)(nil),   // 10: consensuscraft.GetPlayersRequest
+	(*GetPlayersResponse)(nil),  // 11: consensuscraft.GetPlayersResponse
+	(*Slot)(nil),                // 12: consensuscraft.Slot
+	(*Item)(nil),                // 13: consensuscraft.Item
+	(*Location)(nil),            // 14: consensuscraft.Location
+	(*InventoryEntry)(nil),      // 15: consensuscraft.InventoryEntry
+	(*ValidationError)(nil),     // 16: consensuscraft.ValidationError
+	(*PeerInfo)(nil),            // 17: consensuscraft.PeerInfo
+	(*structpb.Struct)(nil),     // 18: google.protobuf.Struct
 }
 var file_proto_consesnuscraft_proto_depIdxs = []int32{
-	0, // 0: consensuscraft.ConsensusCraftService.RegisterNode:input_type -> consensuscraft.RegisterNodeRequest
-	2, // 1: consensuscraft.ConsensusCraftService.Inventories:input_type -> consensuscraft.InventoryMessage
-	1, // 2: consensuscraft.ConsensusCraftService.RegisterNode:output_type -> consensuscraft.DatabaseEntry
-	2, // 3: consensuscraft.ConsensusCraftService.Inventories:output_type -> consensuscraft.InventoryMessage
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	7,  // 0: consensuscraft.RegisterNodeRequest.world:type_name -> consensuscraft.WorldSettings
+	2,  // 1: consensuscraft.RegisterNodeRequest.notices:type_name -> consensuscraft.OperatorNotice
+	4,  // 2: consensuscraft.RegisterNodeRequest.freeze_orders:type_name -> consensuscraft.FreezeOrder
+	1,  // 3: consensuscraft.RegisterNodeRequest.build:type_name -> consensuscraft.BuildInfo
+	2,  // 4: consensuscraft.OperatorNotices.notices:type_name -> consensuscraft.OperatorNotice
+	5,  // 5: consensuscraft.FreezeOrder.signatures:type_name -> consensuscraft.FreezeSignature
+	4,  // 6: consensuscraft.FreezeOrders.orders:type_name -> consensuscraft.FreezeOrder
+	8,  // 7: consensuscraft.GetPlayersResponse.entries:type_name -> consensuscraft.DatabaseEntry
+	13, // 8: consensuscraft.Slot.item:type_name -> consensuscraft.Item
+	18, // 9: consensuscraft.Item.enchantments:type_name -> google.protobuf.Struct
+	18, // 10: consensuscraft.Item.durability:type_name -> google.protobuf.Struct
+	12, // 11: consensuscraft.Item.shulker_contents:type_name -> consensuscraft.Slot
+	18, // 12: consensuscraft.Item.extra:type_name -> google.protobuf.Struct
+	12, // 13: consensuscraft.InventoryEntry.inventory:type_name -> consensuscraft.Slot
+	14, // 14: consensuscraft.InventoryEntry.location:type_name -> consensuscraft.Location
+	7,  // 15: consensuscraft.PeerInfo.world:type_name -> consensuscraft.WorldSettings
+	1,  // 16: consensuscraft.PeerInfo.build:type_name -> consensuscraft.BuildInfo
+	0,  // 17: consensuscraft.ConsensusCraftService.RegisterNode:input_type -> consensuscraft.RegisterNodeRequest
+	9,  // 18: consensuscraft.ConsensusCraftService.Inventories:input_type -> consensuscraft.InventoryMessage
+	10, // 19: consensuscraft.ConsensusCraftService.GetPlayers:input_type -> consensuscraft.GetPlayersRequest
+	8,  // 20: consensuscraft.ConsensusCraftService.RegisterNode:output_type -> consensuscraft.DatabaseEntry
+	9,  // 21: consensuscraft.ConsensusCraftService.Inventories:output_type -> consensuscraft.InventoryMessage
+	11, // 22: consensuscraft.ConsensusCraftService.GetPlayers:output_type -> consensuscraft.GetPlayersResponse
+	20, // [20:23] is the sub-list for method output_type
+	17, // [17:20] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_proto_consesnuscraft_proto_init() }
@@ -268,7 +1591,7 @@ func file_proto_consesnuscraft_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_consesnuscraft_proto_rawDesc), len(file_proto_consesnuscraft_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
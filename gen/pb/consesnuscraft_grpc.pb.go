@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	ConsensusCraftService_RegisterNode_FullMethodName = "/consensuscraft.ConsensusCraftService/RegisterNode"
 	ConsensusCraftService_Inventories_FullMethodName  = "/consensuscraft.ConsensusCraftService/Inventories"
+	ConsensusCraftService_GetPlayers_FullMethodName   = "/consensuscraft.ConsensusCraftService/GetPlayers"
 )
 
 // ConsensusCraftServiceClient is the client API for ConsensusCraftService service.
@@ -31,6 +32,8 @@ type ConsensusCraftServiceClient interface {
 	RegisterNode(ctx context.Context, in *RegisterNodeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DatabaseEntry], error)
 	// Bidirectional stream for inventory updates between nodes
 	Inventories(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[InventoryMessage, InventoryMessage], error)
+	// Records of players missing locally, fetched from a peer instead of waiting for the next sync
+	GetPlayers(ctx context.Context, in *GetPlayersRequest, opts ...grpc.CallOption) (*GetPlayersResponse, error)
 }
 
 type consensusCraftServiceClient struct {
@@ -73,6 +76,16 @@ func (c *consensusCraftServiceClient) Inventories(ctx context.Context, opts ...g
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type ConsensusCraftService_InventoriesClient = grpc.BidiStreamingClient[InventoryMessage, InventoryMessage]
 
+func (c *consensusCraftServiceClient) GetPlayers(ctx context.Context, in *GetPlayersRequest, opts ...grpc.CallOption) (*GetPlayersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPlayersResponse)
+	err := c.cc.Invoke(ctx, ConsensusCraftService_GetPlayers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ConsensusCraftServiceServer is the server API for ConsensusCraftService service.
 // All implementations must embed UnimplementedConsensusCraftServiceServer
 // for forward compatibility.
@@ -81,6 +94,8 @@ type ConsensusCraftServiceServer interface {
 	RegisterNode(*RegisterNodeRequest, grpc.ServerStreamingServer[DatabaseEntry]) error
 	// Bidirectional stream for inventory updates between nodes
 	Inventories(grpc.BidiStreamingServer[InventoryMessage, InventoryMessage]) error
+	// Records of players missing locally, fetched from a peer instead of waiting for the next sync
+	GetPlayers(context.Context, *GetPlayersRequest) (*GetPlayersResponse, error)
 	mustEmbedUnimplementedConsensusCraftServiceServer()
 }
 
@@ -97,6 +112,9 @@ func (UnimplementedConsensusCraftServiceServer) RegisterNode(*RegisterNodeReques
 func (UnimplementedConsensusCraftServiceServer) Inventories(grpc.BidiStreamingServer[InventoryMessage, InventoryMessage]) error {
 	return status.Errorf(codes.Unimplemented, "method Inventories not implemented")
 }
+func (UnimplementedConsensusCraftServiceServer) GetPlayers(context.Context, *GetPlayersRequest) (*GetPlayersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayers not implemented")
+}
 func (UnimplementedConsensusCraftServiceServer) mustEmbedUnimplementedConsensusCraftServiceServer() {}
 func (UnimplementedConsensusCraftServiceServer) testEmbeddedByValue()                               {}
 
@@ -136,13 +154,36 @@ func _ConsensusCraftService_Inventories_Handler(srv interface{}, stream grpc.Ser
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type ConsensusCraftService_InventoriesServer = grpc.BidiStreamingServer[InventoryMessage, InventoryMessage]
 
+func _ConsensusCraftService_GetPlayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsensusCraftServiceServer).GetPlayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsensusCraftService_GetPlayers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsensusCraftServiceServer).GetPlayers(ctx, req.(*GetPlayersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ConsensusCraftService_ServiceDesc is the grpc.ServiceDesc for ConsensusCraftService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var ConsensusCraftService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "consensuscraft.ConsensusCraftService",
 	HandlerType: (*ConsensusCraftServiceServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPlayers",
+			Handler:    _ConsensusCraftService_GetPlayers_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "RegisterNode",
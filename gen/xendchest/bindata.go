@@ -77,7 +77,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _x_ender_chestMcpack = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\xfa\x53\x90\xa6\x41\xba\xfd\x0d\x3f\x4f\xd9\xb6\x8d\x2e\xdb\xb6\x6d\xbb\xba\xec\x2e\xdb\xb6\xd9\xe5\x2e\xdb\xb6\x6d\xdb\xae\x2e\xe3\x8b\xd9\x47\xdf\xfe\xcf\x3b\x33\x7b\x32\xe2\xca\xc3\xdf\x9d\x91\xeb\x5e\x91\x2b\xe3\x4a\x45\x19\x50\x30\x18\xc0\x3f\x06\x15\x90\x57\x07\xf0\xff\x37\x10\x00\x78\x00\x63\x33\x4b\x23\xb7\x9f\xf6\x4e\x06\x0e\x46\x26\xd6\x0c\x6a\xaa\xd0\x00\x50\x2f\xe5\x55\xcb\x7f\x94\xab\x3b\x2c\x00\x08\x76\x0a\x0a\x00\xfc\xcf\xf4\xaf\x39\xe8\xff\xc4\x31\xb6\xb1\x37\xb1\x76\xfe\x8f\x38\x34\x00\x00\x00\xf5\x3f\xb8\x19\x0a\x72\x81\x33\x10\x00\xa0\x07\x06\x00\xa0\xfc\x57\x38\x77\x03\x33\x3b\x53\x33\x27\x03\x13\x4b\x33\x67\x17\x7a\x2b\x67\x7b\xbb\x7f\xf7\x81\x2e\x75\x3d\x3b\x1c\x26\x24\xdf\x13\xb4\x0a\x9f\x82\xaa\x53\x08\x65\xb5\x1f\x92\x1e\x56\x15\xfa\x05\xca\x38\x5a\xc3\x3c\xb4\x76\x9f\x0d\x4d\x5d\xd4\xac\xfb\xbc\x6d\x12\xf2\xcb\x75\x5d\xc0\x1b\x61\xa2\x14\x61\x32\x82\x68\x0b\xde\xaf\xe3\xac\x3b\x0e\x2f\x8e\xd1\x7a\xef\x82\x98\xa2\xd8\xcd\xc0\x3a\xdd\x0a\xef\xd4\xa3\xa4\x7d\x14\xbf\x03\xef\xc3\xa9\x44\x1a\xc3\x50\xfa\x4d\x1b\x7a\xd6\xf7\x90\x27\x9e\x4d\x71\x11\xe8\x54\x6c\xfd\x0e\x77\x2a\xbb\x7d\x76\x06\x21\xae\xee\x5d\x43\xef\x32\x74\xa0\x5a\x20\xb1\x0d\xf4\xf2\x14\xf7\xb7\xf0\x8b\xc3\x20\xf7\x79\x2f\x85\x50\xfd\x97\x50\xe9\x45\x2c\x36\xbe\x5d\xb9\xe5\x7e\x81\x3f\x32\xe6\x01\x72\xf5\x29\x8c\x46\x92\x4d\x83\xab\x8f\xae\x6d\xf3\x8a\x94\x22\xbc\x91\xbb\x59\xf1\x54\xbb\x97\x75\x7d\x16\xcd\x39\x0e\xa8\x4e\xb2\x65\x55\xb4\x1d\x6f\x6c\x36\x4f\xfc\xce\x47\x9e\xb0\x12\xb4\x58\x2f\xe7\xd8\x33\xbd\x53\xa8\xd3\xca\x84\xb5\x84\x05\x1d\x15\xe8\xe0\xbe\xe4\x18\x7c\x2d\x41\x5c\x1f\x36\x5a\x0e\x2c\xd5\x58\x6a\xfe\xc5\x21\x6f\x61\xc9\x61\x64\xe4\xe1\xcb\x14\x15\x5f\x17\x85\xf8\x81\x10\x2b\x52\x97\xa6\xa6\x43\x6e\x86\xc2\x81\x8f\x39\x04\xf4\x65\xf6\x4f\x2d\xeb\x90\x5a\xe5\xdd\xb1\xa6\x58\x4a\x39\x24\x9a\x6a\x3c\x2c\x5a\x48\x26\x55\xb5\x5d\x25\xf9\x5a\xb4\x24\xc7\x40\x0a\x4c\xed\x15\x5d\x35\x4b\x97\x92\x80\xcf\x44\x9b\x01\x13\x8d\x02\xc8\x92\xa0\x88\xa4\xd7\xcc\xde\x3f\x18\xeb\x4f\x6d\x63\x2d\x1b\x83\xfe\x47\xa3\x71\xc6\x5f\xc7\x77\x09\xa3\xe8\x96\xd0\xf4\xcb\x4f\x8e\x7a\x8f\xdc\x8e\x27\x31\x0a\x20\x70\x23\x45\xad\x91\x73\x5e\xae\xde\x2b\x35\x29\x41\x6a\xa7\x98\x3b\x8f\x05\xae\x1d\x05\x41\x71\x11\x7c\x31\x1a\x0c\xae\x67\x7c\xe5\x65\x69\xc9\xad\x7c\x23\xe0\xcd\x10\xcb\x6a\xe3\xf8\x16\xf8\x3c\x29\xce\xd7\x3c\x12\x2c\x14\x99\xa9\x39\xc1\xab\x41\x7a\x3d\x85\xe9\x3a\x97\x5d\x6c\x77\x5d\x17\x42\xde\x33\xdd\x9e\x9a\x6b\x16\x88\x9c\xef\x05\x04\xd7\x7f\x07\x3e\x1f\x61\x19\x3b\xfb\x14\xfb\xbe\x85\x33\xb4\x4a\xe1\xab\xdc\x6a\x88\x7f\x35\x66\x5e\x09\x3b\xc9\x58\x5d\xc2\xb6\x83\xec\xef\x40\x48\x55\x9c\x46\x78\xca\x17\xcf\xa1\x34\xc8\x69\x36\xf7\x36\xfe\xa0\x73\xe4\x0e\x5d\xec\xf6\x24\xa4\x8f\x8a\x93\x98\x1f\x50\x7f\xc8\xb9\xd6\x99\x46\x3e\x21\x8c\xef\x82\x9a\x37\x63\xe0\xfe\xad\xfc\xd1\x38\xba\x11\x00\x90\x85\xed\x13\xb1\x9f\xfa\xfb\x67\xc4\x77\xdf\x5c\x68\xd0\xe2\xd7\xa6\xdc\xad\x55\x84\x42\xf6\x38\xce\xfa\xc7\xb0\x82\x67\xc0\x61\x73\xd5\xf1\x23\x52\x2d\xc4\x04\x91\xd4\x49\x88\xbe\x00\x79\x86\x90\xbe\xf2\x5f\x6e\x8a\xa1\xaa\x15\x39\x09\x68\x2b\xc6\xfa\x08\x90\xc4\xed\xe4\x71\x72\x8c\xb0\x96\xfe\x94\x6f\x7a\x90\x09\xa5\xca\x6d\xb8\x38\xe8\xa5\x5b\x5b\x37\xd7\x14\xf8\x14\x76\x3b\x2e\x59\x26\x02\xb4\x3c\x02\xd8\xd5\xa4\xf1\xe1\x82\xa5\x71\x10\xfe\x4a\xf5\x53\x5e\xd9\x45\x82\xef\x0e\x2e\x76\x50\x7d\xfa\x30\x01\xc9\x1d\x2e\xe5\xf4\x6e\x63\x04\x75\x8e\x93\x72\x50\xf9\xac\x95\x09\x90\x53\x8f\x1f\x77\x5f\x43\xd2\x75\xcf\x72\xa8\x09\x0a\x5f\x98\x8f\x11\x6b\x68\x9d\xef\x0f\xd4\x36\x04\xb9\x4a\xa2\x85\xac\xae\xc7\x0a\x0e\xbe\xd7\xb5\x30\xd3\x94\x10\xea\xb7\x5c\x5c\x8d\xca\x76\x1d\x17\xd7\xa5\x91\x69\xe5\x53\x86\x2c\x2f\x90\xff\xda\x98\x98\xff\xe4\x24\x33\x3b\x97\x9f\x2e\x3f\xcd\xfe\x1b\x6b\x46\x48\xee\x71\x7b\x80\x02\x00\x01\x88\x00\x00\xf5\xbf\x06\xfe\x77\xe6\xbc\xd2\x90\xb1\xdf\x60\x84\xeb\x3e\xd1\xc0\xe2\x86\x14\xd3\xae\xe4\x41\xa3\xaa\xfd\x46\x06\x0b\x34\x5e\x68\x66\xe7\xd3\x95\x9a\x36\x11\x7b\xdb\x90\x58\x93\xb7\x19\x8b\x5d\x68\xe2\xbb\xac\xc5\xcb\x16\x2d\x1a\x8d\xb3\x7f\x73\x4d\x20\x23\x5b\x11\x67\x96\xf8\x65\x48\xbb\x12\xc9\x4b\x7e\xf8\x75\x6d\xe4\xfa\xec\x23\x96\x9a\x4b\xdb\x16\xb8\x58\xbb\xc9\x17\xe0\xb4\x89\x9f\xb0\x87\x3e\x93\x90\x18\x20\x07\x5f\xa3\xeb\x55\xc1\x37\x68\x92\xeb\x31\x9c\x1d\x74\x57\x8f\x80\xa6\x0a\x11\x5a\x42\xae\xf9\x8c\xf6\x61\x7d\xd8\xa2\x41\xdb\x84\xbe\x41\xd3\x8c\xee\x9f\xe6\x44\x41\x0b\x36\xa8\x2d\xed\x53\xc2\xad\x02\x29\xca\xa9\x6a\xd8\x2a\x5a\x2c\x1b\x4d\xaf\x21\x4e\xba\xe4\x58\x0b\x1a\x2e\x05\x07\xeb\x81\x66\x0a\x84\xbc\x2f\x0a\x2c\x54\xb4\x8a\xfb\x11\xb3\xe8\x76\x7c\x52\x89\x41\xaf\xbe\x36\xbb\x59\x77\xaf\x38\xa6\x6e\x2d\x44\x1f\xbc\x60\xfc\xe2\x63\x65\x1f\xaf\x3a\x21\x4d\x53\x53\xce\xd8\x5e\x1d\x2a\x48\x9a\x7e\xea\x70\x53\x3c\x9b\xc8\xcf\xaf\xab\x35\xa2\x38\x89\x86\xcb\x8c\x13\xef\x09\x49\x15\x19\x15\xcd\x5e\x67\xd3\x35\x9f\xef\xc7\x30\x0c\xd0\xab\x59\xdf\x90\x71\xa6\x68\xe3\x0c\x3d\xa5\xb7\xb0\x9e\x1f\xc2\x33\x29\x49\xdf\x4f\x77\x12\x64\xb7\x30\x9b\xa8\x1b\x55\x85\xed\xf3\x11\x9f\xaf\xe2\x0f\x16\x96\xd9\x15\x3b\xc3\xef\x39\xcf\x91\x7f\xe2\x75\x2e\x16\xc8\x4a\x58\x33\x67\x6c\xca\xaf\x64\x2f\x90\xd5\xb1\xdf\x30\xb1\xdf\xf3\xdc\x6d\x29\x40\xd4\x31\xd2\x5d\x4e\x2c\x69\x57\x27\xe8\xff\x64\xfe\xdd\x30\x40\x8b\xef\xc6\x3f\xcf\x85\x89\x10\x1d\x23\xbc\xc8\xc8\x48\x5c\x36\x4d\x8a\xa4\xa2\xc8\xed\x7b\xd2\xb5\x5e\x8c\x73\x35\xff\x29\x99\xaa\x52\x99\x91\x1a\x5d\xe1\xa8\xe6\x76\x26\xe0\x01\xf9\x79\x8f\x3a\x2f\x1e\x04\x9e\xa0\xc0\x72\x95\x5e\x66\x94\x2c\x7d\x32\xf3\xd3\xdb\x62\x19\xa5\xf2\x8c\xde\xd6\x8e\x78\xbe\x7a\x90\x9e\x05\x36\x42\x35\x10\x3f\xe1\x6c\xa1\x4c\xda\x9c\xa9\x6c\xd5\x94\x31\x0b\xee\x2f\xfd\x3b\x8c\x7b\x77\x45\x74\x81\x18\x65\xd6\xee\xf7\xcf\x1f\x85\xc3\x42\xb6\xb9\x74\xd3\x43\x29\x13\x4a\x56\xb8\xee\xe5\x6d\xc0\x48\x6c\xce\xa5\xc5\x2f\xca\x6f\x09\xe1\xd7\x00\x5a\xe7\xca\x17\x59\x2b\x0f\x4a\xc6\x95\x47\x8e\xfb\x0f\xef\x42\xee\xd6\x7e\xc5\xda\xc5\x02\xc8\xaf\x39\x98\xb6\x79\x95\xee\x6a\x51\xe5\x02\x3a\x30\x36\xa7\x57\x3e\x9e\x3c\xab\x8b\xc6\x22\xf2\x5f\x8d\xce\xbd\x0e\xc2\x8e\x61\x2a\xb0\x76\x93\x89\x0f\xa3\x91\x13\x45\x79\xf6\xbf\x10\xe3\x90\xab\xf6\x79\xae\xa4\x2e\x96\x50\xc6\xe2\x5e\x56\x3f\xd8\x1e\x51\xd2\x2d\xd1\x10\x91\xf2\xb6\x7c\x04\x9c\x19\x9e\xb7\x02\x64\xb4\x68\x66\x5e\xb9\x87\x12\x99\xc6\x60\xd2\xb3\x6b\xa7\x6f\x7f\x63\xad\xf0\x49\x69\xd9\x66\xe9\x3e\xfa\x50\xae\xd7\x32\x3e\x35\xa2\x7b\xf8\x28\x65\x08\xf6\xa5\x20\xb6\xb2\xcf\x31\x27\x32\xeb\x52\x20\xe1\xd5\x48\xeb\x9a\x33\x67\xe2\xd2\x49\x64\xe1\xb7\xe9\x8a\x0f\xef\xe8\xdf\xb4\x08\xc1\x49\xe6\xed\x9e\xe0\xd7\x68\xb0\x07\x49\x32\x41\x6f\x7d\x71\x03\x82\x31\x2e\xf8\xfe\xb2\x1a\x3c\x2e\x02\xbe\x0e\xff\x60\xfd\x32\x38\xdc\x6c\x20\xa5\xef\x2f\xde\x96\xc8\x4d\x9f\x3f\x76\xc0\x8f\xed\x8a\x93\x90\x13\x87\x4f\x8c\x81\xaa\xaa\x50\x37\x38\x04\x9d\x91\x63\xe9\xd2\x78\xd4\xe5\x16\x1a\xa5\xff\x7b\xe8\xfe\x46\x13\x9d\x61\x52\xe2\xdf\x6d\xb2\xbd\x4a\x83\x2b\xd4\xc2\xb1\x7d\xed\x43\xfc\xa8\xc7\xae\xbf\x3a\x92\xf5\xf8\x36\x0e\xd3\x95\x31\x56\x38\x5c\x26\xb8\x22\x03\xcf\x66\x37\xad\x2d\x5d\x52\xd2\xe8\x36\xb2\x37\x4b\xe2\xe0\xdf\xe8\x16\x7f\xcb\xbb\x06\x93\xf3\x25\xbd\xbd\xbd\x8d\x32\x2c\x67\x6c\x95\xc2\x9a\x4a\x79\x25\x9c\xf9\x51\x3b\x96\xbd\x05\x5a\xe7\xdc\x5a\x33\xfd\xed\x30\x07\xbe\xa2\x30\x3e\x9a\x4c\x63\x2f\xd6\x67\x8a\xfb\xb8\xfc\xeb\x3d\xa4\x91\x21\xb2\x6a\xca\x84\xbc\x61\xae\x75\xbb\x5e\xc0\x5a\x7d\x51\xa1\x7b\xb9\x1a\x63\x3f\xd8\x28\xea\xe1\xa1\x91\x93\xee\xeb\xff\xf1\xb6\x75\x41\x80\x91\x29\x08\x00\x10\x07\x09\x00\xd0\xfd\x1f\xbd\x6d\xe0\x62\xef\xf0\x1f\xfd\x1d\xa3\x36\x11\x7b\xce\x08\xb7\xf5\x80\xae\xb4\x02\xc5\xd4\xd0\x0a\xab\xfe\x2c\x30\x4b\x05\x53\xb5\x21\x9e\x24\x61\x4f\x90\x0c\xd3\xd7\x62\x40\x49\x17\x9f\xdc\x1f\x1d\x9c\xdf\x84\x9f\x67\x32\xc2\x9f\xad\x11\x8c\xd6\xc4\x9e\xc8\xe5\x09\x66\xd2\xa6\x3e\x14\x8b\x46\x98\x35\x73\x75\x3c\xd3\xac\x09\xd9\x72\x08\x23\x3d\x60\xd5\x02\x7a\xd4\x8a\xcb\xb8\x1b\xed\xf6\xa8\x6a\x28\x33\xc0\xc3\x91\x8b\x13\x63\x79\xac\x06\x55\xc9\x95\x1e\x7f\x21\x2a\xa4\x66\x68\xb9\x16\xe8\xda\x71\x19\xc3\xbd\x25\x16\x7d\x97\x5e\xdc\x6c\xd2\x65\x0c\x09\x74\xaf\x35\xef\x17\x3e\x7e\x54\x3e\xcf\x5a\xc1\xce\xe3\xda\x5b\x0c\xd8\x43\x09\xc8\x70\xe7\xde\xc4\x8e\xd1\x1f\xe9\xa3\x60\x1d\xcb\xc6\x19\xa6\x2a\xab\x87\x8a\x76\x14\xc1\xbc\x8d\x0c\x5a\xde\x9f\x76\xfd\xd3\x6d\xae\xdf\xca\xa5\xfd\x98\x12\x48\x8a\x6e\x05\xa9\x26\x6f\x7a\xb3\x3d\xf1\xe3\xb3\x2b\x7a\x24\x52\x79\x42\xbe\xc2\xa1\x84\x69\xfd\x17\xf5\x1c\xb6\x9c\x8d\xe7\xa9\xb2\xfe\xd8\x41\x6c\x6b\x7d\xac\x51\x66\x31\x23\x1c\x2b\xd1\x5f\x64\x46\x74\x4c\xf4\x98\x67\x2e\x37\xf2\xd4\x5b\x9d\x58\xec\x0e\x48\x97\xf2\x90\x5d\xa3\xe0\x53\xa1\x3c\x27\x5f\xba\xdb\x12\xf8\xaa\x37\xf7\xc6\xf2\x53\x8f\xa3\x75\xc3\x8e\x82\x2a\x4c\x27\xcf\x8b\xc6\x22\xba\x11\xa3\xbe\x73\x0b\xad\x36\x91\xa2\x51\xbf\x3c\xb4\x0c\x8c\xec\xfe\x4a\xe7\x77\x94\xd2\xd3\xd3\x17\x25\xc8\x77\xdf\x0e\x8a\xbd\x87\x94\x56\x86\xe7\x93\x1a\x8e\x4a\x1d\x43\x12\x63\x0a\xd3\x38\x59\xf7\x97\x82\xf5\x4c\xdc\x7c\x5d\xe9\x1a\xe1\x43\x0f\xea\x22\xe3\x44\x36\x41\x3a\xaa\x0a\xb9\xd8\x5d\x38\x7b\x1c\xdd\xfd\xd7\x9c\xb4\xc9\x0d\xce\x01\x59\xde\x18\x46\x7d\x1e\x7c\x95\x6e\x82\x40\x70\x8c\x48\xa9\x74\x76\x26\x62\xff\x57\x57\x85\xa0\x38\xee\x65\x45\xd0\x97\x51\xb5\x87\x4e\x62\xac\xb5\x06\xa0\x46\xfb\xd2\x27\xdc\x2f\xe8\xa9\x3d\xe3\x19\x6b\x31\x54\xed\xe6\x2c\x97\xfc\xe0\xe5\xad\xf0\xb4\xf1\x01\x34\x39\x9a\x2d\x1a\x18\x89\xec\x1d\x8f\x20\x3b\xf1\x68\x9a\x9e\x07\x04\x2e\x73\x9c\x70\x7e\xd8\xdf\xf4\xbe\x4b\xd9\x44\xd8\x8d\xc3\xf3\x43\xf1\x49\xdd\x1b\x4f\x62\x9b\x47\xc6\x31\x00\x21\x10\xa5\x7e\x34\x15\x3c\x35\xac\xc8\x77\x5d\xb3\x0e\x4c\xea\xee\xd5\x0e\xa5\x54\x89\xbb\x6c\x68\xac\x7a\x37\x97\xc4\x1e\x23\xfd\x27\xd8\xd7\xf7\x9f\x30\x18\x47\x96\xde\x59\x31\x22\x7f\x8b\x4a\x60\x99\x5b\xfc\x0a\xec\x0d\xec\xa9\x06\xbd\xc6\x6b\xbf\x3c\x6e\xa9\xac\xb5\xa5\x4b\x52\x15\x29\x9d\x7f\xa0\x16\x54\x50\x52\x75\x81\xee\xa7\x00\xac\xc7\x84\x4e\xef\xb3\x74\x6b\x34\xee\x8f\x15\xa2\xb0\x6a\x24\x3b\x11\x98\xd9\x73\x3f\x9a\x0e\xf5\x14\x50\x46\x43\x32\xd5\x5b\x6e\x26\xce\xeb\xdf\x10\xff\xf5\xb9\x87\xf3\x4f\xbf\xb2\x8d\xbd\xbd\x8b\x81\x8b\x91\xb1\xcd\xff\xe1\xe8\xfb\xff\x66\x12\xff\x5b\xe6\x7f\x1d\x78\x45\x7a\x25\x77\x90\x80\x00\x80\x16\x18\x00\xc0\xf2\x7f\x41\xff\x97\xe1\x57\x59\xc8\x6e\x80\x08\xa9\xf3\x01\x66\x70\x2d\x6d\xfb\x87\x1f\x79\x3d\x52\x5f\x16\x16\x38\x03\x72\x65\xa1\x7a\xc2\xb7\x7e\x7e\x0c\xaa\x43\x56\x23\xd7\xa3\x71\x43\xc3\x81\xd6\x0d\xa3\xc5\x70\x5b\xfe\x33\x69\x78\x6a\x54\x95\xeb\x1b\x51\x26\x18\x7e\x4e\x03\xb1\x71\x96\xa6\x83\xef\xf4\x0c\x94\x97\x05\xcb\xc7\xbc\x2e\x92\xe0\x3d\x44\xf5\xa2\x8e\x27\x85\xee\x20\x7c\x1f\x55\xe6\x06\xc2\x1f\xa6\x5f\xdb\x64\x86\x88\xfa\xe7\x40\x90\x26\x31\x72\x8c\xaf\x0f\xd7\xf3\xd4\xb1\x75\x23\xfb\x51\xce\x4e\x16\xef\xbd\x22\xae\x42\x12\x3e\xc9\xc8\xac\x94\x6f\x60\x19\x64\x08\xeb\xb0\x9a\x74\xb9\x76\xb5\xb9\x85\x52\x50\x7e\xd7\xba\x12\x6e\x34\x9f\xe8\x5f\x29\xc4\x10\xef\xaf\xd1\x7e\xe8\x7a\xc7\x26\xdd\x7b\xd5\x3d\x02\xd3\x45\xa9\x6e\xf5\xab\x8c\x4f\x55\x57\x6b\x8b\x7b\x2f\xbb\xaf\xbd\xe1\x6a\x99\x0e\x5b\xc6\x2e\x25\xcd\x7b\x37\xd4\xf3\xbf\x53\x8b\x41\x30\x56\x89\xf0\x82\xca\x1d\xd4\x38\x1d\xcc\xb7\xa8\x76\xaf\x17\x06\x70\x1e\xfc\x3f\x20\x14\x76\xa1\xdf\x9c\xe8\x40\x04\xaa\xaf\x6f\x35\xb0\xbd\x92\xeb\x9b\x98\x14\x28\xae\x7c\x97\x09\x8a\xaa\xa6\x75\xf4\x7e\x5d\x76\xc8\xa7\x08\xa4\xca\xb3\xbc\xff\x3f\xba\xd6\xe9\xc6\x0e\xb3\xe1\x01\x00\xff\x28\xdc\x7f\xda\xfc\x7f\x4c\x06\x3f\x4d\xec\xed\xe8\x1d\xec\x2c\xfe\xdd\x26\x47\x28\xca\x4b\xc0\xc3\xe0\xfc\x83\x0b\x2f\x25\x29\xaa\x0c\x00\x00\x04\x01\x00\x00\x3f\x14\x04\x00\x00\xc8\x12\xc2\xb7\x07\x00\x00\x40\x67\x65\x09\x61\x40\xf5\x34\xde\x19\x00\x00\x00\xb3\x10\x92\x13\x02\x00\xea\xe2\x60\x3f\x8c\xc0\x01\x00\x00\xb4\x83\xa4\x96\x33\x00\x80\x30\xf0\x8f\x02\x8e\xd8\x97\x99\x02\x00\xb8\xe3\x52\xa2\x42\xaa\x96\x22\x5d\x59\xbf\xbc\x70\x57\x52\x86\xef\xcc\xea\x2f\x2a\x6d\xb5\x62\x12\x25\x23\xbb\x34\x90\x42\x88\xa0\xdd\x90\xc0\xa0\xfc\x01\xe1\xfd\xdb\x64\x09\x24\xe4\xd2\xaa\x2d\x36\x32\x4e\x72\xc6\xa5\x6b\x8e\xc6\x3c\xfa\x3a\x91\x47\x24\xd4\x89\x1d\x49\x97\xc7\x07\xd9\x3e\x3b\x2c\xce\x01\xc4\x72\x3a\x59\xa6\x79\x9f\x07\xcf\x9b\x7d\x93\x86\x8c\x27\x5d\x27\xd9\x5e\xd3\x0e\xa7\xe7\x96\x55\x69\xf2\x22\x3a\x5d\xad\x1b\x38\xb4\xeb\xb8\x45\xb0\x60\x9d\x55\xf3\x1b\x5b\xfa\xf9\x88\x2f\x5b\x72\xf3\x21\xce\xbc\xf8\x9c\x12\xe5\x0c\xf3\x76\x65\xe8\xf6\x16\x41\x8f\xfb\xef\x7c\xb9\x18\xd8\xd7\xce\x32\xee\xa3\xa5\x26\xce\x9f\x8e\xe5\x35\xf4\xe8\x02\xb1\x61\x90\x5d\xcc\xeb\x3b\x47\xbd\x31\x37\xac\x32\xf9\x15\xc8\x23\x8e\x0a\x74\x6a\xf3\x6b\xba\x45\x85\x6d\x4e\x88\x3f\x06\x77\x94\x68\xb6\x72\x5c\xb1\x2e\x06\x21\x39\xdb\x1d\x2b\xbb\xee\x5e\xe2\xf6\xcf\x2d\xcb\x93\x62\xd5\x45\xba\x7e\x0b\xf7\x42\x45\x22\xdd\x36\x4b\xd5\x10\x0e\x07\xf1\x23\x5d\x5e\x23\x19\x68\x34\x10\x3c\x5f\x32\xa6\x2f\x6a\x35\xee\x8d\x00\xc1\xcf\xef\x9a\x25\x7b\x17\x68\x45\x18\xbd\x27\x31\xf2\xa1\x41\x16\x85\x00\x84\xe0\xbd\xdd\x8a\x3d\x90\x2e\xe8\xa7\x01\x80\x2d\xa4\xde\xb0\x0b\x1d\x71\x1e\x4b\xe6\x5e\x07\x48\x7e\x28\xf9\x42\xea\xe0\x48\x20\x95\x60\xd6\xc2\x6e\x68\xce\x86\x6d\xaf\x98\x09\xec\xaa\xb8\xce\xf0\x42\x3e\xc4\xd9\x31\xdc\xdb\xba\x2d\x95\x78\xd6\xcb\x29\x90\xf3\xba\xcf\x11\x51\x02\xbd\xdf\xa8\x95\xa0\x22\x0c\x92\x75\x9f\x07\x09\x00\x51\x0e\x08\x42\xca\x5f\x79\xf2\xc7\x81\xf2\xbf\x99\x7b\x41\xc6\x2b\x64\xe4\x7e\x72\xeb\x37\x89\x86\xdc\xd5\xd7\xfe\xd3\x13\xb6\x40\x13\x0d\xd0\x87\xda\x0d\x9a\x1b\xdc\xd0\x2f\x74\xb9\xde\x03\xda\xc6\x1c\xcd\xaf\x2b\x56\x9b\x78\xa1\x82\x61\x5d\x3e\x40\x61\x93\x08\xd5\x44\x3f\xbe\x17\xf5\x8c\xd0\xf9\xb6\xd2\x6d\x19\x88\x00\x42\x9d\x8b\x88\xe0\xe2\xec\xdc\x05\x6b\xe2\xfa\x01\xef\x9c\xe0\x3f\x2b\x79\x10\x0e\x7e\xdc\x7e\xca\x63\xe3\xef\x36\x19\x66\x4f\x51\x59\x60\xbf\x53\x89\x5a\xc8\xc4\xb8\x94\x11\xb3\xed\xdb\x0d\xc9\x36\x39\x0d\xc2\x9b\xe4\x21\xda\x09\xcc\xaf\x50\x8b\x20\x19\x97\xb5\x88\x84\x7a\xae\xc3\xc2\xfd\xb0\x05\x07\xb9\x86\xc8\xf1\x20\x1c\x2c\xdd\x40\xc5\xd9\x82\x00\xe5\xc1\xa1\x61\x84\x46\xfb\x4d\x05\xf5\xb3\x8c\x9f\xa4\x09\x3d\x4c\x2d\xba\x4f\x30\xaa\x35\xf4\xfd\x53\x58\x7c\xd4\xdf\x40\x58\x04\x88\x7d\xf9\xb7\xac\x2f\xdb\x51\x84\x03\xec\xac\x51\x64\xa8\x06\xc4\x50\x00\xbc\xdf\x37\x96\x57\xdd\x40\x8f\x02\x9e\xed\x08\x29\xf9\x3a\xd6\xda\x68\x58\x24\xda\x11\x4b\x7e\xb8\x96\x0f\xcf\xa5\xe2\xee\x6a\x6a\xc9\xe1\x67\xac\x1a\x46\xfa\x10\xfb\x36\x6b\xb4\x21\x41\x3e\x14\xa9\x10\x14\xb4\x0b\x2e\xdc\xb3\xab\xa6\x60\xfa\x9e\xfe\x21\x49\xb8\x99\xf1\x36\xca\x6f\x8c\x95\xf2\xf8\xd4\x37\x66\x57\x7b\x7b\xdc\x18\x48\x5b\xb6\xfd\xba\xf2\x1a\xda\x84\x3f\xf5\xa0\x50\x01\x4e\xba\x85\x72\xba\xfc\x1c\x16\x38\x56\xeb\x76\xd3\x9a\xa5\x7d\x70\xe0\xa1\x21\xa5\x0d\xd0\x23\x44\x80\xe8\xc7\xde\xe2\xb3\x65\x38\xa0\xc0\x66\xb2\x70\x99\xa2\x7f\xf5\x8e\xa2\x0e\xbd\xdc\x2d\x48\x0f\x6e\x75\x4d\x9d\xbd\x50\xda\xd3\x83\xd7\x28\xb4\xbe\xbb\x34\xa6\xf9\x56\xd0\xc3\x06\x2e\x8d\x63\x03\x7a\xeb\x99\x15\x96\x01\xf8\x8f\x25\x1a\xcf\xf6\xdb\xac\x17\xf8\x63\xa8\xdc\x19\xc5\xb3\x61\xb6\x0e\xc7\x18\xbb\xcb\x86\x66\x5c\x55\xce\x50\x11\x4c\xb8\x06\x73\xac\xed\x45\x90\x96\x30\x6b\x16\x26\xcf\x8d\x3f\x43\x0b\x30\x35\xfd\x5f\xfe\x1d\xfa\x3f\x43\x38\xa7\xd8\x46\x8a\x50\x85\xff\x7a\xe2\x0c\xaa\x69\xa8\x50\x54\xb5\x06\x2c\x97\xe0\x14\xc7\xb0\x8a\x50\x4f\x9a\xd2\x99\x27\xbf\x47\x03\x80\xad\xbc\x22\x26\x48\x05\xcc\xec\xeb\x94\x85\x03\x7c\x89\x72\x61\xe4\x69\x11\x92\x14\xe8\xa9\xaa\x24\x64\x67\x63\xf1\x64\xa8\x85\x89\xd1\x71\x86\x8d\x7d\x6e\xbc\xdc\x64\xa8\x07\xa0\xdc\xc7\xcc\x07\xcc\x39\x31\xbb\x1f\xc8\x19\xd2\x31\x13\x97\x93\x45\x93\xb2\x94\xb4\xf7\xd0\x19\xd3\x43\x29\xde\xf4\x14\x6d\x95\x62\xb9\x79\x0d\x10\xde\xc3\xe9\x52\x12\xe4\x28\x04\x89\xee\x36\xb2\x6f\xa0\xf8\xf3\xe0\x4c\x9c\x1b\x10\xd3\xee\x98\x60\xa7\x01\x05\x22\x01\xea\xd0\x6f\x99\x2e\x84\x43\xbf\x19\x79\x09\x41\x88\xa8\x57\x41\xb0\x0e\xb8\x96\xfb\x5e\xd6\xff\x28\x7b\x57\x2f\x6b\x99\x43\xb9\xb0\x70\x19\x99\xa3\xd6\xc5\x93\xed\x9b\xd4\x77\xf9\x28\x5f\x7f\xbe\x3e\xdf\x8f\x59\xd8\x8f\x5b\x20\xfa\x32\x20\x76\xbb\x1b\x10\x78\x7f\x49\xb4\x7d\xbf\x7a\x89\x75\xf7\x5a\xdc\xf6\x02\x9f\x77\x58\x46\x5a\x3f\x4f\xb7\x72\xbc\xa7\xef\xf0\xb7\xbe\x26\x6f\x6f\xf1\x5f\xd1\x7a\x84\xae\xf7\x9f\xf9\xdf\x0e\x39\x9c\x9e\x17\x76\xc6\xdf\xd6\x79\xaf\x2d\x64\x8d\x13\x8c\x26\xa8\x7a\xe7\x82\xb2\x8d\xa1\xde\xb2\x4c\xf3\x71\x73\x57\x87\x8a\xb4\xcd\xf2\x20\x67\x80\xd5\xc7\xe2\x83\xc4\x69\xfd\x18\xd6\xd0\x8d\xe7\x81\x10\x31\xae\xa1\x82\x2d\x90\x0d\xdb\x62\x07\x3a\x4b\x7f\xdf\xbd\xda\xc9\x72\xa2\x25\x4d\x6d\x24\xab\x05\x7e\x89\x77\xbf\x3d\x81\xb6\x7d\xdf\x22\xde\x9f\x30\xec\xa4\x85\xed\x78\x13\x21\xf6\x7c\x09\x12\x18\x6e\xec\x0b\xfc\x6d\xd8\x9b\xe3\x20\xfc\x81\x98\xc3\x19\xcb\xb3\xf7\x2b\x4a\x73\xa5\xa3\x66\xff\x0b\xb9\xbf\x93\x32\x6f\xf3\x1b\x7f\xd0\x57\xc2\xf7\x61\x59\x2e\x42\x21\x5a\x9a\x1b\x5d\x88\xfe\xe1\x8f\xb5\x4a\x30\xc2\x14\xb8\xfa\xb0\xbd\x84\x9d\x91\xdd\x6e\xe2\xea\xb8\x0f\x82\x6e\xee\xb4\xf9\x19\x48\xb4\x6b\x5c\x96\x09\xcb\xf2\x2b\x46\x25\xd4\xe8\x36\x28\x3f\xb4\xb1\xd8\xad\x91\x80\xd8\xed\x3e\x5e\xfb\x97\xea\x8f\x3f\x7f\x7a\xee\x2a\x4e\x8e\xe6\xd2\xd2\x85\xdc\x03\xa6\xeb\x7d\x0a\xb3\xb7\x66\x1c\x92\x9e\x1d\x1a\x86\x32\x5a\xc0\x88\xfd\xbe\xfe\x84\xbf\xc6\x8a\xcd\x9d\xbe\xbf\x78\x13\xee\x10\x1f\x73\xb6\x78\x4c\x79\x61\x9d\x41\x13\x2e\x3c\xdc\x6e\x58\x5b\x3b\xa3\x44\xc5\x4a\x5b\x31\xd3\xea\x93\x89\x4e\x81\x32\x36\x43\xd7\x6d\xab\xc2\x32\xb2\xa3\xd8\x81\x6e\x1d\x33\xed\x3a\xfd\x62\x8d\xec\x00\xbd\xe0\xce\x87\x32\x87\x83\xd8\x6c\xa7\x7d\x2a\xb8\xbd\x63\x26\x26\xde\x63\x3a\x23\x37\x56\xc4\x25\x88\x0f\x85\x6a\xb9\xf9\xd2\x06\x77\x07\xfc\xf2\x09\xc7\xf5\x2e\x13\xe7\x82\xab\xd7\xdb\x34\xd4\xcd\x6e\xd0\x44\x3a\x9e\x10\x38\xff\x35\xfa\xc9\x50\x39\xbb\x1a\x03\x4d\xda\xbf\xb6\x62\xcd\xe7\xad\xc1\xdf\xfe\x8e\xfb\xfa\xb8\xad\x73\x3f\x61\x20\xc0\x50\x17\x81\x44\x61\x2e\x3c\x2c\xee\xc9\x74\x31\xaa\xd7\xbe\x7c\x53\x09\x84\x7a\xa6\x2e\x41\xb2\xfa\xd9\x98\x68\x00\x9b\xff\xfe\xfc\xc6\xa3\x78\xef\x76\x3e\xd9\xc0\x0f\x05\xc1\xad\x06\x88\xdc\xb7\xb3\x17\x55\x86\x95\x31\x36\x96\x95\xaf\x99\x99\x28\x64\xd8\x4e\x09\x0b\x5f\x09\x6b\x75\x3c\xd8\x8e\x43\x82\x88\x23\x0a\xee\x6b\x6d\x9c\xe6\x34\x97\x0f\xe0\xcc\x3b\xe0\xbd\x0f\x1f\xf1\x20\x70\x80\x51\x47\x82\xba\xf5\xf6\x35\x98\x09\x30\x1c\xde\x60\x25\x24\x42\xf4\x7e\xdb\xb5\x5d\xdb\xd4\x15\xa6\x0a\x26\x63\x41\x97\x80\x5a\xb1\xa2\x02\xfb\xd4\xac\xee\x8a\xe1\xb9\x5b\x5d\xdd\xa8\x1e\x6e\x97\x46\x63\x54\xcb\x57\xd6\xce\xb2\x2e\xee\x69\x91\x81\xc0\x53\x04\x0b\x05\x83\xcd\xe7\x77\x07\xf7\x33\x24\x61\xfe\xa1\x51\x6d\x33\xca\xb1\x43\x1c\x4a\xdc\x4b\xba\xb9\x88\xb9\xb3\xe5\x41\x6b\xf0\x79\xa4\x00\xde\xfd\x71\x81\x1f\xd5\x73\xbb\x6a\x7b\x34\xd1\xa3\x59\x68\x47\xc3\x39\x73\xfc\x60\x6f\x8f\x49\x15\x8c\x18\x6c\x08\xd2\x0d\x09\xe4\x6f\x7d\x80\x50\x4f\xc3\xea\x37\xc4\xab\x90\xd7\x64\xe1\x87\xbf\x7c\x60\x07\xc6\x36\xcf\xb1\xeb\x62\x87\x57\xb4\x15\x68\xd3\xd6\x22\x88\xf8\x13\x2c\x03\x6f\x41\xf0\xb5\x70\x0d\x4d\x35\x19\x99\xc4\xeb\x0e\x4d\xe7\x99\x82\x85\x34\xbf\x6e\xaf\x2b\xee\xa7\x76\x1c\x44\x0c\x16\x5e\x10\x8f\x2a\x7a\xfa\x6b\x41\x08\xa9\x0d\xbe\xaf\xc2\x5e\x13\x85\x57\x4f\x7c\x22\x6e\xb0\x60\x02\x7d\xbc\x20\xb7\x5d\x70\x09\x8c\x3d\x30\x34\x02\x3a\x9e\xb7\x53\xe3\x6e\x7a\xe8\x7e\xf1\x7b\xa8\xd8\xf9\x7d\xe8\xb4\xc5\xdc\x7d\x6e\xd8\x8f\x7a\xdd\x7c\xcb\x5e\x13\xe1\x31\xe8\xe9\x4b\xd0\x43\x49\xb9\xfd\x28\x40\x5d\x51\x54\xfb\x5d\xd0\x9b\xe9\x2f\x95\xc0\x88\x8d\xa1\x11\x68\xc5\x2a\xe2\x76\x7c\xd3\x0b\xf7\x2b\x10\x21\xb5\x63\x14\xd6\x96\xae\xe9\x87\x34\xac\x3a\x34\x29\x42\x5a\x8f\xac\xa7\x30\x45\xc1\xed\x7b\x8b\x41\xda\xcd\x57\x6c\xd6\xf7\x35\xe8\x2b\x0e\x87\x3e\xe3\x75\x4f\xa4\xb8\x6f\xd0\x95\xfd\xb7\x6f\x4b\x2f\xc7\xf7\xd5\xae\xfd\xaf\x3b\x3d\x77\x80\xf9\xdb\x83\xbd\x80\x28\xa7\xe6\xec\x16\x36\x08\x5c\x1e\x24\x54\xd5\x4c\x6d\x7f\xbc\x02\xe3\xc4\xaf\x9b\x4f\xf9\x57\xa8\x06\x5f\xc8\x89\xdd\x9c\x38\x3a\xfe\x8f\x85\x0b\xa4\xdd\xb4\xfb\x69\xaf\x9a\xfc\xd8\xf4\x60\x4c\x22\x09\xf2\x42\x49\x4f\x33\xa7\x8f\x53\x7e\x3a\xbf\x8b\x0a\xda\xf3\x1f\x71\x92\x32\x7a\x19\x29\x88\xe9\xa0\xad\x60\xcd\xb8\xc8\x00\x8b\x4c\x35\xa2\x07\x61\x13\xbd\x10\xd6\x65\x16\xd1\x1f\x45\xb8\xdb\xf1\x33\xfb\xfb\x11\xe1\x95\xb4\x27\x47\xf4\xbe\x2f\x0b\x5e\xf6\xe3\x23\xe1\x42\xf4\x52\xd1\x22\xe0\x2c\xa6\x86\xb6\xbd\xe4\xf3\xbd\xd1\xb6\xbb\x77\x71\x02\x5e\x32\xaf\x83\x7d\x5b\x0a\x0e\x7c\xba\x0f\x55\x2d\x76\x63\x31\x59\x27\x47\x21\xb8\x27\x36\xd3\x13\x0f\x0c\x36\xe8\xee\xd7\x6d\x0d\x4e\x9f\x6f\xd8\x47\xeb\x4d\xf0\x6d\x6e\xae\xf8\xef\xec\x66\x1e\x4c\x15\x52\x9e\xdc\x1c\x11\x8a\xf8\xe2\x25\x0c\x29\xe2\x50\xb3\x9a\x3e\x8b\xc7\xe9\xea\x41\xf8\x6e\xca\x2e\x8e\xe6\x1b\x99\xc4\x81\xcd\x43\x62\xc4\xab\x14\x0b\x7f\x3b\xe2\x3f\x5a\xc1\x62\xc3\xde\x12\x00\x23\xae\xaf\x26\xd9\x66\xa5\xc6\xda\x11\x73\xa4\xcc\x2a\x02\xb2\xcb\x7d\x71\xf8\x1b\x40\xe3\x8c\x6d\x0c\x04\x78\xab\x62\x0d\xd3\x97\x3a\x46\xe4\xfb\x0e\xd2\x81\x2e\x14\x9c\xab\x15\xe6\xad\xb0\xf1\x99\xc6\xb1\x4b\x7c\x2c\x18\x5d\xea\x55\x56\x74\x70\xff\xb9\xab\x74\x9f\x58\x5b\x7f\xfd\xde\xa2\x91\xa3\x78\xb0\x78\x4c\xef\x6d\xb7\xa1\x8b\x9f\xa8\x0a\x98\x9e\xa0\x94\xd7\x7a\x79\xdf\x62\x2f\xb8\xc7\xcf\x5f\xd8\xee\x31\xf4\xf9\x9f\x91\x1d\x08\x9f\xa0\xef\xd0\xac\x4e\x39\xb5\x98\xa1\x17\xcc\x52\xc0\x4c\xa4\x9d\x4c\x30\xec\x0b\x5d\x14\x62\xf1\xac\x50\x70\x1c\x64\x56\x69\x5d\x60\x38\x21\x7c\xd2\xcc\x0a\xb1\x6f\xbe\x80\x60\x10\x7c\x2c\xee\xb7\x23\x55\x76\x48\x5a\xaf\xe4\x90\xb7\x5b\xed\xf3\xf3\xaf\xf9\x90\x9d\x6d\xf8\x67\x9e\x10\xc3\x28\x39\x8d\x1b\xc3\x20\xb0\xd6\xa9\xc0\xd1\xdd\x3f\x55\xe6\x0b\x2a\xb1\xeb\xdf\xad\x8b\x4b\x78\x2b\x45\x12\x40\x34\xf7\xb0\x63\xa8\x68\x7d\xdd\xd6\x8d\x1d\x68\xe0\x3a\xf9\x6c\x0c\x49\x84\xed\xc3\xee\xc1\x69\x6a\x1f\x30\x0a\x38\x64\x90\x4f\x78\xf5\x8c\xc8\xb4\x5b\x74\xbb\xd5\x55\x5b\xad\xc6\xbb\xc2\xe0\xd3\xa5\x1f\x06\xc0\xb7\x9c\x91\x29\x7e\x7b\x21\x2e\xe7\xf2\xc5\x72\x00\x43\x5f\x6d\x76\x5c\x52\xad\x3b\x2b\x00\x00\x40\x18\xcf\xea\x08\x88\xd4\xdd\x05\xc1\x76\x03\x38\x14\xfd\xe9\xa3\x4d\x4c\x3e\x9d\x1e\x52\xa2\xee\xfb\x27\x36\xee\xfb\x65\x36\xee\x3f\xa2\x24\x15\x5b\x6c\x5a\x6d\x1f\x36\xab\x9c\x9d\xfe\xbe\xb2\x8c\xa3\x93\x95\xc1\x6a\xc8\xa3\x73\x7a\xf9\x8a\xb1\xb7\xa4\xa6\xc4\x38\x8b\xb1\xd7\x7d\x6f\x86\xa0\x8e\xbf\xca\x50\x1e\xcd\x37\xfd\x05\x49\xcb\xdb\xa2\x72\x40\x96\xba\xe9\x4f\x61\x33\x7b\xbf\xa7\xe6\xf2\xbb\x0b\xd6\x6d\x6f\xa7\x90\xa1\xd1\xfa\x2b\xed\xf9\x67\x67\x25\x22\x11\x13\x24\x99\x70\x1e\xdd\xff\xc8\x1e\x14\xa5\x00\x95\x36\xc8\xc6\x1b\xa7\xa8\x7e\x3f\x3d\x39\x8d\x40\x9d\xc9\x92\xd6\x0b\x84\xc1\x13\xd0\xde\x43\x1f\x37\x0a\xdb\x49\xb5\x15\x0d\xd2\xf9\xb2\x6e\xd6\xf6\x7a\x2e\x76\x34\x4c\x83\x79\x38\x2c\xc3\xbe\xd9\x5c\xc0\x39\xb3\xbd\xaa\x5c\xdd\xe5\xe0\x16\xc6\xc4\x7a\x7b\xf9\xaa\x76\x34\xe9\x96\xd0\xb0\xda\xe3\x73\x96\x2c\x7c\x50\xad\xbb\x43\xe5\x80\x3e\x95\x16\x40\xa2\xdb\xee\xa6\xad\xc4\x44\xd4\x9f\x62\xa1\x30\x71\x6f\x55\x4d\x05\xe8\xf4\xb7\xe6\xd7\xcb\xcb\xc9\x5b\x22\x29\x54\x71\xf1\x37\x0f\x1f\x6e\x1e\xbd\xab\x27\x75\x6a\x3c\xf4\x5f\x54\x4d\x2d\x38\x91\xe8\x1c\x38\xfa\x3c\x05\x80\x76\x42\xa1\x18\xf3\xb4\x8b\x95\x02\x5a\xf4\xbb\xc8\x5a\x76\xc1\x1f\x83\x71\x19\x78\xc0\x76\x9b\x63\x60\x1c\x1e\x74\x85\x82\x27\x5a\x85\x06\x9e\xc8\xad\x53\xc7\x30\xcc\x67\x1c\x10\xde\x19\xfa\x6f\xa3\x34\x96\x97\x6a\x2b\x6e\xb3\x09\x51\x7b\xb1\x7a\xe0\xed\xdb\xe0\x8c\xa2\x08\x66\xa9\xf9\x1b\x6f\x73\x9b\xbb\xe0\x19\xd0\x84\x97\x51\x34\x19\x96\x18\x3b\x1f\x4f\x85\xf0\x79\x94\x1e\x9c\x97\x79\x36\x66\xa9\x8c\x36\x0e\x41\x7a\xf0\x41\xf6\xee\x80\x1e\x67\x27\x1a\xb4\x88\xb5\x62\xad\xaf\xf5\xe0\x31\xec\x8c\x60\x8f\xc7\x46\x60\xf6\x8f\x8f\x6b\x62\x6b\x80\x2c\x1d\x99\x7a\x6f\x12\xca\x87\x09\x95\x7a\xc0\x71\x5a\x89\xa9\x1d\x35\x5d\x43\xd4\x4f\x2d\xdd\xa9\xf1\x88\x26\x2d\x2d\x3e\x36\xba\x52\xf6\x63\xd3\x28\x10\x3d\xcd\x14\x67\xa4\x93\x7e\xb3\xd6\x0f\xfa\xd3\xaf\x13\xfa\xec\xe4\x2a\x5b\x99\xd6\x21\x33\xa8\xfc\xd7\xf1\x2a\x19\x4e\x3d\xbf\x96\x0d\xd4\x50\x50\x58\x12\x8d\xa3\x3b\x13\x65\xf0\xe7\x9a\x6a\xda\x94\x1f\x58\x0a\x40\xce\x4c\xdb\xfe\x5a\x43\x65\x53\x04\xc3\x65\x73\x10\x6f\x04\x16\xaf\x8b\x26\x19\x2b\x5e\xbe\x3e\xc1\x01\x36\xc8\x4e\x17\xfd\xea\x9d\x4d\xe5\x5f\x27\xd1\x0b\xfe\x29\x49\x0b\x8c\x36\xe2\xf0\x93\x53\x83\xe4\x29\x62\x43\xa9\x51\xd2\x3f\x50\x13\x0e\x17\x5b\x65\x4b\x06\x12\xf3\xe4\x46\xf1\x82\xd3\x33\x14\xc6\xfb\xa5\x21\x7e\xd3\x8d\xbd\x65\x88\xe1\xb7\x20\x91\x84\x3e\x60\x2a\x60\xe4\xdf\xc1\x15\x3f\x60\x2a\x48\xc1\x6d\xfd\xe8\x4a\x06\x71\xf9\x1d\x5b\x4e\xb5\xc5\x90\x52\x0c\xc9\x18\x68\xf2\xae\x84\xad\xad\x9a\x82\x2f\xaa\xa6\x69\x0c\xdd\xfb\x52\xf3\xc7\x1f\x00\x26\xac\x4a\xca\xb4\x4c\x44\xbd\xdc\xeb\x20\x97\xb8\x5a\x02\x98\xfc\x42\x0b\x80\xc9\xdb\xd2\x4f\x48\xf3\xf3\xfd\x5b\x07\x32\xfb\x42\xb3\xb2\x77\x9f\x4e\x8d\xe3\xeb\xe4\x23\x2f\x9f\x93\x9b\x26\xcb\x4f\x51\xb7\x98\x4e\x56\xd2\xcf\x2c\x75\x20\x84\x97\x57\x6a\xf4\x82\xb2\xef\x6e\xc4\x05\xca\x30\x36\x71\xdc\x86\xbe\x66\x58\x3f\x68\x29\x1c\xfa\x11\x0f\x20\x0e\x2e\x85\xf1\xf7\xb2\xb5\xad\x56\x1a\xa8\x88\x07\xcb\x80\x7a\x65\x6d\x2d\x80\x21\x00\x68\xa2\xb3\xaf\xa4\x46\x56\xfc\xfe\x28\x43\x42\xef\x7f\x82\x58\x06\xb9\x7e\xa2\xa8\x82\xc5\x69\xd4\xa9\xdc\x24\x06\x74\xf9\xdb\xfd\x04\x6b\x8d\x70\xd7\x48\x7f\xd1\x6f\xd4\xcd\x23\x61\xbc\x73\x3c\x6e\x61\xc8\x0b\x06\xca\x70\x57\xb0\xc0\x52\xe3\xdb\xeb\xee\x24\x73\xc4\x4b\xfa\x05\xe8\x3a\xa5\x88\xdb\x4e\x4f\x80\x07\x0f\x93\x98\x0b\x00\x89\x29\x54\x8c\xc2\x59\x89\x2b\xac\x59\x6e\xb3\x79\x83\xb3\x96\x52\x0e\xe0\x82\x77\x4f\xd1\x82\x14\x83\xaf\x64\xac\x99\xf9\xf9\x2d\xc3\x04\x4b\xbf\x80\xa1\x13\x06\xa8\x70\xb6\xf6\x65\x82\x7b\x03\xa1\xa3\x5f\x26\xd2\xa4\x84\x99\xaa\xe1\x51\x63\x37\xad\x74\x81\xc8\xfd\x50\xbd\x16\x60\xf0\x4d\x29\x3f\x84\x65\x54\xaa\xf4\x17\xa1\x7a\x09\x7d\x8e\x30\x57\x58\xb1\x31\xee\x01\x86\xf7\x26\xee\x0e\x52\xa6\xf5\xbf\xf7\x2c\xf6\xea\xa3\x4f\x1a\xaf\xee\xa5\x26\xdf\x70\x39\x9f\x15\xfc\xdc\xb5\xf8\xbd\xdf\xc9\xdb\x42\x3e\x1c\xce\x26\x03\x75\x47\x3c\xac\x25\x8d\x0b\x4d\x84\xa0\x43\x82\x90\x8c\x64\x9d\x07\x37\x8d\x61\xf4\x13\x9a\x52\x65\x83\x41\x46\xb6\x40\x90\x28\xa6\x25\x5d\xa0\x40\x1a\x8d\xea\xdb\x8b\xc0\x21\x9c\xb7\x34\x80\x86\xb4\x59\x79\xea\x20\x46\x70\xd5\xf7\xca\x50\xf9\x24\x78\x4a\x69\xa5\x1d\x2b\x2b\xea\x65\x73\x10\xcb\x90\x66\x23\x08\x51\xb8\x32\xa4\x5d\xfe\x20\x8c\xe5\x5d\x5f\xc7\xf0\x1e\x57\x31\x16\x47\x4b\x2b\x35\xe8\xee\x47\x98\x1a\x6a\x68\xf4\x49\x33\x3b\xeb\xa9\x0f\xe9\x9f\xb0\x9a\x79\x1c\x75\xc3\x16\x16\xc6\x89\x59\x31\x17\xad\x8e\x3f\x18\x30\xd9\xcd\xcd\x70\x27\x95\xd3\x57\x6b\x4d\xf7\x49\x13\x41\x12\x56\xe3\xa3\x25\x20\x97\x54\xd4\x97\xef\xad\x3e\x22\xfe\x62\x57\xc1\x5a\x52\x6d\x6d\xd3\x06\x84\x4e\xf8\x8f\x84\x29\xfa\x7b\xf0\xa9\xd7\x2d\x6f\x2b\x93\x4e\xb9\x5a\x86\xc6\xdf\x5a\xcd\x81\xd7\xa3\x92\x35\x85\xfb\x0c\xf7\x1c\xfb\xd7\x9b\x90\x2b\xab\x6b\x93\x1c\x99\x91\x5b\x79\xd3\xfd\x10\x06\x85\x2f\xc5\xda\xf4\x3b\xbc\xf5\x6d\x1c\x9b\x9a\x47\x3d\x9d\x3f\x62\x84\xc6\xcc\xc9\x90\x91\xf6\xa5\xa8\x0b\xda\x64\x85\x3b\x3c\xb0\x19\x7c\x51\xc5\xa8\x72\x9e\x77\x3a\xd1\x34\x56\x15\x17\xf1\x19\x1f\xb5\xac\xc9\x0e\x55\xd2\x68\xc6\x38\x9a\x5f\x03\xd7\xca\x8e\x95\x72\x63\x26\x63\x91\x0c\x94\x82\x42\x6e\xa4\xe9\x44\xe2\x25\x06\x21\x1e\x26\x0d\x42\x19\x9a\x94\x97\x27\xb1\xd8\xe5\x8d\x1d\x5b\x7c\x41\x98\x1f\x25\x46\xad\x43\xae\x02\x9c\x15\x03\x7b\x05\x7a\x6b\x1f\x0a\xe7\x96\x8e\x2a\xc1\xa2\x96\x88\x2a\x44\x40\x10\xa9\x12\x94\x27\xe9\x43\xca\x78\xed\x28\x85\x7b\xd5\x02\x68\x00\x5d\x81\x1b\xc5\x12\x88\x7d\xa0\xda\xaa\x80\x2f\xc0\x8a\x36\x4c\xbf\x4f\xc7\x19\x73\x6d\x7e\xbf\x07\xcc\xfe\x7c\x58\x86\x91\xc7\x7a\xc0\x91\xab\xa3\xa7\x30\xbd\x88\x3f\x6c\xf8\xe7\x4f\xac\xdc\x70\xb8\x5e\xd8\x50\x32\x38\xc7\x4e\xc6\x40\x92\x07\xef\x2b\xf8\x5d\xc7\x9d\x0b\xc4\x49\x57\xdb\x4a\xfe\xfc\x29\xe6\x5c\xfd\x48\xe7\x1f\x09\x67\x34\x13\x08\xa3\x8e\xe6\xdf\x5b\x86\xe4\x86\x81\x2e\xf8\x3c\x4e\x92\x68\x58\x92\xa4\xe8\xe8\x83\x81\x20\x29\x26\x45\x50\xea\x36\xe8\xa8\xc4\x22\xc6\x0c\xc0\x6c\xb4\x41\xc6\x5d\xf1\xac\x04\x85\x92\x05\x62\xae\x91\xc1\xd0\xbf\xfc\x38\xe7\x42\x27\x6b\xf4\xa0\x5d\xb4\xfa\x44\x95\x03\xb5\x23\xfb\x48\xc1\x24\xd8\x40\xcd\x29\x02\x9e\x8f\x47\x04\x69\x99\x27\x20\xcb\x75\x10\xd4\x4c\x78\xc5\xe3\xa9\x11\x96\x2d\xae\xac\x89\x2d\x2b\x03\x56\x3c\xe1\x29\xfa\x07\xb1\x7e\xb6\x5d\xaa\x6c\xa8\x92\x8b\xa9\xa9\x2f\x71\xd5\xe4\x27\xd2\x83\xbc\xf2\x5b\x0e\x74\xeb\x2b\x2b\xca\xe6\xeb\x42\x43\x7d\x08\x2d\x21\xec\xb3\x54\x6d\xa9\x08\xb8\x24\x91\x6a\xfd\x6b\xab\x29\x12\x3f\x5c\x41\xb9\xcd\x22\x49\x03\xc7\x19\x35\x2c\xcd\x66\x3f\x0f\xaf\x3f\x04\x77\xc1\x1c\xda\xfe\x68\x1f\x65\xf1\x61\xaf\xdf\xe8\x9c\x88\x42\x1d\xae\x69\xa5\x06\xd5\x5f\xb7\x61\xfb\x5d\x91\x79\x4f\x65\x3a\xb9\x27\x16\x91\x5c\x89\xb4\xe3\x21\xae\x7b\x08\xbf\xaf\x89\x93\xc3\xa3\x3c\x3d\x25\x7d\xe1\xf9\x8d\x28\x9d\x1a\xee\x36\xb2\x9a\xae\xaf\xca\x4a\xfb\x69\x29\x94\xba\xae\x00\xde\xda\x58\x63\x0b\xef\x22\x9d\xef\xcb\xe9\x1e\xf6\x1a\xe3\xb1\x8e\xfb\x4b\xec\x47\xe0\x85\x26\xff\x4a\xbc\xe8\xdc\x29\x12\x59\x6b\x97\x21\xba\x64\x2b\xc8\xa1\x05\xc5\x12\x8b\x47\x9c\x9d\x6a\x80\xf6\x07\xa7\xe2\x81\xd1\x63\x9f\x45\x6e\xbc\x45\xee\x47\x04\xde\xe6\x33\x58\x47\x9f\xf1\xbe\x0a\x71\xfc\xa3\x1b\xac\x10\x72\x3a\xe2\x69\xfb\x24\x10\xcd\xdf\x6a\xc1\x61\x01\xd4\xe1\x73\x07\xb6\x1a\x4c\x6c\x7a\x22\x0a\x69\x9f\xcc\xdc\xa7\x68\x27\x8a\x11\x2b\xc9\xfb\x47\x11\x83\xde\xed\xcc\xc5\xf5\x32\xb7\x96\x20\xbe\xc3\xde\xbd\x67\x41\x2b\xd7\x40\x93\xc1\xca\xf7\x11\x1f\x13\x3e\xb9\x58\xf8\x90\xc4\x92\x8c\x47\xdc\x87\x0a\x9f\x45\xeb\x07\x94\x45\x0f\xd6\xf3\xd1\x39\xb4\xc2\xc1\x6a\x12\x12\x18\x81\x62\xe0\xa5\xbe\xd3\x0b\xa0\x9e\x97\x8a\x2b\x3c\xf4\x34\xff\x18\xb8\x0c\x91\x52\x09\x7f\x09\x12\x51\x30\xbb\xaa\xa8\x86\x44\x94\x00\x55\x2e\xb4\x72\x79\x7c\x08\x93\x89\xba\x7f\x39\x4f\xfb\x82\xfd\x0e\x8c\x33\xe8\x71\x44\x80\x97\x11\x17\x06\xe5\xcf\x98\x8a\x9e\x80\xb3\x02\xf9\x1c\x30\x17\xf2\x5f\x34\x01\xaf\x8d\x36\xe7\x03\x68\x62\xfa\x3b\x73\x0a\xc4\x7a\xfd\x06\x74\xf1\x11\xc8\x9a\x73\x99\xbf\xec\x18\x9d\x2e\x58\xb1\xca\xec\x73\xbf\xf7\x59\xf2\xa9\x87\x40\x44\x57\x22\xe8\x41\x3e\x0b\xd0\x85\x21\x5c\x26\x89\x40\xe6\x25\x0c\x84\x01\x86\x13\x45\xde\xaf\xbc\x1d\xc0\x26\x0a\x53\x20\xd3\x31\xa3\x01\x1f\x09\x58\xa9\x78\x38\x43\xcd\x4b\x3f\x24\xaa\x70\xb6\xce\x1f\xae\xb2\x06\x62\xea\x9d\x9c\xd5\xed\x2b\x09\xcd\xeb\xba\xe3\xc0\xf8\x12\x65\x42\x6b\x3f\x62\x7a\x41\xd7\x7f\x41\xd7\xaa\xb7\xa6\x22\xfb\xbb\x06\x67\xd8\x62\x88\xe0\x9e\x22\x66\xdb\x08\xd1\x1e\x00\x53\x01\x66\x4e\x00\x10\x3c\xae\x97\xe4\x76\xdf\xb5\x04\x9f\xf6\x1f\xb5\x90\x8c\xc5\x8e\x5c\xbc\x20\x29\xbb\x4a\x5c\xb7\x93\x0b\xf5\x37\x67\x79\xe3\x5f\x3e\x0a\xe5\x00\x51\x3e\xe1\xae\x7d\x7f\x4f\xf0\x16\x6b\x64\x12\x79\x60\xb5\xbe\x40\x61\xdd\x08\x2e\xbb\x2b\x57\xcc\x61\xcc\xc5\x54\xc5\x9e\xfa\x8c\x3d\x0e\x96\xe7\x10\xde\xb8\xd1\xb0\x17\xeb\x22\x4d\xc2\x1c\xc1\xd6\xf1\xbb\x68\x07\x38\xd5\xe4\x97\x00\x31\xe0\xbb\xb1\xe7\xfb\xcc\x2f\x49\xe4\xdd\xef\x47\x58\x2e\xf0\xc9\x1d\xab\x50\x0b\x3d\xb6\x8f\xa7\x11\x9b\x1b\x0a\xf1\xe5\x0c\x55\x20\xa1\x34\xd7\x5b\x18\xa1\x97\x74\xb4\x0f\xd4\x34\xcf\xe9\x74\x97\xff\xd2\x4b\x3a\x8b\x8c\x14\x20\x23\x3f\x9c\xd3\x56\xb0\x90\x05\x3b\xfe\x36\xdc\xb9\x7c\x55\xda\x7b\x6f\xa5\x8f\x91\xca\xbc\xbe\xca\xf8\xde\xa0\x61\xc1\x81\x25\x51\x72\x62\xcd\x7e\x40\x0a\x84\x46\xfc\x15\xd5\x53\x21\xc5\xad\x63\xa5\x54\xa8\x31\x86\x06\x93\x99\x64\x99\xf9\xa2\x97\x6b\x3f\x7b\xb1\x7a\x5b\x10\x66\x04\x7e\x52\x95\x9b\x9e\x4a\xde\xd8\xd7\x97\xbf\xe2\x89\x04\x12\x4b\x7c\xc0\x02\xb6\xe8\xb0\xe1\x4e\x21\x00\xa3\x18\x6a\xf2\x08\xb5\xff\xa7\x9a\x36\x2c\x8a\x50\xad\x45\xc0\xf3\xd0\xd0\x88\xcb\xd4\xc8\x94\x2e\xc2\x0d\xcb\x90\xda\x54\x20\x5f\x06\x8e\xf9\x4a\xd3\x5d\x90\x87\xa7\xb9\xf5\x72\xdd\x1d\xd4\x14\x99\xe3\x54\x17\xc2\xa2\xee\x11\xfb\xc5\x83\x60\x44\x8b\x5a\xde\xa9\xed\x20\x0a\x5d\x05\x59\xef\xcd\x18\x55\x84\x19\xa8\x64\x61\x4e\x69\xe4\x80\xb0\x12\x30\x17\xa5\x02\x6e\x5e\x17\x8d\x93\xdd\x4f\x7b\xa0\x1b\x59\x5b\xcb\x48\x1e\xe0\xaf\x56\x78\x2b\xa5\x28\x27\x16\xd1\xde\x3e\x24\xa4\x07\x9e\xfe\x91\x0e\xb7\x86\x88\xf6\xc5\xb9\xbe\xbd\x1e\x9e\xd9\x26\xc4\x3d\xf7\xc1\xf8\xce\xb2\xcd\x76\xc0\xb1\xfc\xa7\xef\x2d\xb9\xb5\x8d\x80\x46\x28\x46\xdd\x41\x1e\xb8\xba\xec\x24\xae\x06\xb6\x09\x55\x00\xf5\xd0\x6a\x14\xa4\x02\xd7\x10\xd7\x50\x26\xae\xcc\x08\x33\x72\xe3\x38\xec\xba\x1c\xf5\x8e\x99\xea\x5e\x1d\xf2\xc0\xed\x05\xe3\x7f\xf1\xf4\x58\x2b\x7b\x12\xb4\x81\xb0\xf7\x2e\xce\xda\xd8\x56\x0d\x17\x71\x53\x66\xec\x09\xbc\xa8\x11\xdd\xfc\x25\xaa\x6b\xcb\x98\x1b\xd7\xfb\xfa\x58\x8a\x1e\x5a\x0f\x7e\x6d\x63\x9f\xaa\xdf\xea\xc9\x41\x2f\x6a\xd4\xae\x78\xef\x64\x7a\xb6\x71\x75\xa3\xf8\x62\xb2\xd0\x30\x76\x83\x3d\x66\x6c\x81\xc1\x07\xb2\x67\x73\x5c\x3a\x47\xba\x5a\xdb\xcb\x64\xba\x0b\x0e\xae\xa8\x72\x39\xa4\x1f\x82\x28\x55\x3a\xc7\xbb\x46\x36\x07\x59\xc4\x58\x7f\x92\x0f\x79\x5b\x9e\x92\x3a\x97\xe3\xdd\x52\x86\x60\x92\xe3\xfe\x77\x89\x0b\xd7\x8e\x87\xbf\x83\xb3\x61\x30\xf3\x8e\x1b\x3d\x80\x65\xaa\x3e\x05\xa2\xe2\xd7\x2e\xcb\x85\x53\x3b\x86\x9e\xec\x16\x9c\x80\xb0\x88\x65\xe3\xc2\x54\xcc\x9e\x69\xe6\x14\xf2\x34\x13\x67\x8c\xfc\x02\x12\x68\xbf\x21\x83\xf2\x4f\x9c\x67\x9f\x56\x30\xdc\xf4\x17\x14\xcb\xd8\xe1\xfe\x4f\x4f\x85\x47\xc5\x2f\xea\x3c\xbf\xc7\x7c\x4c\xa4\x87\xd0\xf0\x6e\xf2\x7e\xb7\xf4\x6b\xfb\x1b\xe7\xd7\xb9\x6c\x17\xd4\x56\xf9\xb8\x1d\x3a\xbe\xd3\x42\x74\x9b\x05\xd8\x40\x3e\x7d\xef\xe3\x85\x14\xcf\x9f\x0d\x84\xd1\x38\xc8\xcc\x6b\xe9\x6e\xc1\x0b\xac\xe9\xa1\x1b\xb3\x0e\x89\x2d\x3c\x22\x03\xb9\x20\xf9\xd1\x5b\x46\x44\x16\x8b\xa1\xf8\x27\xfa\xb5\xb8\xac\x0e\x57\x16\x4a\xec\xcc\xf3\x16\xae\x81\xad\x1a\xdd\xa3\x66\x53\xec\x16\x3b\xfd\x41\x33\x8f\x50\xdd\xb6\xd2\xc0\x17\x6d\x82\xe7\x0e\x4b\xc3\xda\x15\x0d\xc6\x4e\x8e\x5d\x25\xa6\x55\xdd\x59\x54\x4b\xce\x16\x50\x24\x32\xff\x4f\x1b\x16\x6c\x0b\x34\xff\xeb\xd2\xd0\x3e\xbd\x06\x4b\x3f\xf8\x61\xc9\xe5\x93\xe9\x0c\xb7\xf6\x5f\x46\x25\x67\xc3\x8c\xfe\xb2\xf2\xa1\xe4\x04\x88\xe8\x01\x8e\x10\x26\xb5\x5c\x1a\x33\x90\xd9\x3e\xdb\x23\xa7\x96\xb3\x76\x21\x09\x50\xaf\xca\x98\x06\x51\x0f\xac\xe7\xb0\x29\xde\x2d\xb5\x23\x1b\xe1\x66\xcd\xda\x73\x1c\x72\x42\x79\x35\x5a\xba\xac\xa9\xc3\x73\xb7\xfc\xe1\x42\x54\x92\x3d\x74\x30\xcf\x0b\x32\x9d\xd7\xcd\xc2\x50\x58\x0c\xee\xd9\x00\x1e\xf3\x37\x03\x24\x7f\xf1\x2f\x11\x90\x97\x59\xee\x96\x95\xd3\xf7\xa0\x6c\x8f\x61\x5e\x89\x96\x4f\xad\x96\x57\x4d\xbc\x3c\x36\x30\xcf\x0e\x3e\x66\xe6\x45\x9a\xc2\x01\x1f\x14\xe5\x40\x14\x04\x78\x49\x56\x5e\xda\xba\x53\xa8\xc1\x5b\x12\x25\x63\x70\xff\xbd\x4a\xd0\x27\x94\xde\x9f\xac\x02\x59\xc1\x47\x2e\x17\x5d\xd1\x14\xac\xc2\x52\x9a\xf4\x55\x97\x28\xfd\x83\x9d\x4d\xf6\xaa\x46\x1f\x0f\x58\x21\x58\xf8\x4d\x22\x80\xe7\xa5\x8d\x29\x8d\x9f\x1b\x7f\xaf\x1e\x12\xf2\x75\xf6\xdc\x5a\x3a\x84\x4c\xf2\x40\xd2\x4d\xe8\xad\x9b\x3f\xe7\x77\x4e\x92\x02\xf8\x7b\xe0\xc0\x3a\x52\x12\xfa\xf4\xa1\xe3\x9e\x68\x0e\x4c\x82\xde\xdc\x46\x07\x5f\xe2\x8f\xc1\x49\x01\x16\xa2\x0d\xcc\x31\xf5\x16\x5a\x4c\xa1\x20\x31\x3a\x1b\x8a\x16\xd9\x59\xd8\xe4\xe0\x85\x39\x9c\xe0\x0e\xbd\x23\xdb\xde\xf7\x26\xf9\x50\x64\xab\x16\xe8\x42\x9d\x35\x5f\x82\x3c\x9e\x31\xf4\x11\xf9\x13\xd1\xd1\x2f\x29\x9a\x65\x12\x3b\x7c\xc7\x95\xef\xc4\xa2\x02\xa2\x24\xf4\xdd\xfa\x18\x94\xa8\xa3\x25\x6f\x2d\xae\x9b\xaa\xde\xb2\xf3\xd0\x93\xe9\xdd\x3f\x81\x8c\xe6\xca\x4a\x50\x8c\xd1\xb7\xb7\xa7\x06\x11\x4b\xa0\x86\x8b\x02\x24\x82\xc4\x09\x46\xef\xdf\xda\x00\x78\xb5\xf7\x6e\x4d\x3d\xf9\x68\x08\x32\x18\xe0\xef\xc2\xad\x37\x69\xfe\x1d\xd1\x93\x68\x15\x30\xcf\x11\xc1\x5e\x8c\x7c\x86\xdd\xa1\xb4\xa8\x3a\x85\x53\xa0\x0a\x23\x6f\x5e\x84\x0c\x8c\xb0\xe8\x2e\x90\x35\x97\x06\x34\x4d\xe4\x0b\x2a\x23\x1d\x6b\x0e\xbb\x58\x29\x64\xad\x49\x00\xd7\x12\xce\x7e\xd6\x63\x61\x1a\x97\x92\x27\x7a\x53\x04\xc0\x86\xd3\xf3\xd7\xa4\xf6\x8b\x03\x44\x36\x7f\x44\x52\xfc\xcd\x8c\x19\x65\x10\x2b\xf9\x45\x93\x81\xb7\x9f\xd4\x44\x44\x1f\x9d\x94\xd7\xa6\x6f\x86\xbe\xfb\x9e\x7d\x4c\x2b\x2e\x64\xef\x1a\x74\x14\x54\xc8\xa7\x81\x04\x03\x9b\xda\x1d\x9c\x2f\x98\x54\xc4\xb0\x76\xa7\x86\xa0\xd7\x1d\x97\x60\x18\xb1\x2f\x87\x0d\x39\x43\x0b\x22\x24\x1d\x82\x95\x9d\x9b\xa9\x92\x57\x1d\x60\xf2\xd1\x43\x24\xa8\xf0\x8b\xd2\x3c\xbd\x41\xd7\x14\x40\xdc\xc7\xb1\x54\x78\x0b\x43\x43\x1b\x59\xb0\x57\xbf\x89\x07\x0d\x3b\xbb\xc5\x75\x2f\xa7\x18\x8b\xdd\xed\x2e\xa9\x14\x7a\x39\x18\x2e\xcb\x83\xf4\x78\x81\x88\x0e\x26\xe9\x6b\xe0\x8f\x65\xbf\xce\x2e\x1f\x13\xa7\xcd\x09\xee\xa0\x8c\xd4\x4f\xec\x7f\x1d\xb4\x37\xfc\xc2\x57\x23\x74\x70\x95\x00\x72\x1b\x60\x2d\x20\x9c\x7c\x27\x6f\x32\xa6\xea\x2d\xa9\x73\xad\xeb\x24\x7a\x5f\xae\x94\x91\xd1\xdf\xb4\x87\xfc\xd1\x13\x92\x60\x98\xd6\xfa\x32\x5d\xd3\xed\x88\x0f\x3f\xe6\x82\x01\x2e\x17\x9b\x9f\x3c\x15\x74\x96\x8c\x63\x1f\x02\x8e\x5a\x84\x28\x10\x6c\x03\xc3\x51\x08\xe5\x0d\x1d\x28\x30\x3d\x3d\x9b\x69\x14\x05\x65\xd7\x28\x08\xb6\xd2\xc2\x94\x5b\x8b\x6b\x12\x8f\x1c\x78\xdb\xc0\x75\xdd\x39\x7c\xed\xcc\x79\x7f\xd7\x26\x0f\x83\x25\xc8\x2c\x2b\xfe\x4e\xce\x84\xb0\x82\xf8\x2c\xc5\xcf\xf5\x43\x86\x05\xb5\x9e\x13\x5f\x0d\xc8\x1c\x61\x24\x25\x47\x54\x5e\x21\xc8\xb7\x0f\x13\xf3\x08\x8b\x4d\x9a\x6e\xcb\x72\xce\x1c\xde\x5a\x1a\x36\x4b\x7a\x0a\xbf\xf6\x18\x4c\x9f\x92\xed\x43\x0a\xb0\x10\xb3\x9f\xc6\x10\xb2\x7f\xf9\xd6\xf1\x8f\x17\x01\x34\x0e\x7a\x77\xe6\x46\x23\x10\x41\x3e\xca\x0c\x3f\x01\x70\xa6\x67\x26\x11\xeb\xea\xf8\xd1\xc3\x5b\x5f\xa6\x0d\x98\x63\x07\x43\x4c\x47\x82\x25\x44\x30\x4f\xe6\xcc\xe8\xf2\x3b\x74\x44\x6b\xba\x58\xe9\x28\x35\xcc\xcd\xe8\xf3\xc4\x95\x65\x2d\xf5\x7a\x09\x4d\xd8\xb0\x04\x1f\x84\x56\x68\x00\xcf\x2a\xd9\xef\xd9\xe9\x07\x02\x82\x79\x41\xf8\x23\x78\x2b\xab\x3e\x08\x12\xcb\x14\x8f\xd2\x30\x48\xf0\x7f\xcb\xfb\xd0\x02\x1c\x9a\x21\x3d\xb7\xde\x16\x23\xec\x04\xf6\xa0\x10\xf7\xc7\x4b\x94\xea\x29\xaa\xd2\x08\xa5\x3c\x99\xff\x14\x30\xe6\x64\x24\x87\x68\x35\x56\x3d\x20\x19\x13\xdb\xd7\x40\x9d\xb6\xd7\x8a\x9e\xc7\xf5\xc1\x7e\x71\xdc\xce\xa8\xbe\x85\xc1\x2b\xba\x0b\xc1\x6c\x44\x6b\xe4\xb0\x65\x9b\x3c\xf1\xd4\x75\x9b\xf8\xf8\x3d\xb3\xf5\xc4\xb9\xd2\x7d\x09\x91\xf3\x6f\xd7\xf8\x39\x8f\xca\xc7\x62\xa4\x47\x22\x6e\xc0\x8d\x19\xfb\x0a\xcb\xd3\x93\x1d\x0d\x7e\x14\xed\x8f\x17\x19\x5b\xad\x82\xd5\xf3\x5b\x83\x58\xab\xf9\x63\xf9\xef\x33\x62\x32\x52\x5a\x26\x8a\x72\xde\xf2\x6d\x06\x7c\xd9\x9d\x49\xc8\xaf\x42\xc3\xfc\xe1\xc3\x1a\x6c\x0b\xf6\x6b\x80\x2d\x30\x22\x1e\x10\x01\xd2\x95\x79\xee\x0a\x65\x0b\x59\x0b\xa4\xfe\xe8\x7f\x7e\x67\x44\x78\x20\xa7\xa9\xe4\x2c\x22\x74\xfc\x1c\x70\x67\xbd\xab\xe3\x37\x8a\x1d\x37\x7c\x7a\xcd\x67\xb3\x17\x70\x64\x9f\x45\x4d\xf4\x17\x3e\xbc\xe5\x0a\xdb\x14\xd4\x75\xc4\x9a\xdc\x0f\xbb\xf9\x76\xe2\x32\x45\x39\x9c\x4c\x7a\xcd\x28\xef\x7f\xda\xb7\x51\xc7\x38\x61\xfe\x01\x65\x1d\x8c\xcf\xa8\x20\x1b\x4b\x73\x06\xf2\x58\x52\x3b\x29\x77\xe0\xc9\x42\xad\xa5\x35\x3a\xba\x3c\x9c\x98\xbd\xa3\x9b\x9a\x34\xe1\x81\x8d\x7b\x2d\x14\x08\x2f\x09\xe2\x61\x7c\xf1\x18\x98\xad\x97\xd7\xa2\x99\x95\x44\x17\x4d\xf9\x92\x0d\x6c\x60\x8b\xdb\x26\x89\xe1\x54\x20\x6e\xbb\x16\x73\x02\xb9\x43\x50\xc6\x4b\x08\x63\x4e\xbf\x5b\x93\x15\x35\xbf\xa4\x9c\x0e\x56\xc8\xa3\x16\x04\xe1\xf2\x1f\xbc\x7a\x69\x71\x06\x8c\x2b\x1f\x03\xe2\x03\xdf\x4f\x4a\x66\x07\x48\x97\x1f\xa0\x80\xbf\xf7\xf2\x2b\x44\x94\x9d\xfd\xe9\x93\xc0\x0f\xe8\x6a\x6a\x53\x67\x48\xe6\x6e\xf3\xf5\xc4\x21\xcd\x6c\xcd\x70\xc4\x6b\x7a\x47\xc9\xb9\x20\x0f\x66\xf2\xb4\x12\xa6\x54\x1e\x18\xe9\x89\x9d\xdb\x38\xe1\x64\x47\xe7\x6d\x60\x40\x28\xa3\x26\xc9\xcc\x8c\x32\x47\x4c\x38\x09\xd2\x64\x51\x50\xc1\x0e\x28\x96\x34\xb1\x10\x5c\x5c\xa0\x98\x40\x0b\x4f\xea\x95\x20\x3f\xf0\x12\x5e\x75\x45\x31\x88\x4c\x73\x6f\xf0\x18\x99\xae\xfb\x53\x82\xdf\x4b\x13\x40\x4b\x12\x47\xc1\xa1\x20\xfb\x45\x47\x5b\xfa\xb1\x41\xc8\x38\xd0\x21\xcd\x5d\x94\x79\x47\xa8\xe1\x60\x4b\x1c\x2b\x49\x4c\x5d\x99\x68\x70\x19\xdd\x7d\x5c\xd5\x97\x19\x1f\x2e\x28\xcd\x17\x41\x4f\xb1\x9d\x5f\xa5\xd0\x0d\x03\xf4\x41\x8e\xd1\xb1\x62\x7d\xf0\xd2\x4e\xe6\x9d\xac\xb8\x20\xeb\xeb\xdb\xe3\xd5\x6b\xd2\xf3\x62\x07\xba\xb8\x3a\x90\x07\x39\xce\x11\xa7\xf9\xc1\x46\x65\x49\xae\x93\x0a\x02\x21\xb1\x90\x5f\x34\x4e\xca\xc1\xd4\x81\x97\xa5\x50\xea\x96\x68\x8e\x72\xc5\x07\x0e\x17\xd4\x95\xaf\x0e\x5c\x20\x2d\x79\x79\x01\x1b\x33\x73\x47\xc0\x44\x22\xd4\x82\x00\x23\x45\x28\x58\x3c\x83\xfe\x48\xa1\x48\x12\x87\x31\x5a\x61\x21\x2c\x18\x15\x43\x37\xee\xa8\xb5\xe0\x95\xfc\x0b\xd2\x72\x51\xac\x13\xc3\x50\xce\x03\xee\x8b\x54\x24\x34\x43\x64\x7d\x25\x28\xd8\x5a\x57\x4f\xfd\x69\x62\xd5\x65\x75\xa7\x73\xf4\x28\xef\xd3\xfe\x6a\x65\x3e\x00\x79\xdb\x15\x34\x1a\x59\x18\x8a\x77\xee\x5e\xd2\x80\x28\x85\x78\xdd\x07\xfc\x69\xde\x7e\xc9\x82\xd1\x5f\x88\x6b\x8e\x56\xf5\xb4\x93\x41\x07\x63\x33\x04\x99\xed\xce\x6c\xdd\x9c\x11\x14\x4e\x78\x50\xa3\x5b\x7a\xa0\x7c\xd5\xf4\xd8\x9b\xd4\xe6\x68\x84\xc7\x9a\xa6\x4e\x62\x03\x6e\x85\xd3\xd3\x63\x64\xb6\x96\x01\x84\xcc\x78\x49\x3d\x55\x96\x35\x06\xae\x35\xec\x6d\x20\xf8\x1a\xaf\x17\x70\x65\x42\xe4\x16\x2c\x58\x30\xd7\x1f\x80\x16\xba\xa1\x12\xf9\x89\xad\x48\xf4\x95\xa5\xc7\x1a\x9d\x1e\x03\x27\x9d\x5a\xc5\x11\x7b\xc2\xa8\x0c\xe8\x47\xca\x08\x41\x6e\xb0\xec\xc1\xdc\x63\x2a\xd5\x70\xbe\x6d\xac\x8c\x2d\x55\x38\x34\x03\xcd\xde\xa5\xe1\x1a\x05\x3e\xc2\xfc\x8d\xd6\x55\x35\x18\xd5\xcc\x8c\x38\x70\x97\xff\x82\x24\x4f\xa4\x6d\x55\x0d\x6c\xbb\x00\x00\x00\x00\x29\x31\x79\xd1\x6a\x61\xc3\xc0\x7f\xdd\x61\xc6\xf8\xa7\x4e\xa4\x93\x99\xc9\x4f\x87\xff\xea\xb1\xd6\x98\x41\xf0\xda\x3a\x00\x00\xb8\x04\x02\x00\x54\xff\x92\xf7\xdf\xf5\x92\x43\x14\x79\xec\x06\x18\xe1\xb6\x7c\x60\x82\x1a\x94\x16\xf1\x7d\x40\x6d\xd7\xa2\x84\x0d\xc9\xb8\x89\x71\x26\x20\x86\xd0\xe0\x41\x76\xba\x12\x8f\xbc\x3b\x8b\x92\x7a\x9f\x0f\x0a\xab\xd5\xf4\x44\x04\xc6\xfd\xad\xfc\x24\x49\xe2\x80\xed\x38\xeb\x2d\x4f\xa0\x99\x93\xf0\x78\xa3\xd0\x4f\xeb\xd0\x5c\xb9\xa3\xc3\xa4\xf2\x87\x49\xa7\x99\xa7\x64\xb7\x68\x39\xb7\x45\x43\x5a\xbd\x60\x0d\xa5\xf9\x9b\x2c\x52\xd3\xef\x4d\x68\xbc\x11\xae\x12\x91\xeb\xb6\x14\x9a\x78\xce\x2a\xf3\x68\x88\x4c\xd8\xfc\x46\x6b\x63\x7b\x86\xe8\xcf\x94\x81\x35\x29\x79\x8a\x29\x64\xb4\x28\x26\x70\xac\x6c\xe9\x7a\x1e\xf3\x2e\x2a\x3e\x0b\x47\x6d\x13\xd7\x3f\x47\x97\x92\x43\x75\xcf\x1d\xb5\xcb\x45\x30\x03\x8f\x89\x1f\xca\xd2\x4d\x6f\x68\x98\x77\xa8\x18\xbf\x87\x62\xde\x47\x73\x99\x3a\xa5\x37\x74\xe9\xd0\x66\x28\xb7\xbc\x77\x70\xde\xce\x0f\xae\x43\x36\xdd\x11\xd6\xb6\xb6\x13\xa6\x9a\x3b\xad\xab\x8f\xcf\xff\xcd\x0b\x82\x7f\xd6\xc2\xd9\xc4\xe9\xa7\x83\xcb\x7f\xa3\x85\x20\x59\x46\xbf\x1c\x10\x00\x18\x06\x01\x00\xc8\xfe\x25\xef\x7f\xda\xfb\x06\x0e\x36\x46\x26\x66\xf4\x56\xce\xff\x8e\x6e\xa6\xd4\x67\x35\xc0\x08\xd7\xf5\x4c\x23\xba\xda\x17\xb2\xbf\xa5\xc1\x0c\x51\x1c\xb6\x35\xbc\x6a\x1b\xcd\xee\x98\x3c\x45\xdb\x1c\x74\xd9\x08\x4b\xfc\xcd\x3f\x72\xbd\x59\xf4\xd0\x97\x50\x4c\xd9\xf5\x8c\x56\xae\x2d\x82\x00\x51\xdb\xe5\x5a\xc6\xee\xc0\xbe\xa8\xca\x28\x2c\x27\x2e\xf4\x54\x54\x24\x2e\x24\x76\x75\x4d\x37\x0f\x4d\xbd\x51\xad\xde\x6f\x79\x21\xe8\x5d\xe0\x8a\xe1\xe4\x3e\xe3\x8b\x50\x46\x53\x24\x22\x69\x46\x48\x80\x52\x7a\x87\x62\x2d\xd2\x2a\x50\xe0\x96\xaa\xa3\x11\x7f\xa1\x6d\xd7\x31\x0f\x93\x48\xce\x4c\xd1\xe4\x52\xd0\x1e\xfa\x81\x1c\x8a\x2e\x38\x7e\x5b\xcf\xb4\xef\x65\xd1\xc9\x7f\x95\x57\xf3\x42\x5d\x55\x98\xab\x02\x15\xef\x96\xe3\x2a\x5e\xcc\xfd\xd0\x05\x90\x21\x47\xb4\x55\x81\x9a\x64\x2f\x60\x0d\xc6\xed\x3d\x4d\xa3\x9e\x60\x9c\xff\x2a\x06\xfe\xae\xe8\x3d\x97\xdb\x53\x0b\x6a\x69\x18\x5c\x54\xd6\xe8\x88\xe8\x3d\xd8\xc7\x78\x49\x36\x86\x86\xe8\x15\xe6\x6f\x85\x61\x69\xbd\xd0\xf1\xae\x26\x9a\x71\xe6\xa4\x17\xdb\x86\xdf\xe3\x21\x50\x79\xf7\xcc\x12\xd6\x02\x95\x71\x71\x6e\x72\x78\x35\xca\xe6\x9e\xd2\xd4\xde\xf9\xcb\x7a\xda\xab\x13\x5f\xfd\xfc\xa3\x30\x54\xfa\xc7\xa2\x3a\xce\x94\xad\x4e\xf6\x5f\x7e\xd5\xb5\xdf\xb0\x5f\x15\x32\x8f\x07\x8e\xce\x41\x31\x26\x21\xa5\x42\xc6\x17\x62\x8e\xc7\x46\xdc\x4a\xd0\x2b\x21\xfd\x2d\xfb\xa8\x76\x69\xbd\x98\xac\x0f\xcb\x8e\xec\x96\x9d\xa2\x26\x8b\x4c\x7c\x3e\xae\xc2\x1f\x20\xff\x5b\x2a\x30\x85\x32\x3d\x47\x68\x00\xa0\x87\x10\x00\xa0\xff\x97\x52\xfd\xb4\x73\x33\xb3\x73\xb1\x77\xf2\x30\x70\x32\x73\x76\xb1\x77\x32\x72\xf9\x69\x6f\xf7\x1f\x44\xab\xd2\xba\x71\xda\x68\x45\xfe\xae\x2e\x15\x64\xe5\x60\xca\xa0\x6a\xdc\x2a\x5a\x91\x57\xde\x5a\x4a\x6b\xe5\x33\x70\x8e\xa0\x74\x5a\xdf\x6e\x25\xc0\x26\x21\x8e\x80\x86\xb6\x02\xcc\xb2\x53\xce\x3d\x3c\xf2\xbd\x99\x6e\x81\x0b\xa2\x10\x95\x6b\x4a\x55\x20\xa2\x80\xd3\x3d\x7d\x7f\xb9\x01\x8b\x5f\x4b\x90\x22\xbd\x49\x5a\x42\x46\x13\xa1\x13\x52\x54\x69\x29\x75\xea\xd1\xc4\xe4\x87\xfd\xa6\x55\xa6\x8d\x85\xd2\xce\x15\x99\x1d\x07\x06\x5f\x86\x2c\x5d\xdc\x25\xe9\xc1\x62\x04\x12\x63\xa8\x8c\x29\xc8\x65\x46\x29\x0a\xd1\x51\x13\xcb\x02\x19\xe9\x65\xfa\x24\xfb\xd6\x50\x53\x8e\xa3\xa1\x28\xd4\x88\xcb\xa4\x61\x11\xa2\xb4\xd8\x22\x61\xe9\xd5\x3a\x9e\x37\xed\x72\x50\xa6\xed\xc6\xa2\xcb\x89\x58\x99\xf5\x4b\xf3\x61\x28\x27\x84\x35\x9f\x44\xed\x4e\xe9\x28\x2f\x43\x1d\xc9\xa5\xe2\xd1\x2b\x92\xc3\x48\xab\xdc\xc1\x70\x98\x1d\xff\xf8\x45\xc0\xfd\x85\x8b\x20\xd5\xa2\x37\xa5\x9d\x07\x87\x05\xe9\x62\x20\x5b\x20\x1c\x2d\x45\x0e\x48\x5b\x1a\xa8\xcd\xc5\x69\x76\xbb\x89\x22\xe8\xd3\x02\x21\x3b\x66\x92\x46\x55\x54\x54\xf1\x4f\xbd\x91\xbe\xce\x18\xbd\xb6\xac\xac\x8f\xc4\x43\x1d\xf3\x17\x12\x6e\x34\x17\xa5\xa9\x78\xc6\x42\x94\xf8\x7d\x88\xb9\x9b\xfb\x5b\x55\x68\x97\xca\xac\xad\x1b\x5c\x62\x89\x9e\x24\x7a\xf8\x42\x7b\x26\x70\x24\xda\x66\x16\xf3\x16\x76\xd4\x9a\xbd\x58\x06\xc5\xc5\x07\xa7\xaa\x1c\xce\xea\x73\x60\x16\x39\x80\xa1\x4f\x95\xfa\x25\xca\xdc\x96\xc7\x8a\xc0\x2b\xda\x32\xb1\x31\x7a\x9d\x2e\x1f\x2a\x98\xd2\x6f\x02\x7b\xfd\xbe\xda\x35\x88\x7c\x58\xd2\x6a\x19\xf5\xbe\xa3\xf0\x16\x5c\x7b\xf9\xc5\x0c\x46\x46\xb4\xf8\x4e\x82\x6b\x7d\x3d\xbc\x67\x12\xeb\xa8\x68\x13\x8e\x6c\xf9\x0a\x47\x59\xa8\x4a\x4b\xcc\xff\x38\xf4\x2a\xfa\xcd\xfa\xc3\x75\xa7\xec\x22\xbf\xdd\x51\x40\x26\xda\x96\xef\x1d\x35\x95\x69\x48\x17\x13\x2b\x87\x90\x0f\x27\x41\xe2\x70\xb3\xd5\x88\xf3\xe4\x80\x2d\x48\xb9\x8f\xb6\x44\x9d\xd6\xae\x4d\xb3\x34\x51\x10\x61\x94\xc2\x88\xb1\x3e\xc9\x6e\x7d\xb5\x6c\xe3\xd2\xc3\x85\x53\x3b\x95\x77\xd9\xe6\xc6\xb5\xab\x49\x23\x02\x26\x37\x33\x1c\xfb\x01\x5d\x6f\x8c\x3d\x4f\x43\x56\xaf\x57\x78\x17\x6c\x39\xbe\x18\xcf\x47\x25\xfc\xbc\x7f\xf6\xa0\xd9\x32\xc3\x3c\x5f\x0b\x30\x73\xaa\xfa\x12\x5e\xf4\x5b\x78\xcc\xaf\xc8\x0a\x3f\x3a\xe6\xc8\x06\x41\xe7\xe4\xa0\x36\xd6\x2f\x27\x9f\x60\x80\xca\xc4\xbb\x78\xf9\x10\xd2\x69\x51\x41\x6e\xea\xa8\x92\xe2\x1b\xd6\x3f\x41\x7d\xce\x92\xf7\x8e\xcd\xf9\x71\x1b\x25\x55\x6a\x79\x0f\xc3\x44\x3b\x43\x32\xf7\x3e\x38\x22\xcd\xda\x70\xc7\xdc\x92\xf2\xbb\x85\xac\x04\x06\x86\xde\x50\x18\xad\x7f\x09\x2e\x47\xd9\x94\x36\xd0\x12\x6c\xa5\xf4\x85\xe6\x94\x09\xcf\x05\xee\xf9\x57\x5e\xa5\x1f\xd7\xd4\xda\xd3\xeb\xdf\x60\xcb\x58\x25\x84\x0c\x31\x8d\x49\xe9\xed\xd8\x66\x39\x82\x0c\xaa\xfa\x65\xee\xa0\x7e\x76\x68\x97\x59\xaa\x22\x71\x5a\x2c\xb5\xe8\xcd\x22\xe1\x14\x56\x52\x27\x3d\x73\x42\x64\x64\xc5\xb7\xc8\xd7\x9f\xf7\x29\xb9\x8f\x89\xc3\x7d\x85\xd8\xec\xd0\x55\xbd\x20\x0e\xd0\xfd\x72\xee\x19\x0c\x82\x96\xbb\x10\x1f\x96\xc2\x9b\x35\x23\x86\x75\x91\x85\xb5\x49\x6a\x28\xee\x75\x83\x25\xc9\x79\xc8\x2f\x2a\x48\xb3\xd0\x5a\x2e\x44\x75\x5b\x72\x3b\xc9\x5c\x25\xf4\xe8\x28\x95\xca\xc0\x18\x27\x38\x0d\x87\x53\x69\x84\xaf\x14\x5e\x84\x93\xb0\xbd\xb8\xb9\xa3\x3b\xf4\xd7\x8f\x8c\xe8\xb8\x61\xf9\x22\x1d\xbb\x28\x84\x96\x21\x0e\x27\x16\x4d\xab\x64\xaf\xdf\x6d\xdc\x1e\x47\xea\x40\x37\xb8\x12\xb3\x28\x66\xf5\x70\xcb\xc3\xb4\xb5\x0d\x0e\x24\xde\x84\xfb\x94\x88\x5f\x9d\xf4\x5c\xf9\xc6\x5d\xe0\xf1\x61\xde\xf1\xe0\x79\xd8\x89\xd4\xd8\x71\x3a\xc7\xe9\x96\x42\x2a\x8b\x45\x9a\xdc\xd7\x57\xea\xe8\xa3\xfc\x5d\x2a\xb3\x6d\xe8\x52\x65\xbf\x29\x62\x21\x2c\x74\x9d\x05\x32\x66\xed\x4e\x40\xbf\xfa\xe9\x30\xa0\x49\xda\xc6\x94\xd1\xf1\x26\x11\xb9\xbb\x67\x73\xa0\xce\x32\x41\xf2\x12\x4b\xf5\xf4\x2a\xf9\x7b\xd0\xca\xed\x4e\x7f\x2b\x5b\xb3\x4e\x5b\x06\xe9\x07\xdc\x90\x30\xfd\x80\x08\x5a\xef\xe2\x6e\xe3\x84\xf6\xe2\x8d\xc9\xb4\xdc\xe6\xb2\xcb\xc1\x89\x21\xcd\x28\x66\x82\xcd\x0d\x9d\x83\x44\xe2\xcc\x67\xe9\xc6\x23\x14\xc8\x65\xa7\xf5\x6e\xbf\x52\x6c\x71\xe1\xd1\xe0\xc8\x57\xe8\x6d\xf2\x70\x1c\xc8\x77\xa0\xa1\xa2\xc9\x34\x6f\xc5\xc1\xf4\x90\x27\x9f\x03\x38\x89\x34\xa0\x59\x79\xca\x31\x31\xce\x5a\x8b\x83\xbe\xc9\xe6\x30\x20\x77\x9e\x08\x51\x96\xca\x4f\xcd\x2e\xa5\x20\xf0\x41\x68\x63\x37\xc2\x9c\x32\xac\xd2\x95\x05\x2e\xde\x22\xbd\x06\x0c\xac\xf6\xdd\x05\x4d\x04\x6d\x77\xee\x9e\x81\x82\x1d\xd6\x35\x1c\xf6\x9f\xd2\x7f\x45\x53\xa6\xbe\xab\xe5\x75\xd0\xc3\x5d\x51\xef\xc8\x8c\x9a\x81\x42\x03\xa8\xea\x74\x70\x25\xc2\x0d\xfd\x6d\x90\x2e\x06\xc6\x7e\x85\xa1\x3a\xcd\xc2\x3f\xc6\xda\x1a\xc2\x52\xb1\x71\xc2\x7a\x94\xf9\x81\x20\x4c\x72\xc0\xfc\xea\x2d\xab\x30\x1a\xe0\xf4\x06\xdc\xee\x75\x5c\xe8\x62\x49\x3d\xaa\x38\x04\x0f\xcc\x2a\xb5\xc6\xfc\x3e\x6d\x47\x1d\x76\x9e\x42\x2a\x5c\x6d\x06\xc8\x0e\xea\xf9\xbc\xa2\xa7\xde\xa9\x5a\x23\xfd\x34\x0b\x54\x37\x0e\xfb\xbb\xc3\x34\x55\x6b\x5b\xc6\xa6\x76\x98\x9a\xa0\x06\x1c\x42\x8c\x27\x07\xab\xfa\x88\xcf\xd9\x55\x4e\x96\xfe\xc4\x76\xef\xf7\x34\x52\xb1\x56\xda\x3c\x1f\xd3\xe3\x18\x34\xac\x94\x93\x84\xeb\x15\x7c\x73\x94\x18\x6f\xdd\xb1\xb1\xee\xb8\x2a\xba\xd0\xe9\x56\xa8\x79\xf8\x98\x8f\xb6\x95\xeb\x10\x01\x30\x46\x4d\xa1\x98\xef\x4e\xd6\x26\xe0\x1f\x78\xc7\x4d\x51\x51\x90\x12\x58\x1a\x9a\xf7\x32\x21\x78\xad\xd4\x6e\xf6\x91\x0a\xcb\x99\x88\xab\x8d\x2d\x56\xc0\xa0\x90\xc6\xcc\xb8\x8a\xfd\xf9\xd1\xba\x9c\xcd\x76\xe0\x91\xc3\x63\x31\x86\x01\x01\x8e\xaf\x98\x44\x7f\x56\x0f\xf3\xe6\x16\x82\xaf\x87\xfb\xe1\x18\x86\x74\xb9\x62\x60\x89\x16\x94\xd3\xe3\xab\xc5\x03\xe7\x0e\x04\x4e\x7b\xf1\x0c\x3c\x97\x28\xe6\x9b\xb3\x6c\xbd\xd1\xe6\x3b\x25\x19\x4c\x8d\xa2\xea\x7e\x9f\xec\x3c\xb6\xfc\x46\xcb\x55\xd8\xf0\x1f\x70\x0c\xa1\xc5\xb2\x2e\x49\x1d\x7c\x1c\x00\xcf\x66\x9b\x98\x0a\xba\x98\xf6\x5e\x47\x3b\xaa\x8c\x9e\xe3\x9b\x60\x06\x4d\xb7\x6e\xe6\x16\x51\xad\x61\x1f\xa3\x23\x03\x56\x7a\xec\xb5\x5c\x59\xc9\x98\xaa\x53\xad\x25\x1f\x6b\x4c\x05\x89\xb7\x87\x6e\x33\x84\x4b\x58\xde\x9a\xf7\x5d\xc9\x6f\xc8\xdb\x01\xe0\xce\x48\x4c\xb8\x33\x67\xf4\x72\xfe\x0e\x9a\xbe\x61\x03\xf4\x5a\xaf\x02\xa5\x36\x92\x1c\x16\xfb\x4d\x10\xfa\x1e\xa8\xc7\x6d\x47\x3f\x84\x6e\x70\xef\x4d\xe3\x74\x99\x5e\xe7\x79\xd9\xae\x2f\xeb\x71\x1c\x4a\x73\x37\xe7\xb2\x96\x61\xae\x5a\x85\x4a\x5f\xa1\xb9\x82\x12\xa0\x1d\xdb\x9d\xe6\x44\x13\xc9\xa7\x98\x65\x4b\x18\xd2\x7b\x14\x84\x5a\x5c\xb4\x43\x61\xd9\xa1\x82\x21\xd8\xf3\x3e\xf9\x07\x0b\x95\x31\x1c\x24\x27\x62\x39\xbe\x7e\x89\xde\x82\x87\xe1\x7f\x0d\xa4\x60\xb6\x44\x7a\x4a\xa0\x4b\x32\x86\x72\x08\xac\x7e\xce\xad\xb4\xb3\x47\x3f\x67\x33\xc7\xf0\xda\xaf\x08\x55\xf2\x79\xae\x57\xc5\xe0\x03\xc1\xb3\x9a\xa2\x3d\x0d\x70\x09\xe1\xaa\x46\x6c\x25\xd2\xa7\xbc\xbc\xcc\xff\xe8\x84\xed\x30\x3e\x9f\xe2\x73\x2f\x70\x69\x42\x68\x2a\x7d\x37\x52\x5d\xa7\x5f\x66\x37\x3c\xdb\x13\x7e\xc1\x0f\x28\x32\xac\xe7\x10\x78\x9e\x68\xb8\x1a\x78\x1d\x9e\x4e\x94\xcf\xa4\xbc\x89\xa4\xb1\xe4\x12\x29\x23\xf9\x3d\x82\xf0\x7f\x89\xfa\x16\x5b\xe1\x1f\xf9\xe3\x8a\xdc\x72\x10\xe7\xe3\xcc\xf2\xf9\x6e\x86\x70\xef\x06\x68\x04\xa9\xf8\x7d\xb4\xca\xd6\x70\x87\x7d\x40\x66\xc2\xc5\x4a\xbd\x11\x2b\x2f\x85\xe6\x90\x77\x7d\xcd\xab\xe0\x62\x15\x84\xf5\xe2\x77\x49\x07\x25\x53\xaf\x55\x6b\xfb\x69\x01\x20\x79\x73\xde\x72\xd6\xa3\x3b\xf0\x41\x33\xb1\x5f\x26\x35\xe7\x33\xcc\x1a\x4b\x66\x22\x0a\x9f\x06\x49\xf3\x3d\x94\x9c\xcc\xa0\x27\x31\x1b\x1f\x56\x4b\x85\xc3\x21\xd1\x1a\x4d\x9f\x70\xe7\x53\xf2\xb7\x99\x8f\x2a\x07\xd8\x82\xdc\xcc\x22\xee\x90\xa9\x19\x6a\xbc\x51\x86\xf7\xbc\x7b\xd5\x62\x31\xf5\x52\x17\xf5\x70\xd6\x13\x93\x03\xe9\x0d\x63\xd5\x53\xb6\x66\xe9\x89\xb8\xde\xda\x5c\xcb\x76\x38\x7d\x43\xf7\x03\x42\x0f\xd0\xc1\xf1\x4c\xdb\x8f\x16\xb1\x43\x1e\x3f\x11\x60\xea\xdf\x8f\xb1\x38\xab\xb6\x06\xc2\x18\xa1\xd7\x33\x5f\xcb\x5b\x61\x37\x1b\xb1\xfa\xe1\xd4\xa2\x96\x01\x9d\xc6\xd9\x40\xd5\x3a\xad\xae\xe3\x1c\x9f\xca\x71\x16\x1f\x7b\x65\xeb\x4d\xc9\xd0\x81\xe2\x61\xed\x44\xac\xfc\xa6\x52\xe2\x94\x40\xa1\x80\xe0\x51\x43\x8d\xb2\xbf\xe0\x3c\x85\x88\xcc\x9b\x88\xe5\xb8\xf0\x5e\xb3\xbc\xa7\x41\x0e\x82\x27\x49\x45\xe0\xb9\x0d\x1a\xe9\x3d\xdc\x1f\xf5\xfe\xee\xc0\x6e\x27\xac\x90\xda\x51\x31\x98\x2a\x1e\x26\xcc\xfc\x37\xcb\x24\xf7\x68\x97\x21\x0f\x8f\xaa\x06\x2a\xb2\x4f\x36\x87\xe3\xa3\x14\x03\x5d\x9f\xdf\x96\x2a\xe3\xae\x0e\x3e\x4f\x30\xc0\xb6\xba\x79\x42\xfa\x50\xbb\x48\x1e\xd7\x4b\xa6\x27\xa4\x33\xd8\xd6\xa5\xdf\xed\x19\x7a\xb2\x84\x3c\xf1\xfa\x46\x90\x4c\x2e\x11\xeb\xc4\x9d\xa7\x72\x9d\x44\x38\x30\x74\x8f\x4a\x1f\x51\x1a\xfe\x41\x69\xfa\xef\x70\x20\x06\x7c\x60\x1f\x84\x33\x60\x9f\xd0\xbb\xb9\x7d\xef\x88\x5d\x7d\x1d\xd8\x2d\x4d\xbf\x34\x51\x7c\x36\x26\x37\xe0\x7e\xe2\xbf\x7c\x86\xc2\xce\xa6\xbd\x55\xe3\x29\x6b\xb7\x20\x05\x43\xf1\x8a\x28\x63\x46\x7e\xb4\x7a\xb6\xee\xff\xc2\x41\xcd\x5e\x9b\xb9\x31\xf8\xbe\x7a\xdc\xfa\x28\x6d\xf9\xce\x36\xf8\x70\xf3\xe5\x1e\x20\xb1\xac\xc4\xe6\xf0\xb3\xe7\xfb\xa5\x68\xc8\x8b\x4d\xe2\x5f\xf4\x47\x93\xb9\x27\xed\xda\x0d\x7e\x7c\x47\x01\x82\x7a\x42\xc3\x6b\xf6\x68\x97\xba\xfa\xf4\x33\x58\xab\x5d\xbb\xe2\x95\x84\xe6\xd9\x50\xbb\x8e\x96\xc6\xee\x79\x2f\xe3\xa6\xcf\x36\xe9\x3e\xee\x59\xa5\x2a\x9f\xe8\xa9\xc9\x58\x60\xbd\xf5\xb7\x37\xe2\x95\x74\xdd\xd8\x53\xad\xb1\xc0\x0c\x9d\xa8\x63\x47\xd8\x6c\x8a\x7f\x7b\x7b\x9e\xec\x65\x92\x22\x2c\xe0\x93\xf8\x5b\x9b\xaa\xc5\x7d\x5d\xe6\x91\xea\xc8\x51\x9d\x42\x76\xd9\x75\x6f\xff\xc2\xc0\x56\x85\xfc\x66\x47\xe4\xa2\xcd\xa3\xe4\x62\xa9\xbc\xde\x5c\x72\x7b\x8b\x7c\x8b\x19\xef\x74\xae\x19\xa6\xca\x0d\xf2\xd7\xd3\x5f\xd1\x1e\xbe\x81\x96\x3f\x5f\xda\x3d\x08\xeb\x13\x1d\x13\x1d\xe7\xe7\x0e\x6c\xc4\x31\xc1\x44\xd7\x71\x85\x2f\x35\x55\xb9\x66\x05\xc9\x89\x24\x90\x19\x71\xa3\x77\xcf\x87\x2c\x93\x6d\xf8\xe3\xb8\xf9\xd1\x63\x2e\x30\x9e\x78\xf1\x84\x46\x07\xce\x28\x50\xf7\xf1\x78\x5b\x7c\x62\xf7\x1d\x02\xdc\x12\x70\x15\x77\x72\x45\x30\xce\x09\x18\x78\x7e\x06\x22\x29\x27\x03\x69\x9e\x5f\x07\xe0\x7e\x81\xa7\xd5\xc4\x32\x0a\x78\x53\xee\x9b\x3f\x85\x24\x63\xb7\x66\xb9\xd8\x5d\x3a\x17\x2b\x57\xfc\xea\x33\x02\xde\x21\x41\xc3\x10\x4b\xcc\x79\x11\xae\x8a\x62\xdb\x3f\x30\xff\x77\xb0\x8b\xcb\x58\x5b\xd2\x07\x00\x00\x39\x00\x00\x00\xff\x5f\x06\x3b\x5b\xa3\x9f\xff\x29\xc7\xe9\x4d\xf6\xc1\xfb\x13\xc1\x85\xec\x75\xa8\x60\x41\x3a\x00\xc3\x88\xfd\xe5\xca\x92\x53\x01\x28\x9b\xfa\xbf\x09\x16\x55\x10\xbf\x9f\x23\x89\xa5\x7f\x1c\x98\x4a\x56\x6a\x24\x34\x86\x78\x4c\x2c\x30\xef\xa2\x66\x82\xe8\x13\x53\x5f\x10\xa5\xd9\xff\xc9\x4c\x90\xec\x09\x90\x76\xf8\x84\xea\x15\xc8\xde\x92\xef\x66\x21\xbd\xc2\x8d\xb7\x1e\x49\x8c\xed\x9e\xfa\x3c\x0f\xec\xe7\xe0\x02\xfe\xef\x45\xa7\x2c\xf7\x48\x9d\xc3\x03\x00\xed\x9c\xff\xee\xe2\xe0\x6c\xe9\x6a\x63\x6d\xe6\x64\x60\x6c\xef\xfe\x1f\xd6\xde\xa5\x63\xeb\xbc\xc1\x8e\xf2\x75\x6d\x00\xf4\x62\x6e\x72\x49\x31\x2b\x95\xe3\xa9\x72\xb1\x63\x88\xdd\xca\xad\xf9\x99\x13\x91\x64\x6d\xcf\xdc\x0d\x46\x42\x1c\xc1\x26\x66\x05\x35\x9b\x9a\x79\xda\xb3\x43\xaf\x2f\x09\x00\xa3\x5e\xbb\xc2\x13\xe0\x24\x09\xd0\xa3\x25\xa0\x7d\x82\x97\xac\xd7\x3c\x0e\x21\x22\x1c\xf3\xd1\x2f\xba\xf3\xbf\x65\xc3\xb4\x61\x22\x4a\xbb\x7d\xd6\x9e\x3d\x75\x13\xa4\xaf\xb7\x94\x67\xa9\x33\xcc\x70\x6f\xd0\x67\x96\x67\x37\xd5\x3e\x84\x75\xba\x8d\x13\x4b\xac\x2b\xcb\x57\x71\x9c\xd4\x72\x1c\x89\x0c\xba\xdb\xd6\x4f\xb6\xd0\x8d\xbf\xf0\xeb\xf6\x79\x5d\x18\xba\x78\xb6\xd0\xe8\xe9\xf2\x7b\xc8\xd3\xc0\x5c\x39\x4c\xee\xdc\x20\xbd\xc5\x53\x15\x25\xe5\x67\x58\xd0\x51\xef\xcc\x3b\xac\x08\x0a\xd8\xd1\x34\xbf\xcb\x1c\xba\xe1\x79\xdf\x9c\x41\xed\x6b\xef\x38\x04\x9f\x32\xd7\x79\xa3\x3d\x85\x3b\x16\xef\xe0\x29\x1e\xb3\xa1\xdf\x56\x98\x77\x82\x6d\x60\x93\x59\xf8\xf0\xc5\xad\x19\xc2\xa0\x9f\x20\xeb\xb8\xba\x66\xa2\x39\xf5\xc4\x8c\x68\x79\x8e\x5a\x66\xdd\x20\x91\xd3\xa2\x5d\xa0\x43\xb1\x97\x30\x57\x3e\x7c\xd6\x81\xc8\x44\x8f\xb6\x3d\x53\xb6\x51\x89\x94\x82\xd1\xbe\x70\x6d\x03\x0e\xf1\xf2\x53\x08\x30\x0b\x25\x97\x24\x46\xd3\xa7\x2b\x9b\x10\x1c\x81\x67\x1d\x36\x86\x4b\xe9\xc7\x53\xcb\x2d\x40\x33\x12\x91\x50\xde\xe1\x78\x92\x23\x6b\x61\xbc\x26\xa2\x1b\xfb\xe0\x35\x50\xd3\xa9\xd4\x71\xd0\x35\x48\x92\x79\x88\x9b\xcb\xea\xca\xf8\xf6\x00\x3a\xf4\xf3\xbc\x93\xfb\xc3\x8d\xab\xfc\xf6\x40\x0f\xbe\xe2\xd1\x6a\x29\x6f\x59\x1c\x72\x5c\x61\x95\xe6\xca\x63\x92\x15\x7c\xc3\xaa\x48\x39\x4e\x6a\xaf\x59\xc1\x28\x34\x92\x76\xb0\x25\x1d\x47\x3c\x21\x3b\x13\xf7\xf2\x05\xaa\xb1\xc8\x54\xe6\x41\xef\xbb\xc9\x61\xf9\x55\xd2\xcd\x14\x98\x0c\x94\x57\xdf\x5e\xd1\x20\x85\x62\x6b\x76\xd9\x22\x12\xc9\x6d\xdc\xb6\x2f\xde\x5d\x9a\x64\xff\x3a\x10\xe1\xdc\x63\x0d\x43\x1b\x94\xb8\xc4\xe4\x0d\xc3\x93\x24\x0f\x90\xbc\x5e\xb2\x64\xf5\x7d\x9d\x51\x73\x51\x35\x6c\xc0\xb9\x1c\x48\xea\x24\xcc\x8e\xbe\xa0\xc9\x26\x8b\x99\x2b\x71\x8c\xac\x5f\x86\xec\x9c\x1b\x4c\xb7\x6b\x46\xd1\x11\xdb\xd1\xb6\xb5\xe0\x4c\x4f\x2e\xfd\x06\xd8\x9d\x44\x9e\xa6\x94\x30\x5d\xdc\xf1\xc3\xd2\x4a\x4e\xf5\xe5\x99\x8d\x5c\x6a\x17\x29\x30\x67\x2e\xc3\x78\x89\x36\x7a\x72\xaf\xbb\xe6\x85\x73\x21\x1b\xda\xb7\xe6\x47\x15\x73\xe0\xcb\xe5\x9f\x3c\x08\xcd\xe1\xf3\xbd\xba\xcb\x83\xe3\xf6\x47\x3e\xbe\x65\x21\x36\xa1\x69\x43\xb7\x4c\xfe\x32\x74\x6e\xa2\xa8\x29\xb5\x60\xfb\xd3\x0c\x1f\x51\x16\x7e\x5c\x34\x12\x49\xc2\x60\xa3\xc8\x2a\x96\xa6\x99\xd5\x15\xb3\xb9\xca\x70\x0c\x87\x04\xf0\xee\x23\xa4\x1d\xcd\x76\x5a\x1d\x1f\xa3\x1d\x93\x84\xe1\x29\xd9\xae\x9e\x75\x20\xef\xe9\x09\x9e\xe1\xbb\xb4\x1e\x1e\x28\x5e\xd3\x20\x7c\xac\xf5\xa7\x59\x21\x69\x7f\xd7\x12\x0a\x8b\x06\xb2\x90\x09\xa1\xc7\xc3\x75\x15\x6f\x8f\xf0\x13\xfb\x2e\x1f\xd6\x4e\xc4\x9f\x18\xf2\x2a\x33\x1a\x1e\x3e\xf2\x04\x7e\x23\x18\xd5\xc1\xf4\xf9\x85\x34\x5d\xe0\x53\x45\x48\x1c\xe6\xef\xec\x20\x33\x59\x53\x81\xce\x3e\x70\x36\x13\x0b\x85\xa4\x29\x4a\x8d\x4a\x66\xb3\xe4\xe2\xc3\xf4\x9e\xc4\x26\xf0\x36\x20\x15\x3a\x5e\x12\xb3\x13\xc9\xa1\x57\xcf\x92\xe6\xf1\x87\x08\x22\x04\xdc\x32\x9a\x26\xdc\x75\x64\xd5\xad\xa8\x4d\x9d\x16\x8b\xc6\x7a\x33\x0a\xf2\xbd\x6a\xca\x58\x5e\xd4\x0c\x7a\x6a\xdd\x7b\xd4\x94\x34\xa4\xc1\x41\x1e\x7a\x0a\xb6\xb9\xc4\x2c\xa1\x88\xb5\x55\x2c\xbf\x59\x2b\x12\x67\xde\x5a\x1f\x65\x4e\xdf\xab\x29\x08\xf2\xba\x62\xe3\x3a\xc9\xad\x87\xe7\x84\x4a\x61\x14\x9b\x4d\xb6\x9e\x09\x2c\x57\xbc\x91\x4e\x68\x02\xb3\x02\x3b\x7d\xbc\x3c\xe4\xaf\x73\xa5\x36\xaa\xf9\x08\x81\x33\x61\x8c\xd0\x50\xb6\xad\x57\x75\x68\x11\x18\xfb\xcc\xd7\x19\xfc\xe2\x91\x00\x03\x9d\xf6\x81\x14\xdf\xd8\xc3\x3c\x8b\x17\x0b\x5b\xfd\x29\x67\x4e\x5a\x2c\xce\xe0\xf0\xbf\xb1\x3b\x55\xcb\x1c\x23\x0d\xc0\x72\xb2\x53\xb1\xcf\xe1\x4c\xcc\x5a\x5f\x01\x15\x26\x42\xe1\x46\x8c\x13\x58\xcd\x9b\x9e\x84\x52\x83\x24\x85\xc3\x41\x83\x7b\x9e\x53\x6c\x64\x07\x9c\xb0\x0a\x91\x7b\x3e\xa4\x58\xc9\x1a\xa2\xbc\x84\xa4\xa5\xb4\x55\x9f\xbf\x72\xce\x4b\xd7\xa1\x24\x3d\x18\x62\x89\x53\x9e\x0a\x3d\xec\x5e\xa3\xfd\x8a\xaa\xf1\x7d\xa6\xc5\x1c\xa9\x7f\x36\x0f\x40\x92\x89\x28\x3c\x27\x1e\xf5\x6a\xec\x77\xdf\x81\x6f\x26\x95\x97\x9a\x49\x12\x58\x70\x13\x34\x32\x32\x00\x7d\xad\x0c\xc4\x15\xa9\x5d\x79\x6b\xcd\xf0\x09\xe7\x9f\xf8\xda\x9f\xae\x28\x03\xbd\x91\x7f\xb2\x1d\x42\x82\x49\x70\x4a\x68\xb3\x22\x3c\xfc\xa0\x08\x31\x9b\x39\x09\x82\xa5\x2e\xd9\x5f\x63\x9b\xb6\x11\x88\x42\xbe\x4a\xa4\x1a\x98\xe5\xe0\x97\x57\xe8\x97\x5b\x71\xdb\x13\x8e\x31\xe6\x35\xb4\x42\x1e\x7a\x1d\xca\x9f\x63\x61\x3d\xc6\xdd\x1a\x49\x89\x16\xad\x9b\x47\xfb\x2e\xe7\x6c\xe4\x56\x7f\xec\x81\xcc\x0a\xed\x21\x31\x8c\xb8\x10\x85\x5a\x0a\x95\x48\xfd\xd0\x54\x5a\xbb\xf8\x6c\x3e\x72\x80\x10\x7a\xcf\xaa\xf3\x1a\x48\x57\x84\x82\x3c\x89\xae\xa5\x45\x48\x2f\x45\x1c\x05\x56\xa6\x67\x1c\x1c\x0f\xe4\x71\xa8\x20\x32\x29\x19\xf2\xa3\x66\x52\xf8\xd5\x86\x1a\x73\x6c\x15\x69\x6f\x77\x69\xaf\xfd\x30\x56\x6d\xf7\x94\x35\x6b\x13\x97\xf6\xfe\xcb\x5c\x86\x73\x46\xc2\xc0\x52\x80\xbb\x14\x65\xb5\x69\xb9\xcc\x59\x6d\x33\x88\x74\x00\x9f\xf3\xb7\xca\x68\x92\xa3\x61\xf9\x4d\x4d\x85\x7f\xfe\xd7\x04\x3d\x52\xf9\xb8\x98\x52\x56\xb0\xb7\x84\x7c\xb6\x23\x5d\x31\x54\x3e\x7c\x7a\x33\x0c\x52\x1a\x78\x0e\xd9\x4f\x92\xe5\x54\x2c\x06\x27\xc9\x21\x96\xda\x41\x3e\x9a\x8c\x72\xaf\xb7\x00\xd3\xad\x7d\x7b\x6f\x19\x51\x8b\xfc\x12\x8f\x70\xea\x92\x6e\x52\x99\xdc\x82\x37\x56\x4b\x17\xbd\xd9\x25\x8f\xa5\x89\x02\x07\x6d\xdd\xfe\x18\xc2\xfe\xeb\x68\x24\x15\x36\x48\x6e\xa9\x35\xf6\xf0\xca\x6a\x7e\x71\xf5\x24\xc4\x44\x16\xb8\x54\x76\xce\x68\x93\xbf\x23\x7b\x95\xfd\x90\xcf\x31\x85\x43\xfa\x4a\x41\xf5\xab\x37\xb1\xf3\xac\x09\x2d\xcb\x54\xb4\x41\xc6\xf5\xf9\x13\xb9\x78\x25\x71\x25\x25\x98\xf2\x51\x0e\x1c\x31\x64\x39\x9b\x9a\x03\x64\x76\xf4\x84\xfc\xd4\x1d\x26\xe5\xd7\x54\x7e\x1c\x1d\xb6\x6f\x9f\x61\xd8\x9e\xa8\x4c\x1f\xbb\x10\x04\xd1\x88\xe1\x08\xda\xb3\xd3\xe4\xb3\x65\xb2\xde\xe8\xf1\x58\x70\x39\x0e\xa3\xcc\x08\xb1\x3c\xce\x6c\x45\xfa\xdd\xe3\xc2\x20\x76\x57\xf2\x6d\x6d\x0e\xf0\x37\x73\xa6\xb6\x60\x21\xd9\x41\x6e\x47\x9e\x6c\x15\x0e\xfb\xd6\x0f\x3f\x27\xac\xb7\x90\x8d\x1a\xfd\xf4\x0c\x3e\x99\x83\x07\x33\x59\xd2\xba\xb9\xc0\xf8\x53\xe9\x33\xfe\x84\x0d\x4d\x7c\x78\x0b\x56\xe3\x39\x1c\x7b\x16\x5f\xe0\xa9\xc5\x71\xba\xe1\x41\x06\x97\xc1\xaf\x18\x29\x74\x3a\x11\x9e\x9b\x2c\x84\x04\xcb\x06\xc0\x21\xcb\xa7\x3c\xb3\x8a\x8d\x45\x36\x38\x92\x17\xbd\xe5\x6f\x4a\x18\xb0\xb0\x16\xaa\x4f\xa5\x80\xd6\xf9\x84\xb0\x33\xb9\x85\x39\xa6\x6f\xbe\x80\x9f\x04\x35\xac\xe0\x7f\x5f\xba\x58\xa7\x94\x6f\x25\xbf\x5f\xff\x66\xf6\x8f\x7b\xc0\x35\x3f\x04\x0c\xc4\xdb\x41\xb4\xff\xfc\x36\x04\x5d\x76\x20\xb8\xc2\x20\xe3\xf5\xd9\x89\x86\xc0\xe5\xbe\x3c\x33\x38\x62\x8a\x36\xc4\xd7\x17\x71\x83\x8e\x84\xbc\x7b\x07\x8f\x39\x1e\xd7\xa6\xf9\x0b\x11\xb5\x65\x5c\x8b\x7b\x5c\xe9\xca\x5d\x72\x8a\xa0\x72\x3f\x71\xcb\xd9\x65\xc5\xda\x86\xa8\x7d\x19\xc4\xc1\x30\x90\x3f\x85\x93\x31\xe2\x16\xca\x1a\x5b\xb5\xa7\xf3\x11\xcf\x32\x34\x4e\xa9\x45\x1a\xba\x95\xf8\xa9\x49\x2f\x6d\x8b\xeb\x7d\x53\xb4\xee\x22\xeb\x89\x6a\x03\x9d\xd1\x42\x1f\x2b\x04\xce\xee\x81\x7c\xda\x1f\x0b\x0e\x34\x3b\x42\xc3\x4d\x81\xef\x32\x9f\xa7\x86\x40\x75\xd3\x43\x57\x97\x67\x81\xa0\xb2\x22\xd5\xa1\x62\xbe\xba\xd4\xb7\x0c\x1c\x47\x1e\x7b\x84\x5a\x53\x9c\x7d\xfe\x4d\x43\xba\x75\xce\x47\x97\xea\xfb\xb3\x62\x29\xe8\xcf\x5a\xec\x40\x7a\x08\x98\x80\x8e\x41\xee\x8a\xfe\x22\x46\x17\xf8\xea\xd6\x6d\x56\x25\xf1\xca\x3a\x84\xf6\xfb\x2a\x7e\xed\x67\xc6\xa8\xa0\xf1\x0a\x3a\x8a\x4b\xef\xb3\x3f\x55\x9f\x9c\x3d\xba\x74\xd9\x1f\xbc\x0b\xdf\x25\xb2\xad\x75\xd0\x92\x49\xde\xa9\xb9\x86\xec\xe8\xa5\x9a\x9e\x8a\x4c\x38\xac\x69\x2b\x80\x6d\x28\x5d\x59\x28\x5e\xa7\x8f\x81\x92\xf7\x97\x47\x99\xbe\x91\xe5\x8e\x51\x5a\x56\x2c\x73\x88\xd3\xa3\x2f\xd2\x5e\x42\xd8\x00\x2d\x8f\x14\xbc\x03\xc7\x6f\xe6\x02\x34\x5c\xce\xf2\x78\xe2\xde\xea\x77\xa8\x02\x3d\xfc\x1a\x3a\x36\x30\xf8\x5d\x68\xc8\x3a\xd2\x12\x05\x55\xa9\x7e\xa9\xd4\x1d\xd4\x1f\x71\x36\x4f\x4d\x20\x13\x38\xd8\x11\x42\x66\xa9\xb5\x33\xb6\x62\xa9\xae\x5e\xb7\xba\x48\xfc\x2a\xc4\x34\xeb\xe7\x92\xfd\x15\x96\x17\xcc\x1b\x6d\xfb\xf9\x62\xbd\xcc\xc1\x70\x02\xd7\x4a\xaa\x3a\x53\x68\xb3\x84\x01\xd0\x93\x20\xa7\x90\x83\x83\x0a\xcb\xf4\xe1\xa8\x8c\xab\x78\x6d\xf6\x8a\x3d\xf0\x71\x75\x49\x87\x0b\x24\x99\x3d\x25\x8e\xca\xbf\x29\xf8\xf1\x7d\xd9\xaa\x95\x03\x0c\x7e\x82\x5c\x5b\x83\xa3\xca\xad\x1c\x52\x23\x43\xe7\x12\x8b\xa0\xc4\x13\xff\xc8\xb7\x82\xd5\x7a\x9d\x0d\x2c\xb4\xf6\xd7\x13\x67\x91\x3f\x0a\x26\x43\x0d\x59\xad\xa5\x6a\xe0\x20\x92\xd6\x8f\xd4\x9f\x79\x28\xa9\x4f\x0d\x26\x91\xdc\x82\xdf\xa3\x4c\x81\x4e\xa7\x24\x31\xda\xdc\x10\xc1\x8e\x97\x5f\x4e\x73\x12\xc9\x5e\x5e\x50\xa3\xce\xfb\x7d\xab\x9b\xed\x64\xa1\x0e\x15\x70\x89\xa8\xeb\x67\xce\x88\xbf\x74\xca\x7d\x95\x4e\x19\x24\xaf\x38\x1b\x91\x00\x6a\x58\x65\xb4\xbd\xec\x13\x90\x40\x7b\xaa\x83\x41\xb9\x0b\x82\x45\x88\xec\x5e\xda\xa0\xc5\xa7\x16\xeb\x2a\x1c\xcc\x32\x1e\x15\x28\x26\x0c\x73\xae\x13\x1d\x49\x30\xb3\x5b\xb5\x9e\xa6\xe6\x34\x78\x36\x5a\x5e\x93\x50\x10\xa2\xc0\xb9\x2c\x2d\x8b\x57\x4d\x4a\x27\xb6\x8e\x63\x2f\x55\xfb\xb6\x83\x48\x53\x6f\x3e\xa9\xe6\xbc\x08\xcc\xf7\x9e\x9b\xe3\xe3\xe2\xe3\x5b\x8a\x8c\x9c\x7a\xaf\x2a\xcf\x25\x83\xc1\x14\xa6\x41\x52\x8d\xa8\x4a\xf5\xc4\xb4\x8f\x3b\x17\x2f\x85\x9b\x8d\xb8\xcd\xff\x09\x86\x8b\xea\x82\xcb\x6e\x3a\x58\x6b\xc3\xd5\xb4\xd8\x85\x33\x5a\xf7\xba\x7c\xcb\x1d\x3b\x44\xcd\x67\x2b\xa1\xc1\x30\x5c\x4e\xfe\xd2\x91\x94\x75\x67\xd0\x84\xf1\xa4\xcc\xc9\xab\x74\x01\x13\x5a\xec\x77\x6e\xae\xfb\x46\x84\xac\x1a\x73\xf0\xb3\x80\x9f\x30\x24\x47\x98\xa2\xb8\xd7\x84\xc4\x2c\x3d\xf3\x1e\x98\x26\x23\x33\x68\x8e\x63\x51\xf3\xd8\xab\xfd\x6e\x4e\xa2\x61\x1b\x45\x5e\x46\x9d\xeb\xa0\xfe\x77\x5d\x3b\xdb\x6d\xea\x8f\x8a\x80\x0e\x19\x03\xb8\x4b\x37\x5b\xd8\x30\x48\xf3\x60\x3e\xbd\x71\x96\x88\xb8\xdf\xa6\xe0\xd9\x3a\xc1\x0a\xbd\x51\x18\x9a\x7a\x9e\x6c\x19\x09\x53\xf5\x29\xb1\xbb\x7f\x36\xe5\xb9\x2a\x7d\xd4\x18\x49\xcd\xa5\x19\xaa\x8b\xd8\xbd\xf2\x06\xb1\x06\x4b\xb4\xab\x95\x27\x7f\xd6\xd3\x0e\xdc\xdb\x12\xf8\xb8\x76\x85\xac\xc8\x8c\x01\x36\x5d\x13\x7d\x69\xe1\xae\x07\x8e\xb5\x5d\x1f\x99\xf4\x03\x2d\x99\x10\x6e\xc7\x05\x79\xd7\x4c\xd1\xf2\x22\x86\x8f\xed\xf8\x89\x32\xfa\x94\xb7\x76\x49\xa1\x50\x0d\xbb\xaa\xed\x35\x57\x3d\x02\x6d\x34\xac\x7e\x94\xee\x4c\x9a\xcc\xea\x4d\x0a\xd2\x2f\x08\x7a\xd7\x7c\xee\x52\x26\x02\x2a\x30\x11\x74\x44\x03\x46\xcb\xed\xd2\x3d\xe9\xed\x48\xce\xbe\x06\x98\x1a\xb3\xad\xf5\xfb\x30\x2b\xe8\x73\x14\x7e\x04\x38\x91\xc8\x79\x03\xfa\x54\x33\x98\xbe\xc2\x89\xb3\x3d\xe4\x39\x00\xdc\x1a\x16\x53\x9b\x66\x5d\x99\xe8\x02\x13\xba\x93\xf3\x3a\xb1\x3f\x74\xe2\xe2\x1a\x05\x57\xc7\x5b\x4c\xa7\xe7\xeb\xc7\xd0\x10\x17\x17\x73\x9f\x07\xfe\xc6\x6f\xdf\x42\xa9\x80\xdb\xfc\xfc\x7c\x51\xa4\xa6\x92\xe4\x8a\x17\x17\x05\xf0\x4d\xa7\xe8\x28\x1e\xec\xa4\xfd\x25\x54\xf5\xce\x7e\xf7\x7d\x38\x0a\x47\x9a\xb8\xb6\xdf\x24\x19\x8c\x92\x23\x37\xa6\x8e\x85\x91\x04\xb2\x60\x45\x96\x10\xa6\x81\x60\xcc\xb3\x09\x7c\x57\x0f\x10\x1d\xcc\xd4\x1d\x21\xd2\x49\x1b\x26\x29\x9e\x8b\x64\x03\xc4\xf7\x20\x4b\x8a\x4c\x36\x4d\xf3\x25\x16\x65\xfc\x4a\x0d\x42\x06\x3f\xf5\x43\x63\x0e\x8b\xa9\x05\x97\xc0\x7f\xb9\x26\x31\xe6\x9f\xa5\xa3\xcb\xf6\x21\x8c\xac\xa8\xd7\x6b\x96\xd6\x4f\x11\xa3\x55\xa7\x2d\xcb\x2e\xa5\xe9\x95\xac\x53\x47\xb4\x89\xb3\xcd\xa7\xf1\xf5\xda\x08\x6a\xb0\x7e\xc9\x6f\x61\x5f\x39\xc2\x23\x85\x72\x9e\x77\x83\xe6\xdc\x41\x4c\x3c\x75\x8e\x64\x85\x4c\x3a\xae\x2b\xe3\xd9\xc7\xa8\xa1\x1c\xb0\xa4\x6b\x65\x48\xc8\x0c\xf5\x73\xd2\xa0\x02\xd7\xfa\xe2\x0a\x7d\x75\xac\x25\xe2\xa8\xa3\xde\x13\x5d\x52\x47\xf5\x90\xf8\xae\xca\xa8\x36\x20\x7b\x47\x5c\xd1\x9d\x12\x4c\xa7\x44\x26\x89\x55\x12\x39\x6f\xc1\x16\x28\x6b\x4b\x9e\xd3\x3f\x77\xaf\x63\x79\xb8\xae\xbd\x4f\x71\xc9\xbe\x54\x28\x3b\x0b\x4a\x2f\xf2\xd9\x1f\x59\x5d\xec\xd2\x4c\x97\x7c\x29\x90\x5a\xb7\x9a\x7f\xfd\x2c\x0f\x05\xaf\xf4\x71\xb8\x39\xb3\x36\x27\xbd\x67\x9e\xb1\x3f\x5a\x0e\x08\x06\x6d\xe0\x68\xf8\xac\x95\x06\xaf\xb6\x01\xa7\xe0\x0d\x2d\xc3\xba\xc9\x16\x9a\x4d\x1f\x8a\x7f\x34\xbe\x29\x1b\xe5\x12\xd8\x8f\x2e\xbc\xb9\x82\xe4\x3b\xe8\xae\x90\x29\xf3\x88\xab\xce\x0b\x6c\xd5\x8c\xd8\xc3\x47\xcf\x9c\x68\x75\xfc\x63\x21\xf0\xc4\x27\x95\x0f\xd8\x0a\x5d\x1c\xad\xdd\x6a\xa6\xd9\xe4\xf9\x89\xd4\xa1\xe4\x24\xb1\x40\xd6\x6c\x7f\x4e\x5b\x76\xaa\x4e\xe4\x7f\xf8\xd2\x73\x23\x1f\xdb\x5d\x2c\xb1\x90\x15\xb7\x63\x13\xfb\x67\x67\x86\x5d\x62\xe6\x4f\x1a\x43\xc1\x5e\x96\xfd\xd9\xe1\xf0\xab\xb1\xaf\xc0\xa7\xf1\xc3\x21\x27\xb6\x30\x08\xb1\xa7\x15\x41\x15\x93\x30\xea\x90\xbb\x4c\x8c\x0c\xcb\xe5\xb2\x0c\x4b\x5a\xeb\x01\x14\xad\x3f\x21\x01\x35\x89\xb6\x04\xea\xb8\x50\x1e\xfd\xcc\xab\xff\x66\xc3\x00\x2b\x79\x4f\x1a\x91\xf6\x58\xca\x91\xe6\xa1\xbb\x9f\x64\xb9\x71\x6e\x9d\x5e\x9b\x81\xcc\x81\xd3\x0c\x63\x1f\xf7\xa0\x67\x08\x15\xd5\xf1\xcc\x71\x49\x11\xb3\x38\x67\x37\xb6\x5a\x89\x6f\x19\x60\xd9\x52\x2e\x0f\xc5\x13\x87\x42\xb9\xaf\x19\x9f\xcd\x7b\xb1\x5a\x3c\x61\xe7\x1c\x5b\xe1\xc8\xd6\xb8\x58\x5e\x93\x38\xf1\xb2\xe5\x1e\xc6\xfe\xfb\xaa\x2a\x8e\xf6\x77\xcb\x21\x84\x7e\x3e\xa4\x95\xd1\x4e\x17\x8c\x3c\x98\x5a\xe9\x4f\x2c\x61\x61\x57\x34\x0f\x3d\x88\xa9\x1f\xce\xef\xd1\x55\x0e\xbf\x04\xbb\x99\xc5\xce\xf8\xb4\x2a\xee\x40\xd6\x1b\x56\x34\x04\xf4\x3e\x40\x80\xb6\xa2\x69\xbf\x1f\x41\xb5\xf5\x42\xd5\xc2\x20\xb8\x23\x73\x18\x6b\x41\x27\xa1\x4d\x66\x1c\xba\xbe\x6f\x8c\x2e\x0d\x50\xf2\xf9\x29\x38\xb7\x98\x18\xe1\x93\x4e\x85\x5b\x6f\x36\x40\x7f\x35\x75\x45\xbd\xe9\x7b\xaf\x11\x40\xfd\xff\xd8\xfb\xea\xa8\xac\xd2\xee\xed\x43\x77\x48\x77\x28\xdd\xdd\x8d\x48\x77\x77\x49\x77\x97\x48\x77\x8a\x80\x48\x37\xd2\x1d\xd2\x21\xdd\x5d\x12\x0a\xd2\xdd\x82\xc8\xb7\x66\xe6\xfd\xde\x57\x1c\x99\xc1\x99\xdf\x9f\xae\xe5\xb3\x7c\x9e\xc5\x5a\xd7\x75\xf6\xbe\xf7\x39\x67\x9f\xb3\xf7\x7d\xed\x7a\xa3\xbd\x12\xe4\x11\x98\x1b\xf3\x0a\x5a\x9f\xa5\x9a\x39\xa7\xab\x49\xed\x12\xaa\x0e\x3b\x1d\xed\xf7\x01\x62\xe2\x9b\x8a\x4e\x7c\x48\xa3\x76\xa2\x6f\x60\xf5\x27\x87\xdd\x92\xa5\x50\xdd\x94\x2d\xfa\xa5\x97\xce\x12\x26\x0d\xc6\x8d\x16\xf4\xc9\x4a\x25\x32\xa4\x6b\x08\x94\x33\x49\xe9\x4b\xc9\xcb\x3e\xd6\x47\x6f\xa6\xc9\x80\xfb\x7b\x89\x71\x12\xa6\x45\x7b\xc2\x96\x17\x47\x61\x33\x44\x5a\x2d\xfa\x1e\x4b\x06\x3c\x77\x7b\x63\x47\xa8\x8a\x53\x2d\xd4\x59\xa5\x92\x88\x32\xa6\x42\x6e\x6c\xd3\x94\x85\x4b\x21\xee\x86\xc1\x0a\x77\x23\x0b\xd9\xc2\x82\x11\x99\xa1\x51\x7a\xa1\xd9\x0d\x76\x34\x23\xbe\xf0\x50\x3a\x77\x5b\xa3\x6a\xca\x3d\x9a\x6b\xb5\xb5\x93\x35\xc0\xfd\xed\xde\x02\x39\x1e\x5f\xde\x9e\x5f\x64\xd9\xdc\x24\xba\x9b\xd1\x40\xbb\xd3\x67\xd8\x07\x9e\xc2\xa3\x35\x2f\x3e\xa4\xa2\x63\x3d\xda\x9e\x21\x99\x51\x7c\xbd\xfe\x82\x71\xbc\x8d\x4f\x59\xb0\xcf\x11\x57\x45\x37\xd1\x7c\xdb\x0f\x8f\x2b\xb2\x60\x8e\x47\xd5\x09\x42\x83\xb8\x9f\xac\x3a\x8c\xd8\x11\x26\x5d\x51\x2f\xbd\xc0\x96\x91\x83\x9e\x27\x87\x8a\x93\x6c\xaa\xee\xe9\xd1\x05\x14\x86\xc8\x24\x27\x49\x5a\x58\xdc\x17\x7a\xbf\x37\x33\x58\xfe\x3e\x5d\x9f\x40\x1b\x5a\x6b\xe8\x14\xe5\x98\x6b\xc6\x6e\x72\x46\x66\x05\xc1\x6e\xf4\x6c\x96\xb5\x02\x08\xab\x5c\xf7\x05\x19\xfc\x3a\x93\x02\x6e\xc0\x6e\x3f\x47\xb2\x98\x35\x71\x7b\x81\x00\x80\x2b\x18\x00\x70\xdc\xf9\x1c\xe9\xac\x6f\x6d\x66\x69\xa9\x7f\x6b\x8b\xb7\xbd\xd1\xef\x05\x29\x2b\x23\x6b\xc7\xbf\x79\xb6\xf4\x7c\x21\x28\xdb\xc1\x00\x1f\xb0\x7f\x8d\xae\x22\x53\xcc\xe2\x74\x9e\xf1\x10\x5c\xc8\x94\xfd\x5d\x34\x87\x8c\xfa\xd6\x98\xa9\x95\x02\xe9\x6c\x57\xd5\x6e\xcb\x4a\xb6\xfa\x34\x68\x66\xde\x84\xdb\x90\xc7\x57\x9a\x79\x19\x5c\x28\x72\x0e\xd3\xf1\x58\x5b\x9f\xbe\x0d\x8c\x19\x61\x6a\x74\xd8\x0e\x6a\xf6\x5a\xd3\x33\xd3\x19\xed\x98\x81\xf5\x83\x40\xed\x0e\x88\xf6\x54\xea\x59\x16\x3c\xb9\x38\x53\x81\x09\xd3\x94\x00\x3f\x47\xd6\xe0\x14\xa7\x13\x5f\x15\xb1\x00\x1c\x74\xb9\x8e\x07\x11\x10\xf9\x78\x38\x74\x5a\xf4\x0e\xac\x35\xe2\x07\x03\xbd\xe0\x83\xc7\x04\x71\x95\x6e\xf4\x3a\x4f\x36\xa1\x28\x09\x71\x69\x6b\xde\x2e\x58\x15\x31\xd8\xfb\x99\x85\xea\xbc\x16\xb4\x43\xca\x03\xdf\x40\xd5\x50\xe7\x6f\xed\x82\x33\x19\x8d\x84\xa4\x24\xa2\xa0\xac\x55\x95\x3f\xcb\xc3\xe9\xc4\x78\xfa\xa9\x29\x88\x45\xed\x2c\x82\x88\xf6\xa0\xa5\x0b\x76\x61\x22\x16\x52\x7d\x2f\x12\x9f\x4e\x92\x22\x14\x74\xa6\xd5\xf4\xc1\xc9\x76\xba\x89\xfa\x00\xba\xb6\xee\x08\x44\x87\x02\x0e\x9a\x8c\x39\x44\xbd\xac\xed\x29\xac\x76\x74\x4c\x80\xa3\x9c\xc3\xf6\xa8\x51\x07\xdc\xb5\x63\xed\x9a\x41\xdd\xa1\x6e\x5a\xbe\xf0\xf8\x1e\xde\xf9\xf9\x67\xf2\x7a\x74\x7c\x46\xfc\x1c\xd4\xf7\xb1\x0a\x87\xc6\xbc\x3c\xc2\xc4\xc3\x26\x7a\xa1\x2a\xba\x95\x62\x14\x6b\x4d\xd6\xae\x78\x76\xfe\x86\x15\xab\x69\x09\xd6\x2f\x68\xea\x5d\x1e\xc6\x55\x94\x9e\x06\x0f\x1a\x1c\x75\x57\xe4\x1f\x53\xc5\x54\x34\xe2\xaf\x14\x54\xc1\x7c\xda\xe0\x74\xda\x3e\xa4\xe0\x69\x5c\xc4\x1a\x59\xf3\x72\x38\x2c\xf7\x60\x40\xf5\xbe\x51\x4c\x94\xae\x12\xf6\x7c\x33\x06\xb4\x74\x11\x40\x88\xe7\xbd\xcd\xbc\x8c\xd1\xda\x38\x41\xb8\xbd\xe0\x8e\xc3\x3e\x1c\xbc\x08\x00\x00\xca\x02\x00\x64\x77\x2e\xf8\xf7\xd5\xdf\xbf\x5a\xde\x41\x1c\x0b\x87\x79\xb6\xa3\x06\x2f\x88\xcc\xde\xaa\x09\x25\xfd\x18\x19\xe6\xdd\xde\xd3\x1c\xab\x8f\x3a\x0e\xaf\xd8\x5e\xcf\xb0\xbb\x30\x13\x44\x49\x87\x44\xfb\xfb\x93\x38\x02\x66\x9a\x76\x4e\x2d\xd7\xce\x98\x44\x30\xd1\xe9\x4f\xd7\xed\x53\x14\x09\xe3\x7b\x3c\xf5\x5d\xca\x67\xa0\x1e\x37\xc7\x17\x3c\xad\x95\xb2\x71\xec\x3d\x86\x69\x88\xa7\x92\x24\xdf\x41\xff\xc2\x41\xf9\xf2\x73\x10\x0c\x37\xdd\x5a\x61\x36\x85\x83\x4e\x6c\x5e\x12\xeb\xb4\x65\x3f\x86\xc4\x9a\xe5\x89\x30\x1d\x2c\x9f\x6a\x6f\xe6\xb3\xa2\xb7\xf5\x1f\xcd\x1d\x5d\x89\x70\xdc\xb5\x42\x48\x50\x09\x3f\x53\x3f\x4c\x3a\x1a\x02\xcb\x90\x55\x51\x3e\xf3\x29\x4c\x5b\x1d\xf4\xa7\x75\x33\xf3\x6a\x96\xf0\x82\xfc\x08\x3d\x7e\x10\x42\x68\x71\xbc\x67\x40\x66\xf3\xf1\x1c\x67\x06\x6f\xc0\x28\x43\x92\x4d\xd7\x4e\xdb\xdc\xf9\xdd\xd0\x13\x76\xcc\x46\x31\x10\x7f\x82\x88\x21\x90\xd5\x43\xc3\xb3\xf8\x42\xd1\x6d\x9a\x59\xdb\xce\x8d\x62\x41\xbd\xfa\x58\x2a\x75\x7b\x49\xff\xc5\xc6\x56\x4c\xf2\x35\xce\x11\xea\x0b\xd6\x2b\x56\x75\x51\x43\xe1\x75\xfa\xc6\xa7\xc6\x21\x45\x62\xf6\xd6\xab\x63\x0e\xf9\x17\x52\x64\x54\x46\x0a\xe5\x22\x31\x46\xcc\x23\x98\xd4\xa4\x92\xec\x00\xe9\x9a\x65\xf1\x6a\x62\xa1\xfd\x31\xc7\xa7\xb0\xec\xbe\x3e\x3f\x11\xe5\x01\x49\x62\x38\x0d\xfa\xd1\x2b\x84\x1e\x11\x43\x67\x8f\xd6\x65\xc5\x82\x88\xeb\x40\xc2\xe5\xab\x4a\x39\x22\x22\x3e\xc8\x82\xfe\x05\x21\x16\x57\x63\x65\xfb\x33\x82\x29\xc1\xa9\x70\xcd\x74\xac\xe0\xc6\xd7\x4d\xeb\x44\x9d\xe8\x51\x4f\xea\x5f\xe8\x83\x53\x17\xc3\x04\x43\x84\x01\x9e\x3b\x3d\xbe\x69\x10\x45\x4c\x4c\xef\xa6\x61\x66\xd4\xe8\xa2\x61\x14\x9e\xc1\xf1\x77\x6a\x15\xb5\x49\x3a\xb2\xec\x7d\xac\x66\xaa\xde\x11\x60\xc9\x7e\x90\x27\x94\xe5\x79\x30\xaa\x8f\x04\xf3\xc9\x56\xae\xf9\xa2\x9d\xf4\x60\x79\x23\x38\xa2\xe0\xa3\x30\x71\x69\x98\xe8\xd4\xb5\x50\xb2\xc5\x1e\xd3\x8a\x1d\xd9\xab\xd1\x4f\x4b\xd5\x14\xf1\x0d\x68\x9c\x46\x48\x36\x9a\x4f\xe6\xfb\xed\x74\x99\xbe\x50\xe8\xa8\x14\xd8\x3e\xde\xf2\xb3\x42\x6a\x6f\x99\xb3\x49\x2d\xc7\x47\x7a\xd8\x70\xfa\xe5\x8d\xa1\x81\x6c\x4a\xd7\x98\x5e\xf8\x2b\xc8\xcd\xe2\xce\xfc\x88\x67\xf2\xe0\x45\xef\x15\x36\x0a\xf6\xe8\xa8\xb6\x43\x6b\x45\xed\xb8\xd1\xd8\xd2\x91\xe5\x49\xd4\x1b\xca\x09\x18\x1b\x22\x78\xc4\x4b\x44\xde\x1a\xe4\xf4\xe8\x5f\x91\x7d\xae\xcf\x22\x0e\xd3\x53\x76\x34\x3b\x20\xe0\x6d\xf4\x7c\x1d\xcb\x03\x39\x1c\xd2\x35\xdf\xaf\x02\xdb\xa0\x7e\xf6\xc6\xf6\xa3\x82\x53\x3c\xd6\x88\x9a\x80\xce\xc4\x0b\xbf\x7d\xca\x5e\xaa\x1e\x37\xab\x45\xab\x59\x1e\xca\x75\x6a\x0c\xbb\x9c\x6c\x7b\xda\x4f\x87\x3c\x5d\xb5\x58\xa4\x7d\x91\xd5\xd1\xe4\x19\x5d\xe9\xa4\x08\x20\xd6\xdc\xfa\xe8\x30\x6a\xdb\x6c\x44\x9d\x74\x46\x56\x48\x35\x68\x18\xc4\xa6\xbe\xda\xad\x2f\xc0\x03\xb7\x95\x33\x89\x91\x43\x73\x28\xd4\x20\x3d\xf2\x11\x9c\x0f\x3e\x22\xf0\x7a\x21\x44\x3d\xf2\x2f\x9e\xf6\xe6\xbb\x68\xe5\x29\xb3\x14\x7c\x68\xf5\x6a\x57\x54\xd8\x50\x8e\x04\x3e\xfe\x31\xda\x89\xd3\xc3\x99\xc3\x4d\x28\x68\xd4\xcc\x75\x1a\x44\x41\x03\x5a\xe2\x13\x63\x5b\xff\xf2\x2c\x7e\xe6\x2f\xb8\x7e\x70\xf9\x0a\x37\x60\x3c\x54\x38\xd8\x21\x79\x96\x2c\x04\xfb\x95\x42\x69\xca\xc5\xe9\x84\xe3\x13\xca\x30\x7b\x96\x0a\xe8\xf9\xe1\x39\x31\xdb\x1f\xfb\x51\x4e\x1c\x3a\x5f\xd3\xe0\x45\x90\x9a\xcd\x8d\xce\xf8\x9f\x9e\x9b\x67\xf1\x9e\x5d\xed\x13\x93\x06\xc5\xda\xf2\x0f\x3e\xfc\x50\x79\xdc\x81\xf8\x46\x7d\xd4\x62\x0c\xaf\xa1\x08\xfd\xd3\xfe\x35\x46\x90\x63\x53\xc9\x09\x95\xf9\xa1\xd6\x4b\x62\x61\x2b\xec\x98\x4d\xf7\x29\x2a\xe5\x6a\x17\xf2\xf7\xfd\x90\xcb\xa7\xef\x54\x99\x5d\x13\x5a\x5b\x24\x48\x6d\xdf\x95\xa0\x54\x89\x1b\x3e\x90\x87\x84\xb7\xa7\x62\x64\x1d\xcd\xd6\x6b\x1a\xa7\xc0\x89\x3b\x5f\x16\x25\xc1\x87\x93\xf6\x42\x98\x2a\xd3\x66\xe2\xf8\xe0\xf0\x3a\x34\x60\x99\xf2\xcd\x94\xdb\x6e\x72\xd3\x53\x5b\xb3\xb3\x64\x67\x65\xef\xe4\x4d\x52\xbc\x58\x0f\xbc\x37\xb8\xbb\xf8\xb9\xc5\x15\x11\x28\xf3\x37\x9a\x19\x16\x33\xc2\x85\x4b\xa8\x13\x71\xd2\x15\x63\xd9\x52\x90\x51\xf4\x6d\x4e\x85\x72\x08\x6a\x36\x30\x30\xed\x92\x28\xd8\x5f\x54\xa3\x5d\xdd\xfb\x61\x39\xa9\x40\x88\x62\x4e\x55\xf0\xd7\xa3\xdf\xcc\x96\x81\x72\xcf\xea\xaa\xf3\xb7\xa3\x29\xc7\x43\x20\xe5\x3e\xdf\x93\x13\xaa\xbf\x82\x3d\x02\x39\x92\x3f\x36\x1c\x03\x93\xb0\x57\x47\xcf\x81\x60\xe2\xbf\x14\xc0\x0a\x94\x7d\x99\x13\x50\xd1\x72\xa9\xb7\x91\x3e\x3e\x44\xb0\x23\x7c\xc8\x46\x3c\xad\x88\x21\xe2\x63\x74\x45\x55\x09\xdd\x4b\xe3\x50\x7b\x01\x07\xf3\xd6\x1d\xe4\x71\xe6\x0b\x30\x06\x1c\x31\x17\xb9\x17\x88\x33\x72\x05\x93\x86\x12\x0a\xa0\xaa\x50\x98\xaf\xb9\x45\x02\x22\x21\xd5\x3e\x76\xcf\x75\x91\x1a\xef\x44\xc8\x9a\x7e\x18\x2d\x23\xba\x10\xac\x94\x86\x49\xa3\xc1\x0a\xf3\x86\x59\x90\xed\x2a\x75\x35\x7e\xb0\x71\xc5\x70\xe1\xcc\x43\x74\xc1\x76\x31\x41\xd7\x19\x91\x47\x9b\x13\x9d\x32\x2e\x41\xca\xfc\x02\x66\xa0\x68\x4f\xef\x13\x06\x8f\xe5\x6a\xae\x46\x52\x4d\xb4\xf0\x54\x89\xe2\x8b\xf8\xd5\x63\x7c\x48\xd9\x97\x78\x15\xa1\xf9\x60\x04\x49\xa2\x1d\xf5\xfd\x2f\x93\x5e\x0d\x1d\xe1\x49\xed\x4b\x27\xb4\xc6\x4b\x83\xcd\x73\x14\xf5\x14\xa3\x0e\x24\x77\x22\xae\xb9\x13\x1c\x91\x4e\x06\x2a\xb1\xe8\xf0\x34\x16\xfb\x4d\x9c\x8d\x99\x1c\x4e\xbf\x64\x6f\x11\xdc\x4b\x27\xca\x42\x81\xc3\x5d\x65\x14\x88\xc0\x7d\x93\x81\x96\xc9\x99\xad\xd3\x4f\x72\xea\x4b\x41\x3a\x73\xd5\x61\x0a\xe9\xea\xc3\x50\x4f\xfd\x3c\x97\xcc\x11\x71\x0a\x4e\xd8\x62\x2f\x57\x5d\x2c\x0f\xa1\x40\x8e\x3e\xe9\xd1\x5e\x92\xc9\x8a\x2b\x71\x05\x8d\x10\x98\xfe\xe7\x1d\x07\x97\x2c\xf6\x4d\x84\x3e\x81\xc1\x47\x0f\xa7\xb8\x2f\x70\x97\xd9\xde\xbd\xd4\xb9\xe2\xce\x10\x4f\x69\xa2\x13\x0e\x1b\x75\xf1\x6d\xca\x7f\x77\x10\x03\x8d\x8f\x60\xe2\x4e\x76\x18\xde\x71\x38\x10\x55\x4a\xc1\x21\x8f\xa8\x5e\x6c\xa8\x3d\x78\xa2\x15\x82\x0d\xf5\x16\x4e\x7e\x4c\x53\xf4\x60\x4d\x9d\xa7\x7f\xf5\x8b\x70\xa5\xf4\xd2\x61\x0e\xa0\x3a\xf7\x2e\xb4\xd9\xf6\x34\x73\x2c\x67\x60\xde\xea\x7d\xf0\xa6\x0b\x4f\xee\xf3\x9b\x2c\x27\x39\x5d\xd9\x0a\x0e\xf4\x63\xa4\x07\xec\x63\xf0\x93\x9b\x24\x28\x5d\x3b\x2a\xad\x5f\x55\x2a\x17\xc1\xe3\x54\x33\x46\xd1\x2d\x0e\xd6\x42\x0c\x3d\x08\x4d\x88\x43\x63\x2f\x69\xbd\x0d\xb9\x38\x18\xf9\x42\x7d\x3d\x78\x1f\x42\x8f\xe1\xc1\x18\x0c\xeb\x3b\x83\x9f\xde\xe4\x94\xfa\x06\xd5\x9e\xb8\x78\xcc\x3e\x42\x0b\xb6\x3f\x25\x56\x86\x7d\x2f\x4f\x24\xfe\xa8\x96\x33\x75\xe0\xd8\xa7\x03\x02\x5c\xdc\xf6\x46\xba\x58\xca\x21\xbd\x43\x99\x22\x70\xa5\x1b\x4f\x90\x57\x1f\x97\xd5\x71\x45\xfc\xba\x4d\x0c\x9a\x2f\x1a\xcd\x41\xc0\x0b\xc5\xeb\xaa\x08\xa9\x06\x5f\x0b\xdb\x2e\xc3\xc8\x72\xe3\x84\x75\x9b\xd3\x03\x85\xc3\xd3\x94\x9b\xfb\xe0\xb4\x04\x11\x0b\xf9\x98\x37\x52\x45\x35\x19\x14\x79\x62\xdd\xa8\x62\x22\x36\x2d\x9e\xda\xfa\xca\xe2\x9d\x15\xf3\x06\x23\x06\xe6\x3b\xa6\x39\xbf\xba\x97\xaa\xa4\x94\x68\x4e\x5e\xa0\xba\x6e\x33\xd8\xc9\xcc\x96\xa9\x6f\xd0\xae\xc0\x30\x11\x9a\x46\xb5\x07\x22\x2d\xac\x18\x02\x63\xb0\x3c\x69\x29\x58\x76\x20\xb2\x95\x49\x13\x2b\xe5\x89\x95\xdf\xe2\x48\xbb\xe4\x62\x44\x86\x1a\x8a\x8d\x10\x83\x85\xd3\x2c\x46\x82\x43\xf3\x33\x77\xbe\x6c\x45\x3c\x21\x1d\xa9\xb8\x24\x81\x81\x5f\x5c\x8f\x5e\xc6\x79\x34\x7c\x0d\x41\x54\x35\xdc\x96\x46\x9e\xf6\x2e\x6d\x84\x2f\xc5\x8b\x7f\x76\xbb\xea\x7a\x4e\xdb\x42\x07\x28\x2c\xde\xa5\xbe\x89\x41\xa3\x5c\x5d\x75\x95\x2b\x92\x44\xe5\x1f\xd4\x61\xba\x82\x96\x59\x05\x8d\x09\xed\x3e\x96\x32\x68\xc8\xe5\xb3\x69\xbd\x7e\x5b\x10\x85\x2b\x94\x43\xb8\x7f\x91\x1c\x46\xbd\x97\xca\xd3\xe8\xb0\x95\x80\xef\xda\x4f\x04\x63\x5c\x83\x09\xee\x94\x51\x34\x91\x3c\x2f\x57\x5e\xbc\x52\xb7\xf0\xe5\xab\xcf\xb2\x6f\x97\x4e\xeb\x17\x18\xe2\x64\x53\x37\x66\xa3\x16\xb2\x4f\x54\x3a\x4f\x15\x0a\x7a\x3a\x0a\xfd\x4c\x9e\xad\xbc\xeb\x1f\xc4\x5f\x66\x47\xfc\xd2\xcd\xdd\xea\x55\x75\x30\x5f\x5e\x9b\xd0\xd4\x3d\x4c\x4e\xc8\x39\x15\x42\x91\xca\xa6\xc5\x35\x1a\x5b\xe0\x98\x3d\xc9\xdb\x3a\x3a\xf3\xa8\x68\x01\x7f\x40\x25\x6d\x11\x96\xd8\xca\xca\xe3\xa5\x27\x15\x0f\xbe\xf4\x9b\x48\x1b\xfb\xb8\x85\xe4\xd1\x26\x8e\xf2\xb3\xba\x22\x14\xe9\x2b\x3f\xef\x45\xac\x7a\x9d\xf5\x74\xba\xaf\x94\x07\xaa\x12\x3e\xd3\x6a\xdd\x10\x22\xaf\x56\xfb\x73\xb2\x6b\xc9\xc2\xbe\x28\x4c\xbf\xc4\x34\x56\x20\x53\xe1\x76\x96\x1e\x95\xc1\x84\xd6\xd8\x3f\x61\x7f\x79\xe2\x9e\x93\x25\x48\xe7\x9a\x69\x8c\x7b\x8c\x93\x07\x0f\x39\xa4\xc6\xa1\x01\xa5\xd0\x7d\xa9\xc4\x04\x45\x9b\x1e\xad\xbb\xe9\x95\x5f\xe1\x05\x39\x5a\x58\xdd\x18\x39\x15\x89\x59\x29\x6a\xd9\xda\x42\xbe\xb3\xcd\x78\xb5\x25\xa3\xc4\xe6\xa7\xc4\x76\x9e\x7e\x64\x17\xdd\x27\x9a\xfd\x71\x14\xda\x65\x1d\x97\xf7\x99\xd7\xbb\xd4\x0c\xc9\x22\x5c\x7e\xe4\x84\x15\x92\x63\x94\x85\xd6\xe1\xbc\xa6\xf6\x56\x56\x01\xd3\x02\xb9\xf9\x5a\xfc\x1a\xd6\x35\x9e\x27\x79\x55\xc3\xbc\x5c\x72\xd5\x2e\xef\xac\xae\x44\x27\xa2\x3a\x5e\x13\xef\x62\xd3\xa3\x49\x3d\x53\x41\xad\x49\xca\x3d\x57\x39\xd4\xd2\x39\xd4\x86\xe3\x87\xb6\x91\x81\x1a\x9d\xe7\x0c\xd2\x3f\x98\x2a\x94\x9f\x42\x55\x77\x42\xfd\xf0\xc2\x57\x58\x68\xcd\x4e\x33\x7e\xf4\xb9\xb4\xe8\x73\x36\x2c\x96\x06\x4d\xef\xc0\xd9\xe9\xa0\xf4\x1c\x4e\xe8\xe9\xd0\xfc\x02\xd0\x82\x17\x3c\x9b\x7d\x6e\xbd\x36\x17\x8d\x54\xfa\x95\x1d\xa9\x4f\xd6\x78\x3a\x53\x6d\x5d\x4d\x2c\xa1\x74\xad\x02\x75\x3d\xdf\x33\xe9\x45\xc2\x90\x5f\xf9\xa4\xb5\xa5\x65\x29\x94\x90\x6f\x6d\xcc\x3b\x18\xc7\x0e\x69\x48\x6a\xd7\x4b\xde\x78\xe9\x3e\xd0\xe0\xa3\x7c\x2c\x83\x64\x67\x05\xf7\xa6\xa7\x32\x42\x7b\x71\xbc\xf7\xcb\xc6\x26\xa7\x05\x60\x60\xef\x9b\x40\xe3\x8c\x5d\x53\xd6\x8b\xea\x7d\x42\x49\x83\x72\xd4\xa0\x77\xbe\x91\x3a\x77\x21\xcc\x2a\x44\x19\x5c\xfc\xc8\x1d\xd6\xcd\x00\xed\x23\xa1\x81\xdc\x65\xe9\xcb\xed\xf6\x2f\xf5\xef\xd1\x37\x07\x5e\x47\x9a\x98\x6a\xba\x3c\xbe\x96\x00\x87\x0a\xcb\x45\xdb\xc4\x7b\x45\x04\x9e\x5c\x2a\xfb\x7e\x6d\xc9\xb9\xbd\xd9\x02\x17\x6c\x28\x07\x44\xea\x13\x91\xd3\xe3\x88\xdd\xe6\xd0\xe5\x45\x78\x68\xf0\x0e\x6b\xc3\x20\x85\xf0\xc5\x4e\x08\xf8\x49\x5a\x53\x17\x39\x35\x85\xda\xe0\xbc\x9a\x2b\xa6\x09\x2e\x73\xd5\xc9\x3c\x24\x09\x07\x8d\x53\xda\xb7\xc6\xbb\x17\x37\xb9\x46\xf3\x9d\x3a\x5c\x33\x2b\xee\xcf\x0e\x84\x4a\xd8\x9d\xb1\x0c\xd7\x0e\xce\xd4\xca\x98\xb1\x37\x5f\xc5\xfa\x74\x61\x76\xde\x28\x9a\x65\xf2\x83\x4c\xeb\xdf\xc4\x71\x4b\x94\x29\x33\x92\x90\xc1\x4c\x44\x17\x0c\x5d\x73\xf1\x2a\xc8\xa7\xaf\x88\x57\x63\x47\xac\x40\x4e\xd1\x20\x1a\x0d\x2e\xfb\xdb\x6e\xbd\x87\xc4\xb1\x67\xab\x7f\x43\x3c\xab\x81\x3d\x9d\x23\x28\xa5\x51\xcc\xfc\xe1\xb1\x41\xe2\xc7\xe5\x68\x51\xe0\x8a\x3c\xe8\x60\xdd\xe3\x6c\x90\xf9\xe2\x6a\x52\x15\xdf\x10\xbb\xeb\x8d\xcd\x78\x77\x22\x43\xe9\xf9\xd3\x4f\x76\xf5\x81\xd1\x9a\xa7\x71\x1c\x3d\xb1\xf6\x50\x6f\x72\xb2\x6c\x52\xdd\xfa\x42\x99\x75\x68\xfa\x27\xeb\xc8\x51\x2e\x93\xc0\x5c\xc8\x86\xc0\x44\x3d\x74\x37\x10\x25\xbc\x4a\x56\xc3\xa3\xf1\x0e\xb5\x5f\x66\x29\xf0\xa6\x41\x0f\x46\x73\x71\x0a\x2c\xc1\x25\x12\x6e\x9a\x1c\x07\xb5\xe2\x87\x0f\x15\x13\x98\x54\xb8\xb8\xf4\x4f\xa3\x36\x3f\x12\xcd\xea\xd7\xc8\x4a\x21\x28\xe2\x96\x28\xe5\xef\x50\x34\xc1\x4f\xb4\x75\x96\x82\x7e\x5f\x8a\x7a\x6d\xc1\x8d\xf8\x9e\x59\xec\xe4\x11\x75\xdf\x6a\x7a\x50\x3d\x99\x2b\x65\x21\x95\x19\x12\xab\xb0\xa8\x06\xbd\xf6\xa1\xf7\xf1\x3c\x1a\xfc\xe0\x9c\xb0\x74\x76\x73\xda\x8b\x87\xcf\xd4\x85\x6a\xad\x9e\xb9\x54\x29\xa2\xe0\x8d\xd7\x74\xf8\x5b\x59\x30\x3b\x78\x49\xc1\x55\x96\x4b\xd3\x2f\x2e\xa8\xf9\x3d\x92\x62\xbf\x9c\x51\x7c\xcd\xb6\x8d\x22\xd9\x40\xb2\xfd\xce\x8b\x07\x6f\x03\xa5\x1f\xd4\xdf\xb4\x64\xb0\x5a\xf0\xd1\xcb\xfe\x15\xb2\xd8\xe6\xb2\x80\x49\xa2\x5a\xf7\xe4\x32\x70\x91\x4f\xc8\xe1\x2a\xae\x05\x7e\xf9\xda\xa9\xd2\x4e\x64\xa1\x6e\x2a\x39\x8e\x9a\xef\xb4\xca\x0c\x99\xdd\x2a\x4d\x16\x6d\x5d\xf4\x9d\x07\x88\xa6\x9c\xdc\xa6\xa4\x88\x13\xdd\x87\x0a\x27\xa6\xca\x19\x8c\x5c\x96\x1d\xed\x29\x27\x55\xfb\xe0\x67\xd7\xcf\xe0\x06\xd5\x67\xab\x04\xf7\x10\x1e\x25\xea\x0b\x6a\xd0\x43\x94\xa2\x70\xe1\x8f\x8d\xd4\xec\x06\xf5\x2f\xb2\x59\x73\xce\x14\xef\x70\xeb\xe4\x95\x86\x82\xc8\x3d\x75\x35\xf5\xee\x5a\xd7\x4a\x4a\xe2\x6c\x9d\x65\x84\x9d\x69\x1d\x5e\x70\x8f\xc1\x0d\xda\x8b\xe2\x6f\x47\x0f\x73\xb1\xdb\xd8\x49\xc3\xec\x6e\x69\xe2\x79\xee\x72\xf8\xf1\xd5\xca\xcb\xf1\xe3\x4a\xf7\x2d\x61\x76\xce\x21\xc2\x45\xe7\x35\x4d\xcf\xc5\x15\x63\xae\x09\x19\xe5\xf7\x9b\xf1\x56\x0e\xea\xc7\x13\xe2\x5c\x97\xa8\x9c\x7c\x6e\x9f\x7c\xec\x20\x6a\x6b\x8d\xaa\x8d\xdb\x0e\x35\x64\x16\x6e\x66\xa6\x3f\x98\x7f\xe9\x0f\x27\x19\x90\x65\xb7\x7c\x15\xd2\xbe\x1d\xcb\xea\xfd\xf9\x73\xaf\xf6\x43\xbf\xaf\xea\xf3\x8f\x9d\xf1\x51\x78\xc7\x62\x4a\x1d\x9e\xba\xe0\x3b\x19\x0e\xf9\xbe\xcf\x22\x85\xca\x76\x61\x59\x3e\xd8\xb5\x6d\x40\x58\x42\x64\x99\x36\xc5\x19\xe9\x01\xbb\x11\x74\x9a\x75\x34\xe3\x02\x03\xc0\x2d\x3b\xb3\x64\x2e\x62\x23\x52\x9f\x9f\xbf\xa3\xb4\xca\xe7\x46\x90\x5d\x30\x99\x61\x08\x14\xf5\xb2\x4f\xd3\x47\xc5\x4c\xe5\x35\x27\xb0\xe6\x2d\xc7\x49\xf5\x5a\xc1\x7d\x6a\xb1\xd7\xda\x45\xb8\xac\x3a\xff\x69\x3a\x35\xe1\x29\x63\x74\xaa\x4a\x65\x11\xb7\xd8\x01\xba\xf4\xe2\xc1\x29\x74\x12\xb5\x91\xd8\x48\x7d\xe7\x58\x93\x42\x60\x54\x77\x09\x18\xc7\xb3\xc5\xde\x98\x27\x72\xd5\x24\x26\xac\xe7\x0e\x67\x5f\x38\x1f\x13\x54\xc6\x93\xc1\xa7\xe9\xe5\x1b\xb2\x09\xaf\x42\xa6\xa6\xe2\xbe\x2f\xad\x22\x4d\x9b\x99\xa3\x4f\xba\x0c\xa3\xed\x75\x8c\x5b\x6f\x75\x77\x0d\x75\x02\x09\x78\x70\xc5\x7e\x6d\x78\x8d\xaf\x52\xbd\x8b\xfe\x51\x86\xfd\x08\xf1\xaa\x0b\x3b\x06\x02\x63\xce\x91\xbe\x3d\x02\xc3\x03\x7e\x42\xee\x03\x9f\x8c\xac\x89\x15\x61\xee\x92\x7b\x06\xd9\x2e\x78\xf5\xda\xd4\x7c\x93\x12\xde\x90\xb4\x74\x47\xf9\x65\x3c\x76\x0e\xab\xd0\xbc\x9c\xea\xca\x42\x1e\x7e\x0d\x22\x4b\x31\x64\x28\x51\x65\xa6\xcc\x71\x7f\xdc\xf9\xb1\x52\xab\xec\x02\xec\xcc\xb1\x98\x2e\x2b\x79\x1d\x44\x82\x19\x03\xe3\x8b\x75\x1e\x74\x01\xe6\x79\x29\x86\x71\xe6\x41\xa4\x44\xc7\x2a\x7c\x44\xcc\x79\x2d\x1f\x28\xf5\x7c\x8c\xaa\xc7\x07\x2c\x3e\x69\x28\x63\x72\xa4\x0a\xe3\x3e\x25\xf4\xf3\x89\x88\x75\xe2\x45\x5f\xa3\x23\xca\x1b\x3c\x8e\xab\xcd\x54\xb0\x7a\xe9\xe5\x8e\x6e\x72\x4d\xfc\x38\x37\x11\x3d\x17\x2c\xf2\x6f\xfa\x3f\x39\x27\x29\x53\xec\xeb\x29\x1a\x87\x35\x6d\x5a\x9f\x67\xbb\x14\x1f\xe0\xa4\x40\xc0\x37\xe9\xcb\x05\xcc\xe7\x83\x7d\x82\xf7\x7b\xdd\x61\xe1\xdf\x60\x51\xe1\x0d\xd2\x23\x89\xc9\xa0\xfb\x98\xc8\xe1\x70\x37\x35\x25\x7e\x2f\x75\x71\x1e\xde\xec\xbd\x9e\x8b\xfc\xa8\xf5\x1a\x4e\x86\x2c\xe7\xa7\xe8\x72\x2e\xda\xac\x8e\x7a\x27\xf9\x32\xf3\x41\xfb\x54\x61\xce\x6d\x8a\x2b\xfe\xdb\x0f\x67\x2d\xe9\xd5\xc2\x35\x20\x00\x40\x08\xfe\x23\xd1\x21\x2b\x7d\x6b\x33\xe3\xfb\x74\xe3\xe6\xbc\x94\x8e\x4a\x62\x40\x0e\xdc\xff\x8c\x3e\xb1\xde\x9a\x18\x75\x30\xaa\x98\x53\x70\x4e\x40\xad\xac\xcc\x32\x03\xe6\x0a\x41\xc6\x44\x36\x69\x1e\x77\xb3\xd8\x06\x4e\x3e\x1f\x51\x1f\xa4\xd3\xfa\x1c\x7b\x98\xe7\xb9\x89\xeb\x02\x04\x88\xc5\x13\x66\x6f\xde\xd4\x51\x29\xf3\x89\x1d\x87\xe8\x1d\x4c\x37\x01\x38\x14\x9a\xe8\x03\x09\x0f\x05\xbd\x9a\x05\x53\x32\x94\xe7\x5c\x3e\x24\xd8\x16\xa3\x71\xb4\x0e\x57\xba\x57\xac\x25\x22\x11\x90\x34\x7d\x2b\x36\x46\xdc\x5e\xa9\xcd\x13\x4f\x7a\x31\xf6\x51\xa2\xe3\x1f\x8b\xc4\x1a\xef\x50\x69\x39\xd0\xe4\xeb\x6f\x2d\x3e\x39\xa2\x88\xcc\x6a\x17\xad\x3f\x0f\x48\xc5\xec\x9e\x27\x16\x63\x61\xd7\x40\xe8\x50\x08\xb9\x86\x6e\x36\xbb\x44\x7e\xb6\x6b\x5b\xb1\x13\x4a\x61\x87\xf2\x24\x65\xc7\x5d\xff\x6c\x21\xbc\xd8\x47\xdf\xbb\xbe\x1b\xd0\xae\x53\x6b\x3f\xf7\x69\x53\x44\x12\xda\x64\xe3\xf0\x53\xf1\x44\x6a\x7b\x73\x38\x1e\x6f\x2c\x77\x19\x5c\xaf\x8c\xb3\xbf\x33\x1f\xbc\xb2\x4b\x15\xa2\xdb\xc9\xe3\xb6\x32\xc1\x43\xfd\x76\xa2\xd4\xc8\xe7\x33\xc8\x36\xb2\x82\x7c\x80\x7a\x67\x52\x19\x8d\xf9\xdc\x14\x0f\x75\x40\x4f\x79\xef\xaa\xca\x31\x61\x40\x9d\xee\x0e\x5b\x90\x8a\x4d\xa0\xfc\x10\xef\x22\xd7\x3a\xae\x81\xae\xcc\x10\xc9\xb4\xa9\xcd\x75\x60\x80\xb2\x02\x86\x9d\x27\x34\x44\x1f\xd3\x80\xab\xd0\x63\xd0\x7e\xb9\x78\x84\x03\xdf\x75\x75\xf5\x9e\xf9\xfc\x73\x3d\x17\x53\x17\x5b\xdc\x54\x9b\x0f\x5f\x19\xc7\xe7\x32\xce\x95\xcc\xe2\x58\xa0\x04\x8d\xe3\xdc\x24\x8c\x69\x0b\xa9\xb2\x94\x53\xf0\x8e\x58\x7c\xc8\xc0\xe4\x6f\x30\xe3\xf8\xe7\xfd\xac\x4d\x6c\xc1\xfa\x53\x3f\xc0\x7b\xa1\x66\xd4\xac\x7d\x0a\xe4\xe2\x45\x48\x77\x3c\xe1\x61\xe4\x4f\xa4\xc3\x94\x9e\x37\xc3\xa6\xa2\x9d\xc8\x22\x28\x1a\xe4\xb4\x3e\xbe\x0c\x08\x84\x0d\xf0\xe9\xe2\x93\x36\x85\xbe\x19\xd5\xde\x28\xfd\xf0\xc0\x04\xe9\xfa\x8a\x42\x97\xa3\x10\x49\x91\x6e\xa3\xa1\x9f\xf0\xaf\x45\x78\xed\x8d\x1c\x6c\x9c\xec\x0d\x8d\xfe\x8d\x08\x2f\xc9\x9f\x70\xf4\xad\xcd\xac\x7e\x6f\x51\xd5\x35\xb4\xb1\x76\xb4\xb7\xb1\xb4\x34\xb2\xff\x99\xfe\x65\x52\xe0\xc0\x10\x04\x04\x00\xfa\x40\x01\x40\xf8\x9e\xe8\x7f\xbc\x53\xf8\xd1\xdf\xfe\x3e\xb0\x63\xda\xcc\xc1\x88\x91\x03\xf7\xaf\xd0\x50\x91\x32\x02\x88\xc4\xa3\x02\x78\xc4\x29\x99\x22\xc8\xe0\xed\xaa\x27\x67\x03\xa2\x77\x1a\x54\x17\x9c\xa7\x4f\xca\xc0\xdd\xd5\x55\x6f\x6c\xbe\xac\x13\x36\xb2\x01\x1f\x37\x84\xc3\x8a\xdc\x51\x1f\xba\x91\xeb\x08\xee\xac\x5a\x53\xd7\x1a\xbc\x54\x76\x8c\x9d\x20\x79\xe3\xe7\x82\xd7\x58\x1d\x22\x39\xc2\x1a\x22\x9e\x8a\xf4\xe9\xb1\x84\xc1\xb8\x8c\x98\x6f\xcf\x03\x54\x0d\x74\xec\xd9\xa7\x60\x56\x91\x33\xea\x74\x88\x01\x14\x12\x45\xe6\x7a\x81\x5b\x1d\xf5\x19\xe1\x2d\xca\xb0\xef\xed\xb2\xca\x6b\x3a\x6d\x4e\x27\x5f\xdb\xbe\x40\x53\xde\xf6\xb0\x27\xb7\x83\x42\x9c\xdc\xf3\x54\xf4\xb0\x13\xc0\xdc\x14\xac\x74\x74\x62\xbc\x5a\x81\xd8\x66\x2d\x72\x87\xf7\x27\x9c\x12\x7b\xda\xd5\xa1\xce\x58\x6d\x9c\x11\xb2\xe9\x95\x30\xff\xb9\xc9\x4b\x1f\xeb\xd1\x51\x14\xf4\x71\x4f\xe7\x38\xd6\x0a\xda\xb3\xf5\xaa\xd0\xb4\x2e\xd3\x99\x7d\xe2\xa6\x30\x14\x53\xd8\xfc\x88\xce\xb0\x51\x73\xf8\xf8\x23\xbe\x8e\x5d\x48\xfe\x49\xda\xc0\x4a\xaa\x59\xfc\xf5\xad\x57\x5a\x0d\x72\x97\x23\x62\x89\xee\x83\x4b\x19\xd8\x89\x36\x77\xaf\x2d\xf6\xdd\xde\xff\x99\x05\x4d\xb1\x6e\x3b\x11\x06\x01\x00\x49\x88\x3f\xd4\x1e\xef\x84\xfc\x6e\x15\xff\x76\xe9\x26\x5f\xce\x5b\xfb\x13\x21\x07\x96\x9e\x52\xb2\x69\x69\xb6\x0b\x82\x9f\x2b\x27\x06\xce\xc4\x15\x93\x95\x60\x3d\x3d\x37\x5e\x69\xc0\x8c\x62\x45\xaf\xdd\x15\x19\x67\x21\x26\x19\xb8\x61\x7e\xfd\x01\x77\x4f\x1f\x18\x9a\xd3\x62\xa2\xb6\xe9\xab\xca\x29\x69\x3b\x04\x18\xd0\x90\x7d\x7a\xcc\xd4\xb5\xb9\x06\x03\x34\x31\x5b\x82\xda\xa8\x40\x65\x03\xcc\x69\x27\x22\x0a\xc8\x69\x81\xb8\x29\xe9\xb4\xf4\x11\x65\x3a\x27\xda\xc2\xa2\xea\x23\xcb\x8a\xb0\xad\xca\x62\x9b\x7e\x89\x49\x9b\x1a\x5b\x63\x70\xd4\xf6\x27\xca\x0d\x38\x52\xaf\xa9\x67\xb1\x9c\xa8\x4f\xab\xe3\xc2\x96\xb0\x8b\xdf\x16\x8c\x9e\x17\x58\xb0\x62\xb2\xe0\xf7\xda\xc3\x7b\x8f\x3a\x37\x33\x07\x80\x7a\x6b\x09\x77\x77\x30\x87\x22\x5e\xbb\x87\xa8\x1c\x62\x1d\xeb\xb8\x1b\x20\x1b\x26\x2a\x44\x09\xcb\x71\x71\x30\x04\xc1\xa3\xc6\x58\xd2\x26\x94\xb5\x09\x1a\x06\xab\x09\xf1\x22\x7e\xac\x80\xc7\x88\xd4\xc3\x19\x27\x39\x53\xeb\xc2\xf3\xed\x50\xb3\xdd\x63\xce\x56\xcc\x37\x83\x0d\x10\x43\x5e\xd2\xa0\x48\xb1\x10\xb1\x54\x10\x2d\x87\x7b\xd5\xb4\x54\xdf\x03\x75\xac\xb4\xa9\x72\x4d\x98\x58\x48\xe6\x29\x70\xae\x6b\x46\x5c\x7f\x33\xc5\x3c\x0a\x55\x67\x9c\x89\x94\x5e\x49\xbf\x66\x4c\x5c\xcf\xfd\x8c\x59\xee\xc4\x21\xf1\x7a\x5a\xe9\xb3\x49\x32\x21\xd2\x7e\xe7\xb9\xc5\x01\xdb\xa3\xcf\x29\xea\x03\x46\xaf\x55\x93\x42\xbe\x54\x62\x44\xb7\x7c\x1d\x29\xde\xc3\xff\x6b\x75\xed\xdb\x2b\x75\x4f\xb1\xc1\xfb\xc2\xfd\x2e\xf3\xe9\xf6\x13\x71\xd4\x74\xe0\xcb\x45\x0f\x02\x00\xee\x60\x00\x40\x7d\x17\xdc\x3f\xd0\x0c\x8d\x59\x88\xf4\x23\x42\x0e\xd8\xf7\x0c\x0d\xdc\x0e\xb4\xde\x67\x6b\xee\x33\x16\xa6\x88\x8c\xa3\xf1\xf5\x31\x33\x1c\xbf\xd8\xe3\x0e\xce\xbc\x66\xdc\x0c\x08\x61\x32\x69\xe6\x84\x5a\xd7\xd9\x71\x2e\x72\x6e\x8e\xa3\x28\x59\xf6\x73\x8e\x8f\xe3\x76\x5e\x5d\xfa\x12\xb6\x6b\x58\x3e\x89\x11\xfb\x8a\x35\xbe\xcf\xb4\x8c\x62\x63\xd4\xf5\xd1\x64\x4a\x1f\xc9\x1b\x22\x8a\x52\x6e\xa9\xe2\xcd\xe8\x48\x27\xf4\x80\x6a\x48\x42\x1f\xde\x6a\xe5\xe5\x00\x73\x22\xbd\xcf\x07\xa4\x23\x4e\x2b\x6f\xca\x23\xc2\xbd\xa7\xc7\xfc\xd1\x2d\xf0\x14\xfa\xc3\xda\x82\x25\x8e\x03\x58\xb4\x85\xe7\xbc\x2d\x15\x10\x5e\xfb\x8c\xac\x74\x76\xb0\x19\x60\xe0\xc9\x74\xe5\xb7\xd8\x6e\x88\x72\x67\xe1\x2e\xce\x1e\x53\xcb\x6b\x48\x77\x51\x7c\x40\x55\x54\x85\x7c\xc8\x30\xed\xe6\x5b\x87\x48\xa3\xc4\xa9\x3c\xf0\xa9\x84\x90\x6a\xa5\x37\xde\xbe\x58\xb1\x2b\xa0\xb6\x47\xc9\x55\x45\xc5\xa0\x7f\xa7\xbe\x31\x45\x8b\xc2\x64\x94\xdb\xd0\x54\x32\xf8\xe8\xe5\x61\xbf\x53\x11\xdd\xd3\x07\xc7\x46\xc9\x4f\x4c\xd5\x55\x90\xae\xce\x83\x3f\xe7\xf2\x09\x4a\xd9\xb4\xcc\xee\x61\x6b\xc1\x6d\xd8\x24\xb5\x3c\xc7\xc8\xde\x39\x66\x6a\x79\x77\x36\xee\xcb\x67\xac\xe3\x21\x01\xcb\x97\x85\x09\xa7\xf7\xa9\xd7\x3b\x3a\x58\x81\xf5\x90\xb5\x66\xaa\x6a\x54\x6e\x20\x35\xc8\x80\xe2\x2b\xc8\xcf\x2c\xb1\x95\xcd\x53\x23\xcb\x7f\x1a\x31\x78\x77\xc1\xfd\xb4\xe8\xe5\xc4\x65\x20\xf5\x16\x08\x00\x24\xc0\xfc\xf1\x7e\xfa\xaf\x50\xef\xb7\x37\xa9\x38\x6e\x21\xd2\x97\xe1\x8f\x98\xb1\x20\x89\x8d\xeb\xbc\xa0\xf2\xc7\x8a\x15\x4c\x2a\x9a\xa8\x12\x71\x99\xbe\xd8\x9b\x57\x69\x3d\x52\x14\x99\x4a\x0a\x74\x46\x24\x64\x1e\x1f\xb7\x7f\x4d\xbc\xba\x1a\x8b\xaf\x50\xce\x07\xb2\xe2\x8f\x31\x04\xcb\x39\x03\x57\xb6\xce\x5d\x12\x4a\x20\x06\xa2\x19\xbf\x66\x50\x0d\xe6\x0e\xb4\x3d\xcf\x7e\x18\xff\xc0\x9f\xce\x3d\x36\x11\xfe\x22\xfe\x45\x56\x5d\x93\x0e\x7d\x3f\xd0\x9b\x8e\x4c\x42\xde\x8a\x56\x03\xe9\x3a\xcf\x6b\x9b\x71\x18\x27\xd3\x5a\x4b\x81\x5d\x34\x4c\x82\xea\x41\xd9\x99\x06\xd6\xc6\x1b\xbd\xa0\xe3\x98\x45\xde\xde\xbb\x06\x37\x85\xab\x81\x81\x6d\x03\xed\xa3\x3d\x66\x0b\x4a\x5f\x55\x68\x9a\xea\x20\x57\x30\xe3\xf2\x62\x5f\x24\x03\xef\xa5\x84\x67\xb1\xae\x03\x5b\x15\xb0\xc8\xf2\xce\x32\x14\x11\x73\x13\x44\xe9\x93\x84\xf1\x04\xf5\x23\x51\xfb\x4c\x04\xed\x0c\xcd\x51\xab\xb7\x9b\x7a\x0d\xa6\xb4\x59\xe1\x3b\xc1\xe6\x6e\x3c\x51\x4a\x31\x3e\x61\x6b\xf2\x61\xd9\x46\xc9\x5f\x0a\x68\x0c\x1a\x6d\x1f\xc4\x13\x76\x84\x35\xe7\xf2\x54\x3b\xef\xe9\x80\x18\xd5\xa7\xbd\x92\x40\xf1\xea\xc9\xcd\xc8\xc1\xf4\x0f\x29\x8c\x9f\x62\xd5\x2a\xed\x8f\x24\x3b\xdf\xc3\xc2\x12\x7b\xcb\x40\xf9\x15\x1c\x32\xcf\x88\x1b\x7d\x47\xb6\xef\x13\xfc\xae\x56\x26\x4c\xf8\xd1\x45\x32\xc3\x34\x34\x2b\x22\x33\x93\x3b\xdd\x93\xb8\x71\x50\x27\x8e\xdd\xb7\x9c\x92\x89\x26\x2b\x2b\x2a\x2e\x90\xf8\x1e\xb9\x52\xa9\xef\xd7\xa7\x3a\xe7\x1c\x47\x71\xdf\x87\x89\xce\xb2\x3f\x0b\xf2\x50\x7e\x04\xc5\x86\x5b\x38\x14\xe5\xb4\xdf\x0f\x1f\xe6\xa1\xbb\x06\x5f\x77\x6e\xf4\x64\xb8\xc9\xb3\x9d\xe5\x98\xef\x03\xcf\x59\x09\xf7\x1c\x9d\x1a\x21\x6a\x88\xf6\xdc\xc2\xc3\x8e\xad\xfa\xab\x20\xd5\x6c\x62\x61\xcf\x42\x67\x8b\xb1\x9c\x8f\xa3\x2a\xd8\x24\xe0\xce\x8f\x57\x62\x75\xa0\x59\x17\xd8\x14\x70\x55\x16\xd8\xd3\xfb\xa6\x72\x0d\x95\xb2\x27\xa3\x70\xe9\xaf\x53\x4d\xc3\x21\xca\x9b\xe4\x91\xfa\x1a\x5f\x8f\xe8\x18\x5e\x0f\x7b\xaa\xe8\x35\x49\x9f\xa9\x60\x91\x42\x8e\xd6\xa1\x34\x9c\x95\xc4\x94\xbb\xea\xef\x2d\x45\xce\xc5\x36\xb8\x19\x97\xbc\x51\x67\x6a\x18\xfa\xfa\x4f\xe2\xf3\xa7\x2f\x6c\xa6\x47\x5e\x16\xef\x40\x00\x40\x02\xea\x47\x37\xc8\xdb\xa8\x7f\x5c\xd7\xfe\xf8\xf1\xf7\xb9\x4d\xec\xb7\x61\x4a\xbb\x65\xf0\x0c\x5d\x08\xba\x27\x5d\x5a\xc5\x7c\xce\x77\x1b\x67\xc9\x73\x1e\x56\x6d\x24\xd3\xce\x46\x6c\x8e\xf3\x9a\x8b\x3f\x48\xc7\x18\xda\x8d\x74\x25\x48\x2a\x17\xc1\x56\x50\xfd\x93\x55\xb4\xa8\x6f\x34\xf8\xcc\xb1\xd3\x89\x00\xf5\xcc\x51\x51\x6c\x12\x7e\x19\x98\xd8\x73\x95\x50\x35\x11\xe4\xfa\x84\xd2\x31\x34\xa2\x9a\x64\x82\x4f\xbb\xae\xb5\xc5\xa0\x55\xde\x12\x63\x31\xa0\xd3\x33\x88\xc8\x96\xe7\x44\x4f\x0b\x84\x8f\x2c\xad\xcd\x3e\xa1\x3c\x7f\x3a\x48\xcc\x3b\x2a\xb4\x37\x09\x73\x74\x4a\x52\x07\x55\x3f\xdf\x6f\x57\x95\xe1\xbb\xb6\x14\xbd\x56\x54\x02\x3b\x8a\x8a\x61\x2d\x3e\xac\x0e\x4e\x5d\x1b\x1a\x97\x03\x14\x87\x96\x47\x29\xe9\xaa\xf0\xb5\x8b\x17\x36\x3b\x77\x37\x26\x2b\x13\x5d\x36\xcf\x34\x31\x7e\xa0\xe2\xf6\xb6\xf6\x8e\x57\x3f\x2d\xe8\x79\xc1\x56\x94\x35\x8c\x89\x31\x9f\x29\xdb\xda\xfe\x80\x76\xa5\x20\xbf\xd4\x06\xd6\x13\x8d\xd4\x6f\xa3\x66\x48\x14\x22\x7c\x9f\x95\xa9\x6b\x33\x72\x0b\xbb\xfb\x58\x4c\x1f\x34\xb3\x82\x20\x26\x5c\x6a\x44\x37\xc5\x40\x2a\x9e\x4f\x01\xe1\x51\xbf\xa8\xf8\x02\x7c\xb2\x7d\x69\xa4\x1d\x73\x9d\x24\x75\xc8\xa9\xe3\x49\x30\x8a\xee\x7e\x36\xfe\x9c\xe5\xb6\x1b\x89\x73\x96\x3b\xfc\x53\xa1\x39\xb4\x2f\x20\xc2\x65\xee\x09\xb0\x6d\x84\x9f\xec\x7d\xf5\xe9\x46\xa1\xc2\x2f\x2f\x62\x18\xd8\xa1\xaf\x3d\x23\x29\x66\xf2\xdd\xf3\x12\x41\x4c\xfc\xb1\x4b\x44\x6b\xa5\xde\x8a\x60\x43\xe0\xd6\x16\x2d\x58\xcb\x97\x28\x3d\x27\xbe\x39\x80\x6d\xbe\x4e\xab\x0f\xeb\x48\x94\xca\x5f\xb5\x2a\xe4\x21\xf5\xb7\x7e\xbc\x1f\x1b\x84\xbc\xd5\x60\x95\x73\xa6\xe5\x44\x28\x90\x54\x2c\xd9\xfd\x7a\x60\x2e\x2f\xbe\xe8\xd0\x6f\x03\x1f\x0e\xc5\x25\x8b\xec\xb7\xd8\xd3\x7e\x84\xf8\xf0\x98\xa7\x99\xaa\x0c\xf7\x65\x03\xba\x4a\x88\xfe\x89\x4e\x88\xa1\x47\x6d\x2f\x47\x23\x43\x77\xad\xae\xd5\xdc\xd2\x54\x9f\xfb\x2b\x93\xb3\x48\x1d\xd2\xca\xed\x1d\xa4\xbf\x96\x90\xbd\x1d\x2a\xbf\x24\x64\x7f\x49\xc8\xfe\x92\x90\xfd\x25\x21\xfb\x4b\x42\xf6\x97\x84\xec\x2f\x09\xd9\x5f\x12\xb2\xbf\x24\x64\x7f\x49\xc8\xfe\x92\x90\xfd\x25\x21\xfb\x4b\x42\xf6\x97\x84\xec\x2f\x09\xd9\x5f\x12\xb2\xbf\x24\x64\x7f\x49\xc8\xfe\x92\x90\xfd\x25\x21\xfb\x4b\x42\xf6\x97\x84\xec\x2f\x09\xd9\x5f\x12\xb2\xbf\x24\x64\x7f\x49\xc8\xfe\x92\x90\xfd\x25\x21\xfb\x6f\x25\x64\xb1\x7e\x50\x89\xb4\x77\x34\x33\xfc\x77\x63\x4f\x6f\x23\xda\xff\xa7\xaf\xe7\x1f\xf5\x14\xd6\xf1\xba\x4a\x86\x01\x00\xb0\x0e\xfc\xa8\x75\xe8\x07\xd0\xf7\x6b\x03\x11\x19\xe2\x86\xed\x60\x80\x87\x5c\x5e\xc8\x63\xc0\x11\x66\xe8\x9d\x83\x73\xdc\x9a\x2a\xf6\xd7\xc3\xc9\x01\xc7\xc3\x55\xca\xc9\xc6\x5a\x6a\xb2\x27\xd8\x23\x2a\x3b\xf1\x42\x89\x94\xb0\xc0\x02\x1b\x8c\xbf\x42\x1a\xf3\x80\x25\xa0\xc3\xd1\x18\x67\xcb\xf5\xe1\xca\xf3\x7f\x2e\xb0\x95\xd6\xc6\x0c\xb1\x0e\x1e\x12\x1c\x23\x76\x46\x98\x98\xf2\x1a\x44\xb4\x81\x83\xee\xf1\x08\x5c\x47\xb8\xd4\xd2\xb3\x0b\xf7\xc6\xb0\x42\x59\x52\x7e\x81\xc4\xa2\xfe\x69\x42\x79\xe1\xb8\xcf\xbb\x58\x18\x25\x8a\xaa\x6c\x58\xaa\x3a\xd0\xb5\x10\x75\xfb\xd2\x3e\x0b\xb9\x31\x7d\x71\x13\x7f\xa1\x29\x8b\xf6\x27\x7b\x1d\x8d\x5c\x7f\x4a\x51\xb6\xea\x4a\xa6\x5f\x1c\x00\x00\x2f\x00\x00\x08\xee\x40\x33\xb2\xd6\x55\x56\xa4\xb3\xd4\xff\xeb\xba\x33\x77\x15\xcf\xf1\xc8\x51\xa6\x70\xff\x29\x22\xed\x30\x7f\x15\xcf\xe9\xc8\x11\x9a\xe6\x7a\x66\xdf\xc7\x98\x77\x78\x10\xeb\xe1\x13\x1f\x51\x4a\x42\x2f\x26\x1a\x63\x27\x32\x2f\x9e\x22\xcb\x22\x05\x98\xe0\x66\x2c\xc3\x7e\x14\x54\x8e\x7f\xba\x96\x9d\x49\x55\x26\x34\x99\xfc\x2a\x21\x29\x34\x17\xea\xbb\xc8\x99\x26\xd1\x97\xfe\xed\xd7\x6f\x9f\x87\x77\x1c\xe0\x6f\x87\xe6\xa4\x6f\x62\xe4\xf0\xb7\xab\xaa\xf9\xf0\x77\x63\x1e\x6a\xc3\xfe\xf5\x88\xfb\x3f\xb3\x38\xd9\xff\xe3\x80\xff\xb1\x5f\x7f\x07\xfc\xe9\xbe\xbb\xb2\x40\x0c\xeb\x0f\x60\x00\xf0\xdb\xe7\xcf\xfd\x24\xdf\xe3\xba\xfe\x1e\xfd\x7f\x04\xfc\x3f\x6b\x1b\x10\xf8\xbd\x6d\xa0\xc0\xcc\x6e\xf1\x07\x9d\x02\xdf\xb6\x11\x80\x85\x89\x3f\x16\x54\x72\x9d\xdb\x55\x6f\xb1\x40\x17\x44\x7e\x0e\x8d\x42\xfd\x40\x89\xe4\xa1\xfc\xb3\x18\xf0\x88\x98\x60\x0a\x10\x18\x21\x58\x2f\x1e\x5c\x08\x38\x41\xc1\x89\x3e\xd5\x60\x22\x8c\xaf\x30\xf1\xf1\x97\x2e\x6f\x66\x2e\x90\xb2\x47\x87\x9e\xcc\x4f\xd4\x3c\x2c\x7a\x0e\xb5\xb4\xe2\x90\xd0\x54\xeb\xd2\xfd\x61\xe1\x2d\x58\x87\xcc\x9a\xf3\xb9\x71\x73\xca\xec\x6b\x0f\xec\x88\xaf\x23\xc3\x84\xfb\xed\x7b\xaf\x76\x5b\xed\x62\x05\x24\x2d\xd6\xdb\x3f\xba\x56\xa3\x4c\xab\x0b\x13\x54\x8b\x6c\xb9\xa2\x23\xeb\x4d\xf4\xe5\x0c\xda\x9e\xcf\x66\x19\x68\xcd\xd7\x1f\xbd\x6a\x74\xba\x32\x26\xf6\x23\x8f\xda\x47\x7f\x94\xf2\xd6\xd6\x53\x61\xa0\x33\xe1\x61\x2d\x6c\xeb\x7a\x54\x5e\xfb\xde\x34\x26\x48\x56\x1f\x25\x30\x52\x7e\xba\x5b\x08\xd1\x61\xc7\x20\x28\xc7\x0b\x10\x03\xb6\x34\xa4\x38\x0c\x50\x0e\x9a\x10\xcb\xd7\xdb\x93\x07\x9e\xeb\x2a\xad\x6f\xec\x61\x01\x47\x94\xbc\xec\x21\x05\x51\x32\x0a\x1c\x90\xfc\x8c\x25\x77\xf0\xd6\x59\x59\x9b\x78\x13\x40\x4e\x46\x1a\x64\xbb\xe8\x1a\x77\x40\x69\x83\x04\x70\x82\x79\xc2\x38\xdc\x9f\x09\x3c\xda\x87\x95\xf6\x51\x02\x34\x64\x7d\xc8\x80\x11\x79\xfc\xb8\xdd\x05\x86\x3a\xe8\xe5\x4d\xcd\x20\x5a\xac\x70\xdf\x36\x10\xef\x83\x70\x6d\x7f\x36\x2a\x4b\x74\x2c\x25\xd8\x83\xdc\xda\x3c\x19\x39\xc8\x34\x50\x8d\xbd\xc6\x82\x91\x4d\xa6\x99\x2a\x58\xd3\x37\x16\x60\xd9\xe8\xde\x0b\x7a\xe4\xb8\x19\xb9\x0f\x80\xee\x0d\x9e\xfe\xcc\x9a\xce\x39\x3a\x97\x0a\xf4\x12\x49\xbf\xcf\x58\x05\xdb\x62\xba\x53\xa4\x01\x4c\x1d\x11\x6f\xaf\x4e\x26\xfb\x5f\x5b\x9e\xba\xfb\xcc\xbe\x15\x83\xb2\x41\xda\x50\x9c\x9e\xe5\x75\xc4\x3f\x99\x10\x9c\x7a\x3b\x17\xb8\x5d\x4f\x02\x74\x6d\xaf\x87\x0d\x7a\x63\x19\x85\xeb\xad\x56\x4f\x8e\xa7\xcf\x38\x34\x10\xaa\x4f\x77\x2e\xae\x6f\x9e\x3e\x76\xe2\xf7\x64\x9f\xd1\x54\x85\x01\x16\xb4\xd8\xa9\xc1\x5a\x4b\xa8\x99\xe9\x20\xb5\xc2\x19\xf3\xe0\x81\xfe\x6a\x60\xa6\xf2\x46\x0e\x8b\xa9\xd6\xd9\xed\x2b\x9c\x28\x06\x46\x54\x14\x46\x64\xea\xc6\x13\x6c\x7f\x79\x28\xd9\x48\xb8\x45\x8f\x8b\x99\xf0\x7c\xc5\x35\x66\xae\x0e\xb7\xfa\x5c\x29\xeb\x53\x92\x24\xd0\xf4\xa1\x61\x43\x50\x6f\xa7\x7e\x4c\x76\xad\xb2\x37\xdd\x75\x4e\x86\x0a\x3d\x7e\x80\x77\x24\x03\xe8\x72\xba\xcb\x56\x01\x14\xe0\x17\x02\xc0\x09\x0c\x98\xc9\x2e\x51\x03\x7a\x55\x5c\xab\x20\x7a\x1d\x04\xe4\xf8\x92\x21\x3a\x39\x80\x83\x4a\x07\x3b\x29\x84\xa3\x75\x29\xbd\xa0\x53\x36\x2c\xd0\x24\x0d\x42\x3f\xad\xa8\x28\xd0\xc1\x87\xf1\x35\xa5\x14\x54\x59\x42\x2f\x85\x2f\xfd\xd5\x83\x11\x34\x80\x1e\x1f\x52\x15\xb2\x7a\xb4\x1c\xdd\xdb\xab\xb0\x64\x1c\x88\xad\x6e\xd6\xe1\xc4\x55\xa2\x4e\x6e\xd9\x0a\x3c\xe0\x0c\x17\x87\xea\x32\x09\x93\x0b\x8b\x50\xe9\x1e\x54\x16\x75\x5b\xda\x64\x86\xd0\x6c\x6a\x02\x37\xbc\xd2\x3c\x5b\x5c\x9f\x5d\x8d\x88\x03\xe4\x0c\x9b\xe4\xd5\x11\x14\xb2\xe8\x64\x09\xfd\xe3\x2c\x92\x20\x84\x6b\x2c\x14\x81\x83\x27\x48\x07\x09\x9e\x11\x20\x65\x4f\x3a\xd9\x72\x1f\xb9\x1f\x71\xb7\x34\x07\x70\x8f\x5f\x7d\xe8\xe9\x52\x70\xbd\x38\x13\xcf\x08\x03\x31\x79\xf4\xce\x43\x15\xfe\x20\x96\x35\x0e\x84\x82\x99\x02\xd2\x9b\x2b\x4a\xd1\xeb\xab\x3e\x17\x18\x12\x93\x63\x22\x62\xea\x23\xbd\x9a\x41\xc6\x20\x9a\xe5\x54\xab\x22\x2c\x36\xfb\x18\x10\x81\x85\x4f\x06\x7d\xc7\x7b\x00\x5f\x12\x94\x77\x3c\x33\x12\xc0\xc6\x9f\x0f\xd8\x4e\xc4\xc0\x20\x17\x37\xf5\x02\xae\xba\x26\xb0\x90\xec\xd9\x8b\x70\x46\x1c\xa1\x65\xa2\x17\xf3\x68\xde\x19\x46\x6f\x8b\xf6\xe5\xb8\x1b\x63\xa9\x2b\x5f\x7a\x6f\x5e\xc5\x80\x24\x9c\x17\x86\xef\x0e\x6e\x42\x47\x00\xf3\x92\x72\xb2\x33\xa9\x4f\x8b\x3e\x8f\x3a\x52\x58\x64\x38\xe9\x52\xab\x5e\x92\xe6\x5b\x9b\x18\x2b\x06\xf5\x54\xc2\x1f\xa1\x35\x42\x53\x5c\x81\xab\x40\xed\x5e\x19\x0c\xe3\x3a\x45\x7f\xbe\x01\x7d\xcd\x64\xd4\x17\x4b\xa9\x98\x2b\x75\xeb\xcb\x09\xe1\xa8\x4d\x5e\x12\x11\xb2\xda\xd9\x2a\xd5\xdd\xb7\xf8\xe7\xae\xa5\x4f\x63\xc0\x00\xe0\xb7\x0f\xd3\xcf\x5c\x47\x74\x6d\x6c\x8d\xfe\x69\x0f\xd2\xed\x8b\x89\x83\x90\xb8\x12\x34\x34\x34\xf4\x33\xe8\xa7\xc1\x00\x00\x26\xfe\xfb\xf5\x23\x79\x37\x61\x58\x16\x55\x10\xad\xbb\x65\x21\xc6\x12\x6c\x53\x60\x53\x05\x59\x2c\xe9\x89\x61\x62\x74\x30\xa6\x10\x47\xff\x52\xab\xd7\x4a\x4b\xfa\xe1\xf0\xc8\x4b\xcc\x95\x36\x3f\x28\x44\xbc\xba\x1e\x9f\x19\xdb\x59\x01\x39\x96\x05\x05\xec\xb1\x40\x56\x1f\x77\x0a\x1b\x6e\xee\x14\x75\x8d\xad\x29\x8a\x7d\x87\xd9\x59\xf6\x96\xc5\x46\x37\x9b\xf5\x41\x50\xe0\x79\x4a\xcb\x7e\xea\x1e\xff\xbe\xaf\x3e\x56\xe0\xff\xfe\x7d\xf5\x60\x72\xd9\xdd\x80\xb6\xc9\x6c\x19\x29\x31\xb2\x35\xd1\x7e\x99\x80\x93\xe7\xd0\x96\x04\x01\xf4\x84\x31\xc4\x84\x34\x72\x8f\x44\x6e\x95\xb6\x4b\x02\x02\x53\x83\x82\xab\x0b\x62\xcf\x35\x5f\x5f\xe6\xb4\xa9\x41\x02\x1f\xc3\xd8\x9b\x92\x90\xdd\x30\x45\x64\x81\x68\x46\x6c\x6f\x31\x9d\x13\x70\x8c\x26\x52\xbc\x89\x6d\xd3\x1c\x53\x80\xbc\xfa\x05\xab\xd2\x30\xd1\xa3\xde\xb0\x11\x8d\xb3\xeb\x27\x45\x56\x1a\x52\x03\x00\xd7\x60\x7a\xcb\x55\x93\x36\x61\xac\x17\x52\x73\x4e\x49\xf7\x0c\x71\xba\x62\x71\x3c\x40\x34\x27\x88\xba\x8a\x70\xa2\x75\x12\x0f\x9c\x60\xca\x1e\x19\x13\x11\x1f\xed\xb5\xa0\x82\x26\x6d\x5b\x98\x42\xa2\x34\x2f\xb5\xae\x5d\x26\x56\xa7\x34\x42\xcb\x9f\x3c\x1e\xd8\xf5\x6c\xd1\x47\x08\x62\xf9\xbc\x42\x6c\x8b\x19\x60\x55\x52\x12\xf8\x44\x23\x1c\x1d\xf4\xd2\xc0\xe5\xfc\x2c\x0b\x79\x66\x61\xbe\xf6\x0c\xd5\x6d\x7a\xeb\xcc\xdc\x6d\x54\x53\x88\x39\x3a\x3f\xb0\x55\x2d\x44\x6e\x6a\x30\x62\xc2\x64\x82\x27\x5d\x83\xb6\xed\x1c\xb7\x0e\x96\x5e\x51\xd2\xff\xeb\x1c\xa5\x5e\x87\xf3\xc3\xec\x4e\x35\xe2\xf4\xbd\x29\x06\xab\xde\x38\xca\x07\x1a\xc5\xa7\xed\xe9\xe7\x08\x1b\xb4\xf1\x42\x60\x09\x03\x94\x0c\x67\x8c\x09\xb1\x97\x89\x5b\x97\xa7\x4c\x5c\x54\xca\xc2\x04\x13\xdb\x5d\xb6\x62\x61\x1e\x5e\xd8\x7d\x52\xee\xc4\x2f\xd7\xd8\x3a\xa4\x41\xbc\x29\x78\x1f\x6b\xd6\x30\x3c\x82\xed\x22\xf7\x4e\x90\x62\x93\xc9\x38\x07\xf3\xae\xb1\x24\xd6\xdc\x5a\x40\x2e\xc5\x59\x67\xe4\x44\xe6\x87\x98\x60\x3c\xad\x08\x35\xe3\xab\xa6\x13\xdf\xd7\xb7\x7e\x31\x4a\x29\xd0\x97\x98\xce\xa5\x88\x9b\x58\x44\xab\x39\x33\xb3\x28\x94\x43\x23\x47\x09\x67\x69\x69\xd3\x89\xf1\x8a\xe5\x6b\x99\x03\xe9\x26\xd7\xce\x05\x17\x58\x77\x7a\x33\xea\x25\x5d\x81\x93\x39\xa7\x66\x62\x67\xe1\x34\xfd\xc1\xc9\xa8\xe1\x97\x2f\x87\xe8\xb2\xa9\xd2\xa2\x8b\xd1\xd9\x45\xc5\x96\xee\xb2\xe6\x59\x38\x1e\x68\x0f\xc1\x36\xf6\xd8\x07\x04\x9e\x16\x59\x9a\x61\xc4\x55\xb4\x26\x45\xd1\x1f\xee\xed\x92\x27\xa7\x68\x19\x3e\x27\xf5\x93\x50\x5f\x1e\x6b\xaa\xa9\x67\x2b\x7f\xdb\x6c\xec\x68\x85\x3f\xde\x15\xad\xb5\xba\x30\x43\x86\x60\x11\xdc\x03\xc4\xb6\xd7\x18\x51\x53\x8d\x37\x10\xe8\x92\x05\xc4\xc8\xc5\x31\x33\x6e\x55\xbf\xea\xea\x73\xe6\x96\x77\xab\x56\xa9\xc4\xa1\x83\x11\xc5\x8f\x4e\x8d\x0d\xdf\xe8\x1a\xb1\x71\x7d\x1a\xae\xb5\xb1\xbc\xa1\xf6\x71\x27\x3b\xec\x33\x3a\xd4\x31\x35\xe2\x90\x4c\x3d\x07\x57\x7c\x4f\x80\x4c\x51\x71\x33\xb4\xf6\x07\xa8\x4e\x4a\x8b\x04\x79\xde\x5e\xb3\x51\x81\xa5\x60\xe6\x4c\xbd\x41\xfe\x22\x48\x00\xd2\xeb\x71\x42\x78\x46\x1f\x0c\x93\x04\x9d\xa3\xed\xc5\x5b\xbf\xb7\x01\x46\x03\xb4\x34\x2b\x07\x0e\xe4\x1a\x8d\xcf\x0b\xc7\xb5\xe3\xd7\xac\xfb\x55\x58\xd2\xac\xd8\xf3\x77\xc3\x29\xde\x61\x14\x57\x68\x14\x22\xec\xc2\x74\x49\xc9\xb1\x76\x09\x36\x59\xc7\xa2\x16\x5c\x3d\xff\x5c\xe6\xe7\x5c\xe2\xf2\x0e\x22\x88\x5b\xc0\xb8\x7e\x69\x3f\x57\xea\xe4\x2b\x14\xfc\x57\x16\xf9\xf5\x06\x5a\x54\x4b\x48\x94\xdd\xa4\x4b\xe3\xe9\x88\x3e\xa8\xd3\xc8\xea\xa8\xa1\x26\x59\xff\x6f\xc3\x19\x2b\x50\x7f\x0f\xbd\x05\xc4\xc9\x03\x0c\x77\xd3\x38\xda\xe7\xfb\xb3\xff\x7f\x59\xdf\xe2\x08\x9b\xb0\x34\x00\x00\xfa\xc0\x1f\x33\x1d\xee\x38\xfb\xcd\x1c\x8d\xac\x74\xff\xf3\xeb\x6f\x53\xab\xc2\x35\x05\x39\x39\x2a\x09\xda\x21\x7a\x5a\x09\x99\x08\x7a\x31\xa9\xa1\xc8\x61\xc9\x01\x69\xe5\x0a\x05\xb9\x02\xb1\x41\x49\x71\x5a\x89\x21\x31\x5a\x3a\xf9\x31\x10\x4a\x6a\xf1\x62\x0a\x4a\x5a\x89\x00\x6a\x31\x9a\x5e\x71\x9a\xa9\x7e\x1a\xf1\x41\xda\x27\xca\xd1\x31\x6a\x31\xd0\x4a\x72\xda\xfd\xca\xfd\x10\xdf\x25\xa9\x8f\x69\x3b\xa4\x5f\x00\x00\xc0\x04\xf2\xa3\x14\xff\xbf\x87\xeb\x68\x64\x6f\xaf\x6f\x66\x7d\xef\x23\x4e\x89\x14\x44\x6c\x27\x42\x16\x39\x4f\xf6\x2d\xb3\xde\xe9\x0a\x38\xa4\xea\x8a\x7e\x40\x26\x21\xe1\x03\xaf\x97\xc8\xd2\xd4\xe0\xad\x61\xf5\xfe\xad\xde\x21\xff\x7e\xcb\xc0\x00\x07\x26\x5d\x94\x3d\x8b\xa0\x46\x63\x56\xc4\xc7\x9e\x73\xed\x62\x30\x53\xab\xf7\xd5\xab\xc2\xfd\xfe\xa3\x26\x0a\x14\x60\x6f\x04\xb9\xb0\xca\x9f\x85\xc0\xa4\x3c\x51\x60\x0f\x17\x67\xa1\xf9\x10\xcb\xf4\x01\x56\xa0\x02\xd9\x05\x26\xa4\xb5\x8e\x36\x93\x58\x50\x70\x6d\xec\xb0\x78\x53\xfb\xf9\xb2\xfb\xb4\x19\xc2\x75\xa7\x3b\xeb\xbb\xaf\x1e\x0e\xd6\x61\x39\x57\xe5\xe2\x84\x23\xd7\x59\xcf\x10\x75\x75\x54\x96\xda\x09\xee\x4e\x21\x1f\xfc\xc9\x6a\x27\xb3\x9f\xc8\xf2\xeb\x31\xe3\x42\xb8\x01\x00\x10\xfe\x61\x36\xea\x64\x46\xaf\xeb\x64\xa6\xfb\xd4\xc8\xf8\xef\x13\xe8\x3f\x56\x79\x30\x5c\x56\x5c\x9a\x5a\xa5\x42\x21\x18\xb2\xe2\xf4\x25\xb3\x42\x98\xe4\xc7\xb0\x97\x61\x0a\x8a\xc7\x27\x15\xa1\xc7\xc7\xb1\x8e\x68\xad\x1f\x73\x2e\x5f\x8b\x7c\x76\x3b\xec\x15\xd8\x34\x08\x7e\x4c\xa3\x4a\x8b\xf9\xdd\x21\xf9\x21\x71\x6c\x65\x83\x01\x00\x2c\x2e\x00\x90\xff\xe8\x90\x0c\xf4\x1d\x8c\xfe\xb3\xd5\xc3\xc1\xd0\xde\xc8\xe8\xef\xf7\x0c\xed\xaa\xb5\x29\xcc\x33\x20\x37\xad\x53\xf3\xa4\x9e\xac\x31\xd9\x51\xc8\x71\x1c\x12\x4a\x3d\xc9\xe2\x48\x7a\x87\x3a\x6b\x4f\xfa\x3a\x66\xec\x8b\x07\xa9\x7a\xa4\x91\x24\x5b\x2e\x2d\x0b\x1a\xa2\x54\xcf\x9e\xf9\xe9\x59\xf1\xc9\xd4\xe1\x0a\x09\x74\x3b\x9e\x49\x2b\x5c\xb2\x80\x2b\xaa\x7f\x2f\x46\x1d\x74\x56\x88\xfd\xc6\xcd\x83\x36\x49\xa2\xb1\x53\x0a\xe9\x31\x8a\xb3\x00\xc4\xa2\x01\x79\xa3\xec\x6d\x56\x28\xe2\x41\x17\x55\xf9\x03\x4e\x1d\x2c\xe6\xa1\x97\xb4\x46\xa6\x83\xa6\xb9\xf1\xd0\x1a\x44\xa0\x16\x0f\x6a\x16\x7c\x2f\x93\x41\x09\x94\xda\x6d\x13\x47\xb4\x96\x59\xa0\xb0\x13\x60\x41\xab\xd3\xf2\xf8\xd1\x22\x1b\x0e\xdc\xcf\x28\xc3\x7d\x6d\x21\x2c\xb4\x50\x1e\x4c\x79\x90\xc7\xca\xe5\xa2\x6e\x18\x89\x7d\x12\x8e\x1b\x7d\x8c\xdc\x08\x85\xce\xf8\x4a\x17\xc9\xb9\x13\x37\xbe\x44\x8e\x8a\xa8\x41\xda\x34\x7e\xf4\x4b\x91\x4f\x7a\x11\x02\xa6\xe2\xa9\x32\x29\x69\x74\x70\xec\x9a\x98\x7c\xbe\xba\x25\xed\xb2\x7e\x25\xd8\x30\x03\xf1\x9b\xd9\x6e\x52\x15\x4d\x6e\x91\xae\x57\xc7\x14\x75\x17\x47\xec\xae\x93\x9d\xc5\x63\xf0\xf6\x74\x0f\x1c\xe2\xae\xb9\x60\x87\x30\x6b\x34\x5f\x91\xde\xc0\xfa\x8a\x38\x50\x0d\x71\xed\xf8\x23\x8c\xaf\x6c\x93\x27\x58\x2d\x9f\x6f\x45\x99\xe8\xea\x46\x7a\xf7\x4f\x3f\x6c\xe5\x03\x20\x86\x7c\x88\x20\x6c\xc3\x7a\xe7\x72\x84\xae\x52\xc0\x08\x87\x0b\x50\xbd\x88\xf9\x31\x86\x20\x92\xc5\x33\xd7\xc2\x18\x1f\xf3\x08\x66\x52\xf9\xc8\x67\xc2\x8c\xc5\x13\xb9\x5a\x9a\xb5\x17\x4b\xf7\xc9\x93\x22\x16\xc8\xa1\x5a\xf3\xf1\x76\x2f\x16\xed\x36\x21\x18\xab\x4b\xaa\x96\x60\x8a\x4e\xeb\x1b\x78\xd1\x7d\xe9\xde\x8e\x9a\x89\x18\x5e\x7f\x6e\xdd\xf0\xf1\x6c\xff\xed\xae\x0e\x65\x65\xcd\x79\xee\xe7\xba\x08\x93\x47\x3d\x4e\x91\x7b\xa8\xc9\x67\x4a\x6e\x8d\x38\xb8\x6e\xea\xb0\x8d\x42\x9a\x44\x10\x0c\x7b\x05\x56\xe4\xe9\x69\xbe\xac\xb3\x79\x9f\x73\xeb\x6b\xe0\x8f\xd0\x19\xf4\x9c\xb8\xd2\x4a\xfb\x45\x78\x61\x3f\xd2\xd3\xc1\x35\x8f\xc3\x6f\x35\xfb\x8d\x86\xa0\x8b\xd5\xf9\x67\xb1\xca\x80\x1b\x5d\xb9\x2f\xb5\x11\x69\xf7\xb5\x22\x86\x2b\x74\xd8\xa5\x94\x94\x9f\xf8\x46\x59\x16\x06\x3d\x2b\x84\xa0\x32\xe2\x6e\xb1\xda\x46\x5e\x10\x51\xdd\x5f\xe6\x84\x2e\x06\x96\x32\x4c\x21\x26\x7a\xe8\x79\x9a\x75\xbe\x3c\x67\x31\x56\x6e\xa9\x77\x47\xeb\x46\x0f\xf6\x23\xdf\x6e\x83\x98\x6e\xf0\x4c\x5c\x12\x2e\xb9\xb4\xb0\xa7\xcf\x47\x66\x19\xdf\x1c\x3d\x57\xb2\xdc\xee\xd7\x96\xe7\xa3\xbb\x74\xab\xf3\xb5\x95\xec\x44\x61\xce\x02\x9f\x7c\x12\xdf\x9b\x6a\x6e\x1d\x25\x8a\x41\x7e\x80\x28\xd7\xb6\x11\x08\xb2\x4e\x11\x57\x2c\xd4\xaf\x60\x30\xbc\xd0\xe7\x2e\xec\xd1\x6f\x6f\x19\xd6\x2b\xf7\x4e\x31\xaf\xca\x67\x4c\xab\x87\xb8\xb5\x99\x02\xd3\xc2\x45\xf5\xf3\x7b\xbd\x2a\x2a\x4a\xec\x3c\xd1\x43\x33\xcd\x5d\xc7\x62\xa6\xae\xe0\x52\x59\x19\xc8\xea\x5c\xf9\xf5\x29\xf0\x24\x84\x88\xfe\x1b\xd9\xd1\x15\x55\x25\xc7\xa8\xaf\x04\x69\xd0\x16\xd7\x87\xce\xe6\x68\x5d\xeb\x03\xfe\x2b\x7e\xc7\x93\x46\x68\x03\xeb\x95\x22\xab\xa4\xeb\x86\x06\xf0\x3c\x8c\xee\xa9\x59\x7c\x64\x5d\xdd\xef\x5c\x99\x51\x85\xa9\xb3\x3c\x6d\xaf\x08\x7c\x1c\x9d\x99\x8d\x6a\x48\x67\x7b\x95\x25\x2f\x19\xdc\x23\x78\x28\xa4\x52\x3b\x47\x35\xd5\xf8\x52\x19\x00\xc2\x9c\xb9\x48\xaa\x86\xd3\x66\x52\xe2\x58\x30\x85\xcc\xac\x00\xc0\x4a\x92\xec\xf9\x94\xcd\x73\x6b\xd0\x36\x89\x37\xe4\x71\xe4\x15\xee\x29\xab\x0e\x09\x22\xe7\x09\x39\xae\xe2\xe9\xac\x4b\x34\x20\x81\x36\x46\x7d\x55\xec\xc9\x25\xde\xfb\xb1\xc8\x4a\x72\xb4\xc5\xb0\x79\xa2\x27\x6f\xa3\x4f\x59\x4a\x91\xb4\x54\x78\x0a\xe3\x93\xa6\x84\x98\xdd\xc2\x91\xc8\x1b\x10\xb2\x8c\x9a\x0b\xc8\x03\x5d\x64\x66\x18\xe0\x12\x77\x04\xc2\x85\xcd\x0c\xa2\xc6\x13\x3d\x90\xa3\x3e\x7f\xb8\x6e\xae\xdf\xe2\x13\x4e\x71\x57\xd5\x7e\x67\x48\xb2\xea\xe9\x34\xf9\x60\xf8\xe5\x7e\x41\x58\xdd\x79\x9e\x3f\xe9\x91\x93\x2a\xbe\x0f\x13\x45\xe0\xd5\xec\xde\x8a\x64\xbf\x89\x0b\x5b\xca\x33\x49\xad\x77\x9e\xb4\x8c\xcc\x27\x08\x4e\x1d\xa9\x97\xe4\x4b\xc1\x18\x6a\xb5\x50\x2f\x3b\x56\xbf\xd3\xd2\x8e\xf0\xec\x33\x16\x01\x03\x80\x25\xf8\x1f\x3d\xdc\x3b\x99\xd1\xff\xd4\xf9\x5f\xac\x3a\x6f\x3d\xcf\x00\xef\x39\xac\x8a\xf5\x24\xc8\x5a\x40\x85\x44\xbb\x14\x0c\xf2\x84\x3f\x54\x40\xce\x92\x0b\x83\x4a\x32\x95\xa4\x76\xb0\xfe\xcb\x95\x63\xb9\xe5\x6c\xfc\x02\xc2\xb6\xa6\x14\xfe\x8b\x20\xcc\x17\x03\xf3\x54\xe2\x0a\x81\xd4\xd1\xac\x43\x44\xd7\xa9\xd9\xea\x30\x0a\x39\x16\x8f\x6a\xf9\x1f\x74\x7a\xa4\x5f\xae\x4a\x87\x55\x1d\x84\xb7\xc1\xf5\x71\x28\x26\x30\x20\x8b\x3d\x10\xf9\xe0\x82\x08\x61\xfd\x86\xe9\x7c\xf8\x89\x89\x4a\xdc\xdb\xbc\xe5\x49\x90\x87\xb0\x0a\x11\x9c\xea\x49\x15\xed\xb1\x0f\x4d\xd4\x8d\x41\xbd\xaf\x5b\x36\x43\xd7\xb3\x89\xb0\x55\xfc\x99\xf3\x7d\xa2\x54\x92\xab\xcc\x69\xc1\xe1\x7c\xa2\x7a\xc0\xdb\x29\xdc\xbb\x7c\x97\x79\x21\x66\xcd\xcb\x90\x75\xfc\xca\xa8\xe0\x7d\x19\x60\x84\x32\x78\x87\x52\x85\x06\x98\x73\x11\xc0\x31\x02\x55\x68\x1e\xb7\x0e\x07\x42\x33\xf0\x8c\x0d\x33\x46\xc5\x10\xf6\x7e\xa6\x06\x8d\xb7\xf0\x89\xe8\xe8\x5c\xde\xfe\x22\xa9\x37\x12\xd0\xd9\xd9\xbb\x05\x92\x5b\x4f\x82\xb8\x3f\xb8\x30\x47\x08\x89\xe9\xa6\x6c\xe7\xfc\x61\xb8\xca\x03\xaa\x0d\x37\x3e\xaf\xed\x93\xe2\xb9\x47\x2d\x2c\x69\x43\x00\x09\x5f\x55\x28\x8c\xc7\x54\x7c\x1d\x47\x66\x64\xd5\x0b\x28\xce\x21\x8a\x57\xde\x1c\xc2\xac\x9d\xa2\xd2\xcd\x96\xfe\xb0\x31\x45\x8f\xc1\xac\x34\x0c\xc5\x54\x21\xcb\xc1\x44\x87\xca\x70\x03\x73\x2a\x8b\x22\xab\xa5\x9f\x47\xbc\x2e\x71\xb1\x11\x0e\x7a\x62\xcb\xf5\x06\x87\xdc\x09\x9b\x7b\x23\x34\xdf\x2e\x52\x22\xbd\x9e\x27\xdb\xc5\x01\x43\x6f\x20\x45\x2b\xf6\x09\xba\xda\xc8\x23\x7d\xa8\xc7\x68\x4a\x62\x6a\xbe\x44\x8d\xf5\x33\xea\xa2\x8d\x4d\x36\xda\x93\xed\xbb\xca\xe4\xa7\x21\xa1\xaf\x5f\x0a\x10\x2b\x46\x73\xe7\x70\x9a\xd6\x2b\x65\x82\x9a\x90\x71\x2e\xee\x3d\xec\xa9\xaf\xd0\x13\xb4\x2d\x89\x46\xc8\x55\x9d\x96\x34\xee\x0d\x12\x49\x94\x38\xea\xe7\xcb\xdd\xdd\x1d\xe4\x7a\xfb\x5e\x6b\x0f\x32\xef\xc5\x44\x38\xa7\xa3\x98\xe1\xf3\x1e\xd2\xd8\x80\xd4\xf0\x2f\xaf\xc7\x4d\x25\xb9\xd7\x24\xe0\x82\xd1\xc7\x81\x65\xde\xe6\xc8\xe4\xc3\xcc\x68\x3d\xaa\x63\xbb\x4a\x19\xb6\x06\x68\x83\xa9\x73\x8e\xa5\x33\x95\x0b\x1a\x5a\xc6\x6c\xd7\x08\x09\xa9\xb0\x20\xb1\x0e\x2c\xc1\x5c\xc1\xd0\x40\xf3\x17\x02\x37\x97\x29\x7b\xbc\xaa\xa1\xaa\x2a\xbc\xee\xc4\x05\x8e\x95\xc6\x73\xd4\xf1\x23\x84\x6d\x85\xab\xe6\x51\xa7\x9a\xe8\x24\xe7\x5d\x60\x0f\xc6\x3a\x4b\xb9\x43\x7c\xa3\x10\x7c\xda\x65\xbd\xcd\xdb\x90\xdc\xb1\xc4\x1e\x3b\x4a\x8c\x36\x8f\x12\x3c\x71\xff\x44\xe3\x18\x3f\xfa\xb8\x46\x93\x96\x78\xb1\xf0\x3c\xdd\xca\x85\x56\x3f\xc4\x16\xfe\x74\xbf\x74\xe0\x7c\xa8\xe8\x41\x8c\xcb\xa0\x2d\x45\x8a\x2a\xc1\xb3\xd3\x21\x17\x04\xea\x64\xb5\xeb\x12\xc3\x71\xab\xc4\x85\xaa\x17\xb8\x66\xfc\x34\x3d\x3e\x76\x20\x6f\x31\xd0\x12\x24\xf8\x5f\x0c\x59\xa6\x0d\x70\xb0\x2e\x6e\x4b\x67\x68\x52\x04\x9a\x7f\xd1\x18\x65\xaf\x6b\x70\xaa\x68\x82\x9b\x81\x27\xcc\xd4\x67\x4d\x51\x54\xe1\x52\x71\x74\x78\x2b\xb3\xe7\xd9\x2f\xc6\xd8\x2f\x81\x9f\x29\x64\x2b\xcc\xcb\x2a\xfb\x5a\xe8\x83\x2a\x88\x57\x91\xeb\xce\x48\xe0\x43\x72\x74\x86\xcd\xe9\xdc\x2b\x27\xfb\x26\xb8\xa8\xe2\x86\xb6\x6d\xd3\xf3\x48\x79\x9a\x2b\xf5\x4b\x6b\xad\xae\xcf\x65\x70\xa3\xae\x60\xa7\x20\xb6\x1f\x55\xdc\x96\x98\x6d\xa6\x7b\xa7\x93\xa2\x82\x05\x6a\xcb\x86\x8a\xe2\xe6\x08\xe9\x68\x5a\x47\x59\x5c\x57\xfd\x69\xdb\xd9\x3f\x17\xbe\x1d\x90\xd1\xdc\x69\xa7\xfb\xf8\x32\xd9\x9a\x3d\x49\x36\xbf\x24\xc1\xe6\xf9\x43\x44\xf2\x2e\xe7\x86\x2b\xdc\xf3\x45\x18\x69\xcb\xe3\xcd\x20\x8f\x83\xe9\x65\x62\xa9\xcb\x6c\xd2\x6e\x36\x3f\x33\x27\xd1\xf5\xe1\xf4\x9d\xf9\x42\x3f\x16\x1b\xf6\x30\x24\x0f\x27\x8d\xeb\xef\x74\xe3\x85\x0a\xbf\x38\x88\x82\x00\x40\x34\xe8\x8f\xf6\xa4\xdc\x5b\x61\xc0\xf3\x85\xbc\x8d\x1f\x11\x5a\xc0\xd2\x15\x75\xc2\xf0\x24\x60\x50\xec\xa1\x7d\xd6\xdc\x4d\x2a\x39\xae\xf5\xa1\x80\xc4\x17\xc1\xe0\x55\xce\xa6\x17\xe1\x9c\xb9\x55\xfc\x8e\xc8\x07\xdb\xba\xf3\x95\xf7\xfd\x81\xd1\x6f\xb6\x92\x41\xbc\x78\xb2\xb6\x9d\x4c\xb2\x54\xdb\x6b\xb3\x2c\x47\xfc\x40\x91\x04\xeb\xfc\x3d\xe5\xf4\x0e\xa4\xd7\x2f\x89\x9e\x49\x0b\x45\xa3\x55\x56\xcc\x4e\x8f\x56\xf1\x5b\xda\x34\xe3\xd0\x72\x1d\xbc\x89\x5c\x9a\x63\xef\xbf\xb6\x55\xe0\x2a\x36\xd7\x42\x87\xc6\x91\x3d\x22\x41\x1f\xd5\xae\x9b\xc6\x92\x14\x5e\xe2\xe1\x36\x6b\x57\x8b\xa1\x1f\xda\xf4\x42\x58\xda\x7e\x37\x4f\x44\xe1\x88\xa4\x01\x67\xbc\x83\xe6\x19\xe3\x82\x8b\xd8\xa3\xe9\xd7\x14\xb5\x17\x0c\xeb\xb1\x79\xd2\xbb\x44\x80\xe7\x59\x57\xe2\xac\x07\x1f\x4b\x8b\x92\xbc\x63\x5f\x65\x13\xd2\xa1\x08\xa7\x30\x9f\x98\xdc\xb6\x55\x03\x31\xa6\xfe\xec\x75\x3f\x96\x55\x94\xa9\x85\x95\x1d\xfa\x92\xd4\xc0\x5a\xde\x81\x51\xdc\x58\x5e\x7a\xa0\x60\x61\x79\xd3\x2a\x8b\x22\x45\xa8\xab\xea\x28\x0b\x46\x7e\x3b\x96\x7d\x5c\x37\x29\x2d\xb5\xca\x81\xd2\x48\x4a\xa2\xe6\xd7\xf1\x20\xba\xb0\x41\xbc\x11\x5a\x9c\xf1\xfc\x8c\xce\xee\xe5\x78\xb7\x8b\xb4\xf1\xd8\x17\x1d\xcd\xb8\xc9\xf0\xf1\xa4\x5f\x5f\x17\xf7\x99\xb6\xf9\xd3\x18\x90\xa1\x83\xb3\x91\x7d\x14\x32\x78\xe0\x00\x83\x8d\x49\x71\xc6\xd3\xd1\x5d\xdc\xe2\x48\xdd\x8c\xfe\x61\xa0\x8e\x60\x45\xdf\xe1\x1a\x42\x4e\x12\x04\x94\x00\xec\xae\x4d\xff\x58\xff\xf9\x86\x0c\xec\x0a\xfe\xf6\xff\x6d\x35\x08\x65\x25\x88\x3f\x96\xe7\x4f\x89\xe2\xdd\x98\xe8\xb7\x30\xc5\xfe\x84\xf9\xdf\xb7\x97\x7f\x05\xfd\xbf\x60\x1a\x26\x23\xe5\xdf\x04\x05\x80\x16\xd8\xdf\x52\xbe\x3f\xa0\x41\x00\x00\xc8\xf6\x79\x75\x17\xf4\x8f\xc6\xca\xfd\xbc\x1d\x98\xb7\xec\xf8\x00\xf6\x3d\xd9\xef\x5b\xee\xcc\x8c\xee\x6f\x49\x88\xd8\x07\x2e\x37\x30\x00\xf0\x41\x02\x00\xca\x5b\x96\x30\x81\xdf\x09\xfe\xb3\xb6\xfc\x8f\xce\x22\xc3\x47\xff\x29\x28\x00\x44\xfd\xbe\x89\xf0\x5b\x3a\x44\xe8\xfb\xd1\x7d\xb3\x5b\xfa\xe7\xdd\x87\x73\xcb\x7d\xb3\xb0\xdf\x53\x5a\xda\xd8\x38\xea\x3a\xea\x1b\x58\xfe\xad\x07\xef\x2a\x08\x7d\x8b\x4f\x0b\xf7\x57\xf8\x3f\x19\x72\xc2\xad\x62\x4b\xc8\x20\x00\xa0\x0e\x0e\x00\xcc\xb7\x3c\x17\x7c\x1f\x9a\x7f\x1e\x7e\xb7\x37\xf3\x61\xfd\xd7\xd6\x6c\x1f\x08\x84\xef\x89\xbf\xdb\xda\xf7\xf3\x0e\xc4\xb8\xe5\xc0\x28\xca\xef\x09\xfe\x37\xf4\xf1\x7e\x5e\xbb\x3d\xf2\xf1\x5b\xaf\x2d\xdd\x89\xfd\x7f\x73\xa6\xde\xb6\x04\x97\xfa\x7b\xb6\xff\x8d\x4c\xbc\x9f\x25\xb7\x07\x26\x7e\x6b\x89\xc5\x9d\xd8\xdf\x8f\x4f\xbc\x1f\xd3\xed\x79\x7f\xdf\x32\x61\xd0\xde\xc5\x74\xf7\xf4\xbf\xfb\x71\xde\x1e\x45\xf3\x2d\xe7\x3e\xdb\x5d\x9c\xff\x1d\x4c\x73\x3f\x8a\xdb\x83\x63\xbe\xa5\xc8\x66\xbf\x8b\xe2\xfb\x31\x32\xf7\x63\xba\x2d\x2d\xfc\x2d\xd3\x8e\xc8\x5d\x4c\xf7\x11\x1a\xbe\x1f\xfb\x6d\x9d\xdb\x6f\xd9\x17\x44\xef\x62\xff\xb3\xea\xed\xfd\xb8\x6e\xcb\x36\x7d\xcb\xe5\xae\xfc\x3d\xd7\x77\x29\xd6\xcf\x9f\x51\xb7\xf3\x82\x00\x55\xe0\x4f\xd2\x40\x3f\x8f\x49\x72\x0b\x73\xf0\x4f\x98\x77\xc9\x04\xdd\xcf\x3d\xb7\x45\x82\xbe\x75\x0f\xb5\xda\xfd\x98\xfe\x56\x32\xe8\xe7\x4d\xc6\xbe\x65\x72\xbe\xfa\x9d\x07\x72\x7f\x3b\x6f\x6b\xe7\x7c\x6b\xe7\x97\xbf\x82\xff\xb1\x92\xce\xbf\x4d\xee\x72\x35\xbf\xa7\xbc\xd7\x9d\xf6\x3e\xd0\xa7\x7f\x82\xfe\xaf\x8a\xc2\xfd\x1c\x75\x5b\x1c\xe6\x5b\x47\x3d\xd6\xba\x03\xfa\xff\x2e\xf9\xb9\x6d\xcb\x8c\xf6\xf7\x84\xff\xd5\x41\xf9\x79\x68\xbc\x5b\xd0\xe4\x3a\x77\x40\xff\x64\xca\x73\x5b\x11\xe5\x5b\x6f\x79\xfe\x0d\xc3\xbf\xbb\x75\xdf\xb6\xa6\x5b\xef\x0e\xae\x9f\x5c\xfb\xdb\xfa\x19\xdf\x5a\x83\xaf\xff\xd7\x0c\x3f\x50\xd3\xf8\xb7\xa9\x9b\x98\xe1\xf7\x94\xff\x3a\x75\xc3\xba\xe5\xb5\x31\xaf\x3f\x13\xfc\xaf\xd9\xea\xdf\x66\xd6\x24\xde\xdf\xa3\xff\xb0\xf1\xea\x7e\x0b\x73\xbb\xed\xea\xdb\x85\xf1\xba\x0f\xcd\xbf\x8b\x35\xb4\x5b\x76\xd9\xf8\x7c\x4f\xf8\xff\xbb\xa0\xee\x67\xca\xed\x1e\xa8\x6f\x4d\x69\xba\x03\xf9\xdb\x8e\xa8\xfb\x1d\xfd\xed\x36\xa6\x6f\x39\x74\x7c\x7f\xcc\xf1\x7d\x53\xd3\xbf\x7d\xec\x7d\xf7\x43\x9e\xff\xb4\x35\xfd\x3c\x38\xc1\x2d\x70\x74\xbf\x3b\xc1\x7f\xf2\x3e\x72\xbb\xc1\xe9\xdb\xb3\xcf\xea\x6f\x39\xfe\xd4\xee\x74\x3f\xca\xdb\xbd\x10\xdf\x52\x56\x07\xfd\x04\xe5\x37\x9d\x11\xf7\x8b\xbb\xdb\x55\xd8\x6f\x63\xa2\x32\xec\x4e\xde\x1f\xd4\x64\xef\xc7\x76\xbb\x88\xfa\x2d\x9b\x41\xf8\x9d\x6c\x3f\x2e\xa9\xfe\x7c\xb4\x3c\xb8\x15\x2d\x6a\x11\xdf\x13\xfe\x5e\xcd\xbc\x9f\x1d\xb7\x6b\x99\xdf\xda\x91\xf5\x23\xd8\xef\x2a\x9b\xf7\xe3\xb8\x5d\x9c\xfc\x96\x83\x2d\xf2\x07\x1c\x77\x95\x2a\xef\x47\x76\xbb\x08\xf2\x2d\x19\x77\xcc\x0f\xc8\xfe\x31\xcf\xed\x77\xb9\xdf\xf2\xbc\x8f\xfb\xd3\xad\xf4\x7e\x8f\x1d\x10\x90\xbf\x81\x30\x03\xcc\x40\x14\x1a\x00\xb8\xbe\xfa\xed\xd7\xff\x0b\x00\x00\xff\xff\x09\x7e\x01\xd3\x1d\xac\x00\x00")
+var _x_ender_chestMcpack = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x75\x58\x18\x59\xb2\x3e\xdc\xb8\x5b\x70\x08\x04\x08\x04\x77\x77\x77\x77\x08\xee\xee\xae\xc1\xdd\xdd\x5d\x42\x70\xb7\xe0\xee\x1a\xdc\xdd\xdd\x2d\xdf\x33\xbb\xf7\xde\xdf\xcc\xee\xce\xee\xcc\xbd\xdf\x9f\x03\x69\x1e\x42\xc2\xfb\x76\x9d\x3a\x55\x7d\xba\xbb\xea\xbc\x72\x92\x60\xe0\xb0\xc0\x2f\x1f\x2b\x4a\x72\x5a\xc0\xaf\x3e\x10\x81\xf7\x80\xbd\x91\x83\x8d\x93\xbd\x81\x91\x8e\xad\x9e\x81\x05\xad\xb2\x12\x0c\x00\xd6\xf9\x36\x65\xfe\xcb\xe1\xe4\x0a\x07\x80\x80\xff\xf2\x1f\xff\xf6\xe5\xf7\x71\x50\xfe\x09\xc7\xc9\xec\x3f\x42\xa1\x01\x00\x00\xfd\x37\x28\x7f\x24\xb6\xa3\x02\x30\x00\x80\xc5\x05\x00\xd2\x7f\x05\xa5\xaf\xe7\x60\xa4\x63\x60\x6a\xe4\xe0\xa8\xe3\x60\x60\x6f\x64\x64\x4d\x63\xee\x60\x63\xfd\xef\x08\x4e\xd5\xba\x14\x96\xe9\x90\xdb\xf6\x29\xb9\xb2\x6e\xf6\x18\xec\xc8\xe4\xd8\x2e\x3f\x48\x89\xe4\xb3\xa5\xf7\xa2\x2e\xda\x93\xa4\xc6\x4d\xbf\x78\x90\xa8\x47\x19\x49\xb2\x14\x51\x33\xa1\x21\x4a\x0d\x9e\x99\xdf\xde\x95\xdf\xcc\x5d\x6e\x13\x43\x77\xbf\x37\xe9\x84\xcb\xe0\x73\x45\x0d\x18\xc2\x68\x82\xce\x0f\xb5\x3f\xf8\x89\xd2\x25\x49\x30\x7d\x4b\x26\x3d\x4d\x76\x17\x88\x58\x36\x2a\x6f\x54\x70\xcc\x0c\x45\x38\xe6\xa2\x2a\x7f\xc1\xae\x8d\xc5\x38\x1e\x4f\x6d\x64\x3a\x66\x5a\x94\x04\xfd\x99\x00\xd4\x02\xa5\x61\xd5\xef\x29\x03\x14\x5f\xa9\xdb\x36\x6d\x52\x73\x83\x09\x0a\x3b\x05\x16\xb4\x3e\xbb\x98\x17\x2d\xaa\xf5\xc2\xfd\x8e\x3c\xc2\xcf\x16\xc2\x42\xf3\x1d\xca\x9c\x07\x69\x82\x5c\x11\xea\x81\x91\xd8\xae\x60\xe2\x94\x10\xf2\x77\x28\x74\xfa\x64\x1d\x24\xe7\x3e\xdc\xa4\x0a\x39\x0a\x82\x56\x69\xd3\xa4\xa9\x97\x32\xdf\x9c\x32\x04\x4c\xc5\x5b\x65\x12\x92\x98\x90\x84\x3d\x31\xf9\x12\x75\x4b\xea\x0d\xbd\x5a\xb0\x09\x3a\xc2\xaf\x8b\x03\x24\x2a\x1a\x9c\xc2\xfd\xc9\xd7\x64\x4d\x0f\x57\xac\xae\x3f\xfa\xca\xa7\xe1\xed\x69\x50\x1c\x12\x5f\x39\x60\xc7\x31\x1b\x34\x92\x49\x7e\xc2\xfa\x09\x3b\x50\x8c\x73\x9c\x04\x20\xcc\x6c\x1f\x93\xa6\x58\x6d\xdc\x1f\x45\x9b\xe8\xe8\x44\xf9\x8c\xcc\x13\x75\xf2\x00\x10\xe3\xbe\x04\x10\xb6\xe1\x43\x4b\x85\x02\xcf\x99\x60\x1f\x26\xbe\xa1\x7e\x21\xe4\xc5\x18\x87\xc8\x10\xcf\xdb\x0b\xa7\x17\xe2\xe2\xcf\xa3\xf0\x95\xcf\x83\x99\x4e\x22\x70\xb5\x34\xeb\x2e\x97\x1e\x96\x27\x41\xfc\x26\x87\x6a\xcd\xc3\x3d\xb0\x56\x76\xda\x86\x60\xac\x2e\xa9\x5a\x81\x29\x3a\xaf\xa7\xff\x85\xe6\x65\xe0\x38\x7a\x21\x72\x62\xdf\xdb\xba\x75\xeb\xee\xbc\xf9\x54\x9b\xbc\xb6\xe1\xbe\xe8\xb1\x29\xd2\xe4\xe3\xa0\x53\xd4\x19\x6a\xc6\x9d\x92\xdb\x77\x1c\x5c\x37\x75\xd8\xef\x02\x1a\x04\x10\x74\x67\xdf\xac\x48\x73\xb2\xfd\x98\x17\x8b\x1f\x8b\x5a\x1a\xe0\xaf\xd0\xe9\x74\x9d\x38\xb2\x2b\x47\x84\xb9\x61\xb7\x68\x69\xe0\xda\x67\xe0\x8f\xda\xfd\xa7\x42\xd1\xc5\x9a\x02\xf2\x99\x65\xc0\x8d\x9e\xdd\xd7\xbb\x08\xb4\x86\x3b\x11\x23\x14\x7a\xec\x32\x2b\xaa\x6f\xfc\xa2\x2d\x4b\x83\x3d\x4b\x21\x28\x8c\x38\x3b\xac\x8e\x91\x57\x85\x55\xcf\x37\xd8\xa1\xcb\x81\xf5\x5c\x53\x88\xd9\x41\x5a\xae\x76\xed\x17\x6f\x26\x63\xe5\x8e\x16\x77\xb4\x01\xf4\x10\x7f\xd2\xe3\x2e\x88\xf9\x56\xaf\xb4\x75\xc1\x8a\x27\x0b\x7b\xda\x12\x64\xa6\x99\xc3\xa9\x7b\x25\xcb\xe3\x11\x2d\x79\x1e\x9a\x27\xb7\x26\x3f\x5b\xc9\xbe\x77\x8c\xf9\xe0\x3f\x44\x92\x86\xb2\xcc\xad\xa3\x45\x31\x48\x2f\x10\xe5\xba\x0e\x82\x40\xf6\xc9\x12\xcb\x05\x46\x14\xf4\x27\x56\x87\xdd\x05\x3d\x46\xec\x2d\xc3\x87\xe4\x7a\x15\x8b\xeb\x7c\xa7\x35\x07\x09\x3b\xdb\xc9\x30\x2d\x5c\x54\x1f\x57\x74\xeb\x28\xc8\xb1\x8b\x45\x2f\xcd\x34\x4e\x1d\xcb\x19\xfa\x43\x2a\x65\x65\x20\xeb\x8b\xe4\xf7\xe7\xc0\xd3\x11\x22\x47\x7e\xca\x4e\x6d\xab\x2a\x39\x46\xbf\xe1\x67\x43\x5b\xbc\x5e\x3a\x9b\xa3\xf5\xef\x8f\x06\x6c\xfb\x5f\xff\x30\x42\x1b\xdd\xaf\x15\xde\x21\xd9\x37\xd0\x87\xe7\xa2\x77\xcf\xca\xe7\xf9\xd4\x3f\xd0\xeb\xca\x88\x2a\x48\x99\xef\x65\xfb\x8c\xef\xeb\xe8\xcc\x68\xd4\x40\xb2\x38\xa4\x2c\xf9\x44\xe7\x1e\xc9\x45\x26\x95\xd5\x37\xa5\xa1\xc6\x93\x45\x07\x7c\x28\x5c\x8a\xa2\x68\xbd\x6d\x27\x21\x4c\x00\x53\xc8\xcb\x0f\x04\xac\x24\x3f\x79\xcf\xd9\x78\x5b\x83\x76\x49\x7c\x25\x4d\x24\xad\x71\xcf\xdc\x71\x48\x11\xbe\x4f\x29\x74\x15\xcf\x61\x5e\xa7\x02\x09\xb2\x31\x1a\xae\x63\xcd\xa8\xf0\x39\x4f\x40\x56\x92\xa3\x2e\x87\x2d\x16\xbd\x69\x8e\xb9\x65\xaa\x44\xd2\x54\xe1\x2a\x4d\x4a\x9f\x13\x60\x74\x8b\x40\x22\x6d\x45\xc8\x37\x6a\xff\x46\x1a\xe4\x22\xb3\x40\x07\x97\x76\xc2\x17\x21\x68\xa6\x1f\x3d\x93\xe6\x81\x1c\xfd\xb8\xf9\xda\xde\x72\xc4\x23\x98\xe9\xae\xaa\xd5\x6b\x40\xbc\xe3\xe5\xf4\x03\x65\x22\xfe\xfc\x5b\x78\xd3\x7d\x71\x00\xc9\x95\x93\x2a\x9e\x2f\x03\x59\xd0\xf3\xe2\xd9\xb6\xe4\x88\x89\x0b\x4b\xa6\xa7\xa4\x66\xaf\x17\x35\x3d\xe3\x0d\x82\x53\x4f\xd6\x13\xe9\x7a\x08\x86\x5a\x23\x54\x7c\xcf\x0e\xe6\x6f\x93\x40\xa4\xd7\xb0\xb1\x30\x18\x00\xac\xc3\x03\x00\xd1\xbf\x4a\x02\x7f\x2a\xfe\xcb\x55\x97\xad\x97\xe9\xe0\xbd\x26\x54\xb1\x44\x82\xad\xf9\x54\x88\xb5\x2a\xc1\x20\x6f\x78\xc3\xf8\xe4\x2c\x39\x30\x28\x24\xb3\x88\x1b\xc7\x5a\x5e\x9e\x1d\xab\x2d\x17\x93\x56\x11\x8e\x35\xa4\xf0\x62\x83\x31\x63\x47\x97\x29\xc4\x15\x82\x28\x63\x98\xc7\x09\x5e\xb3\x0a\xd4\x61\x14\x0a\x2d\x3e\x36\xf2\xa2\xf4\x79\xe4\x3c\xed\x48\x87\xd7\x5d\x44\x74\xc1\x0d\xb3\x29\xa6\xd0\x21\x8b\xa1\x08\x6f\xba\x20\x42\x58\x7f\x65\xb8\x9f\x10\x31\x51\x49\x6c\x2e\xde\xf8\x01\x42\x04\xab\x10\xc9\xae\x9e\x5e\xd3\x9d\x40\x64\xa2\x6e\x0c\xea\xf3\xda\x71\x18\xb6\x5f\x40\x80\xad\x12\xc0\x58\xe2\x1b\xad\x92\x51\x67\x4e\x0d\x0e\xe7\x1b\x3d\x08\xde\x4d\xe6\xde\xef\xb7\xc1\x0d\xb1\x68\x5e\x85\xac\xed\x5f\x45\x01\xef\x47\x07\x23\x90\xcb\x3d\x9e\x25\x30\xca\x58\x84\x00\x8e\x11\xa4\x42\x25\xd4\x39\x11\x04\x4d\xc7\x35\x3d\x41\x1f\x1d\xf7\x61\xe8\x91\x12\x34\xc9\xc2\x37\xb2\xa7\x6f\xe3\xf8\x45\x52\x77\x32\xb0\xaf\x6f\xe8\x08\xa4\xa8\x85\x18\xf1\x7c\x6c\x75\xe9\x03\x24\xa6\x9b\xb2\x9d\xf3\xe6\x44\x9d\x07\x54\x17\x6e\x52\x71\xd7\xae\xe2\xbd\x47\x23\x2c\x49\x6b\x20\x31\x4f\x5d\x18\x8c\xc7\x5c\x52\x13\x5b\x5e\x54\x5d\x2c\x14\xfb\x38\x59\xb2\x0f\x9b\x20\x73\x9f\xa8\x74\xbb\x65\x00\x6c\x5c\x99\x10\x98\xd5\x67\x03\x31\x55\xc8\x6a\x30\xd1\xf1\x2a\xdc\xa0\xc2\xda\xb2\xa8\x7a\x69\xef\xc8\xd4\x0a\x17\x1b\xc1\x60\x11\x5b\x8e\xaf\x38\xa4\x4e\xd8\x9c\x07\x61\x25\x76\x51\x12\x39\x2d\x5c\x05\x2e\x0e\x18\xba\xa3\x99\x9a\x09\x22\xe8\x6a\x93\x1f\xf5\xa0\x84\xd0\x94\xc4\xd4\xfc\x08\xbe\xb7\x2c\xa8\x8b\x7e\x6f\xb3\xd1\xfa\xd1\x7d\xaa\x4c\x7a\x1b\x1a\x96\x1a\xcf\x47\xa8\x18\xc3\x59\xc8\x6e\xda\xa2\x94\x07\x6a\xf2\x89\x7d\xed\x8c\x68\xb0\xa5\x46\x97\xdf\xb6\x22\x06\xa1\x48\x75\x5e\xd2\x78\x28\x58\x38\x4d\xe2\x6a\x84\xa7\xe8\xf4\x74\x8c\xa3\x79\x45\xf3\x0c\xb2\x38\x76\x36\x82\xdd\x51\xcc\xc0\x7b\x90\x24\x21\x30\x2b\xe2\x25\x75\xc6\x54\x92\x73\x4f\x02\x2e\x04\x7d\x06\xd8\xe0\x6e\x8f\xca\xb8\xcc\x8b\xd1\xa5\xb8\xb6\xab\x95\x61\x69\x85\xd6\x9f\xbb\x67\x5b\xbf\x53\x79\xa0\xa2\xa6\x2f\x70\x8d\x94\x90\x0a\x0f\x16\xeb\xc1\xe2\x2f\xe2\x0f\x0b\x32\x8f\xe5\xfb\xf9\x94\x79\xc6\xad\x1a\xa6\xaa\xc2\xed\x4e\xf8\xcd\xb1\xd6\x78\x89\x32\x69\xf2\x43\x57\xe9\x8e\x79\xf4\xad\x06\x3a\xf1\x7d\x3f\x18\xca\x74\x5f\x25\x67\xa8\x5f\x34\x82\x6f\xb7\xac\x8f\x79\x17\x92\x3b\x96\x98\x90\xa3\xc4\x54\xfb\x14\xbe\x88\xfb\x2e\x95\x63\xd2\x94\x50\x83\x06\x35\xe1\x5a\xe9\x7d\x8e\x95\x0b\xb5\x5e\xa8\x2d\xfc\xed\x79\xe5\xe8\xfd\x78\x19\x4a\x9c\xcb\x98\x2d\x59\xa6\x2a\xbe\xe7\xed\xb8\x0b\x02\x65\x86\xda\x6b\x85\xc1\x8c\x55\xda\x6a\x5d\x2c\xae\x19\x2f\xd5\xa0\xaf\x1d\x48\x33\x06\x5a\x8a\x04\x6f\xec\xb8\x65\xf6\x28\x1b\xf3\xda\xb1\x74\xae\x06\x59\x90\xf9\xcb\xe7\x29\xd6\xa6\x56\xa7\x9a\x36\xb8\x05\xf8\x0f\x79\x7a\xcc\x99\x8a\x2a\x1c\x2a\x8e\x0e\xcd\x32\x67\x5e\x23\x62\xf4\x23\x12\x78\x79\x02\xb6\x82\xdc\xcc\xb2\xa9\x02\x9b\xaa\x20\x5f\xca\x5c\x4f\x26\x83\x88\x48\xd1\xe9\x0e\xe7\x8b\x9e\x9d\xec\xdb\xe0\xa2\xcb\x5b\xbb\x8e\x4d\xef\xa3\xe4\xa9\x9e\xd5\x9f\xac\x35\xfb\x1f\xab\xe0\xa6\x5c\xc1\x6e\x41\x6c\xb7\x54\xdc\xd6\x19\x6d\xe6\x87\xe6\xd3\xa3\x43\xf8\x1a\xab\xc6\xcb\x12\x97\x3e\xd0\x50\x75\x4e\x31\xb9\xee\x04\x50\x77\xb3\x3e\x96\x36\x8f\xca\x68\x9c\x74\xd3\x6c\xc5\x67\x58\xb3\xa6\xcb\x96\x54\xa4\xd8\x78\x13\x21\x92\xf6\x3b\xb7\x3e\xe3\xde\xaf\xc1\x48\x5b\x5e\x1f\x06\x7b\x5c\xcc\x6f\x10\x4a\x3d\x15\x90\x0c\xb0\xf8\x9b\x39\x89\xee\x4f\xe4\x9c\x2c\x97\xfa\x33\xd9\xb0\x86\x23\x79\x38\x7d\x7e\x05\xf9\x6d\x80\xb6\x60\x26\x86\x72\x02\x00\x20\x08\x00\x00\xfe\xbf\x0a\x50\x1d\x27\x33\x1d\x43\x23\x63\x87\xff\x18\x9c\xa5\x7b\x0a\x72\x72\x14\x63\x11\xb2\xe2\xd2\x94\x2a\x35\x0a\x21\x90\x35\xb7\xf1\x8c\x0a\xe1\x92\x5b\xe1\xf1\xe1\x0a\x8a\xd7\x37\x35\x61\xd7\xd7\x09\x8e\x68\x9d\x5b\x85\x4f\xa9\xc2\x8f\x6e\x97\x43\x7c\x87\xfa\x21\x42\x54\xaa\xd4\x98\xff\xb0\x06\xa9\xd6\x8c\xea\x67\x7e\x0f\x00\xbf\x1c\xb8\xff\x74\x4a\xbf\x7c\xd1\x31\x33\xb0\xb1\xa6\xb1\xb5\x36\xf9\x77\xe7\x13\x2a\x27\x23\x8a\x00\x8b\xf3\xcb\xda\x06\x41\x5c\x4c\x48\x01\x00\x00\x3e\x00\x00\x78\xa0\x21\x01\x00\x48\xe7\xc7\xb3\x01\x00\x00\xc4\x41\x41\x54\x00\xa8\x18\x7f\x7f\xf8\xcb\x6f\x99\xf0\x4b\xf3\x03\x40\x75\x34\xdc\x8b\x1e\x04\x00\x00\x30\xb6\x62\xea\x0e\x00\x80\xd8\xf3\xcb\x01\x32\x60\xf3\xd5\x10\x00\x70\x87\xc5\x85\xf8\x95\x4c\x05\xdb\xd2\x5d\x3c\x70\xe7\x13\xfb\x2f\x8d\x6a\x8e\xcb\xac\xd4\x23\xe3\xc4\xc2\xda\x54\x91\x03\x09\x60\x9c\x91\xc1\xa1\x7d\x80\x90\xee\x35\xe2\x58\x22\x12\x09\xa5\x46\x4b\x49\x7b\x69\xfd\xe2\x45\x3b\x7d\x4e\x6d\x8d\xb0\x5d\x22\xf2\xb8\xd6\xf8\x93\xbd\xed\x0c\xaf\x75\x46\x07\x5f\x42\x69\x8d\x74\xc3\xec\xd7\xed\xfb\x95\xae\x51\x5d\xba\xfd\xb6\xfd\x0c\x8f\x71\xdb\x83\x23\xd3\xf2\x64\x19\x41\x8d\xb6\xa6\x65\x1c\xaa\x25\xdc\x02\x38\xf0\xef\xe5\xd3\xcb\xab\xda\x39\x48\x0f\xab\xd2\xd3\x81\x0e\x5c\x78\x6c\xa2\x25\xb4\xd3\xd6\x5f\xd1\x6d\x4c\xfc\x6f\xb6\x9e\xb9\xb3\x30\xb0\xcf\x1c\x24\x5d\x07\x8b\x0d\x1c\x5e\xed\x4a\x2a\x69\xd0\x79\xa3\x82\xa1\xda\x18\x96\xd6\x77\x3b\x23\xcf\x99\x24\x73\x4a\x51\x06\xec\x64\xa9\x95\xa7\x17\x35\x0b\xf2\x9b\xed\x91\x28\x7a\xd7\xe5\x29\x57\x33\x9d\xb0\x8e\x7b\xa1\xd8\x5a\xec\xca\xda\x2e\x1f\xa2\xb7\x8e\x4c\x4b\xe2\xa3\x54\x04\xdb\xf2\x04\x3a\xa1\xc3\x90\x2f\x1a\xc4\x2b\x3f\xf4\xfb\xf3\x20\x9f\x9c\x21\xeb\xa8\xd6\xe2\xdf\x9f\xd0\xa5\xcc\xaa\xd7\x6d\x0e\x80\x40\x1c\x5d\x36\x88\x75\xce\x50\x09\xd2\x79\x8e\x62\xe4\xc0\x80\xce\xf2\x03\x1f\x20\x3a\xdb\xe5\x3a\xa0\x1c\xd1\x0f\x7c\x81\x55\xe4\xce\xe0\x63\x0d\x11\x4e\x53\x86\x4e\x5b\x28\x1e\x68\x99\x7c\xf2\x80\x30\x10\x32\xbe\xf4\x99\x8d\xa0\xcc\x65\xab\x4e\x61\x03\xb8\x05\x11\x8d\xfe\x99\x1c\xc8\xc3\x3d\xf8\xa7\x25\x2b\x32\x91\xf4\x87\x03\x10\xb6\xb3\x2e\x3b\x24\x51\xf4\x6e\xbd\x26\xfc\xd2\x60\x28\xa6\x2d\x4e\x64\x00\xb2\x04\xf0\x47\xce\x99\xbf\xf3\xc1\x81\xf6\x39\x9f\x7a\x40\x79\x9f\x4f\xc7\x71\xe7\xdc\x6d\x10\x01\xb5\xa1\xfd\xf9\x5b\x47\xf0\x0c\x65\x04\xa0\x0d\xbd\xe1\x3f\xd5\xbb\xac\x9d\xef\x78\xb6\x09\x62\x15\xb9\x3b\xbd\x24\x57\x61\xe0\x81\x0a\x8e\x75\x72\x0d\x8d\x4d\xc4\x5f\x19\x71\xf3\x5c\xd0\x31\x40\xed\xdd\x44\xbd\xaa\x23\x08\x04\x39\x14\x10\xc0\x47\x5b\xbb\xf2\x55\x46\x77\x03\xcf\x6c\x10\x66\x65\x9c\x88\xdb\x14\x17\xaf\x32\xd8\x78\x1b\xf5\xba\x19\x63\x64\x26\xd8\xcf\x64\x42\x26\x92\x91\x8e\x5f\x09\x99\xb7\xac\xfb\xa4\xea\xed\x7b\x11\x0c\xb2\x91\xac\x79\xa7\xe7\xc9\x05\x91\xf5\xbf\x36\x0a\x06\xb9\x2f\xc1\xc1\x53\x58\x41\x80\x9e\x41\x66\xba\x7d\xe8\x2d\x5e\x46\xc5\x59\x85\x04\xe3\xc4\xa1\xa4\x83\x41\xcb\x23\x83\x36\xfb\xca\x43\x54\x8f\x1e\xac\x1c\xd1\xc5\x17\xde\x14\xf4\xfc\x2a\x20\x32\xe8\xa3\x23\x20\x08\x82\x7d\x72\xfb\xb5\x2b\xc3\x4e\x90\x15\xfc\xb0\x4e\xb0\xaf\x12\x54\x97\x17\xa2\xdb\x3b\x8a\x4b\x45\x47\xeb\x13\x02\xf3\x2e\x72\xc2\x59\x94\x85\x5e\xbf\x60\x84\x1d\x96\x4c\x7f\x15\xf7\x7b\xc7\xd2\xcb\xd3\xb1\x1f\xb6\x66\x51\xca\x18\x29\x7d\x2c\x6b\x4c\x11\xba\xf8\x39\xd0\x1f\xf9\xa1\x61\x1c\x71\xe1\xef\x9d\xd4\xf8\x52\x36\xb5\x77\x88\x42\x8c\xf4\xd7\xde\xe5\x61\xcc\x97\xc4\x24\x3d\x31\x38\xd9\xd8\xe0\x46\x42\x59\x31\x6f\x55\x97\x54\x52\xc5\x7e\xab\x01\x83\xf6\xb5\xd7\xcc\x97\xd6\xe4\x61\x35\xc1\x31\x5f\xb2\x1e\x57\x2b\xee\x82\x87\x08\x0a\x2c\xae\x85\x19\x20\x00\x22\x6e\x3a\x0b\x0f\xe7\xe0\x41\x78\x57\x12\x04\xbe\xca\xf9\x54\xac\xcb\x69\xd0\x48\x5f\x80\x76\xe0\x56\x54\x56\xdb\xf0\x27\xdf\x5d\x7b\x0c\xc2\x68\xbb\x4a\x60\x1a\xaf\xfa\x5f\x2f\xe3\x52\xda\xd5\xa2\x37\x1d\x9a\x63\xe9\x40\x50\xfc\xa0\x74\x6f\xb9\x48\x7f\x40\xd8\x83\xce\x9a\x90\x3b\xec\x67\x6e\xb5\x8b\xb4\x3e\xa9\x6d\xc0\x55\x62\x0b\x12\xc4\x84\xaf\x35\xc6\x5a\x9b\x05\x6d\x0c\xb6\x60\xa4\x77\x5f\xfe\xd6\x37\x03\x5b\xd9\xfd\xe6\xd3\xaa\x6d\x16\xc8\x36\xc6\x3c\x50\x80\x2a\x70\xeb\x8e\xd3\xab\xac\xaa\xf8\xa9\xbc\xc9\x77\xae\x08\xa7\x30\x92\x49\x90\x7c\xd4\x90\xda\x38\xe1\x39\x02\x00\x69\xe2\x12\x34\x40\xce\x65\x60\x59\x22\xcd\xef\xe1\x8e\x93\x0e\x26\x49\x0e\x15\xfb\x84\x9e\xa4\x44\x44\x7c\x38\x14\x43\x8c\x9a\x1f\x17\x11\xad\x5b\xd7\xe5\xcc\xc5\x41\x8c\xba\x0d\xc6\xb1\xc7\xb0\xcd\x90\x19\xb9\xf1\x82\x92\x2a\x11\x39\x72\x32\x5a\x30\x2a\x45\x4a\x75\x05\x93\x3a\xde\x97\xe8\x49\xf3\xa9\xb9\x4c\x38\x2b\xbb\x16\xd2\xb3\x3f\x45\x5c\x94\xe4\x1d\x7e\x9c\xab\xa5\xd4\x13\x18\xde\x34\x04\x3d\xdb\x32\xe4\xb8\x2b\x26\xf8\x81\x6f\xae\xa0\xaf\x0a\xcc\x53\x9a\xe3\x87\xbe\x3c\x3a\xae\x0f\xa0\x04\xe4\x0b\xa0\x58\xdb\xec\x73\x5d\x0f\x4b\xdf\x14\x3c\x2b\xe6\xd4\x8d\xa1\x1d\x19\xd9\xf5\x8c\x51\xab\x63\x88\xb7\x0c\x6a\xda\xbc\x14\xce\x5e\x1f\xef\xaf\x86\x4c\x6c\x86\x4d\x90\xbc\x69\x91\xda\x5d\x75\xf0\x3d\xdf\x44\x9b\x7f\x3e\x7a\x08\xb7\x77\x9a\x5c\x74\x82\xdc\xaf\x33\x0e\x34\xbd\x1e\xac\x66\x7a\x8e\x5f\xe2\xad\xbe\x8d\x5e\x5c\xe0\x3d\xa2\x75\xf0\x9f\x6d\xdd\xf3\x3c\xed\xb0\xda\xdf\xcf\xac\x0f\x3f\x2d\x71\x9d\x99\x48\xe9\xc7\xea\x8d\x90\x75\x4e\xf9\x67\xe8\x43\x3f\xa5\x1b\xe6\xe0\x66\x2d\xf4\x15\x7c\x36\xca\x86\x9a\x00\xa9\xd8\x13\xe9\x25\x4c\xee\xc6\xb0\x80\xa9\x3b\xf2\x83\x8c\x74\x0a\xe2\x6b\x84\xaa\x5d\x13\xde\xd6\xf8\x71\xfb\xec\xd1\x42\x9c\x19\x21\x66\x68\x29\x56\xc1\xeb\x22\xd2\xfe\x74\x07\xd6\xfc\xf3\x02\xe9\x6a\x9f\x76\x3d\x39\x78\xdd\x93\x00\xa9\xe3\x8d\x0f\x5f\x77\x79\x8b\xf7\xb6\x76\x73\x8a\xf5\x03\x05\x52\x26\x5b\x14\xe7\xa6\x4b\xb8\xda\x7c\x6b\xe5\xd6\x1b\x4a\xf7\x77\xd2\xec\x95\x9f\x78\xbd\xde\xa2\xde\xd7\x73\xd2\xa1\xb2\x11\x12\x1c\xe8\xfc\x34\xd7\xdf\x2c\x14\x03\x10\xc7\x20\x54\xfa\x6d\x44\xad\xf5\xac\x37\xe2\x16\x86\xbd\x10\x35\xb3\xc6\x8d\x0f\x41\x23\x9c\xa2\xd3\x0d\x18\xe7\x1e\x31\xca\xa0\x07\xd7\xc0\x78\x60\xf4\x85\x2f\xf4\x78\x85\x2f\xb6\xde\xb7\xbc\x29\x51\x7c\xfb\xd6\x71\x59\xba\xbf\x3b\x95\x9c\xc2\xef\xea\x3b\x5e\xe3\x95\x9f\xb1\x3a\x61\x1b\x7f\x6f\x5b\xdb\x97\xda\x08\x4e\xf8\xe5\xed\x5b\xc8\x63\x94\xf0\xd4\xc1\xf3\x83\xe7\x87\x75\xc2\x3d\xb6\x46\xb7\x31\x0f\xac\x43\x98\x0f\x33\xd7\x17\xcb\x16\x16\x0e\xef\xc2\xa3\x24\xcc\x19\xa8\xb4\x89\x85\xc6\xc0\xe8\x1a\x60\xaa\xd7\x94\xe0\xe8\x58\xde\x59\x83\xad\xee\xd1\x6f\xd8\xbb\x30\x85\xb5\x82\x1d\x73\xe4\x40\x1b\xc3\x43\xae\xb4\x50\xdd\xe5\x5e\x5c\x32\x10\x12\x6e\xd2\x1f\x92\xe8\xcb\xe1\xe2\xc7\x04\x41\x37\x9e\xbf\x7d\x86\x70\x05\x5c\xbc\x42\x70\x3d\xbf\x8a\xb0\xc3\xd7\x68\xad\xe8\x6a\x66\xd4\xaa\x21\xef\x8d\xf0\x1e\xb9\x0c\xbe\xd2\x96\x4d\x2e\x44\xc2\x7c\xec\x5e\x9c\xb7\xe0\xf6\x54\xe5\x69\x79\xc6\x7d\xbc\x59\xd3\xb8\x1a\xd1\xe1\xa5\xad\x0e\x45\xfe\x64\x2c\xd0\x2f\xe2\x4e\x7f\x3c\xa8\xd5\x32\x77\x5e\x06\x02\x7d\x4f\x5e\x84\x6c\x6e\x56\x17\xa7\x03\x97\xf3\x7c\xff\xc4\x29\x77\xe5\x7c\x34\x5a\xcb\x03\x0d\xc9\xa1\x0c\x84\x6d\x59\xdb\x08\x29\xc0\x49\xea\xeb\x4b\xc9\x54\x4e\x8c\xe4\xd3\xae\x25\x06\x87\xcc\x07\x37\xd9\x6d\xaf\x45\x23\x43\x46\x13\x04\x74\x35\xd5\x8d\xb3\x19\xcb\xf8\xb2\x65\x6f\x73\x5d\x85\x0c\xb8\xe1\xdb\xc2\xaa\x20\x43\x5f\x78\x7a\xeb\x4c\xf8\xea\xf6\x2f\x33\x7d\x20\x40\xf2\x7c\xda\xb0\x5a\x5c\xd1\x14\x20\x0b\x20\x66\x44\x17\x85\x9e\x37\x27\x03\x7f\x55\xab\x68\x8b\xe4\xbc\x5c\x58\x58\xae\xe8\x6f\x91\x40\xa3\x53\xce\x51\xf8\x9c\x6e\x51\xd8\xd1\x28\x09\xf9\x5e\x0e\x3c\x08\x1c\x2e\x87\xc7\x15\xe2\x8b\x2e\x11\x03\x85\x6a\x85\xe5\x20\xeb\x3a\x61\x10\x61\xe7\xc7\x95\x59\xcc\xf5\x55\x37\x2a\x9d\xd7\x5d\x59\x88\xf6\x97\x63\xbc\xf0\x8e\x8b\x05\xab\xdd\x91\x0e\xb5\x7c\x6b\x4a\xb6\x89\xbd\x6b\x1b\x1b\x4c\xb2\x00\xa4\x00\x5d\xd0\x76\x28\x10\x9e\xa6\x6b\x48\x95\x64\xac\x6e\xdd\xf7\xa5\x32\x6a\x8c\x3c\x08\x27\xd7\x2c\x20\x51\x0d\x53\x2c\x9a\xd8\x21\xa5\xcd\xb9\x9f\xa9\xaa\x10\x05\x7d\xf0\xe7\x40\x2e\x40\xf1\xd4\x71\x75\x0d\xd5\xe8\xe8\x45\xaa\x77\x0c\xa7\xe9\x03\xf8\xd5\xde\x2e\xce\x4a\xaf\xc6\xd6\x6d\x05\x75\x66\x1e\x90\x76\x4b\x3b\xba\xab\x40\x3f\x90\xeb\xfc\x3c\x0d\x7e\x8c\x13\x58\xd8\xf7\x0a\x3d\xc7\x82\xf5\xf3\xf2\x80\x5a\x73\xc4\xc5\xd7\x77\xc3\x50\xf5\x6d\xbd\x5f\x4b\x8a\x3e\xef\xa0\x76\xe1\x71\x53\xb4\xfe\xf2\xa2\xd1\x1c\x79\xf9\xba\x6c\x33\xe8\x71\xfe\x53\xea\x8c\xe0\x3d\xad\x96\xb6\x28\x0d\xb4\xb8\x33\x45\x2e\xea\xbc\x9c\x72\x5e\x6e\x67\x9a\x8f\x78\x2c\x1d\x36\x86\xaa\x9f\x39\x93\xa0\xf3\xde\x79\x27\xbc\x8b\x1f\x62\x52\xeb\x20\x9c\x15\x75\x3d\x85\x04\x9c\x0a\xcc\x47\xc4\xe4\x0e\x29\x77\x81\x4f\xb9\x17\xcf\x8d\x3a\xc9\xe7\x6f\x51\xe9\x3f\xcf\xc0\x1e\x71\x58\xb5\xe9\xce\x3a\xc2\x44\xbc\xfd\x4f\x6d\x7e\x7a\x37\x76\xb2\xfe\x3c\xdd\xb0\x71\xb9\xd4\x72\x05\x8c\x9f\xae\x6d\x78\x85\xd8\xd4\x26\x57\xb1\x41\xe1\xb3\xa1\xa0\xcb\x27\xaa\xba\x63\x64\xe9\x46\x5c\xce\x5f\x65\x1e\xa1\x6b\xbd\xa1\x46\x36\x32\xa3\xa9\x79\x5e\x66\x8e\x91\x37\x92\xaf\xc6\x3d\x2a\x73\xa2\x52\x02\x30\x09\x44\x49\xf2\xc5\xdc\x8d\xec\x5f\x0e\x78\xa8\xbf\x1c\x97\x52\x1d\x51\x44\x8b\x49\x6a\xa5\x26\x22\xa5\x80\x35\x81\x37\xe0\xa2\x00\x26\x69\xca\x04\xd7\x02\x06\x5a\x81\x4c\x73\x8c\x42\x14\x05\xb8\x6b\x31\x13\x5b\x5b\xa1\x21\x65\x54\xfb\xbb\x34\xde\x0f\x33\x1e\x36\xc3\x03\x21\xfc\x34\xe2\x11\x82\x10\x8c\x86\xba\x56\x9d\x24\xd3\x9d\x11\x56\x1b\x97\xd1\xbc\x1e\x92\x8f\xbd\x5d\xab\xb2\xb6\xdc\x9a\xd7\xe5\x8d\xd6\x43\x91\xe9\xfb\xbb\x81\xb8\xfb\x96\xe3\x23\xd7\xb4\x96\xe8\xae\x67\xcd\xb5\xf6\xaf\x4f\xd8\xbb\x4b\xf5\x08\xcd\xce\x4e\x78\xcf\x2c\x46\x6e\xf4\xa5\xe2\xee\x1c\xac\xa1\x72\x78\x22\x45\xb4\x89\x22\xd0\x93\x6a\x5e\xb3\x7b\x29\x2a\xfe\x78\xce\x0a\x8e\x76\xc6\xcb\x69\x84\x7e\x0d\x7d\xc2\x84\x0b\x9f\x66\x6e\x5b\x63\x5e\x9a\xc0\xa3\x82\x9f\x62\x81\x01\xa7\x47\x83\x0c\xa3\x62\xfd\xcf\xa1\x53\x1f\x19\x14\x79\xa5\xe6\xba\xa2\xf1\x96\x41\xf4\x53\xd7\x30\x10\x11\xcc\x0b\x55\x0d\x1f\xaa\xe9\x50\xae\x5a\x3f\xf6\xb4\xbd\xc3\x39\x9d\x67\x58\x0d\x1e\x9e\xa8\x1b\x3a\xc1\xc3\x82\xd5\x24\x5f\x60\x42\x87\xf0\x99\x3a\x4d\xf1\x8a\xb2\xf2\xd1\xee\x2c\x18\xd8\x8d\x01\x8f\xc1\xf4\x5c\x73\xee\x3b\x36\x43\x95\xc5\x74\x07\x23\x3d\xd3\xca\xfe\x29\xfc\x80\xbb\x77\xff\x86\xed\x1a\x49\x93\xf3\x1a\xd6\x8a\xf8\x0a\xf6\x0c\xc3\x64\x9f\x59\x85\x19\x74\xcc\x20\x0e\x92\x86\xbc\x9e\x06\x8e\x7d\xac\xf9\x8e\x50\x24\x3d\x08\x02\x07\x85\x49\x42\x13\x24\xe4\x03\x42\xfc\xc4\x3c\xa1\x77\x0e\x2f\x9f\x3f\x42\x14\xee\x4f\x3b\xb2\x8c\xc0\xe4\x4e\xb1\x3e\x4f\xe7\xaa\xfb\x7b\x97\xe9\xc0\xf5\x35\x84\x7b\xce\x40\xdd\x70\x69\xd5\x73\x5d\x7f\xf0\xa6\x31\xbf\xc1\x8d\x6f\xe5\xc6\x33\x8a\x51\x4b\x3f\x9b\x66\x7f\xbc\x9f\x2f\x10\x05\x41\x73\x0d\xde\x83\x8e\xd0\xd6\x6c\x5a\x5e\x87\x01\x59\x22\x99\x8c\x24\x0a\xb5\xba\xde\xd8\x3e\x48\xea\x02\x09\x07\xe9\xd3\xc9\xf9\x70\x7a\x8f\x44\xbf\x51\x70\xb1\xda\x56\x55\xa1\xcc\x35\x4f\xeb\xd5\xa6\x1d\x0c\xe0\x99\x4e\x48\x16\x3e\x3d\x10\x96\xb0\x7b\x63\xd9\x82\xa3\x2f\x34\xd8\xfd\x50\xaa\x3e\xcc\x05\x00\x50\xba\xc3\x6a\x7c\x02\x15\x57\x3e\xf0\x0d\x5f\x56\x39\x1f\x9a\x08\x03\x83\x57\xfb\xeb\xc4\xf0\xab\xee\x91\xe5\xab\x6e\xc9\xe5\xab\x97\x70\x31\xb9\x46\xcb\x26\xab\xeb\x95\x72\x07\xfb\xdb\x47\xc6\x61\x74\xe2\xaf\x70\xaa\x32\xe8\x6c\x1e\xde\xc2\x2c\x8d\x49\x89\x91\x0e\xc2\x2c\xd5\x3f\x57\x02\x51\x87\x1f\x25\x49\x77\xa7\xeb\x6f\x41\x93\xb3\x57\xc9\x6c\x51\xc4\xcf\xbb\x13\x99\x8d\x9e\xaf\xc8\xd9\xbf\x5c\x06\x68\xb6\xb4\x7c\x92\xa4\x54\xbf\x95\x70\xff\xb6\x3e\x1f\x1a\x87\x09\x9a\xf0\x61\x1a\xdd\x67\xd7\x06\xec\x5d\x2e\x2a\x95\xbf\xa5\x27\x4e\x41\xcd\x56\x4a\x42\x32\xbe\x0a\xbd\x29\x95\x07\x28\xad\x3b\xd0\xd2\x41\x13\x3d\x08\xf7\x9d\x6c\x35\x02\xf4\xfb\xc3\x92\x51\xf3\xe3\x91\xf0\x6e\x3f\x25\xe6\x4e\xbf\x24\xcb\x4a\x43\x2e\xdb\xc4\xda\x82\x42\x45\x9b\xad\x73\x30\x3d\xd3\xc5\xc9\xa3\xf2\xee\xa8\x73\x6c\xed\x42\x87\xd7\x61\x82\xc0\x76\x85\xe6\x3a\x99\x2d\xfa\x58\xb2\x2f\x91\x66\x8b\xf3\x67\x79\x7a\x82\xee\x44\x13\xd9\x91\x2b\xf3\x0a\x32\xe0\xbb\x8f\x05\x8f\x56\x76\x66\xf6\x0f\xa2\x7c\x45\x47\x1f\xe3\x90\xfe\x86\xc1\xcb\x9a\x8f\xf6\x75\x3b\x3e\xb3\x4a\x49\xb9\xfb\xa2\xdf\x7b\x76\x5f\x0f\x00\xb0\xef\xd0\xef\xf4\x39\x5b\x84\x8b\x81\x46\xed\x36\xe2\xc6\x0d\x88\x9b\x00\x5c\x5a\x4e\xf0\x8d\x86\x48\x58\xdb\x6b\x4d\xfe\x80\x91\x26\xfe\x9e\x3b\x12\x8b\xa4\x21\x0c\xe3\x09\x5b\xc4\x67\xda\xee\x8b\x70\xd5\xb9\x1f\x55\xa5\x17\x19\x1f\x50\x3b\xb1\x3a\x10\x6c\x9a\xe1\xf5\xc2\xf1\x27\xc9\x79\xea\x2e\xb2\x1a\xda\x10\x68\xd1\x04\xe6\xde\xa9\xd1\xfe\xa0\xfb\x7e\x73\xc0\x8f\xc7\x29\x7f\xed\x30\xc7\xb9\x3c\x49\xa6\xb7\xbc\x03\xda\x81\x07\xba\x79\x09\xe2\x76\xb8\xaf\x4a\x85\x54\x25\xdc\xf4\x58\x03\x11\xc9\x42\x07\x7e\xb3\xa7\x07\x6e\x73\x73\xb3\x28\xbc\x08\xa4\x6b\x48\xd6\x78\x12\x91\x5e\x8f\x28\xd6\x00\x7b\xc9\x45\x86\xd6\xe4\xd4\xb5\xe1\x66\xea\x9a\x63\xc3\xa1\xf5\xea\xea\xdc\xcc\xd4\xc5\x2c\x7b\x86\xe1\xa0\x5a\x6a\x89\x0e\xc8\xfb\xdd\x46\x4d\x2f\x34\x07\x6f\xfb\x34\x19\x09\xe5\x56\x92\x4d\x7d\x46\xd0\x39\x8f\xc3\xe5\x92\x6c\x5a\x5f\x1a\x97\x51\x83\xc0\xe0\x88\x54\x77\x2f\x0d\x14\x20\xee\x2b\x2b\xa8\x12\x29\xb0\x64\x41\xd8\xd2\xac\xba\xab\x74\x15\x0c\x11\x75\xe7\x8c\x41\x3d\x11\x19\x3d\x8e\xeb\x25\xcd\xb9\xb8\xbb\xf8\x7a\x98\xa1\xbe\x3b\x6a\x57\xac\xaf\x28\xb8\xec\x47\xcc\xf8\x24\xc6\xcf\xd0\x59\x8a\x20\x8c\x8e\xf5\x92\x24\x0a\xf7\x25\x85\x4b\x50\xa0\xc6\xee\xcc\x36\x49\x15\xf5\xc4\x65\x4b\x0f\xbe\x0f\x48\x49\x95\x1d\xee\x96\x80\xcc\xa3\x1e\x7a\x4a\x15\xc6\x6b\x44\x26\x0a\xba\xc6\x94\xc5\xc8\xb9\x84\x2f\xbc\xc6\x94\x15\x87\x5f\xa5\x68\x4b\x00\x75\xcc\x8b\x2a\x21\x5b\xa5\x4d\x2c\x84\xa2\xf3\x33\x78\x96\xc7\xfe\xac\x94\x88\x27\xa4\xac\xa6\x0f\xd3\xf9\x50\xf9\xcd\x07\x00\x17\x50\xfa\x48\x3f\x47\x40\x3e\xd7\x69\x2b\x1d\xb7\x50\x04\x8c\xbe\xa1\xf9\xc2\x66\xaf\x6a\xc7\x26\x7f\xf1\xbe\xad\x06\x9d\x7c\xa0\x9c\xdf\xbc\x4a\x21\xc7\xf1\xb6\xf7\x92\x91\xc9\xcc\x4a\x96\xe2\xf9\x54\x3d\x9b\x42\x5c\xd4\xcd\x20\xbe\xcd\xff\x3e\xbb\x58\xef\xe1\xdd\x96\xab\x1e\x3b\x18\xed\xd0\xc8\x5e\x33\xfa\xa2\x6e\x4d\xaf\xa9\x40\xd0\x4b\x0c\x40\x18\x50\x0c\xeb\xe3\x61\x65\x55\x21\xdf\x53\x1a\x03\x9e\x0a\xfd\xc8\xd4\x94\x0b\x8b\x0f\xa8\xa1\xb3\xcc\x27\x85\x95\xe6\xbd\x7c\x45\x46\xef\xbe\x83\x9c\x03\x3d\xbb\xfb\x54\x0e\x87\x53\xa7\x51\xb6\x42\x08\xb4\xf9\x58\x9b\x81\x37\x85\xba\xaa\xa6\x3c\x68\xd7\x69\x66\x13\xd1\x5d\xda\xed\x35\xd2\x66\x07\x80\x48\x72\x94\x32\xc2\x91\xe3\xd9\x68\xae\x27\xb0\xc6\x88\x7d\xf1\xd5\xb4\x4f\x14\xb1\x1a\x1f\x81\x08\xe8\x27\x32\xe6\x05\x21\xfc\xa4\xa8\x17\xc2\x44\x58\x6a\xc1\x78\x91\xc1\x15\x90\xfe\x23\x71\x1b\x3e\x60\xe3\x00\xcd\x5f\x2e\xe0\x54\xd2\x82\x81\x87\xc7\x34\x98\xaf\xf8\x0d\x24\x68\x44\x07\x15\xde\xca\xe6\x2b\xdf\x66\x4f\xd0\xe0\x9b\x81\xc4\xc7\x0f\x69\x4a\x21\xe1\x43\xe7\x4d\xd4\x7e\x28\xdd\xd0\x9d\x26\xe0\x08\xf5\x89\x14\x02\x92\x8a\xe5\xda\xb3\xd0\x9d\x1f\xbc\x76\x31\xe7\x99\xb0\x31\xae\x00\xdd\x2b\x03\x57\x5b\x71\xc3\x9a\xbc\x4d\x93\xcd\x9a\x88\xfd\xba\xd3\x2b\xf1\xd1\x27\x5c\xb6\x7b\xd9\x2f\xae\xea\x3c\x9e\xcf\x24\xcd\x81\x2f\xb6\x87\xa3\x7e\x9a\x03\x6e\x16\x62\xfa\xf9\x06\xfc\x30\x81\xfe\xc8\x7a\x52\x0e\xbd\x2b\xfa\xb0\xda\xb1\xf5\x49\x52\x01\xa0\x03\xab\xa0\xc8\x9f\xc6\xc5\x1c\xa1\x41\xeb\xf4\x6a\x5a\x0a\x20\x20\x1d\x56\x55\x41\x74\xa9\xd2\xb3\x55\x40\xf5\xe0\x2b\xae\x14\xa0\x73\x88\xde\xcb\x27\x17\xb7\xce\xcf\xab\x7c\x9d\x82\x9c\x83\x32\x1a\x40\x0c\xc7\x95\xfc\xd8\xe6\x03\x4a\x57\xd2\xf6\xb6\x87\xe0\x76\x1a\x69\xb2\xfb\x63\xbe\x12\xdd\x75\x17\x53\x55\x19\x8d\x26\x7e\x62\x7d\x29\xe9\x3a\xe5\x15\x4e\x2d\x9b\xb5\xba\xdf\xc4\x44\x3f\x2e\x3d\xf2\xb8\xc9\x8e\x82\x16\x93\xc5\xd8\x08\x77\x54\x21\x65\xa1\xca\x70\xeb\x63\x1c\x68\xec\x42\x4c\x84\x28\xd4\x0f\x45\x95\xb9\x2b\xf3\x97\xd0\x5b\xec\x72\x38\x53\xb2\xd5\x35\x2a\xdf\xa0\x11\x9f\x81\x60\x39\x1f\x37\x6e\x95\xea\xb9\x35\x85\x8f\x63\x4e\xa6\x41\x31\x17\xe6\x53\x10\x35\xa8\xc4\xf5\x21\x5e\xfd\x1d\x7b\x3e\x35\x06\x24\x0a\x2a\x9f\x89\x76\x8d\x48\xcc\x3d\xa9\x29\x04\xc0\x10\x8a\xb1\x56\xbe\xec\x5c\x78\xd7\x0d\x8d\x4d\xa3\x1e\x4c\xef\xd2\xc1\x60\x66\xa6\x4a\x4a\x78\x7f\xaa\xf6\x5f\x61\x82\xdf\xd9\xb6\xec\x7d\x50\xc2\x28\x77\x98\xb6\xdf\x57\xd3\x57\x12\x11\xf4\x1a\x1e\x34\xad\xcc\x08\x92\x57\x6d\xc0\xd8\x9d\x5e\x84\x50\xcf\x88\x12\x77\x66\x20\x66\x14\xf3\x13\x87\x46\xa9\xa3\xfc\x8e\xcc\x45\x08\x4a\xd8\xff\xd1\xff\x5d\xdf\xa8\x8c\x0c\x91\xc9\x06\x57\xd4\xd0\xec\x03\xe2\xf4\x20\x21\x6a\x35\x4a\x39\x70\x58\x08\xd2\xc9\xdb\x59\x75\x9d\x3f\xf5\x63\xb7\x0c\x3c\xfc\x07\x41\xa9\x20\x28\x12\x59\xac\xc2\x28\x4d\xe0\x57\x2e\x6b\x52\x81\x4e\x65\x5f\x4a\xa0\xcd\x6f\xb9\x50\x14\xa9\x0b\xec\xb3\x12\xf0\x06\xcc\x7f\x86\xed\xf6\x6a\x3d\x64\xa8\xca\xe9\x76\x83\xdd\x9a\x0e\x4e\xd5\x73\x5b\xf2\xdd\x75\xb2\x73\x17\xa0\x11\xf4\x81\x0b\x79\x35\xc3\xca\x0a\x81\xef\x84\x0b\x22\x86\xb7\xfb\x4e\xe7\x47\x74\xed\x79\x8a\xb0\x61\xb7\x7e\x8c\x34\xea\x64\x55\xc6\x93\x33\xc6\x90\xa5\x1d\xe6\x40\x11\x7b\x48\x39\x82\x38\x68\x67\xfc\x73\x55\x97\x44\xd7\xcf\x11\x8f\xd3\x5e\x0c\x0d\x4b\xec\x23\x3a\x7a\xaf\x1f\x68\xa2\x41\x01\xb4\x8a\x25\x3a\x2a\xa1\xa0\x3e\x2d\x48\x06\x5a\x2f\xdd\x86\x48\x7a\xac\x6c\xd1\x0c\x21\xfb\x40\x6f\xd0\x2d\x0f\xce\x11\xff\xfe\x22\x0d\x58\x1b\x95\x36\x41\x59\x4f\xd5\xc0\x16\x72\x00\x11\x36\x88\xda\x18\x3e\xe7\xcb\x0d\xa2\x84\xe4\x1d\x08\xe3\x99\x3f\xf4\x44\x48\xe9\xcd\x81\x1e\x96\x15\xae\x94\x81\x15\x13\x2d\x56\xcc\x87\x03\xf4\x17\x42\xed\x0c\xeb\x24\xa9\x20\x79\x47\x43\x43\x6f\xc2\xf2\xd1\x57\xe4\x6b\x19\x85\xa7\x4c\x98\xa6\x47\xa6\x77\x2b\x8f\x33\xb5\x35\x81\x54\x1f\xe0\xee\xc5\xab\x8a\x05\x21\xc4\x08\x94\x6a\x1e\x9b\x0c\x91\x79\xe0\x73\x4b\x2c\x67\x89\x6a\x59\x0f\xc9\xe1\x28\x57\xba\x39\xb9\x7c\x20\x39\x72\xa7\xd0\xb6\x06\xbb\x48\x0b\x77\x3a\xbf\x0c\x4e\x09\xca\x56\xe3\x1a\x96\xa9\x92\xdd\x3a\xf7\xdb\x6c\x08\x4e\xbb\x2b\x50\x4b\xdf\x31\x0a\x66\x89\x26\xef\xf5\xb1\x5f\x41\x7e\x79\x1b\xd9\xdf\xd9\xcd\xd6\x92\xd7\x16\x98\x5e\x0e\xd7\xa8\xe4\x68\x26\xae\x6c\x7b\x2b\x2b\xb3\x19\x17\x7f\x57\xdd\xe6\xcb\x55\x15\xa5\x6f\xe2\x59\xa0\xf1\xf3\x64\xbc\x83\xa5\x52\x7f\xa8\xf5\xea\x04\xfb\x06\xe4\x58\x8d\x67\x3e\x46\x68\xea\x00\x99\xb8\xa9\x4d\x17\x5d\xac\x09\x74\xc7\xe4\xd3\x0f\x46\xb7\x68\x6b\x25\xdf\xcf\x2f\x6c\x72\xdb\x7a\x37\x5d\x26\x59\x31\x26\x59\x2f\xa1\xef\x57\xee\xc1\x5b\xbb\xf4\xb7\x14\x09\x63\x6e\x9c\xe1\xf8\x51\x52\x90\x0e\x5a\x46\x41\xd0\x7c\xcc\x67\x6c\x67\xc0\x6c\x5f\xd7\xe1\x2a\xc0\x85\xc7\x47\xc2\x91\xb7\x88\x8d\xbd\x0a\xd6\xc3\xe9\xb0\xe2\x3d\x29\x0a\x68\xb5\x2e\x26\x8e\xcf\xe6\x38\xd4\xf9\xf0\x6c\x37\xaf\xdc\x73\x9b\xd8\x7b\xea\x75\xe6\x7f\xee\x72\xd3\xe3\x91\x08\x87\xf4\x89\xfe\x90\x74\x8b\x7e\x51\xe4\x36\x69\x7a\x81\x36\xe9\xc0\xba\xdf\x3d\x82\x91\xdd\x5e\x88\x47\x06\xc7\x97\xf3\x3b\xd1\xb6\x7f\x00\x6a\xb8\xc8\xd8\x43\x82\x0e\x72\xf6\x40\xe6\x20\x13\xcb\x10\x4e\x40\x43\x73\x27\x17\xe4\x94\x91\x09\x62\xa1\x4b\xf8\xe7\x4f\xf6\x76\x60\xd3\x50\xb7\x4e\xa6\xa9\x1e\xb0\x9f\x41\xa2\x75\x3a\xec\x10\x11\x24\x45\x04\xc0\x78\x52\xc7\x22\x46\xe0\xcd\x41\x5f\x7b\x8c\xf9\x7d\x66\x0d\x20\xaa\x22\x8c\xb9\x01\x35\x4c\x1f\x07\x36\xde\x28\x8f\x3c\xa0\x8d\x1b\x5f\xca\x98\xdd\xf8\x61\x5d\xef\x60\xc6\x9c\x49\x72\x8b\xe3\xb9\xcb\x94\x5b\x25\x10\x32\xa2\x0c\x51\x0b\xea\x9e\x97\x3a\x18\xf1\x24\x5e\x10\x2a\x3b\xb6\x27\x18\xe8\x8f\x13\x7c\x3e\xf5\xb4\x05\x1f\xc9\x4f\x84\x4a\xc1\x8c\x00\x5e\x62\xb1\x92\xde\xe3\xf4\x35\xfc\xa0\x10\x2d\xc7\x59\x3d\xba\x3e\x4d\xef\x89\xac\xb1\x77\x50\xb1\x29\xfb\x60\x5c\xdd\x1e\x0d\xce\x1d\x27\x19\x54\xf5\x12\xd9\x09\xb6\xe4\x02\x53\xa5\xd2\x94\x84\xe2\xe3\x14\x90\x6a\x85\x21\x88\x7b\x80\x94\x61\xc9\x4f\xb5\x0d\x92\x04\x18\xd9\x03\xa0\xef\xd9\x1f\x12\x5a\xbc\x17\x63\xbd\x5a\x28\xaa\xa0\xe8\x0a\xed\xd8\xb9\x40\x13\x37\xe4\xd9\x2f\x46\x67\x6a\xce\x0f\xb3\x87\xdf\xbc\x64\x4b\x00\x21\x6e\x81\xb6\x2d\x1f\x77\x88\x46\x0b\x14\x22\x19\x90\x0a\x6d\xde\xfc\xea\x01\x5c\x16\x27\xf6\xc8\x9d\xc8\xe3\xb1\xd2\x4d\x95\x09\x1b\x1c\x2c\xf7\xbe\xf7\xc3\x7a\xfd\x1e\x4c\xb3\x94\xb1\x53\xf8\xab\x7b\xcf\x42\xad\x10\x64\xa3\x6f\xbc\x84\xc0\xcf\xba\x8e\x9f\x87\x5f\xe2\x05\x9f\xbf\x50\x04\x67\x81\xdc\xb9\x62\xe5\xab\xa3\x47\x75\x71\xd6\x61\x73\x40\x23\x3d\x1c\xa2\xf2\xc6\x16\x67\x79\x0a\x20\x76\x7e\x1c\xec\x02\x33\xcc\xb6\x3f\xd8\xe0\x39\xf1\x90\x48\x27\xfe\x08\x48\xca\xf4\x67\x36\xe7\xce\xa4\xc3\x0d\x3f\xf5\x7f\x9f\x3b\x2d\xee\xbc\x32\xd7\xc6\x48\x62\x58\x5a\xa0\x7b\xae\x55\x35\x61\xc5\x12\x2d\xda\xb7\x60\xd9\xfe\x08\x02\x83\xe4\x12\xde\x51\x2a\xce\xa1\x61\x2e\x9f\xaf\x3a\x84\x06\x9b\x16\x6f\x9a\xf6\xa0\x95\x65\x33\x79\xbc\x70\x91\x1b\xac\x07\xb1\x5f\x9e\x95\x92\x44\x52\xd7\xd5\x95\x33\xef\x8e\x0c\x1a\x45\xb8\xcd\x08\x3e\x6b\xbb\xec\xfa\x89\x17\x56\x2e\xc8\xe0\x06\x7a\xeb\x5b\x05\x55\x70\xf8\x07\xe5\x46\x5e\xf7\x1d\x5d\x3d\x76\x43\x3d\x43\xea\x50\x67\x2c\x5d\x72\x43\xde\x1c\x49\x78\x86\x53\x35\x57\x3e\x4e\xce\x86\xa6\x93\x25\x57\x30\x43\x14\xd6\x03\x4d\x48\x93\xea\x1b\xec\x07\x37\xfc\x01\x75\x72\x19\xfb\xe6\xed\x70\x74\x45\x14\xad\x27\x7d\x54\x41\x06\x10\x79\x13\x63\x52\x3d\x5b\xc4\x79\xdf\xa9\x70\x45\x08\xe3\xea\x08\x9c\x8c\x6e\xaa\x6d\xcd\xb0\xaa\x2a\x3a\x12\x5f\x1f\xe5\xfc\x0b\x71\x39\x69\xe1\xd0\x96\x96\x3e\x7e\x2d\x88\x94\x97\x14\xf8\x45\x24\xb4\x37\xb6\xa5\xb5\xa5\x90\xb4\x66\x7e\x8e\xa9\x17\xba\x67\xc6\x35\xe6\x6d\xd6\xb9\x6f\x5d\x4f\x09\x4d\xcd\xf8\x94\xfc\x91\x2a\xb6\x32\x20\x0b\x73\xf6\x22\xca\xe0\x2b\xd0\xb9\xd0\xd7\x4d\x7a\xfe\x8a\xf0\xb5\xd1\xb5\x5f\x45\x14\xe8\x60\x07\xce\xed\xfa\x9d\xe6\xc2\x9f\x31\x93\x5c\x2b\x02\xaf\x39\x3c\x60\x7d\x8e\xef\x6e\xaa\xa4\xf6\xfd\x97\x11\x37\x9f\x45\x98\xea\x9a\x2b\xe0\x43\xcf\xbf\xea\xbb\x83\x1c\x57\x0a\xad\xb8\x08\x69\x5a\xd1\x65\x45\x77\x3e\xde\x14\xa3\x07\xd5\x40\x9c\x59\xda\x24\x69\x37\xb9\xb3\xd2\x08\xe9\xb5\xc8\x5d\xd9\x1b\x1e\x2e\x9f\x9e\xcb\x3d\x18\xcc\xd4\x0e\x9d\x63\x0f\xe9\x9b\x60\x70\x83\x6e\x5a\xee\x15\x4f\x7d\x5c\xa8\xea\xa4\x37\xdc\x80\x80\x90\x53\x3c\xe9\xd3\x0e\x44\x12\x2f\x9e\xe2\x5a\x24\x9e\x82\x2a\xa0\xab\xd9\xcf\x81\xba\x28\x49\x4c\x9a\xca\xf4\x6c\xfc\x8a\x68\x90\xe9\x7a\xfb\x83\x1d\xd7\x9a\x93\xa7\x95\xad\xb6\x37\xed\x92\x03\xdd\x97\x71\xac\x26\x11\xb2\xd4\x65\x83\xf1\xd8\xbe\x05\x43\x4b\x6a\x15\x9e\x57\x40\xd0\xb4\x6e\x66\x2c\x72\xd3\x30\x6d\x0c\x65\x9c\x9e\x2d\x52\x66\x06\x19\xac\x5b\x97\x56\xc1\x0c\xe7\xde\xab\x09\x1c\x37\xe5\xe1\x9d\x69\x54\x7f\xf7\xab\xbb\xec\x8d\xdc\x1b\x79\xf6\x97\x9b\x1c\x4c\xe4\xeb\xa0\x90\x76\x92\x6e\xe7\x94\x33\x9b\x73\x87\xc7\xa9\x0c\x47\xd4\x26\x99\xe8\x75\x6a\xee\x83\x7c\x74\xcb\x19\x38\x3f\x6e\x6d\xcf\xbd\x99\x44\x77\xb3\xda\x0f\x11\x38\x28\x0c\x8b\x29\xce\x01\x33\x4c\x29\x41\xcb\x93\xb6\x71\x8d\x9c\x82\x3d\x59\xa0\x39\x11\xab\x7a\x04\x26\xb3\x41\x78\xfb\xda\x55\xb8\x4c\xb6\xa7\x26\xf2\x2c\x0c\xd3\x26\x4e\x7e\x4d\xaa\xed\x83\x46\x63\x2c\x26\xeb\xdd\xfe\x13\x37\xd0\xed\x56\x12\x20\x0f\x9f\xf1\xef\x5b\x4d\x75\xab\xe6\x55\xe9\xbe\xb3\x6e\xc8\xd3\x2f\x68\x4e\xa2\x9a\xb2\x35\x82\x21\x13\xfb\xbc\x5a\x32\x62\x9b\xa0\xf9\x9c\x15\x07\x75\x69\xd5\x9a\x7e\x41\xe8\x17\x9b\xdb\x1f\x4f\x75\x6e\x71\xd1\x2b\x3a\xec\xa7\xf3\x91\x92\x09\x22\xc1\x47\x42\xf7\xb5\x83\x34\xa8\x62\x57\x9d\x80\xca\xf0\x5a\x1b\x38\x30\x9d\xb4\x0e\x8c\x85\x7e\x54\xc0\xd4\x09\xbf\x66\x3a\x82\x4b\xf4\x6c\xac\x1a\x58\x0e\x31\x6a\xf8\x3c\xc5\x2a\xcd\x9f\x5d\xa9\xae\xc9\x94\xd4\x3f\x75\xc1\x13\xc2\x4f\x26\xd6\x41\x0d\x7b\x3f\x23\xf9\xfd\xac\x41\x00\x1a\x8b\xd6\x35\x03\xe0\x34\x7e\xd2\x41\xf6\x11\x79\x13\x04\x7d\x98\xe4\x68\x9c\x3f\x78\xf6\xcf\x70\xeb\xe7\x12\x6d\x7c\x55\x6f\x7c\x54\x7b\x9f\xcd\x0c\xee\xde\xca\xcd\xc0\x30\x4b\x99\xdf\xe3\xf5\x4e\xc1\xef\x1d\x22\x82\x18\x13\x17\x55\xf5\x01\x74\xef\x05\x91\xbc\x3e\x84\xcf\x66\x19\xd8\xdd\xbb\x4e\x33\x26\xde\xf4\x80\x5d\xc7\xe3\xb6\x88\x4f\x4c\x02\xe2\x6a\x34\xe5\x27\xef\xba\x7b\xbf\xd7\xdb\x28\xe9\xbd\x5c\x63\x05\x62\xe1\xd5\x0b\x02\xf7\x3f\x96\xc7\x54\xcd\x96\x6f\x4f\xaf\x63\x73\x34\x36\x9d\x1b\x5b\xf9\x0d\xb2\x41\x53\x0c\x68\x2c\x1a\x5e\xa7\xd7\xf7\xe3\x7d\x79\x3a\xe0\xc1\x5b\x13\x63\xbb\xb4\x61\xa2\xef\x28\xb7\x0d\xfc\x9f\x9c\x07\x7b\x1f\x62\xf6\x20\x3e\x02\x26\x42\xb5\x0c\x91\x35\x26\xea\xf4\x41\xa0\x91\x1a\xcb\x72\x26\x19\xe9\xd8\x24\x10\xf9\x99\x6c\x10\xb6\x9d\x03\x6b\x9e\x57\x06\x39\xd0\xc4\x0b\x26\xe8\xfc\xdf\x2b\xdf\xf8\x38\xdd\x23\x69\x42\x73\x46\x22\x22\x1e\x12\xd5\xbe\x8a\xae\x73\xef\x95\x3d\x13\x0a\xf1\x0a\x11\xd1\xb4\x6b\x63\x90\xa2\x0e\x16\x3d\x35\x3a\xad\x28\x79\x4a\x4d\xc3\x8c\xa6\xb4\x9b\x81\xd0\x19\x2b\xc8\x43\xd3\x45\x5c\x5c\x1c\xe8\x84\xfe\x00\xd3\x9d\xe5\x25\xe2\x23\x8c\xd5\x7b\xfe\xf9\x19\x40\x50\x7e\x6e\x57\xd3\x92\x89\x80\x24\x86\x05\xc9\xcb\x5f\x7d\x92\xe0\x59\x17\xda\x8f\x50\x04\x77\x1f\xe0\xeb\xc4\xc8\xa1\xdd\xe8\x4b\x0e\xaf\x96\x3d\x00\x51\xa4\xe3\xca\x0e\x95\x84\x15\x10\xda\x00\x61\xca\xa2\x04\x4b\x16\x7c\x83\x4e\x4d\xc1\x9a\xc2\x2e\x94\x0f\x5c\xac\xe7\xc5\x35\x85\xb7\x99\x74\x9b\x19\xc7\x25\xe5\x8c\x58\x11\x04\x98\x71\x3a\x6e\x0d\xaa\xde\x58\x41\xa5\x72\x06\xc4\x44\x9e\x8c\x18\xde\xf5\x62\x25\x3c\xa8\xd1\x72\x75\x7f\x34\x10\xd4\x46\xff\xc8\x65\xd9\x35\x41\xd3\x7e\xc5\x32\xa4\x1e\x1d\xb8\x79\x06\x36\x08\xc6\xef\x55\x4b\x84\x81\x4d\xee\x0a\xc1\x1d\xf0\x51\x50\xb7\x6a\xbd\x12\xbf\xd3\x15\x17\xbf\x1f\xa9\x2b\x93\x19\x25\x55\x1d\x32\x30\x05\x92\x89\x85\x83\xbe\x8c\x4b\x05\x30\x78\xe9\x20\xe0\x93\x75\x21\x35\x4e\xa9\xd5\x34\x04\x08\xbb\x58\x7f\xe4\x5f\xc0\x52\x52\x85\xe5\x6e\xd6\xac\xbc\x87\x81\x9b\x5c\x65\xbf\x92\x96\x8b\xc2\x6e\x77\x15\x93\x0f\x3a\xe9\x0d\x91\xe2\x44\xbe\x39\x46\x42\x07\x17\xf3\xd6\xf1\xc1\xb2\x59\x62\x91\x89\x8c\xfe\xcc\x06\x61\xab\x80\xdc\x4d\xe8\x73\xe6\xbf\xd9\xff\xc0\x5d\xc9\xbf\x7d\x1a\x0b\x7a\xe1\x6b\xc1\x2b\x90\x70\x29\x63\x30\xa4\xe4\x29\xa6\x71\xa6\x69\x2f\x74\x55\x22\x9f\x9a\xda\x5d\xbf\x89\xf2\xd2\x11\x18\xab\x9b\xdc\xf4\x30\x5e\xd9\x6e\x87\x87\x30\xe4\x88\x01\x21\x1d\x95\x93\x30\xe6\x7f\x98\x80\x63\x13\x08\x81\x5a\x80\xc4\x1b\x60\x09\xcb\x9a\x0f\xed\x09\xe3\xc7\x3b\x3e\x3e\x99\xa6\x17\x0e\x6d\x5d\xc7\x07\x3e\xdf\x48\x9f\x55\x85\x6b\x10\x83\xe2\x77\x51\xcb\x7e\xf6\xbd\xff\xcc\x81\xed\xea\xb2\x59\x06\x16\x8b\x8f\x41\x4a\xe4\x99\x84\x1e\x71\x1e\xe9\x5e\x9c\x87\x9d\x42\x92\x11\xb5\x86\x0d\x4f\x19\x84\x21\x54\x4f\x5c\x9a\xa0\xa4\x94\x8f\x7b\x0b\x36\xf2\x06\x0e\xfb\x63\x8a\x15\xe3\x11\x43\x48\x53\x71\xf0\xe4\xc7\x03\x84\xc5\x9b\x00\x9a\xc4\x0c\xaf\x8f\x80\x89\xb0\xcd\x38\x06\xbf\xcd\xc3\x4f\x0d\x9f\x18\x41\xa0\xae\xd7\xf3\x7b\x56\x04\x22\x01\xd4\x8d\x64\xff\x1d\x80\x33\x3e\x31\x8a\x54\x5d\xcd\x83\x1e\xd2\xf4\x30\xae\xc3\x10\xd5\x1b\x68\x38\x10\x20\x2a\x88\xb9\x3f\x65\x44\x9d\xd3\xaa\x21\x54\xd9\xc6\x44\x4d\xaa\x6a\x6c\x44\x93\x2d\xa2\x20\x65\xaa\xd5\xf9\xc1\x80\x19\x8b\xef\x9a\x7f\x9e\x12\xb8\x57\xcc\x78\xce\x48\xd9\xe6\xe5\xcb\xf6\xc7\x1b\x78\x3f\xbf\xe0\x85\x28\x3a\xf7\xe9\x46\x02\x16\x19\xe1\xb6\xa4\x0b\xcd\xd7\xb6\x01\xca\x7d\xf5\x69\x36\xd4\x9a\x77\x13\x1a\x69\x6b\xb8\x48\xbe\xe6\x53\x79\xf2\x07\x71\x77\x86\x6f\xb9\x74\x99\xa9\x09\x81\xea\x75\xe5\xd7\xc8\xfa\x84\x36\x95\xd0\x07\x2d\x55\x42\x47\xd1\x5d\x70\x6f\xac\x17\x13\x4a\x4f\xc1\x08\x72\xae\xfc\xb0\xcb\x11\xaa\x99\xcc\x19\x06\x77\x9c\xd5\xed\x06\x5e\x5f\xee\x99\x3b\xa2\x9d\xa8\xdf\xf8\x49\x78\xd6\x2a\xbf\x38\x0c\xca\x44\x61\xa4\x84\x21\x2d\xc3\x0f\xe9\x7b\x0b\xc8\xd0\x10\xef\xf6\xbe\x14\x6c\x0d\x17\xe8\x9b\x2f\x80\xd7\xf0\x58\x80\x5a\x28\xfb\x60\xf9\x6c\xd1\x61\xd2\x91\x9a\xc6\x09\xb1\x5d\x70\xaf\xf8\xbe\x59\x1f\x8a\xca\x2c\xc0\xc0\x7e\xe3\xc6\xea\x6d\x0e\xf8\x52\x0b\x97\xab\x47\xd8\x23\x08\x42\xfd\xd5\x7d\x83\x3f\x83\xdf\x82\x37\x89\xa2\xfb\xfe\x99\x0e\xf1\x9a\x84\xb2\x8c\xad\xe0\x83\xdd\x6b\x8f\x2b\xd3\x65\x35\x8f\x5e\xd4\xb0\xee\xdd\x63\x0e\xb3\x0d\xaf\x1d\xcb\x24\x6a\x9c\x8f\xc0\xce\x05\x7b\xf0\x0a\x9f\xa6\x1d\xd6\xe8\x56\xf0\xf9\x4f\x7b\x76\xc3\x77\x3b\xa3\xf1\x8f\xa9\x25\xdd\x77\x5b\x96\x2a\x18\xfb\x0c\x14\xd0\x16\x01\x78\x74\xb2\x52\x51\x94\x87\xa0\x37\x45\x55\xa3\xd2\xdb\xee\x8c\xe4\xea\xea\x83\x83\x73\xfd\x71\x19\xeb\x9a\x49\xf1\x23\x6e\xd8\xb8\x67\xfc\x7e\x08\x62\xa0\x6e\xfa\xc7\x37\x7e\x19\x5a\xd9\x8d\x6a\xe9\xf1\xd4\x11\xa4\x0f\x19\x20\xb5\xcc\xd1\x6b\x44\x91\x6c\xb2\x84\xcd\x67\xc2\xf6\xa0\x97\x88\x0a\xef\x63\x83\x19\x52\x2e\x17\xa5\x84\x8c\x4f\x48\xc7\x03\x64\xb3\xc9\xf9\x40\xd9\x7d\x7a\x4f\x1f\x1a\x1d\x80\x61\x85\x3d\x20\xc6\xef\x79\xbf\x68\xb2\xe7\xe3\xdc\x35\x34\x48\xde\x66\x4e\xa9\xa0\x82\x83\x0f\x4d\x3c\xc4\x36\x75\x65\x55\xd2\x04\xd1\xd4\x45\x8e\x96\x08\x94\x91\x95\x11\x8e\x48\x65\xe7\x20\x09\x3b\xd4\xf6\x44\xb6\x7a\xec\x98\xe2\x35\x1d\x0d\xa1\x43\x33\x1b\xbc\xd4\xe0\xb4\x25\x2c\x28\x69\xf8\x28\xb1\x91\x5e\xda\x80\x01\x1b\x7e\xb2\xd4\x3b\x54\xf0\xed\x4f\x3f\xd4\xb0\x10\x1d\x1d\xa1\xe9\xc1\xf2\xf7\x6b\xe4\xa1\x5e\xde\xc7\x3e\x6a\x0a\x61\x10\x18\x66\x9d\xbf\xa7\xa3\x3f\xeb\x4e\x0c\x78\x2e\x8e\x05\x2b\x8a\x1b\x84\x80\x86\xea\x16\x1a\x6c\xec\xc6\x06\x25\x66\x45\x87\x32\x76\x54\xe0\x1a\x20\x87\x87\x2b\xb2\x2b\x23\x32\x74\xa2\xa7\xc4\xa5\x73\xf5\x72\x52\x99\xa3\xbb\x3e\x26\x35\x9e\x05\x3b\xc0\x76\x78\x14\x47\xd7\xf5\xd5\x06\xdd\x43\xc7\x8a\xf2\x7a\x9f\xbc\x3f\x6d\x6f\xce\x0e\x55\x53\xd3\x12\xa3\x52\x99\x92\x1d\xd5\xd3\xc6\xde\x8a\xd2\xcb\x7a\x84\x34\xce\x03\x3e\x28\x45\x74\x16\x9f\xeb\x07\x85\x85\xf2\xa0\xba\x5f\x02\xae\x02\x72\x52\x0c\xad\x62\x8a\x66\x27\x5d\xb8\x6d\x7b\x4c\x5e\xf6\x68\xcb\x0e\xda\x98\x9d\xed\xbb\x3c\x31\xb5\x0b\x12\x47\x80\x9a\xeb\xab\x27\x07\x0d\xf7\x5e\xa7\x3b\x8c\x3f\x8c\xc8\x76\x88\x4a\x80\x1f\x0b\x56\x51\xd7\x99\x23\x7c\x31\x60\x3e\xe7\xf8\x63\x89\x10\xd6\xbe\x6e\x10\xdb\x36\xc7\x71\x12\x32\x9a\x2e\x8a\xb6\x3c\x34\x5c\x95\x93\xbb\xf6\x38\xa1\xd2\x9c\x8a\xfd\x11\x7a\xb8\xe7\x41\x77\x85\x02\x37\x40\xd2\x7c\x0a\x83\x46\x1c\xfc\xce\x33\x6b\x33\xbe\x47\xe8\x93\x48\xf5\x0b\xc2\x41\xf6\x56\xd1\x8c\xde\x2d\xe4\x19\x6b\x93\x4a\xf2\x7e\xaf\xad\xbe\x11\xa2\xe4\xda\xf7\x0c\xcd\xcc\x81\x77\x6c\x08\x60\x7a\x17\x34\x20\x32\xe5\xe3\x43\x4f\xe2\x2b\x83\xa1\x6e\x8b\x6a\x1a\x71\xb5\xb8\xa5\xf6\x77\x37\x61\x19\xea\x3a\x90\x92\xc3\x45\x35\x64\xe9\x16\x18\xb8\x16\x70\x17\x7e\x10\x8b\x5c\x1e\x20\xf3\x23\x82\x17\xe0\x01\x7c\x59\x3e\x00\x5a\xd0\xb2\x62\xd8\x2b\xb6\x1c\xc1\x5b\xba\x16\x53\x44\x4a\x24\xbc\x44\x52\x39\x6b\xd4\x3e\x9d\x02\xd0\x8d\x9c\x1a\x88\x52\x6b\xda\x81\xb9\x49\x5f\xac\xea\x70\x51\x57\x16\x55\x2c\xbb\x63\x04\x96\xb1\x41\xc9\x3e\x08\x72\x03\x7b\x1b\xa1\xa9\xa4\x33\xa8\x96\x16\xba\xed\x2a\xf3\x06\x45\x12\x47\xd5\xa4\xe4\xd7\x7c\x0c\x00\x00\x20\x2e\x2c\x23\x54\x21\xa0\xeb\x27\x27\x09\x06\x0e\x0b\x00\x00\x00\xac\x28\xc9\x69\x01\x00\x00\x00\x00\x00\x00\x00\x00\x10\x03\xef\x01\x7b\x23\x07\x1b\x27\x7b\x03\x23\x1d\x5b\x3d\x03\x0b\x5a\x3d\x6b\x33\x2b\x3d\x47\x33\x1b\x6b\x1d\x03\x1b\x6b\x47\x7b\x1b\x4b\x4b\x23\x7b\x07\x5a\x65\x25\x18\x00\xac\xf3\x6d\xca\xbc\xf3\x6d\xca\xdc\xc9\x15\x0e\x00\x01\x07\x00\x00\x00\x07\x00\x00\x90\x93\x04\x03\x47\x03\x00\x00\x1a\x58\x51\x92\xd3\x22\x01\x2e\x0c\x40\x40\x00\x60\x18\x14\x00\x04\x81\xf7\x80\xbd\x91\x83\x8d\x93\xbd\x81\x91\x8e\xad\x9e\x81\x05\xad\x9e\xb5\x99\x95\x9e\xa3\x99\x8d\xb5\x8e\x81\x8d\xb5\xa3\xbd\x8d\xa5\xa5\x91\xbd\x03\xad\x81\xa9\x91\x83\x23\x8d\x9e\xb5\x99\x95\x9e\xa3\x99\x8d\xb5\x8e\x81\x8d\xb5\xa3\xbd\x8d\xa5\xa5\x91\x3d\x8d\xb9\x83\x8d\xb5\xb2\x12\x0c\x00\xd6\xf9\x36\x65\xde\xf9\x36\x65\xee\xe4\x0a\x07\x80\x80\x03\x00\x00\x80\x03\x00\x00\x14\xc6\x75\x99\x83\x11\x22\x07\x9d\x3f\xa3\xa1\x22\xe5\x06\x12\x88\x47\x07\x72\x89\x93\x33\x44\x7e\x82\xb7\xab\xff\xb1\x18\x18\x73\xd2\xaa\xba\xea\x3c\x7f\x53\x05\xee\xae\xae\xfa\xd3\xe6\x65\xff\xc3\x77\x16\x60\xeb\x40\x30\xbc\xcc\x1d\x95\xc8\x8d\x54\x9b\xff\x64\xc7\x9a\xb2\x51\x3f\x5e\xd9\x31\x61\x96\xf8\xab\xbf\xcb\xfb\xef\xf5\xa1\x92\x93\xcc\xa1\xe2\x59\x48\xbb\x42\x12\xfa\x33\x32\x62\x7e\x83\x28\xa8\x9f\xd1\xb1\x17\x0d\xc1\xac\xa2\x16\xd4\x69\x10\x03\xc9\x24\xca\xcc\x75\x83\x8e\x7a\x5a\x72\x23\x3a\x94\x61\x57\xec\xf2\xab\x1b\xfa\x6c\x6e\x7f\xa4\xda\xc6\xa2\x29\x1f\x7b\xd8\x93\xda\x41\x21\xfe\x38\xf3\x52\xf4\xb0\xe3\xc3\x3c\xe4\xaf\x75\x74\xa2\x7f\xde\x86\x38\x66\x2e\x73\x87\x0f\xf8\x30\x27\x66\xd8\xdf\xa3\x4e\x5f\x6f\x9c\x1b\x7a\xf8\x25\x65\xf9\xb1\xed\x8b\x1e\xd6\xc7\xab\x68\xe8\xeb\xc1\xbe\x19\xac\x6d\x34\xcf\xfd\xba\xb0\xec\x7e\xd3\x85\x73\xc2\xb6\xf0\x77\xa6\xb0\x25\x91\x7d\xe1\x53\xe6\xf0\x49\x57\x3c\x3d\xa7\x90\xbc\x3f\xa8\x83\x6a\x29\x16\xf1\xf6\x8f\x92\x35\x5b\xe5\x9e\x26\xc5\xd2\xdc\xc7\xd6\x73\xb1\xd3\x6c\xe4\x24\xc1\xc0\xd1\x00\x00\x80\x06\x56\x94\xe4\xb4\xe4\xbc\x82\xbc\x44\x41\x00\x20\x06\x14\x00\x70\xff\xa9\x62\xce\x4a\xcf\xda\xcc\xd8\xc8\xc1\x91\xc6\xdc\xc1\xc6\x5a\x59\x09\x06\x00\xeb\x7c\x9b\x32\xef\x7c\x9b\x32\x77\x72\x85\x03\x40\xc0\x01\x00\x00\xc0\x01\x00\x00\xbc\x62\xe5\x6d\xfc\x09\xd0\x02\xd7\x9f\x29\xb1\x26\xfa\xd0\x12\x37\xef\x5a\x4e\xbc\xa2\x25\x93\x8a\x9a\xbb\x95\xc5\xc1\x71\x7b\x69\x24\x67\x7e\xf2\xd4\xfd\x58\xa2\x5a\x0c\xef\xde\xb4\x3d\x1f\x68\xde\x47\x20\xac\x3b\xfa\x1e\x68\xcb\x96\x7b\x2e\xbd\x7f\x40\x29\xde\x98\x6f\x49\xef\x13\x04\xc8\xb3\x0d\x78\x29\xe9\x42\x4b\xef\x3f\x93\x79\x4a\x0b\x24\x30\xb9\x7c\x23\x37\xe7\x38\xbd\x5f\x2e\xad\x14\x09\xc6\x07\x3d\xea\x69\x84\x19\xb3\xe7\xcd\x4a\x58\x3b\xc0\x51\x85\xc8\x4b\xfa\xc4\x30\x64\xef\x8e\x6a\xcd\xdc\x3f\x3d\xc9\x1a\x3d\xae\xd5\xed\x46\x84\xb9\x7f\xd3\xd1\x7b\x6e\xb4\x65\x85\xcc\xf4\xc4\x59\x0e\xc6\xe9\x9c\x97\xbd\xb3\xda\x07\x76\x35\xa7\xfb\xe1\x00\x41\xde\xa0\xf2\x3b\x7b\xd0\xb1\xff\x61\xf0\xb9\x39\xd2\x9c\xc0\x22\x51\xd7\x50\x85\xc8\x73\x92\x2b\x57\x43\x4e\x44\xe9\x10\x4c\x86\x99\x06\x41\x66\x4f\x71\x73\x04\xf3\x99\x4f\x05\x0b\xa2\xea\xe5\x58\xaf\xa7\xb7\x44\x4b\x59\xcd\xaa\xa0\x5c\x8f\xee\xcd\x42\x96\x3f\x86\x0f\x81\x5a\x57\x78\xd8\xee\x7b\x09\x95\x24\x06\x23\x23\x48\x06\xc5\x3c\x64\xe1\xac\x31\xea\x9f\x87\xb5\x1a\x18\xe9\xec\x39\x16\x4c\x0d\x33\x82\xe1\xf7\x5d\xd4\xd7\xd0\xbc\x03\xe4\x1f\xa3\xbd\x58\x5d\xd1\xa9\x25\x5f\x18\xd3\x5d\xc7\x1d\x94\xc5\xbb\xbe\xed\x67\xd4\x6a\x52\x8c\x69\xb3\xa9\x4a\x15\x24\x4e\x2b\x84\xc1\x06\x7e\x5f\x7f\x92\x6c\xdc\xd2\x40\x19\x8f\x38\xa6\x3f\x63\x4d\xd6\xf9\x6d\x05\xc0\x7f\x57\x20\xfe\xf2\x89\xfe\x4f\x15\x00\x56\x36\x86\x46\x96\x0e\xff\xcb\xa2\xc6\xf7\xbf\x07\xa7\x6f\x69\x63\x60\xe1\xf0\x27\xea\x1b\x67\x9f\x82\x28\x8f\x40\x00\x20\x05\x06\x00\x3e\xfd\x07\xd4\xbf\x55\x39\xfd\xe7\xf2\xa6\xc4\xd5\x28\x3f\x3a\xe4\xc0\x73\xaf\xb0\x20\x0b\xe2\x84\xc4\xbe\x07\x8a\x00\xac\x04\xfe\xf4\xb2\xd9\x3a\x61\x97\xf9\x87\xb3\x65\x95\xce\x2b\x45\xe1\xb9\xf4\x20\x67\xc4\x0f\x8c\x33\x33\xf6\xa9\x84\x3b\x3b\x09\x78\x0a\xd5\x3c\x20\xdb\x01\x18\xe3\xb0\xec\x0b\x70\x55\xfb\x9c\x15\x61\xf8\x62\x20\x1a\x49\x7b\xfa\xf5\x60\xee\x40\x97\x77\x01\x51\x12\x4a\x00\x8d\x7b\x42\x1a\xfc\x43\x52\x6c\x7e\x53\x9b\x36\xed\x08\x30\x94\x83\x4c\x4c\xda\x89\xd6\x00\xe9\xba\xcc\x6d\x9b\x7b\x99\x28\xd3\xd9\x48\x86\x5d\x36\x41\x8c\xea\x41\xde\x97\x0d\xd6\xc5\x1d\xb3\xaa\xed\x98\x4f\xda\x3d\xb4\x07\x37\x87\xfb\x19\x03\xdb\x06\xda\x57\x6b\xda\x16\x94\xb6\xae\xd4\x34\xcb\x41\xee\xdb\x82\x4b\xec\xb9\x70\xee\xfb\x78\x09\xaf\x72\x1d\x07\x96\x3a\x60\x8d\xa9\xd7\x32\x0c\x11\xf3\x10\x44\x69\x57\xc2\x78\x96\xf2\xa3\xa8\x7d\x1e\x82\x56\xae\xc6\x94\x55\xf3\xa1\x6e\xab\x29\x75\x7e\xc4\x49\x88\xb9\x1b\x57\xb4\x52\x9c\x6f\xf8\x9e\x7c\x78\x81\x51\xc6\xcb\x37\x2a\xfd\xef\xb6\x28\x49\x1f\x7a\xc2\xdb\x8b\xb8\xea\x9d\xcf\xb4\x41\x8c\x5a\xb2\x93\x25\xde\x7d\x19\x2c\xca\x2d\xc4\x0c\x08\x2d\x4d\x9a\x63\xd6\xac\x1c\x89\xfa\x74\x7f\x86\x85\x25\xd6\x4c\x47\xfe\x06\x0e\x59\x6c\xc4\x89\x7e\x22\x3b\xbc\x0b\x7f\xaa\x99\x07\x13\x71\xf5\x90\x41\x37\x0f\xcd\x8c\xc8\xc8\xe0\x4e\x23\x92\x38\x03\xea\xc4\x76\xda\xcc\x2e\x99\x66\xb2\xbd\xad\xe2\x02\x89\xe7\x51\x24\x95\xb5\xb2\x3f\xd7\xb7\xe4\x38\x85\xbb\x12\x2e\xba\xc8\xea\x19\xec\xa1\xfc\x11\x8a\x05\xb7\x74\x3c\xda\xe9\x7c\x04\x3e\xdc\x43\x67\x0f\xbe\xe9\xde\x48\x64\xa2\xcd\xab\x9b\xe9\x9a\x67\x93\xeb\xae\x82\x73\x89\x46\xed\x03\x6a\xa8\xd6\xd2\x2a\x51\xcf\x51\xcb\x73\xb0\x6a\x01\xa1\xa0\x57\xa9\xb3\xc5\x74\xe1\xd6\x94\x0a\x36\x31\xb8\xb3\xd0\x76\x82\x36\x34\xf3\x2a\x8b\x02\xae\xca\x2a\x6b\xce\xf0\x5c\x91\x81\x52\xc1\x8f\x68\x5c\xda\xd7\x2c\xd3\x08\x88\xea\x36\x79\xa4\xe1\xef\xa9\x93\xda\x06\xaf\x13\x5e\x2a\xba\x6d\xd2\x77\x2a\x58\x24\x90\x53\x4d\xef\x5a\xef\x2a\xe2\xaa\x5d\xf5\xce\xd6\xa3\x96\x12\x5a\xdd\x8c\x2b\xbe\xaa\x33\xb4\x8e\xbf\xfd\x6f\xe6\xa7\x91\xb5\xa3\x99\xa3\xdb\x9f\x98\x9f\xa6\x57\x5f\x2c\x7a\x41\x00\x40\x02\x0a\x00\xa8\xff\x03\xea\xdf\xe6\xa7\xce\xdf\xff\xf2\x1f\xa7\x69\x61\xc2\xaf\xa7\x29\xf5\x91\xbe\x27\xba\x00\xf4\x60\x8e\xb4\x8a\xf9\x92\xdf\x31\xce\xba\xd7\x32\xac\xda\x64\x9e\x9d\x8d\xd8\x12\xfb\x2b\x07\x6f\xb0\xb6\x31\xb4\x1b\xc9\x76\xb0\x54\x11\x82\x2d\xbf\xfa\xae\x55\x8c\xa8\x5f\x0c\xf8\xc2\xb5\xd3\x0d\x1f\xe5\xc2\x55\x59\x42\x3a\x5e\x15\x98\x98\xb7\x4a\x98\x9a\x30\x72\x4b\x4a\xe5\x34\x1a\x41\x43\x06\xfe\xee\xa9\x6b\x63\x39\x68\x9d\x8f\xc4\x74\x1c\xe8\xfc\x02\x22\xb2\xe5\x3d\x81\xe1\x37\xc1\x2b\x4b\x6b\xb3\xdd\x77\xde\x86\x63\x84\xdc\x53\x02\x67\x3f\x60\xae\x6e\x89\x9b\xa0\x5a\x96\x47\xec\xea\x72\xfd\xf6\xd6\x63\xf6\xca\x2a\x60\xa7\x50\x31\xac\xc5\x27\xd4\xc1\x29\x1b\xc3\x12\x0b\x81\xf2\xb0\xea\x68\x25\x1d\x15\x9e\x6e\xf1\xd2\x76\xe7\x81\xef\x19\xca\x04\x4f\xed\x0b\x6d\xf4\x9b\x14\x9c\x3e\xd6\x3e\x49\xea\xb7\xdf\x06\x63\x59\xca\xf2\x27\x30\x31\x96\xf3\x64\x3b\xbb\x51\xa8\xb7\xbf\x95\x54\xda\xc0\x7a\xa1\x91\xf8\x1f\x34\x8c\x8b\x42\x44\x9c\x33\x33\xf4\x1f\x46\x1d\x61\x0f\x5c\x8b\xe9\x81\xe6\xd5\xe0\xc7\x45\x48\x4d\xea\x64\xea\x4b\x25\xf1\x28\x20\x7c\x1c\x11\x15\x5f\x85\xcf\xb0\xaf\x8c\xb2\x63\x6c\x92\xa4\x0c\xbd\x75\xbc\x09\x79\xa7\x73\x5e\x80\xb7\x64\x79\xec\x46\xec\x9c\xef\x0e\x6f\x28\xb0\x84\xf6\x02\x22\x58\xe5\x9e\x02\xdb\xf5\x61\xd7\xde\x4f\x8f\x66\x0a\x2a\xe2\xe9\x21\x8e\x8e\x15\xfa\xd5\x2b\x8a\x6c\xa1\xc4\xbd\x38\x0d\xc4\x24\x00\xbb\x42\xb4\x51\xaa\x59\x18\x1b\x02\xb7\xb1\x6c\xd5\x5a\xbe\x42\xc9\x9b\xf0\xe7\x05\x6c\xfb\x6b\x76\x4b\x78\x4f\x9a\x54\xc9\x8e\x55\x29\x17\x49\x80\xb5\xd0\x79\x42\x30\xf2\x51\xab\x55\xe1\x9d\xa6\xd3\x07\xbe\xf4\x72\xc9\x81\xd4\xd1\xa5\xe2\xa4\xb2\x4b\xff\x03\x3c\xb8\x77\x2e\xf9\x9f\x7e\x99\x7b\x5a\x1f\x11\x89\xae\xb9\xda\x29\xaa\x70\xe3\x5b\xd1\x55\x42\xf5\x6e\xb4\x43\x0d\x3c\x1a\x87\xd8\xbe\xd3\x0d\x34\xea\x58\x2d\xad\xcf\x0d\xbb\x27\x9b\xdc\x45\x69\x93\xd4\x1e\x9f\x20\xfd\xfe\x04\x24\xfc\xa7\xa9\x62\x6f\x64\x6d\x68\x64\xaf\x63\x60\x63\xed\x68\x6f\x63\x69\x69\x64\xff\x67\xb2\x64\x13\xb7\xab\x64\x38\x00\x00\xfb\x00\x00\x50\xfe\x11\xe8\x3f\x96\x2a\x85\xc7\x39\x61\x7b\xe8\xe0\x21\x37\x56\x8b\xe9\x70\x04\xe9\x86\x96\xe0\x1c\x8f\xe6\xca\x03\x74\x71\x0a\xc1\xdf\xe3\x2a\x15\x16\x60\xad\xb7\xd9\xe3\x9f\x11\x54\xdd\x7c\x79\x17\x25\x61\x81\x05\x36\x96\xf4\x8c\x34\xed\x01\x8b\x4f\x83\xf3\x79\x86\xa5\xc8\x97\xa3\x38\xc0\x9b\xef\x28\xbb\x8b\x11\x62\x1f\x3c\x34\x24\x4e\xec\xee\x43\x5a\x66\x2a\x88\x68\x2b\x1b\x8d\xd0\x24\x5c\x4f\x84\xd4\xba\xe7\x83\xfb\xf7\xf0\x52\x59\x12\x5e\xbe\xb4\xb2\x91\xf9\x0f\xf2\x82\x89\x8f\xa7\x58\x18\x15\x8a\xaa\x2c\x58\xaa\xda\xd0\x8d\x10\x4d\xe7\xd2\xbe\xab\x45\x71\xc3\x89\xb3\xff\x66\x28\xd1\xfe\xc9\x5e\x47\x23\x57\xc7\x3f\x33\x7a\x75\xcf\x32\x23\xe2\x00\x00\x7c\xf9\x97\xd5\x79\x7f\x47\x33\xb2\xd6\x51\x56\xa4\xb1\xd4\xfb\xf7\xd5\x70\x9c\x75\x5c\xd7\x93\x57\x79\x82\x23\xb7\x88\xd4\x13\xbc\x75\x5c\xb7\x93\x57\x68\x1a\xfb\x79\xc3\x5b\x71\xbd\xef\x21\xf6\x23\x66\xb7\xde\x55\x84\x3d\xcc\x7e\x4f\x98\xcd\x7b\x30\x44\x96\x45\x0a\x34\xc1\xcd\xdd\x80\xdd\xe2\x57\x4e\x32\xdc\x2b\xc8\xa3\xa8\x12\xf8\x91\x91\x9c\x92\x1e\x56\x04\xf5\x0f\x97\xd6\x79\x62\x3d\xe9\x5f\x8c\x87\x05\xfe\x55\x7d\xef\xdf\x4f\xf0\x97\x53\x73\xd2\x33\x31\xfa\xcf\x25\x84\x1a\x44\x7f\x33\x86\x48\x0b\xf6\xf7\xe7\x27\xf6\x3f\xb1\xe8\x59\x9b\x59\xe9\x39\x9a\xd9\x58\xff\x99\x91\xcd\xb4\xee\xba\x11\x04\x01\x00\x49\x88\x7f\x95\x1d\x7f\x05\xf9\xf7\xf9\xf8\x3f\x3f\xf8\x8f\x36\xfc\x88\x5f\xb6\x0e\x20\x40\x0e\xaa\xbc\x25\x67\xd1\xd4\xe8\xe6\x07\xbf\x57\x4e\x0b\x5a\x48\x2c\xff\x54\x81\x65\x78\x6f\xbc\xdd\x8a\x19\xcd\x8c\xde\x78\x2a\x3c\xc3\x44\x48\x3c\xfa\x93\x31\x75\x13\xf7\x4c\x0f\x18\x5f\xd2\x64\xa0\xb4\x19\xae\x2b\xac\xe8\xba\x04\xe8\xd0\x90\x7d\x07\xcd\xd4\xb5\x38\xc6\x02\x35\x30\x3b\x82\xbb\x28\x40\x65\x03\xcd\xa9\x67\x23\xbf\x91\x52\x03\x89\x73\xd2\xd9\x39\x93\xca\x34\x4e\xd4\xa5\x65\xf5\x57\x96\x35\xe1\x47\xb5\xe5\x36\x23\x12\x3f\x6c\x1a\x6c\x8d\xc1\x51\xbb\x45\x94\x5b\x71\xa4\x52\x29\x17\xb1\x9c\x28\x6f\xeb\x13\xc3\xd7\xb1\xcb\x9b\xbf\x4d\xdd\x7f\xb3\x60\xc6\x64\xc2\x1b\xb2\x87\xf7\x99\x72\x6e\x67\x0c\x04\xf5\xd1\x14\x1c\xe8\x61\x0c\x43\x7c\x75\x0f\x55\xb9\xc4\xba\xd6\x76\xd7\x47\x36\x48\x53\x88\x16\x94\xe3\x60\xa3\x0b\x86\x47\x8d\xb3\xa4\x4e\xa9\xea\xe2\x37\x08\x51\x13\xe0\x46\xdc\xaa\x81\xc7\x88\xd2\xc5\x99\x21\xbe\x53\xeb\x7f\xef\xd7\xa3\x66\x7b\xc6\x58\xa0\x58\x62\x06\x1b\x28\x86\xbc\xfe\x99\x2c\xd3\x42\xd8\x52\x41\xb4\x1a\x2e\xb9\x6d\xbd\x65\x10\xea\x5a\xe9\x50\xe5\xf5\x43\x5a\xe9\x27\x2f\xbe\x7b\x1d\x33\xc2\x96\x9f\x73\x8c\x53\x50\x4d\xc6\x79\x48\x39\xb5\xb4\x7b\xc6\x84\x2d\x9c\x9e\x8c\x72\x37\x0e\x69\xaf\xf3\x4a\x8f\x26\x19\x1f\x90\xce\xfb\xee\x2d\x2e\x58\x3e\x3e\x66\xaa\x8f\x1a\xa5\xaa\xa6\x87\xbe\xd4\x62\xc4\x74\xbc\x4d\x96\x9f\xe1\xfd\x99\xc5\xe0\x9f\xbe\x2c\xb6\x5d\xf8\x71\xd0\x82\x00\x80\x3b\xd8\xbf\x4a\x48\xff\x05\xe7\xaa\xf3\x5f\x89\xe9\x17\xe7\xeb\x38\xda\xd8\xfe\x47\xb7\x47\xc6\xad\x46\xf9\x13\xfc\xfd\xa2\x78\x1c\x64\x7d\xce\xd2\x3e\x6c\x2c\x48\x16\x95\x48\xe5\xe7\x6b\x66\x30\xf3\x70\xc6\x19\x92\xf7\x4a\x7f\x18\x18\xca\x60\xd2\xce\x0e\xb5\xaf\x7d\xe2\x5c\xe6\xdc\x9e\x48\x56\xb1\xe1\xef\x9c\x94\xc8\xe9\xbc\xb3\xfe\x12\x7e\x6a\x50\xfd\x03\x23\x21\x99\x39\x69\xd8\xb4\x8a\xec\x60\xca\xf5\xe3\x8f\xcc\x61\xe2\xaf\x04\x64\x95\x9c\x52\xe5\x87\x31\x51\x4e\xe8\x81\xf5\x90\x1f\x7c\xb9\xeb\x95\x37\x02\xcd\x09\x74\x1f\x2f\x48\x26\x9d\xb6\xbf\x56\x47\x46\xf8\xcc\x4f\x07\xa0\x5b\xbc\x57\x18\x09\xef\x0a\x91\xb8\x0e\x64\xd2\x12\x5c\xf2\xb1\x54\x40\x48\xf5\x9d\xdc\xee\xeb\x61\xd1\xc7\x78\x2f\xd3\x5f\xd2\x61\x7b\x20\xca\x99\x8f\xbb\xb6\x78\x4d\x29\xff\x59\xba\x9f\x6c\x13\x55\x51\x15\x92\x88\x6e\xde\xcd\xaf\x09\x91\x4a\x89\x5d\x79\x74\xb7\xe2\x03\xc5\xf6\x50\x92\x7d\xb9\x62\x7f\x60\xe3\xa0\x92\xab\x8a\x8a\xfe\xc8\x49\xcb\xf7\x4c\x4d\x32\x93\x29\x4e\x03\x53\xc9\x90\xab\xf8\xcb\x11\xa7\x32\x1a\x43\x94\x6b\xa3\x0c\x11\x53\x75\x15\xa4\xe7\xfb\x90\xc7\x22\x1e\x7e\x29\x9b\x8e\xc5\x33\x6c\x4d\xb8\x03\x9b\xf4\x0e\x6f\x8c\x82\x93\x6b\x86\x8e\xde\xbb\x19\x3f\x1e\x63\x6d\x0f\x09\x58\x9e\x7c\x4c\x38\xdd\xdd\x21\x9f\x98\x10\x05\xe6\x4b\xe6\x86\xb9\xba\x29\xb9\xd1\xac\x60\x7d\xb2\x37\x90\xdf\x8f\x6f\xcc\x7f\xf2\xc9\x2f\x59\xc4\xc9\xde\xe8\xcf\x44\xb7\x10\x75\x8f\x74\x2c\x00\x00\x0c\x20\xff\xca\xc9\xff\x03\xe8\x68\x64\x6f\xaf\x67\x66\xad\xf3\x5f\x3f\xf8\x8f\x4e\xce\x8c\xe2\x47\xec\x26\x40\x16\xbe\xcf\xf0\xab\xb2\x3e\xe9\x0f\xbc\xa4\xe8\x8f\x41\xf9\x24\x21\xe1\x0b\xaf\x9b\xc6\xd4\xd6\xea\xf3\xd9\x6a\xa5\x59\xf7\x92\xf7\xbc\x63\x74\x94\x0d\x93\x26\xda\x9e\x89\xff\xf3\xf7\xfc\xc8\xad\xc1\x7b\xad\x72\x30\x53\xab\x95\xfa\x1d\xc1\x91\x80\x29\x13\x05\x32\xb0\xaf\xfc\x1c\x58\xd5\x9e\xa1\x30\x99\x22\x0a\xac\x11\xe2\x4c\x54\x9b\x09\x0c\x9b\xb0\x7c\x35\xc8\x2e\x30\xa1\x9d\x4d\xd4\x79\x84\xfc\xfc\x7b\xd3\x97\xe5\x87\x5a\xde\x1b\xee\xf3\x66\x08\xaf\x7d\xee\xcc\xbd\x6f\x1e\x0e\xd6\xe1\x85\xcf\xd5\xe2\x1f\x26\x5f\xf3\x3d\x11\x75\xb4\x55\xd6\xbb\xf1\x7f\x7f\x18\xff\xf5\xd5\xe2\x6f\x56\xff\xc1\x3b\x9d\xff\x87\x5b\x15\x84\x61\xbd\x09\x06\x00\x9b\x60\xff\x2a\x57\xfe\x23\xae\xeb\xdf\x42\xe7\xef\x69\xf3\x7f\x57\xa2\xcd\xf7\xb7\x12\xed\x6f\x66\x76\x6b\xff\xa2\x2a\xfb\xd7\x25\xdb\x60\xe1\xbf\x54\x63\xbb\x2e\x9d\xaa\x77\x58\xa0\xf3\x23\x7b\x43\xbf\xa3\x44\x51\x22\x26\x92\xf7\x8c\x03\x8f\x8c\x0b\x21\x03\x81\x11\x80\xfd\xc2\x85\x0b\x01\xc7\xcf\x3f\x3b\xac\x1a\x42\x80\xf1\x06\x93\x94\xf4\xe4\xf2\x75\xe1\x01\xa9\x60\x6a\x5c\x64\x79\xb6\x81\xa8\xcc\x1b\x6a\x7d\xdb\x21\xa5\xad\xd1\x65\x60\x73\xb5\x19\xac\x47\x66\xcf\xf9\xde\xb8\x3d\x73\x31\xd5\x03\x3b\xf2\x6d\x72\xe2\xc3\x79\xf7\x59\xf2\x69\xa7\x5d\x02\x9f\xa4\xc5\x7e\xf7\x96\x6b\xfd\xbb\x79\x75\x41\xfc\x7a\xe1\x23\x57\x74\x64\xdd\xd9\xe1\xc2\x31\xdb\xfb\xc5\x7c\x7d\xcd\xe5\x96\xab\xe4\xef\x4e\xcf\xc6\x84\xfe\xa4\xd1\xe7\xe8\x1f\x33\x9b\x6d\xbd\x14\x46\xfb\x52\x88\x1a\x61\x3b\xf7\xa3\x8b\xbb\xcf\xe6\x31\x41\xf2\x87\xc9\x81\xc9\xea\xdb\xd3\x52\x88\x1e\x3b\x3a\x7e\x39\x6e\x80\x10\xb0\xa5\x22\xc1\xa1\x83\x72\xd0\x80\xd8\x78\x3d\xfe\x71\xe1\xb5\xaf\xd2\xf9\xd5\x1e\x16\x70\x7c\x57\x5c\x30\xae\x20\xfa\x89\x0c\x07\xa4\x24\x77\xdd\x1d\xbc\x73\x51\xd6\x26\xc9\x04\x90\x93\x91\x06\x39\x2e\x7b\xc5\x1d\x55\x3a\x20\x06\x9c\x60\x44\xe8\x27\x46\xf2\x80\x8f\xe7\xb0\xd2\xbe\x4a\xc0\x67\x59\xdf\x4f\xc0\xa4\x3c\x5e\xe2\xe9\x2a\x5d\x13\xf4\xc6\xa1\x46\x30\x35\x56\x84\x5f\x17\x88\xcf\x45\x84\x56\x00\x0b\x85\x25\x3a\x96\x12\xec\x45\x51\x63\xb1\x8c\x1c\x64\x36\xe8\xe7\xb3\xef\xdf\x26\x0f\x19\x16\xea\x60\x4d\xbf\x5a\x80\x15\xa0\xfb\xac\xea\x92\xe2\xe6\x16\xa1\x00\x03\x07\x5c\x23\x79\x0d\x7d\x4b\x34\x2e\x35\xe8\x15\x92\xfe\x8f\x58\xdf\x8e\xc5\x74\xe6\x48\x02\x19\x7a\x22\x9b\x9f\x6f\x7e\x8c\xa4\x5a\xde\xba\xfb\x2e\x36\x8b\x41\xd9\x20\x1d\x28\xce\x2f\x72\x3b\xe2\xdd\xcc\xf2\xcf\x35\x2f\x05\x1d\xb7\x10\x03\xfd\xc7\xfb\xe1\x63\x3e\x58\x46\x11\xba\x3b\xf5\x3f\x66\x72\x16\x1c\x5a\x3f\xa8\xcf\xf7\xad\xed\x1f\xde\x0a\x39\xf1\x7a\xb1\x2e\x68\xa8\xc2\x00\xab\x9a\xac\x94\x60\x9d\x15\x94\x8c\x34\x90\x9a\x11\xf4\xc5\xf0\xc0\x48\x3d\xb0\x50\xfb\x53\x0e\x8b\xa1\xd1\xd9\xed\x0d\x4e\x14\x03\x23\x3a\x1a\x23\x2a\xeb\x40\x04\x3b\x40\x1e\x4a\x36\x0a\x6e\xcd\xe3\x61\x21\xa2\x44\x71\x8f\x91\xa3\xc7\xad\xa5\x48\xca\xfa\x96\x38\x1d\x34\x67\x7c\xc2\x00\xd4\xc7\x69\x04\x93\x55\xb3\xea\xeb\x40\x93\x93\x81\xc2\xa0\x3f\xe0\x13\x45\x07\xba\x91\xe3\x72\xf4\x0d\x0a\xf0\x0f\x05\xe0\xf8\x46\xcd\x64\xd7\x29\x01\xdd\x3a\x8e\x1d\x10\xdd\x1e\x7c\x52\x3c\xc9\x50\xed\x42\xc0\x41\xa5\x87\x95\x04\xc2\xd1\xba\x92\x96\xdf\xa9\x00\x16\x68\x93\x06\xa1\x9d\x57\x54\xe4\xeb\xe1\xc1\x78\xcb\xac\x04\x55\x96\xd0\xcd\xe4\xc9\x49\x46\x99\x44\x03\x68\xf1\x20\x55\x21\xeb\xa7\xaa\xd1\x7d\xbe\x94\x56\xcc\x00\x09\xf5\xed\xda\xec\xb8\x4a\x94\x19\x1d\x47\x41\x17\xec\x11\xe2\x50\xfd\x26\xe1\x72\xe1\x91\x2a\x03\x63\xca\xa2\x6e\xeb\x87\x8c\x10\x1a\x6d\x6d\xe0\x06\xcf\x1a\x77\x6b\xfb\x8b\x3b\x91\x89\x80\x9c\x41\x9b\xbc\x3a\x82\x42\x3e\x8d\xec\x87\x80\x44\x8b\x74\x08\xc1\x06\x0b\x45\xe0\x42\x04\xe9\x22\xc5\x2b\x12\xa4\x4a\xa4\x8f\xa5\xe8\xa3\xfb\x15\x67\x47\x7b\x20\xe7\xcc\xf3\xe6\x60\xbf\x82\xeb\xc3\x9d\x78\x6e\x38\x88\xc9\xc7\x5e\x0f\x55\xf8\x8b\x04\xe6\x44\x10\x32\x46\x32\x48\x1f\x8e\x68\xc5\x2f\x6f\x7a\x1c\x60\x48\x0c\x8e\x69\x88\x59\x1f\x75\x1b\xc6\xe8\x83\xa9\x36\xb2\xac\xca\xb0\x58\xec\xe3\x40\xf8\x56\x77\xf5\x87\xaf\xcf\x00\x9e\x74\x28\x9f\x24\x46\x24\x80\x85\xb7\x04\xb0\x9d\x8d\x83\x41\x2e\x6f\x1b\x02\x5c\x75\x4c\x60\x21\x59\x0b\xd6\xe0\x8c\xd8\xc2\xaa\x44\x1f\x96\xd1\x7c\x72\x8d\x9a\xcb\xce\xe5\x38\xbf\x27\x50\xd6\xc6\xfb\x1c\x3e\xc7\x81\xa4\xdc\x97\x46\x9c\x8e\x1d\x42\x47\x02\xcb\x92\x72\xb2\x0b\x59\x86\x65\x8f\x53\x8e\x64\x16\xb9\x4e\x3a\x94\xaa\x4f\x24\x25\xd6\x26\xc6\x8a\xc1\x83\xb5\xf0\x57\x68\xdf\xa1\xc9\x9e\xc1\x55\xa0\x4e\x9f\xf5\x27\x70\x9d\x62\x1e\x7f\x82\xa6\x32\x18\x0d\x27\x90\x2b\x16\x49\xa5\x32\x18\x0d\x27\x90\x2b\x16\x49\xa5\x32\x18\x0d\xdf\x7c\x98\xb2\x29\x4e\x27\x40\x56\xbb\xdb\xa1\x00\x00\x00\x10\x17\x96\x11\xaa\x10\xd0\xf5\xfb\x6d\x7e\xf2\x76\xad\x34\x8c\x03\x03\x80\x5f\x0e\x86\x3f\x93\x47\x74\x6c\x6c\x8d\xac\xff\x7f\x49\x26\x0e\x02\xe2\x4a\xd0\xd0\xd0\xd0\x9e\xd0\x86\x21\x00\x00\x26\xfe\xb7\xfc\x91\x71\x9a\x32\x21\x8b\xca\x8f\x36\xd0\xb1\x1a\x67\x09\x76\xc8\x77\xa8\x82\x2c\x96\x2e\x62\x90\x16\x13\x82\x29\xc0\x36\xb2\xde\xf9\x65\xbb\x23\xe7\x72\x62\x32\x1e\x73\xbb\xcb\x1f\x0a\xf1\x7d\xd3\xa0\xef\x82\xed\x22\x9f\x1c\xd3\xaa\x02\xf6\x74\x10\xb3\xaf\x3b\x99\x0d\x27\x67\xa6\xfa\xe7\xa3\x39\xb2\x73\x87\xc5\x45\xd6\x8e\xb5\xef\x6e\x36\xfb\x63\xa0\x80\x77\x66\xc7\x79\xd6\x19\xef\xb9\x9f\x1e\x56\xd0\xff\xfb\xf3\xe6\xc1\xe0\x72\x7a\x00\x6d\x93\xd7\x31\x59\x61\x64\x6b\xa2\x15\x9f\x82\x53\xec\xd0\x95\x0e\x01\x0c\x86\xd3\xc5\x85\x7e\xe7\x9c\x8c\x3a\xaa\xec\x96\x04\xf8\xe6\xc6\xf8\x77\x56\xc5\xbc\x35\x52\x9f\x0a\xbb\xd4\x20\x81\xad\x70\xd6\xb6\x74\x64\x37\x4c\x61\x59\x20\x86\x1e\xdb\x47\x4c\xfb\x06\x1c\xa3\x8d\xe4\xfd\xec\xb1\x69\xa1\x29\x40\x5a\x1f\xcb\xac\x34\x41\xf0\x71\x28\x7c\xf2\xf3\xdd\xab\x48\x99\xd5\x67\xa9\x51\x80\x63\x2c\xa7\xe3\xb9\x4d\xeb\x43\xc2\x17\xa4\xf6\xc2\x8a\x81\x05\xc2\x1c\xc5\xf2\x24\x80\x60\x89\x1f\x75\x07\xe1\x46\xf3\x26\x09\xb8\xc1\x94\xbd\x32\x26\x20\xbc\x3a\xeb\x40\x05\x4d\x3f\xb6\x30\x85\x7c\xd7\xbe\xde\xb9\xf7\x94\x56\x9f\xf9\x1d\x5a\xfe\x46\x68\xf4\xd4\xab\x43\x0f\x21\x98\xe9\x71\x9b\xd0\x16\x33\xd0\xaa\xa2\x22\x48\xe4\x73\x04\x3a\xe8\x93\xbe\xcb\xfd\x5d\x3e\xf2\xc2\xea\x72\xe3\x1d\xaa\xdb\xfc\xd1\x9d\xb9\xdb\x94\x86\x00\x63\x4c\x49\x50\xa7\x5a\xa8\xdc\xdc\x58\xe4\xac\xc9\x2c\x57\xce\x67\xea\xae\x7b\xdc\x26\x58\x5a\x45\xc9\x80\xb7\x25\x72\xdd\x1e\x67\xa2\x82\x3e\x35\xc2\x9c\xb3\x39\x3a\xab\xa1\x44\x72\x94\xcf\xe5\xb7\xdd\x39\xf7\x08\x07\xd4\x49\x02\x60\x29\xa3\xe4\x74\x77\xf4\x29\x09\x4f\x69\x47\x4f\xb7\x0c\x1c\x14\xca\x82\xf8\xb3\xc7\xfd\xb6\x62\xe1\x1e\x5f\xb0\x87\xa5\xdc\x09\xe3\xf7\x58\x7a\xa4\x41\x7c\xc8\xb8\x85\x34\x1a\xe8\x3e\xc2\xf6\x93\xfa\xa4\x48\xb1\xc8\xe4\xde\x83\xf9\x34\x58\x12\x6a\x1c\xad\x22\x57\xe2\xec\xd3\xb3\x23\xf3\x42\xcc\xd2\xdf\xd6\x84\x99\xf1\xd4\xd3\x88\x9f\xeb\x59\xc7\x4e\x91\xf3\x0d\xa7\xe5\x70\x28\xe2\xa6\x95\x51\x6b\x2c\x2c\xac\x09\x14\x52\xc9\x91\xc3\x59\x5a\xda\xf4\x61\x24\x33\xbd\x55\x39\x90\x1c\x72\x9c\x3c\x70\x80\x0d\xe4\xb4\xa3\x3e\xd1\x7c\x73\x32\x67\xd7\x48\xeb\x2b\x9d\xa7\xbd\xb8\x99\x32\x78\x79\xb9\x44\x97\xcd\x92\x16\x5d\x8b\x29\x28\x2b\xb7\x74\x97\x35\xcf\xc7\xf1\x40\x23\x02\x3b\x38\x63\x1d\xe5\x33\x2c\xb3\x34\xc3\x48\xac\xe9\x4c\x8f\xa6\xbd\x3c\x3b\x25\xcd\xc8\xd4\x34\xf0\x26\xf1\x97\x50\xdf\x98\x6e\x6b\x68\x61\xa9\x6e\x6e\x37\x76\xb4\xc2\x9b\xe9\x8f\xd1\xdc\x59\x5d\xf8\x84\x60\x11\x32\x08\x24\x74\x37\x18\x51\x52\xcc\xb4\xe2\xeb\x7c\x0a\x8c\x93\x4b\x64\xa4\x3f\xaa\x4f\xee\x1f\x76\xe6\x94\x77\xab\x57\xa9\xc5\xa1\x81\x11\xc5\x8b\xc9\x4a\x88\x38\xe8\x9f\xb4\x71\x35\x8c\xd0\x3c\xd8\x38\x50\xdb\x3a\x29\x08\x7f\x44\x87\xba\xa6\x44\x1c\x97\x69\x61\xe3\x48\x1a\x0c\x94\x29\x2b\x6f\x87\xd6\xda\x84\xea\x23\xb7\x48\x91\xe7\x1e\x32\x9b\xe2\x5b\x0f\x61\xcc\xd3\x1d\xe3\x2d\x83\x04\x20\xbf\x08\xa5\x44\xe4\x0e\xc3\x30\x48\xd0\x38\xda\x3e\x34\xfb\x37\x07\x1a\x8d\x52\x53\x6d\x5f\x38\x90\x7e\xfe\xee\x5d\x3a\xa3\x95\xb4\x67\x3d\xa2\xc2\x94\x6d\xc5\x5a\x72\x1a\x41\xd6\x8b\x51\x5e\xf3\xb9\x14\xe1\x14\xa6\x5f\x4a\x8e\xb9\x9f\xbf\xcd\x3a\x01\xf5\xdb\xb3\xf7\x63\x95\xbf\x73\x85\x4b\x2f\x44\x30\x27\x9f\x71\xcb\xfa\x79\x91\xd4\xcd\x1b\x14\xfc\x1b\x93\xfc\x7e\x2b\x35\xaa\x25\xe4\xbb\xd3\xf4\x27\xe3\xf9\xc8\x61\xa8\xdb\xa8\xfa\xe8\xf1\x36\xd9\x80\x5f\x4f\x67\xac\x20\xbd\x33\xf4\x0e\x10\x27\x0f\x30\xdc\x43\xe3\x18\xdf\x5f\xc2\xf1\xd7\x2f\x53\xfe\xdf\x9a\x6c\x6d\x92\x45\x50\x1a\x00\x00\x3d\x00\x00\xc8\x7e\x3f\xfa\xcd\x1c\x8d\xac\xfe\xf0\x82\xec\xef\x3d\x67\x12\xd4\xe3\xb4\xd4\x12\x32\x91\xb4\x62\x52\xe3\x51\x13\x92\xa3\xd2\xca\x35\x0a\x72\xdf\xc4\xc6\x24\xc5\xa9\x25\xc6\xc5\xa8\x69\xe4\xa7\x41\xc8\x29\xc5\xcb\xc9\xc8\xa9\x25\x02\x29\xc5\xa8\x86\xc4\xa9\xe6\x46\xa8\xc4\xc7\xa8\x45\x94\x63\xe2\xd4\xe2\xa0\x95\xe4\xb4\x46\x94\x47\x20\xfe\x61\xd1\xf3\x8f\x1d\xf5\xfa\x46\xa6\x7a\xce\x66\x36\xf6\xff\x97\x8e\x7a\x9c\x7f\xc2\xb1\xb4\xb1\x71\xd4\x71\xd4\xd3\xb7\x34\x72\xf8\x5f\x62\x12\xfe\x5b\xcc\xff\xca\xa5\x7f\x7c\xe5\x2c\xd8\x29\xb6\x8e\x0c\x02\x00\xea\xe0\x00\xc0\xf8\x47\xa0\x7f\x73\xab\xf4\x1f\x1d\xd6\xa6\xc0\x6f\xdd\x43\x80\xfc\xfd\x1a\xb6\x77\x31\x79\x8d\xe2\x0b\x49\x0d\x72\x57\x3a\x16\x04\x2d\x4a\x59\xbe\x4a\xec\x4f\xed\x9c\x48\x54\xdb\xf4\x3a\xf6\x1b\xfd\xda\xda\x6d\xf5\x73\x3a\x93\xfe\xe6\x9c\xfb\x8f\x21\x49\xe1\xe5\x4e\x4f\x04\x69\xe0\x78\x99\xb5\x84\xfa\xe9\x6a\xb6\xde\xe3\x13\xd0\x1e\x26\x8c\x2f\xd3\x9a\xc8\x7c\x57\x90\x15\xb3\x1a\xee\x9f\x34\x7b\x11\xba\xc8\xd2\x96\x11\xbf\xd1\xbb\xac\x11\xeb\x22\x69\x1f\x81\x80\xd6\x0b\x93\x60\xbc\xbd\x38\x1d\x25\x0d\x2d\xe9\xd9\x0c\xb2\x7d\x67\xf4\xdc\x2c\x60\xcf\x27\xe2\x16\x0b\x4b\x4f\xfc\x09\xf2\x15\x2a\x90\xa9\x5f\x59\xa2\xe4\x73\x85\xb1\x89\xbc\x7f\x4e\xdb\x92\x3c\x6e\x04\xb7\xd0\xad\x38\x52\xa0\xe7\xdb\x60\x37\x4c\x8d\x5d\xbd\xe6\x95\xd2\x26\xbe\xe1\xac\x78\xbb\xca\x69\xea\xab\x92\x93\x85\xc9\x95\x87\xf5\xdb\x66\x7f\x85\x64\xab\x15\x5d\x9b\xbc\xda\x95\x33\xea\xd1\xed\xd8\xac\x3f\xac\x79\x1c\x02\x9f\x42\x2b\x39\x4e\x2b\xc3\x05\xaa\xf5\xe3\xb1\x0e\xbc\x1b\x0f\x05\xa4\xec\x06\xcc\x93\x3d\x35\x28\x6f\xc5\xd9\x85\x2a\xb6\x47\x42\x4d\x3d\xbd\xec\xa7\x53\xef\x39\xfc\x82\xf2\x71\x0d\x2d\x97\x93\x56\x99\x44\xde\x24\x19\xc6\xe7\x7f\xe3\x57\x8c\x7f\x1a\x7c\x7b\x23\x03\x33\xdb\x3f\x75\x1b\x34\xa4\x13\xb0\xb8\x04\x00\xc0\x09\xc8\xdf\x43\xee\x5f\xe3\xfd\x39\x0f\x06\xca\x71\x5a\xf7\xd0\xc1\xaf\x7a\xc1\xfa\xd7\xca\xcf\xe2\x79\x81\x59\x2d\x86\x0b\xe8\x12\x73\x10\xe2\x8c\x40\xf6\xa1\x21\x80\xae\xb7\xc5\xed\x7a\x7e\x2f\x88\xef\xbc\xdf\xce\xaf\x50\xd6\x12\xe4\x1d\xf6\x31\xff\x22\x46\x14\x0d\xd2\x82\xb3\xd4\x78\x07\x96\x36\x8a\xf0\x7e\x10\xe6\x6e\x09\x86\x3d\x6b\xb0\xff\xa3\xcc\x4e\xfc\x41\xda\x01\xf1\x05\x5a\xe6\x45\x41\x9f\x7a\x27\x78\x6d\x71\xce\x0a\xa3\xf8\xf8\x73\x3d\x1a\x57\xa8\x93\x68\xd8\x92\xd5\x27\xb5\xf7\x0e\x8a\xd3\x68\x48\xf4\xd8\x3c\x7a\x8b\x43\x9b\xba\xe8\xf7\xa4\x7e\x95\x89\xd9\x72\x89\xc4\x54\xef\x0c\xe0\x99\x98\x53\xb4\xdc\xa6\x1d\x15\xbd\x66\x76\x9b\x47\xce\xcc\x06\x7f\x24\x04\x69\x1e\xd9\x7d\x2e\x11\xc4\xf4\xdb\x23\xbc\xfe\x9a\x62\x78\x4e\xc9\xb0\x4e\x46\xf7\xb3\x2f\xf2\x79\x30\x8b\xfe\xbb\xc4\xb2\x26\x35\xda\x04\xe9\xaa\xe7\x3a\xce\xd3\xd1\xf6\x59\xe0\x8a\x2b\xe2\xe2\xea\x5a\xec\x58\xc3\x77\x8b\x8a\xbd\x23\xa4\x7f\xdf\x85\xfa\xdb\xb1\xfb\xab\x0b\xf5\xaf\x2e\xd4\xbf\xba\x50\xff\xea\x42\xfd\xab\x0b\xf5\xaf\x2e\xd4\xbf\xba\x50\xff\xea\x42\xfd\xab\x0b\xf5\xaf\x2e\xd4\xbf\xba\x50\xff\xea\x42\xfd\xab\x0b\xf5\xaf\x2e\xd4\xbf\xba\x50\xff\xea\x42\xfd\xab\x0b\xf5\xaf\x2e\xd4\xbf\xba\x50\xff\xea\x42\xfd\xab\x0b\xf5\xaf\x2e\xd4\xbf\xba\x50\xff\xea\x42\xfd\xab\x0b\xf5\xaf\x2e\xd4\xbf\xba\x50\xff\xea\x42\xfd\xbf\x76\xa1\xfe\xf3\x5b\x61\x07\x03\x7b\x33\xdb\x3f\xd5\x54\xc0\x47\x9c\xda\x2d\x0d\x02\x00\xfd\xa0\x00\x40\xfc\xbb\x78\x7f\x7b\xbd\xaf\x63\x6b\xa9\x67\x60\x44\x63\xee\xf0\xef\xd0\x8d\xe4\xbb\xcc\x7b\xe8\xe0\xdb\xee\x29\x85\x16\xba\x02\xb7\x56\x55\x19\x20\x0b\x83\x57\xfb\x17\xac\x22\x58\xec\x12\xc6\xa8\x1a\xfc\x4f\xea\xe0\x08\x7f\xf2\x0c\x9c\xad\x14\x5c\x77\xc5\x16\x92\xb6\xdd\xa3\x95\x7c\x16\x44\x84\xac\x6a\x73\xfa\xca\x62\xcb\x32\xab\x44\x27\x20\x2d\xc2\x7f\x57\x50\x20\xc2\x2f\x7c\x7a\x46\x3d\x0d\x43\xbe\x5c\xa1\xd2\x6d\x7a\xcc\xe7\x99\xeb\x84\x61\xef\x3a\xe1\x8d\xf8\x95\xb2\x40\x50\xcc\xe8\x03\xfe\xbb\xe2\xcb\x77\x16\x82\x4d\xbc\xb9\xce\x49\x1a\xaa\x31\xc7\x9f\xad\x5b\xa7\x61\xe3\x48\x18\x3e\xd5\x3b\xe6\xb6\x04\xbd\xa0\x04\xa1\xf3\x0d\x5f\xd4\xd0\x6f\x79\x98\x7c\xe7\x39\xcd\xae\x7c\x20\x2f\xcf\xcf\x52\x84\x8e\x71\xce\x74\x12\x29\xe4\xb8\x6e\x03\x24\x49\x90\xac\x14\xa1\x47\x59\x72\x99\x02\x70\x3b\x0f\x92\xc9\x47\xe8\xa6\xdf\x0a\x41\xf2\x4a\x3b\x8f\xa4\x37\x95\xfd\x1b\x6b\x7b\x67\x15\x54\x5b\x43\x3b\xb7\xb7\x30\x1e\x12\xf4\x61\x20\x3b\x05\x78\x9a\x60\x19\x9b\x8e\x35\x3c\x2b\x08\x26\x1c\xd8\x68\x84\xd7\x10\x36\x39\xf1\x15\x9f\xdd\xd3\x05\xd4\xc1\x24\x1d\x1d\xea\x6d\x1f\xf5\x32\x38\xc6\xd4\x3e\xaf\xdf\x32\x1d\x74\x6a\xc4\x54\xdc\x53\xe4\x07\x49\x50\xcc\xaa\xe0\x8c\x59\x69\x64\xdc\xf2\x28\x2d\xe6\xc1\xbd\x95\x4a\xde\x6c\xdb\x39\xf8\x47\x1a\x04\x16\xf3\xeb\x1f\x0b\xdb\xed\xe9\x71\xc8\xc3\xcc\x07\x76\x37\x6e\xa1\x5a\x27\x77\x62\x32\x5d\xcf\xd9\xb1\x98\x7e\x17\x32\x98\xa5\xe7\xf6\x72\x12\x78\x01\xfd\xad\xab\x62\xa5\x24\x5d\x28\x90\x01\xa0\x87\xef\xdf\xb9\xca\xc1\xd4\xc9\xd2\xc2\xc8\x5e\x47\xdf\xc6\xf5\x3f\xb8\xaa\x4d\xd3\xca\x61\x99\xe5\xdd\xdb\x99\x8e\xaf\x29\x43\xe8\xec\xa0\xe3\x74\x51\xa6\xca\xb7\x74\x49\x7d\xd2\x62\x0b\x1c\x0f\xcb\xfd\xa5\xa8\x53\x07\x02\x94\xb8\x14\xb9\x82\x20\x02\xab\x92\x92\xb7\xe7\xd3\xad\x40\x70\xbe\x98\x9a\x55\x19\xf6\x78\x21\x22\x9f\x3e\xfd\x6a\xda\x09\x9a\x1c\xa1\x70\xa3\xb4\xdd\x1d\xb8\x66\xf1\x5a\xb6\x13\xd4\xf6\x3d\x76\x8d\x9b\x21\xe8\x37\x9e\xb1\xc3\x1d\x87\xec\xf7\x9f\xe2\xd9\xa5\xaf\x20\x24\xaf\x6a\x6f\xe3\xec\x6a\x08\x67\xa8\xb4\x62\xc6\x3b\x30\xe4\xbb\x3f\x61\x67\xee\x79\x64\x18\xcd\x53\x1d\xc3\xbb\x38\x7f\x32\xeb\x76\xb0\x7f\x3a\x47\x3a\xf7\xe6\xe1\x6a\x76\x77\xba\x59\xfe\xb1\x78\x3e\x10\x5c\x6c\x98\x9d\x71\x8e\xec\xca\xb1\x9b\x03\x92\x8d\x79\x69\x92\xa4\x15\x39\xa3\x5c\x7c\xce\x82\x21\xde\x64\xbc\xa9\x6c\x91\x9d\xee\x96\x50\x7b\x47\x63\x26\x12\xbf\x98\xc4\x03\xc7\xe1\x5f\x3f\x75\x27\x63\xc7\x94\xbe\xaf\xba\xdd\xb4\x20\x60\x39\xb6\xc5\xd6\xb6\x28\x62\x51\x1d\x38\xcc\x20\xc1\xd3\x78\x9f\x62\xe4\xe8\x85\x1b\xd5\x78\x9f\x5e\x66\x56\x13\x98\x4e\xa4\xd1\xaf\xc1\xb6\x46\x93\x6a\x97\xb4\x66\x99\x6a\x9a\x44\xdf\x50\x28\xd9\x20\x4d\xde\x4f\x6f\x5a\x3e\xb3\xfb\x0e\xcd\xc1\x26\xfa\xbb\x11\x7b\x6c\x9a\xc4\xbb\x16\x5d\x85\xc8\x47\xe2\xb1\x05\xb6\x42\x75\xb1\xe9\x08\x29\xb3\xf7\xd1\xbe\xc0\x90\xe2\xbb\x8a\x5c\x55\x26\xc2\x11\x2a\xad\x1a\x2a\x9d\xf3\x40\x4b\x89\x70\xb6\xf5\x54\x8c\x21\xe2\xe8\xe9\x80\x51\x35\x6f\x8a\x83\x36\x28\xfb\x55\xfc\x47\xa2\x94\xa1\xda\xfa\xa7\x00\xa4\xd1\xe7\x4f\xc3\x9d\x9f\x2e\x98\xe5\x32\xeb\x19\xe5\xa3\xe2\x83\x12\xa2\x78\x7d\x0f\xa1\xb7\xe1\xbf\xc9\x80\x20\xb3\xe3\x7c\xd1\xef\xec\x63\x3e\x76\x76\x1d\x12\x2c\x66\xa5\x82\x11\x52\x9c\x0e\xdc\xe4\xf6\xc7\xf4\x2c\xaa\x1a\xae\x20\x6e\x2f\xd0\x7d\x3a\x44\x23\xe5\x0f\x1b\xb8\x60\x4e\x13\x92\xa4\x73\x5e\x3b\x6d\xde\xa6\xc8\xfa\x76\xe8\xc3\x5c\x80\x12\x80\xa2\xd3\xef\x18\x59\x19\x54\xcf\x3f\x18\x4c\xbf\x33\xf5\x31\xbe\xd3\xff\xd5\x6b\x9a\x3c\x6e\x02\xe6\xa0\xbf\xe2\x8a\xa8\x3d\xf5\x72\x48\x4d\xdb\x30\x94\xbe\x85\x67\xde\x78\xe0\x67\xf9\x93\xe1\x69\x78\xf5\xa2\x40\x6f\x3d\xb5\x8b\x73\xcf\xe7\xe6\x15\xb4\x98\x65\xdf\xf7\x8e\x65\xfd\x07\xaf\x4d\x12\x5c\xb8\x0a\xf2\xb6\xaa\x8b\x35\x2d\x90\x67\xf9\x01\xfd\x89\xdd\x67\xef\x0a\xb3\x92\x9c\x57\x85\x1e\x33\x76\x36\x6c\x5c\x65\x30\x25\x4d\xd8\xcd\x7d\x3e\x4f\x88\xb2\x5b\xdf\x6f\x26\x83\xa5\xd5\x02\xce\x05\xcb\xcb\x9e\x65\x4d\x72\x12\x4d\x46\x6d\x5e\xf1\xdf\xaa\x5d\xeb\xb9\xf2\xe5\x1b\xac\x06\x03\xf6\xe2\xd9\x0f\x6b\xa8\x24\x19\x15\xc9\xcc\x85\x70\x35\xd2\x33\x23\xa3\xd8\xa0\x82\x6d\xb4\xbd\x5d\x5d\x9c\x9d\x1c\x19\xdc\x39\xdd\xbf\xdc\xee\x1d\xec\x24\x27\x9b\x54\x7d\x1b\x97\xc7\x18\xcb\x33\xe4\xf3\x4e\x70\xf4\x3e\x52\x6a\x27\x40\x97\x26\x86\xac\x15\xa8\x55\x9b\x5c\xa9\x17\x81\x0e\x99\xb4\x81\x0f\xe2\x34\x21\x16\x17\x34\xaa\x98\x23\x4e\xe2\x8c\xa9\x6d\x43\xe9\xc5\x79\xdf\x8d\x07\xcf\xc9\xfc\xf5\xf5\x63\x51\x79\x81\xad\x46\xad\xca\x6a\x57\xc1\x6e\x31\xf0\x24\xea\xd6\xa8\x38\xdc\x82\xd9\x4b\x95\xea\x0b\x7b\xe6\x9f\xf9\xa5\xad\xaf\x8a\x24\xb2\x15\xc7\xa9\x16\x94\x1c\x2e\xb8\x6d\x64\x5a\x9f\x45\x94\x72\xc0\x6a\xd6\x98\xa8\x78\xb9\x84\x8c\x9c\xe5\x7b\x1a\x93\x90\x34\x98\x1f\x29\x15\x4c\x57\x43\x4c\x54\x09\x37\x7d\xc0\x07\x42\xef\x6f\x65\x95\x0a\xb0\xec\x91\xb3\x70\xd1\x8f\xa5\xe2\x16\xdf\x50\x0d\xe1\x0d\x79\xf5\x82\xe8\x9e\x83\x1c\x0e\xcc\x82\x74\x4d\xd8\x51\x5f\x52\x8c\x69\xb9\xfc\xe4\x94\xd3\x66\xb1\x4e\xe3\x85\x03\x6a\xb6\x88\x0b\xd8\xf1\x14\xc7\x41\x1d\xce\xc2\x6e\x49\xa6\x55\x32\xb6\xf9\xc6\x6a\x67\x13\xaf\x8d\xfa\x8b\xa6\x60\x9b\x28\x8a\x2e\xce\x49\x4c\xa5\xbd\xd0\x99\x03\xb1\x70\xd0\x72\x42\x8d\x36\x7a\xee\xc4\x59\x4d\x4a\xbf\x8b\xde\x7d\x21\x7a\xe0\x8f\x5c\x6a\xdd\x90\xbd\x73\xef\xf2\xa3\x69\xd5\x9a\x15\x9d\xa2\x41\x53\x1c\x31\x3e\xe7\x7a\x38\x1a\x0a\xff\xc9\xfd\x52\x73\x78\xf7\xc6\xd5\xd1\xf4\xbd\xa9\x69\x25\xfd\x56\x7c\x7f\x6b\x6b\x33\x5f\xa6\x12\xd3\x53\xc2\xd7\xe1\xa1\x3f\x7b\x01\x95\x36\xa0\xff\x24\x3e\x60\xb5\x33\x6a\x1c\x51\x75\xe4\x4c\x34\x27\xef\xe1\x70\x38\xf0\x96\x65\x35\x3c\x7c\xe6\x2c\x9c\xff\x02\xfd\x65\xd9\x63\xaa\x94\x63\x10\xfd\x9e\x05\x89\xf7\x0e\xb4\x41\x1f\x95\x3c\x33\xb7\x39\xd7\x3e\x0c\xf3\x6a\xfd\xda\x24\xd9\x90\x2f\x8e\x28\xea\xb6\xa1\x2d\x8b\x71\x81\xb0\x8a\x30\xa7\x89\xc0\x61\x78\x4c\xeb\x9b\x33\x80\x53\xc0\xb7\xde\x75\x4c\x73\xf7\xc3\xc3\x67\xef\x9e\xaf\x7d\x72\x4f\xd0\xa0\xe2\x64\x76\xdf\xb6\xf7\xee\x3c\xfc\x71\xf2\x23\xb4\x5f\x94\xa4\x9b\x8f\x97\xe7\x4d\x84\x93\x5e\xb2\x4f\x31\xe0\x12\x30\x3e\x82\x67\x57\x07\xee\xcf\x41\xd3\xe0\x22\x89\x69\x33\x5a\x27\x7f\xe5\x86\x1d\x94\xb4\xe6\x77\x0a\x2b\x0e\x13\x1a\x4b\x67\xc4\xe5\x96\xe1\xef\x5a\xd1\x85\x88\x2a\xa8\xcb\x04\x8f\xe7\xab\xae\xb2\x0d\x1b\xfd\xf2\x58\x2c\xdb\xcf\x83\xbd\x22\x5d\x25\xf6\xf0\x62\x7f\xc0\xe1\xc5\x1e\x8d\xac\x99\x6f\xf0\x15\x12\x46\x9d\x16\x8e\xe7\x5c\x05\x6c\x8c\xba\x07\xbb\x39\x4d\xde\x52\xcb\xaa\xf1\xaa\xac\xc9\x2a\xc3\x89\x35\x17\xcd\xac\x57\x96\x24\xc5\x3b\xb1\xa2\x85\xdd\xd0\x27\x7f\xd2\x4e\xbb\xde\x92\x36\x24\x4e\x5c\x33\x20\xe2\xf8\xed\xf3\xe9\x7a\x0d\x38\x3c\x99\xc9\x4e\x4f\xf0\x14\x48\xb3\x37\x8d\x67\x51\xf7\x11\x78\x15\xc7\x30\x79\xb0\x61\x44\x3a\xbf\x32\xa2\xbc\x54\x44\x77\xc5\x59\x7e\xf3\xc5\x24\x73\xb3\xc7\x77\x85\x89\x8a\x87\x63\xf6\xb7\xfa\x39\x9d\xdb\xeb\x85\x0b\xf2\xd2\xfa\x0b\x22\x12\xa8\x55\xf1\xca\x86\x43\x6c\xcb\x0d\x5a\xa6\x6c\xa8\x18\x9d\xa0\x43\x50\x96\x17\xca\xab\x13\xce\x2b\x52\xb7\xa3\xb3\x47\xe6\x9b\x0c\xbe\x17\x30\x1e\x7c\x7f\xde\xc1\x4e\x14\x47\xc6\xab\x10\x2e\x2d\x2f\xff\x55\xd5\xf5\x79\x97\x53\x8e\xc1\x99\x19\xae\xf0\x38\xf5\xfb\x45\x97\xa5\x10\x78\xec\xbd\xc2\x41\xdd\xea\xee\x11\x38\xad\xb3\xab\xf7\x09\x04\xc4\xc3\xc6\xee\xce\x59\x79\x7d\xf7\x90\x4f\x06\xce\x36\x6e\xf1\x1e\x6c\xf0\x60\x96\xd0\xb2\x80\xae\x60\x0b\x12\x67\x7f\x7e\x89\x9e\x07\x00\x30\x8d\x3c\x56\xfa\x88\xb6\x66\x4e\x75\xf9\x38\x7e\xd6\x15\x43\xeb\xee\xb5\x57\xa2\xdf\x53\x78\x67\x1f\xd3\x8d\xda\x06\x68\xd3\x48\x39\xbf\x47\x11\xc9\x5d\x9d\x8d\xc0\xf1\x81\xe3\x7c\x4b\xb7\xc6\x81\x10\x2e\xa2\xe3\x1e\x49\xea\x14\x46\x7c\xa1\x4e\x12\x46\x14\x50\x49\xb9\xf8\x94\x2f\xc9\xd7\xe5\x75\x21\x70\x89\x83\x4c\x44\x4c\xaf\xc6\x9c\xbb\x9d\x02\x33\x64\x97\x42\xea\xcb\x8b\x0d\xcd\x36\x8c\xae\xe2\xc5\xe8\xfe\xf3\xee\x62\xe7\xc1\xf0\xe6\xed\xe4\xe8\x61\xe7\xe0\xe1\x62\xe7\xc4\x25\xe0\xdb\xc2\xf0\x3b\x6d\xdb\xd4\x21\x9c\x6b\x22\x53\x66\x97\x6b\xe9\x1f\xb3\x35\xd1\x64\x1e\xb9\xd8\xc0\x64\x2f\x0b\xcd\xc1\xd1\x2e\x11\xb5\x2e\x5e\xc8\x40\x16\x73\x01\x81\xfb\x80\x56\xc6\xb9\xc1\xf2\x82\x6f\xb9\x1f\x35\xc0\xd1\x95\xa6\xc6\x56\xf7\x02\x57\x49\x5b\x6c\x33\xfc\xe2\x6d\x81\x9a\x53\x27\x53\xf0\xbe\xc2\x73\xdf\x6a\xce\xd4\x81\xf1\x26\xb4\xec\xaa\xb6\x31\x2e\xe0\xaa\x5e\x61\x40\xc6\xca\x43\x26\xde\x6a\x6e\x14\xc1\x82\x47\x42\x42\xe3\xcc\x82\x22\xf1\x4b\x83\x30\x46\xad\x06\x24\xa5\xaf\x03\x26\x79\xaf\x4a\x5a\x19\x8c\x86\x30\x17\x14\x08\x81\x83\x69\xbc\x8a\xab\x00\x67\x1f\x19\x73\xa4\xbe\x8f\x08\xc9\x27\xd7\x49\xc3\xab\x39\x62\xac\x1d\x14\x3f\xf2\x6e\x70\x05\x98\x3a\x72\x9f\x85\x2c\xe4\x07\x14\x72\xae\x17\x85\x0a\xbb\xeb\xcb\xca\xe0\xee\xd6\x00\xfe\x90\x49\x94\xc8\x7c\x5e\xb5\x31\xb7\xb9\xdc\x14\x47\x42\xc8\x0d\x2a\xa8\x7c\x83\xd2\xbd\x84\x29\x3b\x82\xc5\x64\x9d\x27\xa3\x8f\xe8\x4b\x65\x38\xbb\x98\x8b\x4d\xf2\x05\xf5\x30\xe0\xd0\x8f\x13\x07\x65\x26\x52\xf9\xeb\x87\x6d\x2a\x3a\x3f\x06\x3d\xb6\x04\xa7\x20\x4e\x24\x2c\xc3\x24\x4e\xbc\x89\x64\xcb\xdd\x10\x0a\x2f\xc4\x70\x0d\x97\x06\xf9\xd4\x0c\x1d\xdf\x83\xf4\x6a\xe3\x5a\x7e\x87\xfc\x02\x07\x20\xbd\xf8\xc9\x6f\x95\xba\xb7\x88\xdb\xfd\xd8\xc2\xf9\xde\x95\xc7\x2c\xcf\x0d\x1e\x88\x06\xd7\x0d\xe6\x9f\xcd\xd7\x7e\x34\xdf\xe2\x37\xc1\x4e\xe6\xa1\xfe\xb1\x0c\xc3\x63\xc3\x38\x3d\xdf\x34\x01\x39\x3e\xd1\x15\x45\xb3\x21\x64\xf0\xe0\x68\x78\xd0\xd4\x3e\xd0\x50\xda\x1f\xe2\x0b\xe2\x35\xd6\xab\x1d\xc7\x36\x9b\x4f\x2b\xc8\x02\x01\xad\xa8\x7f\x4d\xca\x3a\x75\x61\x89\xe4\xda\xc2\x47\x5e\xa4\xb8\x10\x31\xe8\xcb\x48\x31\xe8\xd6\xb7\x34\xd0\xcf\x33\x7c\x11\xa8\x41\xe8\x42\x7a\x5e\xcf\x17\xa1\x8b\xcc\xe1\xca\xa2\x97\xcd\x6b\x56\x33\x0e\x49\xcd\x08\x52\x58\x41\x36\xfa\xc1\x5e\x36\x3b\xf8\x49\x0d\x96\x81\x94\x82\x18\x2c\x08\xdb\xe9\x35\xc6\x65\x0e\x0b\x74\x69\x97\x3c\xf2\xc8\xef\xb9\xcd\xc1\x23\x19\x52\x12\x2e\x3e\x4f\x98\xf9\x7a\xf4\x20\xa8\x6f\x8c\xce\xf5\x99\xdb\x15\xd2\x26\x49\x5a\xf8\xa4\x38\xa1\x97\xf4\x95\x70\x98\x8d\x1d\x80\x8c\x99\x69\x94\xd7\x13\xed\x56\xca\xa5\x3a\xa6\x0d\xcb\xde\x45\xd0\x2d\x7f\x91\x89\x5e\x83\x22\x2a\xaf\x1f\x98\x2f\xee\x1c\x58\x20\xef\x1b\x3b\x0e\x4b\xc0\xff\x12\xfc\x2d\x09\x8a\x85\x2c\xf1\x9b\x44\xaf\xb9\x00\xae\x18\x7f\xbb\x72\xb8\xfc\x5e\xcb\x08\x1b\x89\xce\x9c\x41\xe2\xc4\x3e\x34\x15\x11\x25\xc9\x6e\xaa\xae\xb8\xfa\x00\x95\x42\x7b\x8c\xdd\xb7\x62\xe8\x23\xf5\x91\x3d\x06\x85\x54\x7e\xf1\x47\x86\xda\x9e\x49\x5e\x4d\x28\x82\xe3\xc0\x5d\x25\x2a\x02\x5d\x27\x8b\x9d\x12\x76\xe3\x26\xbb\x92\x9b\xba\x7b\x38\x39\x77\x63\x24\xfe\x6c\x73\xf7\x96\x12\x3e\x43\xd8\x32\x8c\xc2\x34\xad\xc4\x2f\x8f\x15\x1d\x77\x2c\x75\x6c\x67\x1d\x94\x4c\x66\xfd\x9f\xbb\xe3\x0f\xb6\x8c\x90\xe2\xa6\x04\x39\x52\x47\xaa\x08\x7e\xe0\x0a\x08\x0a\x1a\x7e\x75\xab\xed\xdf\x3d\x60\xb4\x51\x8b\xf3\x80\x8d\x1a\xa1\x34\x41\x75\x9e\xdc\xc0\xcf\x70\x32\x03\x95\x1e\x17\xe3\x35\x9e\x2d\xd4\x33\x95\x5a\x47\xbe\x28\x23\x49\xea\x9a\x48\x99\xfa\xf0\x73\x74\x20\x24\x70\xda\xf2\xc2\xe3\xf8\xab\xf4\x48\x1e\xeb\x1a\x46\xb6\x87\x45\x7e\x93\x96\x6f\x66\xd5\xa1\xf8\xa7\x36\x2d\x04\x17\xdd\x9d\xb6\x46\xaa\x24\xf1\x62\xf2\xe6\x69\x28\x30\xa2\x5e\xf7\x70\xf5\x23\x25\xf1\x20\xdd\xb2\x66\x55\x1e\xce\xf8\x1e\x99\xd3\x4e\xf9\x20\xe6\x2e\x9e\xb8\x5c\x5d\x1e\x75\x8d\x7a\x63\x0b\x2a\x16\xb1\x16\xa3\xf5\x7e\x57\x2a\x55\xce\x1b\x2b\xdf\xec\x4b\xd5\xe3\x29\x7e\xf4\x78\xe9\x39\x5c\x70\x0f\xce\xc7\xc8\xf9\x98\x8f\xd6\xa8\x9f\xaf\x57\xa8\xf5\x9f\x29\xd9\x04\x07\x2b\x10\x74\xeb\x42\xce\x11\x53\x7a\x64\x46\x0a\x13\x0c\xcd\xc8\x65\x4b\x85\x97\x9c\xd8\xa5\x6e\xdd\x32\xf4\x73\xdc\x39\x12\xb2\xbd\x13\x6e\x25\x2e\x7a\x76\xc1\x87\x4b\x71\xf3\x60\xd9\x7c\x82\xb3\xde\x5f\xee\x84\x56\x26\x15\x0f\x64\x19\x24\x8f\x24\xbd\xcb\xea\x45\xa0\xf9\x64\x17\xf4\xcc\x9f\x9e\xaa\xe9\xf1\xd5\x42\x45\x9d\x06\x1d\x97\x4c\x1c\xf7\x3e\xa6\x75\x7b\x51\xaf\x24\xaa\x38\x08\xc7\x0f\xf2\x22\x04\x6c\x19\xb5\xe8\x4c\x34\x36\x24\x9e\xb8\x8d\x50\x29\x63\x86\x47\x0e\x96\xea\x68\x80\x18\x3c\x51\xdd\x2f\x33\x3d\x8c\x4a\x66\x93\x10\x19\x14\xab\xb8\x99\xed\x34\x16\x13\x3e\x63\x06\x5f\xd9\x8f\x27\x06\x0d\x0d\x4e\x8d\x07\xc5\x74\x0f\x52\x9d\xf9\x87\x14\xec\x13\x1c\xb2\xaf\x72\xe7\x03\xcf\x94\xed\x68\x95\x1f\x1d\xe8\xcf\x89\xd8\x27\xc8\x5b\x8d\x19\x3a\xd9\x1e\xb6\xfc\x45\x43\x6f\x81\x9e\xaf\x7c\xd2\x9e\x18\x9e\x1a\x24\x18\x1d\xd3\xd0\x79\x9b\xb0\x18\x38\x95\x43\x8c\x71\xa2\xae\x1f\xe1\x15\xc2\xc0\x0b\xfd\x48\xb1\xf2\xf4\xf3\xb1\x06\xc4\xc0\x54\x44\x50\x65\xfa\xfc\xc0\x51\xc8\xab\x09\x28\xe3\x18\x75\x49\xa8\xaf\xac\xe7\x61\xc6\xf3\x20\x83\xa8\xa0\x57\x6f\x87\x64\xc9\x50\x91\xa3\xaf\x42\xec\x06\x82\xde\x61\x71\x68\x47\x07\xf0\x9f\xa8\x8e\x24\x96\x7c\x7b\xbf\x29\x24\x85\x8d\x87\x20\xe4\xb7\xc3\xe9\xec\x3a\xcb\x80\xa7\x34\x5d\xbf\x44\xdf\x01\x95\x99\x93\x83\x0a\x26\xe9\x93\x18\xc4\x5e\xf4\xfc\x79\xf6\x13\x29\x13\xcc\x47\x68\x90\x08\x24\x68\xcf\xe2\x5a\xf5\x7a\xa5\x28\x66\x47\xb4\x7d\xe2\x31\x06\x96\x21\x88\xfc\x8a\x01\xb2\x81\xed\x48\x8e\x7c\x63\x93\x84\x6d\x3b\x76\xfd\x43\x0b\x62\xd3\xc1\x01\xa4\x55\x22\x84\x63\x74\xdb\x78\x41\xdc\xcd\x74\x64\x95\x73\x61\x39\xc4\x52\xc1\xa6\xb0\xbc\xee\xf8\xad\x70\x09\x0c\x21\x37\x15\xaf\x80\x3d\xa8\xc8\xd0\x7c\xac\x1d\x5e\x62\xb2\x40\x02\x49\x7f\xf4\xf9\x46\x10\xa4\xaf\xd6\x8e\xc3\x7c\x1c\xa8\x5c\x01\x78\x83\xd3\xdc\x58\x3b\x01\x7a\xcd\x4e\xe6\x5f\x3d\xd8\x63\x77\xaf\x23\x0f\x23\x97\x11\xea\xcd\xdd\xad\x18\xef\x10\xfa\x27\xb2\xb5\x11\x7f\x56\xb4\xd1\x35\x76\xe7\x85\x7e\x4e\xa6\xe7\xc2\xc8\x77\x06\x2d\xae\x80\xd8\x2c\x04\xf9\x4e\x9d\x63\xcd\xe9\x5b\x18\x63\x17\xbb\x8d\xfd\xcd\x51\xca\xe9\xcd\x67\x78\x50\xb7\x3a\x0b\x3d\x8d\x73\x95\xf5\xae\x3d\x0b\x33\xc9\xb8\x1a\x9c\xb9\x2f\x1c\xce\x28\xb3\x59\xbf\x58\xbd\xf6\x9b\xd4\xb4\xe1\xd0\x10\x59\xb9\xcb\x6d\x49\xf5\x05\x8d\xa1\x1c\xfa\xfa\x2d\xc8\x77\xce\xca\xe2\x1d\xeb\x71\x8b\xa4\x35\x2f\xd8\x52\x99\x53\x43\x3b\x5c\x97\x1b\x7a\x21\x9f\x04\xd1\xab\x41\x0e\x98\x21\x22\xea\x57\x23\xad\x03\xfb\x41\xce\xdd\x60\xcb\xb1\xc9\xb5\x12\xfc\xc7\xd1\xea\x9f\x46\x93\x95\x14\x65\x2a\x9f\x0b\x71\x5b\xce\xc6\xc9\x2a\x7e\xd0\xfb\x34\x9d\xbd\x0b\x0e\x4b\x63\x25\x55\x64\x62\xa4\xcf\x09\x5d\x39\x8c\x5b\x23\x16\xb0\xa0\x88\x6e\x9f\x33\xfa\x92\x1b\xe6\xce\x8d\x30\x22\x2f\x40\x8b\xf0\xcd\xfe\xfe\xbd\xb8\x07\x4b\x3f\x86\x36\x98\x0e\x6d\xf2\x88\xab\x16\xbd\x5d\x79\x1f\x89\x0d\xee\xa8\x59\x08\x14\xa1\xf6\xd7\xad\x41\xcc\xf7\x69\xd7\x14\x6a\xa1\xc7\xc8\xb2\x97\x11\x7d\x66\xea\xac\x0d\x2f\x84\x1a\xdf\x5b\x02\xa2\x49\x82\x7c\x88\xee\xf3\xfb\x6a\x42\x40\xb8\x96\x12\xce\xa2\x42\x9e\x36\x06\x83\xc8\xe2\x7b\xd0\xd3\xd1\x0e\x38\x8a\x9a\xe9\x64\x50\x41\x4d\x63\x88\x27\x5e\x87\x4a\xf3\xd7\x07\x2f\x5b\x93\x2b\x91\x90\x06\x2b\x20\xf7\xd1\x1b\x84\x5f\x45\x92\x41\x2f\x08\xf2\x73\x84\x7c\x3e\x1b\x0c\x93\xf0\xac\xc6\xfa\x68\x8c\xda\xb9\x52\xd3\x91\xaf\xb1\x19\x2c\xd2\x6f\x85\xaa\x56\x85\x4c\x6c\x41\xbb\xc6\x09\x1b\x40\x2e\xbe\xd6\x7f\x4d\x4c\x38\xe0\xff\x48\xc0\x5e\x24\xdb\xcf\xc6\x07\xe0\x4d\xcd\x70\x91\xd2\x1f\x67\xe4\xa5\x57\x4e\x36\xe3\x95\x2a\xd5\x8a\xc0\xc8\xc4\x1a\x7f\x0d\x8a\xd3\xcd\x4f\x9e\xe3\xf6\xda\x8d\x0a\x83\xef\x97\x78\x25\xf9\x4a\x42\xeb\x04\x39\x4c\x63\xfa\xe3\xd6\x17\x5f\x7a\xa0\x4c\x17\xd6\x9b\x8d\x8b\x39\xae\x8f\x22\xb1\x5c\x96\x70\x97\x83\x41\x85\x62\x7d\xc5\xb8\xa4\x3c\xe3\x40\x72\x0a\xcc\x09\x05\x76\x05\xd2\x51\x78\xdf\x46\xb9\x7a\xea\x83\x2a\xa8\x40\xc8\x47\x5a\xb0\x29\xec\xca\x58\xcb\x71\x8f\x0c\xe5\x90\xf7\xc5\xe4\x7d\x76\x77\x6a\x9f\xe8\x69\x7c\x53\x63\xdd\x98\xd0\xf8\xcc\x07\x66\x20\x1f\xaa\x67\x6d\x2c\xc8\x8f\x78\x84\x9d\x93\xe3\xe7\xfc\x5a\xa9\x85\x44\x39\x87\x63\xc7\x50\x37\x0c\x82\x61\xdd\x72\x31\x2c\xf0\x1f\xdf\x33\x90\x10\x42\xe1\xeb\x3d\x81\xaf\xdd\x40\xd6\x7e\xa8\x3b\xab\x4a\xdc\x2a\x47\x2a\x6d\xf3\xff\x39\x3d\x54\x77\x6e\xc6\xa9\x7b\xc7\xcd\x9c\xd9\x2a\x5d\x63\xce\x63\x00\x58\x67\xa7\xb7\x32\xa1\xcd\x73\xad\xad\x59\x78\x1c\x4d\x3f\xf8\x7c\x97\x9c\x99\xe8\x76\x24\xd8\xec\x3c\x91\x4e\xff\xa0\x52\x42\x90\x50\x6e\xff\xfd\x1c\x27\x0c\x77\x07\xc0\x89\x47\x8e\xd3\x3e\xe1\x1e\x52\x18\x77\x61\xdf\xc6\xf2\xf0\x58\x1b\x7c\xe8\x9c\x23\x99\xfd\xee\x91\x6c\xe0\xab\x55\xd6\x7b\x91\x04\x3b\x46\x56\x96\x29\x92\x16\xed\xc6\x8c\x23\xe2\x63\x2d\x68\x5e\x30\x64\x5d\x0b\xfb\xdc\xfe\x49\x97\x55\xa2\xc2\xec\x7d\xe0\x1e\x35\x8b\x57\x62\xd3\x21\x7f\xec\xc9\xeb\xf9\xcb\xe1\x19\x92\xa3\x8b\xfb\xe3\xee\xf3\x98\xf5\xe3\xe3\xa3\xf1\xbd\xdb\xe3\x2e\xae\xe9\xc3\x1b\x14\x47\x7b\xdd\xda\xc1\xc4\x44\xfb\x1a\xd7\x4a\x38\xa8\x18\xc1\x55\x85\xa6\xe8\x47\x91\xaf\x92\xf4\x2b\x0a\xdb\x0a\xe0\xcb\xb7\x0a\x95\x24\x96\xec\x72\xd7\x83\x48\x48\x64\xf2\x9f\x5b\x5d\x57\xd9\xc0\xbf\x8e\x3d\xef\x04\x71\x47\x19\x5c\x95\x00\x73\xb0\x4e\xc1\x6e\xb1\x5b\xab\x1c\x25\x61\x66\xdd\xd6\x92\xaf\x4a\x6c\xc9\xb8\x41\x30\x73\x06\x65\xaa\x3b\xd8\x7d\x2f\xea\x3f\xcf\xf8\x39\x3e\x66\xe8\x4c\x50\x28\xf0\x2a\x01\x06\x0d\xee\x52\x97\xdc\xcc\x98\xe4\x7d\x0d\x5a\xe6\x0f\x62\xa5\x22\x49\xbc\x5f\xd1\x65\xfb\x6c\xfc\x0b\x38\x0a\xb7\xde\xb9\xc5\xd6\x79\x88\x78\xbe\xec\x75\xb4\xdd\x66\x31\x3f\x06\xad\x74\x82\xb8\xe7\x60\xec\xb5\x09\x9d\x0b\xec\x54\x42\x6a\x0e\x6c\x18\x7c\x2b\x1c\xb4\x40\xfd\xaa\x9a\x9a\xae\x6a\xd9\xb9\xca\xf3\xa8\x82\x59\xe8\x22\x54\xde\x0f\x52\x57\x29\x13\xe5\xcd\xe3\x89\xe4\xe3\x2c\x90\xec\xb6\x09\xfa\x59\x39\x28\x31\x18\x12\xb6\x2f\x0a\xfb\x01\xc4\xb8\x6b\x41\xb5\x69\xfd\x27\x5b\xc4\xab\x06\x70\xe4\xd5\x79\x48\xed\x5b\xf2\x25\x84\x22\xa0\xb5\x7a\xf5\x7a\x84\x72\xdf\xc4\x05\xb1\xdd\x12\xd2\xcf\x39\xb5\x15\x01\xf4\xf3\x45\x25\x4e\x03\xc4\x83\x55\xa9\x16\xeb\xba\xc5\x7e\x0d\xc3\xfb\xa2\xdd\x72\x67\x3e\x09\x85\xec\xe1\x6d\x6f\xbe\x01\x6a\x39\x74\xdd\x1f\xb4\x66\x58\xd2\x1f\xed\xfa\x2c\x47\x98\x1f\x8c\x47\xcd\x63\xcc\xae\xcf\x1e\x7a\xe2\x89\x54\x69\x34\xc6\x28\x0a\x48\xbc\x5d\xdf\x91\x1c\x15\xb3\xdc\xce\xc0\x20\x5d\xbb\x90\xef\x6f\x6f\x5f\xcb\xf2\xd4\xfc\xa0\x07\xdb\x5a\x78\x3a\xe5\x6e\x54\x3a\x48\x0a\x36\x77\xf9\x11\xa8\x38\x42\x5c\xc4\x3c\x8d\x96\xdd\xf0\x39\xa5\xed\xf2\xad\xa5\x98\x78\xd7\x75\xb6\xf7\x67\xab\x50\x23\xd3\xde\x80\x5c\x24\xef\x02\x63\x14\x54\x6f\x75\xfc\x77\x3d\x3c\xe9\x79\x74\x8b\xc8\x5b\x02\xb7\x41\x42\x6f\xd1\x42\xd8\x5b\xfd\xf5\x65\x59\x36\x4d\x89\x53\x28\x3c\xef\xfa\x52\x8a\xab\xe9\xd4\xc7\xe3\xab\x42\xa1\xd9\x31\x89\x19\xd1\x27\x8f\xa4\x6e\x2c\xac\x81\x4f\x6b\x59\xd3\xdc\x48\x75\xe9\x77\x77\xe4\x70\x0a\x2d\x1a\x72\xed\x25\xa5\xe5\x94\x86\xeb\x76\x4a\x8b\x7b\x01\xdf\x3e\x4e\xc2\xca\xb3\x94\x08\xc0\xe7\x4a\x22\x73\x40\x80\xd6\xc0\x62\x0a\x5c\x74\x2b\x7e\xf3\x33\xd0\xa7\xb6\x2e\xfd\xe8\xb6\x80\xa6\xbe\x8f\xec\x88\xa8\xba\xc5\x0b\xf0\xf5\x89\x20\x43\xe2\x24\xef\x2d\x40\x8c\xb6\x84\xf0\x8f\x2d\x48\xbc\x42\x81\x24\x41\xdc\xae\xfa\xb7\xb5\xdf\x32\xc6\x7a\x0b\xde\x9c\x79\x30\x2e\xb4\xdf\x2a\xa6\xb4\x3f\xfa\xe7\xb5\x3b\x18\x55\x9c\xbf\xbf\xd8\x36\x1a\xfe\x09\xf6\xdb\xe7\x10\xc3\xb5\x36\xb9\x31\x48\x00\x70\xc9\x09\x00\x9f\x7e\xf7\x39\xc4\x3f\x36\x12\xfc\xbb\x27\x11\x63\xb8\x16\x0e\xcb\x2c\x57\xad\x5f\x20\xf2\xae\xeb\x66\x85\xf5\xe2\xb4\x67\xee\x76\x5a\xcc\xd2\xd9\xac\xce\xae\xd2\x16\xee\x53\x2e\x5d\xa9\x24\x02\x0c\x67\xd1\x86\x40\x0d\x2d\x17\x77\x5f\xef\x96\xb5\x7c\x88\xf9\xfc\x6a\x32\xf7\x38\x8a\x79\x88\xfd\x16\xfa\xf4\xd7\x17\x02\xfb\x38\x26\x0c\xbb\x2a\x52\x97\xbe\xfa\x55\x92\x9e\x10\xc5\x8b\x73\xe9\xd1\x6e\x6e\x56\x85\x60\xec\xa3\x3a\xc7\xd0\x8c\xe7\xd5\xa9\x5d\xd7\xb1\x2d\xed\x68\x56\x87\x51\x1b\x35\x18\xe7\x9f\xcf\x13\xed\xc2\x61\x53\x87\x07\x87\x8d\x20\x69\x46\xdd\x39\x0b\xf9\x47\x4b\xa2\x90\x6c\x85\x75\x10\xd7\xd1\x9f\xc3\x43\x3b\xb9\xaa\x7c\xda\xd7\x7c\x4b\xd1\x92\x59\x08\xc3\xdc\xbb\x34\x7a\x01\x8d\x39\x66\x1f\x91\xaa\xc8\xdf\x2a\x47\xa3\x8c\x95\x51\xc3\x17\xef\x3b\x86\x02\x5c\x09\xa7\xd1\x93\x9b\xd1\xcc\xe8\x7d\x77\x4f\xf6\x83\x7a\x2f\x72\xa7\x4d\x65\xf7\x17\x8d\x1e\xc2\x41\x61\x5d\x30\xd4\xbf\xc1\x36\xb0\x6d\x7f\x93\xb3\xcc\xc6\x8b\xa6\x91\x75\xc3\xe9\x41\xc3\xc1\xc9\xec\x4c\x08\x46\x5a\xe4\xa4\xf9\xb0\x23\x5e\xb7\x19\x37\xa5\x81\xd0\xa9\x14\x3b\x64\xda\x87\xf0\xce\xfd\x1a\x5e\xe7\xfd\x91\x5d\x67\xf2\x15\xdc\x1d\xc7\xb0\x7f\x75\xff\x75\xbb\x54\x6a\x88\x7a\x42\x3f\xdf\x76\x25\x89\x2c\x66\x86\x91\x64\x92\x61\x29\x7a\x34\xde\xac\x11\xbe\x28\x84\x2a\xaa\x4a\x86\x9d\x33\x61\x3e\x57\xb0\xea\x91\xe6\xce\x37\xef\x3e\xa1\x2e\x5d\x36\x14\x80\x7d\xbf\x33\x33\x0d\xb1\x33\xe8\xf8\xa4\x76\xf1\xec\xd6\x21\x05\xbb\x68\x51\x98\x0e\x31\xfc\xa4\x0a\x70\x2e\xf3\xeb\xf0\x64\xae\x70\xae\xd4\x11\xed\x53\x6f\x92\xaa\x91\x82\xbc\x51\x04\x95\x5f\xe4\xc0\xaa\xe5\xad\x1d\x78\x29\x1d\xbf\x59\x5c\x93\xe9\x86\xb9\xd6\x93\xbe\xe3\xf1\x28\xb8\xbf\x41\xfd\x3e\xf9\xce\xc4\xac\x6e\x2f\x6d\xde\x1c\xe4\x66\xb4\x56\xac\x9f\x2a\xde\x12\x9b\x64\xe8\xfd\x6c\x30\x3a\x76\x38\x2b\x9d\x24\x95\x1a\x0d\xe3\x82\x42\xb5\x1b\xd6\xb5\x91\xd2\xa7\xbe\xf1\x1a\x29\xcc\xc0\x41\x85\x77\xbc\x9b\x1e\x60\x07\x3e\x39\xc7\x78\x90\xd9\x02\xd6\xa3\xb4\x2f\x9d\x2d\xb4\x01\x01\x63\x5b\x74\xba\x5a\x56\xa4\x79\xbe\x33\xef\x87\xf7\x3a\x80\xd7\x82\x15\x3d\x4e\x72\x70\x62\x9b\xfd\x0e\xdb\x9c\x2d\x30\x24\x91\x6f\xb9\x7a\xe0\xd3\xa5\x28\x21\x24\x70\x80\xa7\xe5\x94\xdf\x07\x5d\x5e\x38\x26\xfb\xc9\x51\xd0\x40\xd8\x5a\xe4\x6d\x4e\x57\x34\xb8\x58\xff\xa9\xaf\xba\x2b\x81\x16\x66\xe6\xc9\x60\xd3\xc8\xa2\x58\x70\x81\x1c\xc1\x82\x1e\xdc\x04\x05\x3d\xa0\x8f\x27\x8c\xb8\x5d\x6d\xaf\x5e\x2c\x14\x33\x0e\xc8\x9e\x4f\x9d\x6a\xf9\x36\xbb\xee\xb7\x54\x9b\x84\x83\x2c\x29\xaf\x82\xbe\xbd\x8d\xf4\xf6\xc3\xce\x95\xcb\x81\xdf\x16\x9b\x64\xb1\xbd\x89\x69\x25\x52\x3f\x13\xdf\x16\x2b\x73\xbe\x15\xdf\x25\x7f\xb4\x94\xf4\x17\xb3\xe6\xe6\x3e\x0a\x59\xd0\x2b\x4e\x62\x82\xde\x6e\x86\x74\x37\xd1\x35\x27\xcc\x2e\xc2\x27\x6f\xc4\x6a\x29\x6b\x45\x18\x69\xd9\x59\xed\x6c\xa2\x86\xc7\xd5\x0d\x11\xcb\x2f\x60\x2e\x83\x1a\x4d\x89\x15\x1c\x0a\x24\x1b\x57\x23\x9c\xdb\x90\xcb\x73\x5a\xdb\x8c\x01\xa1\xa3\x4a\x33\x6b\x82\x6a\x18\xe1\xab\xad\xd4\x8b\xa8\x5e\xe9\xec\x0e\x8d\xfc\xb1\x94\x17\x6c\x80\xef\x1e\x2b\x8a\xf1\xbd\x87\xb8\x87\xe0\xb8\xde\x5b\xb5\x5a\x58\x89\x6a\x5c\xad\xb3\x70\xb4\x80\x9a\xdc\x64\x0c\xcc\x33\x5c\x3b\xef\x40\x0c\x6e\x9e\x78\x12\xb9\xa9\xa0\xd3\x4c\x15\x55\xe5\x9d\x2f\xb3\x0d\xb7\x92\xa5\x81\x4a\xbd\x62\xac\x46\x00\x1f\x60\x0f\x22\xfc\x0e\xb9\x6d\x41\xbd\xa0\x13\x45\xbf\x0e\x94\x14\x36\xab\xc5\xce\xf9\x42\x40\xb4\x5a\x95\xee\xfd\xc6\x8a\x3b\xd2\x57\xff\xfb\x1b\x1f\x75\x0c\x32\x05\xe3\xe5\x0a\xd6\xb2\xd7\x76\x21\x71\x22\xe8\x23\x0e\xc2\x93\x83\x1b\xee\x8c\xed\x1e\x13\x2b\xc4\xe2\x00\x0a\xb0\xf9\x74\x3e\x21\x93\xe7\xf5\xc5\xea\xa4\xfe\xfe\x1d\xdd\x69\x06\xf1\x7e\x56\x98\xdc\x0e\x85\xb1\xec\x0f\x5b\x0b\x7b\xd0\x38\x2c\x76\x61\x6c\xfd\x5b\x11\xa5\x37\xd7\xa0\x0b\xd7\x20\x44\x86\xe6\xf4\x54\x61\xdb\x88\x3d\x9f\xca\x6c\xbe\x21\x5c\x2f\xd8\x74\x3c\xba\x9e\x6c\x77\xad\x62\x85\x87\x44\xcb\xde\x71\x28\xde\x46\x69\xa3\x6c\x67\x44\xf4\x39\x93\x52\x23\x5f\x72\xd0\xfe\x50\x9f\x04\x5d\x22\xea\x41\x4f\x54\x8f\x15\x9a\x8f\x57\x21\x1c\xc1\xa5\xde\x94\x11\x00\xca\x8b\x9d\x08\x3b\x00\xe3\x9e\x0e\x05\x11\x64\xfd\x09\x22\x34\x89\x8f\xef\xe4\x5d\x89\xed\x13\xf4\x8a\x8a\x42\x7a\xcc\x4b\x95\xd0\xec\xcf\xd7\x90\x42\x38\xd0\xc2\xda\x99\x67\xab\x74\xe9\x7e\xaa\x99\x54\xab\xe9\xb7\xd7\xda\x74\x34\xe8\xd3\x8a\x73\xcd\xb0\x14\x5b\x62\x6c\x0c\xdf\xbc\x03\xdd\x45\xc0\x99\xbd\xbd\x9b\xcb\xa3\xae\x65\x46\x5d\xfe\x89\x9b\x77\xdc\xec\x11\xd4\x11\x3c\x48\x19\xcf\x26\x30\x8d\x81\x10\x44\xdb\xa5\x3c\x22\x07\x21\x77\x0e\x0d\xbd\x22\x89\x66\xfa\x90\x1c\x13\xcc\xb9\x7c\x65\x46\x21\xcd\x47\x90\x5f\x41\xa1\x29\x78\x65\xb6\x02\xab\x56\xd3\x5a\xdb\x1e\xcc\x9f\x7e\x07\x59\xe4\x7d\x26\xc7\x77\xf7\xf3\xdd\x15\xc8\xb4\xfc\x2b\xdd\xa1\x74\xd8\x9c\x7a\x2a\x86\x71\x9c\xf7\x81\xac\x00\xab\x27\x5a\xf9\x28\xd5\xf5\x6c\x76\x10\x2c\xc7\x29\x96\xde\x17\x0c\x9b\x09\x52\xf3\x61\xbf\xce\x5a\xab\x64\x23\x48\x82\x34\xd2\x86\x48\xde\x91\x8d\x83\x38\xd0\x38\x5d\x43\x69\x2c\x84\x02\xce\x41\x31\x8b\x25\xb9\x6f\x3f\x34\x12\xbe\x06\x28\x53\xab\x69\xae\x70\x18\x94\xe8\xea\x8a\xbf\x60\x2d\x2e\x0c\xd6\x7d\xb7\x0b\x60\xc1\x87\x47\xf5\xfb\x80\xb6\x9c\xb0\x21\xee\x97\x7b\x11\x32\xf9\x46\xfc\x02\x26\x0b\x0f\xbc\xe2\x67\x69\x47\x42\x41\x57\x7e\xcf\x37\xa6\x6c\x10\xb4\xa2\x7f\xdc\x79\x9f\xcc\x90\x16\x2e\xa4\x6b\xec\x04\x47\xde\x38\xf3\x2a\x60\x8d\x58\x39\xe3\xbc\x17\x53\x77\xac\x54\x20\xfc\xe8\x18\xde\xb9\x6d\x8a\xa0\xde\x37\x9b\x86\x7f\x94\xab\xb7\x56\x9e\x3f\xb2\x3f\x77\x47\x25\xb6\x1f\xf9\x81\xbf\xaf\x08\x9f\x11\xdf\x78\xa7\x86\x9b\x4c\x96\x13\xca\xec\x94\x55\x33\x66\xf8\xea\x0b\xc6\x17\xc8\x19\x41\x28\x57\x11\x52\x4d\x8e\x8d\xac\x33\xed\x79\xef\xf2\xb4\x66\xc9\x97\xd3\x8b\xd3\x74\xb9\xa9\x4d\x45\x2a\x69\x4d\xbe\x6c\x16\x6b\x63\x84\x42\x3c\x69\x4d\x8f\x44\xe1\xea\x6d\x17\x72\xd2\x8e\xc5\xeb\x4f\xc0\xfe\xe5\xf7\x65\x4d\xeb\xb2\xb6\xd3\x0d\x14\x03\xd3\x89\xd5\x51\xf8\xcb\x3a\xef\x14\x1d\x3a\x3c\xf4\x0e\x4f\x06\xc4\x64\x22\x71\xd0\x78\x6b\x2b\xbb\x87\x5e\xd6\xe1\x1f\xa0\x02\x73\x23\xe8\x69\x43\x56\x4a\x04\xed\x77\x91\xc5\x43\x0d\x99\x1c\xd2\x70\x53\xaa\x7e\xb8\xb5\xd1\x9b\xd4\x28\x6c\xb0\x32\xaa\x99\x02\x5a\xa3\x6c\x0f\x7c\x6f\xf0\x32\x34\x35\x64\xd7\x72\x89\x0e\xf5\x31\xfa\xe3\xbe\x67\x4a\x68\x37\x73\x44\xdf\xb1\xe1\xf6\xc5\x1b\xd8\x6c\xa8\x9e\xb9\x75\x60\xe4\x0a\xa5\xc9\x0e\xa1\x92\x27\x24\x94\x0d\x8f\xe7\xae\x70\x19\x56\xbb\x27\x66\xa9\xc1\xea\x61\x8f\xae\xc8\x25\xa3\x7b\xb7\xb8\x36\xd2\xd2\x72\xf3\xb9\x63\x03\xac\xdb\x49\xaa\x6c\x32\x45\xd2\x37\xaa\x92\x28\x94\xfa\xdc\xdd\x19\xf5\x87\xd6\x02\x41\xe0\xa2\x4a\x4e\xc0\xe9\x5a\x62\xd0\xb7\xe4\x36\x9c\x90\x40\xc6\x08\xc6\x51\x08\x3f\x39\xd6\x7a\x0d\x1e\x98\xd0\xc0\x39\x57\x1e\xb6\xa6\xdd\x22\xbb\xbd\x37\xc0\x9b\x87\xf5\xc5\x80\x48\x60\x72\x3c\x0e\x29\x1a\x80\xda\x42\x41\x7e\x97\xfb\x2a\x8d\x20\x0d\xd7\xd9\x53\x38\xa1\xb1\x29\x2a\x5a\x8e\x5f\x8d\x80\xdb\xf2\x56\xf0\x96\x73\x0d\x8e\x17\x32\x0d\xe1\xc3\x0b\xdb\xe1\x3d\x9d\xad\x82\x70\x16\xa1\x92\x6b\x65\x49\xa6\x7b\xfc\xfa\x9e\xf7\x0a\x9c\x8a\xc1\xc4\xc2\xdb\xea\x07\x3e\x88\x7e\xd8\xa0\xfc\x51\x5c\x3c\x98\xdf\x47\x2f\x94\x72\xb4\xc2\x3a\xb5\x87\x99\x7a\xbe\xcf\x56\x8c\x5f\xe9\x21\x31\x06\xa7\x57\xc0\x3a\x6a\x69\xe0\xf4\xc9\xae\xde\x90\xbd\xed\x3b\x18\xd4\xf4\x6c\x50\x86\xb0\x24\x61\x8b\x11\xda\xa7\xac\x7a\xa3\x3c\xac\x08\x82\xae\xf0\x9d\x08\x07\x99\x57\xd8\x62\x9d\xd1\xc9\xc7\x45\xcc\x3e\x85\xd7\x4e\x39\x5a\xb0\xe8\x57\xb3\x05\x64\xe0\xc7\xf5\x77\x76\x5a\x0d\xa5\x5e\x30\x26\x73\x5d\xa9\xe5\x48\xfa\x0e\x0f\x47\xe7\xf1\xf5\x78\xc1\xc2\xd2\x4c\x5e\xaf\x64\x02\xa0\xcf\x68\xc7\xd7\x07\xf9\x73\xa4\x55\xd1\x3a\x1d\xd9\x1f\x9c\x29\x02\xcb\x7f\x1c\x61\x56\x29\x19\x0d\x1f\xf3\x8a\x9a\xc1\x9b\x71\x41\x86\x7d\x9f\x7c\x53\x5c\x8e\x6b\xf8\x18\x32\x48\x4e\x8c\x8e\x84\x29\x1e\x84\x9e\xc5\xb1\xe6\xf3\xe4\xa0\x1e\x8c\x0b\x5a\x18\x7c\xef\xd9\xd5\x07\x65\x2d\x8a\xbf\x16\xca\xb1\x04\x5a\x89\xbd\x33\x36\x9a\x10\xbd\x41\x96\x9a\x68\xdf\x15\x59\xfa\xd5\x9c\xb7\xdd\x04\xcf\xc7\x00\xef\xc7\x6c\x87\x90\x1d\x86\xe3\xd6\x78\x08\x2c\xdc\xbd\x25\x31\xde\xcf\xcd\x7e\x34\x0f\xfd\x83\x87\x95\x0f\x76\x41\xbb\xb6\xb4\xc5\xa3\xdf\x1e\x39\x6d\xeb\x7f\x22\xd0\x52\x27\xb7\x36\xf7\xbd\x44\x32\xff\x3c\xc2\xb8\xae\x1c\x7d\x31\x3f\xb1\xdc\xeb\x87\xbe\x9b\x92\xb7\xb0\xbc\x6c\x53\x2f\xec\x91\xfb\x86\x40\x50\x61\x8d\xe8\xf3\xc4\xd0\x1d\xe6\x0b\xb1\x78\x35\xb3\x48\x68\xd8\x6e\x25\x5c\x17\x1d\x38\x90\x0f\xa3\x8c\x94\xe6\x68\xf2\x3c\xfc\x35\x37\x23\xc9\x59\x85\x3d\x1c\x2d\x15\x4b\xda\xbd\xf4\x92\xe3\x36\xac\x65\x87\xaf\x3a\x3f\xe1\x1d\xda\xc4\x01\x0f\x5d\x0b\x81\x03\x19\x83\x7b\x29\xea\xd4\x84\xf2\x6a\x0f\x03\x35\xb1\xca\xb2\x48\xe4\xd4\x0d\x93\x69\xc3\xd2\x37\x1b\x0e\x1b\x67\xc4\x32\x1c\xb6\xdd\xd8\x0c\x9b\x1b\x8b\x13\xab\x03\xb7\xcf\xdd\xfc\x67\x43\x3c\xc7\x9d\x45\x06\xcc\x3e\x31\xda\x25\x3a\x46\x53\x48\x06\x37\x14\x13\x34\x7e\x2b\xd3\xfa\x77\x3e\x08\x07\xf7\x01\x5e\xf4\xc6\xf7\x36\x81\x46\x8c\x21\x46\x0e\x50\x54\xaa\xfe\x6a\x15\xc8\xe1\xfe\x3d\xeb\xa8\xa6\x0f\x17\xa7\x3c\x1e\x7e\x14\xb1\xac\xee\x04\x74\xd2\x61\x18\x10\xdf\x7c\xd3\xe3\x38\x4d\xe0\x34\x80\x76\x6d\x11\xea\xf5\x06\xab\xc5\x90\x6b\x20\x71\x3a\x82\xd3\x55\x00\xc5\x65\xd0\xaa\x54\x45\x09\xa2\xb7\x9f\x7a\x31\x62\x1b\xf6\xd3\x99\x02\x6a\x03\x5e\xf1\xb5\xca\x95\xd3\x9d\xaf\xcd\xf2\x43\xd4\x3a\x1b\x35\xdf\x80\xfd\xdd\xcb\x8c\x46\xb9\xc8\x67\xa4\xda\x9a\x74\xc9\x68\xc7\x4a\x26\x4b\x59\x67\xc3\xca\xcb\x41\x69\xb2\x36\xfd\xc6\x89\xaa\xb7\xdd\x63\x36\x73\x5b\xc4\x71\x8b\xa1\x04\x23\x58\xa8\x34\xa7\xd9\x42\x87\x7e\xda\xc7\x3c\xaa\xce\x85\xae\x22\x3b\x3b\x7c\x03\xcc\x61\x1b\xec\x1f\x1b\xa5\x63\x17\xa5\xa5\x61\x5d\x5b\x29\xb5\x35\xe7\x8f\xf4\x9e\xa7\x98\xea\x3c\xb1\xcf\x3a\xd9\x74\x63\x8e\x73\x12\xe6\xc6\x36\xaf\x3f\xbd\x44\xc9\x1e\xc9\xd0\x2d\x70\x7a\xdb\xa8\x37\xe5\xb2\x2d\x47\xef\xf0\x65\x31\x9e\xa3\x65\xd5\x18\x09\x89\x49\xa0\xc5\xf5\x19\x3b\x02\x9c\xbe\x81\x7c\x8d\xd6\x8d\x8f\x21\x72\xb7\x50\x71\xd9\xe9\xff\x30\x8c\x32\xac\x3f\x2a\x5f\x30\x10\x23\x9a\x46\x68\x8d\x1c\xde\x3c\x9c\x1f\xae\xc4\x3b\xd6\xe0\xc6\x81\x37\x43\x1f\xd4\x20\x9a\x9c\xf3\x25\xa5\xcb\xb9\x88\x67\xcc\xb1\x9b\x04\x19\xe4\xf2\x36\x56\xcb\xbe\xec\xd3\x49\x7a\x43\x83\xee\x95\x60\x15\xa5\x4a\xd1\xd6\x7c\x9c\x06\x75\x14\x0b\xda\xd2\x3d\xad\xe3\x31\x1a\x68\xa1\xf2\x07\x7f\xdd\x50\xae\x14\x9b\x13\x49\xec\x0e\xde\xf0\x82\x23\xfa\xcc\x5b\x42\xcc\x9c\x39\x6e\x0e\xa3\x18\xaf\x92\x9b\xc5\xd1\x4f\x7a\xb3\xe2\xda\x9e\x24\x25\x16\xb5\x85\x25\x8f\xfd\x0d\x90\x3b\x85\xe2\x2a\x64\x12\x62\xe5\x02\xb1\xda\xf3\x3b\x15\x6d\x83\x99\xf3\xf4\xad\x25\xd6\xcc\xfc\x6b\x30\xeb\xec\xca\xa7\xe4\xb8\x16\xaa\x27\x18\x4d\xf0\x0c\xc3\xd6\xb1\x68\x13\xd5\x0b\xda\x89\x7e\x83\xba\x64\xc3\xdc\x20\x60\x9c\x42\x1b\x7e\x2a\xab\x6f\xc8\xde\x65\xf8\x53\xf4\x15\x3c\xd5\xbb\xee\x7e\xd4\x1f\xbd\xae\xc1\x3c\xdd\x09\xab\xe5\x72\xc5\x6f\x44\x25\xcd\x89\x4f\x97\x1d\xf1\x3b\xc8\xe7\x1d\x68\x60\xcc\x57\xc8\x61\x16\xca\x49\x75\xbc\x12\x85\xac\xe5\xf3\xcf\xed\x6f\xf6\xd8\xd1\x3c\xce\x63\x99\x3c\x5c\x96\x95\x96\xc0\x97\x74\xa2\x94\x98\x46\x17\xd6\xed\xbb\xbd\x7d\xda\x46\x70\x25\xbd\xb2\x92\x38\xd7\xe4\x96\x74\x39\x33\xd5\x74\x2d\xec\x3b\xae\x79\x8e\xb1\x4f\x3c\x64\x30\x07\x27\x57\x4e\x3c\x10\x5c\xbf\x48\xb0\xaa\x4a\x0f\x44\x2f\xb9\x85\x09\xc7\x74\x7e\x9a\xca\x20\x85\xb9\x00\xa1\x5e\x68\xe3\xa9\x1f\x9c\xcb\xb9\xd5\xdc\xdc\xc0\x99\x8a\x8f\x85\xab\x0a\x83\xe3\x19\x68\x20\xf8\xae\x18\xb0\x72\x23\x74\x8b\xf3\xc5\x27\x30\x5c\x8d\x73\xae\xc2\x89\x13\x9e\xfb\xed\xfe\x70\xb3\xeb\xf9\x0b\xfe\x77\x5e\xaf\xeb\x9e\x63\xff\xf8\xed\xd5\xe3\x71\xe9\x12\xd8\x81\x8c\xa2\xeb\x03\x8d\xb1\x86\x73\x91\x0b\x7b\xe3\xcc\x84\xcb\x69\x8c\xac\xf0\xcd\xf7\x51\xd5\x06\xcf\xb7\x2c\xcf\xc6\x73\x39\xd4\x0d\xca\xf6\x35\x77\x6b\x46\x6c\x52\x9f\x97\x4b\xb4\xca\xc9\xbf\xde\x8c\x1b\x28\x0e\xe3\x7f\xeb\x69\xfc\xbc\x3a\x80\xc4\x62\x50\x2f\xa1\xe1\x18\xa9\xb0\xf9\x33\x3d\x49\x1d\xd3\xdd\x88\x3f\xfa\xf8\xee\xb4\x96\x6a\x3d\x45\x2c\xf7\x9c\x3f\x15\x7c\xf1\xa6\x97\xb1\x91\x67\xff\x90\x28\x66\xf1\xbd\x11\x07\x52\xd1\x50\x05\x3f\xbb\x13\x38\x57\x9e\x30\x11\x49\x70\x29\x2a\x6c\xa3\x28\x89\x82\x52\xae\x94\x5f\x52\xc0\x98\x66\x1b\x7b\x01\xb3\xaf\xe6\x22\x05\x0e\x4b\x6d\xd7\xc1\x41\x9e\x66\xbf\x7d\x89\x3f\xdb\xa2\x94\xe6\x5e\x68\x95\xbd\xc2\x55\xcc\x81\x00\x84\xd6\xa9\x7f\xa5\xcd\x62\xb2\xec\xc6\xf3\xdb\x6b\x54\xd5\x4d\xf7\xa0\xcd\x2a\x5a\x0a\x9b\x2a\xbf\xb9\x7b\x19\xc6\xe8\xfe\x70\x4f\x6f\x97\xf0\x21\x5a\x3d\xe4\xee\xeb\x55\x68\x4f\x19\xdd\xe6\xe1\x37\x36\xdc\x7b\x31\x01\xaf\x5a\xaf\x6a\xb6\xcb\xa6\x53\x9e\x29\xbc\x18\x6f\x9d\x75\xf3\x4d\x8d\x9d\x65\x22\xd1\x22\x0e\x8e\xcd\xe3\x4d\x17\x19\x41\x2f\x6a\x53\xfc\x2d\xd9\x22\xa4\x2c\x4e\x5b\x3c\x1d\xbd\xd5\xb2\xa6\xb6\x9d\xe5\x85\x79\x7b\x47\x65\x87\x3a\xd4\xf3\xd3\x52\xa9\x5b\x93\x87\xa5\xe8\xab\x55\xba\xc3\x10\xe4\xda\x1a\xb1\x36\xeb\x4a\x83\x15\xdb\xd8\xfc\x0f\x41\xd4\xb6\x3a\x40\x5f\x78\x7b\x61\x14\xcb\x58\x21\xd2\x12\xad\x56\xb5\x7f\x4a\xa1\x24\xe9\xd8\x9a\x17\xfa\x63\x7b\x16\xca\xc7\xa0\x1e\xbb\xd7\x11\x65\xd1\x99\xc2\x7e\xcb\x12\xab\x3d\x95\xf2\xd1\x9b\x4c\x43\x92\x3a\xbd\xe3\xfe\x22\x2b\x35\x7f\x55\xb1\x15\xdb\x35\x9c\x63\x58\x03\x87\x04\xc7\x17\x5e\x48\x28\xd8\x38\x49\x82\xbc\xe6\xbe\x55\x0e\x84\x10\x49\xa8\x48\x4a\x2f\x63\x09\x29\xff\xd2\x1f\x6d\x8b\xe8\xc2\xfa\x43\x13\x17\x9e\x60\x79\x0d\xf3\x96\x47\x4a\x44\x5a\xc4\x46\x52\x8d\xb7\x37\x85\x56\x2c\x13\x34\x36\xa6\x6b\x6b\x59\x29\xd4\x08\xab\xcb\xa4\x6d\x44\x0d\x0a\xda\xa4\x1c\x8b\x29\x47\x94\x6d\x38\xa7\x8a\xd7\x82\x26\xa3\x33\x90\x4d\xc9\x10\xac\x99\xf3\x4d\x6b\xb0\xda\xb2\x8d\xf6\x92\xd9\x8b\x77\xab\x46\x13\x11\xcb\xe8\x5c\x66\x65\x6c\x87\x4c\x25\x93\x20\x61\xe0\xfe\x90\xb6\x9e\x20\x87\x28\xab\x11\x01\x89\xd4\xb9\x3e\xf0\x2b\x8a\x96\xd1\xf6\xe5\x93\xaf\xda\x35\x1d\xce\xfe\x2d\x56\x88\xb5\x58\x35\x49\x5b\xef\x14\x3d\x76\x0b\xec\xf7\x33\xf6\xa9\x40\x5f\xe9\x70\x1c\xcc\x64\x2c\xb9\xfc\x7a\x95\x74\x62\x5c\xc6\x21\x6e\x0f\xb1\x06\xb1\x22\x35\x45\x13\x6d\x4a\xc2\xb5\x6a\x47\x60\x96\xa5\x16\xd0\x33\xd3\x23\x76\x8e\xe2\xab\x2d\x66\xe6\x60\x6b\xb7\xcf\x5d\x2a\x69\x7b\x88\x3c\x08\x69\xdc\xde\x7b\xd2\x57\x7c\x6a\x9d\x4f\x1f\xbf\x56\xde\x5f\x24\xb0\x91\x7d\x25\x0a\x4a\x18\x1b\x21\x38\xc6\x79\x7b\x7d\xae\x98\x5e\x1c\xda\xea\x4a\xdf\x8f\xa1\xe4\x79\x7e\xdc\xb8\x80\x46\xed\x51\xc1\x25\x94\x7d\x99\x39\xab\x7f\x63\xf4\x35\x97\xe8\x30\xf7\xfc\x01\x23\xc5\xc4\x59\x02\x7c\x75\xc0\xcc\xa7\xfd\x1c\xaf\xfa\x2e\x70\xac\xb9\xb3\x3d\x4c\xcf\x9d\x41\xe4\xf5\xd8\x1e\x99\x21\x0c\x5c\x44\xa5\x23\x5f\x7a\x4c\xa6\x2a\x52\x25\xed\x70\x81\xbc\x84\xf2\x94\xb5\xde\x00\xe7\xf9\x07\x76\xa3\x77\x5d\x8f\x3e\x8a\xf8\x8b\x83\xaf\xbb\x9f\xf7\xcb\x81\x77\x00\xe4\xcf\x02\x04\x98\xda\x68\x78\xea\xb5\x1c\x17\xa7\x71\x2b\x90\x54\x72\x17\xd3\x37\xe0\x88\xba\x1c\xdf\x93\x73\x65\xa1\xab\x11\xcc\xea\xbd\xb9\x16\xeb\x4d\xe7\x17\x51\x3a\xb9\x62\x9c\x4c\x6b\x66\x19\xcb\xa1\xfc\x86\xd3\x84\x48\xfc\x2f\xd7\x83\x14\x79\x0a\x94\xe8\x22\xa7\xf4\x27\xd6\xdd\x93\x3a\xa7\xf4\x27\x6c\xdd\x0b\xfe\xc6\x55\xf6\x83\xe6\x73\x2f\x9c\xeb\x1b\x35\x7b\x05\x5f\x10\x65\xad\x24\x3f\xb3\x1b\xc6\xf5\x56\x7d\xae\x93\x4d\x67\xf0\xfe\xe0\x9d\x37\x98\xee\x84\xd7\xfb\x34\xcc\x44\xc4\x64\x5e\x5d\xd3\xea\xc9\xc6\xd5\x80\xde\xf9\x79\xdf\x4b\x65\xd2\x2b\x34\x2d\x6e\xdb\xfd\x16\xf5\xee\x86\xfc\x35\x11\x01\x94\x49\xef\x0c\x39\xdc\x7c\x09\x0b\x74\xd9\xe2\x76\x76\xc3\x5a\x58\x69\x3a\x74\xf8\x65\x61\x96\x57\xea\x52\x36\xf1\x98\x99\x8a\xdb\x73\x77\xab\x6e\xd0\xfe\xee\xc3\xd3\xc0\x01\xe7\xc9\xb7\xa3\x44\xcf\xf2\xf6\x79\xea\x93\xfe\x96\xa9\xcd\x45\x53\x45\xd2\xfb\xe4\x9b\x48\xde\x72\x93\x47\x4e\x48\xee\xac\x7a\xf4\x9f\xde\xb1\xd9\x4b\xbe\xa8\x3b\x69\x5a\x8b\xa7\x78\x4e\x04\x5c\xe4\x2d\x43\xf2\xc9\x58\xe4\x56\x68\xc3\x4d\x2d\x38\x67\x34\x91\x98\x36\xf5\x23\x5f\x06\x67\x39\x8f\xea\xd4\x2d\xdd\x79\xa5\xcb\x6f\xab\xb5\x2c\x9c\x98\xa6\x8d\xc6\x08\xd3\x80\x99\x72\xf8\x4f\x73\x16\xf8\x70\x9d\xa9\x96\x5f\xe9\xf2\x47\x4e\x66\x93\x6c\x2c\x0e\x59\xea\xde\x50\x69\xfb\xc8\x87\xca\x63\x75\x99\x7c\x07\xe0\xa7\x3d\xc9\xf5\x57\x8f\xcb\x7e\xa6\x08\xdd\x7f\x76\xda\x7a\x0a\xc6\xea\xbf\xe3\xe9\xc2\x6c\xa1\xaa\x20\xe7\x15\x1e\xfe\x22\xeb\x02\x5c\xe7\x51\xbf\xb4\xcc\xa8\xfc\x4c\x5e\x3e\x6d\x10\x8e\x59\xeb\xba\xfa\x12\xa8\x35\x69\xbd\x58\xe9\x62\xf5\x06\x9a\x08\x01\xde\xe6\x6b\x58\x71\x66\xa3\x38\x4e\x97\xc2\xfe\xbd\xdb\xad\x51\x1a\x63\x12\x84\xfd\x10\x74\x6f\x9b\x4d\x5c\xe7\xa4\xdd\x80\xa4\xd4\x62\x86\x15\x23\x1d\x87\x79\xc1\xdb\xd4\x22\xb2\x19\xf2\xc0\x20\x8a\xdd\xb0\x55\x45\xdc\xba\x92\x06\xa1\x30\x06\xbd\x96\x59\xc7\xc4\xde\x80\xfe\xb9\x43\x4e\x12\x0c\x1c\x0d\x00\x00\x68\x60\x45\x49\x4e\x8b\xc9\xac\x8d\xf3\x0b\x08\x00\xb8\x82\x01\x00\xdb\xef\xd6\x1d\x38\xeb\x59\x9b\x59\x5a\xea\xfd\xfa\xae\x5f\xc7\xde\xe8\x6f\x25\x23\x56\x46\xd6\xff\xe9\x09\x80\x57\x2c\xbf\x6c\x0f\x1d\x7c\xe0\xf9\x2b\xba\x8a\x4c\x39\x93\xd3\x7d\x2e\x11\xb8\x80\x29\x6b\x6f\x0c\x9b\x8c\xfa\xd1\xb4\xa9\x95\x02\xc9\x62\x7f\xdd\x69\xc7\x76\x81\xfa\x3c\x68\x5e\xf1\xac\xdb\xb8\xc7\x1b\xd5\xb2\x0c\x2e\x14\x29\x9b\xe9\x4c\x82\xad\xef\xf0\x01\xc6\x82\x20\x25\x3a\x6c\x0f\x25\x6b\xa3\xe9\x9d\xe9\x82\x56\xdc\xe8\xfe\x45\x90\x56\x0f\x44\x77\x16\xe5\x22\xd3\x7b\xb9\x44\x53\xbe\x59\xd3\xcc\x40\x7f\x47\xe6\x90\x4c\xa7\x1b\x3f\x15\xb1\x40\x1c\x74\xb9\x1e\x94\x48\x88\x92\xf7\x38\x34\x9a\xb4\x0e\xcc\x0d\xe2\x17\xa3\x43\xe0\x63\xd7\xf8\x89\xb5\x6e\xb4\xda\x22\x87\x50\xe4\x1f\x70\xa9\x1b\x9a\x57\xad\xca\xe8\xec\xfd\xcd\xc2\xb4\x53\xf9\xed\x90\x8a\xc1\x0f\x50\x3f\xab\xf3\x76\xf6\xc3\x99\x4c\x45\x41\x92\x13\x90\x91\x37\xaa\xca\xdf\x15\xe3\xf4\x61\x18\xee\xb6\x05\x33\xa9\xdd\x45\x12\x50\x5f\x74\xf4\xc3\xae\xce\x26\x40\xaa\x9f\x45\xe1\xd1\x48\x92\x85\x81\x2e\x74\x9a\xa2\xdc\x1c\xe7\x98\xa8\x8f\xa2\x6b\xe9\x4c\x42\xf4\x28\xe0\xa0\xc9\x98\x43\xb4\xc8\xda\xde\xc2\x6a\xc5\xc4\x05\x3a\xca\x39\x1c\x4f\x19\xf5\xc0\xbd\x3a\x36\xee\xe9\x37\x5d\xea\x64\x97\x08\xce\x9c\xbd\xbf\xbf\x7f\x24\x6d\x41\xc7\xa3\xc7\x2b\x44\x5d\x49\x50\xb8\x34\xe6\xe6\x12\x24\x9c\x30\xd1\x0d\x53\xd1\xa9\x15\x23\xdb\x6b\xb3\x76\x7d\x6f\x17\x60\x50\xb3\x93\x9d\x62\x1d\x4b\xd5\xe2\x42\x94\x58\x53\x79\x1b\x32\xa6\x7f\x35\x50\x53\x72\x4d\x11\x57\xf3\x1d\x6f\xfb\x5b\x1d\xcc\xee\x01\xbb\xd3\xf1\x25\x19\xd7\xf7\x35\xac\xc9\xbd\x2f\x0e\x97\xd5\x1e\x74\xa8\x3e\x3f\x15\xd3\xa4\xeb\x04\xbd\xbe\x4e\x03\x1d\xfd\xf8\x10\xe2\xc5\xcd\x79\x4f\x71\x9a\x07\x37\x08\xbf\xad\x09\x8a\x4e\x5d\xfc\xa1\xfd\xcb\x0e\x77\x00\x00\xe0\xfd\x6e\xa1\x89\x95\x9e\x99\xf5\x7f\xf0\xaa\xd6\x68\x17\x82\x0f\x01\x7c\xe0\x66\xab\x22\x16\x94\x2d\x48\x30\xa1\x8f\xf4\xd7\x84\x24\xe0\xdd\x8a\x76\x1e\xfe\xac\x22\xd2\xcf\xfb\x30\x42\x09\x8a\x6d\x43\xb1\x32\xd5\xd8\xba\x40\xb7\x91\x19\x86\x0d\xd4\x34\x50\x6d\x42\xf2\x63\x82\x64\x9b\x6f\x69\xb1\x62\x1d\xbe\x12\xb6\xaf\xd0\x9d\xbc\x19\xab\x32\xed\x8c\x1f\x4f\x71\x63\x2c\x06\xe2\xa2\xda\xc7\x5e\x8f\xfc\xba\x59\xd9\x41\x7e\x5b\xc8\xb4\xe2\x2b\xcf\xe2\x0b\x03\x00\xb7\x1f\x00\x80\xe6\x77\x4f\xda\xcc\xda\xd9\xc8\xda\xd1\xc6\xde\x4d\xc7\xde\xc8\xc1\xd1\xc6\xfe\xbf\xb7\xf1\xfc\x77\x56\x94\xab\x9f\xdb\x2f\x37\xa1\xfc\xac\x28\xe6\x63\x62\xa5\x4f\x25\xab\x5b\x2d\x98\x97\x51\x58\xfd\x91\xdc\xc4\xad\xe3\x10\x4a\x6a\xbf\xb4\xd6\x84\x8f\x4d\x44\x18\x0a\x03\x63\x0e\x4c\xb2\x90\x4e\x5d\xdf\x70\x3f\x19\xae\x42\xf0\xbd\x23\x28\x51\x13\x2f\x45\x7a\x07\x41\x7d\xf7\xf3\xcd\x19\xa4\xf0\xb1\x08\x39\xcc\x93\xa8\x31\x70\x30\x0e\x26\x36\x51\x89\x8a\x54\xa3\x06\x4d\x58\xa6\xff\xcb\xb8\xe2\xb8\x3e\x7f\xf2\x91\x1c\x83\x5d\x4f\xef\x43\x9f\xa9\xa3\xab\x18\x0d\x78\x24\x6f\x5c\x24\x99\xfe\x27\x12\xc9\xc1\x4f\xf9\xe8\xa8\x71\x5f\xfd\xe8\x68\x24\xbb\xc4\xba\x16\x51\x13\xf7\x22\xa0\x3f\x29\x13\x7e\x95\x80\x43\x0c\x57\x67\x0e\x83\xa3\x51\x6e\xbd\x5f\xb1\xce\x7c\x37\x6e\x3d\x14\x51\x42\xc0\xc4\xa0\x5d\x9c\x03\x4b\x3a\x22\xa0\x76\x27\x64\x7d\x40\x4d\x7a\x12\x64\x47\x22\x1e\x83\x5e\x9a\x10\xfc\xb1\xdc\x15\x1c\x87\xc1\xee\xdb\x97\x50\xf8\x5b\xf8\xd0\x8f\xea\x34\x86\x54\xd3\x10\x70\xa0\x6d\xb4\xc4\x33\x1f\x06\x8b\x51\x7c\x93\x7f\xf4\x54\x65\xe1\x34\x38\x9f\x87\xe3\x77\xa9\x83\x12\xef\xd1\x4b\xa0\xca\xc9\x29\xfa\x24\x9d\x4b\x9c\xa5\x0e\x9e\x99\x96\xd5\x84\xbd\x47\x1d\xf2\xe1\x17\xa8\x33\x16\xa2\x2c\xbd\xc7\x42\x12\xcd\xdb\xc1\xdc\xc8\xca\x53\xe2\xdf\x20\x33\x6a\x6e\x87\x10\xfd\x41\x43\x14\xd1\x7f\xfc\x79\xc2\x6f\x20\xc2\x72\x12\xf3\x02\x6e\xd0\x82\xa5\x50\xf2\x9d\xa3\x17\x4e\x79\x09\xbc\xf9\x6b\xcf\x24\x8a\x2f\x6d\x97\x12\xf9\x43\xb8\xb1\x15\xa7\x39\xbe\x47\x84\x69\x5c\x5d\xc4\x12\x75\x0e\x74\x00\xe9\x97\x11\xec\xa5\xab\x0a\x27\x7f\x92\x7e\x31\xf3\x39\xd4\xab\xd6\xfc\x0b\x88\xcf\x73\x0f\x46\xb0\x92\x42\x85\x97\xa2\xec\x4b\x4b\x21\x1d\xa3\x58\xbb\x05\x2b\xf0\xc4\x73\xa7\x38\x0a\xfc\xe5\xea\xc2\x3e\x7b\x41\xa7\x11\x4f\x16\x2f\x4e\xeb\x5f\x8f\x73\x5a\xec\x78\x25\x23\xac\xb8\x9f\x51\x93\xe8\xfb\x34\x31\xb1\x32\x3f\x70\xe3\xc4\x8a\xee\xac\x34\xe9\xb1\xed\x6f\x33\xfb\x2b\x74\x51\x15\xa9\x50\x59\x37\xab\x15\xc7\xf1\x21\x0e\x7e\xd2\xa3\xab\x89\xb7\x5e\x5a\xf8\xba\x7c\xe2\xe6\xc8\xf6\x39\x89\x6b\xce\xf2\xdc\xa9\xad\x5e\x35\x14\x36\x2b\x2d\x04\xfb\x1a\x5d\x6b\x88\x25\x5b\x55\x4a\xab\x53\x60\x03\x7c\x2e\xa6\xf0\xbd\x97\x62\xc8\x51\xf7\xe4\x76\x83\x69\xaa\x71\x8e\x3a\x30\x71\xa0\xf4\x10\x52\x90\x27\x30\xf4\xa5\xc0\x1c\x2f\x22\x72\xd7\x12\x51\x63\x7f\xbb\x2a\xea\x4b\x66\x0e\x7e\x0f\x99\x81\x67\xe1\xdc\x0e\x94\xfd\xac\xac\xf4\xd8\x6e\xd9\xa7\x9f\x70\x3e\xb1\x2a\x53\xa6\x5c\x97\xcc\x0e\x37\x6b\xef\x92\xc4\xe7\x36\x31\x0c\x3e\xa7\x8a\x65\x5d\x05\x84\x26\x5b\xe8\xae\x1b\x9b\x92\xfe\x6c\x24\x2e\x82\x85\xa5\xd1\x15\x40\xeb\xfe\x01\x9f\xa9\x60\x48\xe5\x67\x0a\x3e\x5f\xfc\x40\x79\x40\xff\xde\x11\xfe\xde\x25\xbb\xec\x0b\xfb\xd8\xe2\xdd\xe3\x6d\x80\x69\x94\x3c\x62\xaa\xb0\xea\xa8\xc4\x5a\x54\x83\x34\x7e\x2a\x59\xcd\x1c\x87\x7f\x37\x0b\x8c\xe3\x24\x59\x81\x08\x15\x96\x72\xc4\x4a\x81\x40\x22\xd3\x47\x7b\x2d\xe3\x0f\x28\x28\x72\x4f\x61\x8f\x66\x57\x89\x59\x37\x71\xfd\x5d\xf9\xd8\x2c\x30\xe5\x9d\xa0\xb6\x30\xdd\xd2\xae\xa9\xb4\x7c\xa6\x1b\x90\x2f\xa6\x02\x2b\x95\x03\xba\xd5\x61\xf9\x55\xf1\xca\xef\x5c\xab\x7b\x8b\x12\xb2\x51\x1e\x14\x91\x27\x61\xd4\x1d\x09\xaa\x57\xa5\xd7\x13\xd8\x8b\x68\xd0\xdf\x95\x29\x80\x44\xda\xc3\xab\xda\x1e\x48\x20\xbe\x25\x72\x21\xee\x07\x6f\x46\x4f\xed\x5e\xa2\x3f\xbe\xa4\x46\x44\xf7\xcb\x14\x68\x58\x87\x23\x36\xf6\xb1\xda\x33\xaa\x99\x27\x78\xe4\x35\x73\xb8\xed\xaa\x80\x38\xc3\x17\x19\x85\x33\xa8\x84\x98\xee\x24\x2f\x2e\xb3\x22\x73\xc5\x5e\x25\x86\xba\x7c\xa7\x61\xcf\xd1\x6f\x83\x88\x09\xf6\x8c\x81\xc8\xc6\x8e\x23\xc7\x8e\xd6\xd8\x4b\x31\xe5\x57\x9c\x2d\x50\xe3\x38\x3b\x55\x41\x1f\xe4\x69\x53\x9c\x6c\x46\x17\xff\x9a\xf7\x29\x0a\xd2\x44\xd3\x81\x37\x75\xd2\x7a\x1f\xec\xad\x9b\x1a\x03\x86\xa8\x79\x48\x01\xfd\xfd\x28\x12\x47\xfb\x64\x26\xf4\x61\x1a\x68\x76\x5c\xb1\x96\x56\x19\x4f\x07\x5a\x89\xf5\x41\x9e\x82\x05\xd3\xb8\xa9\xbf\xb6\xef\x39\x11\x3d\x05\xa4\xff\x52\x1b\x47\x33\x1b\x8c\x07\x57\x64\x9a\xe9\x1a\xbb\x75\x26\x4e\xe4\xc7\xd4\x42\x7a\xb8\xac\xa0\x29\x28\x64\xb6\x1c\xc6\xf6\xf7\x1f\x78\xb3\x58\xa8\x3c\x5b\x4e\xe5\x1a\x1d\x39\x55\x59\x73\x64\x3b\xeb\xdd\xec\x7a\x72\x6c\x29\xc9\x28\xd3\x8c\x9b\x70\x30\xdd\x64\x48\xa6\x00\x7b\xc1\x5a\x34\x73\x77\x69\x7a\xba\x49\x0b\x11\xb0\x27\xa9\x4c\x5a\x94\xef\xfb\xfc\xa4\xc5\x32\x63\x93\x3f\x12\x11\xb9\x21\x3f\x63\xd7\xc1\x1e\xd0\x2e\x50\x7f\xf5\x9b\xbd\x40\x7e\xf4\xed\x59\xe8\xba\xf4\x1f\xf1\x5f\xfb\xbe\x71\x08\x06\xbe\x53\x5d\xbb\xd3\x7d\x40\xf3\x16\x41\x9a\xf4\xac\x9c\xdd\x4a\x03\x7f\x4a\xbe\x2e\x39\x68\x04\x06\x03\x90\x55\x6b\xe0\x8a\x86\xe8\xfa\x58\x22\x1f\xf7\x0c\xb9\x04\xa3\xda\x4f\x22\xdc\x44\x59\xe9\xc2\x91\x31\xb3\xc1\xb9\x7d\xfd\x02\x8a\x38\xca\x0a\xeb\xd2\xf9\xb5\x54\xaf\x87\xcd\x13\xb8\xd8\x6c\x3d\xd6\xc4\x12\xbf\x51\xb4\x0d\xe8\x99\x94\x6f\x8a\xcc\x3b\x68\x41\xed\x77\x18\x43\xce\x5f\x68\x00\xa4\x7a\xb5\xbc\x1e\xd1\x93\x2e\x95\x2c\x90\xcd\x8c\xfc\x54\xf4\x83\x6f\xd7\xe9\xc7\xaa\xac\xbe\x32\x2b\xef\x24\xc5\x2a\x83\xf4\x21\xc5\x90\x80\x97\xbf\xc4\x64\x6e\x28\x24\x48\xbc\x62\xbb\x76\xbb\xeb\x29\x5a\xc8\xaf\x1c\x0d\x69\xb1\xf6\xea\x96\x49\x8b\xc1\x77\xf2\x3d\xd9\x89\x0e\x37\xad\x5b\x5a\xb4\x9e\x16\x1c\x6b\xb4\xcb\x56\x5e\xbf\x4c\x47\x58\x49\xb7\x0a\x02\x74\xe1\x63\xef\x8c\x37\x46\xab\x62\xf1\xb6\x3d\xa3\xc7\xc8\x3e\x7d\xc4\x37\xd5\x35\xee\xa4\x47\xf4\x98\xaf\x5a\xe9\xfa\x28\x20\x6d\x20\xa2\x3c\x34\x5b\x0a\xfb\xee\x63\xe4\x84\x93\xf0\x37\x8a\xa6\xb9\x0c\xe6\x6d\xb7\x4c\x4e\x93\x21\x0c\x48\x08\x3c\xb9\x78\x9a\xc3\x1a\xd8\x27\xe7\x40\x3c\x2d\xdc\x17\xbb\x60\xe4\x93\x79\x1d\x53\x34\xff\xcc\x0e\x6f\x75\x4e\x78\x57\x10\x90\x71\x0f\xce\x9e\xfb\x22\xb9\x1c\x63\xc6\xd5\x27\xaa\x1c\xfb\x78\x9d\xb1\x41\x54\xcd\x9f\xfb\xeb\x37\x8d\x79\x68\x59\xb2\xcb\x3e\x3d\x76\x81\x54\x58\x16\x45\x49\xbd\x37\x3d\x10\x19\xcc\x23\x63\xfe\xc7\xe3\x9e\x4b\x68\xbb\x65\x11\x53\xdc\x23\x0c\x60\x29\x16\x0d\x1c\x82\x4a\x95\x2c\x43\xd4\xc4\x20\x65\x6e\x9b\x8d\xa7\xe6\x92\x86\x2a\x64\x8b\x09\x7b\xaa\x63\xfe\x22\x2d\x41\x6b\xb4\x21\xa2\xa6\x17\xc6\x5d\xa7\x32\xcb\x32\xd6\x00\xfc\x21\x91\x01\x47\xda\x84\x56\xe6\x6d\xaf\xe1\x13\x36\xa0\xd5\x74\xea\x27\xbe\x1c\x6f\x3b\xdb\x6d\x80\xd8\x75\x4d\x3e\x6c\x35\xf8\xc2\x7f\x8e\x7b\x65\x18\xad\x49\xff\x38\xcd\xc5\x7c\x76\x52\x83\x63\x5b\x9c\xb5\x32\x95\x3e\x07\x7b\xda\xc4\x5f\xfc\x08\xc3\xee\x1f\x0b\x63\xd7\x62\x3f\x25\x14\x47\x32\xc6\x20\x55\x44\x9b\xd2\x21\xcb\xdf\xe8\xf8\x39\x08\x8e\x05\x3a\x00\x92\x25\xfb\x95\xa7\x37\x5f\x01\xc3\x56\x6c\x24\x8a\xf5\xcd\x45\xe8\x02\x22\x18\xef\xad\x27\x11\xb3\x31\xcc\x5d\x14\x5d\x8c\x2e\x88\x95\x77\xe1\x75\x6a\xbe\x85\x25\xe2\x3e\x83\x21\x92\xcb\x66\x9e\xbf\x8c\xdb\x7d\xa9\x3c\x12\x0f\x04\x22\xbd\x3e\xc2\x5d\x07\xf7\x03\x7c\xf9\x80\x95\x68\xca\x98\x87\x87\xf1\x37\x8d\xe0\x75\xba\xfb\x03\x3c\x8e\x19\x76\x35\x48\x35\xf9\x9f\x75\x64\x67\x29\x27\x19\xb5\xf7\x36\x1f\xde\x10\x7a\xe4\x68\x97\x32\xf1\xdd\xf7\x55\x9d\x74\x3c\x76\x0e\x46\x4a\x26\x12\x9f\x04\x93\x19\xb3\x08\x14\x90\xbf\xdc\x80\xf2\xbc\x09\x79\x17\x9a\xe3\xed\xfa\xe0\x0a\x5e\xb0\x12\xe6\xe0\x4c\x72\x7b\xaf\x04\x72\x6c\xf8\xaa\xfa\x2b\x7e\x79\x69\x92\xaa\xe4\x08\x7e\x81\x4a\x83\x8f\x12\x7f\x22\x54\xf8\x11\x94\x49\xd2\xf6\x36\xad\x88\x8b\x95\x1b\xdc\x89\xd7\x26\xe1\x9f\x40\xbe\x58\xf1\xf9\x8b\x3a\x00\xc5\x95\xf9\x94\xb9\x14\xd1\x8a\x07\x96\x86\xfd\x30\xaa\x36\x9d\x6a\x54\x57\x34\x11\x9a\x7f\xd7\xfb\x31\xc7\x4d\xde\xde\x08\x66\x14\xb3\xee\x7a\xa1\x58\x20\x04\x0a\xad\xce\xf0\x0e\x77\x3a\x31\x67\x8d\x61\xb7\xac\x87\xd9\xdf\xd9\x28\xf4\x12\x85\x9c\xb6\xd2\xf3\x5d\xff\xa6\x67\xa7\x72\x14\xa6\x56\xd2\xac\x16\xce\x52\x5c\x82\x1f\x8d\x6e\x94\x4a\xe2\xea\x24\x0d\x01\xfb\xd3\xe2\x3a\x90\x56\x9e\xb2\x00\x74\xcf\x62\x70\x50\x66\x3d\x59\x11\xbc\x04\x5d\x33\xa2\xe1\x31\x51\x54\xa6\x6c\x00\xb3\xd4\x3d\xe3\xd0\x85\xe7\x81\x72\x19\xab\x50\x97\x4a\x4b\x5f\x83\x91\xf4\x7c\xea\x0a\xef\x14\xed\x30\x9b\x16\x99\xc0\x99\xbf\x25\x95\xd9\x79\xf4\xb1\x81\x05\x18\xd4\xba\x8f\x71\x32\xa8\x47\x9a\x3c\xb5\x14\xaa\xb0\x55\x90\xf4\xa4\x2a\x09\x32\x89\xc3\xf3\x2c\x0b\x26\x48\x74\x65\xf1\x09\xf0\x55\xa7\x2e\x68\x39\xd0\x31\x3e\x11\xf4\xbc\x02\xed\x53\x55\xc1\x07\x82\x34\xd0\x50\x4a\x3c\xe2\x9f\x9b\x38\x3c\x02\xd5\x2b\xe3\x45\x30\x90\x19\xf9\x8c\x10\xc1\xd0\x04\xfc\xc5\x72\x37\x49\xf7\x96\x94\x3e\x84\xbc\x8b\x8e\xad\x50\xac\xc4\x71\x3c\x0b\x2c\xe8\x80\x6c\xff\xfe\xfd\x4a\xb2\x46\x16\xcd\x4a\xa2\x19\xb1\x62\x42\x9b\x30\x82\x55\xe4\xe9\xe3\xd0\xe4\xab\x7d\xf1\x13\x77\x3f\x7e\x94\x17\xc8\xe9\x92\xb6\xee\x27\xfc\x99\x57\xc4\x02\x28\x28\x7e\xf8\xb6\x42\x67\x68\x5a\x77\x07\x86\x90\xa0\x17\x44\xaf\x93\x9f\x5b\x7d\x9b\x1b\xca\x8b\x3c\xdb\x3d\xaa\xd1\x88\x3f\x07\xe2\x85\xbe\x58\x37\x99\x68\xfe\x14\xb4\xd1\xb4\x09\x72\x3c\xc5\x4b\x12\x5a\x67\x21\x67\xcd\xd7\x82\xaa\xeb\xd8\x5c\xba\x29\x6c\xd7\x0b\x4e\x98\x8b\x84\x42\x01\x95\xcd\x9a\x85\x20\xe2\xad\xc0\x2f\x7b\x78\x1d\x02\x37\xb9\xbb\x7e\x9e\xff\xc2\xe9\xc5\xfa\xf3\xf0\x94\x77\x1f\xb3\x23\x33\x7b\xbc\x0a\x1a\xc7\xa2\x7f\x2c\x5b\x7f\x02\x0f\x19\x4b\x36\x08\x9a\xa0\x84\x22\x69\xf4\xbc\xb0\x55\xcb\xff\xea\x3e\x9e\x7f\x18\xf4\xd3\xba\x6c\xb2\x7f\x71\x53\xde\x3d\xaa\xf1\xa1\x79\xf9\x5e\x78\xd9\x8a\xbf\x59\x5f\x4d\x59\xd3\x6a\xb0\x49\x3b\x54\xa3\xf4\x30\xef\x9a\x6c\xbd\x9f\xdf\xc2\x7c\xd8\xab\xe9\x76\xd8\x15\x77\x1d\x65\xdf\xa7\xe5\x9e\xb0\x57\xae\x46\xe4\xbe\x53\x7c\x01\x55\xd0\xa3\x23\x6f\x86\x6a\x59\x01\x6a\xe0\x26\xf8\xc5\x22\x77\x65\xcb\x8c\x76\x2a\x3f\x61\x40\x3d\x95\x4e\x7d\x3e\x30\xbc\xf2\x4b\x3d\x77\xe2\xf7\x2f\x48\xe5\xfb\x7b\xa9\x15\xaa\xc5\xc3\x69\x53\xee\x2e\xf1\x8e\x23\xb6\x39\xc2\xcb\x14\x75\x4b\x78\x2b\x8b\xf3\xa8\xaf\x77\xf0\xeb\xdf\x9e\x2c\x5e\x39\x4c\x2b\x5f\x68\xce\xbf\x95\xf6\xfc\x08\x60\x7a\xe7\xd7\xc4\xdb\xbb\xf5\xa5\xcd\x1d\x34\x48\x41\x22\xec\xbd\x21\x0f\xd1\xc0\x7a\xfc\x04\x1d\x9e\x0b\x99\x8d\x0f\xc3\xa7\x19\xf2\x03\x3b\x3e\xef\x80\xc8\x09\x71\x82\x41\x41\x7b\xc7\x7b\xb4\xa1\xf3\xb7\x83\xf7\x52\x65\xfd\xac\xb1\x14\xa3\xb7\x1a\xb6\x2f\x5e\x08\xca\x59\x48\x3a\x47\x25\xb1\x98\x2f\x30\x79\xad\x41\x54\xdc\xb6\x4c\x82\x4e\xc5\x10\x84\xec\xce\x82\xfb\x55\x53\x97\x0a\xb5\xda\x3f\x99\x4d\xfa\x6f\x3e\xc0\xbf\xe7\x49\x49\xcb\xe5\xa8\xbb\xe6\x3e\xba\xd2\xf9\xed\x42\x2f\xa3\x40\xfc\xb8\x19\x04\x00\x3e\x80\xff\xab\xbd\xb8\xac\xf4\xac\xcd\x8c\xff\xc8\xd6\x65\x85\xf1\xd2\x36\xf9\x74\xc8\x41\xe7\x8f\xe8\xb3\xfb\x29\x4b\x9e\x10\xe7\x66\x85\x3f\xd6\x3c\x34\xcd\xea\xc3\x3e\xdd\x61\x69\xfa\x86\x46\x88\x2b\x2b\x3f\x9e\x5b\x10\xc4\xd4\x45\x36\x04\xb7\x01\xde\xdc\x59\x6e\x5c\xe7\x17\x0d\xa1\x44\x79\x9c\xf3\x7e\xeb\xd9\x93\xb7\x45\xb5\x3b\x1a\x09\x96\xa2\xcd\xc2\x48\xb0\x5c\xe0\x9b\xd4\x65\xfe\xa0\xfe\x95\x9c\xf3\x50\x9b\xf7\xc4\xe0\xe2\x76\x94\xc7\x35\x44\x33\x4f\x13\xce\x51\xa5\x93\x79\x60\xd1\xe6\x3f\xf5\x3d\xdb\xb2\x90\x38\xa1\x4c\x94\x02\x59\x61\xfc\x92\x7a\x5b\xd9\xc6\x06\x85\x9b\x76\xb0\x14\x05\x75\x3a\xa2\x0d\xe9\xf7\x2f\xad\x23\x33\xd6\xf6\x2f\xb8\x64\xbf\x33\xfb\x09\xb8\x14\xd6\x4f\x46\x25\x0e\x56\x1e\x14\x23\xe0\x4e\x45\xe3\x78\xc8\xd2\x84\xc0\x7a\x1c\x8e\x4e\x4d\x60\x7e\x92\xad\x28\x7d\x5f\x47\xfd\x1e\x27\xc2\x16\x36\xd9\xe0\x81\xc5\xde\xd5\x92\x0f\x47\x43\x98\xb4\xaa\xc8\x8c\xe0\x88\x8a\x87\xca\xec\xb3\xc1\xbd\x29\xa5\xc1\x65\x91\x17\x1c\xbd\x4e\x45\x5c\x99\x4e\x06\xc9\x39\x07\x33\xbc\x48\xe4\xed\x88\x14\x43\x6e\x78\x46\x51\x18\x9e\xa9\xca\x9e\x1f\xec\x28\xbb\xb2\x54\x58\x1c\x65\xbb\xe4\xb5\x7d\xc9\x63\xb4\x80\xe5\xeb\x02\x15\x5e\x9a\xc7\x8c\xb3\xb9\xe9\xc5\xd1\x8a\x79\xc8\x17\x6e\x19\xe9\xb4\x08\x6d\x8d\x0e\x5d\xf4\xcf\x0e\xb1\x23\x78\x10\x20\x62\x8c\x5d\xec\xd4\xb5\x0c\xf9\xf0\x68\x60\x2a\xd0\x25\x33\x37\xee\x47\x8c\x0b\x08\x4b\x08\x2b\x7e\x8d\x9f\x9b\xda\x26\x98\x38\x6f\x52\xc3\x4c\x14\x04\xd3\x6c\xc3\x70\xc0\xc7\x90\x25\xdc\x27\xcb\x0a\xd4\xf3\xc2\x05\xbe\x09\x91\x53\xbd\x85\x9b\x73\x2f\xfb\x94\x43\xa6\x40\x26\x71\xf5\xc0\xf0\xbc\xcb\xbd\x3d\xbb\x0d\x5b\xe2\x6d\xd0\xb5\x7c\x59\x89\x77\x62\x2a\xd6\xd4\x5b\x8a\xda\x96\x53\x37\x3b\x73\xa3\x4b\xaf\xd5\xd9\xbb\xf5\xc0\xa2\x48\x40\xac\xfb\x72\x32\x05\xf1\xe2\xe1\x02\xf3\x05\x21\x1c\xbb\x79\xb9\xa3\xf2\x6a\xaa\xcb\x33\xd5\x5e\x68\x3d\xe3\x27\xe8\xef\xf7\x43\xa0\xff\x53\x3f\xc4\x9f\xde\xf1\x70\xe2\x13\x09\xef\x21\x28\x00\x74\xc0\x02\x00\xe9\xef\xc1\xfd\xb9\x3d\xf2\xda\x54\xb4\xac\x71\xe8\x91\xbd\xf7\xd1\x4a\xbd\x72\xcb\x0f\x20\x15\x94\x29\xc4\xdc\xcc\x4b\xb5\x73\x15\x70\xd4\xfb\x39\xa9\xac\x5f\x6b\xeb\xdb\xc8\x99\xb6\xb8\x9a\x45\x65\xe6\xaa\xdb\x40\xce\x05\x08\x12\x05\x88\xf1\x23\x4c\xb8\xde\xf6\xd2\x2f\x59\x3d\x58\x07\x6b\x3c\x73\x23\x0b\xa2\x56\xfc\xaa\x35\x4b\x3d\x93\x76\xe3\xb7\xde\x7d\xd9\xf6\xdc\x19\x8b\xa3\xd4\x0d\xa2\x59\xb1\xa4\x61\x7a\x0e\xbc\xe3\x5c\x11\x11\x84\x49\xc2\xd6\x6e\x75\x25\xb3\xde\x62\xa1\xe5\x67\x6f\xdf\xd0\xf5\xfc\x8a\x0e\xa2\xec\x47\x68\x09\x33\x37\xc6\xf1\x53\xe0\xc1\xb6\x97\xe3\xa8\xf3\x13\x7f\xcd\x1b\x7f\xf1\x71\x14\x36\x9e\x75\x89\xe9\x56\xae\x0f\x0a\xe6\x36\x4a\xc5\x01\xac\x6a\xbc\x65\xad\x93\x97\xa6\x55\xc3\xbc\xb8\x1c\x82\x9e\xab\x51\xe1\x58\x8b\x87\x45\x4d\x3a\xe5\x11\x0e\x98\x46\x82\x69\x79\x84\x35\x57\x54\x06\x67\xcc\xfa\x4b\xb6\x80\x3c\x8c\x70\x27\xdb\xd0\x3d\x8d\x7d\x90\xfd\xfc\x88\x85\xa8\x09\x35\x19\x58\xef\x96\xd8\x10\x42\x15\x7e\x74\x17\x36\x5a\x26\x1c\xd9\x50\x52\xce\xf1\x0e\x57\x7e\xd1\x4e\x58\xd8\xce\xc3\x18\x19\x77\xdb\x27\x91\x6d\x7e\x26\xe4\x36\x35\x35\xdb\xac\x54\xd9\x6d\x2f\x63\x48\x98\x93\x0c\x33\x75\x8b\xc0\x2a\x85\x8d\xa1\xfa\x28\x52\x69\x64\xca\x8a\xf7\x58\x54\x50\xf4\x4a\xca\x1b\xf2\x32\x55\x68\xf1\x76\x7e\x9f\x30\x3f\xcf\x6b\x2a\x9b\x3a\x16\xf9\xbe\xc6\x59\xf6\x18\xa8\xe6\x42\x15\xf9\x87\xc6\x3f\xa6\x75\x7e\xc3\x58\xba\x6b\x1e\x6a\x5c\xee\xf5\xd9\x1d\x8c\xd6\x7f\xdb\xbb\x8c\x1d\x44\x37\x85\xa1\x99\xbb\xb3\xd3\xba\xe1\xb0\xdb\x8f\x94\x05\x85\x1f\x28\x68\x0a\x9b\xf2\x70\xf2\x9c\xaf\x4c\xf4\x57\x3e\xc0\x5c\xbf\xc9\x75\x6a\xcd\xf5\x8f\x0e\xe5\x8e\x54\xa5\x75\x3a\xe4\x2e\xf9\x9a\x9c\xd0\xc4\x3d\x00\xd1\x00\x39\xa7\x3c\x8c\x67\x82\xc7\x99\xe8\x70\xc6\x29\xca\xf8\x29\x2d\x29\x33\x60\xc1\x5f\xab\x23\x3f\x45\xe3\xa4\x8d\xf9\xb2\xed\x98\xdf\x73\xa2\xdd\x5d\x6d\xd1\x04\x89\xed\x39\x17\xff\xec\xb6\xe7\xf5\x06\x8e\xee\x7b\x97\x5c\xd7\x4f\x81\x54\xf5\x62\x84\x72\xe7\x4a\x42\x97\xba\xb4\x53\x01\x7b\x49\xf3\x13\xb8\x16\xd0\xad\x75\x48\xf1\xd2\x83\x50\x77\x99\xc2\xa9\x77\xb5\xd2\x6a\x0d\x9d\x75\x14\xd4\x76\x1c\x41\xb3\xed\xee\x1f\x68\xc2\xa3\x45\xa7\x7b\x54\xae\x33\xcf\x34\xc6\x51\xf6\x3f\xc4\xb4\x41\x4f\x1b\xd1\x72\xe4\x29\xbc\xd4\x0d\x2e\xfb\x02\x52\x70\x5d\x82\x36\x63\xb7\xdf\x06\xbc\xb7\x8c\xf9\x7b\x4d\x5c\x56\xa4\x2f\xcc\x43\x65\x33\x86\x71\x96\x5e\xfa\x65\xdd\x7d\x77\x1a\xca\xf7\x6e\x90\xab\x20\x47\x08\xc4\xf7\x03\xb5\x79\x49\x52\xf9\xb5\x15\x6e\x39\x3e\xf5\x95\xcf\x4b\x8b\xc2\x98\xd3\xd5\x84\x82\xc6\xad\x25\x0c\x93\x60\x04\x37\x76\x27\xfe\xa4\x01\x1d\x91\x2f\x5b\x83\x8f\x86\xf9\x71\x61\xe5\xec\x94\x88\x90\xc8\x62\xcd\x2e\x45\x8f\x8f\x96\x8d\x0f\xb7\x10\x3f\xdc\x9f\xfb\x63\x18\x94\xa7\x4c\xe5\x80\x4b\x6a\x16\xff\x67\x2b\x3b\x0b\x98\x36\x4d\x30\xaf\xd8\x3a\xbb\x42\x4a\xbb\x3e\xa2\x0a\xeb\x7e\x09\x98\x4c\xfa\xfc\x08\xe8\x81\x1b\xc5\xe5\x5b\x9f\x44\xf5\xbd\x34\x6a\xac\xec\x1b\xe6\x4d\xe8\x22\xda\xf7\xe7\x6b\x72\x4b\xfc\x2c\x79\xa1\x7c\x26\xa7\x3d\x59\x5b\xef\xb3\x2a\xd8\x71\x52\x48\x95\x0b\x76\xf6\x3a\x05\xeb\xd6\xe3\xb3\xe2\xb0\xe4\x92\x31\x5d\xc6\x07\xa8\xdf\x0f\x4c\xcc\x7f\x0a\xcc\xbf\x49\x2b\x98\xfd\xa9\xfd\x2b\x43\xc5\x36\x39\xdc\xc0\x00\xc0\x17\x09\x00\xc8\x7f\x1f\xf0\xcf\x05\xe7\xa9\xaa\xa4\xcd\x32\x1d\x7c\xfb\xbe\x2a\x16\x07\x94\xf0\xe7\x32\x4e\x34\xb2\xaa\x9f\x28\xe0\x7e\xfa\x33\x0d\x2c\xdc\x9a\xe2\xe3\x06\xc2\x4f\xcb\xa2\x8b\x32\x96\x43\x51\x33\xf5\xdc\x27\x55\xef\x33\x84\x0a\x06\xa3\x6d\x9e\x9c\x62\x89\x89\xe7\x45\x18\x44\x5d\x74\xa9\xe6\xc3\xb8\x48\x76\xde\xce\xf4\x9c\xee\xbd\x84\x93\xb2\xa8\x9a\xfd\x66\xab\x56\xb8\x7d\xed\x57\xf0\x62\x37\xd1\x27\x62\xe3\x7c\xa5\x11\x2a\x35\x3d\x4a\xb9\x7b\x0d\xb2\xdc\xfa\x33\xfc\x2f\x6b\x10\xd1\x94\x20\x83\x8a\x48\xd4\xee\xd1\x5e\x2c\x76\x1a\x55\xa9\xea\xd1\x97\x29\x1b\xd0\x7d\x92\xed\x3f\x51\x81\xf7\x7e\x96\xf0\x2a\xe2\x50\x84\x12\x62\x53\xd2\x6d\x12\x2a\x94\x8a\xa0\x51\x15\xf9\xf8\xc3\xae\x0a\x2c\x44\x1c\x1e\xce\x0d\xcd\x10\x04\xea\xaa\xc0\x2f\x5f\xce\x3c\x9a\x22\x72\xd6\x79\x6f\xbf\x0c\x83\x46\x65\x71\x72\xa5\xfa\x4a\x6e\x48\xc5\x82\x9f\x26\x60\x46\xff\xc1\xcb\xdc\x26\x46\x69\x44\x82\xb2\xb2\x84\xae\xa5\x22\x88\xef\x63\xca\x81\xed\x79\xe1\x64\x1c\x0f\x8f\xa6\xfa\x80\xdc\x28\x1a\x2e\x03\x4e\x8c\x3b\x14\x59\x58\x78\x04\x4b\xb5\x65\xdb\x74\xce\x17\xda\x7e\x40\xab\x72\x69\x59\xd2\xe1\x53\x33\x5b\xd0\x01\x8d\x89\xc5\x74\xdf\x7b\x83\xa2\x94\xad\x14\x7b\x3e\x16\x13\xa3\x91\xea\x41\x25\xb8\x2e\x2f\x91\xe9\x72\x9e\x00\x01\xc9\x0d\xe1\x43\xbc\x8e\xa3\x4c\x99\x3b\x2e\x87\x42\xde\xf4\xd8\x45\x63\xba\xfa\x9c\x32\x96\x5c\x29\x0d\x9b\x65\x03\x9b\x4d\xf7\x8d\xc6\x5c\x24\x0d\x3d\xcd\xb9\xb8\xa2\x16\x15\xfc\xee\x57\x86\xbc\xda\x1e\x2a\x3c\x67\x9e\x69\x76\x4c\xc4\x88\x48\x81\x59\x3a\x3a\xc2\xaf\xe3\x1f\x91\x15\xe5\x38\xbc\xf7\xdb\x96\x0a\x71\x4e\xa7\x5f\xc5\x92\x14\xcb\x52\x93\x22\x4a\xed\x94\x9d\x0f\x79\xdd\xa0\x5e\xaf\x50\xa7\x45\xfc\x21\x62\x65\x19\x4f\x53\xbe\xea\x25\x48\xec\x4f\x98\x79\x9a\xcc\xbd\x2b\x3b\xa4\xb1\xb2\x26\x9c\xae\xe8\xa5\x61\x84\x0b\x55\xf2\xc3\x8b\x3d\x9c\xf9\x2a\x61\x4c\xff\x75\xc1\x90\x2e\x1d\xfe\x96\xe6\x19\xd6\xb5\xbd\x34\x22\x57\x98\x34\x7d\xe3\xa7\x19\x45\x7e\x3f\xbf\x55\x16\xf5\x78\x5f\xe2\x88\xbc\x39\xae\x6b\x49\x33\x48\x18\x36\xdb\x8f\xd9\x37\xd2\x9f\xa2\x02\x8f\xbe\x54\x0e\x65\x0f\x52\xe6\x6e\xa4\x74\xf3\x37\xac\x57\x2f\x9e\xf9\x1c\x4d\xdd\x72\x55\xb3\xb9\x50\x6f\x53\xb0\xcd\xd3\x8a\xed\x15\x42\x0a\xb9\xd4\xe0\xcc\xf6\x8f\xdc\x9c\xd9\xe6\xc7\x75\x05\x24\x2e\x75\x0e\x9d\xb6\x02\x76\xc1\x8a\x70\xd6\xa3\x71\xd7\x83\x61\x23\x05\xd9\x36\x2e\x48\xd1\x28\xe5\x5b\x9c\xa7\xe2\xc7\x3f\xde\x0d\x45\x3f\x2c\xbc\x30\xdf\xbc\x4b\x31\x45\x43\x42\xce\x5e\xf5\xe2\x75\xa0\xbd\x5f\xf5\x95\x54\xa7\x9c\x78\xe4\xe8\x8b\xa3\x1f\x82\x4d\xc9\xa8\x1a\xbf\xc8\xc3\x9a\xe7\x16\x57\xb7\x4a\xd7\xbc\xf1\x22\x5d\xaa\xa2\xbb\xab\x43\x77\xf3\x92\x4f\xe5\xeb\x4a\x44\x6a\x62\x99\x62\x88\x63\xd0\xfc\x84\xfc\xbe\x52\x42\xd3\x98\x21\x0d\x97\x5a\x34\x1d\xaf\x59\x53\xa4\x7f\x5d\xfb\xbc\x91\x1f\x5e\x2c\x7b\x63\x1f\xaf\x52\x95\xc5\x5f\x8c\x1e\x66\xf5\x8d\x03\x08\xc0\x38\xe6\xbe\x65\xd2\xb9\x99\x05\xde\x76\xbe\x61\xb9\xe8\xec\xac\xd4\x7e\xa4\xe9\x2e\x5c\x13\xcd\x4a\x99\xde\xb3\xc5\x8b\x6a\x8b\x16\x95\x16\x41\x88\x8b\x84\x2e\x2f\x55\xd1\xd9\x01\x9b\x90\x66\x6c\x53\xbd\xd1\xe4\xe0\x1f\xa4\xb9\xdd\x71\x7d\xa2\x8c\x48\x35\x28\xf2\x69\x37\x58\x5b\xa0\xc4\xe5\x6f\x64\x5d\x3b\xf3\x22\xbc\xd1\x62\xd1\x5e\x18\x48\xbf\x79\x1a\x86\x6d\x4b\x1d\xca\xef\xff\xca\x37\x2f\x89\xc0\x6c\x3d\xfe\x59\xa2\xa8\xa8\xce\x79\x60\x73\x92\xc8\xd6\xa7\xce\x39\xe6\x82\x6b\x11\x36\xf3\x4d\x62\x6d\x6d\xed\x5d\xbf\xb4\xbe\x79\x22\x53\x12\xe9\xa9\x40\xda\x4b\xd5\x50\xc6\x2a\x58\xb5\x43\x53\xe5\xf8\x4f\xdb\x29\x88\x79\xd9\xe1\xc1\x04\x4a\x1b\xe1\x2e\x43\xdc\x9b\x39\x97\xe7\xc0\x3a\xda\xb0\xf2\x31\x03\x92\xda\xa9\xa6\xb5\x1a\x5e\x0b\x95\x59\xd9\xf6\xb9\x0a\x8c\xad\x00\xbd\xf0\xeb\xeb\x3a\x36\xea\xb7\x7f\x88\x6d\x8b\x5c\x5f\x3d\x43\x50\x00\x88\xfe\x2f\x79\xb2\x3f\x12\xdb\x7f\x4c\x89\x45\x79\x24\xea\x88\x0e\x7e\xf5\x1a\x5d\x7e\x1e\x9a\xbe\xb6\x09\x4e\xe5\x9e\x77\x92\x0c\xb6\x7c\x59\x24\x5e\xd4\x06\x3f\x01\xb6\xab\x51\x87\x94\x3a\x26\xa1\x3b\x22\x20\xa7\x1e\x2f\xdb\x60\x80\x27\x43\x35\x00\xad\x9e\x25\x8e\xdd\x1d\xdc\xa0\x59\xa5\x2f\x0a\xed\x43\xfa\xc4\xe9\xde\x44\x83\x1a\x54\xe3\x0e\xac\x44\x8f\x79\x23\xd8\x6e\x13\x2e\xdd\x46\x84\xf3\x8d\x92\xae\x64\x0f\x27\x6b\x16\x4e\xa4\xe9\x9e\x32\x74\x19\x7b\x4a\xcc\xb1\x10\xbf\xb2\xae\xe9\xa2\x9f\x53\xeb\x49\x24\xc7\xaa\x70\xc4\x65\x4a\x61\x83\x41\x9b\x3e\x14\x88\x6b\x95\x71\xb7\xc0\xde\x8d\xc2\x51\xfa\x3c\x76\x36\xfb\xe6\xac\xef\xe6\x3b\xdf\x54\x57\x8e\x15\xec\x48\xed\x81\xae\x4f\x4c\x43\x19\x38\xfd\x64\x5f\x6b\xa0\x23\xec\x04\x31\x2f\xc2\xfc\xe7\xb6\xc6\x9d\xbe\xb5\x1b\x6b\x37\xb1\x7f\xbe\x49\xf4\xfb\x88\x6e\x0e\xa5\x2c\x63\x78\xbe\x36\x42\xf1\xda\x16\x31\x10\xa6\x30\x22\x53\x6a\x5b\x44\xbf\xe4\x42\x3e\x85\x2d\x6d\xe9\x7e\xa0\xa0\x3d\xb4\x1d\xd5\x54\x13\xa5\x97\x56\x48\x07\xcf\x44\x70\x8b\x42\x87\x8e\x89\x1e\x79\xcf\xee\x4c\x92\x74\xa1\x11\x85\xdd\x0a\xe5\x58\x12\xb8\xa1\x17\x70\xc0\x9f\x6d\xef\x4d\x7d\x51\x84\x50\xfe\xe4\x5a\x57\x72\xe0\xb6\xbb\xa4\xdb\x9a\x5b\x8e\x69\xef\x7e\x5c\x57\x40\x3d\xa0\xd7\x75\x64\xa2\xde\x2c\x58\x30\xf8\x25\x1b\x2d\x15\x23\xa3\xbb\xcc\xe1\xf9\x5d\xf1\xc1\xc1\x83\x3c\xd4\xb3\x77\xeb\xa7\xcd\xeb\xc4\x26\xda\xfb\xfd\x4a\xd6\x32\x0d\x5d\x22\xfd\x4f\x86\xd1\x52\xae\x0f\xb9\x4b\x69\xb8\x39\x9a\x12\x95\x02\x3b\x6e\xe4\x05\xfa\x71\xcc\x7c\xd4\x64\xa5\xd2\x51\x1b\xf0\x36\x38\x9a\x5b\x8f\x99\xc9\xa3\xcb\x6c\x3d\x52\x5c\x91\x74\xda\x9c\x78\x8a\xed\xf8\x7e\x10\x18\x61\xe2\x29\x2c\xf4\x84\x3e\x8f\x4e\xb2\xfe\xd1\x1c\x73\x72\x60\x0f\x83\xca\xd7\xdf\x09\xb1\x16\x6b\x41\x54\x5b\x7e\xbc\xc2\xbb\xc0\x8c\x6d\xea\x4f\x58\x08\xa3\x7e\x6e\x48\x77\xcc\x09\x98\x5b\x0d\x49\x1e\xee\x41\x93\xa6\x5c\xa5\x84\x15\xcd\x58\x77\xf3\xb7\x16\x89\xa0\xec\xb8\x46\x64\x37\xc6\x09\xe1\x81\xcb\xa3\xf1\xfe\x91\x41\x80\x5d\xd7\x3f\xdd\x17\x13\xdf\xbe\x7c\x27\xbc\xb2\xab\x1f\x09\xf0\x83\xca\x77\xa3\x29\xbe\x57\xc6\x0a\x7b\xd6\x34\x6a\xc5\x24\x6f\x5f\x68\x95\x4f\x12\xbd\xcc\x80\xc1\xaa\x71\x76\x8c\xeb\xd0\xd3\xbe\x83\x7b\x7c\x36\x83\xc5\xd8\x35\xf5\x4c\x8f\x14\xbc\x2d\x28\x82\x63\x68\xfc\x92\x6b\xa3\x63\x43\xd6\xeb\x31\x5c\xf5\xe6\x76\x41\x66\xf1\x59\xa2\x28\x49\x8e\xd4\x81\x02\x35\xb7\x94\x94\xac\x0d\x6c\x2b\x11\xb0\x18\xe2\x3f\xb8\x4a\xd7\xac\x54\xbd\xda\x93\x0d\xc7\xaa\x14\xfb\x8e\xc8\xc0\x92\xf5\x52\xbf\xa3\x25\xfb\x6e\x30\x30\x4d\xa5\xf1\x7c\xe4\xa8\xe6\x09\x49\x4e\x12\x04\x14\x1f\xec\x5f\x2f\x49\x11\x01\xac\xff\xfa\x0e\x19\x38\xe5\xff\xe5\x9b\xdf\xea\xa5\x28\x2b\x41\x00\x60\xff\x62\xde\xfe\x3b\x4c\x94\xdf\x60\x8a\xfd\x13\xa6\x93\xd9\x7f\x80\xfd\x7f\xd7\x54\x7f\x24\xb6\xa3\x02\x30\x00\x80\xc5\xfd\x65\xb9\xfb\x77\x58\x10\x00\x00\x0a\x7c\xe3\xff\x15\xac\xbe\x9e\x83\xd1\x7f\x05\x9f\x83\x81\xbd\x91\x91\xf5\x7f\xc5\xdf\x1f\x23\x8b\xf4\x1a\x36\x16\x06\x03\x80\x75\xf8\x5f\xe4\xc1\x7e\x4d\x96\x02\xfe\x2f\xc8\xfe\xd7\x3c\x2d\x98\x89\xa1\x9c\x00\x00\x08\xfe\x4d\xf9\xe6\xd7\x3c\xec\xd0\xff\x82\x47\xc7\xc9\x4c\xc7\xd0\xc8\xd8\xe1\x0f\x70\xfc\xde\x86\xd0\xff\xed\x8f\x5f\x38\x46\xff\x89\xe3\x1f\xb6\x87\xfe\x63\x04\xff\x0d\xf8\xcb\x27\xf1\xff\x10\xfc\x32\x89\x54\x48\x80\xdf\x13\x28\xfb\x8d\x76\xde\x1f\x1d\x2e\x12\xe0\xc2\x00\x04\x04\x00\x86\x41\x7f\x19\xb2\x5f\x0f\x57\xdd\x1f\x64\xfa\x07\x55\xb4\x5f\xfd\xdb\x9f\xf2\x9b\x9c\x57\x90\x97\x28\x08\x00\xc4\x80\xfe\xbf\x31\xfd\xfb\x89\x30\x90\xfe\xe3\x89\xfc\xc3\x6d\xfe\x9f\x1f\x53\xf4\xff\x22\xf8\x7b\x10\x8d\x93\x01\xbf\xa7\x2b\xfb\xe7\xa1\xdf\xff\x06\x1a\x8f\xfc\x77\xa0\xff\xe7\xde\xf4\x8f\x8d\xce\x6f\x15\x66\x7f\x3d\x3a\x0e\xff\x81\xe1\xd7\x2b\xe1\xff\xab\x35\x6d\x94\xbf\xc3\xf5\x3f\xd2\x6b\x7f\xcc\x9a\xdf\xea\x91\xfe\xda\x1a\x54\x2a\xe0\xcf\xaa\x93\xfe\x79\xa3\x08\x7f\x63\x14\x0f\x0d\xf0\x9f\xa5\x28\xff\xa8\x65\xbf\xd5\xb8\xfc\xb5\x65\xa9\x7f\x84\xe6\xff\xe6\x2c\xb4\xdf\xd8\x15\x4a\x0b\xfc\x8e\xe4\xe4\x1f\x33\xe5\xb7\x82\x93\xbf\x36\x65\x9e\x16\xf8\x8f\xf2\x93\x7f\xec\xec\x7f\xab\x19\xf9\x6b\x0e\x57\x3a\xe0\x0f\x29\x48\xfe\x31\x9e\xdf\xaa\x46\xfe\x7a\x94\xd6\xfe\x89\xe7\x7f\x32\xd9\x1f\x1f\xaa\xdf\x2a\x48\xfe\xda\x0c\x7a\x7a\xe0\xcf\xea\x49\xfe\x5f\xb3\xd9\x32\xc3\x3f\x52\xfe\xc9\xe8\xfc\xad\x2c\xe2\xaf\xad\xa1\x60\xfc\x1d\xe8\xdf\x5b\x9a\xff\x79\x5b\x30\x7f\x63\x4b\x3b\xd3\x3f\x12\xfe\x97\x1c\xcc\x1f\xf7\xcd\x6f\xf5\xff\x7e\x6d\x0d\x12\x33\xf0\xe7\xd4\x00\xff\xbc\x35\xff\x1d\x37\x7f\xf7\x0c\x04\xcb\xef\x12\xfe\xa1\xcb\xc1\xef\xc9\xf0\xfd\x37\xc7\x2f\x46\x69\xb1\x00\x7f\x5c\x4c\xeb\xcf\x2c\x49\x7e\xab\xd8\xf5\x6b\xca\x42\x0e\xe0\x7f\xa5\xdf\xf5\xc7\xfc\xf7\x5b\xad\xa0\x5f\xfb\x2f\x9f\x07\xf8\x13\xca\x41\x7f\xcc\xca\xdf\x5f\xbd\x2b\xf0\x02\xff\xf0\xd0\xe9\x7f\x83\x89\xf3\x1b\xcc\x34\x5e\xe0\xdf\xa8\xea\xfc\xdf\x2f\x6d\x67\xff\x16\xff\xbf\x9c\xf3\x47\x1d\xf1\x5b\x39\xa0\x5f\x3b\x42\x9c\xef\x0f\xd0\xfc\x26\x45\xfc\xaf\x7d\x82\xf1\x1b\xfb\xfa\xf9\x81\xdf\x11\xb2\xf9\xa3\x46\xfd\x56\x16\xe7\xd7\x46\x61\x08\xfc\x1e\xf6\xff\xde\x92\xdf\xbf\x6f\x50\x10\x04\xfe\xbd\xac\xcc\x1f\x23\xf8\xfd\xa1\x5a\xd1\x01\x7e\xa7\x28\xea\x8f\x0e\xd5\x6f\xf7\x8a\xfa\xf5\x50\x51\xe9\x02\x7f\x70\xe7\xa8\x3f\xc6\xf4\xdb\xad\x8e\x7e\xcd\x34\xaf\x07\xfc\xc1\x8d\x8f\xfe\x18\xd3\x70\xad\x4d\x6e\x0c\x12\x00\x5c\x72\xfe\xe3\xb2\x5a\xc7\xfe\xf7\x98\xfe\xd1\xfd\x7f\x94\x8b\xc9\xac\x8d\xf3\x0b\x08\x00\xb8\x82\x01\x00\xdb\x6f\xb8\x64\xfd\x7e\x8f\xeb\x8f\x94\x55\xfe\x31\xf6\xdf\x56\xf5\xfd\x9a\x9d\x2f\x00\xf8\x4f\x35\x7e\x7f\x8c\xe2\xb7\x35\x78\xbf\xa6\xb8\x0d\x00\xfe\x74\x45\xde\x1f\xe3\xfc\xed\xeb\xe0\x5f\x73\xae\x45\x01\xff\xfe\xe5\xf0\x9f\x8f\xa8\xdf\xae\xb3\x4e\x62\xfe\x91\xe0\x4f\x26\xd4\xdf\xbe\x6d\xfc\xf5\xb9\x73\xc6\xfe\x0e\xf4\xff\x3e\xf5\xfc\xfe\x1a\x2b\x30\x01\xf8\xbd\x27\xae\x7f\xd4\x92\xdf\xbe\x9c\xf9\xb5\x25\x73\xbf\x0f\xfe\x67\x6d\xf9\xbd\xe7\xc5\xbf\xa6\xab\x49\x01\xfe\xec\xd3\xe3\xdf\xa3\x84\xf8\x45\x95\x0b\xa0\x07\xe8\x81\x39\x54\x00\xf0\x4a\x07\x00\x00\xf8\xff\x06\x00\x07\x7a\xd9\xee\x69\xaf\x00\x00")
 
 func x_ender_chestMcpackBytes() ([]byte, error) {
 	return bindataRead(
@@ -92,7 +92,7 @@ func x_ender_chestMcpack() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "x_ender_chest.mcpack", size: 44061, mode: os.FileMode(420), modTime: time.Unix(1759007355, 0)}
+	info := bindataFileInfo{name: "x_ender_chest.mcpack", size: 44905, mode: os.FileMode(420), modTime: time.Unix(1792147136, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
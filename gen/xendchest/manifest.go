@@ -0,0 +1,8 @@
+package xendchest
+
+import _ "embed"
+
+// Manifest is the signed release manifest of x_ender_chest.mcpack, written by cmd/packsign
+//
+//go:embed x_ender_chest.manifest.json
+var Manifest []byte
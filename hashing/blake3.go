@@ -0,0 +1,237 @@
+package hashing
+
+import (
+	"encoding/binary"
+	"hash"
+	"math/bits"
+)
+
+// BLAKE3 in its default hash mode with a 32 byte output, following the reference implementation
+// https://github.com/BLAKE3-team/BLAKE3/blob/master/reference_impl/reference_impl.rs
+
+const (
+	blake3OutLen   = 32
+	blake3BlockLen = 64
+	blake3ChunkLen = 1024
+
+	flagChunkStart = 1 << 0
+	flagChunkEnd   = 1 << 1
+	flagParent     = 1 << 2
+	flagRoot       = 1 << 3
+)
+
+var blake3IV = [8]uint32{
+	0x6A09E667, 0xBB67AE85, 0x3C6EF372, 0xA54FF53A, 0x510E527F, 0x9B05688C, 0x1F83D9AB, 0x5BE0CD19,
+}
+
+var blake3Permutation = [16]int{2, 6, 3, 10, 7, 0, 4, 13, 1, 11, 12, 5, 9, 14, 15, 8}
+
+func blake3G(state *[16]uint32, a, b, c, d int, mx, my uint32) {
+	state[a] += state[b] + mx
+	state[d] = bits.RotateLeft32(state[d]^state[a], -16)
+	state[c] += state[d]
+	state[b] = bits.RotateLeft32(state[b]^state[c], -12)
+	state[a] += state[b] + my
+	state[d] = bits.RotateLeft32(state[d]^state[a], -8)
+	state[c] += state[d]
+	state[b] = bits.RotateLeft32(state[b]^state[c], -7)
+}
+
+func blake3Round(state *[16]uint32, m *[16]uint32) {
+	// Columns
+	blake3G(state, 0, 4, 8, 12, m[0], m[1])
+	blake3G(state, 1, 5, 9, 13, m[2], m[3])
+	blake3G(state, 2, 6, 10, 14, m[4], m[5])
+	blake3G(state, 3, 7, 11, 15, m[6], m[7])
+	// Diagonals
+	blake3G(state, 0, 5, 10, 15, m[8], m[9])
+	blake3G(state, 1, 6, 11, 12, m[10], m[11])
+	blake3G(state, 2, 7, 8, 13, m[12], m[13])
+	blake3G(state, 3, 4, 9, 14, m[14], m[15])
+}
+
+// blake3Compress runs the compression function over one block, returning the full state
+func blake3Compress(cv [8]uint32, block [16]uint32, counter uint64, blockLen, flags uint32) [16]uint32 {
+	state := [16]uint32{
+		cv[0], cv[1], cv[2], cv[3], cv[4], cv[5], cv[6], cv[7],
+		blake3IV[0], blake3IV[1], blake3IV[2], blake3IV[3],
+		uint32(counter), uint32(counter >> 32), blockLen, flags,
+	}
+
+	m := block
+	for round := 0; round < 7; round++ {
+		blake3Round(&state, &m)
+		if round < 6 {
+			var permuted [16]uint32
+			for i, j := range blake3Permutation {
+				permuted[i] = m[j]
+			}
+			m = permuted
+		}
+	}
+
+	for i := 0; i < 8; i++ {
+		state[i] ^= state[i+8]
+		state[i+8] ^= cv[i]
+	}
+	return state
+}
+
+func blake3Words(block []byte) [16]uint32 {
+	var padded [blake3BlockLen]byte
+	copy(padded[:], block)
+
+	var words [16]uint32
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint32(padded[i*4:])
+	}
+	return words
+}
+
+func firstEight(state [16]uint32) [8]uint32 {
+	var cv [8]uint32
+	copy(cv[:], state[:8])
+	return cv
+}
+
+// blake3Output is a node of the tree that is either chained into its parent or finalized as the root
+type blake3Output struct {
+	cv       [8]uint32
+	block    [16]uint32
+	counter  uint64
+	blockLen uint32
+	flags    uint32
+}
+
+func (o blake3Output) chainingValue() [8]uint32 {
+	return firstEight(blake3Compress(o.cv, o.block, o.counter, o.blockLen, o.flags))
+}
+
+func (o blake3Output) root(out []byte) {
+	state := blake3Compress(o.cv, o.block, 0, o.blockLen, o.flags|flagRoot)
+	var full [blake3BlockLen]byte
+	for i, word := range state {
+		binary.LittleEndian.PutUint32(full[i*4:], word)
+	}
+	copy(out, full[:])
+}
+
+func parentOutput(left, right [8]uint32) blake3Output {
+	var block [16]uint32
+	copy(block[:8], left[:])
+	copy(block[8:], right[:])
+	return blake3Output{cv: blake3IV, block: block, blockLen: blake3BlockLen, flags: flagParent}
+}
+
+// blake3Chunk hashes the up to 1024 bytes of one chunk
+type blake3Chunk struct {
+	cv         [8]uint32
+	counter    uint64
+	block      [blake3BlockLen]byte
+	blockLen   int
+	compressed int
+}
+
+func newBlake3Chunk(counter uint64) blake3Chunk {
+	return blake3Chunk{cv: blake3IV, counter: counter}
+}
+
+func (c *blake3Chunk) len() int {
+	return blake3BlockLen*c.compressed + c.blockLen
+}
+
+func (c *blake3Chunk) startFlag() uint32 {
+	if c.compressed == 0 {
+		return flagChunkStart
+	}
+	return 0
+}
+
+func (c *blake3Chunk) update(input []byte) {
+	for len(input) > 0 {
+		// A full block is only compressed once more input follows, the last one is finalized by output
+		if c.blockLen == blake3BlockLen {
+			words := blake3Words(c.block[:])
+			c.cv = firstEight(blake3Compress(c.cv, words, c.counter, blake3BlockLen, c.startFlag()))
+			c.compressed++
+			c.block = [blake3BlockLen]byte{}
+			c.blockLen = 0
+		}
+
+		n := copy(c.block[c.blockLen:], input)
+		c.blockLen += n
+		input = input[n:]
+	}
+}
+
+func (c *blake3Chunk) output() blake3Output {
+	return blake3Output{
+		cv:       c.cv,
+		block:    blake3Words(c.block[:c.blockLen]),
+		counter:  c.counter,
+		blockLen: uint32(c.blockLen),
+		flags:    c.startFlag() | flagChunkEnd,
+	}
+}
+
+// blake3Hasher is an incremental BLAKE3 hash implementing hash.Hash
+type blake3Hasher struct {
+	chunk blake3Chunk
+	stack [][8]uint32 // Chaining values of completed subtrees, one per set bit of the chunk count
+}
+
+func newBlake3() hash.Hash {
+	return &blake3Hasher{chunk: newBlake3Chunk(0)}
+}
+
+func (h *blake3Hasher) Write(p []byte) (int, error) {
+	written := len(p)
+	for len(p) > 0 {
+		if h.chunk.len() == blake3ChunkLen {
+			cv := h.chunk.output().chainingValue()
+			total := h.chunk.counter + 1
+			h.addChunk(cv, total)
+			h.chunk = newBlake3Chunk(total)
+		}
+
+		n := min(blake3ChunkLen-h.chunk.len(), len(p))
+		h.chunk.update(p[:n])
+		p = p[n:]
+	}
+	return written, nil
+}
+
+// addChunk merges the completed subtrees the new chunk closes, as many as trailing zero bits of total
+func (h *blake3Hasher) addChunk(cv [8]uint32, total uint64) {
+	for total&1 == 0 {
+		last := len(h.stack) - 1
+		cv = parentOutput(h.stack[last], cv).chainingValue()
+		h.stack = h.stack[:last]
+		total >>= 1
+	}
+	h.stack = append(h.stack, cv)
+}
+
+func (h *blake3Hasher) Sum(b []byte) []byte {
+	output := h.chunk.output()
+	for i := len(h.stack) - 1; i >= 0; i-- {
+		output = parentOutput(h.stack[i], output.chainingValue())
+	}
+
+	var sum [blake3OutLen]byte
+	output.root(sum[:])
+	return append(b, sum[:]...)
+}
+
+func (h *blake3Hasher) Reset() {
+	h.chunk = newBlake3Chunk(0)
+	h.stack = h.stack[:0]
+}
+
+func (h *blake3Hasher) Size() int {
+	return blake3OutLen
+}
+
+func (h *blake3Hasher) BlockSize() int {
+	return blake3BlockLen
+}
@@ -0,0 +1,51 @@
+package hashing
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlake3(t *testing.T) {
+	// Vectors from the BLAKE3 test_vectors.json, the input repeats the bytes 0 to 250
+	vectors := map[int]string{
+		0:    "af1349b9f5f9a1a6a0404dea36dcc9499bcb25c9adc112b7cc9a93cae41f3262",
+		1:    "2d3adedff11b61f14c886e35afa036736dcd87a74d27b5c1510225d0f592e213",
+		64:   "4eed7141ea4a5cd4b788606bd23f46e212af9cacebacdc7d1f4c6dc7f2511b98",
+		65:   "de1e5fa0be70df6d2be8fffd0e99ceaa8eb6e8c93a63f2d8d1c30ecb6b263dee",
+		1023: "10108970eeda3eb932baac1428c7a2163b0e924c9a9e25b35bba72b28f70bd11",
+		1024: "42214739f095a406f3fc83deb889744ac00df831c10daa55189b5d121c855af7",
+		1025: "d00278ae47eb27b34faecf67b4fe263f82d5412916c1ffd97c8cb7fb814b8444",
+		2048: "e776b6028c7cd22a4d0ba182a8bf62205d2ef576467e838ed6f2529b85fba24a",
+	}
+
+	for length, expected := range vectors {
+		t.Run(fmt.Sprint(length), func(t *testing.T) {
+			input := make([]byte, length)
+			for i := range input {
+				input[i] = byte(i % 251)
+			}
+			assert.Equal(t, expected, hex.EncodeToString(BLAKE3.Sum(input)))
+
+			// Written in uneven pieces, and summed twice without changing the state
+			h := BLAKE3.New()
+			for len(input) > 0 {
+				n := min(7, len(input))
+				h.Write(input[:n])
+				input = input[n:]
+			}
+			assert.Equal(t, expected, hex.EncodeToString(h.Sum(nil)))
+			assert.Equal(t, expected, hex.EncodeToString(h.Sum(nil)))
+		})
+	}
+
+	t.Run("reset", func(t *testing.T) {
+		h := BLAKE3.New()
+		h.Write(make([]byte, 4096))
+		h.Reset()
+		h.Write([]byte("abc"))
+		assert.Equal(t, "6437b3ac38465133ffb63b75273a8db548c558465d79db03fd359c6cd5bd9d85", hex.EncodeToString(h.Sum(nil)))
+	})
+}
@@ -0,0 +1,117 @@
+// Package hashing names the hash algorithms nodes use for item fingerprints and data compared with
+// peers, so the network can move to another algorithm by negotiating it in the handshake
+package hashing
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"strings"
+)
+
+// Algorithm is a hash algorithm by its name on the wire
+type Algorithm string
+
+const (
+	SHA256 Algorithm = "sha256"
+	BLAKE3 Algorithm = "blake3"
+)
+
+// Default is used with peers that do not advertise their algorithms, every node supports it
+const Default = SHA256
+
+// known lists the supported algorithms, ties in negotiation go to the earlier one
+var known = []Algorithm{SHA256, BLAKE3}
+
+var ErrUnknownAlgorithm = errors.New("unknown hash algorithm")
+
+// Parse returns the algorithm with the given name, case insensitive
+func Parse(name string) (Algorithm, error) {
+	algorithm := Algorithm(strings.ToLower(strings.TrimSpace(name)))
+	for _, candidate := range known {
+		if algorithm == candidate {
+			return algorithm, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnknownAlgorithm, name)
+}
+
+// ParseList parses algorithms in order of preference, dropping duplicates, an empty list is Default only
+func ParseList(names []string) ([]Algorithm, error) {
+	var algorithms []Algorithm
+	seen := make(map[Algorithm]bool)
+	for _, name := range names {
+		algorithm, err := Parse(name)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[algorithm] {
+			seen[algorithm] = true
+			algorithms = append(algorithms, algorithm)
+		}
+	}
+	if len(algorithms) == 0 {
+		algorithms = []Algorithm{Default}
+	}
+	return algorithms, nil
+}
+
+// New returns a new hash of the algorithm, Default for an unknown one
+func (a Algorithm) New() hash.Hash {
+	switch a {
+	case BLAKE3:
+		return newBlake3()
+	default:
+		return sha256.New()
+	}
+}
+
+// Sum returns the 32 byte digest of data
+func (a Algorithm) Sum(data []byte) []byte {
+	h := a.New()
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+// Names returns the wire names of algorithms, as advertised in the handshake
+func Names(algorithms []Algorithm) []string {
+	names := make([]string, len(algorithms))
+	for i, algorithm := range algorithms {
+		names[i] = string(algorithm)
+	}
+	return names
+}
+
+// Negotiate picks the algorithm two nodes use with each other from their preferences, most preferred first
+// Both sides reach the same choice: the common algorithm with the best combined rank, ties broken by the
+// order of known algorithms, and Default when the peer advertised nothing or nothing is in common
+func Negotiate(local []Algorithm, remote []string) Algorithm {
+	rank := func(list []Algorithm, algorithm Algorithm) int {
+		for i, candidate := range list {
+			if candidate == algorithm {
+				return i
+			}
+		}
+		return -1
+	}
+
+	var peer []Algorithm
+	for _, name := range remote {
+		if algorithm, err := Parse(name); err == nil && rank(peer, algorithm) < 0 {
+			peer = append(peer, algorithm)
+		}
+	}
+
+	chosen, best := Default, -1
+	for _, algorithm := range known {
+		l, r := rank(local, algorithm), rank(peer, algorithm)
+		if l < 0 || r < 0 {
+			continue
+		}
+		if best < 0 || l+r < best {
+			chosen, best = algorithm, l+r
+		}
+	}
+	return chosen
+}
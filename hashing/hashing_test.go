@@ -0,0 +1,55 @@
+package hashing
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseList(t *testing.T) {
+	algorithms, err := ParseList([]string{"BLAKE3", " sha256", "blake3"})
+	require.NoError(t, err)
+	assert.Equal(t, []Algorithm{BLAKE3, SHA256}, algorithms)
+
+	algorithms, err = ParseList(nil)
+	require.NoError(t, err)
+	assert.Equal(t, []Algorithm{Default}, algorithms)
+
+	_, err = ParseList([]string{"md5"})
+	assert.ErrorIs(t, err, ErrUnknownAlgorithm)
+}
+
+func TestAlgorithm_Sum(t *testing.T) {
+	sum := sha256.Sum256([]byte("item"))
+	assert.Equal(t, sum[:], SHA256.Sum([]byte("item")))
+	assert.Len(t, BLAKE3.Sum([]byte("item")), 32)
+	assert.NotEqual(t, SHA256.Sum([]byte("item")), BLAKE3.Sum([]byte("item")))
+}
+
+func TestNegotiate(t *testing.T) {
+	tests := map[string]struct {
+		local    []Algorithm
+		remote   []string
+		expected Algorithm
+	}{
+		"peer without algorithms": {[]Algorithm{BLAKE3, SHA256}, nil, SHA256},
+		"both prefer blake3":      {[]Algorithm{BLAKE3, SHA256}, []string{"blake3", "sha256"}, BLAKE3},
+		"nothing in common":       {[]Algorithm{BLAKE3}, []string{"sha256"}, SHA256},
+		"unknown names ignored":   {[]Algorithm{SHA256, BLAKE3}, []string{"sha3", "blake3"}, BLAKE3},
+		"split preference":        {[]Algorithm{BLAKE3, SHA256}, []string{"sha256", "blake3"}, SHA256},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, Negotiate(test.local, test.remote))
+		})
+	}
+
+	t.Run("symmetric", func(t *testing.T) {
+		a := []Algorithm{BLAKE3, SHA256}
+		b := []Algorithm{SHA256, BLAKE3}
+		assert.Equal(t, Negotiate(a, Names(b)), Negotiate(b, Names(a)))
+	})
+}
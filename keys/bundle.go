@@ -0,0 +1,220 @@
+package keys
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Identity bundles start with this header, followed by the salt, the nonce and the sealed JSON
+const bundleMagic = "CCIDENT1"
+
+const (
+	bundleSaltSize   = 16
+	bundleIterations = 600000 // PBKDF2-HMAC-SHA256 rounds deriving the AES-256 key from the passphrase
+	minPassphrase    = 12
+)
+
+var (
+	ErrBundlePassphrase = errors.New("wrong passphrase or corrupted identity bundle")
+	ErrBundleFormat     = errors.New("not an identity bundle")
+	ErrBundleExists     = errors.New("identity files already exist")
+)
+
+// Bundle is a backup of the node identity: its key pair, the pinned keys of its peers and
+// any configuration files needed to run it elsewhere
+type Bundle struct {
+	WebAddress string            `json:"web_address"`
+	CreatedAt  time.Time         `json:"created_at"`
+	Files      map[string][]byte `json:"files"` // Contents by path relative to the node directory
+}
+
+// CollectIdentity bundles the keys directory and the extra files that exist, e.g. .env
+func CollectIdentity(webAddress string, extra ...string) (*Bundle, error) {
+	release, err := lockKeys(false)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	sanitized := sanitizeWebAddress(webAddress)
+	if _, err := os.Stat(filepath.Join("keys", sanitized+".private.key")); err != nil {
+		return nil, fmt.Errorf("no private key for %s: %w", webAddress, err)
+	}
+
+	bundle := &Bundle{WebAddress: webAddress, CreatedAt: time.Now().UTC(), Files: make(map[string][]byte)}
+
+	entries, err := os.ReadDir("keys")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keys directory: %w", err)
+	}
+	for _, entry := range entries {
+		if entry.Type().IsRegular() && !internalFile(entry.Name()) {
+			extra = append(extra, filepath.Join("keys", entry.Name()))
+		}
+	}
+
+	for _, path := range extra {
+		data, err := os.ReadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		bundle.Files[filepath.ToSlash(path)] = data
+	}
+
+	return bundle, nil
+}
+
+// Paths returns the bundled file paths in order
+func (b *Bundle) Paths() []string {
+	paths := make([]string, 0, len(b.Files))
+	for path := range b.Files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// Restore writes the bundled files into the node directory, existing files are only
+// replaced when force is set so a running identity is not overwritten by accident
+func (b *Bundle) Restore(force bool) error {
+	release, err := lockKeys(true)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	paths := b.Paths()
+	for _, path := range paths {
+		if !filepath.IsLocal(filepath.FromSlash(path)) {
+			return fmt.Errorf("%w: unsafe path %s", ErrBundleFormat, path)
+		}
+		if _, err := os.Stat(filepath.FromSlash(path)); err == nil && !force {
+			return fmt.Errorf("%w: %s", ErrBundleExists, path)
+		}
+	}
+
+	for _, path := range paths {
+		target := filepath.FromSlash(path)
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return fmt.Errorf("failed to create %s: %w", filepath.Dir(target), err)
+		}
+
+		// Private keys and configuration with tokens stay readable by the owner only
+		mode := os.FileMode(0600)
+		if strings.HasSuffix(path, ".public.key") {
+			mode = 0644
+		}
+		if err := writeFileAtomic(target, b.Files[path], mode); err != nil {
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+	}
+
+	return nil
+}
+
+// SealBundle encrypts a bundle with a key derived from the passphrase
+func SealBundle(bundle *Bundle, passphrase []byte) ([]byte, error) {
+	if len(passphrase) < minPassphrase {
+		return nil, fmt.Errorf("passphrase must be at least %d characters", minPassphrase)
+	}
+
+	plaintext, err := json.Marshal(bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	salt := make([]byte, bundleSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	aead, err := bundleCipher(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	header := append(append([]byte(bundleMagic), salt...), nonce...)
+	return aead.Seal(header, nonce, plaintext, header), nil
+}
+
+// OpenBundle decrypts a bundle sealed by SealBundle
+func OpenBundle(data, passphrase []byte) (*Bundle, error) {
+	headerSize := len(bundleMagic) + bundleSaltSize
+	if len(data) < headerSize || string(data[:len(bundleMagic)]) != bundleMagic {
+		return nil, ErrBundleFormat
+	}
+
+	aead, err := bundleCipher(passphrase, data[len(bundleMagic):headerSize])
+	if err != nil {
+		return nil, err
+	}
+	headerSize += aead.NonceSize()
+	if len(data) < headerSize {
+		return nil, ErrBundleFormat
+	}
+
+	plaintext, err := aead.Open(nil, data[headerSize-aead.NonceSize():headerSize], data[headerSize:], data[:headerSize])
+	if err != nil {
+		return nil, ErrBundlePassphrase
+	}
+
+	var bundle Bundle
+	if err := json.Unmarshal(plaintext, &bundle); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBundleFormat, err)
+	}
+	return &bundle, nil
+}
+
+func bundleCipher(passphrase, salt []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(pbkdf2SHA256(passphrase, salt, bundleIterations, 32))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// pbkdf2SHA256 derives a key of the given length as in RFC 8018
+func pbkdf2SHA256(password, salt []byte, iterations, length int) []byte {
+	prf := hmac.New(sha256.New, password)
+	key := make([]byte, 0, length+sha256.Size)
+	block := make([]byte, 4)
+
+	for i := uint32(1); len(key) < length; i++ {
+		binary.BigEndian.PutUint32(block, i)
+		prf.Reset()
+		prf.Write(salt)
+		prf.Write(block)
+		u := prf.Sum(nil)
+
+		t := append([]byte(nil), u...)
+		for n := 1; n < iterations; n++ {
+			prf.Reset()
+			prf.Write(u)
+			u = prf.Sum(u[:0])
+			for j := range t {
+				t[j] ^= u[j]
+			}
+		}
+		key = append(key, t...)
+	}
+
+	return key[:length]
+}
@@ -0,0 +1,102 @@
+package keys
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// chdirTemp runs the test in an empty node directory
+func chdirTemp(t *testing.T) string {
+	originalDir, err := os.Getwd()
+	require.NoError(t, err)
+	dir := t.TempDir()
+	require.NoError(t, os.Chdir(dir))
+	t.Cleanup(func() { os.Chdir(originalDir) })
+	return dir
+}
+
+func TestPBKDF2SHA256(t *testing.T) {
+	// Test vector from RFC 7914, section 11
+	key := pbkdf2SHA256([]byte("passwd"), []byte("salt"), 1, 64)
+	assert.Equal(t, "55ac046e56e3089fec1691c22544b605f94185216dde0465e68b9d57c20dacbc49ca9cccf179b645991664b39d77ef317c71b845b1e30bd509112041d3a19783", hex.EncodeToString(key))
+}
+
+func TestBundle_BackupRestore(t *testing.T) {
+	chdirTemp(t)
+
+	km, err := New("node.example.com")
+	require.NoError(t, err)
+	peer, err := New("peer.example.com")
+	require.NoError(t, err)
+	peerPublic, err := peer.Public()
+	require.NoError(t, err)
+	require.NoError(t, os.Remove(filepath.Join("keys", "peer.example.com.private.key")))
+	require.NoError(t, os.WriteFile(".env", []byte("WEB_ADDRESS=node.example.com\n"), 0600))
+
+	bundle, err := CollectIdentity("node.example.com", ".env", "missing.json")
+	require.NoError(t, err)
+	assert.Equal(t, []string{".env", "keys/node.example.com.private.key", "keys/node.example.com.public.key", "keys/peer.example.com.public.key"}, bundle.Paths())
+
+	passphrase := []byte("correct horse battery staple")
+	sealed, err := SealBundle(bundle, passphrase)
+	require.NoError(t, err)
+	assert.NotContains(t, string(sealed), "WEB_ADDRESS")
+
+	// Restoring on a new host brings back the same identity and trust store
+	chdirTemp(t)
+	opened, err := OpenBundle(sealed, passphrase)
+	require.NoError(t, err)
+	assert.Equal(t, "node.example.com", opened.WebAddress)
+	require.NoError(t, opened.Restore(false))
+
+	restored, err := New("node.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, km.privateKey, restored.privateKey)
+	pinned, err := LoadPublic("peer.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, peerPublic, pinned)
+
+	info, err := os.Stat(filepath.Join("keys", "node.example.com.private.key"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	// An existing identity is only replaced on request
+	assert.ErrorIs(t, opened.Restore(false), ErrBundleExists)
+	assert.NoError(t, opened.Restore(true))
+}
+
+func TestBundle_Errors(t *testing.T) {
+	chdirTemp(t)
+
+	_, err := CollectIdentity("node.example.com")
+	assert.Error(t, err, "a node without keys has no identity to back up")
+
+	bundle := &Bundle{WebAddress: "node.example.com", Files: map[string][]byte{".env": []byte("A=1")}}
+	_, err = SealBundle(bundle, []byte("short"))
+	assert.Error(t, err)
+
+	sealed, err := SealBundle(bundle, []byte("correct horse battery staple"))
+	require.NoError(t, err)
+
+	_, err = OpenBundle(sealed, []byte("wrong horse battery staple"))
+	assert.ErrorIs(t, err, ErrBundlePassphrase)
+
+	tampered := append([]byte(nil), sealed...)
+	tampered[len(tampered)-1] ^= 0xff
+	_, err = OpenBundle(tampered, []byte("correct horse battery staple"))
+	assert.ErrorIs(t, err, ErrBundlePassphrase)
+
+	_, err = OpenBundle([]byte("plain text"), []byte("correct horse battery staple"))
+	assert.ErrorIs(t, err, ErrBundleFormat)
+	_, err = OpenBundle(sealed[:len(bundleMagic)+4], []byte("correct horse battery staple"))
+	assert.ErrorIs(t, err, ErrBundleFormat)
+
+	escaping := &Bundle{Files: map[string][]byte{"../outside": []byte("x")}}
+	assert.ErrorIs(t, escaping.Restore(true), ErrBundleFormat)
+	assert.NoFileExists(t, filepath.Join("..", "outside"))
+}
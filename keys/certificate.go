@@ -0,0 +1,68 @@
+package keys
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// certificateLifetime is how long a channel certificate is valid, it is drawn again for every connection
+const certificateLifetime = 24 * time.Hour
+
+// Certificate returns a self-signed TLS certificate for the node key, peers authenticate the
+// channel by comparing its key with the one pinned for the node instead of trusting a CA
+func (k *KeyManager) Certificate() (*tls.Certificate, error) {
+	if k.privateKey == nil {
+		return nil, fmt.Errorf("private key not initialized")
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 127))
+	if err != nil {
+		return nil, fmt.Errorf("failed to draw certificate serial: %w", err)
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: k.webAddress},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(certificateLifetime),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, k.publicKey, k.privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create certificate: %w", err)
+	}
+
+	return &tls.Certificate{Certificate: [][]byte{der}, PrivateKey: k.privateKey}, nil
+}
+
+// CertificateKey returns the node key a certificate made with Certificate was issued for,
+// checking the certificate is signed by that key
+func CertificateKey(der []byte) ([]byte, error) {
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("invalid certificate: %w", err)
+	}
+
+	publicKey, ok := cert.PublicKey.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("certificate key is not an ed25519 key")
+	}
+	if err := cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature); err != nil {
+		return nil, fmt.Errorf("certificate is not signed by its key: %w", err)
+	}
+	if now := time.Now(); now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+		return nil, fmt.Errorf("certificate expired or not yet valid")
+	}
+
+	return bytes.Clone(publicKey), nil
+}
@@ -0,0 +1,37 @@
+package keys
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCertificate(t *testing.T) {
+	chdirTemp(t)
+
+	km, err := New("example.com")
+	require.NoError(t, err)
+	publicKey, err := km.Public()
+	require.NoError(t, err)
+
+	cert, err := km.Certificate()
+	require.NoError(t, err)
+	require.Len(t, cert.Certificate, 1)
+
+	key, err := CertificateKey(cert.Certificate[0])
+	require.NoError(t, err)
+	assert.Equal(t, publicKey, key)
+
+	// Every connection draws its own certificate for the same key
+	again, err := km.Certificate()
+	require.NoError(t, err)
+	assert.NotEqual(t, cert.Certificate[0], again.Certificate[0])
+
+	_, err = CertificateKey([]byte("not a certificate"))
+	assert.Error(t, err)
+
+	var empty KeyManager
+	_, err = empty.Certificate()
+	assert.Error(t, err)
+}
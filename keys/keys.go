@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 )
 
 // KeyManager handles cryptographic operations for ConsensusCraft
@@ -14,6 +15,7 @@ type KeyManager struct {
 	privateKey ed25519.PrivateKey
 	publicKey  ed25519.PublicKey
 	webAddress string
+	rotation   *KeyRotation // Rotation to the current key, nil when it was never rotated
 }
 
 // New initializes a new KeyManager instance with keys stored in keys/{webaddress}.private.key and keys/{webaddress}.public.key
@@ -33,6 +35,13 @@ func New(webAddress string) (*KeyManager, error) {
 		webAddress: webAddress,
 	}
 
+	// Held until the keys are saved, so two processes starting together don't generate different keys
+	release, err := lockKeys(true)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	// Try to load existing keys
 	if err := km.loadKeys(privateKeyPath, publicKeyPath); err != nil {
 		// If loading fails, generate new keys
@@ -45,6 +54,8 @@ func New(webAddress string) (*KeyManager, error) {
 			return nil, fmt.Errorf("failed to save keys: %w", err)
 		}
 	}
+	usage.name(km.publicKey, webAddress)
+	km.loadRotation()
 
 	return km, nil
 }
@@ -63,7 +74,9 @@ func (k *KeyManager) Sign(player string, inventory []byte) ([]byte, error) {
 	message := append([]byte(player), inventory...)
 
 	// Sign the message
+	start := time.Now()
 	signature := ed25519.Sign(k.privateKey, message)
+	usage.record(k.publicKey, false, true, time.Since(start), time.Now())
 
 	return signature, nil
 }
@@ -86,7 +99,10 @@ func (k *KeyManager) Verify(player string, inventory []byte, signature []byte) e
 	message := append([]byte(player), inventory...)
 
 	// Verify the signature
-	if !ed25519.Verify(k.publicKey, message, signature) {
+	start := time.Now()
+	valid := ed25519.Verify(k.publicKey, message, signature)
+	usage.record(k.publicKey, true, valid, time.Since(start), time.Now())
+	if !valid {
 		return fmt.Errorf("signature verification failed")
 	}
 
@@ -116,6 +132,12 @@ func (k *KeyManager) Save(webAddress string, pubkey []byte) error {
 	sanitized := sanitizeWebAddress(webAddress)
 	publicKeyPath := filepath.Join("keys", sanitized+".public.key")
 
+	release, err := lockKeys(true)
+	if err != nil {
+		return err
+	}
+	defer release()
+
 	// Check if key already exists
 	if _, err := os.Stat(publicKeyPath); err == nil {
 		return fmt.Errorf("public key for %s already exists", webAddress)
@@ -127,9 +149,10 @@ func (k *KeyManager) Save(webAddress string, pubkey []byte) error {
 	}
 
 	// Save the public key
-	if err := os.WriteFile(publicKeyPath, pubkey, 0644); err != nil {
+	if err := writeFileAtomic(publicKeyPath, pubkey, 0644); err != nil {
 		return fmt.Errorf("failed to save public key: %w", err)
 	}
+	usage.name(pubkey, webAddress)
 
 	return nil
 }
@@ -175,7 +198,7 @@ func (k *KeyManager) loadKeys(privateKeyPath, publicKeyPath string) error {
 	return nil
 }
 
-// saveKeys saves keys to files
+// saveKeys saves keys to files, the caller holds the exclusive lock of keys/
 func (k *KeyManager) saveKeys(privateKeyPath, publicKeyPath string) error {
 	// Ensure keys directory exists
 	if err := os.MkdirAll("keys", 0755); err != nil {
@@ -183,12 +206,12 @@ func (k *KeyManager) saveKeys(privateKeyPath, publicKeyPath string) error {
 	}
 
 	// Save private key with restricted permissions
-	if err := os.WriteFile(privateKeyPath, k.privateKey, 0600); err != nil {
+	if err := writeFileAtomic(privateKeyPath, k.privateKey, 0600); err != nil {
 		return fmt.Errorf("failed to save private key: %w", err)
 	}
 
 	// Save public key
-	if err := os.WriteFile(publicKeyPath, k.publicKey, 0644); err != nil {
+	if err := writeFileAtomic(publicKeyPath, k.publicKey, 0644); err != nil {
 		return fmt.Errorf("failed to save public key: %w", err)
 	}
 
@@ -12,8 +12,7 @@ import (
 )
 
 func TestNew(t *testing.T) {
-	// Clean up test keys directory
-	defer cleanupTestKeys(t)
+	chdirTemp(t)
 
 	t.Run("creates new key manager with valid web address", func(t *testing.T) {
 		km, err := New("example.com")
@@ -65,7 +64,7 @@ func TestNew(t *testing.T) {
 }
 
 func TestSign(t *testing.T) {
-	defer cleanupTestKeys(t)
+	chdirTemp(t)
 
 	km, err := New("test.com")
 	require.NoError(t, err)
@@ -107,7 +106,7 @@ func TestSign(t *testing.T) {
 }
 
 func TestVerify(t *testing.T) {
-	defer cleanupTestKeys(t)
+	chdirTemp(t)
 
 	km, err := New("test.com")
 	require.NoError(t, err)
@@ -186,7 +185,7 @@ func TestVerify(t *testing.T) {
 }
 
 func TestPublic(t *testing.T) {
-	defer cleanupTestKeys(t)
+	chdirTemp(t)
 
 	km, err := New("test.com")
 	require.NoError(t, err)
@@ -208,7 +207,7 @@ func TestPublic(t *testing.T) {
 }
 
 func TestSave(t *testing.T) {
-	defer cleanupTestKeys(t)
+	chdirTemp(t)
 
 	km, err := New("test.com")
 	require.NoError(t, err)
@@ -299,7 +298,7 @@ func TestSanitizeWebAddress(t *testing.T) {
 }
 
 func TestKeyPersistence(t *testing.T) {
-	defer cleanupTestKeys(t)
+	chdirTemp(t)
 
 	t.Run("keys persist across instances", func(t *testing.T) {
 		// Create first instance
@@ -330,7 +329,7 @@ func TestKeyPersistence(t *testing.T) {
 }
 
 func TestCrossVerification(t *testing.T) {
-	defer cleanupTestKeys(t)
+	chdirTemp(t)
 
 	t.Run("different key managers cannot verify each other's signatures", func(t *testing.T) {
 		km1, err := New("server1.com")
@@ -352,19 +351,3 @@ func TestCrossVerification(t *testing.T) {
 		assert.Contains(t, err.Error(), "signature verification failed")
 	})
 }
-
-// Helper function to clean up test keys
-func cleanupTestKeys(t *testing.T) {
-	// Remove all test key files
-	matches, err := filepath.Glob(filepath.Join("keys", "*.key"))
-	if err != nil {
-		t.Logf("Warning: failed to glob key files: %v", err)
-		return
-	}
-
-	for _, match := range matches {
-		if err := os.Remove(match); err != nil {
-			t.Logf("Warning: failed to remove %s: %v", match, err)
-		}
-	}
-}
@@ -0,0 +1,123 @@
+package keys
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Statuses of a credential against the key lifetime
+const (
+	CredentialOK         = "ok"
+	CredentialRotateSoon = "rotate_soon"
+	CredentialExpired    = "expired"
+)
+
+// lifetime is the rotation policy of keys, set with SetKeyLifetime
+var lifetime struct {
+	mu     sync.Mutex
+	maxAge time.Duration
+	warn   time.Duration
+}
+
+// SetKeyLifetime sets how long keys may be used before they should be rotated and how long before
+// that rotation is due, a zero maxAge keeps keys forever
+func SetKeyLifetime(maxAge, warn time.Duration) {
+	lifetime.mu.Lock()
+	defer lifetime.mu.Unlock()
+	lifetime.maxAge, lifetime.warn = maxAge, warn
+}
+
+// Credential is the key pair of this node or the pinned key of a peer, with its age against the key lifetime
+// Keys age from when their file was written, when they were generated, pinned, restored or rotated
+type Credential struct {
+	Server    string    `json:"server"`
+	Own       bool      `json:"own"` // The key pair of this node rather than a pinned peer key
+	Key       string    `json:"key"` // Hex prefix of the public key
+	Since     time.Time `json:"since"`
+	ExpiresAt time.Time `json:"expires_at,omitempty"` // Zero without a key lifetime
+	Status    string    `json:"status"`
+}
+
+// Credentials lists the keys of keys/ with their rotation status at now, own key first and expiring
+// keys before the others
+func Credentials(now time.Time) ([]Credential, error) {
+	release, err := lockKeys(false)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	entries, err := os.ReadDir(keysDir)
+	if os.IsNotExist(err) {
+		return []Credential{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keys directory: %w", err)
+	}
+
+	lifetime.mu.Lock()
+	maxAge, warn := lifetime.maxAge, lifetime.warn
+	lifetime.mu.Unlock()
+
+	credentials := []Credential{}
+	for _, entry := range entries {
+		stem, ok := strings.CutSuffix(entry.Name(), ".public.key")
+		if !ok || internalFile(entry.Name()) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		publicKey, err := os.ReadFile(filepath.Join(keysDir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read public key: %w", err)
+		}
+		_, err = os.Stat(filepath.Join(keysDir, stem+".private.key"))
+
+		credential := Credential{
+			Server: usage.server(publicKey, stem),
+			Own:    err == nil,
+			Key:    keyID(publicKey),
+			Since:  info.ModTime(),
+			Status: CredentialOK,
+		}
+		if maxAge > 0 {
+			credential.ExpiresAt = credential.Since.Add(maxAge)
+			switch {
+			case !now.Before(credential.ExpiresAt):
+				credential.Status = CredentialExpired
+			case !now.Before(credential.ExpiresAt.Add(-warn)):
+				credential.Status = CredentialRotateSoon
+			}
+		}
+		credentials = append(credentials, credential)
+	}
+
+	sort.Slice(credentials, func(i, j int) bool {
+		a, b := credentials[i], credentials[j]
+		if a.Own != b.Own {
+			return a.Own
+		}
+		if a.ExpiresAt != b.ExpiresAt {
+			return a.ExpiresAt.Before(b.ExpiresAt)
+		}
+		return a.Server < b.Server
+	})
+	return credentials, nil
+}
+
+// server returns the known owner of a public key, fallback when it was not loaded or pinned by this process
+func (t *usageTracker) server(publicKey []byte, fallback string) string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if server := t.names[keyID(publicKey)]; server != "" {
+		return server
+	}
+	return fallback
+}
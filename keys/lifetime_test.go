@@ -0,0 +1,57 @@
+package keys
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCredentials(t *testing.T) {
+	chdirTemp(t)
+	defer SetKeyLifetime(0, 0)
+
+	km, err := New("a.example.com")
+	require.NoError(t, err)
+	for _, peer := range []string{"b.example.com", "c.example.com"} {
+		public, _, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		require.NoError(t, km.Save(peer, public))
+	}
+
+	now := time.Now()
+	require.NoError(t, os.Chtimes(filepath.Join("keys", "b.example.com.public.key"), now, now.Add(-340*24*time.Hour)))
+	require.NoError(t, os.Chtimes(filepath.Join("keys", "c.example.com.public.key"), now, now.Add(-400*24*time.Hour)))
+
+	t.Run("WithoutLifetime", func(t *testing.T) {
+		credentials, err := Credentials(now)
+		require.NoError(t, err)
+		require.Len(t, credentials, 3)
+		assert.True(t, credentials[0].Own)
+		assert.Equal(t, "a.example.com", credentials[0].Server)
+		for _, credential := range credentials {
+			assert.Equal(t, CredentialOK, credential.Status)
+			assert.True(t, credential.ExpiresAt.IsZero())
+		}
+	})
+
+	t.Run("WithLifetime", func(t *testing.T) {
+		SetKeyLifetime(365*24*time.Hour, 30*24*time.Hour)
+		credentials, err := Credentials(now)
+		require.NoError(t, err)
+		require.Len(t, credentials, 3)
+
+		assert.Equal(t, "a.example.com", credentials[0].Server)
+		assert.Equal(t, CredentialOK, credentials[0].Status)
+		assert.Equal(t, "c.example.com", credentials[1].Server)
+		assert.Equal(t, CredentialExpired, credentials[1].Status)
+		assert.Equal(t, "b.example.com", credentials[2].Server)
+		assert.Equal(t, CredentialRotateSoon, credentials[2].Status)
+		assert.False(t, credentials[2].Own)
+	})
+}
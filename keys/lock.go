@@ -0,0 +1,97 @@
+package keys
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// keysDir holds the key pair of the node and the pinned public keys of its peers
+const keysDir = "keys"
+
+// lockFile is the advisory lock of keys/, shared by readers and exclusive for writers
+const lockFile = ".lock"
+
+// lockWait is how long a process waits for another one to release keys/ before giving up
+var lockWait = 5 * time.Second
+
+// lockPoll is how often a held lock is retried
+const lockPoll = 50 * time.Millisecond
+
+var ErrKeysLocked = errors.New("keys directory is locked by another process")
+
+// lockKeys takes the lock of keys/, exclusive to write and shared to read, and returns its release
+// A shared lock of a missing directory is a no-op, as there is nothing to read yet
+func lockKeys(exclusive bool) (func(), error) {
+	if exclusive {
+		if err := os.MkdirAll(keysDir, 0755); err != nil {
+			return nil, fmt.Errorf("failed to create keys directory: %w", err)
+		}
+	} else if _, err := os.Stat(keysDir); errors.Is(err, os.ErrNotExist) {
+		return func() {}, nil
+	}
+
+	path := filepath.Join(keysDir, lockFile)
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open keys lock: %w", err)
+	}
+
+	deadline := time.Now().Add(lockWait)
+	for {
+		locked, err := tryLock(file, exclusive)
+		if err != nil {
+			file.Close()
+			return nil, fmt.Errorf("failed to lock %s: %w", path, err)
+		}
+		if locked {
+			break
+		}
+		if time.Now().After(deadline) {
+			file.Close()
+			return nil, fmt.Errorf("%w: %s is still held after %s, stop the node or command using it and retry",
+				ErrKeysLocked, path, lockWait)
+		}
+		time.Sleep(lockPoll)
+	}
+
+	return func() {
+		unlock(file)
+		file.Close()
+	}, nil
+}
+
+// writeFileAtomic replaces a file with data through a temporary file renamed over it, so a
+// reader never sees a partially written key
+func writeFileAtomic(path string, data []byte, mode os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(mode); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// internalFile reports whether a file of keys/ is the lock or a temporary file rather than a key
+func internalFile(name string) bool {
+	return strings.HasPrefix(name, ".")
+}
@@ -0,0 +1,82 @@
+package keys
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockKeys(t *testing.T) {
+	chdirTemp(t)
+
+	previous := lockWait
+	lockWait = 100 * time.Millisecond
+	t.Cleanup(func() { lockWait = previous })
+
+	t.Run("shared lock of a missing directory", func(t *testing.T) {
+		release, err := lockKeys(false)
+		require.NoError(t, err)
+		release()
+		assert.NoDirExists(t, "keys")
+	})
+
+	t.Run("readers share the lock", func(t *testing.T) {
+		release, err := lockKeys(true)
+		require.NoError(t, err)
+		release()
+
+		first, err := lockKeys(false)
+		require.NoError(t, err)
+		defer first()
+		second, err := lockKeys(false)
+		require.NoError(t, err)
+		second()
+	})
+
+	t.Run("held lock", func(t *testing.T) {
+		release, err := lockKeys(true)
+		require.NoError(t, err)
+
+		_, err = New("node.example.com")
+		assert.ErrorIs(t, err, ErrKeysLocked)
+		_, err = LoadPublic("node.example.com")
+		assert.ErrorIs(t, err, ErrKeysLocked)
+
+		release()
+		_, err = New("node.example.com")
+		assert.NoError(t, err)
+	})
+
+	t.Run("lock is not bundled", func(t *testing.T) {
+		bundle, err := CollectIdentity("node.example.com")
+		require.NoError(t, err)
+		assert.NotContains(t, bundle.Paths(), "keys/"+lockFile)
+	})
+}
+
+func TestWriteFileAtomic(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "node.example.com.private.key")
+
+	require.NoError(t, writeFileAtomic(path, []byte("first"), 0600))
+	require.NoError(t, writeFileAtomic(path, []byte("second"), 0600))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "second", string(data))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1) // No temporary file is left behind
+
+	if runtime.GOOS != "windows" {
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	}
+}
@@ -0,0 +1,27 @@
+//go:build unix
+
+package keys
+
+import (
+	"errors"
+	"os"
+	"syscall"
+)
+
+// tryLock takes a flock of the file without blocking, reporting false when another process holds it
+func tryLock(file *os.File, exclusive bool) (bool, error) {
+	how := syscall.LOCK_SH
+	if exclusive {
+		how = syscall.LOCK_EX
+	}
+
+	err := syscall.Flock(int(file.Fd()), how|syscall.LOCK_NB)
+	if errors.Is(err, syscall.EWOULDBLOCK) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func unlock(file *os.File) {
+	syscall.Flock(int(file.Fd()), syscall.LOCK_UN)
+}
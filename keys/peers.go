@@ -0,0 +1,43 @@
+package keys
+
+import (
+	"crypto/ed25519"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// LoadPublic returns the public key saved for a server, os.ErrNotExist is wrapped when there is none
+func LoadPublic(webAddress string) ([]byte, error) {
+	if webAddress == "" {
+		return nil, fmt.Errorf("web address cannot be empty")
+	}
+
+	release, err := lockKeys(false)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	publicKeyPath := filepath.Join("keys", sanitizeWebAddress(webAddress)+".public.key")
+	publicKey, err := os.ReadFile(publicKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read public key: %w", err)
+	}
+
+	if len(publicKey) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid public key size: expected %d, got %d", ed25519.PublicKeySize, len(publicKey))
+	}
+	usage.name(publicKey, webAddress)
+
+	return publicKey, nil
+}
+
+// VerifyPublic verifies a signature made with Sign by the owner of publicKey
+func VerifyPublic(publicKey []byte, player string, inventory []byte, signature []byte) error {
+	if len(publicKey) != ed25519.PublicKeySize {
+		return fmt.Errorf("invalid public key size: expected %d, got %d", ed25519.PublicKeySize, len(publicKey))
+	}
+
+	return (&KeyManager{publicKey: publicKey}).Verify(player, inventory, signature)
+}
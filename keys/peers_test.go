@@ -0,0 +1,47 @@
+package keys
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadPublic(t *testing.T) {
+	chdirTemp(t)
+
+	_, err := LoadPublic("peer.example.com")
+	assert.True(t, errors.Is(err, os.ErrNotExist))
+
+	km, err := New("local.example.com")
+	require.NoError(t, err)
+	public, err := km.Public()
+	require.NoError(t, err)
+
+	require.NoError(t, km.Save("peer.example.com", public))
+
+	loaded, err := LoadPublic("peer.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, public, loaded)
+
+	_, err = LoadPublic("")
+	assert.Error(t, err)
+}
+
+func TestVerifyPublic(t *testing.T) {
+	chdirTemp(t)
+
+	km, err := New("signer.example.com")
+	require.NoError(t, err)
+	public, err := km.Public()
+	require.NoError(t, err)
+
+	signature, err := km.Sign("signer.example.com", []byte("handshake"))
+	require.NoError(t, err)
+
+	assert.NoError(t, VerifyPublic(public, "signer.example.com", []byte("handshake"), signature))
+	assert.Error(t, VerifyPublic(public, "signer.example.com", []byte("tampered"), signature))
+	assert.Error(t, VerifyPublic(public[:10], "signer.example.com", []byte("handshake"), signature))
+}
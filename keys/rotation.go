@@ -0,0 +1,167 @@
+package keys
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// rotationContext starts the signed message of a rotation, no inventory signature starts with a zero byte
+const rotationContext = "\x00consensuscraft key rotation\x00"
+
+// rotationSize is the encoded size of a rotation: both keys, the rotation time and the signature
+const rotationSize = 2*ed25519.PublicKeySize + 8 + ed25519.SignatureSize
+
+var ErrRotationNotPinned = errors.New("key rotation does not start from the pinned key")
+
+// KeyRotation is a statement signed with the previous key of a node that it now uses another key,
+// peers that pinned the previous key pin the new one when the node presents it
+// A peer that missed several rotations in a row must be repinned by its operator
+type KeyRotation struct {
+	Previous  ed25519.PublicKey
+	Current   ed25519.PublicKey
+	At        time.Time
+	Signature []byte
+}
+
+// rotationMessage binds a rotation to the node it belongs to
+func rotationMessage(webAddress string, previous, current []byte, at time.Time) []byte {
+	message := append([]byte(rotationContext), webAddress...)
+	message = append(append(append(message, 0), previous...), current...)
+	return binary.BigEndian.AppendUint64(message, uint64(at.Unix()))
+}
+
+// Marshal encodes the rotation for handshakes
+func (r *KeyRotation) Marshal() []byte {
+	data := append(append([]byte{}, r.Previous...), r.Current...)
+	data = binary.BigEndian.AppendUint64(data, uint64(r.At.Unix()))
+	return append(data, r.Signature...)
+}
+
+// ParseKeyRotation decodes a rotation encoded by Marshal, its signature is checked by Repin
+func ParseKeyRotation(data []byte) (*KeyRotation, error) {
+	if len(data) != rotationSize {
+		return nil, fmt.Errorf("invalid key rotation size: expected %d, got %d", rotationSize, len(data))
+	}
+
+	keySize := ed25519.PublicKeySize
+	return &KeyRotation{
+		Previous:  ed25519.PublicKey(data[:keySize]),
+		Current:   ed25519.PublicKey(data[keySize : 2*keySize]),
+		At:        time.Unix(int64(binary.BigEndian.Uint64(data[2*keySize:])), 0),
+		Signature: data[2*keySize+8:],
+	}, nil
+}
+
+// verify checks that the previous key signed the rotation of webAddress
+func (r *KeyRotation) verify(webAddress string) error {
+	if !ed25519.Verify(r.Previous, rotationMessage(webAddress, r.Previous, r.Current, r.At), r.Signature) {
+		return fmt.Errorf("invalid key rotation signature of %s", webAddress)
+	}
+	return nil
+}
+
+// rotationPath is where the last rotation of a node is kept, presented in its handshakes
+func rotationPath(webAddress string) string {
+	return filepath.Join(keysDir, sanitizeWebAddress(webAddress)+".rotation")
+}
+
+// loadRotation reads the last rotation of the node, nil unless it rotated to its current key
+func (k *KeyManager) loadRotation() {
+	data, err := os.ReadFile(rotationPath(k.webAddress))
+	if err != nil {
+		return
+	}
+	rotation, err := ParseKeyRotation(data)
+	if err == nil && bytes.Equal(rotation.Current, k.publicKey) {
+		k.rotation = rotation
+	}
+}
+
+// Rotation returns the statement of the rotation to the current key, nil when the key was never rotated
+func (k *KeyManager) Rotation() *KeyRotation {
+	return k.rotation
+}
+
+// Rotate replaces the key pair of the node with a new one and signs the rotation with the previous key
+// The previous pair stays next to the new one with a .previous suffix, to go back while no peer repinned
+// A running node keeps its previous key until it restarts
+func (k *KeyManager) Rotate() (*KeyRotation, error) {
+	if k.privateKey == nil {
+		return nil, fmt.Errorf("private key not initialized")
+	}
+
+	release, err := lockKeys(true)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	sanitized := sanitizeWebAddress(k.webAddress)
+	privateKeyPath := filepath.Join(keysDir, sanitized+".private.key")
+	publicKeyPath := filepath.Join(keysDir, sanitized+".public.key")
+
+	if err := writeFileAtomic(privateKeyPath+".previous", k.privateKey, 0600); err != nil {
+		return nil, fmt.Errorf("failed to keep previous private key: %w", err)
+	}
+	if err := writeFileAtomic(publicKeyPath+".previous", k.publicKey, 0644); err != nil {
+		return nil, fmt.Errorf("failed to keep previous public key: %w", err)
+	}
+
+	next := &KeyManager{webAddress: k.webAddress}
+	if err := next.generateKeys(); err != nil {
+		return nil, err
+	}
+
+	rotation := &KeyRotation{Previous: k.publicKey, Current: next.publicKey, At: time.Now()}
+	rotation.Signature = ed25519.Sign(k.privateKey, rotationMessage(k.webAddress, rotation.Previous, rotation.Current, rotation.At))
+
+	// The statement goes first, a node left with the new keys but no statement would be refused by its peers
+	if err := writeFileAtomic(rotationPath(k.webAddress), rotation.Marshal(), 0644); err != nil {
+		return nil, fmt.Errorf("failed to save key rotation: %w", err)
+	}
+	if err := next.saveKeys(privateKeyPath, publicKeyPath); err != nil {
+		return nil, fmt.Errorf("failed to save keys: %w", err)
+	}
+
+	k.privateKey, k.publicKey, k.rotation = next.privateKey, next.publicKey, rotation
+	usage.name(k.publicKey, k.webAddress)
+	return rotation, nil
+}
+
+// Repin replaces the pinned key of a peer with the key it rotated to, the rotation must be signed
+// by the pinned key
+func (k *KeyManager) Repin(webAddress string, rotation *KeyRotation) error {
+	if webAddress == "" {
+		return fmt.Errorf("web address cannot be empty")
+	}
+	if err := rotation.verify(webAddress); err != nil {
+		return err
+	}
+
+	release, err := lockKeys(true)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	publicKeyPath := filepath.Join(keysDir, sanitizeWebAddress(webAddress)+".public.key")
+	pinned, err := os.ReadFile(publicKeyPath)
+	if err != nil {
+		return fmt.Errorf("failed to read public key: %w", err)
+	}
+	if !bytes.Equal(pinned, rotation.Previous) {
+		return fmt.Errorf("%w of %s", ErrRotationNotPinned, webAddress)
+	}
+
+	if err := writeFileAtomic(publicKeyPath, rotation.Current, 0644); err != nil {
+		return fmt.Errorf("failed to save public key: %w", err)
+	}
+	usage.name(rotation.Current, webAddress)
+	return nil
+}
@@ -0,0 +1,72 @@
+package keys
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyManager_Rotate(t *testing.T) {
+	chdirTemp(t)
+
+	km, err := New("a.example.com")
+	require.NoError(t, err)
+	previous, err := km.Public()
+	require.NoError(t, err)
+	assert.Nil(t, km.Rotation())
+
+	rotation, err := km.Rotate()
+	require.NoError(t, err)
+	current, err := km.Public()
+	require.NoError(t, err)
+	assert.NotEqual(t, previous, current)
+	assert.Equal(t, []byte(previous), []byte(rotation.Previous))
+	assert.Equal(t, []byte(current), []byte(rotation.Current))
+	assert.FileExists(t, filepath.Join("keys", "a.example.com.private.key.previous"))
+
+	t.Run("ReloadedWithTheNewKey", func(t *testing.T) {
+		reloaded, err := New("a.example.com")
+		require.NoError(t, err)
+		public, err := reloaded.Public()
+		require.NoError(t, err)
+		assert.Equal(t, current, public)
+		require.NotNil(t, reloaded.Rotation())
+		assert.Equal(t, rotation.Marshal(), reloaded.Rotation().Marshal())
+	})
+
+	// The peer runs in its own node directory, where it pinned the previous key
+	chdirTemp(t)
+	peer, err := New("b.example.com")
+	require.NoError(t, err)
+	require.NoError(t, peer.Save("a.example.com", previous))
+
+	t.Run("RoundTrips", func(t *testing.T) {
+		parsed, err := ParseKeyRotation(rotation.Marshal())
+		require.NoError(t, err)
+		assert.Equal(t, rotation.At.Unix(), parsed.At.Unix())
+
+		_, err = ParseKeyRotation(rotation.Marshal()[1:])
+		assert.Error(t, err)
+	})
+
+	t.Run("RepinRequiresTheRotationOfThatServer", func(t *testing.T) {
+		assert.ErrorContains(t, peer.Repin("c.example.com", rotation), "invalid key rotation signature")
+
+		forged := *rotation
+		forged.At = forged.At.Add(time.Hour)
+		assert.ErrorContains(t, peer.Repin("a.example.com", &forged), "invalid key rotation signature")
+	})
+
+	t.Run("RepinsFromThePinnedKey", func(t *testing.T) {
+		require.NoError(t, peer.Repin("a.example.com", rotation))
+		pinned, err := LoadPublic("a.example.com")
+		require.NoError(t, err)
+		assert.Equal(t, current, pinned)
+
+		// Presenting the same rotation again does not start from the pinned key anymore
+		assert.ErrorIs(t, peer.Repin("a.example.com", rotation), ErrRotationNotPinned)
+	})
+}
@@ -0,0 +1,56 @@
+package keys
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Secret hashes are stored as pbkdf2-sha256$<iterations>$<hex salt>$<hex key>
+const secretScheme = "pbkdf2-sha256"
+
+const (
+	secretSaltSize   = 16
+	secretKeySize    = 32
+	secretIterations = bundleIterations
+)
+
+var ErrSecretHash = errors.New("invalid secret hash")
+
+// HashSecret derives a salted hash of an operator secret or passphrase for storing in configuration
+func HashSecret(secret string) (string, error) {
+	salt := make([]byte, secretSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to draw salt: %w", err)
+	}
+
+	key := pbkdf2SHA256([]byte(secret), salt, secretIterations, secretKeySize)
+	return fmt.Sprintf("%s$%d$%s$%s", secretScheme, secretIterations, hex.EncodeToString(salt), hex.EncodeToString(key)), nil
+}
+
+// VerifySecret reports whether secret matches a hash made by HashSecret
+func VerifySecret(hash, secret string) (bool, error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 4 || parts[0] != secretScheme {
+		return false, ErrSecretHash
+	}
+	iterations, err := strconv.Atoi(parts[1])
+	if err != nil || iterations < 1 || iterations > 10*secretIterations {
+		return false, ErrSecretHash
+	}
+	salt, err := hex.DecodeString(parts[2])
+	if err != nil || len(salt) == 0 {
+		return false, ErrSecretHash
+	}
+	expected, err := hex.DecodeString(parts[3])
+	if err != nil || len(expected) == 0 {
+		return false, ErrSecretHash
+	}
+
+	actual := pbkdf2SHA256([]byte(secret), salt, iterations, len(expected))
+	return subtle.ConstantTimeCompare(expected, actual) == 1, nil
+}
@@ -0,0 +1,39 @@
+package keys
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretHash(t *testing.T) {
+	t.Run("Salted", func(t *testing.T) {
+		hash, err := HashSecret("hunter2")
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(hash, "pbkdf2-sha256$600000$"))
+
+		again, err := HashSecret("hunter2")
+		require.NoError(t, err)
+		assert.NotEqual(t, hash, again, "equal secrets must not hash alike")
+
+		ok, err := VerifySecret(hash, "hunter2")
+		require.NoError(t, err)
+		assert.True(t, ok)
+		ok, err = VerifySecret(hash, "hunter3")
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		sum := sha256.Sum256([]byte("hunter2"))
+		for _, hash := range []string{"", "hunter2", hex.EncodeToString(sum[:]), "pbkdf2-sha256$0$00$00", "pbkdf2-sha256$1$zz$00", "bcrypt$1$00$00", "pbkdf2-sha256$1$00$"} {
+			ok, err := VerifySecret(hash, "hunter2")
+			assert.ErrorIs(t, err, ErrSecretHash, hash)
+			assert.False(t, ok)
+		}
+	})
+}
@@ -0,0 +1,274 @@
+package keys
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Key shares made by Split carry a header before the SplitSecret share: the format version, the
+// threshold, the ID of the split they belong to and the fingerprint of the public key they restore
+const (
+	shareVersion    = 1
+	shareSetIDSize  = 8
+	fingerprintSize = 8
+	shareHeaderSize = 2 + shareSetIDSize + fingerprintSize
+	shareSize       = shareHeaderSize + 1 + ed25519.SeedSize // Header, coordinate and seed bytes
+)
+
+var (
+	ErrShareFormat   = errors.New("not a key share")
+	ErrShareMismatch = errors.New("key shares are from different splits")
+	ErrShareKey      = errors.New("key shares do not restore the expected key")
+)
+
+// GF(2^8) lookup tables using the AES polynomial x^8 + x^4 + x^3 + x + 1
+var (
+	gfExp [255]byte
+	gfLog [256]byte
+)
+
+func init() {
+	x := byte(1)
+	for i := 0; i < 255; i++ {
+		gfExp[i] = x
+		gfLog[x] = byte(i)
+		// Multiply by the generator 3
+		doubled := x << 1
+		if x&0x80 != 0 {
+			doubled ^= 0x1b
+		}
+		x ^= doubled
+	}
+}
+
+func gfMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return gfExp[(int(gfLog[a])+int(gfLog[b]))%255]
+}
+
+func gfDiv(a, b byte) byte {
+	if a == 0 {
+		return 0
+	}
+	return gfExp[(int(gfLog[a])-int(gfLog[b])+255)%255]
+}
+
+// SplitSecret splits a secret into shares using Shamir's secret sharing, any threshold
+// shares reconstruct the secret. Each share is the x coordinate followed by the y bytes
+func SplitSecret(secret []byte, threshold, shares int) ([][]byte, error) {
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("secret cannot be empty")
+	}
+
+	if threshold < 2 {
+		return nil, fmt.Errorf("threshold must be at least 2, got %d", threshold)
+	}
+
+	if shares < threshold {
+		return nil, fmt.Errorf("shares (%d) cannot be less than threshold (%d)", shares, threshold)
+	}
+
+	if shares > 255 {
+		return nil, fmt.Errorf("shares cannot exceed 255, got %d", shares)
+	}
+
+	result := make([][]byte, shares)
+	for i := range result {
+		result[i] = make([]byte, len(secret)+1)
+		result[i][0] = byte(i + 1)
+	}
+
+	coefficients := make([]byte, threshold)
+	for b, secretByte := range secret {
+		// Random polynomial of degree threshold-1 with the secret byte as constant term
+		coefficients[0] = secretByte
+		if _, err := rand.Read(coefficients[1:]); err != nil {
+			return nil, fmt.Errorf("failed to generate coefficients: %w", err)
+		}
+
+		for i := range result {
+			x := result[i][0]
+			var y byte
+			for c := threshold - 1; c >= 0; c-- {
+				y = gfMul(y, x) ^ coefficients[c]
+			}
+			result[i][b+1] = y
+		}
+	}
+
+	return result, nil
+}
+
+// CombineShares reconstructs a secret from shares produced by SplitSecret
+func CombineShares(shares [][]byte) ([]byte, error) {
+	if len(shares) < 2 {
+		return nil, fmt.Errorf("at least 2 shares are required, got %d", len(shares))
+	}
+
+	length := len(shares[0])
+	if length < 2 {
+		return nil, fmt.Errorf("share is too short")
+	}
+
+	seen := make(map[byte]bool)
+	for _, share := range shares {
+		if len(share) != length {
+			return nil, fmt.Errorf("shares have different lengths")
+		}
+		if share[0] == 0 {
+			return nil, fmt.Errorf("invalid share coordinate 0")
+		}
+		if seen[share[0]] {
+			return nil, fmt.Errorf("duplicate share %d", share[0])
+		}
+		seen[share[0]] = true
+	}
+
+	secret := make([]byte, length-1)
+	for i, share := range shares {
+		// Lagrange basis polynomial evaluated at zero
+		basis := byte(1)
+		for j, other := range shares {
+			if i == j {
+				continue
+			}
+			basis = gfMul(basis, gfDiv(other[0], other[0]^share[0]))
+		}
+
+		for b := range secret {
+			secret[b] ^= gfMul(share[b+1], basis)
+		}
+	}
+
+	return secret, nil
+}
+
+// Split splits the node's private key seed into shares, any threshold of them restore the identity
+// Each share records the threshold, the split it belongs to and the fingerprint of the node key
+func (k *KeyManager) Split(threshold, shares int) ([][]byte, error) {
+	if k.privateKey == nil {
+		return nil, fmt.Errorf("private key not initialized")
+	}
+
+	secrets, err := SplitSecret(k.privateKey.Seed(), threshold, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, 2, shareHeaderSize)
+	header[0], header[1] = shareVersion, byte(threshold)
+	header = append(header, make([]byte, shareSetIDSize)...)
+	if _, err := rand.Read(header[2:]); err != nil {
+		return nil, fmt.Errorf("failed to draw share set ID: %w", err)
+	}
+	header = append(header, keyFingerprint(k.publicKey)...)
+
+	result := make([][]byte, len(secrets))
+	for i, secret := range secrets {
+		result[i] = append(bytes.Clone(header), secret...)
+	}
+	return result, nil
+}
+
+// keyFingerprint identifies a public key in key shares
+func keyFingerprint(publicKey []byte) []byte {
+	sum := sha256.Sum256(publicKey)
+	return sum[:fingerprintSize]
+}
+
+// openShares checks key shares belong to one split and reach its threshold, returning the
+// SplitSecret shares and the fingerprint of the key they restore
+func openShares(shares [][]byte) ([][]byte, []byte, error) {
+	if len(shares) == 0 {
+		return nil, nil, fmt.Errorf("%w: no shares", ErrShareFormat)
+	}
+
+	var header []byte
+	secrets := make([][]byte, len(shares))
+	for i, share := range shares {
+		if len(share) != shareSize || share[0] != shareVersion {
+			return nil, nil, fmt.Errorf("%w: unknown share format", ErrShareFormat)
+		}
+		if header == nil {
+			header = share[:shareHeaderSize]
+		} else if !bytes.Equal(header, share[:shareHeaderSize]) {
+			return nil, nil, ErrShareMismatch
+		}
+		secrets[i] = share[shareHeaderSize:]
+	}
+
+	if threshold := int(header[1]); len(shares) < threshold {
+		return nil, nil, fmt.Errorf("%d shares given, the split needs %d", len(shares), threshold)
+	}
+	return secrets, header[2+shareSetIDSize:], nil
+}
+
+// Restore reconstitutes a node identity from key shares and saves it to keys/{webaddress}.*.key
+// The restored key must match the fingerprint the shares carry and the public key saved for the
+// node, when there is one
+// It refuses to overwrite an existing private key
+func Restore(webAddress string, shares [][]byte) (*KeyManager, error) {
+	if webAddress == "" {
+		return nil, fmt.Errorf("web address cannot be empty")
+	}
+
+	secrets, fingerprint, err := openShares(shares)
+	if err != nil {
+		return nil, err
+	}
+
+	seed, err := CombineShares(secrets)
+	if err != nil {
+		return nil, fmt.Errorf("failed to combine shares: %w", err)
+	}
+
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("invalid seed size: expected %d, got %d", ed25519.SeedSize, len(seed))
+	}
+
+	sanitized := sanitizeWebAddress(webAddress)
+	privateKeyPath := filepath.Join("keys", sanitized+".private.key")
+	publicKeyPath := filepath.Join("keys", sanitized+".public.key")
+
+	release, err := lockKeys(true)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if _, err := os.Stat(privateKeyPath); err == nil {
+		return nil, fmt.Errorf("private key for %s already exists", webAddress)
+	}
+
+	privateKey := ed25519.NewKeyFromSeed(seed)
+	km := &KeyManager{
+		privateKey: privateKey,
+		publicKey:  privateKey.Public().(ed25519.PublicKey),
+		webAddress: webAddress,
+	}
+
+	if !bytes.Equal(keyFingerprint(km.publicKey), fingerprint) {
+		return nil, fmt.Errorf("%w: the shares are corrupted or mixed with shares of another key", ErrShareKey)
+	}
+	saved, err := os.ReadFile(publicKeyPath)
+	switch {
+	case err == nil && !bytes.Equal(saved, km.publicKey):
+		return nil, fmt.Errorf("%w: it differs from %s", ErrShareKey, publicKeyPath)
+	case err != nil && !errors.Is(err, os.ErrNotExist):
+		return nil, fmt.Errorf("failed to read public key: %w", err)
+	}
+
+	if err := km.saveKeys(privateKeyPath, publicKeyPath); err != nil {
+		return nil, fmt.Errorf("failed to save keys: %w", err)
+	}
+
+	return km, nil
+}
@@ -0,0 +1,193 @@
+package keys
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitCombineSecret(t *testing.T) {
+	secret := []byte("consensuscraft node identity seed")
+
+	tests := []struct {
+		name      string
+		threshold int
+		shares    int
+		use       []int
+	}{
+		{name: "2 of 3 first pair", threshold: 2, shares: 3, use: []int{0, 1}},
+		{name: "2 of 3 last pair", threshold: 2, shares: 3, use: []int{2, 1}},
+		{name: "3 of 5", threshold: 3, shares: 5, use: []int{4, 0, 2}},
+		{name: "all shares", threshold: 3, shares: 5, use: []int{0, 1, 2, 3, 4}},
+		{name: "n of n", threshold: 4, shares: 4, use: []int{3, 2, 1, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shares, err := SplitSecret(secret, tt.threshold, tt.shares)
+			require.NoError(t, err)
+			require.Len(t, shares, tt.shares)
+
+			var subset [][]byte
+			for _, i := range tt.use {
+				subset = append(subset, shares[i])
+			}
+
+			combined, err := CombineShares(subset)
+			require.NoError(t, err)
+			assert.Equal(t, secret, combined)
+		})
+	}
+
+	t.Run("below threshold does not reveal secret", func(t *testing.T) {
+		shares, err := SplitSecret(secret, 3, 5)
+		require.NoError(t, err)
+
+		combined, err := CombineShares(shares[:2])
+		require.NoError(t, err)
+		assert.NotEqual(t, secret, combined)
+	})
+}
+
+func TestSplitSecret_InvalidParameters(t *testing.T) {
+	tests := []struct {
+		name      string
+		secret    []byte
+		threshold int
+		shares    int
+		errText   string
+	}{
+		{name: "empty secret", secret: nil, threshold: 2, shares: 3, errText: "secret cannot be empty"},
+		{name: "threshold too small", secret: []byte("s"), threshold: 1, shares: 3, errText: "threshold must be at least 2"},
+		{name: "fewer shares than threshold", secret: []byte("s"), threshold: 3, shares: 2, errText: "cannot be less than threshold"},
+		{name: "too many shares", secret: []byte("s"), threshold: 2, shares: 256, errText: "cannot exceed 255"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shares, err := SplitSecret(tt.secret, tt.threshold, tt.shares)
+			assert.Error(t, err)
+			assert.Nil(t, shares)
+			assert.Contains(t, err.Error(), tt.errText)
+		})
+	}
+}
+
+func TestCombineShares_InvalidShares(t *testing.T) {
+	shares, err := SplitSecret([]byte("secret"), 2, 3)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		shares  [][]byte
+		errText string
+	}{
+		{name: "single share", shares: shares[:1], errText: "at least 2 shares"},
+		{name: "duplicate share", shares: [][]byte{shares[0], shares[0]}, errText: "duplicate share"},
+		{name: "length mismatch", shares: [][]byte{shares[0], shares[1][:3]}, errText: "different lengths"},
+		{name: "zero coordinate", shares: [][]byte{{0, 1, 2}, {1, 2, 3}}, errText: "invalid share coordinate"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secret, err := CombineShares(tt.shares)
+			assert.Error(t, err)
+			assert.Nil(t, secret)
+			assert.Contains(t, err.Error(), tt.errText)
+		})
+	}
+}
+
+func TestSplitRestore(t *testing.T) {
+	chdirTemp(t)
+
+	km, err := New("shares.com")
+	require.NoError(t, err)
+
+	shares, err := km.Split(2, 3)
+	require.NoError(t, err)
+
+	player := "testplayer"
+	inventory := []byte("test_inventory")
+	signature, err := km.Sign(player, inventory)
+	require.NoError(t, err)
+
+	t.Run("refuses to overwrite existing identity", func(t *testing.T) {
+		restored, err := Restore("shares.com", shares[:2])
+		assert.Error(t, err)
+		assert.Nil(t, restored)
+		assert.Contains(t, err.Error(), "already exists")
+	})
+
+	t.Run("restores identity after key loss", func(t *testing.T) {
+		require.NoError(t, os.Remove(filepath.Join("keys", "shares.com.private.key")))
+		require.NoError(t, os.Remove(filepath.Join("keys", "shares.com.public.key")))
+
+		restored, err := Restore("shares.com", [][]byte{shares[2], shares[0]})
+		require.NoError(t, err)
+		assert.NoError(t, restored.Verify(player, inventory, signature))
+
+		reloaded, err := New("shares.com")
+		require.NoError(t, err)
+		assert.Equal(t, km.privateKey, reloaded.privateKey)
+	})
+}
+
+func TestRestore_ChecksShares(t *testing.T) {
+	chdirTemp(t)
+
+	km, err := New("checked.com")
+	require.NoError(t, err)
+	other, err := New("other.com")
+	require.NoError(t, err)
+	require.NoError(t, os.Remove(filepath.Join("keys", "checked.com.private.key")))
+	require.NoError(t, os.Remove(filepath.Join("keys", "checked.com.public.key")))
+
+	shares, err := km.Split(3, 5)
+	require.NoError(t, err)
+	again, err := km.Split(3, 5)
+	require.NoError(t, err)
+	foreign, err := other.Split(3, 5)
+	require.NoError(t, err)
+
+	t.Run("shares carry the threshold", func(t *testing.T) {
+		_, err := Restore("checked.com", shares[:2])
+		assert.ErrorContains(t, err, "the split needs 3")
+	})
+
+	t.Run("shares of different splits are not mixed", func(t *testing.T) {
+		_, err := Restore("checked.com", [][]byte{shares[0], shares[1], again[2]})
+		assert.ErrorIs(t, err, ErrShareMismatch)
+		_, err = Restore("checked.com", [][]byte{shares[0], shares[1], foreign[2]})
+		assert.ErrorIs(t, err, ErrShareMismatch)
+	})
+
+	t.Run("corrupted shares are refused before saving", func(t *testing.T) {
+		corrupted := bytes.Clone(shares[2])
+		corrupted[len(corrupted)-1] ^= 0xff
+		_, err := Restore("checked.com", [][]byte{shares[0], shares[1], corrupted})
+		assert.ErrorIs(t, err, ErrShareKey)
+		assert.NoFileExists(t, filepath.Join("keys", "checked.com.private.key"))
+	})
+
+	t.Run("the saved public key must match", func(t *testing.T) {
+		_, err := Restore("other.com", foreign[:3])
+		assert.ErrorContains(t, err, "already exists")
+
+		otherPublic, err := other.Public()
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join("keys", "checked.com.public.key"), otherPublic, 0644))
+		_, err = Restore("checked.com", shares[:3])
+		assert.ErrorIs(t, err, ErrShareKey)
+		require.NoError(t, os.Remove(filepath.Join("keys", "checked.com.public.key")))
+	})
+
+	t.Run("unknown formats are refused", func(t *testing.T) {
+		_, err := Restore("unknown.com", [][]byte{[]byte("short"), []byte("short")})
+		assert.ErrorIs(t, err, ErrShareFormat)
+	})
+}
@@ -0,0 +1,319 @@
+package keys
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// usageFile keeps the usage statistics of keys in keys/, so baselines survive restarts
+const usageFile = ".usage.json"
+
+// baselineSamples is how many days an hour of the day must have been observed before it can be anomalous
+const baselineSamples = 3
+
+// baselineWeight is how much the latest day moves the baseline of an hour once it is established
+const baselineWeight = 0.2
+
+// maxKeys bounds the keys tracked, handshakes verify keys of any server contacting the node
+// so keys of unknown servers are dropped past it
+const maxKeys = 1024
+
+// maxAnomalies bounds the anomalies kept for operators
+const maxAnomalies = 100
+
+// KeyUsage aggregates the signatures made and verified with one key
+type KeyUsage struct {
+	Key           string        `json:"key"`              // Hex prefix of the public key
+	Server        string        `json:"server,omitempty"` // Owner of the key when it was loaded or pinned
+	Signatures    uint64        `json:"signatures"`
+	Verifications uint64        `json:"verifications"`
+	Failures      uint64        `json:"failures"`    // Signatures that failed verification
+	SignTime      time.Duration `json:"sign_time"`   // Average time to sign
+	VerifyTime    time.Duration `json:"verify_time"` // Average time to verify
+	LastUsed      time.Time     `json:"last_used"`
+	CurrentHour   int           `json:"current_hour"` // Uses since the start of the hour
+	Hourly        [24]float64   `json:"hourly"`       // Usual uses per hour by hour of the day, the anomaly baseline
+}
+
+// KeyAnomaly is an hour in which a key was used far more than usual, as a stolen key mass-signing
+// injected items would be
+type KeyAnomaly struct {
+	Key      string    `json:"key"`
+	Server   string    `json:"server,omitempty"`
+	Hour     time.Time `json:"hour"`
+	Count    int       `json:"count"`
+	Baseline float64   `json:"baseline"`
+}
+
+// keyStats is the usage of a key with the state its baseline is computed from
+type keyStats struct {
+	KeyUsage
+	Samples     [24]int       `json:"samples"` // Days observed per hour of the day
+	Hour        time.Time     `json:"hour"`    // Start of the current hour
+	signTotal   time.Duration // Not persisted, averages restart with the process
+	verifyTotal time.Duration
+	signed      uint64
+	verified    uint64
+	reported    bool
+}
+
+// usageTracker counts key uses and flags the anomalous hours
+type usageTracker struct {
+	mu        sync.Mutex
+	keys      map[string]*keyStats
+	names     map[string]string
+	anomalies []KeyAnomaly
+	factor    float64
+	minimum   int
+	onAnomaly func(KeyAnomaly)
+}
+
+func newUsageTracker() *usageTracker {
+	return &usageTracker{
+		keys:    make(map[string]*keyStats),
+		names:   make(map[string]string),
+		factor:  10,
+		minimum: 100,
+	}
+}
+
+// usage tracks every key used by this process
+var usage = newUsageTracker()
+
+// keyID names a public key in statistics
+func keyID(publicKey []byte) string {
+	if len(publicKey) > 8 {
+		publicKey = publicKey[:8]
+	}
+	return hex.EncodeToString(publicKey)
+}
+
+// SetAnomalyThresholds flags a key used more than factor times its usual count for the hour of
+// the day and at least minimum times within the hour, a zero factor disables detection
+func SetAnomalyThresholds(factor float64, minimum int) {
+	usage.mu.Lock()
+	defer usage.mu.Unlock()
+	usage.factor, usage.minimum = factor, minimum
+}
+
+// AnomalyThresholds returns the factor and minimum set with SetAnomalyThresholds
+func AnomalyThresholds() (float64, int) {
+	usage.mu.Lock()
+	defer usage.mu.Unlock()
+	return usage.factor, usage.minimum
+}
+
+// OnAnomaly replaces the handler of key anomalies, which logs them by default
+// The handler runs with the statistics locked and must not use them
+func OnAnomaly(handle func(KeyAnomaly)) {
+	usage.mu.Lock()
+	defer usage.mu.Unlock()
+	usage.onAnomaly = handle
+}
+
+// name remembers the server owning a public key
+func (t *usageTracker) name(publicKey []byte, server string) {
+	if len(publicKey) != ed25519.PublicKeySize || server == "" {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	id := keyID(publicKey)
+	t.names[id] = server
+	if stats, ok := t.keys[id]; ok {
+		stats.Server = server
+	}
+}
+
+// record counts a signature made, or verified when verify is set, with the key at a time
+func (t *usageTracker) record(publicKey []byte, verify, ok bool, took time.Duration, at time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	id := keyID(publicKey)
+	stats, found := t.keys[id]
+	if !found {
+		if len(t.keys) >= maxKeys && t.names[id] == "" {
+			return
+		}
+		stats = &keyStats{KeyUsage: KeyUsage{Key: id, Server: t.names[id]}}
+		t.keys[id] = stats
+	}
+
+	stats.roll(at)
+	stats.CurrentHour++
+	stats.LastUsed = at
+	if verify {
+		stats.Verifications++
+		stats.verified++
+		stats.verifyTotal += took
+		stats.VerifyTime = stats.verifyTotal / time.Duration(stats.verified)
+		if !ok {
+			stats.Failures++
+		}
+	} else {
+		stats.Signatures++
+		stats.signed++
+		stats.signTotal += took
+		stats.SignTime = stats.signTotal / time.Duration(stats.signed)
+	}
+
+	if anomaly, flagged := t.check(stats); flagged {
+		t.anomalies = append([]KeyAnomaly{anomaly}, t.anomalies...)
+		if len(t.anomalies) > maxAnomalies {
+			t.anomalies = t.anomalies[:maxAnomalies]
+		}
+		if t.onAnomaly != nil {
+			t.onAnomaly(anomaly)
+		} else {
+			logger.Errorf("Key %s of %s signed or verified %d times this hour, %.0f usually: it may be stolen",
+				anomaly.Key, anomaly.Server, anomaly.Count, anomaly.Baseline)
+		}
+	}
+}
+
+// roll starts the hour of at, folding the finished hours into the baseline of their hour of the day
+func (s *keyStats) roll(at time.Time) {
+	hour := at.Truncate(time.Hour)
+	if s.Hour.IsZero() {
+		s.Hour = hour
+		return
+	}
+	if !hour.After(s.Hour) {
+		return
+	}
+
+	// Hours without any use count as zero, a week of them covers every hour of the day
+	for h, folded := s.Hour, 0; h.Before(hour) && folded < 7*24; h, folded = h.Add(time.Hour), folded+1 {
+		count := 0
+		if h.Equal(s.Hour) {
+			count = s.CurrentHour
+		}
+		s.fold(h.Hour(), float64(count))
+	}
+
+	s.Hour = hour
+	s.CurrentHour = 0
+	s.reported = false
+}
+
+// fold adds a day of an hour to its baseline, averaging the first days and weighting recent ones after
+func (s *keyStats) fold(hour int, count float64) {
+	s.Samples[hour]++
+	weight := 1 / float64(s.Samples[hour])
+	if s.Samples[hour] > baselineSamples {
+		weight = baselineWeight
+	}
+	s.Hourly[hour] += (count - s.Hourly[hour]) * weight
+}
+
+// check reports the current hour of a key once it goes past the thresholds
+func (t *usageTracker) check(stats *keyStats) (KeyAnomaly, bool) {
+	if t.factor <= 0 || stats.reported || stats.CurrentHour < t.minimum {
+		return KeyAnomaly{}, false
+	}
+
+	hour := stats.Hour.Hour()
+	baseline := stats.Hourly[hour]
+	if stats.Samples[hour] < baselineSamples || float64(stats.CurrentHour) <= t.factor*max(baseline, 1) {
+		return KeyAnomaly{}, false
+	}
+
+	stats.reported = true
+	return KeyAnomaly{Key: stats.Key, Server: stats.Server, Hour: stats.Hour, Count: stats.CurrentHour, Baseline: baseline}, true
+}
+
+// Usage returns the statistics of every key used by this process, most used first
+func Usage() []KeyUsage {
+	usage.mu.Lock()
+	defer usage.mu.Unlock()
+
+	list := make([]KeyUsage, 0, len(usage.keys))
+	for _, stats := range usage.keys {
+		list = append(list, stats.KeyUsage)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		a, b := list[i].Signatures+list[i].Verifications, list[j].Signatures+list[j].Verifications
+		if a != b {
+			return a > b
+		}
+		return list[i].Key < list[j].Key
+	})
+	return list
+}
+
+// Anomalies returns the anomalies flagged since the process started, newest first
+func Anomalies() []KeyAnomaly {
+	usage.mu.Lock()
+	defer usage.mu.Unlock()
+	return append([]KeyAnomaly{}, usage.anomalies...)
+}
+
+// LoadUsage restores the statistics saved by SaveUsage, nothing is loaded when none were saved
+func LoadUsage() error {
+	release, err := lockKeys(false)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	data, err := os.ReadFile(filepath.Join(keysDir, usageFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read key usage: %w", err)
+	}
+
+	var saved []*keyStats
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return fmt.Errorf("failed to decode key usage: %w", err)
+	}
+
+	usage.mu.Lock()
+	defer usage.mu.Unlock()
+	for _, stats := range saved {
+		if name, ok := usage.names[stats.Key]; ok {
+			stats.Server = name
+		}
+		usage.keys[stats.Key] = stats
+	}
+	return nil
+}
+
+// SaveUsage writes the statistics of every key to keys/, for the baselines to survive restarts
+func SaveUsage() error {
+	usage.mu.Lock()
+	saved := make([]keyStats, 0, len(usage.keys))
+	for _, stats := range usage.keys {
+		saved = append(saved, *stats)
+	}
+	usage.mu.Unlock()
+
+	data, err := json.Marshal(saved)
+	if err != nil {
+		return err
+	}
+
+	release, err := lockKeys(true)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	if err := writeFileAtomic(filepath.Join(keysDir, usageFile), data, 0600); err != nil {
+		return fmt.Errorf("failed to save key usage: %w", err)
+	}
+	return nil
+}
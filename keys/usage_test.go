@@ -0,0 +1,105 @@
+package keys
+
+import (
+	"crypto/ed25519"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsageTracker_Anomaly(t *testing.T) {
+	tracker := newUsageTracker()
+	var flagged []KeyAnomaly
+	tracker.onAnomaly = func(anomaly KeyAnomaly) { flagged = append(flagged, anomaly) }
+
+	key := make([]byte, ed25519.PublicKeySize)
+	key[0] = 0xab
+	tracker.name(key, "quiet.example.com")
+
+	// A quiet server signs a few updates at 4am every night
+	start := time.Date(2026, time.March, 1, 4, 0, 0, 0, time.Local)
+	for day := 0; day < 3; day++ {
+		for i := 0; i < 5; i++ {
+			tracker.record(key, false, true, time.Millisecond, start.AddDate(0, 0, day).Add(time.Duration(i)*time.Minute))
+		}
+	}
+
+	t.Run("baseline", func(t *testing.T) {
+		stats := tracker.keys[keyID(key)]
+		assert.InDelta(t, 5, stats.Hourly[4], 0.01)
+		assert.Equal(t, 2, stats.Samples[4], "the current day is not folded yet")
+		assert.Equal(t, 5, stats.CurrentHour)
+		assert.Equal(t, "quiet.example.com", stats.Server)
+		assert.Equal(t, time.Millisecond, stats.SignTime)
+	})
+
+	// Then mass-signs at 4am once the baseline is established
+	night := start.AddDate(0, 0, 3)
+	for i := 0; i < 200; i++ {
+		tracker.record(key, false, true, time.Millisecond, night.Add(time.Duration(i)*time.Second))
+	}
+
+	require.Len(t, flagged, 1, "an hour is flagged once")
+	assert.Equal(t, "quiet.example.com", flagged[0].Server)
+	assert.Equal(t, night, flagged[0].Hour)
+	assert.Equal(t, 100, flagged[0].Count)
+	assert.Less(t, flagged[0].Baseline, 10.0)
+	assert.Len(t, tracker.anomalies, 1)
+
+	t.Run("disabled", func(t *testing.T) {
+		tracker.factor = 0
+		for i := 0; i < 200; i++ {
+			tracker.record(key, false, true, time.Millisecond, night.AddDate(0, 0, 1).Add(time.Duration(i)*time.Second))
+		}
+		assert.Len(t, flagged, 1)
+	})
+}
+
+func TestUsageTracker_Verifications(t *testing.T) {
+	tracker := newUsageTracker()
+	key := make([]byte, ed25519.PublicKeySize)
+	now := time.Now()
+
+	tracker.record(key, true, true, 2*time.Millisecond, now)
+	tracker.record(key, true, false, 4*time.Millisecond, now)
+
+	stats := tracker.keys[keyID(key)]
+	assert.Equal(t, uint64(2), stats.Verifications)
+	assert.Equal(t, uint64(1), stats.Failures)
+	assert.Equal(t, 3*time.Millisecond, stats.VerifyTime)
+	assert.Zero(t, stats.Signatures)
+}
+
+func TestUsage(t *testing.T) {
+	chdirTemp(t)
+	previous := usage
+	usage = newUsageTracker()
+	t.Cleanup(func() { usage = previous })
+
+	km, err := New("a.example.com")
+	require.NoError(t, err)
+	signature, err := km.Sign("alice", []byte(`[]`))
+	require.NoError(t, err)
+	require.NoError(t, VerifyPublic(km.publicKey, "alice", []byte(`[]`), signature))
+
+	list := Usage()
+	require.Len(t, list, 1)
+	assert.Equal(t, "a.example.com", list[0].Server)
+	assert.Equal(t, uint64(1), list[0].Signatures)
+	assert.Equal(t, uint64(1), list[0].Verifications)
+	assert.Empty(t, Anomalies())
+
+	// Baselines survive a restart
+	require.NoError(t, SaveUsage())
+	usage = newUsageTracker()
+	require.NoError(t, LoadUsage())
+	list = Usage()
+	require.Len(t, list, 1)
+	assert.Equal(t, uint64(1), list[0].Signatures)
+
+	bundle, err := CollectIdentity("a.example.com")
+	require.NoError(t, err)
+	assert.NotContains(t, bundle.Files, "keys/"+usageFile, "usage is not part of the identity")
+}
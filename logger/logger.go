@@ -3,9 +3,23 @@ package logger
 import (
 	"fmt"
 	"log"
+	"slices"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
+// hook is a function added with AddHook, removed by identity
+type hook struct {
+	h func(level, message string)
+}
+
+// hooks receive every logged message, hooksMu serializes the copy on write updates of the list
+var (
+	hooksMu sync.Mutex
+	hooks   atomic.Pointer[[]*hook]
+)
+
 func init() {
 	// Disable the standard log package's timestamp and prefix
 	log.SetFlags(0)
@@ -19,52 +33,92 @@ func formatMessage(level, message string) string {
 	return fmt.Sprintf("[%s:%03d %s] [CONSENSUSCRAFT] %s", timestamp, milliseconds, level, message)
 }
 
+// AddHook calls h with the level and message of everything logged after the message is printed,
+// until the returned function removes it, h runs on the logging goroutine and must not log itself
+// Hooks are removed one by one, so each node of a process can hook the log of its own
+func AddHook(h func(level, message string)) (remove func()) {
+	added := &hook{h: h}
+	updateHooks(func(list []*hook) []*hook { return append(list, added) })
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			updateHooks(func(list []*hook) []*hook {
+				return slices.DeleteFunc(list, func(existing *hook) bool { return existing == added })
+			})
+		})
+	}
+}
+
+// updateHooks replaces the hook list with update applied to a copy of it
+func updateHooks(update func([]*hook) []*hook) {
+	hooksMu.Lock()
+	defer hooksMu.Unlock()
+
+	var list []*hook
+	if current := hooks.Load(); current != nil {
+		list = slices.Clone(*current)
+	}
+	list = update(list)
+	hooks.Store(&list)
+}
+
+// output prints a message and hands it to the hooks
+func output(level, message string) {
+	log.Print(formatMessage(level, message))
+	if list := hooks.Load(); list != nil {
+		for _, added := range *list {
+			added.h(level, message)
+		}
+	}
+}
+
 // Info logs an info level message
 func Info(v ...interface{}) {
 	message := fmt.Sprint(v...)
-	log.Print(formatMessage("INFO", message))
+	output("INFO", message)
 }
 
 // Infof logs a formatted info level message
 func Infof(format string, v ...interface{}) {
 	message := fmt.Sprintf(format, v...)
-	log.Print(formatMessage("INFO", message))
+	output("INFO", message)
 }
 
 // Error logs an error level message
 func Error(v ...interface{}) {
 	message := fmt.Sprint(v...)
-	log.Print(formatMessage("ERROR", message))
+	output("ERROR", message)
 }
 
 // Errorf logs a formatted error level message
 func Errorf(format string, v ...interface{}) {
 	message := fmt.Sprintf(format, v...)
-	log.Print(formatMessage("ERROR", message))
+	output("ERROR", message)
 }
 
 // Warn logs a warning level message
 func Warn(v ...interface{}) {
 	message := fmt.Sprint(v...)
-	log.Print(formatMessage("WARN", message))
+	output("WARN", message)
 }
 
 // Warnf logs a formatted warning level message
 func Warnf(format string, v ...interface{}) {
 	message := fmt.Sprintf(format, v...)
-	log.Print(formatMessage("WARN", message))
+	output("WARN", message)
 }
 
 // Debug logs a debug level message
 func Debug(v ...interface{}) {
 	message := fmt.Sprint(v...)
-	log.Print(formatMessage("DEBUG", message))
+	output("DEBUG", message)
 }
 
 // Debugf logs a formatted debug level message
 func Debugf(format string, v ...interface{}) {
 	message := fmt.Sprintf(format, v...)
-	log.Print(formatMessage("DEBUG", message))
+	output("DEBUG", message)
 }
 
 // Legacy functions for backward compatibility - default to INFO level
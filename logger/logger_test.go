@@ -298,3 +298,35 @@ func TestEmptyMessage(t *testing.T) {
 		t.Error("Empty message should still contain CONSENSUSCRAFT identifier")
 	}
 }
+
+// TestAddHook tests that hooks receive logged messages until each is removed
+func TestAddHook(t *testing.T) {
+	var first, second []string
+	removeFirst := AddHook(func(level, message string) {
+		first = append(first, level+" "+message)
+	})
+	removeSecond := AddHook(func(level, message string) {
+		second = append(second, level+" "+message)
+	})
+
+	captureOutput(func() {
+		Warnf("disk %d%% full", 90)
+		Info("started")
+	})
+	removeFirst()
+	removeFirst()
+	captureOutput(func() {
+		Info("second only")
+	})
+	removeSecond()
+	captureOutput(func() {
+		Info("not hooked")
+	})
+
+	if strings.Join(first, "|") != "WARN disk 90% full|INFO started" {
+		t.Errorf("First hook received %q", first)
+	}
+	if strings.Join(second, "|") != "WARN disk 90% full|INFO started|INFO second only" {
+		t.Errorf("Second hook received %q", second)
+	}
+}
@@ -162,7 +162,8 @@ function processInventoryWithShulkers(inventoryData) {
             nameTag: item.nameTag || undefined,
             lore: item.lore || [],
             enchantments: item.enchantments || [],
-            durability: item.durability || null
+            durability: item.durability || null,
+            fingerprint: item.fingerprint || undefined
         };
 
         // Handle shulker boxes - create virtual storage
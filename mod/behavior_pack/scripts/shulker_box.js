@@ -50,13 +50,46 @@ function getServerName() {
     }
 }
 
+const DEFAULT_ORIGIN_FORMAT = "Origin: <server>";
+
+// Get origin lore format from scoreboard, set by the node from ORIGIN_FORMAT
+function getOriginFormat() {
+    try {
+        const obj = world.scoreboard.getObjective("originFormat");
+        if (obj) {
+            for (const part of obj.getParticipants()) {
+                const format = part.displayName;
+                if (format && format.split("<server>").length === 2) {
+                    return format;
+                }
+            }
+        }
+    } catch (error) {
+        // Fall through to the default format
+    }
+    return DEFAULT_ORIGIN_FORMAT;
+}
+
+// Render the origin lore line for a server
+function formatOrigin(serverName) {
+    return getOriginFormat().replace("<server>", serverName);
+}
+
+// Parse the server name back from an origin lore line, mirrors database.OriginFormat
+function parseOrigin(line) {
+    const [prefix, suffix] = getOriginFormat().split("<server>");
+    const literal = (text) => text.split(/\s+/).map((part) => part.replace(/[.*+?^${}()|[\]\\]/g, "\\$&")).join("\\s+");
+    const match = new RegExp(`^${literal(prefix)}(\\S(?:.*\\S)?)${literal(suffix)}$`).exec(line);
+    return match ? match[1] : null;
+}
+
 // Check if item has origin lore
 function hasOriginLore(item) {
     if (!item) return false;
     try {
         const lore = item.getLore();
         if (!lore) return false;
-        return lore.some(line => line.startsWith("Origin: "));
+        return lore.some(line => parseOrigin(line) !== null);
     } catch (error) {
         return false;
     }
@@ -68,7 +101,7 @@ function addOriginLore(item, serverName) {
     
     try {
         const lore = item.getLore() || [];
-        const originLine = `Origin: ${serverName}`;
+        const originLine = formatOrigin(serverName);
         lore.push(originLine);
         item.setLore(lore);
         return true;
@@ -105,6 +138,28 @@ function getShulkerIdFromItem(item) {
     return null;
 }
 
+// Item fingerprint computed by the wrapper, only non-stackable items can carry it
+const FINGERPRINT_PROPERTY = "consensuscraft:fingerprint";
+
+function getFingerprint(item) {
+    try {
+        if (item && item.maxAmount === 1) {
+            return item.getDynamicProperty(FINGERPRINT_PROPERTY);
+        }
+    } catch (e) { }
+    return undefined;
+}
+
+function setFingerprint(item, fingerprint) {
+    try {
+        if (item && item.maxAmount === 1 && fingerprint) {
+            item.setDynamicProperty(FINGERPRINT_PROPERTY, fingerprint);
+            return true;
+        }
+    } catch (e) { }
+    return false;
+}
+
 // Set shulker ID on item (stored in lore)
 function setShulkerIdOnItem(item, shulkerId) {
     try {
@@ -158,6 +213,9 @@ function serializeItem(item) {
         }
     } catch (e) { }
 
+    const fingerprint = getFingerprint(item);
+    if (fingerprint) serialized.fingerprint = fingerprint;
+
     // For shulker boxes, include their stored contents
     if (isShulkerBox(item.typeId)) {
         try {
@@ -208,6 +266,8 @@ function deserializeItem(itemData) {
             }
         }
 
+        if (itemData.fingerprint) setFingerprint(item, itemData.fingerprint);
+
         return item;
     } catch (error) {
         return null;
@@ -412,4 +472,4 @@ world.beforeEvents.playerBreakBlock.subscribe((event) => {
 // Old afterBreakBlock approach removed - now using the watcher system
 
 // Export for use in other modules
-export { serializeItem, deserializeItem, isShulkerBox, restoreShulkerContents, getShulkerIdFromItem };
+export { serializeItem, deserializeItem, isShulkerBox, restoreShulkerContents, getShulkerIdFromItem, formatOrigin, parseOrigin, getFingerprint, setFingerprint };
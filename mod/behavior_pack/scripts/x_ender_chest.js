@@ -1,11 +1,15 @@
 import { system, world, ItemStack, EnchantmentTypes } from "@minecraft/server";
-import { serializeItem, deserializeItem, isShulkerBox, getShulkerIdFromItem } from "./shulker_box.js";
+import { serializeItem, deserializeItem, isShulkerBox, getShulkerIdFromItem, formatOrigin, parseOrigin, getFingerprint, setFingerprint } from "./shulker_box.js";
 
 const chests = ["x_ender_chest"];
 
 // Per-player ender chest storage
 const enderChestStorage = new Map();
 
+// Run id and sequence of logged ender chest updates, the wrapper skips updates it applied before
+const logRun = `${Date.now().toString(36)}${Math.floor(Math.random() * 1e6).toString(36)}`;
+let logSequence = 0;
+
 // Get server name from scoreboard or fallback to dynamic property/default
 function getServerName() {
     try {
@@ -45,7 +49,7 @@ function hasOriginLore(item) {
     try {
         const lore = item.getLore();
         if (!lore) return false;
-        return lore.some(line => line.startsWith("Origin: "));
+        return lore.some(line => parseOrigin(line) !== null);
     } catch (error) {
         return false;
     }
@@ -57,7 +61,7 @@ function addOriginLore(item, serverName) {
     
     try {
         const lore = item.getLore() || [];
-        const originLine = `Origin: ${serverName}`;
+        const originLine = formatOrigin(serverName);
         lore.push(originLine);
         item.setLore(lore);
         return true;
@@ -107,6 +111,7 @@ function savePlayerData(playerId, items) {
                         maxDurability: durability.maxDurability,
                     } : null;
                 })(),
+                fingerprint: getFingerprint(item),
             };
         });
         world.setDynamicProperty(
@@ -178,6 +183,7 @@ system.beforeEvents.startup.subscribe((initEvent) => {
 // Handle script events for chest opening/closing
 system.afterEvents.scriptEventReceive.subscribe((event) => {
     const { id, message, sourceEntity } = event;
+    if (!id.startsWith("x_ender_chest:") || !sourceEntity) return;
 
     const block = sourceEntity.dimension.getBlock({
         x: Math.floor(sourceEntity.location.x),
@@ -236,6 +242,38 @@ system.afterEvents.scriptEventReceive.subscribe((event) => {
     }
 });
 
+// Tag unique items in a player's ender chest with the fingerprints sent by the wrapper
+system.afterEvents.scriptEventReceive.subscribe((event) => {
+    if (event.id !== "consensuscraft:fingerprints") return;
+
+    try {
+        const { player: playerName, slots } = JSON.parse(event.message);
+        const player = world.getPlayers().find((p) => p.name === playerName);
+        if (!player || !slots) return;
+
+        const playerItems = getPlayerEnderStorage(player.id);
+        let tagged = false;
+        for (const [slot, fingerprint] of Object.entries(slots)) {
+            const item = playerItems[Number(slot)];
+            if (item && getFingerprint(item) !== fingerprint && setFingerprint(item, fingerprint)) {
+                tagged = true;
+            }
+        }
+
+        if (tagged) savePlayerData(player.id, playerItems);
+    } catch (error) {
+        console.log(`Error applying item fingerprints: ${error.message}`);
+    }
+});
+
+// Report the server name and format item origins are labelled with, the wrapper compares them
+// with its web address and origin format
+system.afterEvents.scriptEventReceive.subscribe((event) => {
+    if (event.id !== "consensuscraft:identity") return;
+
+    console.log(`[X_SERVER_NAME][${getServerName()}][${formatOrigin("<server>")}]`);
+});
+
 // Get or create per-player ender chest storage
 function getPlayerEnderStorage(playerId) {
     if (!enderChestStorage.has(playerId)) {
@@ -300,7 +338,15 @@ function logEnderChestContents(playerId, playerItems) {
             serializedContents[i] = serializeItem(item);
         }
 
-        console.log(`[X_ENDER_CHEST][${playerName}]${JSON.stringify(serializedContents)}`);
+        // Position prefix lets the wrapper keep audit context with each update
+        let position = "";
+        if (player) {
+            const { x, y, z } = player.location;
+            position = `[@${x.toFixed(2)},${y.toFixed(2)},${z.toFixed(2)},${player.dimension.id}]`;
+        }
+
+        logSequence++;
+        console.log(`[X_ENDER_CHEST][${playerName}][#${logRun}:${logSequence}]${position}[${JSON.stringify(serializedContents)}]`);
     } catch (e) { }
 }
 
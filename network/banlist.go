@@ -0,0 +1,253 @@
+package network
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/keys"
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+var (
+	ErrInvalidBanList   = errors.New("invalid ban list")
+	ErrUntrustedBanList = errors.New("ban list issuer is not trusted")
+	ErrBanListsDisabled = errors.New("ban list exchange is disabled")
+)
+
+// BanEntry is a banned server with why and since when it is banned
+type BanEntry struct {
+	Server   string    `json:"server"`
+	Reason   string    `json:"reason,omitempty"`
+	BannedAt time.Time `json:"banned_at"`
+	Source   string    `json:"source,omitempty"` // Node whose ban list the ban came from, empty for bans made here
+}
+
+// BanList is a ban list exported by a node for allied networks, signed with the node key
+type BanList struct {
+	Issuer     string     `json:"issuer"`
+	ExportedAt time.Time  `json:"exported_at"`
+	Bans       []BanEntry `json:"bans"`
+	Signature  []byte     `json:"signature,omitempty"`
+}
+
+// BanImport is the outcome of importing a ban list
+type BanImport struct {
+	Issuer  string   `json:"issuer"`
+	Adopted []string `json:"adopted,omitempty"` // Banned here because of the list
+	Known   []string `json:"known,omitempty"`   // Already banned here
+	Failed  []string `json:"failed,omitempty"`  // Their data could not be removed, left unbanned
+}
+
+// banListMessage is the signed content of a ban list, everything but the signature
+func banListMessage(list *BanList) ([]byte, error) {
+	unsigned := *list
+	unsigned.Signature = nil
+	return json.Marshal(unsigned)
+}
+
+// ParseBanListKeys decodes issuer=hex ed25519 public key pairs of allied networks
+func ParseBanListKeys(pairs map[string]string) (map[string][]byte, error) {
+	trusted := make(map[string][]byte, len(pairs))
+	for issuer, encoded := range pairs {
+		key, err := hex.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ban list key of %s: %w", issuer, err)
+		}
+		trusted[issuer] = key
+	}
+	return trusted, nil
+}
+
+// EnableExchange lets the node export its bans signed by km and import ban lists of issuers in
+// trusted or with a pinned peer key, imported bans are kept in path and applied again right away
+func (b *Bans) EnableExchange(km *keys.KeyManager, webAddress string, trusted map[string][]byte, path string) error {
+	var imported []BanEntry
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return fmt.Errorf("failed to read imported bans: %w", err)
+	default:
+		if err := json.Unmarshal(data, &imported); err != nil {
+			return fmt.Errorf("invalid imported bans %s: %w", path, err)
+		}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.km = km
+	b.webAddress = webAddress
+	b.trusted = trusted
+	b.importPath = path
+	b.imported = imported
+	for _, entry := range imported {
+		if _, err := b.ban(entry); err != nil {
+			logger.Warnf("Failed to apply imported ban of %s: %v", entry.Server, err)
+		}
+	}
+	return nil
+}
+
+// Entries returns the servers banned locally with their reasons, sorted by server
+func (b *Bans) Entries() []BanEntry {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	entries := make([]BanEntry, 0, len(b.local))
+	for _, server := range sortedSet(b.local) {
+		entries = append(entries, b.entry(server))
+	}
+	return entries
+}
+
+// Export returns the locally banned servers as a ban list signed by this node
+func (b *Bans) Export() (*BanList, error) {
+	b.mu.Lock()
+	km, issuer := b.km, b.webAddress
+	b.mu.Unlock()
+	if km == nil {
+		return nil, ErrBanListsDisabled
+	}
+
+	list := &BanList{Issuer: issuer, ExportedAt: time.Now().UTC(), Bans: b.Entries()}
+	message, err := banListMessage(list)
+	if err != nil {
+		return nil, err
+	}
+	if list.Signature, err = km.Sign(issuer, message); err != nil {
+		return nil, fmt.Errorf("failed to sign ban list: %w", err)
+	}
+	return list, nil
+}
+
+// Import verifies the signature of a ban list and bans every server it lists, whatever the ban
+// policy, since importing is an operator decision
+func (b *Bans) Import(list *BanList) (BanImport, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.km == nil {
+		return BanImport{}, ErrBanListsDisabled
+	}
+	if err := b.verify(list); err != nil {
+		return BanImport{}, err
+	}
+
+	report := BanImport{Issuer: list.Issuer}
+	for _, entry := range list.Bans {
+		if entry.Server == "" || entry.Server == b.webAddress {
+			continue
+		}
+		if entry.Source == "" {
+			entry.Source = list.Issuer
+		}
+		if entry.BannedAt.IsZero() {
+			entry.BannedAt = list.ExportedAt
+		}
+
+		adopted, err := b.ban(entry)
+		switch {
+		case err != nil:
+			logger.Warnf("Failed to apply ban of %s imported from %s: %v", entry.Server, list.Issuer, err)
+			report.Failed = append(report.Failed, entry.Server)
+		case adopted:
+			b.imported = append(b.imported, entry)
+			report.Adopted = append(report.Adopted, entry.Server)
+		default:
+			report.Known = append(report.Known, entry.Server)
+		}
+	}
+	sort.Strings(report.Adopted)
+	sort.Strings(report.Known)
+	sort.Strings(report.Failed)
+
+	if len(report.Adopted) > 0 {
+		logger.Infof("Audit: imported bans of %v from %s", report.Adopted, list.Issuer)
+		if err := b.saveImported(); err != nil {
+			return report, err
+		}
+	}
+	return report, nil
+}
+
+// Ban bans a server here whatever the ban policy, for bans decided outside of the peer
+// reconciliation such as those of the network configuration, it reports whether it was newly banned
+func (b *Bans) Ban(entry BanEntry) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if entry.Server == "" || entry.Server == b.webAddress {
+		return false, nil
+	}
+	if entry.BannedAt.IsZero() {
+		entry.BannedAt = time.Now().UTC()
+	}
+	return b.ban(entry)
+}
+
+// verify checks a ban list was signed by its issuer, with a trusted key or the pinned peer key, b.mu must be held
+func (b *Bans) verify(list *BanList) error {
+	if list == nil || strings.TrimSpace(list.Issuer) == "" || len(list.Signature) == 0 {
+		return fmt.Errorf("%w: issuer and signature are required", ErrInvalidBanList)
+	}
+
+	publicKey, ok := b.trusted[list.Issuer]
+	if !ok {
+		pinned, err := keys.LoadPublic(list.Issuer)
+		if err != nil {
+			return fmt.Errorf("%w: no key of %s", ErrUntrustedBanList, list.Issuer)
+		}
+		publicKey = pinned
+	}
+
+	message, err := banListMessage(list)
+	if err != nil {
+		return err
+	}
+	if err := keys.VerifyPublic(publicKey, list.Issuer, message, list.Signature); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidBanList, err)
+	}
+	return nil
+}
+
+// ban applies a ban unless the server is already banned, b.mu must be held
+func (b *Bans) ban(entry BanEntry) (bool, error) {
+	if b.local[entry.Server] {
+		return false, nil
+	}
+	if err := b.apply(entry.Server); err != nil {
+		return false, err
+	}
+
+	b.local[entry.Server] = true
+	b.details[entry.Server] = entry
+	return true, nil
+}
+
+// entry returns the details of a local ban, b.mu must be held
+func (b *Bans) entry(server string) BanEntry {
+	if entry, ok := b.details[server]; ok {
+		return entry
+	}
+	return BanEntry{Server: server, Reason: "configured in BANNED_NODES", BannedAt: b.since}
+}
+
+// saveImported writes the imported bans so they are applied again after a restart, b.mu must be held
+func (b *Bans) saveImported() error {
+	data, err := json.MarshalIndent(b.imported, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := b.importPath + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("failed to save imported bans: %w", err)
+	}
+	return os.Rename(tmp, b.importPath)
+}
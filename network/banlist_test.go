@@ -0,0 +1,140 @@
+package network
+
+import (
+	"encoding/hex"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/d1nch8g/consensuscraft/keys"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBans_ExportImport(t *testing.T) {
+	chdirTemp(t)
+
+	allyKeys, err := keys.New("ally.example.com")
+	require.NoError(t, err)
+	allyPublic, err := allyKeys.Public()
+	require.NoError(t, err)
+
+	ally := NewBans(BanManual, []string{"griefers.example.com"}, func(string) error { return nil })
+	require.NoError(t, ally.EnableExchange(allyKeys, "ally.example.com", nil, filepath.Join(t.TempDir(), "bans.json")))
+	ally.Reconcile("peer.example.com", []string{"dupers.example.com"})
+
+	list, err := ally.Export()
+	require.NoError(t, err)
+	assert.Equal(t, "ally.example.com", list.Issuer)
+	require.Len(t, list.Bans, 1)
+	assert.Equal(t, "configured in BANNED_NODES", list.Bans[0].Reason)
+
+	ownKeys, err := keys.New("a.example.com")
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "imported_bans.json")
+	var applied []string
+	apply := func(server string) error {
+		applied = append(applied, server)
+		return nil
+	}
+
+	trusted, err := ParseBanListKeys(map[string]string{"ally.example.com": hex.EncodeToString(allyPublic)})
+	require.NoError(t, err)
+
+	bans := NewBans(BanManual, []string{"known.example.com"}, apply)
+	require.NoError(t, bans.EnableExchange(ownKeys, "a.example.com", trusted, path))
+
+	list.Bans = append(list.Bans, BanEntry{Server: "known.example.com"})
+	list.Signature, err = allyKeys.Sign(list.Issuer, mustBanListMessage(t, list))
+	require.NoError(t, err)
+
+	report, err := bans.Import(list)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"griefers.example.com"}, report.Adopted)
+	assert.Equal(t, []string{"known.example.com"}, report.Known)
+	assert.Equal(t, []string{"griefers.example.com"}, applied)
+
+	entries := bans.Entries()
+	require.Len(t, entries, 2)
+	assert.Equal(t, "ally.example.com", entries[0].Source)
+
+	t.Run("imported bans survive a restart", func(t *testing.T) {
+		applied = nil
+		restarted := NewBans(BanManual, nil, apply)
+		require.NoError(t, restarted.EnableExchange(ownKeys, "a.example.com", trusted, path))
+		assert.Equal(t, []string{"griefers.example.com"}, restarted.List())
+		assert.Equal(t, []string{"griefers.example.com"}, applied)
+	})
+
+	t.Run("tampered list", func(t *testing.T) {
+		tampered := *list
+		tampered.Bans = []BanEntry{{Server: "rival.example.com"}}
+		_, err := bans.Import(&tampered)
+		assert.ErrorIs(t, err, ErrInvalidBanList)
+	})
+
+	t.Run("untrusted issuer", func(t *testing.T) {
+		forged := *list
+		forged.Issuer = "stranger.example.com"
+		_, err := bans.Import(&forged)
+		assert.ErrorIs(t, err, ErrUntrustedBanList)
+	})
+
+	t.Run("pinned peer key", func(t *testing.T) {
+		untrusting := NewBans(BanManual, nil, apply)
+		require.NoError(t, untrusting.EnableExchange(ownKeys, "a.example.com", nil, filepath.Join(t.TempDir(), "bans.json")))
+		_, err := untrusting.Import(list)
+		assert.NoError(t, err) // The key of ally.example.com is in keys/ since it was generated here
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		disabled := NewBans(BanManual, nil, apply)
+		_, err := disabled.Export()
+		assert.ErrorIs(t, err, ErrBanListsDisabled)
+		_, err = disabled.Import(list)
+		assert.ErrorIs(t, err, ErrBanListsDisabled)
+	})
+
+	t.Run("failed ban is left out", func(t *testing.T) {
+		failing := NewBans(BanManual, nil, func(string) error { return errors.New("database is closed") })
+		require.NoError(t, failing.EnableExchange(ownKeys, "a.example.com", trusted, filepath.Join(t.TempDir(), "bans.json")))
+		report, err := failing.Import(list)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"griefers.example.com", "known.example.com"}, report.Failed)
+		assert.Empty(t, failing.List())
+	})
+}
+
+func TestBans_Ban(t *testing.T) {
+	var applied []string
+	bans := NewBans(BanManual, []string{"known.example.com"}, func(server string) error {
+		applied = append(applied, server)
+		return nil
+	})
+
+	banned, err := bans.Ban(BanEntry{Server: "griefers.example.com", Reason: "network configuration version 1", Source: "a.example.com"})
+	require.NoError(t, err)
+	assert.True(t, banned)
+	banned, err = bans.Ban(BanEntry{Server: "known.example.com"})
+	require.NoError(t, err)
+	assert.False(t, banned)
+
+	assert.Equal(t, []string{"griefers.example.com"}, applied)
+	assert.Equal(t, []string{"griefers.example.com", "known.example.com"}, bans.List())
+	entries := bans.Entries()
+	require.Len(t, entries, 2)
+	assert.Equal(t, "a.example.com", entries[0].Source)
+	assert.False(t, entries[0].BannedAt.IsZero())
+}
+
+func TestParseBanListKeys(t *testing.T) {
+	_, err := ParseBanListKeys(map[string]string{"ally.example.com": "zz"})
+	assert.Error(t, err)
+}
+
+func mustBanListMessage(t *testing.T, list *BanList) []byte {
+	t.Helper()
+	message, err := banListMessage(list)
+	require.NoError(t, err)
+	return message
+}
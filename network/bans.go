@@ -0,0 +1,181 @@
+package network
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/keys"
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// BanPolicy decides which bans announced by peers in their handshake are adopted locally
+type BanPolicy string
+
+const (
+	// BanUnion adopts every server banned by any peer
+	BanUnion BanPolicy = "union"
+	// BanIntersection adopts servers banned by every handshaked peer
+	BanIntersection BanPolicy = "intersection"
+	// BanManual adopts nothing, differences are reported for the operator to review
+	BanManual BanPolicy = "manual"
+)
+
+// ParseBanPolicy validates a ban policy name
+func ParseBanPolicy(name string) (BanPolicy, error) {
+	switch policy := BanPolicy(name); policy {
+	case BanUnion, BanIntersection, BanManual:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("unknown ban policy %q, expected union, intersection or manual", name)
+	}
+}
+
+// BanReport is the outcome of reconciling the ban list of one peer with ours
+type BanReport struct {
+	Peer         string    `json:"peer"`
+	ReconciledAt time.Time `json:"reconciled_at"`
+	Adopted      []string  `json:"adopted,omitempty"`       // Banned locally because of this handshake
+	Pending      []string  `json:"pending,omitempty"`       // Banned by the peer only, not adopted under the policy
+	NotBannedBy  []string  `json:"not_banned_by,omitempty"` // Banned here but not by the peer
+}
+
+// Bans tracks the servers banned locally and the ban lists announced by peers
+type Bans struct {
+	mu      sync.Mutex
+	policy  BanPolicy
+	apply   func(server string) error
+	local   map[string]bool
+	peers   map[string]map[string]bool
+	reports map[string]BanReport
+	since   time.Time           // When the configured bans were applied
+	details map[string]BanEntry // Reasons of bans adopted or imported, configured bans have none
+
+	// Ban list exchange with allied networks, see EnableExchange
+	km         *keys.KeyManager
+	webAddress string
+	trusted    map[string][]byte
+	importPath string
+	imported   []BanEntry
+}
+
+// NewBans creates a ban registry starting from the locally configured bans
+// apply removes a newly adopted banned server's data, the same way configured bans are applied on startup
+func NewBans(policy BanPolicy, local []string, apply func(server string) error) *Bans {
+	b := &Bans{
+		policy:  policy,
+		apply:   apply,
+		local:   make(map[string]bool),
+		peers:   make(map[string]map[string]bool),
+		reports: make(map[string]BanReport),
+		since:   time.Now().UTC(),
+		details: make(map[string]BanEntry),
+	}
+	for _, server := range local {
+		b.local[server] = true
+	}
+
+	return b
+}
+
+// List returns the servers banned locally, sorted
+func (b *Bans) List() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return sortedSet(b.local)
+}
+
+// Reconcile compares the ban list announced by peer with ours and adopts bans according to the policy
+func (b *Bans) Reconcile(peer string, banned []string) BanReport {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	remote := make(map[string]bool, len(banned))
+	for _, server := range banned {
+		remote[server] = true
+	}
+	b.peers[peer] = remote
+
+	report := BanReport{Peer: peer, ReconciledAt: time.Now()}
+	for _, server := range sortedSet(remote) {
+		if b.local[server] {
+			continue
+		}
+		if !b.adoptable(server) {
+			report.Pending = append(report.Pending, server)
+			continue
+		}
+
+		if err := b.apply(server); err != nil {
+			logger.Warnf("Failed to apply ban of %s announced by %s: %v", server, peer, err)
+			report.Pending = append(report.Pending, server)
+			continue
+		}
+		b.local[server] = true
+		b.details[server] = BanEntry{Server: server, Reason: "announced by " + peer, BannedAt: report.ReconciledAt, Source: peer}
+		report.Adopted = append(report.Adopted, server)
+	}
+	for _, server := range sortedSet(b.local) {
+		if !remote[server] {
+			report.NotBannedBy = append(report.NotBannedBy, server)
+		}
+	}
+
+	b.reports[peer] = report
+	return report
+}
+
+// adoptable reports whether the policy bans server given the lists of all peers seen so far
+func (b *Bans) adoptable(server string) bool {
+	switch b.policy {
+	case BanUnion:
+		return true
+	case BanIntersection:
+		for _, banned := range b.peers {
+			if !banned[server] {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// Reports returns the latest reconciliation report of every peer, sorted by peer
+func (b *Bans) Reports() []BanReport {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	reports := make([]BanReport, 0, len(b.reports))
+	for _, report := range b.reports {
+		reports = append(reports, report)
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].Peer < reports[j].Peer
+	})
+
+	return reports
+}
+
+// logBanReport reports adopted bans and differences left for the operator
+func logBanReport(report BanReport) {
+	if len(report.Adopted) > 0 {
+		logger.Infof("Adopted bans of %v announced by %s", report.Adopted, report.Peer)
+	}
+	if len(report.Pending) > 0 {
+		logger.Warnf("Peer %s bans %v which are not banned here, review BANNED_NODES", report.Peer, report.Pending)
+	}
+}
+
+func sortedSet(set map[string]bool) []string {
+	list := make([]string, 0, len(set))
+	for item := range set {
+		list = append(list, item)
+	}
+	sort.Strings(list)
+
+	return list
+}
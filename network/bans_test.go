@@ -0,0 +1,68 @@
+package network
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBanPolicy(t *testing.T) {
+	for _, name := range []string{"union", "intersection", "manual"} {
+		policy, err := ParseBanPolicy(name)
+		require.NoError(t, err)
+		assert.Equal(t, BanPolicy(name), policy)
+	}
+
+	_, err := ParseBanPolicy("majority")
+	assert.ErrorContains(t, err, "unknown ban policy")
+}
+
+func TestBans_Reconcile(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  BanPolicy
+		adopted []string
+		pending []string
+	}{
+		{name: "union adopts every announced ban", policy: BanUnion, adopted: []string{"x.example.com", "y.example.com"}},
+		{name: "intersection adopts bans all peers agree on", policy: BanIntersection, adopted: []string{"x.example.com"}, pending: []string{"y.example.com"}},
+		{name: "manual adopts nothing", policy: BanManual, pending: []string{"x.example.com", "y.example.com"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var applied []string
+			bans := NewBans(tt.policy, []string{"local.example.com"}, func(server string) error {
+				applied = append(applied, server)
+				return nil
+			})
+
+			first := bans.Reconcile("a.example.com", []string{"x.example.com"})
+			second := bans.Reconcile("b.example.com", []string{"y.example.com", "x.example.com", "local.example.com"})
+
+			assert.Equal(t, []string{"local.example.com"}, first.NotBannedBy)
+			assert.Empty(t, second.NotBannedBy)
+
+			adopted := append(first.Adopted, second.Adopted...)
+			assert.ElementsMatch(t, tt.adopted, adopted)
+			assert.ElementsMatch(t, tt.adopted, applied)
+			assert.Equal(t, tt.pending, second.Pending)
+			assert.Equal(t, append([]string{"local.example.com"}, tt.adopted...), bans.List())
+		})
+	}
+}
+
+func TestBans_ApplyFailure(t *testing.T) {
+	bans := NewBans(BanUnion, nil, func(string) error { return errors.New("disk full") })
+
+	report := bans.Reconcile("a.example.com", []string{"x.example.com"})
+	assert.Empty(t, report.Adopted)
+	assert.Equal(t, []string{"x.example.com"}, report.Pending)
+	assert.Empty(t, bans.List())
+
+	reports := bans.Reports()
+	require.Len(t, reports, 1)
+	assert.Equal(t, "a.example.com", reports[0].Peer)
+}
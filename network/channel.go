@@ -0,0 +1,73 @@
+package network
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+
+	"github.com/d1nch8g/consensuscraft/keys"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// Peer connections run over TLS with certificates of the node keys on both ends, no CA is
+// involved: each side checks the key of the channel is the key of the handshake it received,
+// so a handshake cannot be replayed by anyone who does not hold the key that signed it
+
+// serverCredentials authenticate the serving node with its key and ask every peer for its own
+func serverCredentials(km *keys.KeyManager) credentials.TransportCredentials {
+	return credentials.NewTLS(&tls.Config{
+		MinVersion:            tls.VersionTLS13,
+		ClientAuth:            tls.RequireAnyClientCert,
+		GetCertificate:        func(*tls.ClientHelloInfo) (*tls.Certificate, error) { return km.Certificate() },
+		VerifyPeerCertificate: verifyChannelCertificate,
+	})
+}
+
+// clientCredentials authenticate a node dialing a peer with its key, the peer's certificate is
+// checked against the handshake it answers with rather than a CA
+func clientCredentials(km *keys.KeyManager) credentials.TransportCredentials {
+	return credentials.NewTLS(&tls.Config{
+		MinVersion:            tls.VersionTLS13,
+		InsecureSkipVerify:    true, // verifyChannelCertificate and checkChannel authenticate the peer
+		GetClientCertificate:  func(*tls.CertificateRequestInfo) (*tls.Certificate, error) { return km.Certificate() },
+		VerifyPeerCertificate: verifyChannelCertificate,
+	})
+}
+
+// verifyChannelCertificate accepts only certificates made by Certificate of a node key
+func verifyChannelCertificate(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	if len(rawCerts) == 0 {
+		return errors.New("peer sent no certificate")
+	}
+	_, err := keys.CertificateKey(rawCerts[0])
+	return err
+}
+
+// channelKey returns the node key of the peer at the other end of the connection of ctx
+func channelKey(ctx context.Context) ([]byte, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("connection has no peer")
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.PeerCertificates) == 0 {
+		return nil, errors.New("connection is not authenticated")
+	}
+	return keys.CertificateKey(info.State.PeerCertificates[0].Raw)
+}
+
+// checkChannel checks the connection of ctx is held by the owner of publicKey
+func checkChannel(ctx context.Context, publicKey []byte) error {
+	key, err := channelKey(ctx)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(key, publicKey) {
+		return fmt.Errorf("connection is authenticated with another key than the handshake")
+	}
+	return nil
+}
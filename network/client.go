@@ -0,0 +1,323 @@
+package network
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/gen/pb"
+	"github.com/d1nch8g/consensuscraft/keys"
+	"github.com/d1nch8g/consensuscraft/logger"
+	"github.com/d1nch8g/consensuscraft/tracing"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// dial opens a gRPC client to a host:port address or, for ws:// and wss:// URLs, through a WebSocket tunnel,
+// the connection is authenticated with the node key
+func dial(address string, km *keys.KeyManager) (*grpc.ClientConn, error) {
+	options := []grpc.DialOption{
+		grpc.WithTransportCredentials(clientCredentials(km)),
+		grpc.WithDefaultCallOptions(callOptions()...),
+		grpc.WithChainUnaryInterceptor(traceUnaryClient),
+		grpc.WithChainStreamInterceptor(traceStreamClient),
+	}
+	if !IsWebSocketAddress(address) {
+		return grpc.NewClient(address, options...)
+	}
+
+	options = append(options, grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return DialWebSocket(ctx, address)
+	}))
+	return grpc.NewClient("passthrough:///"+address, options...)
+}
+
+// Join registers with the peer at address, records its handshake and merges its database snapshot
+// The address is either host:port for raw gRPC or a ws:// or wss:// URL for the WebSocket transport
+// It returns the number of player records that changed locally
+func Join(ctx context.Context, address string, handshake *pb.RegisterNodeRequest, km *keys.KeyManager, db *database.DB, peers *Peers) (changed int, err error) {
+	ctx, span := tracing.Start(ctx, "network.join")
+	span.SetAttribute("peer", address)
+	defer func() {
+		span.SetAttribute("changed", strconv.Itoa(changed))
+		span.RecordError(err)
+		span.Finish()
+	}()
+
+	conn, err := dial(address, km)
+	if err != nil {
+		return 0, fmt.Errorf("failed to connect to %s: %w", address, err)
+	}
+	defer conn.Close()
+
+	handshake, err = freshHandshake(km, peers.withSightings(handshake), nil)
+	if err != nil {
+		return 0, err
+	}
+	nonce := handshake.GetNonce()
+
+	// Notices, freeze orders and the network configuration ride along the handshake, outside of its
+	// signature, they are signed on their own
+	handshake.Notices = peers.Notices().outgoing()
+	handshake.FreezeOrders = peers.Freeze().orders()
+	if config := peers.NetworkConfigs().outgoing(); len(config) > 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, networkConfigHeader, string(config))
+	}
+
+	started := time.Now()
+	stream, err := pb.NewConsensusCraftServiceClient(conn).RegisterNode(ctx, handshake)
+	if err != nil {
+		return 0, fmt.Errorf("failed to register with %s: %w", address, err)
+	}
+
+	header, err := stream.Header()
+	if err != nil {
+		return 0, fmt.Errorf("handshake with %s failed: %w", address, err)
+	}
+	rtt := time.Since(started)
+
+	values := header.Get(handshakeHeader)
+	if len(values) == 0 {
+		return 0, fmt.Errorf("peer %s did not send its handshake", address)
+	}
+
+	var remote pb.RegisterNodeRequest
+	if err := proto.Unmarshal([]byte(values[0]), &remote); err != nil {
+		return 0, fmt.Errorf("invalid handshake from %s: %w", address, err)
+	}
+
+	if err := verifyAnswer(stream.Context(), km, &remote, nonce); err != nil {
+		return 0, fmt.Errorf("peer %s: %w", address, err)
+	}
+	logPeer(peers.Record(remote.GetWebAddress(), remote.GetPublicKey(), worldFromProto(remote.GetWorld())))
+	peers.setLatency(remote.GetWebAddress(), address, rtt)
+	peers.reconcileBans(remote.GetWebAddress(), remote.GetBannedServers())
+	peers.negotiateHash(remote.GetWebAddress(), remote.GetHashAlgorithms())
+	peers.setBuild(remote.GetWebAddress(), buildFromProto(remote.GetBuild()))
+	peers.vouch(remote.GetWebAddress(), remote.GetSeenServers())
+	if values := header.Get(maintenanceHeader); len(values) > 0 {
+		peers.setMaintenance(remote.GetWebAddress(), values[0])
+		logger.Infof("Peer %s is in maintenance: %s", remote.GetWebAddress(), values[0])
+	}
+	if values := header.Get(noticesHeader); len(values) > 0 {
+		var notices pb.OperatorNotices
+		if err := proto.Unmarshal([]byte(values[0]), &notices); err != nil {
+			logger.Warnf("Ignored invalid notices from %s: %v", remote.GetWebAddress(), err)
+		} else {
+			peers.Notices().receive(remote.GetWebAddress(), notices.GetNotices())
+		}
+	}
+	if values := header.Get(freezeHeader); len(values) > 0 {
+		var orders pb.FreezeOrders
+		if err := proto.Unmarshal([]byte(values[0]), &orders); err != nil {
+			logger.Warnf("Ignored invalid freeze orders from %s: %v", remote.GetWebAddress(), err)
+		} else {
+			peers.Freeze().receive(remote.GetWebAddress(), orders.GetOrders())
+		}
+	}
+	if values := header.Get(networkConfigHeader); len(values) > 0 {
+		peers.NetworkConfigs().receive(remote.GetWebAddress(), []byte(values[0]))
+	}
+
+	// Nothing from peers is accepted while frozen, the snapshot is merged once it is lifted
+	if peers.Freeze().Frozen() {
+		return 0, nil
+	}
+
+	for {
+		entry, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return changed, nil
+		}
+		if err != nil {
+			return changed, fmt.Errorf("database sync from %s failed: %w", address, err)
+		}
+
+		merged, err := db.MergeContext(ctx, entry.GetKey(), entry.GetValue())
+		if err != nil {
+			return changed, fmt.Errorf("failed to merge %s from %s: %w", entry.GetKey(), address, err)
+		}
+		if merged {
+			changed++
+		}
+	}
+}
+
+// Probe registers with the peer at address and verifies its handshake without merging its database
+// snapshot, returning the web address the peer announced and the round trip of the handshake
+func Probe(ctx context.Context, address string, handshake *pb.RegisterNodeRequest, km *keys.KeyManager) (string, time.Duration, error) {
+	conn, err := dial(address, km)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to connect to %s: %w", address, err)
+	}
+	defer conn.Close()
+
+	// Cancelling once the header is read stops the snapshot the peer streams after its handshake
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	handshake, err = freshHandshake(km, handshake, nil)
+	if err != nil {
+		return "", 0, err
+	}
+
+	started := time.Now()
+	stream, err := pb.NewConsensusCraftServiceClient(conn).RegisterNode(ctx, handshake)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to register with %s: %w", address, err)
+	}
+
+	header, err := stream.Header()
+	if err != nil {
+		return "", 0, fmt.Errorf("handshake with %s failed: %w", address, err)
+	}
+	rtt := time.Since(started)
+
+	values := header.Get(handshakeHeader)
+	if len(values) == 0 {
+		return "", 0, fmt.Errorf("peer %s did not send its handshake", address)
+	}
+
+	var remote pb.RegisterNodeRequest
+	if err := proto.Unmarshal([]byte(values[0]), &remote); err != nil {
+		return "", 0, fmt.Errorf("invalid handshake from %s: %w", address, err)
+	}
+	if err := verifyAnswer(stream.Context(), km, &remote, handshake.GetNonce()); err != nil {
+		return "", 0, fmt.Errorf("peer %s: %w", address, err)
+	}
+
+	return remote.GetWebAddress(), rtt, nil
+}
+
+// verifyAnswer checks the handshake a peer answered with: its signature, that it answers the handshake
+// with nonce and that the connection is held by the key that signed it
+func verifyAnswer(ctx context.Context, km *keys.KeyManager, remote *pb.RegisterNodeRequest, nonce []byte) error {
+	if err := checkChannel(ctx, remote.GetPublicKey()); err != nil {
+		return err
+	}
+	if !bytes.Equal(remote.GetChallenge(), nonce) {
+		return errors.New("handshake does not answer ours, it was replayed")
+	}
+	return VerifyHandshake(km, remote)
+}
+
+// inventoryMessage is the signed part of a pushed inventory: the inventory and the Unix nanoseconds it was stored at
+func inventoryMessage(inventory []byte, timestamp int64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{}, inventory...), uint64(timestamp))
+}
+
+// Push sends the latest local inventory of each player to the peer at address, signed with km
+// Updates carry the ID of the local entry, so pushing a player again after a failed push stores nothing twice
+// It returns the number of updates the peer acknowledged
+func Push(ctx context.Context, address, webAddress string, km *keys.KeyManager, db *database.DB, players []string) (acknowledged int, err error) {
+	ctx, span := tracing.Start(ctx, "network.push")
+	span.SetAttribute("peer", address)
+	span.SetAttribute("players", strconv.Itoa(len(players)))
+	defer func() {
+		span.SetAttribute("acknowledged", strconv.Itoa(acknowledged))
+		span.RecordError(err)
+		span.Finish()
+	}()
+
+	conn, err := dial(address, km)
+	if err != nil {
+		return 0, fmt.Errorf("failed to connect to %s: %w", address, err)
+	}
+	defer conn.Close()
+
+	stream, err := pb.NewConsensusCraftServiceClient(conn).Inventories(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open inventory stream to %s: %w", address, err)
+	}
+
+	sent := make(chan error, 1)
+	go func() {
+		defer stream.CloseSend()
+		for _, player := range players {
+			entry, err := db.Latest(player)
+			if errors.Is(err, database.ErrPlayerNotFound) {
+				continue
+			}
+			if err != nil {
+				sent <- fmt.Errorf("failed to read %s: %w", player, err)
+				return
+			}
+			inventory := entry.Inventory
+			timestamp := entry.Timestamp.UnixNano()
+
+			signature, err := km.Sign(player, inventoryMessage(inventory, timestamp))
+			if err != nil {
+				sent <- fmt.Errorf("failed to sign %s: %w", player, err)
+				return
+			}
+
+			msg := &pb.InventoryMessage{
+				PlayerName:    player,
+				InventoryData: inventory,
+				WebAddress:    webAddress,
+				Signature:     signature,
+				UpdateId:      entry.UpdateID,
+				Timestamp:     timestamp,
+			}
+			if err := stream.Send(msg); err != nil {
+				sent <- nil // The receive side reports the stream error
+				return
+			}
+		}
+		sent <- nil
+	}()
+
+	for {
+		_, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return acknowledged, fmt.Errorf("push to %s failed: %w", address, err)
+		}
+		acknowledged++
+	}
+
+	return acknowledged, <-sent
+}
+
+// GetPlayers asks the peer at address for the records of players, signing the request with km
+func GetPlayers(ctx context.Context, address, webAddress string, km *keys.KeyManager, players []string) (_ []*pb.DatabaseEntry, err error) {
+	ctx, span := tracing.Start(ctx, "network.get_players")
+	span.SetAttribute("peer", address)
+	span.SetAttribute("players", strconv.Itoa(len(players)))
+	defer func() {
+		span.RecordError(err)
+		span.Finish()
+	}()
+
+	conn, err := dial(address, km)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", address, err)
+	}
+	defer conn.Close()
+
+	signature, err := km.Sign(webAddress, []byte(strings.Join(players, "\n")))
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign player request: %w", err)
+	}
+
+	resp, err := pb.NewConsensusCraftServiceClient(conn).GetPlayers(ctx, &pb.GetPlayersRequest{
+		Players:    players,
+		WebAddress: webAddress,
+		Signature:  signature,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get players from %s: %w", address, err)
+	}
+	return resp.GetEntries(), nil
+}
@@ -0,0 +1,123 @@
+package network
+
+import (
+	"sync"
+	"time"
+)
+
+// ConnectivityStatus is a snapshot of whether the node is syncing or running local-only
+type ConnectivityStatus struct {
+	LocalOnly bool      `json:"local_only"`
+	Since     time.Time `json:"since"`                // When the current mode was entered
+	LastError string    `json:"last_error,omitempty"` // Last failure to reach a peer
+	Queued    int       `json:"queued"`               // Players with updates waiting for peers
+}
+
+// Connectivity tracks peer reachability and switches the node to local-only mode when no peer
+// could be reached at startup or for longer than the grace period, local players keep being
+// served and their updates are queued until a peer is reachable again
+type Connectivity struct {
+	grace    time.Duration
+	onChange func(localOnly bool)
+
+	mu            sync.Mutex
+	reached       bool
+	lastReachable time.Time
+	status        ConnectivityStatus
+	queued        []string
+	queuedSet     map[string]bool
+}
+
+// NewConnectivity creates a tracker that calls onChange, when not nil, each time the mode flips
+func NewConnectivity(grace time.Duration, onChange func(localOnly bool)) *Connectivity {
+	now := time.Now()
+	return &Connectivity{
+		grace:         grace,
+		onChange:      onChange,
+		lastReachable: now,
+		status:        ConnectivityStatus{Since: now},
+		queuedSet:     make(map[string]bool),
+	}
+}
+
+// Reachable records a successful exchange with a peer and leaves local-only mode
+func (c *Connectivity) Reachable() {
+	c.mu.Lock()
+	c.reached = true
+	c.lastReachable = time.Now()
+	c.status.LastError = ""
+	changed := c.setLocalOnly(false)
+	c.mu.Unlock()
+
+	if changed && c.onChange != nil {
+		c.onChange(false)
+	}
+}
+
+// Unreachable records a failure to reach any peer, entering local-only mode right away
+// if no peer was ever reached, otherwise once the grace period has passed
+func (c *Connectivity) Unreachable(err error) {
+	c.mu.Lock()
+	if err != nil {
+		c.status.LastError = err.Error()
+	}
+	changed := false
+	if !c.reached || time.Since(c.lastReachable) >= c.grace {
+		changed = c.setLocalOnly(true)
+	}
+	c.mu.Unlock()
+
+	if changed && c.onChange != nil {
+		c.onChange(true)
+	}
+}
+
+// setLocalOnly switches mode, reporting whether it changed, c.mu must be held
+func (c *Connectivity) setLocalOnly(localOnly bool) bool {
+	if c.status.LocalOnly == localOnly {
+		return false
+	}
+	c.status.LocalOnly = localOnly
+	c.status.Since = time.Now()
+	return true
+}
+
+// LocalOnly reports whether the node is running without reachable peers
+func (c *Connectivity) LocalOnly() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.status.LocalOnly
+}
+
+// Queue records that a player's inventory changed while local-only, players are kept once in order
+func (c *Connectivity) Queue(player string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.queuedSet[player] {
+		return
+	}
+	c.queuedSet[player] = true
+	c.queued = append(c.queued, player)
+}
+
+// Drain returns and clears the queued players
+func (c *Connectivity) Drain() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	queued := c.queued
+	c.queued = nil
+	c.queuedSet = make(map[string]bool)
+	return queued
+}
+
+// Status returns the current mode, last error and queue length
+func (c *Connectivity) Status() ConnectivityStatus {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	status := c.status
+	status.Queued = len(c.queued)
+	return status
+}
@@ -0,0 +1,56 @@
+package network
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnectivity(t *testing.T) {
+	t.Run("unreachable at startup is local-only right away", func(t *testing.T) {
+		var changes []bool
+		c := NewConnectivity(time.Hour, func(localOnly bool) { changes = append(changes, localOnly) })
+		assert.False(t, c.LocalOnly())
+
+		c.Unreachable(errors.New("connection refused"))
+		c.Unreachable(errors.New("connection refused"))
+		assert.True(t, c.LocalOnly())
+		assert.Equal(t, "connection refused", c.Status().LastError)
+
+		c.Reachable()
+		assert.False(t, c.LocalOnly())
+		assert.Empty(t, c.Status().LastError)
+		assert.Equal(t, []bool{true, false}, changes)
+	})
+
+	t.Run("short outage after joining stays connected", func(t *testing.T) {
+		c := NewConnectivity(time.Hour, nil)
+		c.Reachable()
+
+		c.Unreachable(errors.New("timeout"))
+		assert.False(t, c.LocalOnly())
+	})
+
+	t.Run("sustained outage after joining is local-only", func(t *testing.T) {
+		c := NewConnectivity(10*time.Millisecond, nil)
+		c.Reachable()
+		time.Sleep(20 * time.Millisecond)
+
+		c.Unreachable(errors.New("timeout"))
+		assert.True(t, c.LocalOnly())
+	})
+
+	t.Run("queue keeps players once in order", func(t *testing.T) {
+		c := NewConnectivity(time.Hour, nil)
+		c.Queue("bob")
+		c.Queue("alice")
+		c.Queue("bob")
+		assert.Equal(t, 2, c.Status().Queued)
+
+		assert.Equal(t, []string{"bob", "alice"}, c.Drain())
+		assert.Empty(t, c.Drain())
+		assert.Equal(t, 0, c.Status().Queued)
+	})
+}
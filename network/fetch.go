@@ -0,0 +1,141 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/keys"
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+var (
+	ErrNoPeerToFetch = errors.New("no peer to fetch from")
+	ErrFetchFrozen   = errors.New("inventory updates are frozen network-wide")
+)
+
+// Fetcher fetches records of players missing locally straight from peers, so a player spawning
+// here does not wait for the next sync, peers are tried by latency with a timeout each
+type Fetcher struct {
+	km         *keys.KeyManager
+	webAddress string
+	db         *database.DB
+	peers      *Peers
+	timeout    time.Duration
+
+	mu     sync.Mutex
+	failed map[string]time.Time // Peers whose last fetch failed, tried after the others
+}
+
+// NewFetcher creates a fetcher asking peers joined by this node, each for at most timeout
+func NewFetcher(km *keys.KeyManager, webAddress string, db *database.DB, peers *Peers, timeout time.Duration) *Fetcher {
+	return &Fetcher{
+		km:         km,
+		webAddress: webAddress,
+		db:         db,
+		peers:      peers,
+		timeout:    timeout,
+		failed:     make(map[string]time.Time),
+	}
+}
+
+// Fetch merges the record of player from the first peer that has it, returning
+// database.ErrPlayerNotFound when none of the reachable peers does
+func (f *Fetcher) Fetch(ctx context.Context, player string) error {
+	// Nothing from peers is accepted while frozen
+	if f.peers.Freeze().Frozen() {
+		return ErrFetchFrozen
+	}
+
+	candidates := f.candidates()
+	if len(candidates) == 0 {
+		return ErrNoPeerToFetch
+	}
+
+	var lastErr error
+	for _, peer := range candidates {
+		merged, err := f.fetchFrom(ctx, peer, player)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			logger.Warnf("Unable to fetch %s from %s: %v", player, peer.WebAddress, err)
+			lastErr = err
+			continue
+		}
+		if merged {
+			logger.Infof("Fetched %s from %s", player, peer.WebAddress)
+			return nil
+		}
+	}
+
+	if lastErr != nil {
+		return fmt.Errorf("%w: %v", database.ErrPlayerNotFound, lastErr)
+	}
+	return database.ErrPlayerNotFound
+}
+
+// fetchFrom asks a single peer for player, reporting whether the peer had a record of them
+func (f *Fetcher) fetchFrom(ctx context.Context, peer Peer, player string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, f.timeout)
+	defer cancel()
+
+	started := time.Now()
+	entries, err := GetPlayers(ctx, peer.Address, f.webAddress, f.km, []string{player})
+
+	f.mu.Lock()
+	if err != nil {
+		f.failed[peer.WebAddress] = time.Now()
+	} else {
+		delete(f.failed, peer.WebAddress)
+	}
+	f.mu.Unlock()
+	if err != nil {
+		return false, err
+	}
+	f.peers.setLatency(peer.WebAddress, peer.Address, time.Since(started))
+
+	found := false
+	for _, entry := range entries {
+		if string(entry.GetKey()) != player {
+			continue
+		}
+		if _, err := f.db.MergeContext(ctx, entry.GetKey(), entry.GetValue()); err != nil {
+			return false, fmt.Errorf("failed to merge %s: %w", player, err)
+		}
+		found = true
+	}
+	return found, nil
+}
+
+// candidates returns the peers this node can dial that are not in maintenance, lowest latency first,
+// peers whose last fetch failed come last, oldest failure first
+func (f *Fetcher) candidates() []Peer {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var candidates []Peer
+	for _, peer := range f.peers.List() {
+		if peer.Address != "" && peer.Maintenance == "" {
+			candidates = append(candidates, peer)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		failedI, isFailedI := f.failed[candidates[i].WebAddress]
+		failedJ, isFailedJ := f.failed[candidates[j].WebAddress]
+		switch {
+		case isFailedI != isFailedJ:
+			return isFailedJ
+		case isFailedI:
+			return failedI.Before(failedJ)
+		default:
+			return candidates[i].Latency < candidates[j].Latency
+		}
+	})
+	return candidates
+}
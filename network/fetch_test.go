@@ -0,0 +1,130 @@
+package network
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/keys"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetcher(t *testing.T) {
+	chdirTemp(t)
+
+	serverKeys, err := keys.New("server.example.com")
+	require.NoError(t, err)
+	serverDB, err := database.NewMemory()
+	require.NoError(t, err)
+	defer serverDB.Close()
+
+	serverHandshake, err := NewHandshake(serverKeys, "server.example.com", survival, nil)
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := NewServer(serverHandshake, serverKeys, serverDB, NewPeers(survival))
+	server.SetAllowlist([]string{"client.example.com"})
+	go server.Serve(listener)
+	defer server.Stop()
+
+	clientKeys, err := keys.New("client.example.com")
+	require.NoError(t, err)
+	clientDB, err := database.NewMemory()
+	require.NoError(t, err)
+	defer clientDB.Close()
+
+	t.Run("peer without handshake is rejected", func(t *testing.T) {
+		_, err := GetPlayers(context.Background(), listener.Addr().String(), "stranger.example.com", clientKeys, []string{"alice"})
+		assert.ErrorContains(t, err, "has not completed the handshake")
+	})
+
+	clientHandshake, err := NewHandshake(clientKeys, "client.example.com", survival, nil)
+	require.NoError(t, err)
+	clientPeers := NewPeers(survival)
+	_, err = Join(context.Background(), listener.Addr().String(), clientHandshake, clientKeys, clientDB, clientPeers)
+	require.NoError(t, err)
+	require.Len(t, clientPeers.List(), 1)
+	assert.Equal(t, listener.Addr().String(), clientPeers.List()[0].Address)
+	assert.Positive(t, clientPeers.List()[0].Latency)
+
+	// alice played on the peer after the last sync
+	require.NoError(t, serverDB.Put("alice", []byte(`[{"typeId":"minecraft:diamond","amount":1}]`), "server.example.com"))
+
+	fetcher := NewFetcher(clientKeys, "client.example.com", clientDB, clientPeers, time.Second)
+
+	t.Run("missing player is fetched", func(t *testing.T) {
+		require.NoError(t, fetcher.Fetch(context.Background(), "alice"))
+
+		inventory, err := clientDB.Get("alice")
+		require.NoError(t, err)
+		assert.JSONEq(t, `[{"typeId":"minecraft:diamond","amount":1}]`, string(inventory))
+	})
+
+	t.Run("player unknown to every peer", func(t *testing.T) {
+		assert.ErrorIs(t, fetcher.Fetch(context.Background(), "bob"), database.ErrPlayerNotFound)
+	})
+
+	t.Run("unreachable faster peer falls back to the next one", func(t *testing.T) {
+		require.NoError(t, serverDB.Put("carol", []byte(`[{"typeId":"minecraft:emerald","amount":2}]`), "server.example.com"))
+
+		closed, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		require.NoError(t, closed.Close())
+		clientPeers.Record("down.example.com", nil, survival)
+		clientPeers.setLatency("down.example.com", closed.Addr().String(), 0)
+
+		require.NoError(t, fetcher.Fetch(context.Background(), "carol"))
+		_, err = clientDB.Get("carol")
+		require.NoError(t, err)
+
+		// The failed peer is tried last from now on
+		candidates := fetcher.candidates()
+		require.Len(t, candidates, 2)
+		assert.Equal(t, "server.example.com", candidates[0].WebAddress)
+		assert.Equal(t, "down.example.com", candidates[1].WebAddress)
+	})
+
+	t.Run("peers in maintenance are skipped", func(t *testing.T) {
+		clientPeers.setMaintenance("server.example.com", "upgrade")
+		defer clientPeers.setMaintenance("server.example.com", "")
+
+		candidates := fetcher.candidates()
+		require.Len(t, candidates, 1)
+		assert.Equal(t, "down.example.com", candidates[0].WebAddress)
+	})
+
+	t.Run("no peer to fetch from", func(t *testing.T) {
+		empty := NewFetcher(clientKeys, "client.example.com", clientDB, NewPeers(survival), time.Second)
+		assert.ErrorIs(t, empty.Fetch(context.Background(), "alice"), ErrNoPeerToFetch)
+	})
+}
+
+func TestFetcher_Candidates(t *testing.T) {
+	peers := NewPeers(survival)
+	for _, peer := range []struct {
+		webAddress string
+		latency    time.Duration
+	}{
+		{"slow.example.com", 80 * time.Millisecond},
+		{"fast.example.com", 5 * time.Millisecond},
+		{"failing.example.com", time.Millisecond},
+	} {
+		peers.Record(peer.webAddress, nil, survival)
+		peers.setLatency(peer.webAddress, peer.webAddress+":50051", peer.latency)
+	}
+	// Peers that only joined us cannot be dialed
+	peers.Record("inbound.example.com", nil, survival)
+
+	fetcher := NewFetcher(nil, "client.example.com", nil, peers, time.Second)
+	fetcher.failed["failing.example.com"] = time.Now()
+
+	var order []string
+	for _, peer := range fetcher.candidates() {
+		order = append(order, peer.WebAddress)
+	}
+	assert.Equal(t, []string{"fast.example.com", "slow.example.com", "failing.example.com"}, order)
+}
@@ -0,0 +1,129 @@
+package network
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"sync/atomic"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Frames carry one peer protocol message: payload length and CRC-32C checksum as big-endian
+// uint32, then the protobuf payload
+const frameHeaderSize = 8
+
+// DefaultMaxMessageSize bounds the payload of a single peer message unless SetMaxMessageSize changes it
+const DefaultMaxMessageSize = 4 << 20
+
+// frameCodecName is the gRPC content subtype of framed messages
+const frameCodecName = "consensuscraft-frame"
+
+var (
+	ErrFrameTooLarge  = errors.New("frame exceeds the maximum message size")
+	ErrFrameTruncated = errors.New("frame is truncated")
+	ErrFrameTrailing  = errors.New("frame has trailing data")
+	ErrFrameChecksum  = errors.New("frame checksum mismatch")
+)
+
+var (
+	crc32c         = crc32.MakeTable(crc32.Castagnoli)
+	maxMessageSize atomic.Int64
+)
+
+func init() {
+	maxMessageSize.Store(DefaultMaxMessageSize)
+}
+
+// SetMaxMessageSize changes the largest payload accepted from and sent to peers, for servers
+// and connections created afterwards
+func SetMaxMessageSize(size int) error {
+	if size <= 0 {
+		return fmt.Errorf("maximum message size must be positive, got %d", size)
+	}
+	maxMessageSize.Store(int64(size))
+	return nil
+}
+
+// MaxMessageSize returns the largest payload accepted from peers
+func MaxMessageSize() int {
+	return int(maxMessageSize.Load())
+}
+
+// EncodeFrame frames a payload, refusing payloads larger than max
+func EncodeFrame(payload []byte, max int) ([]byte, error) {
+	if len(payload) > max {
+		return nil, fmt.Errorf("%w: %d bytes, limit %d", ErrFrameTooLarge, len(payload), max)
+	}
+
+	frame := make([]byte, frameHeaderSize, frameHeaderSize+len(payload))
+	binary.BigEndian.PutUint32(frame[0:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(frame[4:8], crc32.Checksum(payload, crc32c))
+	return append(frame, payload...), nil
+}
+
+// DecodeFrame returns the payload of exactly one frame, checking its length against max
+// before looking at the payload and its checksum after
+func DecodeFrame(frame []byte, max int) ([]byte, error) {
+	if len(frame) < frameHeaderSize {
+		return nil, fmt.Errorf("%w: %d byte header", ErrFrameTruncated, len(frame))
+	}
+
+	length := uint64(binary.BigEndian.Uint32(frame[0:4]))
+	if length > uint64(max) {
+		return nil, fmt.Errorf("%w: %d bytes, limit %d", ErrFrameTooLarge, length, max)
+	}
+
+	payload := frame[frameHeaderSize:]
+	switch {
+	case uint64(len(payload)) < length:
+		return nil, fmt.Errorf("%w: %d of %d bytes", ErrFrameTruncated, len(payload), length)
+	case uint64(len(payload)) > length:
+		return nil, fmt.Errorf("%w: %d bytes after the payload", ErrFrameTrailing, uint64(len(payload))-length)
+	}
+
+	if crc32.Checksum(payload, crc32c) != binary.BigEndian.Uint32(frame[4:8]) {
+		return nil, ErrFrameChecksum
+	}
+	return payload, nil
+}
+
+// frameCodec is the gRPC codec of the peer protocol, encoding protobuf messages into frames
+type frameCodec struct {
+	max int
+}
+
+func newFrameCodec() frameCodec {
+	return frameCodec{max: MaxMessageSize()}
+}
+
+func (c frameCodec) Marshal(v any) ([]byte, error) {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("cannot frame %T, not a protobuf message", v)
+	}
+
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	return EncodeFrame(payload, c.max)
+}
+
+func (c frameCodec) Unmarshal(data []byte, v any) error {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("cannot unframe into %T, not a protobuf message", v)
+	}
+
+	payload, err := DecodeFrame(data, c.max)
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(payload, msg)
+}
+
+func (c frameCodec) Name() string {
+	return frameCodecName
+}
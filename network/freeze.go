@@ -0,0 +1,389 @@
+package network
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/d1nch8g/consensuscraft/gen/pb"
+	"github.com/d1nch8g/consensuscraft/keys"
+	"github.com/d1nch8g/consensuscraft/logger"
+	"google.golang.org/protobuf/proto"
+)
+
+// freezeHeader carries the serving node's freeze orders back to a registering peer
+const freezeHeader = "freeze-bin"
+
+const (
+	maxFreezeReason   = 200
+	freezeProposalTTL = 24 * time.Hour // Proposals that do not reach the quorum in time are dropped
+)
+
+var (
+	ErrFreezeDisabled  = errors.New("freeze is disabled, no signers configured")
+	ErrNotFreezeSigner = errors.New("this node is not a freeze signer")
+	ErrUnknownProposal = errors.New("unknown freeze proposal")
+	ErrInvalidFreeze   = errors.New("invalid freeze order")
+)
+
+// FreezeOrder describes a freeze order, either pending its quorum or in effect
+type FreezeOrder struct {
+	ID       string    `json:"id"`
+	Frozen   bool      `json:"frozen"`
+	Reason   string    `json:"reason,omitempty"`
+	IssuedAt time.Time `json:"issued_at"`
+	Issuer   string    `json:"issuer"`
+	Signers  []string  `json:"signers"`
+}
+
+// FreezeStatus is a snapshot of the freeze: the order in effect, if any, and pending proposals
+type FreezeStatus struct {
+	Frozen  bool          `json:"frozen"`
+	Reason  string        `json:"reason,omitempty"`
+	Since   time.Time     `json:"since"`
+	Order   *FreezeOrder  `json:"order,omitempty"` // Order in effect, nil before the first one
+	Pending []FreezeOrder `json:"pending"`
+	Signers []string      `json:"signers"`
+	Quorum  int           `json:"quorum"`
+}
+
+// Freeze is the network-wide emergency stop of inventory updates: an order to freeze or unfreeze
+// is proposed by one signer node, endorsed by others as it travels with the handshakes, and takes
+// effect on every node once a quorum of signers signed it
+type Freeze struct {
+	mu         sync.Mutex
+	km         *keys.KeyManager
+	webAddress string
+	signers    []string
+	quorum     int
+	current    *pb.FreezeOrder
+	since      time.Time
+	pending    map[string]*pb.FreezeOrder
+	onChange   func(frozen bool, reason string)
+}
+
+// NewFreeze creates the freeze of the node at webAddress, orders need signatures of quorum
+// of signers, a quorum of 0 means a majority of them
+// onChange, when not nil, is called whenever an order takes effect, it must not call back into the Freeze
+func NewFreeze(km *keys.KeyManager, webAddress string, signers []string, quorum int, onChange func(frozen bool, reason string)) (*Freeze, error) {
+	if quorum == 0 {
+		quorum = len(signers)/2 + 1
+	}
+	if len(signers) > 0 && (quorum < 0 || quorum > len(signers)) {
+		return nil, fmt.Errorf("freeze quorum %d must be between 1 and the %d signers", quorum, len(signers))
+	}
+
+	return &Freeze{
+		km:         km,
+		webAddress: webAddress,
+		signers:    slices.Sorted(slices.Values(signers)),
+		quorum:     quorum,
+		since:      time.Now(),
+		pending:    make(map[string]*pb.FreezeOrder),
+		onChange:   onChange,
+	}, nil
+}
+
+// Propose signs a new order to freeze or unfreeze the network, it takes effect once endorsed
+// by enough other signers, or right away when the quorum is 1
+func (f *Freeze) Propose(frozen bool, reason string) (FreezeOrder, error) {
+	if err := f.signable(); err != nil {
+		return FreezeOrder{}, err
+	}
+	reason = strings.TrimSpace(reason)
+	if !frozen {
+		reason = ""
+	}
+	if utf8.RuneCountInString(reason) > maxFreezeReason || strings.ContainsAny(reason, "\r\n") {
+		return FreezeOrder{}, fmt.Errorf("%w: reason must be a single line of at most %d characters", ErrInvalidFreeze, maxFreezeReason)
+	}
+
+	order := &pb.FreezeOrder{Frozen: frozen, Reason: reason, IssuedAt: time.Now().Unix(), Issuer: f.webAddress}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.current != nil && order.GetIssuedAt() <= f.current.GetIssuedAt() {
+		order.IssuedAt = f.current.GetIssuedAt() + 1
+	}
+	if err := f.sign(order); err != nil {
+		return FreezeOrder{}, err
+	}
+	f.merge(order)
+
+	return f.describe(order), nil
+}
+
+// Endorse adds this node's signature to a pending proposal
+func (f *Freeze) Endorse(id string) (FreezeOrder, error) {
+	if err := f.signable(); err != nil {
+		return FreezeOrder{}, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	pending, ok := f.pending[id]
+	if !ok {
+		return FreezeOrder{}, fmt.Errorf("%w: %s", ErrUnknownProposal, id)
+	}
+
+	order := proto.Clone(pending).(*pb.FreezeOrder)
+	if err := f.sign(order); err != nil {
+		return FreezeOrder{}, err
+	}
+	f.merge(order)
+
+	return f.describe(order), nil
+}
+
+// Frozen reports whether inventory updates are frozen, a nil Freeze never is
+func (f *Freeze) Frozen() bool {
+	if f == nil {
+		return false
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.current.GetFrozen()
+}
+
+// Status returns the order in effect and the pending proposals
+func (f *Freeze) Status() FreezeStatus {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.expire()
+
+	status := FreezeStatus{
+		Frozen:  f.current.GetFrozen(),
+		Reason:  f.current.GetReason(),
+		Since:   f.since,
+		Pending: []FreezeOrder{},
+		Signers: f.signers,
+		Quorum:  f.quorum,
+	}
+	if f.current != nil {
+		order := f.describe(f.current)
+		status.Order = &order
+	}
+	for _, order := range f.pending {
+		status.Pending = append(status.Pending, f.describe(order))
+	}
+	sort.Slice(status.Pending, func(i, j int) bool {
+		return status.Pending[i].IssuedAt.After(status.Pending[j].IssuedAt)
+	})
+
+	return status
+}
+
+// orders returns the order in effect and the pending proposals, for relaying to a peer
+func (f *Freeze) orders() []*pb.FreezeOrder {
+	if f == nil {
+		return nil
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.expire()
+
+	// Pending proposals gain signatures while the copies are sent
+	var orders []*pb.FreezeOrder
+	if f.current != nil {
+		orders = append(orders, proto.Clone(f.current).(*pb.FreezeOrder))
+	}
+	for _, order := range f.pending {
+		orders = append(orders, proto.Clone(order).(*pb.FreezeOrder))
+	}
+	return orders
+}
+
+// receive merges the freeze orders a handshaked peer relayed, keeping only valid signatures of signers
+func (f *Freeze) receive(peer string, orders []*pb.FreezeOrder) {
+	if f == nil || len(f.signers) == 0 || len(orders) == 0 {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.expire()
+
+	for _, order := range orders {
+		if !slices.Contains(f.signers, order.GetIssuer()) {
+			logger.Warnf("Ignored freeze order relayed by %s from %s, not a freeze signer", peer, order.GetIssuer())
+			continue
+		}
+		if utf8.RuneCountInString(order.GetReason()) > maxFreezeReason || strings.ContainsAny(order.GetReason(), "\r\n") {
+			logger.Warnf("Ignored freeze order relayed by %s with an invalid reason", peer)
+			continue
+		}
+
+		verified := proto.Clone(order).(*pb.FreezeOrder)
+		verified.Signatures = nil
+		for _, signature := range order.GetSignatures() {
+			if hasSignature(verified, signature.GetWebAddress()) {
+				continue
+			}
+			if err := f.verify(order, signature); err != nil {
+				logger.Warnf("Ignored freeze signature of %s relayed by %s: %v", signature.GetWebAddress(), peer, err)
+				continue
+			}
+			verified.Signatures = append(verified.Signatures, signature)
+		}
+		if len(verified.Signatures) > 0 {
+			f.merge(verified)
+		}
+	}
+}
+
+// merge adds the signatures of an order to the matching proposal and puts it in effect once it
+// has a quorum, orders older than the one in effect are ignored, f.mu must be held
+func (f *Freeze) merge(order *pb.FreezeOrder) {
+	if f.current != nil && order.GetIssuedAt() <= f.current.GetIssuedAt() {
+		return
+	}
+
+	id := freezeID(order)
+	if pending, ok := f.pending[id]; ok {
+		for _, signature := range order.GetSignatures() {
+			if !hasSignature(pending, signature.GetWebAddress()) {
+				pending.Signatures = append(pending.Signatures, signature)
+			}
+		}
+		order = pending
+	} else {
+		if order.GetIssuedAt() < time.Now().Add(-freezeProposalTTL).Unix() && len(order.GetSignatures()) < f.quorum {
+			return
+		}
+		order = proto.Clone(order).(*pb.FreezeOrder)
+		f.pending[id] = order
+		logger.Warnf("Freeze proposal %s from %s: frozen=%t %s", id, order.GetIssuer(), order.GetFrozen(), order.GetReason())
+	}
+
+	if len(order.GetSignatures()) < f.quorum {
+		return
+	}
+
+	changed := f.current.GetFrozen() != order.GetFrozen() || f.current.GetReason() != order.GetReason()
+	f.current = order
+	for pendingID, pending := range f.pending {
+		if pending.GetIssuedAt() <= order.GetIssuedAt() {
+			delete(f.pending, pendingID)
+		}
+	}
+	if !changed {
+		return
+	}
+
+	f.since = time.Now()
+	if order.GetFrozen() {
+		logger.Errorf("Inventory updates frozen network-wide by %v: %s", signersOf(order), order.GetReason())
+	} else {
+		logger.Infof("Inventory updates unfrozen network-wide by %v", signersOf(order))
+	}
+	if f.onChange != nil {
+		f.onChange(order.GetFrozen(), order.GetReason())
+	}
+}
+
+// signable checks that this node may propose and endorse orders
+func (f *Freeze) signable() error {
+	if len(f.signers) == 0 {
+		return ErrFreezeDisabled
+	}
+	if !slices.Contains(f.signers, f.webAddress) {
+		return ErrNotFreezeSigner
+	}
+	return nil
+}
+
+// sign adds this node's signature to an order that does not have it yet
+func (f *Freeze) sign(order *pb.FreezeOrder) error {
+	if hasSignature(order, f.webAddress) {
+		return nil
+	}
+
+	signature, err := f.km.Sign(f.webAddress, freezeMessage(order))
+	if err != nil {
+		return fmt.Errorf("failed to sign freeze order: %w", err)
+	}
+	order.Signatures = append(order.Signatures, &pb.FreezeSignature{WebAddress: f.webAddress, Signature: signature})
+	return nil
+}
+
+// verify checks one signature of an order against the signer's own or pinned key
+func (f *Freeze) verify(order *pb.FreezeOrder, signature *pb.FreezeSignature) error {
+	signer := signature.GetWebAddress()
+	if !slices.Contains(f.signers, signer) {
+		return ErrNotFreezeSigner
+	}
+
+	var publicKey []byte
+	var err error
+	if signer == f.webAddress {
+		publicKey, err = f.km.Public()
+	} else {
+		publicKey, err = keys.LoadPublic(signer)
+	}
+	if err != nil {
+		return fmt.Errorf("no key for %s: %w", signer, err)
+	}
+	return keys.VerifyPublic(publicKey, signer, freezeMessage(order), signature.GetSignature())
+}
+
+// expire drops proposals that did not reach the quorum in time, f.mu must be held
+func (f *Freeze) expire() {
+	cutoff := time.Now().Add(-freezeProposalTTL).Unix()
+	for id, order := range f.pending {
+		if order.GetIssuedAt() < cutoff {
+			delete(f.pending, id)
+		}
+	}
+}
+
+func (f *Freeze) describe(order *pb.FreezeOrder) FreezeOrder {
+	return FreezeOrder{
+		ID:       freezeID(order),
+		Frozen:   order.GetFrozen(),
+		Reason:   order.GetReason(),
+		IssuedAt: time.Unix(order.GetIssuedAt(), 0).UTC(),
+		Issuer:   order.GetIssuer(),
+		Signers:  signersOf(order),
+	}
+}
+
+// freezeMessage is the signed part of a freeze order, prefixed so it can not pass for another signed message
+func freezeMessage(order *pb.FreezeOrder) []byte {
+	return []byte(strings.Join([]string{
+		"freeze",
+		strconv.FormatBool(order.GetFrozen()),
+		order.GetReason(),
+		strconv.FormatInt(order.GetIssuedAt(), 10),
+		order.GetIssuer(),
+	}, "\x00"))
+}
+
+func freezeID(order *pb.FreezeOrder) string {
+	sum := sha256.Sum256(freezeMessage(order))
+	return hex.EncodeToString(sum[:8])
+}
+
+func hasSignature(order *pb.FreezeOrder, signer string) bool {
+	return slices.ContainsFunc(order.GetSignatures(), func(s *pb.FreezeSignature) bool {
+		return s.GetWebAddress() == signer
+	})
+}
+
+func signersOf(order *pb.FreezeOrder) []string {
+	signers := make([]string, 0, len(order.GetSignatures()))
+	for _, signature := range order.GetSignatures() {
+		signers = append(signers, signature.GetWebAddress())
+	}
+	sort.Strings(signers)
+	return signers
+}
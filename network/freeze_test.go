@@ -0,0 +1,211 @@
+package network
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/gen/pb"
+	"github.com/d1nch8g/consensuscraft/keys"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+var freezeSigners = []string{"a.example.com", "b.example.com", "c.example.com"}
+
+// freezeChange records the changes a freeze applied
+type freezeChange struct {
+	frozen bool
+	reason string
+}
+
+// newTestFreeze creates the freeze of a node whose keys share the working directory with the
+// other test nodes, so each node finds the keys of the others
+func newTestFreeze(t *testing.T, webAddress string, quorum int) (*Freeze, *[]freezeChange) {
+	km, err := keys.New(webAddress)
+	require.NoError(t, err)
+
+	changes := &[]freezeChange{}
+	freeze, err := NewFreeze(km, webAddress, freezeSigners, quorum, func(frozen bool, reason string) {
+		*changes = append(*changes, freezeChange{frozen: frozen, reason: reason})
+	})
+	require.NoError(t, err)
+	return freeze, changes
+}
+
+func TestNewFreeze(t *testing.T) {
+	freeze, err := NewFreeze(nil, "a.example.com", freezeSigners, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, freeze.Status().Quorum)
+
+	_, err = NewFreeze(nil, "a.example.com", freezeSigners, 4, nil)
+	assert.Error(t, err)
+	_, err = NewFreeze(nil, "a.example.com", freezeSigners, -1, nil)
+	assert.Error(t, err)
+
+	var disabled *Freeze
+	assert.False(t, disabled.Frozen())
+	assert.Nil(t, disabled.orders())
+}
+
+func TestFreeze_Quorum(t *testing.T) {
+	chdirTemp(t)
+
+	a, aChanges := newTestFreeze(t, "a.example.com", 2)
+	b, bChanges := newTestFreeze(t, "b.example.com", 2)
+	c, _ := newTestFreeze(t, "c.example.com", 2)
+
+	// One signer alone can not freeze the network
+	proposal, err := a.Propose(true, "item duplication exploit")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a.example.com"}, proposal.Signers)
+	assert.False(t, a.Frozen())
+	require.Len(t, a.Status().Pending, 1)
+
+	// The second signature puts the freeze in effect
+	b.receive("a.example.com", a.orders())
+	assert.False(t, b.Frozen())
+	endorsed, err := b.Endorse(proposal.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a.example.com", "b.example.com"}, endorsed.Signers)
+	assert.True(t, b.Frozen())
+	assert.Empty(t, b.Status().Pending)
+	assert.Equal(t, []freezeChange{{frozen: true, reason: "item duplication exploit"}}, *bChanges)
+
+	// The signed order travels with the handshakes
+	a.receive("b.example.com", b.orders())
+	c.receive("b.example.com", b.orders())
+	assert.True(t, a.Frozen())
+	assert.True(t, c.Frozen())
+	assert.Equal(t, "item duplication exploit", c.Status().Reason)
+	assert.Len(t, *aChanges, 1)
+	frozenOrders := b.orders()
+
+	// Unfreezing needs a quorum as well
+	unfreeze, err := c.Propose(false, "ignored")
+	require.NoError(t, err)
+	assert.Empty(t, unfreeze.Reason)
+	a.receive("c.example.com", c.orders())
+	_, err = a.Endorse(unfreeze.ID)
+	require.NoError(t, err)
+	assert.False(t, a.Frozen())
+	b.receive("a.example.com", a.orders())
+	assert.False(t, b.Frozen())
+	assert.Equal(t, []freezeChange{{frozen: true, reason: "item duplication exploit"}, {frozen: false}}, *bChanges)
+
+	// The older freeze order does not come back when replayed
+	b.receive("c.example.com", frozenOrders)
+	assert.False(t, b.Frozen())
+
+	_, err = b.Endorse("unknown")
+	assert.ErrorIs(t, err, ErrUnknownProposal)
+}
+
+func TestFreeze_Receive(t *testing.T) {
+	chdirTemp(t)
+
+	a, _ := newTestFreeze(t, "a.example.com", 2)
+	b, _ := newTestFreeze(t, "b.example.com", 2)
+	outsider, _ := newTestFreeze(t, "d.example.com", 2)
+
+	proposal, err := a.Propose(true, "exploit")
+	require.NoError(t, err)
+	signed := a.orders()[0]
+
+	// A signature repeated by a relay counts once
+	repeated := proto.Clone(signed).(*pb.FreezeOrder)
+	repeated.Signatures = append(repeated.Signatures, repeated.Signatures[0])
+	b.receive("a.example.com", []*pb.FreezeOrder{repeated})
+	assert.False(t, b.Frozen())
+	require.Len(t, b.Status().Pending, 1)
+	assert.Equal(t, []string{"a.example.com"}, b.Status().Pending[0].Signers)
+
+	// Signatures over another order, of other nodes or by unknown issuers are dropped
+	forged := proto.Clone(signed).(*pb.FreezeOrder)
+	forged.Reason = "something else"
+	foreign := proto.Clone(signed).(*pb.FreezeOrder)
+	foreign.Signatures = append(foreign.Signatures, &pb.FreezeSignature{WebAddress: "d.example.com", Signature: signed.GetSignatures()[0].GetSignature()})
+	unknown := proto.Clone(signed).(*pb.FreezeOrder)
+	unknown.Issuer = "d.example.com"
+	b.receive("a.example.com", []*pb.FreezeOrder{forged, foreign, unknown})
+	assert.False(t, b.Frozen())
+	require.Len(t, b.Status().Pending, 1)
+	assert.Equal(t, proposal.ID, b.Status().Pending[0].ID)
+
+	// Only signers propose and endorse
+	_, err = outsider.Propose(true, "exploit")
+	assert.ErrorIs(t, err, ErrNotFreezeSigner)
+	disabled, err := NewFreeze(nil, "a.example.com", nil, 0, nil)
+	require.NoError(t, err)
+	_, err = disabled.Propose(true, "exploit")
+	assert.ErrorIs(t, err, ErrFreezeDisabled)
+	_, err = a.Propose(true, "line\nbreak")
+	assert.ErrorIs(t, err, ErrInvalidFreeze)
+}
+
+func TestJoin_Freeze(t *testing.T) {
+	chdirTemp(t)
+
+	serverKeys, err := keys.New("server.example.com")
+	require.NoError(t, err)
+	serverDB, err := database.NewMemory()
+	require.NoError(t, err)
+	defer serverDB.Close()
+	require.NoError(t, serverDB.Put("alice", []byte(`[{"typeId":"minecraft:diamond","amount":1}]`), "server.example.com"))
+
+	signers := []string{"server.example.com"}
+	serverHandshake, err := NewHandshake(serverKeys, "server.example.com", survival, nil)
+	require.NoError(t, err)
+	serverPeers := NewPeers(survival)
+	serverFreeze, err := NewFreeze(serverKeys, "server.example.com", signers, 1, nil)
+	require.NoError(t, err)
+	serverPeers.SetFreeze(serverFreeze)
+	_, err = serverFreeze.Propose(true, "exploit")
+	require.NoError(t, err)
+	require.True(t, serverFreeze.Frozen())
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := NewServer(serverHandshake, serverKeys, serverDB, serverPeers)
+	server.SetAllowlist([]string{"client.example.com"})
+	go server.Serve(listener)
+	defer server.Stop()
+
+	clientKeys, err := keys.New("client.example.com")
+	require.NoError(t, err)
+	clientDB, err := database.NewMemory()
+	require.NoError(t, err)
+	defer clientDB.Close()
+	require.NoError(t, clientDB.Put("bob", []byte(`[{"typeId":"minecraft:diamond","amount":64}]`), "client.example.com"))
+
+	clientHandshake, err := NewHandshake(clientKeys, "client.example.com", survival, nil)
+	require.NoError(t, err)
+	clientPeers := NewPeers(survival)
+	clientFreeze, err := NewFreeze(clientKeys, "client.example.com", signers, 1, nil)
+	require.NoError(t, err)
+	clientPeers.SetFreeze(clientFreeze)
+
+	// The client learns about the freeze and accepts nothing from the snapshot
+	changed, err := Join(context.Background(), listener.Addr().String(), clientHandshake, clientKeys, clientDB, clientPeers)
+	require.NoError(t, err)
+	assert.Zero(t, changed)
+	assert.True(t, clientFreeze.Frozen())
+	_, err = clientDB.Get("alice")
+	assert.ErrorIs(t, err, database.ErrPlayerNotFound)
+
+	// Pushed updates are refused by the frozen server
+	_, err = Push(context.Background(), listener.Addr().String(), "client.example.com", clientKeys, clientDB, []string{"bob"})
+	assert.Error(t, err)
+	_, err = serverDB.Get("bob")
+	assert.ErrorIs(t, err, database.ErrPlayerNotFound)
+
+	// Once unfrozen the sync resumes
+	_, err = serverFreeze.Propose(false, "")
+	require.NoError(t, err)
+	changed, err = Join(context.Background(), listener.Addr().String(), clientHandshake, clientKeys, clientDB, clientPeers)
+	require.NoError(t, err)
+	assert.False(t, clientFreeze.Frozen())
+	assert.Equal(t, 1, changed)
+}
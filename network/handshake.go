@@ -0,0 +1,241 @@
+package network
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/bds"
+	"github.com/d1nch8g/consensuscraft/buildinfo"
+	"github.com/d1nch8g/consensuscraft/gen/pb"
+	"github.com/d1nch8g/consensuscraft/keys"
+	"github.com/d1nch8g/consensuscraft/logger"
+	"google.golang.org/protobuf/proto"
+)
+
+// handshakeMaxAge is how far the signing time of a handshake may be off, older ones are refused as replays
+const handshakeMaxAge = 5 * time.Minute
+
+// nonceSize is the number of random bytes drawn for every handshake
+const nonceSize = 32
+
+// NewHandshake builds the signed registration request announcing this node and the servers it bans to a peer
+func NewHandshake(km *keys.KeyManager, webAddress string, world *bds.WorldSettings, banned []string) (*pb.RegisterNodeRequest, error) {
+	publicKey, err := km.Public()
+	if err != nil {
+		return nil, err
+	}
+
+	req := &pb.RegisterNodeRequest{
+		WebAddress:    webAddress,
+		PublicKey:     publicKey,
+		World:         worldToProto(world),
+		BannedServers: banned,
+		Build:         buildToProto(buildinfo.Get()),
+	}
+	if rotation := km.Rotation(); rotation != nil {
+		req.KeyRotation = rotation.Marshal()
+	}
+
+	if err := signHandshake(km, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// freshHandshake signs a copy of a handshake for one connection with a new nonce and, when it answers
+// another handshake, the nonce of that handshake as its challenge
+func freshHandshake(km *keys.KeyManager, handshake *pb.RegisterNodeRequest, challenge []byte) (*pb.RegisterNodeRequest, error) {
+	req := proto.Clone(handshake).(*pb.RegisterNodeRequest)
+	req.Challenge = challenge
+	if err := signHandshake(km, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// signHandshake draws the nonce of a handshake, stamps it with the current time and signs it
+func signHandshake(km *keys.KeyManager, req *pb.RegisterNodeRequest) error {
+	req.Nonce = make([]byte, nonceSize)
+	if _, err := rand.Read(req.Nonce); err != nil {
+		return fmt.Errorf("failed to draw handshake nonce: %w", err)
+	}
+	req.Timestamp = time.Now().Unix()
+
+	message, err := handshakeMessage(req)
+	if err != nil {
+		return err
+	}
+
+	if req.Signature, err = km.Sign(req.GetWebAddress(), message); err != nil {
+		return fmt.Errorf("failed to sign handshake: %w", err)
+	}
+	return nil
+}
+
+// VerifyHandshake checks the handshake signature and age and pins the peer's public key on first contact
+func VerifyHandshake(km *keys.KeyManager, req *pb.RegisterNodeRequest) error {
+	message, err := handshakeMessage(req)
+	if err != nil {
+		return err
+	}
+
+	if err := keys.VerifyPublic(req.GetPublicKey(), req.GetWebAddress(), message, req.GetSignature()); err != nil {
+		return fmt.Errorf("invalid handshake signature: %w", err)
+	}
+	if len(req.GetNonce()) != nonceSize {
+		return fmt.Errorf("handshake of %s has no nonce", req.GetWebAddress())
+	}
+	if age := time.Since(time.Unix(req.GetTimestamp(), 0)); age > handshakeMaxAge || age < -handshakeMaxAge {
+		return fmt.Errorf("handshake of %s was signed %s ago, check the clocks of both nodes", req.GetWebAddress(), age.Round(time.Second))
+	}
+
+	known, err := keys.LoadPublic(req.GetWebAddress())
+	if errors.Is(err, os.ErrNotExist) {
+		return km.Save(req.GetWebAddress(), req.GetPublicKey())
+	}
+	if err != nil {
+		return err
+	}
+
+	if bytes.Equal(known, req.GetPublicKey()) {
+		return nil
+	}
+	if len(req.GetKeyRotation()) == 0 {
+		return fmt.Errorf("public key of %s does not match the pinned key", req.GetWebAddress())
+	}
+	return repin(km, req)
+}
+
+// repin pins the key a peer rotated to when the rotation it presents is signed by its pinned key
+func repin(km *keys.KeyManager, req *pb.RegisterNodeRequest) error {
+	rotation, err := keys.ParseKeyRotation(req.GetKeyRotation())
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(rotation.Current, req.GetPublicKey()) {
+		return fmt.Errorf("public key of %s does not match its key rotation", req.GetWebAddress())
+	}
+	if err := km.Repin(req.GetWebAddress(), rotation); err != nil {
+		return fmt.Errorf("public key of %s does not match the pinned key: %w", req.GetWebAddress(), err)
+	}
+
+	logger.Infof("Audit: repinned the key of %s, rotated at %s", req.GetWebAddress(), rotation.At.UTC().Format(time.RFC3339))
+	return nil
+}
+
+// handshakeMessage is the signed part of a handshake: the public key, the signing time, the nonce and
+// challenge, then the world settings and, when the node bans or saw any server, the banned and seen servers
+func handshakeMessage(req *pb.RegisterNodeRequest) ([]byte, error) {
+	world, err := proto.MarshalOptions{Deterministic: true}.Marshal(req.GetWorld())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode world settings: %w", err)
+	}
+	if len(req.GetNonce()) > 255 || len(req.GetChallenge()) > 255 {
+		return nil, errors.New("handshake nonce too long")
+	}
+
+	message := append([]byte{}, req.GetPublicKey()...)
+	message = binary.BigEndian.AppendUint64(message, uint64(req.GetTimestamp()))
+	message = append(append(message, byte(len(req.GetNonce()))), req.GetNonce()...)
+	message = append(append(message, byte(len(req.GetChallenge()))), req.GetChallenge()...)
+	message = append(message, world...)
+	for _, server := range req.GetBannedServers() {
+		message = append(append(message, 0), server...)
+	}
+	for _, server := range req.GetSeenServers() {
+		message = append(append(message, 1), server...)
+	}
+
+	return message, nil
+}
+
+// nonceCache remembers the nonces of handshakes verified within handshakeMaxAge, so none is taken twice
+type nonceCache struct {
+	mu   sync.Mutex
+	seen map[string]time.Time
+}
+
+// use records a handshake nonce, failing when it was used before
+func (c *nonceCache) use(nonce []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if c.seen == nil {
+		c.seen = make(map[string]time.Time)
+	}
+	for seen, at := range c.seen {
+		if now.Sub(at) > 2*handshakeMaxAge {
+			delete(c.seen, seen)
+		}
+	}
+
+	if _, ok := c.seen[string(nonce)]; ok {
+		return errors.New("handshake was replayed")
+	}
+	c.seen[string(nonce)] = now
+	return nil
+}
+
+func worldToProto(world *bds.WorldSettings) *pb.WorldSettings {
+	if world == nil {
+		return nil
+	}
+
+	return &pb.WorldSettings{
+		SeedHash:      world.SeedHash,
+		Difficulty:    world.Difficulty,
+		Gamemode:      world.Gamemode,
+		ForceGamemode: world.ForceGamemode,
+		AllowCheats:   world.AllowCheats,
+		PackHash:      world.PackHash,
+		Port:          uint32(world.Port),
+	}
+}
+
+func worldFromProto(world *pb.WorldSettings) *bds.WorldSettings {
+	if world == nil {
+		return nil
+	}
+
+	return &bds.WorldSettings{
+		SeedHash:      world.GetSeedHash(),
+		Difficulty:    world.GetDifficulty(),
+		Gamemode:      world.GetGamemode(),
+		ForceGamemode: world.GetForceGamemode(),
+		AllowCheats:   world.GetAllowCheats(),
+		Port:          int(world.GetPort()),
+		PackHash:      world.GetPackHash(),
+	}
+}
+
+func buildToProto(build buildinfo.Info) *pb.BuildInfo {
+	return &pb.BuildInfo{
+		Version:     build.Version,
+		Commit:      build.Commit,
+		BuildDate:   build.BuildDate,
+		PackVersion: build.PackVersion,
+		Protocols:   build.Protocols,
+	}
+}
+
+// buildFromProto returns nil for peers too old to announce their build
+func buildFromProto(build *pb.BuildInfo) *buildinfo.Info {
+	if build == nil {
+		return nil
+	}
+
+	return &buildinfo.Info{
+		Version:     build.GetVersion(),
+		Commit:      build.GetCommit(),
+		BuildDate:   build.GetBuildDate(),
+		PackVersion: build.GetPackVersion(),
+		Protocols:   build.GetProtocols(),
+	}
+}
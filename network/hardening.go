@@ -0,0 +1,64 @@
+package network
+
+import (
+	"context"
+	"runtime/debug"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/crash"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
+)
+
+// Limits on what a single peer connection may hold on to
+const (
+	maxStreamsPerPeer = 32
+	keepaliveTime     = 2 * time.Minute  // Idle connections are pinged after this
+	keepaliveTimeout  = 20 * time.Second // and closed when the ping is not answered in time
+)
+
+// serverOptions frame messages, bound their size and keep a misbehaving peer from crashing
+// the node or holding its goroutines forever
+func serverOptions() []grpc.ServerOption {
+	codec := newFrameCodec()
+	return []grpc.ServerOption{
+		grpc.ForceServerCodec(codec),
+		grpc.MaxRecvMsgSize(codec.max + frameHeaderSize),
+		grpc.MaxSendMsgSize(codec.max + frameHeaderSize),
+		grpc.MaxConcurrentStreams(maxStreamsPerPeer),
+		grpc.KeepaliveParams(keepalive.ServerParameters{Time: keepaliveTime, Timeout: keepaliveTimeout}),
+		grpc.ChainUnaryInterceptor(recoverUnary, traceUnary),
+		grpc.ChainStreamInterceptor(recoverStream, traceStream),
+	}
+}
+
+// callOptions frame messages sent to peers with the same limits as the server
+func callOptions() []grpc.CallOption {
+	codec := newFrameCodec()
+	return []grpc.CallOption{
+		grpc.ForceCodec(codec),
+		grpc.MaxCallRecvMsgSize(codec.max + frameHeaderSize),
+		grpc.MaxCallSendMsgSize(codec.max + frameHeaderSize),
+	}
+}
+
+// recoverUnary turns a panic in a unary handler into an Internal error for that call only
+func recoverUnary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	defer recoverHandler(info.FullMethod, &err)
+	return handler(ctx, req)
+}
+
+// recoverStream turns a panic in a streaming handler into an Internal error for that stream only
+func recoverStream(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer recoverHandler(info.FullMethod, &err)
+	return handler(srv, stream)
+}
+
+func recoverHandler(method string, err *error) {
+	if r := recover(); r != nil {
+		crash.Record(method, r, debug.Stack())
+		*err = status.Errorf(codes.Internal, "internal error in %s", method)
+	}
+}
@@ -0,0 +1,104 @@
+package network
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// maintenanceHeader tells a registering peer that this node is in maintenance
+const maintenanceHeader = "maintenance"
+
+// MaintenanceStatus is a snapshot of the maintenance mode of a node
+type MaintenanceStatus struct {
+	Enabled bool      `json:"enabled"`
+	Reason  string    `json:"reason,omitempty"`
+	Since   time.Time `json:"since"` // When the current mode was entered
+}
+
+// Maintenance is the maintenance mode of the node: only operators play on the server,
+// registering peers get the handshake but no database snapshot and pushes to peers are held back
+type Maintenance struct {
+	mu       sync.Mutex
+	status   MaintenanceStatus
+	onChange func(enabled bool, reason string) error
+}
+
+// NewMaintenance creates the maintenance mode, onChange, when not nil, applies a switch to the
+// server and keeps the mode unchanged when it fails
+func NewMaintenance(onChange func(enabled bool, reason string) error) *Maintenance {
+	return &Maintenance{
+		status:   MaintenanceStatus{Since: time.Now()},
+		onChange: onChange,
+	}
+}
+
+// Set enters or leaves maintenance mode, the reason is shown to kicked players and peers
+func (m *Maintenance) Set(enabled bool, reason string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !enabled {
+		reason = ""
+	}
+	if m.status.Enabled == enabled && m.status.Reason == reason {
+		return nil
+	}
+
+	if m.onChange != nil {
+		if err := m.onChange(enabled, reason); err != nil {
+			return err
+		}
+	}
+
+	if m.status.Enabled != enabled {
+		m.status.Since = time.Now()
+	}
+	m.status.Enabled = enabled
+	m.status.Reason = reason
+	return nil
+}
+
+// Enabled reports whether the node is in maintenance, a nil Maintenance never is
+func (m *Maintenance) Enabled() bool {
+	if m == nil {
+		return false
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.status.Enabled
+}
+
+// Status returns the current maintenance mode
+func (m *Maintenance) Status() MaintenanceStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.status
+}
+
+// header is the maintenance header value sent to peers, empty when not in maintenance
+func (m *Maintenance) header() string {
+	if m == nil {
+		return ""
+	}
+
+	status := m.Status()
+	if !status.Enabled {
+		return ""
+	}
+	if status.Reason == "" {
+		return "enabled"
+	}
+	return status.Reason
+}
+
+// WithMaintenance flags outgoing peer requests made with ctx when the node is in maintenance
+func WithMaintenance(ctx context.Context, m *Maintenance) context.Context {
+	if value := m.header(); value != "" {
+		return metadata.AppendToOutgoingContext(ctx, maintenanceHeader, value)
+	}
+	return ctx
+}
@@ -0,0 +1,57 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestMaintenance_Set(t *testing.T) {
+	var calls int
+	fail := false
+	m := NewMaintenance(func(enabled bool, reason string) error {
+		calls++
+		if fail {
+			return errors.New("server is not running")
+		}
+		return nil
+	})
+	assert.False(t, m.Enabled())
+
+	require.NoError(t, m.Set(true, "upgrade"))
+	assert.True(t, m.Enabled())
+	assert.Equal(t, "upgrade", m.Status().Reason)
+
+	// Setting the same mode again does not touch the server
+	require.NoError(t, m.Set(true, "upgrade"))
+	assert.Equal(t, 1, calls)
+
+	fail = true
+	assert.Error(t, m.Set(false, ""))
+	assert.True(t, m.Enabled())
+
+	fail = false
+	require.NoError(t, m.Set(false, "ignored"))
+	assert.False(t, m.Enabled())
+	assert.Empty(t, m.Status().Reason)
+}
+
+func TestWithMaintenance(t *testing.T) {
+	var nilMaintenance *Maintenance
+	assert.False(t, nilMaintenance.Enabled())
+
+	ctx := WithMaintenance(context.Background(), nilMaintenance)
+	_, ok := metadata.FromOutgoingContext(ctx)
+	assert.False(t, ok)
+
+	m := NewMaintenance(nil)
+	require.NoError(t, m.Set(true, ""))
+
+	md, ok := metadata.FromOutgoingContext(WithMaintenance(context.Background(), m))
+	require.True(t, ok)
+	assert.Equal(t, []string{"enabled"}, md.Get(maintenanceHeader))
+}
@@ -0,0 +1,441 @@
+package network
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/hashing"
+	"github.com/d1nch8g/consensuscraft/keys"
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// networkConfigHeader carries the newest network configuration a node holds to its peer, both ways
+const networkConfigHeader = "netconfig-bin"
+
+// maxConfigVersionJump is how many versions past the accepted one a received version may be and
+// still be taken by the policy, further versions always wait for review: a signer publishes the
+// next version of the newest it holds, so a far jump is a node that missed many versions or a
+// version forged with a leaked signer key to outrun every later one
+const maxConfigVersionJump = 16
+
+var (
+	ErrNetworkConfigDisabled  = errors.New("network configuration is disabled, no signers configured")
+	ErrNotConfigSigner        = errors.New("this node is not a network configuration signer")
+	ErrInvalidNetworkConfig   = errors.New("invalid network configuration")
+	ErrUntrustedNetworkConfig = errors.New("network configuration issuer is not a signer")
+	ErrNoPendingConfig        = errors.New("no network configuration awaits review")
+)
+
+// ConfigPolicy is how a node takes the network configurations it receives from peers
+type ConfigPolicy string
+
+const (
+	ConfigAccept ConfigPolicy = "accept" // Newer configurations are applied as they arrive
+	ConfigReview ConfigPolicy = "review" // Newer configurations wait for the operator to accept them
+	ConfigIgnore ConfigPolicy = "ignore" // Configurations are neither applied nor relayed
+)
+
+// ParseConfigPolicy returns the network configuration policy with the given name
+func ParseConfigPolicy(name string) (ConfigPolicy, error) {
+	switch policy := ConfigPolicy(name); policy {
+	case ConfigAccept, ConfigReview, ConfigIgnore:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("unknown network configuration policy %q, expected accept, review or ignore", name)
+	}
+}
+
+// NetworkSettings are the rules a network configuration sets on every node, named after the
+// environment variables they replace, empty and zero fields leave the local setting in place
+type NetworkSettings struct {
+	// Validation profile
+	AllowedNamespaces []string `json:"allowed_namespaces,omitempty"`
+	NamespaceAction   string   `json:"namespace_action,omitempty"`
+	PeerInvalidItems  string   `json:"peer_invalid_items,omitempty"`
+
+	// Servers banned on every node, in addition to the ones banned locally
+	BannedNodes []string `json:"banned_nodes,omitempty"`
+
+	// Quotas of peer entries: nodes that must have seen an origin online and for how long
+	OriginQuorum       int `json:"origin_quorum,omitempty"`
+	OriginQuorumWindow int `json:"origin_quorum_window,omitempty"` // Seconds
+	UptimeTolerance    int `json:"uptime_tolerance,omitempty"`     // Seconds
+
+	OriginFormat string `json:"origin_format,omitempty"`
+
+	// Protocol settings
+	PeerMaxMessageBytes int      `json:"peer_max_message_bytes,omitempty"`
+	HashAlgorithms      []string `json:"hash_algorithms,omitempty"`
+}
+
+// Validate checks the settings the way the matching environment variables are checked at startup
+func (s NetworkSettings) Validate() error {
+	if len(s.AllowedNamespaces) > 0 {
+		if _, err := database.NewNamespaceRule(s.AllowedNamespaces); err != nil {
+			return fmt.Errorf("%w: allowed namespaces: %v", ErrInvalidNetworkConfig, err)
+		}
+	}
+	if s.NamespaceAction != "" && s.NamespaceAction != "strip" && s.NamespaceAction != "reject" {
+		return fmt.Errorf("%w: namespace action must be strip or reject, got %q", ErrInvalidNetworkConfig, s.NamespaceAction)
+	}
+	if s.PeerInvalidItems != "" {
+		if _, err := database.ParseInvalidItemPolicy(s.PeerInvalidItems); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidNetworkConfig, err)
+		}
+	}
+	for _, server := range s.BannedNodes {
+		if strings.TrimSpace(server) == "" {
+			return fmt.Errorf("%w: empty banned node", ErrInvalidNetworkConfig)
+		}
+	}
+	if s.OriginQuorum < 0 || s.OriginQuorumWindow < 0 || s.UptimeTolerance < 0 || s.PeerMaxMessageBytes < 0 {
+		return fmt.Errorf("%w: quotas and sizes must not be negative", ErrInvalidNetworkConfig)
+	}
+	if s.OriginFormat != "" {
+		if _, err := database.NewOriginFormat(s.OriginFormat); err != nil {
+			return fmt.Errorf("%w: origin format: %v", ErrInvalidNetworkConfig, err)
+		}
+	}
+	if len(s.HashAlgorithms) > 0 {
+		if _, err := hashing.ParseList(s.HashAlgorithms); err != nil {
+			return fmt.Errorf("%w: hash algorithms: %v", ErrInvalidNetworkConfig, err)
+		}
+	}
+	return nil
+}
+
+// NetworkConfig is a version of the network configuration, signed by the signer node it was
+// published on, a node keeps the newest version it accepted
+type NetworkConfig struct {
+	Version   uint64          `json:"version"`
+	IssuedAt  time.Time       `json:"issued_at"`
+	Issuer    string          `json:"issuer"`
+	Settings  NetworkSettings `json:"settings"`
+	Signature []byte          `json:"signature,omitempty"`
+}
+
+// NetworkConfigStatus is a snapshot of the network configuration held by a node
+type NetworkConfigStatus struct {
+	Policy     ConfigPolicy   `json:"policy"`
+	Signers    []string       `json:"signers"`
+	Current    *NetworkConfig `json:"current,omitempty"` // Accepted version, nil before the first one
+	AcceptedAt time.Time      `json:"accepted_at,omitempty"`
+	Pending    *NetworkConfig `json:"pending,omitempty"` // Newer version awaiting review
+}
+
+// NetworkConfigs distributes the network configuration: a signer node publishes a new version,
+// it travels with the handshakes and every node takes it according to its policy
+type NetworkConfigs struct {
+	mu         sync.Mutex
+	km         *keys.KeyManager
+	webAddress string
+	signers    []string
+	policy     ConfigPolicy
+	path       string
+	current    *NetworkConfig
+	acceptedAt time.Time
+	pending    *NetworkConfig
+	onAccept   func(config *NetworkConfig)
+}
+
+// NewNetworkConfigs creates the network configuration of the node at webAddress, accepting
+// versions signed by signers, the accepted version is kept in path
+// onAccept, when not nil, is called whenever a version is accepted, it must not call back into NetworkConfigs
+func NewNetworkConfigs(km *keys.KeyManager, webAddress string, signers []string, policy ConfigPolicy, path string, onAccept func(config *NetworkConfig)) (*NetworkConfigs, error) {
+	current, err := LoadNetworkConfig(path, signers)
+	if err != nil && !errors.Is(err, ErrUntrustedNetworkConfig) {
+		return nil, err
+	}
+	if err != nil {
+		logger.Warnf("Ignored the saved network configuration: %v", err)
+		current = nil
+	}
+
+	configs := &NetworkConfigs{
+		km:         km,
+		webAddress: webAddress,
+		signers:    slices.Sorted(slices.Values(signers)),
+		policy:     policy,
+		path:       path,
+		current:    current,
+		onAccept:   onAccept,
+	}
+	if current != nil {
+		if info, err := os.Stat(path); err == nil {
+			configs.acceptedAt = info.ModTime()
+		}
+	}
+	return configs, nil
+}
+
+// LoadNetworkConfig reads the network configuration accepted earlier from path, nil when none was
+// accepted yet, a configuration whose issuer is no longer one of signers is refused
+func LoadNetworkConfig(path string, signers []string) (*NetworkConfig, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read network configuration: %w", err)
+	}
+
+	var config NetworkConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("invalid network configuration %s: %w", path, err)
+	}
+	if !slices.Contains(signers, config.Issuer) {
+		return nil, fmt.Errorf("%w: version %d from %s", ErrUntrustedNetworkConfig, config.Version, config.Issuer)
+	}
+	return &config, nil
+}
+
+// Publish signs settings as the next version of the network configuration and accepts it here,
+// whatever the policy, peers receive it on their next handshake
+func (c *NetworkConfigs) Publish(settings NetworkSettings) (*NetworkConfig, error) {
+	if len(c.signers) == 0 {
+		return nil, ErrNetworkConfigDisabled
+	}
+	if !slices.Contains(c.signers, c.webAddress) {
+		return nil, ErrNotConfigSigner
+	}
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Versions held for review after a jump are not built upon, they may be forged
+	latest := c.current.version()
+	if !c.jump(c.pending.version()) {
+		latest = max(latest, c.pending.version())
+	}
+	if latest == math.MaxUint64 {
+		return nil, fmt.Errorf("%w: no version left after %d", ErrInvalidNetworkConfig, latest)
+	}
+
+	config := &NetworkConfig{
+		Version:  latest + 1,
+		IssuedAt: time.Now().UTC(),
+		Issuer:   c.webAddress,
+		Settings: settings,
+	}
+	message, err := networkConfigMessage(config)
+	if err != nil {
+		return nil, err
+	}
+	if config.Signature, err = c.km.Sign(c.webAddress, message); err != nil {
+		return nil, fmt.Errorf("failed to sign network configuration: %w", err)
+	}
+
+	if err := c.accept(config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// Accept applies the version awaiting review
+func (c *NetworkConfigs) Accept(version uint64) (*NetworkConfig, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.pending == nil || c.pending.Version != version {
+		return nil, fmt.Errorf("%w: version %d", ErrNoPendingConfig, version)
+	}
+	config := c.pending
+	if err := c.accept(config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// Current returns the accepted version, nil for a disabled NetworkConfigs or before the first one
+func (c *NetworkConfigs) Current() *NetworkConfig {
+	if c == nil {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.current
+}
+
+// Status returns the accepted version and the one awaiting review
+func (c *NetworkConfigs) Status() NetworkConfigStatus {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return NetworkConfigStatus{
+		Policy:     c.policy,
+		Signers:    c.signers,
+		Current:    c.current,
+		AcceptedAt: c.acceptedAt,
+		Pending:    c.pending,
+	}
+}
+
+// outgoing returns the newest version known here, accepted or not, encoded for a peer
+// Versions awaiting review are relayed too, they are signed and each node checks them on its own,
+// except versions held for jumping far ahead, which a forged version must not spread through
+func (c *NetworkConfigs) outgoing() []byte {
+	if c == nil || c.policy == ConfigIgnore {
+		return nil
+	}
+
+	c.mu.Lock()
+	newest := c.current
+	if c.pending.version() > newest.version() && !c.jump(c.pending.version()) {
+		newest = c.pending
+	}
+	c.mu.Unlock()
+	if newest == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(newest)
+	if err != nil {
+		logger.Errorf("Failed to encode network configuration: %v", err)
+		return nil
+	}
+	return data
+}
+
+// receive takes a version a handshaked peer relayed when it is newer than the one held here and
+// signed by a signer, accepting it or holding it for review as the policy says
+func (c *NetworkConfigs) receive(peer string, data []byte) {
+	if c == nil || len(c.signers) == 0 || c.policy == ConfigIgnore || len(data) == 0 {
+		return
+	}
+
+	var config NetworkConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		logger.Warnf("Ignored invalid network configuration from %s: %v", peer, err)
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if config.Version <= c.current.version() {
+		return
+	}
+	jump := c.jump(config.Version)
+	if pending := c.pending; pending != nil && (jump || c.policy == ConfigReview) {
+		// A jump never displaces a version within reach of the accepted one
+		pendingJump := c.jump(pending.Version)
+		if (jump && !pendingJump) || (jump == pendingJump && config.Version <= pending.Version) {
+			return
+		}
+	}
+	if err := c.verify(&config); err != nil {
+		logger.Warnf("Ignored network configuration version %d relayed by %s: %v", config.Version, peer, err)
+		return
+	}
+
+	if jump {
+		c.pending = &config
+		logger.Warnf("Network configuration version %d from %s relayed by %s skips more than %d versions past version %d, it awaits review: "+
+			"run consensuscraft netconfig accept %d to apply it", config.Version, config.Issuer, peer, maxConfigVersionJump, c.current.version(), config.Version)
+		return
+	}
+	if c.policy == ConfigReview {
+		c.pending = &config
+		logger.Warnf("Network configuration version %d from %s awaits review, run consensuscraft netconfig accept %d to apply it", config.Version, config.Issuer, config.Version)
+		return
+	}
+	if err := c.accept(&config); err != nil {
+		logger.Errorf("Unable to accept network configuration version %d: %v", config.Version, err)
+	}
+}
+
+// jump reports whether version is too far past the accepted version to be taken without review, c.mu must be held
+func (c *NetworkConfigs) jump(version uint64) bool {
+	current := c.current.version()
+	return version > current && version-current > maxConfigVersionJump
+}
+
+// verify checks a version was signed by its issuer, a signer, with its own or pinned key, and
+// that its settings are valid
+func (c *NetworkConfigs) verify(config *NetworkConfig) error {
+	if !slices.Contains(c.signers, config.Issuer) {
+		return fmt.Errorf("%w: %s", ErrUntrustedNetworkConfig, config.Issuer)
+	}
+	if len(config.Signature) == 0 {
+		return fmt.Errorf("%w: signature is required", ErrInvalidNetworkConfig)
+	}
+
+	var publicKey []byte
+	var err error
+	if config.Issuer == c.webAddress {
+		publicKey, err = c.km.Public()
+	} else {
+		publicKey, err = keys.LoadPublic(config.Issuer)
+	}
+	if err != nil {
+		return fmt.Errorf("no key for %s: %w", config.Issuer, err)
+	}
+
+	message, err := networkConfigMessage(config)
+	if err != nil {
+		return err
+	}
+	if err := keys.VerifyPublic(publicKey, config.Issuer, message, config.Signature); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidNetworkConfig, err)
+	}
+	return config.Settings.Validate()
+}
+
+// accept saves a version and puts it in effect, c.mu must be held
+func (c *NetworkConfigs) accept(config *NetworkConfig) error {
+	data, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := c.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("failed to save network configuration: %w", err)
+	}
+	if err := os.Rename(tmp, c.path); err != nil {
+		return fmt.Errorf("failed to save network configuration: %w", err)
+	}
+
+	c.current = config
+	c.acceptedAt = time.Now()
+	if c.pending.version() <= config.Version {
+		c.pending = nil
+	}
+
+	logger.Infof("Audit: accepted network configuration version %d from %s", config.Version, config.Issuer)
+	if c.onAccept != nil {
+		c.onAccept(config)
+	}
+	return nil
+}
+
+// version returns the version of a configuration, 0 for none
+func (config *NetworkConfig) version() uint64 {
+	if config == nil {
+		return 0
+	}
+	return config.Version
+}
+
+// networkConfigMessage is the signed content of a network configuration, everything but the
+// signature, prefixed so it can not pass for another signed message
+func networkConfigMessage(config *NetworkConfig) ([]byte, error) {
+	unsigned := *config
+	unsigned.Signature = nil
+	data, err := json.Marshal(unsigned)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte("netconfig\x00"), data...), nil
+}
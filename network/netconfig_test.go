@@ -0,0 +1,295 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net"
+	"testing"
+
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/keys"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var configSigners = []string{"a.example.com", "b.example.com"}
+
+// newTestConfigs creates the network configuration of a node whose keys share the working directory
+// with the other test nodes, returning the versions it accepted
+func newTestConfigs(t *testing.T, webAddress string, policy ConfigPolicy) (*NetworkConfigs, *[]uint64) {
+	km, err := keys.New(webAddress)
+	require.NoError(t, err)
+
+	accepted := &[]uint64{}
+	configs, err := NewNetworkConfigs(km, webAddress, configSigners, policy, webAddress+".netconfig.json", func(config *NetworkConfig) {
+		*accepted = append(*accepted, config.Version)
+	})
+	require.NoError(t, err)
+	return configs, accepted
+}
+
+func TestParseConfigPolicy(t *testing.T) {
+	for _, name := range []string{"accept", "review", "ignore"} {
+		policy, err := ParseConfigPolicy(name)
+		require.NoError(t, err)
+		assert.Equal(t, ConfigPolicy(name), policy)
+	}
+
+	_, err := ParseConfigPolicy("always")
+	assert.Error(t, err)
+}
+
+func TestNetworkSettings_Validate(t *testing.T) {
+	valid := NetworkSettings{
+		AllowedNamespaces:   []string{"minecraft"},
+		NamespaceAction:     "reject",
+		PeerInvalidItems:    "strip",
+		BannedNodes:         []string{"griefers.example.com"},
+		OriginQuorum:        2,
+		OriginFormat:        "Forged on <server>",
+		PeerMaxMessageBytes: 1 << 20,
+		HashAlgorithms:      []string{"sha256"},
+	}
+	assert.NoError(t, valid.Validate())
+	assert.NoError(t, NetworkSettings{}.Validate())
+
+	for name, settings := range map[string]NetworkSettings{
+		"namespace action":   {NamespaceAction: "drop"},
+		"invalid items":      {PeerInvalidItems: "keep"},
+		"empty banned node":  {BannedNodes: []string{" "}},
+		"negative quota":     {OriginQuorum: -1},
+		"origin format":      {OriginFormat: "Origin"},
+		"hash algorithm":     {HashAlgorithms: []string{"md5"}},
+		"negative size":      {PeerMaxMessageBytes: -1},
+		"invalid namespaces": {AllowedNamespaces: []string{"minecraft:diamond"}},
+	} {
+		assert.ErrorIs(t, settings.Validate(), ErrInvalidNetworkConfig, name)
+	}
+}
+
+func TestNetworkConfigs_Publish(t *testing.T) {
+	chdirTemp(t)
+
+	a, aAccepted := newTestConfigs(t, "a.example.com", ConfigReview)
+	b, bAccepted := newTestConfigs(t, "b.example.com", ConfigAccept)
+
+	// The publisher accepts its own version whatever its policy
+	first, err := a.Publish(NetworkSettings{BannedNodes: []string{"griefers.example.com"}})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), first.Version)
+	assert.Equal(t, "a.example.com", first.Issuer)
+	assert.Equal(t, []uint64{1}, *aAccepted)
+	assert.Equal(t, first, a.Current())
+
+	// Peers accepting configurations apply it as it arrives
+	b.receive("a.example.com", a.outgoing())
+	assert.Equal(t, []uint64{1}, *bAccepted)
+	assert.Equal(t, []string{"griefers.example.com"}, b.Current().Settings.BannedNodes)
+
+	// Versions follow the newest one known
+	second, err := b.Publish(NetworkSettings{OriginQuorum: 2})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), second.Version)
+
+	// A reviewing node holds it until the operator accepts it
+	a.receive("b.example.com", b.outgoing())
+	assert.Equal(t, []uint64{1}, *aAccepted)
+	status := a.Status()
+	assert.Equal(t, uint64(1), status.Current.Version)
+	require.NotNil(t, status.Pending)
+	assert.Equal(t, uint64(2), status.Pending.Version)
+
+	// Versions awaiting review are relayed
+	var relayed NetworkConfig
+	require.NoError(t, json.Unmarshal(a.outgoing(), &relayed))
+	assert.Equal(t, uint64(2), relayed.Version)
+
+	_, err = a.Accept(3)
+	assert.ErrorIs(t, err, ErrNoPendingConfig)
+	accepted, err := a.Accept(2)
+	require.NoError(t, err)
+	assert.Equal(t, 2, accepted.Settings.OriginQuorum)
+	assert.Equal(t, []uint64{1, 2}, *aAccepted)
+	assert.Nil(t, a.Status().Pending)
+
+	// The accepted version is loaded again after a restart
+	restarted, _ := newTestConfigs(t, "a.example.com", ConfigReview)
+	assert.Equal(t, uint64(2), restarted.Current().Version)
+	assert.False(t, restarted.Status().AcceptedAt.IsZero())
+}
+
+func TestNetworkConfigs_Receive(t *testing.T) {
+	chdirTemp(t)
+
+	a, _ := newTestConfigs(t, "a.example.com", ConfigAccept)
+	b, bAccepted := newTestConfigs(t, "b.example.com", ConfigAccept)
+	ignoring, ignored := newTestConfigs(t, "c.example.com", ConfigIgnore)
+
+	// Only signers publish
+	_, err := ignoring.Publish(NetworkSettings{OriginQuorum: 1})
+	assert.ErrorIs(t, err, ErrNotConfigSigner)
+	km, err := keys.New("a.example.com")
+	require.NoError(t, err)
+	disabled, err := NewNetworkConfigs(km, "a.example.com", nil, ConfigAccept, "disabled.json", nil)
+	require.NoError(t, err)
+	_, err = disabled.Publish(NetworkSettings{OriginQuorum: 1})
+	assert.ErrorIs(t, err, ErrNetworkConfigDisabled)
+	assert.Nil(t, disabled.Current())
+	_, err = a.Publish(NetworkSettings{NamespaceAction: "drop"})
+	assert.ErrorIs(t, err, ErrInvalidNetworkConfig)
+
+	published, err := a.Publish(NetworkSettings{OriginQuorum: 3})
+	require.NoError(t, err)
+
+	// Tampered settings, unknown issuers and garbage are dropped
+	tampered := *published
+	tampered.Settings.OriginQuorum = 1
+	foreign := *published
+	foreign.Issuer = "c.example.com"
+	for _, config := range []NetworkConfig{tampered, foreign} {
+		data, err := json.Marshal(config)
+		require.NoError(t, err)
+		b.receive("a.example.com", data)
+	}
+	b.receive("a.example.com", []byte("not json"))
+	assert.Empty(t, *bAccepted)
+	assert.Nil(t, b.Current())
+
+	// Older and equal versions are not taken again
+	b.receive("a.example.com", a.outgoing())
+	b.receive("a.example.com", a.outgoing())
+	assert.Equal(t, []uint64{1}, *bAccepted)
+
+	// Nodes ignoring the network configuration neither take nor relay it
+	ignoring.receive("a.example.com", a.outgoing())
+	assert.Empty(t, *ignored)
+	assert.Nil(t, ignoring.outgoing())
+
+	var none *NetworkConfigs
+	assert.Nil(t, none.Current())
+	assert.Nil(t, none.outgoing())
+	none.receive("a.example.com", a.outgoing())
+}
+
+func TestNetworkConfigs_VersionJump(t *testing.T) {
+	chdirTemp(t)
+
+	a, _ := newTestConfigs(t, "a.example.com", ConfigAccept)
+	b, bAccepted := newTestConfigs(t, "b.example.com", ConfigAccept)
+	km, err := keys.New("a.example.com")
+	require.NoError(t, err)
+
+	published, err := a.Publish(NetworkSettings{OriginQuorum: 3})
+	require.NoError(t, err)
+	b.receive("a.example.com", a.outgoing())
+	require.Equal(t, []uint64{1}, *bAccepted)
+
+	signed := func(version uint64) []byte {
+		config := *published
+		config.Version = version
+		message, err := networkConfigMessage(&config)
+		require.NoError(t, err)
+		config.Signature, err = km.Sign("a.example.com", message)
+		require.NoError(t, err)
+		data, err := json.Marshal(config)
+		require.NoError(t, err)
+		return data
+	}
+
+	// A version far ahead waits for review even under the accept policy and is not relayed
+	b.receive("a.example.com", signed(math.MaxUint64))
+	assert.Equal(t, []uint64{1}, *bAccepted)
+	require.NotNil(t, b.Status().Pending)
+	assert.Equal(t, uint64(math.MaxUint64), b.Status().Pending.Version)
+	assert.JSONEq(t, string(a.outgoing()), string(b.outgoing()))
+
+	// Versions within reach are still taken and displace the held jump
+	b.receive("a.example.com", signed(1+maxConfigVersionJump))
+	assert.Equal(t, []uint64{1, 1 + maxConfigVersionJump}, *bAccepted)
+	b.receive("a.example.com", signed(math.MaxUint64))
+	b.receive("a.example.com", signed(2+maxConfigVersionJump))
+	assert.Equal(t, []uint64{1, 1 + maxConfigVersionJump, 2 + maxConfigVersionJump}, *bAccepted)
+
+	// Signers publish past the accepted version rather than the held one
+	b.receive("a.example.com", signed(math.MaxUint64))
+	next, err := b.Publish(NetworkSettings{OriginQuorum: 2})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3+maxConfigVersionJump), next.Version)
+
+	// The held version is applied once an operator accepts it, after which nothing is left to publish
+	a.receive("b.example.com", signed(math.MaxUint64))
+	_, err = a.Accept(math.MaxUint64)
+	require.NoError(t, err)
+	_, err = a.Publish(NetworkSettings{OriginQuorum: 2})
+	assert.ErrorIs(t, err, ErrInvalidNetworkConfig)
+}
+
+func TestLoadNetworkConfig(t *testing.T) {
+	chdirTemp(t)
+
+	config, err := LoadNetworkConfig("missing.json", configSigners)
+	require.NoError(t, err)
+	assert.Nil(t, config)
+
+	a, _ := newTestConfigs(t, "a.example.com", ConfigAccept)
+	_, err = a.Publish(NetworkSettings{OriginQuorum: 2})
+	require.NoError(t, err)
+
+	path := "a.example.com.netconfig.json"
+	config, err = LoadNetworkConfig(path, configSigners)
+	require.NoError(t, err)
+	assert.Equal(t, 2, config.Settings.OriginQuorum)
+
+	// Versions of nodes that are no longer signers are refused
+	_, err = LoadNetworkConfig(path, []string{"b.example.com"})
+	assert.ErrorIs(t, err, ErrUntrustedNetworkConfig)
+}
+
+func TestJoin_NetworkConfig(t *testing.T) {
+	chdirTemp(t)
+
+	serverKeys, err := keys.New("a.example.com")
+	require.NoError(t, err)
+	serverDB, err := database.NewMemory()
+	require.NoError(t, err)
+	defer serverDB.Close()
+	serverHandshake, err := NewHandshake(serverKeys, "a.example.com", survival, nil)
+	require.NoError(t, err)
+	serverPeers := NewPeers(survival)
+	serverConfigs, _ := newTestConfigs(t, "a.example.com", ConfigAccept)
+	serverPeers.SetNetworkConfigs(serverConfigs)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := NewServer(serverHandshake, serverKeys, serverDB, serverPeers)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	clientKeys, err := keys.New("b.example.com")
+	require.NoError(t, err)
+	clientDB, err := database.NewMemory()
+	require.NoError(t, err)
+	defer clientDB.Close()
+	clientHandshake, err := NewHandshake(clientKeys, "b.example.com", survival, nil)
+	require.NoError(t, err)
+	clientPeers := NewPeers(survival)
+	clientConfigs, _ := newTestConfigs(t, "b.example.com", ConfigAccept)
+	clientPeers.SetNetworkConfigs(clientConfigs)
+
+	// A version published on the serving node reaches the joining one
+	_, err = serverConfigs.Publish(NetworkSettings{OriginFormat: "Forged on <server>"})
+	require.NoError(t, err)
+	_, err = Join(context.Background(), listener.Addr().String(), clientHandshake, clientKeys, clientDB, clientPeers)
+	require.NoError(t, err)
+	require.NotNil(t, clientConfigs.Current())
+	assert.Equal(t, "Forged on <server>", clientConfigs.Current().Settings.OriginFormat)
+
+	// and the next one, published on the joining node, travels the other way
+	_, err = clientConfigs.Publish(NetworkSettings{OriginFormat: "Made on <server>"})
+	require.NoError(t, err)
+	_, err = Join(context.Background(), listener.Addr().String(), clientHandshake, clientKeys, clientDB, clientPeers)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), serverConfigs.Current().Version)
+	assert.Equal(t, "Made on <server>", serverConfigs.Current().Settings.OriginFormat)
+}
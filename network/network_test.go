@@ -0,0 +1,778 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/bds"
+	"github.com/d1nch8g/consensuscraft/buildinfo"
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/gen/pb"
+	"github.com/d1nch8g/consensuscraft/hashing"
+	"github.com/d1nch8g/consensuscraft/keys"
+	"github.com/d1nch8g/consensuscraft/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var survival = &bds.WorldSettings{SeedHash: "seed", Difficulty: "normal", Gamemode: "survival", ForceGamemode: true}
+
+// chdirTemp runs the test in a temporary directory so key files don't leak into the repo
+func chdirTemp(t *testing.T) {
+	originalDir, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(t.TempDir()))
+	t.Cleanup(func() { os.Chdir(originalDir) })
+}
+
+func TestHandshake(t *testing.T) {
+	chdirTemp(t)
+
+	km, err := keys.New("node-a")
+	require.NoError(t, err)
+
+	handshake, err := NewHandshake(km, "node-a", survival, nil)
+	require.NoError(t, err)
+
+	verifier, err := keys.New("node-b")
+	require.NoError(t, err)
+
+	t.Run("valid handshake pins the key", func(t *testing.T) {
+		require.NoError(t, VerifyHandshake(verifier, handshake))
+
+		pinned, err := keys.LoadPublic("node-a")
+		require.NoError(t, err)
+		assert.Equal(t, handshake.PublicKey, pinned)
+
+		// Repeated handshakes with the pinned key are accepted
+		assert.NoError(t, VerifyHandshake(verifier, handshake))
+	})
+
+	t.Run("tampered world settings are rejected", func(t *testing.T) {
+		tampered, err := NewHandshake(km, "node-a", survival, nil)
+		require.NoError(t, err)
+		tampered.World.Difficulty = "peaceful"
+
+		assert.ErrorContains(t, VerifyHandshake(verifier, tampered), "invalid handshake signature")
+	})
+
+	t.Run("nonce and signing time are signed", func(t *testing.T) {
+		signed, err := NewHandshake(km, "node-a", survival, nil)
+		require.NoError(t, err)
+		assert.Len(t, signed.Nonce, nonceSize)
+
+		signed.Nonce[0] ^= 0xff
+		assert.ErrorContains(t, VerifyHandshake(verifier, signed), "invalid handshake signature")
+		signed.Nonce[0] ^= 0xff
+		signed.Timestamp++
+		assert.ErrorContains(t, VerifyHandshake(verifier, signed), "invalid handshake signature")
+	})
+
+	t.Run("stale handshakes are rejected", func(t *testing.T) {
+		stale, err := NewHandshake(km, "node-a", survival, nil)
+		require.NoError(t, err)
+		stale.Timestamp = time.Now().Add(-handshakeMaxAge - time.Minute).Unix()
+		message, err := handshakeMessage(stale)
+		require.NoError(t, err)
+		stale.Signature, err = km.Sign("node-a", message)
+		require.NoError(t, err)
+
+		assert.ErrorContains(t, VerifyHandshake(verifier, stale), "check the clocks")
+	})
+
+	t.Run("answers carry the nonce they answer", func(t *testing.T) {
+		answer, err := freshHandshake(km, handshake, []byte("challenge"))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("challenge"), answer.Challenge)
+		assert.NotEqual(t, handshake.Nonce, answer.Nonce)
+		require.NoError(t, VerifyHandshake(verifier, answer))
+
+		answer.Challenge = []byte("another challenge")
+		assert.ErrorContains(t, VerifyHandshake(verifier, answer), "invalid handshake signature")
+	})
+
+	t.Run("nonces are taken once", func(t *testing.T) {
+		var nonces nonceCache
+		require.NoError(t, nonces.use(handshake.Nonce))
+		assert.ErrorContains(t, nonces.use(handshake.Nonce), "replayed")
+	})
+
+	t.Run("pack hash is signed", func(t *testing.T) {
+		packed := *survival
+		packed.PackHash = "c0ffee"
+		signed, err := NewHandshake(km, "node-a", &packed, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "c0ffee", worldFromProto(signed.World).PackHash)
+
+		signed.World.PackHash = "deadbeef"
+		assert.ErrorContains(t, VerifyHandshake(verifier, signed), "invalid handshake signature")
+	})
+
+	t.Run("banned servers are signed", func(t *testing.T) {
+		signed, err := NewHandshake(km, "node-a", survival, []string{"griefers.example.com"})
+		require.NoError(t, err)
+		require.NoError(t, VerifyHandshake(verifier, signed))
+
+		signed.BannedServers = nil
+		assert.ErrorContains(t, VerifyHandshake(verifier, signed), "invalid handshake signature")
+	})
+
+	t.Run("different key for a pinned address is rejected", func(t *testing.T) {
+		impostor, err := keys.New("impostor")
+		require.NoError(t, err)
+
+		forged, err := NewHandshake(impostor, "node-a", survival, nil)
+		require.NoError(t, err)
+
+		assert.ErrorContains(t, VerifyHandshake(verifier, forged), "does not match the pinned key")
+	})
+
+	t.Run("rotation not signed by the pinned key is rejected", func(t *testing.T) {
+		impostor, err := keys.New("node-a-impostor")
+		require.NoError(t, err)
+		_, err = impostor.Rotate()
+		require.NoError(t, err)
+
+		forged, err := NewHandshake(impostor, "node-a", survival, nil)
+		require.NoError(t, err)
+		forged.KeyRotation = impostor.Rotation().Marshal()
+
+		assert.ErrorContains(t, VerifyHandshake(verifier, forged), "does not match the pinned key")
+	})
+
+	t.Run("rotated key is repinned", func(t *testing.T) {
+		rotation, err := km.Rotate()
+		require.NoError(t, err)
+
+		rotated, err := NewHandshake(km, "node-a", survival, nil)
+		require.NoError(t, err)
+		assert.Equal(t, rotation.Marshal(), rotated.KeyRotation)
+		require.NoError(t, VerifyHandshake(verifier, rotated))
+
+		pinned, err := keys.LoadPublic("node-a")
+		require.NoError(t, err)
+		assert.Equal(t, rotated.PublicKey, pinned)
+
+		// The previous key is refused once the rotation was pinned
+		assert.ErrorContains(t, VerifyHandshake(verifier, handshake), "does not match the pinned key")
+	})
+}
+
+func TestPeers_Record(t *testing.T) {
+	peers := NewPeers(survival)
+
+	matching := peers.Record("b.example.com", []byte{1, 2}, &bds.WorldSettings{SeedHash: "seed", Difficulty: "normal", Gamemode: "survival", ForceGamemode: true})
+	assert.Empty(t, matching.Mismatches)
+	assert.Equal(t, "0102", matching.PublicKey)
+
+	creative := peers.Record("a.example.com", nil, &bds.WorldSettings{SeedHash: "seed", Difficulty: "normal", Gamemode: "creative", ForceGamemode: true})
+	assert.Equal(t, []string{`gamemode "survival" != "creative"`}, creative.Mismatches)
+
+	unknown := peers.Record("c.example.com", nil, nil)
+	assert.Equal(t, []string{"world settings not published"}, unknown.Mismatches)
+
+	list := peers.List()
+	require.Len(t, list, 3)
+	assert.Equal(t, "a.example.com", list[0].WebAddress)
+	assert.Equal(t, "c.example.com", list[2].WebAddress)
+}
+
+func TestPeerToProto(t *testing.T) {
+	build := buildinfo.Get()
+	build.GoVersion = "" // Not announced in handshakes
+	peer := Peer{
+		WebAddress:  "b.example.com",
+		PublicKey:   "0102",
+		ConnectedAt: time.Unix(0, 1700000000123456789),
+		World:       survival,
+		Mismatches:  []string{`difficulty "normal" != "peaceful"`},
+		Banned:      []string{"evil.example.com"},
+		Maintenance: "upgrading",
+		Address:     "b.example.com:50051",
+		Latency:     12.5,
+		Hash:        hashing.Default,
+		Build:       &build,
+	}
+
+	msg, err := PeerToProto(peer)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1, 2}, msg.GetPublicKey())
+
+	converted := PeerFromProto(msg)
+	assert.True(t, peer.ConnectedAt.Equal(converted.ConnectedAt))
+	converted.ConnectedAt = peer.ConnectedAt
+	assert.Equal(t, peer, converted)
+
+	_, err = PeerToProto(Peer{WebAddress: "c.example.com", PublicKey: "zz"})
+	assert.Error(t, err)
+}
+
+func TestJoin(t *testing.T) {
+	chdirTemp(t)
+
+	serverKeys, err := keys.New("server.example.com")
+	require.NoError(t, err)
+	serverDB, err := database.NewMemory()
+	require.NoError(t, err)
+	defer serverDB.Close()
+	require.NoError(t, serverDB.Put("alice", []byte(`[{"typeId":"minecraft:diamond","amount":1}]`), "server.example.com"))
+
+	serverHandshake, err := NewHandshake(serverKeys, "server.example.com", survival, nil)
+	require.NoError(t, err)
+	serverPeers := NewPeers(survival)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := NewServer(serverHandshake, serverKeys, serverDB, serverPeers)
+	server.SetAllowlist([]string{"client.example.com"})
+	go server.Serve(listener)
+	defer server.Stop()
+
+	clientKeys, err := keys.New("client.example.com")
+	require.NoError(t, err)
+	clientDB, err := database.NewMemory()
+	require.NoError(t, err)
+	defer clientDB.Close()
+
+	peaceful := &bds.WorldSettings{SeedHash: "seed", Difficulty: "peaceful", Gamemode: "survival", ForceGamemode: true}
+	clientHandshake, err := NewHandshake(clientKeys, "client.example.com", peaceful, nil)
+	require.NoError(t, err)
+	clientPeers := NewPeers(peaceful)
+
+	changed, err := Join(context.Background(), listener.Addr().String(), clientHandshake, clientKeys, clientDB, clientPeers)
+	require.NoError(t, err)
+	assert.Equal(t, 1, changed)
+
+	inventory, err := clientDB.Get("alice")
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"typeId":"minecraft:diamond","amount":1}]`, string(inventory))
+
+	// Both sides see the difficulty mismatch
+	require.Len(t, serverPeers.List(), 1)
+	assert.Equal(t, "client.example.com", serverPeers.List()[0].WebAddress)
+	assert.Equal(t, []string{`difficulty "normal" != "peaceful"`}, serverPeers.List()[0].Mismatches)
+
+	require.Len(t, clientPeers.List(), 1)
+	assert.Equal(t, []string{`difficulty "peaceful" != "normal"`}, clientPeers.List()[0].Mismatches)
+
+	// Both sides learn the build of the other
+	for _, peer := range []Peer{serverPeers.List()[0], clientPeers.List()[0]} {
+		require.NotNil(t, peer.Build)
+		assert.Equal(t, buildinfo.Get().Version, peer.Build.Version)
+		assert.Equal(t, buildinfo.Protocols, peer.Build.Protocols)
+	}
+}
+
+func TestProbe(t *testing.T) {
+	chdirTemp(t)
+
+	serverKeys, err := keys.New("server.example.com")
+	require.NoError(t, err)
+	serverDB, err := database.NewMemory()
+	require.NoError(t, err)
+	defer serverDB.Close()
+	require.NoError(t, serverDB.Put("alice", []byte(`[]`), "server.example.com"))
+
+	serverHandshake, err := NewHandshake(serverKeys, "server.example.com", survival, nil)
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := NewServer(serverHandshake, serverKeys, serverDB, NewPeers(survival))
+	go server.Serve(listener)
+	defer server.Stop()
+
+	clientKeys, err := keys.New("client.example.com")
+	require.NoError(t, err)
+	clientHandshake, err := NewHandshake(clientKeys, "client.example.com", survival, nil)
+	require.NoError(t, err)
+
+	peer, rtt, err := Probe(context.Background(), listener.Addr().String(), clientHandshake, clientKeys)
+	require.NoError(t, err)
+	assert.Equal(t, "server.example.com", peer)
+	assert.Positive(t, rtt)
+
+	t.Run("UnreachablePeer", func(t *testing.T) {
+		closed, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		address := closed.Addr().String()
+		closed.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_, _, err = Probe(ctx, address, clientHandshake, clientKeys)
+		assert.Error(t, err)
+	})
+}
+
+func TestJoin_Bans(t *testing.T) {
+	chdirTemp(t)
+
+	serverKeys, err := keys.New("server.example.com")
+	require.NoError(t, err)
+	serverDB, err := database.NewMemory()
+	require.NoError(t, err)
+	defer serverDB.Close()
+
+	serverHandshake, err := NewHandshake(serverKeys, "server.example.com", survival, []string{"griefers.example.com"})
+	require.NoError(t, err)
+	serverPeers := NewPeers(survival)
+	serverPeers.SetBans(NewBans(BanManual, []string{"griefers.example.com"}, func(string) error { return nil }))
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := NewServer(serverHandshake, serverKeys, serverDB, serverPeers)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	// The client was offline when griefers.example.com got banned and still holds its items
+	clientKeys, err := keys.New("client.example.com")
+	require.NoError(t, err)
+	clientDB, err := database.NewMemory()
+	require.NoError(t, err)
+	defer clientDB.Close()
+	require.NoError(t, clientDB.Put("alice", []byte(`[{"typeId":"minecraft:diamond","amount":1}]`), "griefers.example.com"))
+
+	clientHandshake, err := NewHandshake(clientKeys, "client.example.com", survival, nil)
+	require.NoError(t, err)
+	clientPeers := NewPeers(survival)
+	clientPeers.SetBans(NewBans(BanUnion, nil, func(server string) error {
+		return clientDB.Delete(server, true)
+	}))
+
+	_, err = Join(context.Background(), listener.Addr().String(), clientHandshake, clientKeys, clientDB, clientPeers)
+	require.NoError(t, err)
+
+	// The joining node learns the ban and removes the banned server's items
+	assert.Equal(t, []string{"griefers.example.com"}, clientPeers.Bans().List())
+	_, err = clientDB.Get("alice")
+	assert.ErrorIs(t, err, database.ErrPlayerNotFound)
+
+	// The serving node only reports the difference under the manual policy
+	reports := serverPeers.Bans().Reports()
+	require.Len(t, reports, 1)
+	assert.Equal(t, []string{"griefers.example.com"}, reports[0].NotBannedBy)
+	assert.Equal(t, []string{"griefers.example.com"}, clientPeers.List()[0].Banned)
+}
+
+func TestJoin_HashNegotiation(t *testing.T) {
+	chdirTemp(t)
+
+	serverKeys, err := keys.New("server.example.com")
+	require.NoError(t, err)
+	serverDB, err := database.NewMemory()
+	require.NoError(t, err)
+	defer serverDB.Close()
+
+	preferred := []hashing.Algorithm{hashing.BLAKE3, hashing.SHA256}
+	serverHandshake, err := NewHandshake(serverKeys, "server.example.com", survival, nil)
+	require.NoError(t, err)
+	serverHandshake.HashAlgorithms = hashing.Names(preferred)
+	serverPeers := NewPeers(survival)
+	serverPeers.SetHashAlgorithms(preferred)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := NewServer(serverHandshake, serverKeys, serverDB, serverPeers)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	join := func(t *testing.T, webAddress string, algorithms []hashing.Algorithm) *Peers {
+		clientKeys, err := keys.New(webAddress)
+		require.NoError(t, err)
+		clientDB, err := database.NewMemory()
+		require.NoError(t, err)
+		defer clientDB.Close()
+
+		clientHandshake, err := NewHandshake(clientKeys, webAddress, survival, nil)
+		require.NoError(t, err)
+		clientPeers := NewPeers(survival)
+		if algorithms != nil {
+			clientHandshake.HashAlgorithms = hashing.Names(algorithms)
+			clientPeers.SetHashAlgorithms(algorithms)
+		}
+
+		_, err = Join(context.Background(), listener.Addr().String(), clientHandshake, clientKeys, clientDB, clientPeers)
+		require.NoError(t, err)
+		return clientPeers
+	}
+
+	t.Run("both support blake3", func(t *testing.T) {
+		clientPeers := join(t, "new.example.com", preferred)
+		assert.Equal(t, hashing.BLAKE3, clientPeers.HashAlgorithm("server.example.com"))
+		assert.Equal(t, hashing.BLAKE3, serverPeers.HashAlgorithm("new.example.com"))
+	})
+
+	t.Run("peer without algorithms", func(t *testing.T) {
+		clientPeers := join(t, "old.example.com", nil)
+		assert.Equal(t, hashing.SHA256, clientPeers.HashAlgorithm("server.example.com"))
+		assert.Equal(t, hashing.SHA256, serverPeers.HashAlgorithm("old.example.com"))
+	})
+}
+
+func TestJoin_OriginQuorum(t *testing.T) {
+	chdirTemp(t)
+
+	serverKeys, err := keys.New("server.example.com")
+	require.NoError(t, err)
+	serverDB, err := database.NewMemory()
+	require.NoError(t, err)
+	defer serverDB.Close()
+
+	serverHandshake, err := NewHandshake(serverKeys, "server.example.com", survival, nil)
+	require.NoError(t, err)
+	serverQuorum, err := database.NewOriginQuorum("server.example.com", 2, time.Hour, "")
+	require.NoError(t, err)
+	serverQuorum.Seen("far.example.com", time.Now())
+	serverPeers := NewPeers(survival)
+	serverPeers.SetOriginQuorum(serverQuorum)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := NewServer(serverHandshake, serverKeys, serverDB, serverPeers)
+	server.SetAllowlist([]string{"client.example.com"})
+	go server.Serve(listener)
+	defer server.Stop()
+
+	clientKeys, err := keys.New("client.example.com")
+	require.NoError(t, err)
+	clientDB, err := database.NewMemory()
+	require.NoError(t, err)
+	defer clientDB.Close()
+
+	clientHandshake, err := NewHandshake(clientKeys, "client.example.com", survival, nil)
+	require.NoError(t, err)
+	clientQuorum, err := database.NewOriginQuorum("client.example.com", 2, time.Hour, "")
+	require.NoError(t, err)
+	clientQuorum.Seen("far.example.com", time.Now())
+	clientPeers := NewPeers(survival)
+	clientPeers.SetOriginQuorum(clientQuorum)
+
+	_, err = Join(context.Background(), listener.Addr().String(), clientHandshake, clientKeys, clientDB, clientPeers)
+	require.NoError(t, err)
+
+	// Each side saw far.example.com itself and heard of it from the other
+	assert.Equal(t, 2, clientQuorum.Witnesses("far.example.com", time.Now()))
+	assert.Equal(t, 2, serverQuorum.Witnesses("far.example.com", time.Now()))
+	assert.Equal(t, 1, clientQuorum.Witnesses("server.example.com", time.Now()))
+	assert.Equal(t, 1, serverQuorum.Witnesses("client.example.com", time.Now()))
+
+	t.Run("fresh identities do not vouch for a ghost", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			webAddress := fmt.Sprintf("sybil%d.example.com", i)
+			sybilKeys, err := keys.New(webAddress)
+			require.NoError(t, err)
+			sybilHandshake, err := NewHandshake(sybilKeys, webAddress, survival, nil)
+			require.NoError(t, err)
+			sybilQuorum, err := database.NewOriginQuorum(webAddress, 1, time.Hour, "")
+			require.NoError(t, err)
+			sybilQuorum.Seen("ghost.example.com", time.Now())
+			sybilPeers := NewPeers(survival)
+			sybilPeers.SetOriginQuorum(sybilQuorum)
+
+			_, err = Join(context.Background(), listener.Addr().String(), sybilHandshake, sybilKeys, clientDB, sybilPeers)
+			require.NoError(t, err)
+			assert.Zero(t, serverQuorum.Witnesses(webAddress, time.Now()))
+		}
+
+		assert.Zero(t, serverQuorum.Witnesses("ghost.example.com", time.Now()))
+		assert.False(t, serverQuorum.Admitted("ghost.example.com", time.Now()))
+	})
+
+	t.Run("seen servers are signed with the handshake", func(t *testing.T) {
+		handshake, err := freshHandshake(clientKeys, clientPeers.withSightings(clientHandshake), nil)
+		require.NoError(t, err)
+		require.NoError(t, VerifyHandshake(serverKeys, handshake))
+
+		handshake.SeenServers = append(handshake.SeenServers, "ghost.example.com")
+		assert.ErrorContains(t, VerifyHandshake(serverKeys, handshake), "invalid handshake signature")
+	})
+}
+
+func TestJoin_Maintenance(t *testing.T) {
+	chdirTemp(t)
+
+	serverKeys, err := keys.New("server.example.com")
+	require.NoError(t, err)
+	serverDB, err := database.NewMemory()
+	require.NoError(t, err)
+	defer serverDB.Close()
+	require.NoError(t, serverDB.Put("alice", []byte(`[{"typeId":"minecraft:diamond","amount":1}]`), "server.example.com"))
+
+	serverHandshake, err := NewHandshake(serverKeys, "server.example.com", survival, nil)
+	require.NoError(t, err)
+	serverPeers := NewPeers(survival)
+	maintenance := NewMaintenance(nil)
+	require.NoError(t, maintenance.Set(true, "upgrade"))
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := NewServer(serverHandshake, serverKeys, serverDB, serverPeers)
+	server.SetMaintenance(maintenance)
+	server.SetAllowlist([]string{"client.example.com"})
+	go server.Serve(listener)
+	defer server.Stop()
+
+	clientKeys, err := keys.New("client.example.com")
+	require.NoError(t, err)
+	clientDB, err := database.NewMemory()
+	require.NoError(t, err)
+	defer clientDB.Close()
+
+	clientHandshake, err := NewHandshake(clientKeys, "client.example.com", survival, nil)
+	require.NoError(t, err)
+	clientPeers := NewPeers(survival)
+	clientMaintenance := NewMaintenance(nil)
+	require.NoError(t, clientMaintenance.Set(true, "investigation"))
+
+	// A node in maintenance commits nothing to its peers
+	changed, err := Join(WithMaintenance(context.Background(), clientMaintenance), listener.Addr().String(), clientHandshake, clientKeys, clientDB, clientPeers)
+	require.NoError(t, err)
+	assert.Zero(t, changed)
+	_, err = clientDB.Get("alice")
+	assert.ErrorIs(t, err, database.ErrPlayerNotFound)
+
+	// Both sides flag the other as being in maintenance
+	assert.Equal(t, "upgrade", clientPeers.List()[0].Maintenance)
+	assert.Equal(t, "investigation", serverPeers.List()[0].Maintenance)
+
+	// Once maintenance is over the snapshot streams again and the flag is cleared
+	require.NoError(t, maintenance.Set(false, ""))
+	changed, err = Join(context.Background(), listener.Addr().String(), clientHandshake, clientKeys, clientDB, clientPeers)
+	require.NoError(t, err)
+	assert.Equal(t, 1, changed)
+	assert.Empty(t, clientPeers.List()[0].Maintenance)
+}
+
+func TestServer_PeerAuthentication(t *testing.T) {
+	chdirTemp(t)
+
+	serverKeys, err := keys.New("server.example.com")
+	require.NoError(t, err)
+	serverDB, err := database.NewMemory()
+	require.NoError(t, err)
+	defer serverDB.Close()
+	require.NoError(t, serverDB.Put("alice", []byte(`[]`), "server.example.com"))
+
+	serverHandshake, err := NewHandshake(serverKeys, "server.example.com", survival, nil)
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := NewServer(serverHandshake, serverKeys, serverDB, NewPeers(survival))
+	server.SetAllowlist([]string{"friend.example.com"})
+	go server.Serve(listener)
+	defer server.Stop()
+	address := listener.Addr().String()
+
+	clientKeys, err := keys.New("client.example.com")
+	require.NoError(t, err)
+	clientDB, err := database.NewMemory()
+	require.NoError(t, err)
+	defer clientDB.Close()
+	clientHandshake, err := NewHandshake(clientKeys, "client.example.com", survival, nil)
+	require.NoError(t, err)
+
+	// register sends a handshake as it is over a connection authenticated with km
+	register := func(km *keys.KeyManager, handshake *pb.RegisterNodeRequest) error {
+		conn, err := dial(address, km)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		stream, err := pb.NewConsensusCraftServiceClient(conn).RegisterNode(context.Background(), handshake)
+		require.NoError(t, err)
+		for {
+			if _, err := stream.Recv(); err != nil {
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				return err
+			}
+		}
+	}
+
+	t.Run("PeersOffTheAllowlistGetNoSnapshot", func(t *testing.T) {
+		changed, err := Join(context.Background(), address, clientHandshake, clientKeys, clientDB, NewPeers(survival))
+		require.NoError(t, err)
+		assert.Zero(t, changed)
+		_, err = clientDB.Get("alice")
+		assert.ErrorIs(t, err, database.ErrPlayerNotFound)
+
+		_, err = GetPlayers(context.Background(), address, "client.example.com", clientKeys, []string{"alice"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(errors.Unwrap(err)))
+	})
+
+	t.Run("ReplayedHandshakesAreRejected", func(t *testing.T) {
+		fresh, err := freshHandshake(clientKeys, clientHandshake, nil)
+		require.NoError(t, err)
+		require.NoError(t, register(clientKeys, fresh))
+		assert.Equal(t, codes.PermissionDenied, status.Code(register(clientKeys, fresh)))
+
+		// A captured handshake is useless without the key that signed it
+		eavesdropper, err := keys.New("eavesdropper.example.com")
+		require.NoError(t, err)
+		captured, err := freshHandshake(clientKeys, clientHandshake, nil)
+		require.NoError(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(register(eavesdropper, captured)))
+	})
+
+	t.Run("PushesOverAnotherKeyAreRejected", func(t *testing.T) {
+		impostor, err := keys.New("impostor.example.com")
+		require.NoError(t, err)
+		require.NoError(t, clientDB.Put("bob", []byte(`[]`), "client.example.com"))
+
+		_, err = Push(context.Background(), address, "client.example.com", impostor, clientDB, []string{"bob"})
+		assert.ErrorContains(t, err, "not authenticated with the key of client.example.com")
+	})
+}
+
+func TestPush(t *testing.T) {
+	chdirTemp(t)
+
+	serverKeys, err := keys.New("server.example.com")
+	require.NoError(t, err)
+	serverDB, err := database.NewMemory()
+	require.NoError(t, err)
+	defer serverDB.Close()
+
+	serverHandshake, err := NewHandshake(serverKeys, "server.example.com", survival, nil)
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := NewServer(serverHandshake, serverKeys, serverDB, NewPeers(survival))
+	go server.Serve(listener)
+	defer server.Stop()
+
+	clientKeys, err := keys.New("client.example.com")
+	require.NoError(t, err)
+	clientDB, err := database.NewMemory()
+	require.NoError(t, err)
+	defer clientDB.Close()
+	require.NoError(t, clientDB.Put("alice", []byte(`[{"typeId":"minecraft:emerald","amount":3}]`), "client.example.com"))
+
+	t.Run("peer without handshake is rejected", func(t *testing.T) {
+		_, err := Push(context.Background(), listener.Addr().String(), "stranger.example.com", clientKeys, clientDB, []string{"alice"})
+		assert.ErrorContains(t, err, "has not completed the handshake")
+	})
+
+	clientHandshake, err := NewHandshake(clientKeys, "client.example.com", survival, nil)
+	require.NoError(t, err)
+	_, err = Join(context.Background(), listener.Addr().String(), clientHandshake, clientKeys, clientDB, NewPeers(survival))
+	require.NoError(t, err)
+
+	t.Run("handshaked peer that is not allowed is rejected", func(t *testing.T) {
+		_, err := Push(context.Background(), listener.Addr().String(), "client.example.com", clientKeys, clientDB, []string{"alice"})
+		assert.ErrorContains(t, err, "is not on PEER_ALLOWLIST")
+
+		_, err = serverDB.Get("alice")
+		assert.ErrorIs(t, err, database.ErrPlayerNotFound)
+	})
+
+	server.SetAllowlist([]string{"client.example.com"})
+
+	t.Run("queued players are stored by the peer", func(t *testing.T) {
+		pushed, err := Push(context.Background(), listener.Addr().String(), "client.example.com", clientKeys, clientDB, []string{"alice", "unknown"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, pushed)
+
+		inventory, err := serverDB.Get("alice")
+		require.NoError(t, err)
+		assert.JSONEq(t, `[{"typeId":"minecraft:emerald","amount":3}]`, string(inventory))
+	})
+
+	t.Run("retried updates are stored once", func(t *testing.T) {
+		require.NoError(t, clientDB.PutWithID("bob", []byte(`[]`), "client.example.com", nil, "run1:1"))
+		for i := 0; i < 2; i++ {
+			pushed, err := Push(context.Background(), listener.Addr().String(), "client.example.com", clientKeys, clientDB, []string{"bob"})
+			require.NoError(t, err)
+			assert.Equal(t, 1, pushed)
+		}
+
+		entries, err := serverDB.GetPlayerInventories("bob")
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, "run1:1", entries[0].UpdateID)
+	})
+
+	t.Run("forged signature is rejected", func(t *testing.T) {
+		impostor, err := keys.New("impostor")
+		require.NoError(t, err)
+
+		_, err = Push(context.Background(), listener.Addr().String(), "client.example.com", impostor, clientDB, []string{"alice"})
+		assert.ErrorContains(t, err, "PermissionDenied")
+	})
+}
+
+// spanRecorder keeps the spans exported by the global tracer
+type spanRecorder struct {
+	mu    sync.Mutex
+	spans []*tracing.Span
+}
+
+func (r *spanRecorder) Export(spans []*tracing.Span) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.spans = append(r.spans, spans...)
+	return nil
+}
+
+func TestJoin_PropagatesTrace(t *testing.T) {
+	chdirTemp(t)
+	recorder := &spanRecorder{}
+	tracing.Init(recorder)
+
+	serverKeys, err := keys.New("server.example.com")
+	require.NoError(t, err)
+	serverDB, err := database.NewMemory()
+	require.NoError(t, err)
+	defer serverDB.Close()
+
+	serverHandshake, err := NewHandshake(serverKeys, "server.example.com", survival, nil)
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := NewServer(serverHandshake, serverKeys, serverDB, NewPeers(survival))
+	server.SetAllowlist([]string{"client.example.com"})
+	go server.Serve(listener)
+	defer server.Stop()
+
+	clientKeys, err := keys.New("client.example.com")
+	require.NoError(t, err)
+	clientDB, err := database.NewMemory()
+	require.NoError(t, err)
+	defer clientDB.Close()
+	require.NoError(t, clientDB.Put("alice", []byte(`[{"typeId":"minecraft:emerald","amount":3}]`), "client.example.com"))
+
+	clientHandshake, err := NewHandshake(clientKeys, "client.example.com", survival, nil)
+	require.NoError(t, err)
+	_, err = Join(context.Background(), listener.Addr().String(), clientHandshake, clientKeys, clientDB, NewPeers(survival))
+	require.NoError(t, err)
+	_, err = Push(context.Background(), listener.Addr().String(), "client.example.com", clientKeys, clientDB, []string{"alice"})
+	require.NoError(t, err)
+	tracing.Shutdown()
+
+	byName := make(map[string]*tracing.Span)
+	for _, span := range recorder.spans {
+		byName[span.Name] = span
+	}
+
+	// The server side of each call is a child of the client span, in the same trace
+	for client, server := range map[string]string{"network.join": "network.register_node", "network.push": "network.store_inventory"} {
+		require.Contains(t, byName, client)
+		require.Contains(t, byName, server)
+		assert.Equal(t, byName[client].TraceIDHex(), byName[server].TraceIDHex(), server)
+		assert.Equal(t, byName[client].SpanID, byName[server].ParentID, server)
+	}
+	assert.NotEqual(t, byName["network.join"].TraceIDHex(), byName["network.push"].TraceIDHex())
+}
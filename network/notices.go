@@ -0,0 +1,216 @@
+package network
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/d1nch8g/consensuscraft/gen/pb"
+	"github.com/d1nch8g/consensuscraft/keys"
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// noticesHeader carries the serving node's notices back to a registering peer
+const noticesHeader = "notices-bin"
+
+// Limits of operator notices, they are short coordination messages rather than a chat
+const (
+	MaxNoticeText   = 500
+	MaxNoticeAuthor = 64
+	noticeTTL       = 7 * 24 * time.Hour // Own notices are sent on every sync this long
+	maxNotices      = 200                // Notices kept, the oldest are dropped first
+)
+
+var ErrInvalidNotice = errors.New("invalid notice")
+
+// Notice is an operator notice sent from this node or received from a peer
+type Notice struct {
+	ID         string    `json:"id"`
+	From       string    `json:"from"` // Web address of the node the notice was written on
+	Author     string    `json:"author"`
+	Text       string    `json:"text"`
+	SentAt     time.Time `json:"sent_at"`
+	ReceivedAt time.Time `json:"received_at"`
+	Local      bool      `json:"local"` // Written by an operator of this node
+}
+
+// Notices keeps the operator notices written here, which are delivered to peers on every sync,
+// and the notices received from peers, each signed by the node it was written on
+type Notices struct {
+	mu         sync.Mutex
+	km         *keys.KeyManager
+	webAddress string
+	outbox     []*pb.OperatorNotice
+	notices    map[string]Notice
+}
+
+// NewNotices creates the notice channel of the node at webAddress, signing with km
+func NewNotices(km *keys.KeyManager, webAddress string) *Notices {
+	return &Notices{
+		km:         km,
+		webAddress: webAddress,
+		notices:    make(map[string]Notice),
+	}
+}
+
+// Send signs a notice by author and queues it for every peer this node syncs with
+func (n *Notices) Send(author, text string) (Notice, error) {
+	author, text = strings.TrimSpace(author), strings.TrimSpace(text)
+	if err := checkNotice(author, text); err != nil {
+		return Notice{}, err
+	}
+
+	msg := &pb.OperatorNotice{WebAddress: n.webAddress, Author: author, Text: text, SentAt: time.Now().Unix()}
+	signature, err := n.km.Sign(n.webAddress, noticeMessage(msg))
+	if err != nil {
+		return Notice{}, fmt.Errorf("failed to sign notice: %w", err)
+	}
+	msg.Signature = signature
+
+	notice := noticeFromProto(msg, true)
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.outbox = append(n.outbox, msg)
+	n.store(notice)
+
+	return notice, nil
+}
+
+// List returns the notices sent and received, newest first
+func (n *Notices) List() []Notice {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	list := make([]Notice, 0, len(n.notices))
+	for _, notice := range n.notices {
+		list = append(list, notice)
+	}
+	sortNotices(list)
+
+	return list
+}
+
+// outgoing returns the notices written here within the last week, for delivery to a peer
+func (n *Notices) outgoing() []*pb.OperatorNotice {
+	if n == nil {
+		return nil
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	cutoff := time.Now().Add(-noticeTTL).Unix()
+	kept := n.outbox[:0]
+	for _, msg := range n.outbox {
+		if msg.GetSentAt() >= cutoff {
+			kept = append(kept, msg)
+		}
+	}
+	n.outbox = kept
+
+	return append([]*pb.OperatorNotice(nil), kept...)
+}
+
+// receive stores the notices a handshaked peer delivered, only notices written on that peer and
+// signed with its pinned key are accepted
+func (n *Notices) receive(peer string, msgs []*pb.OperatorNotice) {
+	if n == nil || len(msgs) == 0 {
+		return
+	}
+
+	publicKey, err := keys.LoadPublic(peer)
+	if err != nil {
+		logger.Warnf("Ignored notices of %s, its key is not pinned: %v", peer, err)
+		return
+	}
+
+	for _, msg := range msgs {
+		if msg.GetWebAddress() != peer {
+			logger.Warnf("Ignored notice relayed by %s from %s", peer, msg.GetWebAddress())
+			continue
+		}
+		if err := checkNotice(msg.GetAuthor(), msg.GetText()); err != nil {
+			logger.Warnf("Ignored notice from %s: %v", peer, err)
+			continue
+		}
+		if err := keys.VerifyPublic(publicKey, peer, noticeMessage(msg), msg.GetSignature()); err != nil {
+			logger.Warnf("Ignored notice from %s with an invalid signature: %v", peer, err)
+			continue
+		}
+
+		notice := noticeFromProto(msg, false)
+		n.mu.Lock()
+		if _, seen := n.notices[notice.ID]; !seen {
+			n.store(notice)
+			logger.Infof("Notice from %s on %s: %s", notice.Author, notice.From, notice.Text)
+		}
+		n.mu.Unlock()
+	}
+}
+
+// store keeps a notice, dropping the oldest beyond maxNotices, n.mu must be held
+func (n *Notices) store(notice Notice) {
+	n.notices[notice.ID] = notice
+	if len(n.notices) <= maxNotices {
+		return
+	}
+
+	list := make([]Notice, 0, len(n.notices))
+	for _, notice := range n.notices {
+		list = append(list, notice)
+	}
+	sortNotices(list)
+	for _, old := range list[maxNotices:] {
+		delete(n.notices, old.ID)
+	}
+}
+
+// checkNotice validates the author and text of a notice
+func checkNotice(author, text string) error {
+	switch {
+	case author == "" || text == "":
+		return fmt.Errorf("%w: author and text are required", ErrInvalidNotice)
+	case utf8.RuneCountInString(author) > MaxNoticeAuthor:
+		return fmt.Errorf("%w: author longer than %d characters", ErrInvalidNotice, MaxNoticeAuthor)
+	case utf8.RuneCountInString(text) > MaxNoticeText:
+		return fmt.Errorf("%w: text longer than %d characters", ErrInvalidNotice, MaxNoticeText)
+	case !utf8.ValidString(author) || !utf8.ValidString(text):
+		return fmt.Errorf("%w: not valid UTF-8", ErrInvalidNotice)
+	}
+	return nil
+}
+
+// noticeMessage is the signed part of a notice, prefixed so it can not pass for another signed message
+func noticeMessage(msg *pb.OperatorNotice) []byte {
+	return []byte(strings.Join([]string{"notice", msg.GetAuthor(), msg.GetText(), strconv.FormatInt(msg.GetSentAt(), 10)}, "\x00"))
+}
+
+func noticeFromProto(msg *pb.OperatorNotice, local bool) Notice {
+	sum := sha256.Sum256(append([]byte(msg.GetWebAddress()+"\x00"), noticeMessage(msg)...))
+	return Notice{
+		ID:         hex.EncodeToString(sum[:8]),
+		From:       msg.GetWebAddress(),
+		Author:     msg.GetAuthor(),
+		Text:       msg.GetText(),
+		SentAt:     time.Unix(msg.GetSentAt(), 0).UTC(),
+		ReceivedAt: time.Now().UTC(),
+		Local:      local,
+	}
+}
+
+// sortNotices orders notices newest first
+func sortNotices(list []Notice) {
+	sort.Slice(list, func(i, j int) bool {
+		if !list[i].SentAt.Equal(list[j].SentAt) {
+			return list[i].SentAt.After(list[j].SentAt)
+		}
+		return list[i].ID < list[j].ID
+	})
+}
@@ -0,0 +1,180 @@
+package network
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/gen/pb"
+	"github.com/d1nch8g/consensuscraft/keys"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestNotices_Send(t *testing.T) {
+	chdirTemp(t)
+
+	km, err := keys.New("a.example.com")
+	require.NoError(t, err)
+	notices := NewNotices(km, "a.example.com")
+
+	notice, err := notices.Send(" alice ", "Downtime on Saturday 10:00 UTC")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", notice.Author)
+	assert.Equal(t, "a.example.com", notice.From)
+	assert.True(t, notice.Local)
+	assert.Equal(t, []Notice{notice}, notices.List())
+
+	outgoing := notices.outgoing()
+	require.Len(t, outgoing, 1)
+	assert.NoError(t, km.Verify("a.example.com", noticeMessage(outgoing[0]), outgoing[0].GetSignature()))
+
+	tests := map[string]struct {
+		author string
+		text   string
+	}{
+		"no author":   {author: " ", text: "hello"},
+		"no text":     {author: "alice", text: ""},
+		"long author": {author: strings.Repeat("a", MaxNoticeAuthor+1), text: "hello"},
+		"long text":   {author: "alice", text: strings.Repeat("a", MaxNoticeText+1)},
+		"invalid":     {author: "alice", text: "\xff"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := notices.Send(tt.author, tt.text)
+			assert.ErrorIs(t, err, ErrInvalidNotice)
+		})
+	}
+}
+
+func TestNotices_Outgoing(t *testing.T) {
+	chdirTemp(t)
+
+	km, err := keys.New("a.example.com")
+	require.NoError(t, err)
+	notices := NewNotices(km, "a.example.com")
+
+	_, err = notices.Send("alice", "fresh")
+	require.NoError(t, err)
+	notices.outbox = append(notices.outbox, &pb.OperatorNotice{WebAddress: "a.example.com", Author: "alice", Text: "stale", SentAt: time.Now().Add(-noticeTTL - time.Hour).Unix()})
+
+	outgoing := notices.outgoing()
+	require.Len(t, outgoing, 1)
+	assert.Equal(t, "fresh", outgoing[0].GetText())
+
+	var disabled *Notices
+	assert.Nil(t, disabled.outgoing())
+}
+
+func TestNotices_Receive(t *testing.T) {
+	chdirTemp(t)
+
+	peerKeys, err := keys.New("b.example.com")
+	require.NoError(t, err)
+	peer := NewNotices(peerKeys, "b.example.com")
+	_, err = peer.Send("bob", "Incident: restored a backup, expect rollbacks")
+	require.NoError(t, err)
+	signed := peer.outgoing()[0]
+	publicKey, err := peerKeys.Public()
+	require.NoError(t, err)
+
+	// The local node keeps its keys apart from the peer's
+	chdirTemp(t)
+	localKeys, err := keys.New("a.example.com")
+	require.NoError(t, err)
+	notices := NewNotices(localKeys, "a.example.com")
+
+	// Nothing is accepted before the peer key is pinned by a handshake
+	notices.receive("b.example.com", []*pb.OperatorNotice{signed})
+	assert.Empty(t, notices.List())
+
+	require.NoError(t, localKeys.Save("b.example.com", publicKey))
+
+	tampered := proto.Clone(signed).(*pb.OperatorNotice)
+	tampered.Text = "Incident: nothing happened"
+	relayed := proto.Clone(signed).(*pb.OperatorNotice)
+	relayed.WebAddress = "c.example.com"
+
+	notices.receive("b.example.com", []*pb.OperatorNotice{tampered, relayed, signed, signed})
+	received := notices.List()
+	require.Len(t, received, 1)
+	assert.Equal(t, "b.example.com", received[0].From)
+	assert.Equal(t, "bob", received[0].Author)
+	assert.Equal(t, signed.GetText(), received[0].Text)
+	assert.False(t, received[0].Local)
+
+	// Received notices are shown here but not forwarded to other peers
+	assert.Empty(t, notices.outgoing())
+}
+
+func TestNotices_Limit(t *testing.T) {
+	notices := NewNotices(nil, "a.example.com")
+
+	start := time.Now().Add(-time.Hour).Unix()
+	for i := range maxNotices + 10 {
+		notices.store(noticeFromProto(&pb.OperatorNotice{WebAddress: "b.example.com", Author: "bob", Text: "notice", SentAt: start + int64(i)}, false))
+	}
+
+	list := notices.List()
+	require.Len(t, list, maxNotices)
+	assert.Equal(t, time.Unix(start+maxNotices+9, 0).UTC(), list[0].SentAt)
+	assert.Equal(t, time.Unix(start+10, 0).UTC(), list[len(list)-1].SentAt)
+}
+
+func TestJoin_Notices(t *testing.T) {
+	chdirTemp(t)
+
+	serverKeys, err := keys.New("server.example.com")
+	require.NoError(t, err)
+	serverDB, err := database.NewMemory()
+	require.NoError(t, err)
+	defer serverDB.Close()
+
+	serverHandshake, err := NewHandshake(serverKeys, "server.example.com", survival, nil)
+	require.NoError(t, err)
+	serverPeers := NewPeers(survival)
+	serverPeers.SetNotices(NewNotices(serverKeys, "server.example.com"))
+	_, err = serverPeers.Notices().Send("alice", "Planned downtime tonight")
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := NewServer(serverHandshake, serverKeys, serverDB, serverPeers)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	clientKeys, err := keys.New("client.example.com")
+	require.NoError(t, err)
+	clientDB, err := database.NewMemory()
+	require.NoError(t, err)
+	defer clientDB.Close()
+
+	clientHandshake, err := NewHandshake(clientKeys, "client.example.com", survival, nil)
+	require.NoError(t, err)
+	clientPeers := NewPeers(survival)
+	clientPeers.SetNotices(NewNotices(clientKeys, "client.example.com"))
+	_, err = clientPeers.Notices().Send("bob", "Incident resolved")
+	require.NoError(t, err)
+
+	_, err = Join(context.Background(), listener.Addr().String(), clientHandshake, clientKeys, clientDB, clientPeers)
+	require.NoError(t, err)
+
+	// Notices travel both ways, and the handshake shared by every join is left untouched
+	assert.Empty(t, clientHandshake.GetNotices())
+	serverNotices := serverPeers.Notices().List()
+	require.Len(t, serverNotices, 2)
+	assert.Contains(t, []string{serverNotices[0].Text, serverNotices[1].Text}, "Incident resolved")
+	clientNotices := clientPeers.Notices().List()
+	require.Len(t, clientNotices, 2)
+	assert.Contains(t, []string{clientNotices[0].Text, clientNotices[1].Text}, "Planned downtime tonight")
+
+	// Joining again does not duplicate notices
+	_, err = Join(context.Background(), listener.Addr().String(), clientHandshake, clientKeys, clientDB, clientPeers)
+	require.NoError(t, err)
+	assert.Len(t, serverPeers.Notices().List(), 2)
+	assert.Len(t, clientPeers.Notices().List(), 2)
+}
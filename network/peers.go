@@ -0,0 +1,306 @@
+package network
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/bds"
+	"github.com/d1nch8g/consensuscraft/buildinfo"
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/gen/pb"
+	"github.com/d1nch8g/consensuscraft/hashing"
+	"google.golang.org/protobuf/proto"
+)
+
+// Peer is a node that completed the handshake with this node
+type Peer struct {
+	WebAddress  string             `json:"web_address"`
+	PublicKey   string             `json:"public_key"`
+	ConnectedAt time.Time          `json:"connected_at"`
+	World       *bds.WorldSettings `json:"world,omitempty"`
+	Mismatches  []string           `json:"mismatches,omitempty"`  // World settings differing from the local ones
+	Banned      []string           `json:"banned,omitempty"`      // Servers the peer bans
+	Maintenance string             `json:"maintenance,omitempty"` // Reason the peer is in maintenance, empty when it is not
+	Address     string             `json:"address,omitempty"`     // Address this node joined the peer at, empty for peers that only joined us
+	Latency     float64            `json:"latency_ms,omitempty"`  // Last measured round trip to the peer in milliseconds
+	Hash        hashing.Algorithm  `json:"hash_algorithm"`        // Hash algorithm negotiated with the peer in the handshake
+	Build       *buildinfo.Info    `json:"build,omitempty"`       // Build the peer runs, nil for builds that do not announce it
+}
+
+// PeerToProto converts a peer to its protobuf form
+func PeerToProto(peer Peer) (*pb.PeerInfo, error) {
+	publicKey, err := hex.DecodeString(peer.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key of %s: %w", peer.WebAddress, err)
+	}
+
+	msg := &pb.PeerInfo{
+		WebAddress:    peer.WebAddress,
+		PublicKey:     publicKey,
+		ConnectedAt:   peer.ConnectedAt.UnixNano(),
+		World:         worldToProto(peer.World),
+		Mismatches:    peer.Mismatches,
+		Banned:        peer.Banned,
+		Maintenance:   peer.Maintenance,
+		Address:       peer.Address,
+		LatencyMs:     peer.Latency,
+		HashAlgorithm: string(peer.Hash),
+	}
+	if peer.Build != nil {
+		msg.Build = buildToProto(*peer.Build)
+	}
+	return msg, nil
+}
+
+// PeerFromProto converts a protobuf peer back
+func PeerFromProto(msg *pb.PeerInfo) Peer {
+	return Peer{
+		WebAddress:  msg.GetWebAddress(),
+		PublicKey:   hex.EncodeToString(msg.GetPublicKey()),
+		ConnectedAt: time.Unix(0, msg.GetConnectedAt()),
+		World:       worldFromProto(msg.GetWorld()),
+		Mismatches:  msg.GetMismatches(),
+		Banned:      msg.GetBanned(),
+		Maintenance: msg.GetMaintenance(),
+		Address:     msg.GetAddress(),
+		Latency:     msg.GetLatencyMs(),
+		Hash:        hashing.Algorithm(msg.GetHashAlgorithm()),
+		Build:       buildFromProto(msg.GetBuild()),
+	}
+}
+
+// Peers tracks handshaked peers and how their world settings compare to the local world
+type Peers struct {
+	mu      sync.RWMutex
+	local   *bds.WorldSettings
+	peers   map[string]*Peer
+	bans    *Bans
+	notices *Notices
+	freeze  *Freeze
+	configs *NetworkConfigs
+	uptime  *database.Uptime
+	quorum  *database.OriginQuorum
+	hashes  []hashing.Algorithm
+}
+
+// NewPeers creates a peer registry comparing peers against the local world settings
+func NewPeers(local *bds.WorldSettings) *Peers {
+	return &Peers{
+		local:  local,
+		peers:  make(map[string]*Peer),
+		hashes: []hashing.Algorithm{hashing.Default},
+	}
+}
+
+// Record stores a peer after a successful handshake, replacing any earlier record
+func (p *Peers) Record(webAddress string, publicKey []byte, world *bds.WorldSettings) Peer {
+	peer := &Peer{
+		WebAddress:  webAddress,
+		PublicKey:   hex.EncodeToString(publicKey),
+		ConnectedAt: time.Now(),
+		World:       world,
+		Hash:        hashing.Default,
+	}
+
+	if p.local != nil {
+		if world == nil {
+			peer.Mismatches = []string{"world settings not published"}
+		} else {
+			peer.Mismatches = p.local.Mismatches(world)
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if known, ok := p.peers[webAddress]; ok {
+		peer.Address, peer.Latency = known.Address, known.Latency
+	}
+	p.peers[webAddress] = peer
+
+	return *peer
+}
+
+// setLatency records the address a peer is reachable at and the round trip measured to it
+func (p *Peers) setLatency(webAddress, address string, rtt time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if peer, ok := p.peers[webAddress]; ok {
+		peer.Address = address
+		peer.Latency = float64(rtt) / float64(time.Millisecond)
+	}
+}
+
+// Joined reports whether this node joined the peer itself, at an address of its configuration
+func (p *Peers) Joined(webAddress string) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	peer, ok := p.peers[webAddress]
+	return ok && peer.Address != ""
+}
+
+// SetUptime records the handshakes of trusted peers as sightings of the peer, for verifying peer entries
+func (p *Peers) SetUptime(uptime *database.Uptime) {
+	p.uptime = uptime
+}
+
+// SetOriginQuorum records the handshakes of trusted peers as sightings of the peer and exchanges the
+// servers seen recently with peers, for admitting the item origins of peer entries
+func (p *Peers) SetOriginQuorum(quorum *database.OriginQuorum) {
+	p.quorum = quorum
+}
+
+// withSightings returns the handshake carrying the servers this node saw recently, unchanged without an origin quorum
+func (p *Peers) withSightings(handshake *pb.RegisterNodeRequest) *pb.RegisterNodeRequest {
+	if p.quorum == nil {
+		return handshake
+	}
+
+	handshake = proto.Clone(handshake).(*pb.RegisterNodeRequest)
+	handshake.SeenServers = p.quorum.Recent(time.Now())
+	return handshake
+}
+
+// vouch records a sighting of a trusted peer and the servers it reports having seen recently, the
+// handshake of any other key only pins it and vouches for nothing
+func (p *Peers) vouch(webAddress string, seen []string) {
+	now := time.Now()
+	if p.uptime != nil {
+		p.uptime.Seen(webAddress, now)
+	}
+	if p.quorum != nil {
+		p.quorum.Seen(webAddress, now)
+		p.quorum.Witness(webAddress, seen, now)
+	}
+}
+
+// SetHashAlgorithms sets the hash algorithms this node supports, most preferred first, for negotiating with peers
+func (p *Peers) SetHashAlgorithms(algorithms []hashing.Algorithm) {
+	p.hashes = algorithms
+}
+
+// HashAlgorithms returns the hash algorithms this node advertises in its handshake
+func (p *Peers) HashAlgorithms() []hashing.Algorithm {
+	return p.hashes
+}
+
+// HashAlgorithm returns the hash algorithm negotiated with a peer, the default one for an unknown peer
+func (p *Peers) HashAlgorithm(webAddress string) hashing.Algorithm {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if peer, ok := p.peers[webAddress]; ok {
+		return peer.Hash
+	}
+	return hashing.Default
+}
+
+// SetBans enables reconciling the ban lists peers announce in their handshake with ours
+func (p *Peers) SetBans(bans *Bans) {
+	p.bans = bans
+}
+
+// Bans returns the ban registry peers are reconciled with, nil when disabled
+func (p *Peers) Bans() *Bans {
+	return p.bans
+}
+
+// SetNotices enables exchanging operator notices with peers on every handshake
+func (p *Peers) SetNotices(notices *Notices) {
+	p.notices = notices
+}
+
+// Notices returns the operator notices exchanged with peers, nil when disabled
+func (p *Peers) Notices() *Notices {
+	return p.notices
+}
+
+// SetFreeze enables relaying freeze orders with peers on every handshake
+func (p *Peers) SetFreeze(freeze *Freeze) {
+	p.freeze = freeze
+}
+
+// Freeze returns the network-wide freeze of inventory updates, nil when disabled
+func (p *Peers) Freeze() *Freeze {
+	return p.freeze
+}
+
+// SetNetworkConfigs enables relaying the network configuration with peers on every handshake
+func (p *Peers) SetNetworkConfigs(configs *NetworkConfigs) {
+	p.configs = configs
+}
+
+// NetworkConfigs returns the network configuration distributed to peers, nil when disabled
+func (p *Peers) NetworkConfigs() *NetworkConfigs {
+	return p.configs
+}
+
+// reconcileBans records the servers a handshaked peer bans and reconciles them with ours
+func (p *Peers) reconcileBans(webAddress string, banned []string) {
+	p.mu.Lock()
+	if peer, ok := p.peers[webAddress]; ok {
+		peer.Banned = banned
+	}
+	p.mu.Unlock()
+
+	if p.bans != nil {
+		logBanReport(p.bans.Reconcile(webAddress, banned))
+	}
+}
+
+// setMaintenance records the maintenance flag a handshaked peer sent
+func (p *Peers) setMaintenance(webAddress, reason string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if peer, ok := p.peers[webAddress]; ok {
+		peer.Maintenance = reason
+	}
+}
+
+// setBuild records the build a handshaked peer announced
+func (p *Peers) setBuild(webAddress string, build *buildinfo.Info) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if peer, ok := p.peers[webAddress]; ok {
+		peer.Build = build
+	}
+}
+
+// negotiateHash picks the hash algorithm used with a handshaked peer from the ones it advertised
+func (p *Peers) negotiateHash(webAddress string, advertised []string) {
+	algorithm := hashing.Negotiate(p.hashes, advertised)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if peer, ok := p.peers[webAddress]; ok {
+		peer.Hash = algorithm
+	}
+}
+
+// List returns all known peers sorted by web address
+func (p *Peers) List() []Peer {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	peers := make([]Peer, 0, len(p.peers))
+	for _, peer := range p.peers {
+		peers = append(peers, *peer)
+	}
+	sort.Slice(peers, func(i, j int) bool {
+		return peers[i].WebAddress < peers[j].WebAddress
+	})
+
+	return peers
+}
+
+// Local returns the local world settings peers are compared against
+func (p *Peers) Local() *bds.WorldSettings {
+	return p.local
+}
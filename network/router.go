@@ -0,0 +1,94 @@
+package network
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/d1nch8g/consensuscraft/shard"
+)
+
+// Router directs player records to the node owning their shard in a sharded network
+// Players still play on any server, their updates are forwarded to the owning node
+type Router struct {
+	ring      *shard.Ring
+	self      string
+	addresses map[string]string
+
+	mu      sync.Mutex
+	pending map[string]bool
+	order   []string
+}
+
+// NewRouter creates a router for the shard members, mapping each member's web address to the
+// address it is dialed at, self must be one of the members
+func NewRouter(self string, members map[string]string, replicas int) (*Router, error) {
+	if _, ok := members[self]; !ok {
+		return nil, fmt.Errorf("node %s is not a shard member", self)
+	}
+
+	ring := shard.NewRing(replicas)
+	for member := range members {
+		ring.Add(member)
+	}
+
+	return &Router{
+		ring:      ring,
+		self:      self,
+		addresses: members,
+		pending:   make(map[string]bool),
+	}, nil
+}
+
+// Owner returns the web address of the node owning a player
+func (r *Router) Owner(player string) string {
+	return r.ring.Owner(player)
+}
+
+// Local reports whether this node owns a player
+func (r *Router) Local(player string) bool {
+	return r.ring.Owner(player) == r.self
+}
+
+// Member reports whether a web address belongs to a shard member
+func (r *Router) Member(webAddress string) bool {
+	return r.ring.Has(webAddress)
+}
+
+// Address returns the dial address of a shard member
+func (r *Router) Address(webAddress string) string {
+	return r.addresses[webAddress]
+}
+
+// Queue records that a player owned by another node changed here and must be forwarded
+func (r *Router) Queue(player string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.pending[player] {
+		return
+	}
+	r.pending[player] = true
+	r.order = append(r.order, player)
+}
+
+// Drain returns the queued players grouped by owning node and clears the queue
+func (r *Router) Drain() map[string][]string {
+	r.mu.Lock()
+	order := r.order
+	r.order = nil
+	r.pending = make(map[string]bool)
+	r.mu.Unlock()
+
+	return r.Group(order)
+}
+
+// Group splits players by owning node, leaving out the ones owned by this node
+func (r *Router) Group(players []string) map[string][]string {
+	groups := make(map[string][]string)
+	for _, player := range players {
+		if owner := r.Owner(player); owner != r.self {
+			groups[owner] = append(groups[owner], player)
+		}
+	}
+	return groups
+}
@@ -0,0 +1,91 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/keys"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRouter(t *testing.T) {
+	_, err := NewRouter("c.example.com", map[string]string{"a.example.com": "a:1", "b.example.com": "b:1"}, 0)
+	assert.ErrorContains(t, err, "not a shard member")
+}
+
+func TestRouter_Queue(t *testing.T) {
+	router, err := NewRouter("a.example.com", map[string]string{"a.example.com": "a:1", "b.example.com": "b:1"}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "b:1", router.Address("b.example.com"))
+	assert.True(t, router.Member("a.example.com"))
+	assert.False(t, router.Member("c.example.com"))
+
+	var remote []string
+	for i := 0; i < 20; i++ {
+		player := fmt.Sprintf("player%d", i)
+		router.Queue(player)
+		router.Queue(player)
+		if !router.Local(player) {
+			remote = append(remote, player)
+		}
+	}
+	require.NotEmpty(t, remote)
+
+	groups := router.Drain()
+	assert.Equal(t, map[string][]string{"b.example.com": remote}, groups)
+	assert.Empty(t, router.Drain())
+}
+
+func TestServer_ShardedRegisterNode(t *testing.T) {
+	chdirTemp(t)
+
+	members := map[string]string{"server.example.com": "", "client.example.com": ""}
+	serverRouter, err := NewRouter("server.example.com", members, 0)
+	require.NoError(t, err)
+
+	serverKeys, err := keys.New("server.example.com")
+	require.NoError(t, err)
+	serverDB, err := database.NewMemory()
+	require.NoError(t, err)
+	defer serverDB.Close()
+
+	var owned []string
+	for i := 0; i < 20; i++ {
+		player := fmt.Sprintf("player%d", i)
+		require.NoError(t, serverDB.Put(player, []byte(`[]`), "server.example.com"))
+		if serverRouter.Owner(player) == "client.example.com" {
+			owned = append(owned, player)
+		}
+	}
+	require.NotEmpty(t, owned)
+
+	serverHandshake, err := NewHandshake(serverKeys, "server.example.com", survival, nil)
+	require.NoError(t, err)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := NewServer(serverHandshake, serverKeys, serverDB, NewPeers(survival))
+	server.SetRouter(serverRouter)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	clientKeys, err := keys.New("client.example.com")
+	require.NoError(t, err)
+	clientDB, err := database.NewMemory()
+	require.NoError(t, err)
+	defer clientDB.Close()
+	clientHandshake, err := NewHandshake(clientKeys, "client.example.com", survival, nil)
+	require.NoError(t, err)
+
+	changed, err := Join(context.Background(), listener.Addr().String(), clientHandshake, clientKeys, clientDB, NewPeers(survival))
+	require.NoError(t, err)
+	assert.Equal(t, len(owned), changed)
+
+	for _, player := range owned {
+		_, err := clientDB.Get(player)
+		assert.NoError(t, err)
+	}
+}
@@ -0,0 +1,346 @@
+package network
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/gen/pb"
+	"github.com/d1nch8g/consensuscraft/keys"
+	"github.com/d1nch8g/consensuscraft/logger"
+	"github.com/d1nch8g/consensuscraft/tracing"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// handshakeHeader carries the serving node's own handshake back to a registering peer
+const handshakeHeader = "handshake-bin"
+
+// maxPlayersPerRequest bounds how many player records a single GetPlayers call may ask for
+const maxPlayersPerRequest = 64
+
+// Server serves the peer protocol to other nodes
+type Server struct {
+	pb.UnimplementedConsensusCraftServiceServer
+
+	handshake   *pb.RegisterNodeRequest
+	km          *keys.KeyManager
+	db          *database.DB
+	peers       *Peers
+	allowlist   []string
+	nonces      nonceCache
+	router      *Router
+	maintenance *Maintenance
+	grpc        *grpc.Server
+}
+
+// NewServer creates a peer protocol server announcing itself with handshake, peers connect over
+// TLS authenticated with their node keys
+func NewServer(handshake *pb.RegisterNodeRequest, km *keys.KeyManager, db *database.DB, peers *Peers) *Server {
+	s := &Server{
+		handshake: handshake,
+		km:        km,
+		db:        db,
+		peers:     peers,
+		grpc:      grpc.NewServer(append(serverOptions(), grpc.Creds(serverCredentials(km)))...),
+	}
+	pb.RegisterConsensusCraftServiceServer(s.grpc, s)
+
+	return s
+}
+
+// SetAllowlist sets the web addresses of the peers given the database snapshot and player records,
+// "*" allows every peer, no peer but shard members is allowed without one, it must be called before Serve
+func (s *Server) SetAllowlist(allowlist []string) {
+	s.allowlist = allowlist
+}
+
+// allowed reports whether a handshaked peer may read the database
+func (s *Server) allowed(webAddress string) bool {
+	if s.router != nil && s.router.Member(webAddress) {
+		return true
+	}
+	return slices.Contains(s.allowlist, "*") || slices.Contains(s.allowlist, webAddress)
+}
+
+// trusted reports whether a handshaked peer may push inventories and vouch for the servers it saw,
+// a handshake alone only pins its key
+func (s *Server) trusted(webAddress string) bool {
+	return s.allowed(webAddress) || s.peers.Joined(webAddress)
+}
+
+// SetRouter enables sharding, shard members registering with this node only receive
+// the players they own, it must be called before Serve
+func (s *Server) SetRouter(router *Router) {
+	s.router = router
+}
+
+// SetMaintenance holds back database snapshots from registering peers while the node is
+// in maintenance, it must be called before Serve
+func (s *Server) SetMaintenance(maintenance *Maintenance) {
+	s.maintenance = maintenance
+}
+
+// Serve accepts peer connections until Stop is called
+func (s *Server) Serve(listener net.Listener) error {
+	return s.grpc.Serve(listener)
+}
+
+// Stop closes all peer connections
+func (s *Server) Stop() {
+	s.grpc.Stop()
+}
+
+// RegisterNode verifies a peer's handshake, answers with our own and streams the database snapshot
+// to allowed peers
+func (s *Server) RegisterNode(req *pb.RegisterNodeRequest, stream grpc.ServerStreamingServer[pb.DatabaseEntry]) (err error) {
+	_, span := tracing.Start(stream.Context(), "network.register_node")
+	span.SetAttribute("peer", req.GetWebAddress())
+	defer func() {
+		span.RecordError(err)
+		span.Finish()
+	}()
+
+	err = checkChannel(stream.Context(), req.GetPublicKey())
+	if err == nil {
+		err = VerifyHandshake(s.km, req)
+	}
+	if err == nil {
+		err = s.nonces.use(req.GetNonce())
+	}
+	if err != nil {
+		logger.Warnf("Rejected handshake from %s: %v", req.GetWebAddress(), err)
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	peer := s.peers.Record(req.GetWebAddress(), req.GetPublicKey(), worldFromProto(req.GetWorld()))
+	logPeer(peer)
+	s.peers.reconcileBans(req.GetWebAddress(), req.GetBannedServers())
+	s.peers.negotiateHash(req.GetWebAddress(), req.GetHashAlgorithms())
+	s.peers.setBuild(req.GetWebAddress(), buildFromProto(req.GetBuild()))
+	if s.trusted(req.GetWebAddress()) {
+		s.peers.vouch(req.GetWebAddress(), req.GetSeenServers())
+	}
+	if md, ok := metadata.FromIncomingContext(stream.Context()); ok {
+		if values := md.Get(maintenanceHeader); len(values) > 0 {
+			s.peers.setMaintenance(req.GetWebAddress(), values[0])
+			logger.Infof("Peer %s is in maintenance: %s", req.GetWebAddress(), values[0])
+		}
+		if values := md.Get(networkConfigHeader); len(values) > 0 {
+			s.peers.NetworkConfigs().receive(req.GetWebAddress(), []byte(values[0]))
+		}
+	}
+	s.peers.Notices().receive(req.GetWebAddress(), req.GetNotices())
+	s.peers.Freeze().receive(req.GetWebAddress(), req.GetFreezeOrders())
+
+	reply, err := freshHandshake(s.km, s.peers.withSightings(s.handshake), req.GetNonce())
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	handshake, err := proto.Marshal(reply)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	header := metadata.Pairs(handshakeHeader, string(handshake))
+	maintenance := s.maintenance.header()
+	if maintenance != "" {
+		header.Set(maintenanceHeader, maintenance)
+	}
+	if outgoing := s.peers.Notices().outgoing(); len(outgoing) > 0 {
+		notices, err := proto.Marshal(&pb.OperatorNotices{Notices: outgoing})
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		header.Set(noticesHeader, string(notices))
+	}
+	if orders := s.peers.Freeze().orders(); len(orders) > 0 {
+		freeze, err := proto.Marshal(&pb.FreezeOrders{Orders: orders})
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		header.Set(freezeHeader, string(freeze))
+	}
+	if config := s.peers.NetworkConfigs().outgoing(); len(config) > 0 {
+		header.Set(networkConfigHeader, string(config))
+	}
+	if err := stream.SendHeader(header); err != nil {
+		return err
+	}
+
+	// Nothing is committed to peers during maintenance or a freeze, they sync once it is over
+	if maintenance != "" || s.peers.Freeze().Frozen() {
+		return nil
+	}
+	if !s.allowed(req.GetWebAddress()) {
+		logger.Infof("Withheld the database snapshot from %s, it is not on PEER_ALLOWLIST", req.GetWebAddress())
+		return nil
+	}
+
+	sharded := s.router != nil && s.router.Member(req.GetWebAddress())
+	for entry := range s.db.StreamAll() {
+		if sharded && s.router.Owner(string(entry.Key)) != req.GetWebAddress() {
+			continue
+		}
+		if err := stream.Send(&pb.DatabaseEntry{Key: entry.Key, Value: entry.Value}); err != nil {
+			return fmt.Errorf("failed to stream database to %s: %w", req.GetWebAddress(), err)
+		}
+	}
+
+	return nil
+}
+
+// Inventories stores signed inventory updates pushed by handshaked peers, acknowledging each stored update
+func (s *Server) Inventories(stream grpc.BidiStreamingServer[pb.InventoryMessage, pb.InventoryMessage]) error {
+	channel, err := channelKey(stream.Context())
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	for {
+		msg, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if s.peers.Freeze().Frozen() {
+			return status.Error(codes.Unavailable, "inventory updates are frozen network-wide")
+		}
+
+		if err := s.storeInventory(stream.Context(), channel, msg); err != nil {
+			return err
+		}
+
+		if err := stream.Send(&pb.InventoryMessage{PlayerName: msg.GetPlayerName(), WebAddress: s.handshake.GetWebAddress()}); err != nil {
+			return err
+		}
+	}
+}
+
+// storeInventory verifies and stores an inventory update pushed over a channel authenticated with
+// the key channel, returning the status error ending the stream when it is refused
+func (s *Server) storeInventory(ctx context.Context, channel []byte, msg *pb.InventoryMessage) (err error) {
+	ctx, span := tracing.Start(ctx, "network.store_inventory")
+	span.SetAttribute("peer", msg.GetWebAddress())
+	span.SetAttribute("player", msg.GetPlayerName())
+	defer func() {
+		span.RecordError(err)
+		span.Finish()
+	}()
+
+	publicKey, err := keys.LoadPublic(msg.GetWebAddress())
+	if err != nil {
+		s.db.RecordRejected(msg.GetWebAddress(), "unknown_peer")
+		return status.Errorf(codes.PermissionDenied, "peer %s has not completed the handshake", msg.GetWebAddress())
+	}
+	if !bytes.Equal(publicKey, channel) {
+		s.db.RecordRejected(msg.GetWebAddress(), "channel")
+		return status.Errorf(codes.PermissionDenied, "connection is not authenticated with the key of %s", msg.GetWebAddress())
+	}
+	if !s.trusted(msg.GetWebAddress()) {
+		logger.Warnf("Rejected inventory of %s from %s: the peer is neither allowed nor joined by this node", msg.GetPlayerName(), msg.GetWebAddress())
+		s.db.RecordRejected(msg.GetWebAddress(), "not_allowed")
+		return status.Errorf(codes.PermissionDenied, "peer %s is not on PEER_ALLOWLIST", msg.GetWebAddress())
+	}
+	if err := keys.VerifyPublic(publicKey, msg.GetPlayerName(), inventoryMessage(msg.GetInventoryData(), msg.GetTimestamp()), msg.GetSignature()); err != nil {
+		logger.Warnf("Rejected inventory of %s from %s: %v", msg.GetPlayerName(), msg.GetWebAddress(), err)
+		s.db.RecordRejected(msg.GetWebAddress(), "signature")
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	// The entry is checked at the time the sender signed, the peer vouches for when its update was stored
+	storedAt := time.Unix(0, msg.GetTimestamp())
+	if msg.GetTimestamp() <= 0 || time.Until(storedAt) > handshakeMaxAge {
+		s.db.RecordRejected(msg.GetWebAddress(), "timestamp")
+		return status.Errorf(codes.InvalidArgument, "inventory of %s carries an invalid timestamp", msg.GetPlayerName())
+	}
+
+	entry := database.InventoryEntry{Inventory: msg.GetInventoryData(), Server: msg.GetWebAddress(), Timestamp: storedAt}
+	entry, err = s.db.VerifyPeerEntryContext(ctx, msg.GetPlayerName(), entry)
+	switch {
+	case errors.Is(err, database.ErrEntryQuarantined):
+		// Acknowledged so the peer does not send it again, operators inspect the quarantine
+		return nil
+	case err != nil:
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.db.PutWithIDContext(ctx, msg.GetPlayerName(), entry.Inventory, msg.GetWebAddress(), nil, msg.GetUpdateId()); err != nil {
+		if errors.Is(err, database.ErrInventoryRejected) {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// GetPlayers returns the stored records of the requested players to a handshaked peer,
+// players unknown here are left out of the response
+func (s *Server) GetPlayers(ctx context.Context, req *pb.GetPlayersRequest) (resp *pb.GetPlayersResponse, err error) {
+	_, span := tracing.Start(ctx, "network.serve_players")
+	span.SetAttribute("peer", req.GetWebAddress())
+	span.SetAttribute("players", strconv.Itoa(len(req.GetPlayers())))
+	defer func() {
+		span.RecordError(err)
+		span.Finish()
+	}()
+
+	if len(req.GetPlayers()) > maxPlayersPerRequest {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d players per request", maxPlayersPerRequest)
+	}
+
+	publicKey, err := keys.LoadPublic(req.GetWebAddress())
+	if err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "peer %s has not completed the handshake", req.GetWebAddress())
+	}
+	if err := checkChannel(ctx, publicKey); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err := keys.VerifyPublic(publicKey, req.GetWebAddress(), []byte(strings.Join(req.GetPlayers(), "\n")), req.GetSignature()); err != nil {
+		logger.Warnf("Rejected player request from %s: %v", req.GetWebAddress(), err)
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if !s.allowed(req.GetWebAddress()) {
+		return nil, status.Errorf(codes.PermissionDenied, "peer %s is not on PEER_ALLOWLIST", req.GetWebAddress())
+	}
+
+	// Nothing is committed to peers during maintenance or a freeze
+	if s.maintenance.Enabled() || s.peers.Freeze().Frozen() {
+		return nil, status.Error(codes.Unavailable, "player records are held back during maintenance or a freeze")
+	}
+
+	resp = &pb.GetPlayersResponse{}
+	for _, player := range req.GetPlayers() {
+		record, err := s.db.RawRecord(player)
+		if errors.Is(err, database.ErrPlayerNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		resp.Entries = append(resp.Entries, &pb.DatabaseEntry{Key: []byte(player), Value: record})
+	}
+	return resp, nil
+}
+
+// logPeer reports a handshaked peer, warning about world settings that differ from ours
+func logPeer(peer Peer) {
+	if len(peer.Mismatches) > 0 {
+		logger.Warnf("Peer %s runs different world settings: %v", peer.WebAddress, peer.Mismatches)
+		return
+	}
+	logger.Infof("Peer %s registered", peer.WebAddress)
+}
@@ -0,0 +1,62 @@
+package network
+
+import (
+	"context"
+
+	"github.com/d1nch8g/consensuscraft/tracing"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// traceparentHeader carries the W3C trace context of a call, so the spans of both nodes form one trace
+const traceparentHeader = "traceparent"
+
+// traceUnaryClient sends the trace context of a unary call to the peer
+func traceUnaryClient(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(outgoingTrace(ctx), method, req, reply, cc, opts...)
+}
+
+// traceStreamClient sends the trace context of a stream to the peer
+func traceStreamClient(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(outgoingTrace(ctx), desc, cc, method, opts...)
+}
+
+// traceUnary continues the trace of the calling peer in a unary handler
+func traceUnary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	return handler(incomingTrace(ctx), req)
+}
+
+// traceStream continues the trace of the calling peer in a streaming handler
+func traceStream(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &tracedStream{ServerStream: stream, ctx: incomingTrace(stream.Context())})
+}
+
+// tracedStream is a server stream whose context carries the trace of the calling peer
+type tracedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *tracedStream) Context() context.Context {
+	return s.ctx
+}
+
+// outgoingTrace adds the traceparent of the span in ctx to the metadata sent to the peer
+func outgoingTrace(ctx context.Context) context.Context {
+	if traceparent := tracing.Traceparent(ctx); traceparent != "" {
+		return metadata.AppendToOutgoingContext(ctx, traceparentHeader, traceparent)
+	}
+	return ctx
+}
+
+// incomingTrace returns ctx carrying the remote span named by the traceparent the peer sent
+func incomingTrace(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	if values := md.Get(traceparentHeader); len(values) > 0 {
+		return tracing.WithTraceparent(ctx, values[0])
+	}
+	return ctx
+}
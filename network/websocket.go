@@ -0,0 +1,119 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/websocket"
+)
+
+// WebSocketListener accepts peer connections tunnelled over WebSocket, so the gRPC
+// protocol can pass through HTTPS-only firewalls, CDNs and reverse proxies
+type WebSocketListener struct {
+	addr  net.Addr
+	conns chan net.Conn
+
+	closeOnce sync.Once
+	done      chan struct{}
+}
+
+// NewWebSocketListener creates a listener fed by its Handler, addr is reported as the listen address
+func NewWebSocketListener(addr net.Addr) *WebSocketListener {
+	return &WebSocketListener{
+		addr:  addr,
+		conns: make(chan net.Conn),
+		done:  make(chan struct{}),
+	}
+}
+
+// Handler upgrades HTTP requests to WebSocket and hands the connections to Accept
+func (l *WebSocketListener) Handler() http.Handler {
+	return websocket.Server{
+		Handler: func(ws *websocket.Conn) {
+			ws.PayloadType = websocket.BinaryFrame
+			conn := &webSocketConn{Conn: ws, closed: make(chan struct{})}
+
+			select {
+			case l.conns <- conn:
+			case <-l.done:
+				return
+			}
+
+			// The connection is torn down once the handler returns
+			select {
+			case <-conn.closed:
+			case <-l.done:
+			}
+		},
+	}
+}
+
+// Accept waits for the next WebSocket peer connection
+func (l *WebSocketListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.done:
+		return nil, net.ErrClosed
+	}
+}
+
+// Close stops accepting connections and releases pending handlers
+func (l *WebSocketListener) Close() error {
+	l.closeOnce.Do(func() { close(l.done) })
+	return nil
+}
+
+// Addr returns the address the HTTP server listens on
+func (l *WebSocketListener) Addr() net.Addr {
+	return l.addr
+}
+
+// webSocketConn signals the upgrading handler when gRPC closes the connection
+type webSocketConn struct {
+	*websocket.Conn
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+func (c *webSocketConn) Close() error {
+	err := c.Conn.Close()
+	c.closeOnce.Do(func() { close(c.closed) })
+	return err
+}
+
+// IsWebSocketAddress reports whether a peer address is a ws:// or wss:// URL
+func IsWebSocketAddress(address string) bool {
+	return strings.HasPrefix(address, "ws://") || strings.HasPrefix(address, "wss://")
+}
+
+// DialWebSocket opens a binary WebSocket connection to a peer, wss:// addresses use TLS
+func DialWebSocket(ctx context.Context, address string) (net.Conn, error) {
+	target, err := url.Parse(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid websocket address %s: %w", address, err)
+	}
+
+	origin := "http://" + target.Host
+	if target.Scheme == "wss" {
+		origin = "https://" + target.Host
+	}
+
+	config, err := websocket.NewConfig(address, origin)
+	if err != nil {
+		return nil, fmt.Errorf("invalid websocket address %s: %w", address, err)
+	}
+
+	ws, err := config.DialContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial %s: %w", address, err)
+	}
+	ws.PayloadType = websocket.BinaryFrame
+
+	return ws, nil
+}
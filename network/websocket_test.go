@@ -0,0 +1,77 @@
+package network
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/keys"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsWebSocketAddress(t *testing.T) {
+	assert.True(t, IsWebSocketAddress("ws://node.example.com/consensuscraft"))
+	assert.True(t, IsWebSocketAddress("wss://node.example.com:443/sync"))
+	assert.False(t, IsWebSocketAddress("node.example.com:32842"))
+}
+
+func TestJoin_WebSocket(t *testing.T) {
+	chdirTemp(t)
+
+	serverKeys, err := keys.New("server.example.com")
+	require.NoError(t, err)
+	serverDB, err := database.NewMemory()
+	require.NoError(t, err)
+	defer serverDB.Close()
+	require.NoError(t, serverDB.Put("alice", []byte(`[{"typeId":"minecraft:diamond","amount":1}]`), "server.example.com"))
+
+	serverHandshake, err := NewHandshake(serverKeys, "server.example.com", survival, nil)
+	require.NoError(t, err)
+	server := NewServer(serverHandshake, serverKeys, serverDB, NewPeers(survival))
+	server.SetAllowlist([]string{"client.example.com"})
+	defer server.Stop()
+
+	// Served under a sub path, as a reverse proxy would forward it
+	mux := http.NewServeMux()
+	httpServer := httptest.NewServer(mux)
+	defer httpServer.Close()
+
+	listener := NewWebSocketListener(httpServer.Listener.Addr())
+	mux.Handle("/proxy/consensuscraft", listener.Handler())
+	go server.Serve(listener)
+
+	clientKeys, err := keys.New("client.example.com")
+	require.NoError(t, err)
+	clientDB, err := database.NewMemory()
+	require.NoError(t, err)
+	defer clientDB.Close()
+
+	clientHandshake, err := NewHandshake(clientKeys, "client.example.com", survival, nil)
+	require.NoError(t, err)
+	clientPeers := NewPeers(survival)
+
+	address := "ws://" + strings.TrimPrefix(httpServer.URL, "http://") + "/proxy/consensuscraft"
+	changed, err := Join(context.Background(), address, clientHandshake, clientKeys, clientDB, clientPeers)
+	require.NoError(t, err)
+	assert.Equal(t, 1, changed)
+
+	inventory, err := clientDB.Get("alice")
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"typeId":"minecraft:diamond","amount":1}]`, string(inventory))
+	require.Len(t, clientPeers.List(), 1)
+	assert.Equal(t, "server.example.com", clientPeers.List()[0].WebAddress)
+}
+
+func TestWebSocketListener_Close(t *testing.T) {
+	listener := NewWebSocketListener(nil)
+	require.NoError(t, listener.Close())
+	require.NoError(t, listener.Close())
+
+	conn, err := listener.Accept()
+	assert.Nil(t, conn)
+	assert.Error(t, err)
+}
@@ -0,0 +1,86 @@
+package node
+
+import (
+	"context"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/coldstore"
+	"github.com/d1nch8g/consensuscraft/config"
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// archiveInterval is how often old inventory entries are moved to the cold store and tombstones collected
+const archiveInterval = 24 * time.Hour
+
+// newColdStore creates the configured cold store, nil when archiving is not configured
+func newColdStore(cfg *config.Config) (database.ColdStore, error) {
+	if cfg.ArchiveS3Endpoint != "" {
+		return coldstore.NewS3(coldstore.S3Config{
+			Endpoint:  cfg.ArchiveS3Endpoint,
+			Bucket:    cfg.ArchiveS3Bucket,
+			Region:    cfg.ArchiveS3Region,
+			AccessKey: cfg.ArchiveS3AccessKey,
+			SecretKey: cfg.ArchiveS3SecretKey,
+			Prefix:    cfg.ArchiveS3Prefix,
+		})
+	}
+	if cfg.ArchiveAfterDays > 0 {
+		return coldstore.NewDir(cfg.ArchiveDir)
+	}
+	return nil, nil
+}
+
+// archiveOldEntries moves entries older than ArchiveAfterDays to the cold store once a day until ctx is done
+func archiveOldEntries(ctx context.Context, cfg *config.Config, inventories *database.DB) {
+	age := time.Duration(cfg.ArchiveAfterDays) * 24 * time.Hour
+
+	for {
+		report, err := inventories.Archive(time.Now().Add(-age))
+		if err != nil {
+			logger.Errorf("Inventory archive failed: %v", err)
+		} else if report.Entries > 0 {
+			logger.Infof("Archived %d inventory entries of %d players", report.Entries, report.Players)
+		}
+
+		if !sleep(ctx, archiveInterval) {
+			return
+		}
+	}
+}
+
+// collectTombstones forgets tombstones older than TombstoneGraceDays once a day until ctx is done
+func collectTombstones(ctx context.Context, cfg *config.Config, inventories *database.DB) {
+	grace := time.Duration(cfg.TombstoneGraceDays) * 24 * time.Hour
+
+	for {
+		collected, err := inventories.CollectTombstones(time.Now().Add(-grace))
+		if err != nil {
+			logger.Errorf("Tombstone collection failed: %v", err)
+		} else if collected > 0 {
+			logger.Infof("Collected %d tombstones of deleted players", collected)
+		}
+
+		if !sleep(ctx, archiveInterval) {
+			return
+		}
+	}
+}
+
+// collectBanSnapshots removes the data of bans older than BanSnapshotDays once a day until ctx is done
+func collectBanSnapshots(ctx context.Context, cfg *config.Config, inventories *database.DB) {
+	grace := time.Duration(cfg.BanSnapshotDays) * 24 * time.Hour
+
+	for {
+		collected, err := inventories.CollectBanSnapshots(time.Now().Add(-grace))
+		if err != nil {
+			logger.Errorf("Ban snapshot collection failed: %v", err)
+		} else if collected > 0 {
+			logger.Infof("Collected %d snapshots of banned servers, their bans can no longer be reversed", collected)
+		}
+
+		if !sleep(ctx, archiveInterval) {
+			return
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package node
+
+import (
+	"github.com/d1nch8g/consensuscraft/bds"
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// CheckpointsFile logs the world saves of the node next to the inventories database
+const CheckpointsFile = "checkpoints.json"
+
+// recordCheckpoint stores a completed world save as a checkpoint of the inventory history
+func recordCheckpoint(checkpoints *database.Checkpoints, webAddress string, save bds.WorldSave) {
+	files := make([]database.CheckpointFile, len(save.Files))
+	for i, file := range save.Files {
+		files[i] = database.CheckpointFile{Path: file.Path, Size: file.Size}
+	}
+
+	checkpoint := database.NewCheckpoint(webAddress, save.At(), save.CompletedAt, files)
+	if err := checkpoints.Add(checkpoint); err != nil {
+		logger.Errorf("Unable to record checkpoint of world save: %v", err)
+		return
+	}
+	logger.Infof("Recorded checkpoint %s of world save with %d files", checkpoint.ID, len(files))
+}
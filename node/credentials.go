@@ -0,0 +1,44 @@
+package node
+
+import (
+	"context"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/keys"
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// credentialCheckInterval is how often key ages are checked against KEY_MAX_AGE_DAYS
+const credentialCheckInterval = 24 * time.Hour
+
+// watchCredentials reports keys due for rotation once a day until ctx is done, an error for this
+// node's expired key and a warning for the others
+func watchCredentials(ctx context.Context) {
+	for {
+		credentials, err := keys.Credentials(time.Now())
+		if err != nil {
+			logger.Errorf("Unable to check key ages: %v", err)
+		}
+		for _, credential := range credentials {
+			reportCredential(credential)
+		}
+
+		if !sleep(ctx, credentialCheckInterval) {
+			return
+		}
+	}
+}
+
+func reportCredential(credential keys.Credential) {
+	expires := credential.ExpiresAt.Format("2006-01-02")
+	switch {
+	case credential.Own && credential.Status == keys.CredentialExpired:
+		logger.Errorf("Key of this node expired on %s, run consensuscraft rotate-keys and restart the node", expires)
+	case credential.Own && credential.Status == keys.CredentialRotateSoon:
+		logger.Warnf("Key of this node expires on %s, run consensuscraft rotate-keys before then", expires)
+	case credential.Status == keys.CredentialExpired:
+		logger.Warnf("Pinned key of %s expired on %s, ask its operator to rotate it", credential.Server, expires)
+	case credential.Status == keys.CredentialRotateSoon:
+		logger.Warnf("Pinned key of %s expires on %s, ask its operator to rotate it", credential.Server, expires)
+	}
+}
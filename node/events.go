@@ -0,0 +1,49 @@
+package node
+
+import (
+	"context"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/coldstore"
+	"github.com/d1nch8g/consensuscraft/config"
+	"github.com/d1nch8g/consensuscraft/events"
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// EventsFile journals audit records and rejected updates until they are exported
+const EventsFile = "events.jsonl"
+
+// newEventStore creates the store events are exported to, the archive bucket when one is configured
+func newEventStore(cfg *config.Config) (coldstore.Store, error) {
+	if cfg.ArchiveS3Endpoint != "" {
+		return coldstore.NewS3(coldstore.S3Config{
+			Endpoint:  cfg.ArchiveS3Endpoint,
+			Bucket:    cfg.ArchiveS3Bucket,
+			Region:    cfg.ArchiveS3Region,
+			AccessKey: cfg.ArchiveS3AccessKey,
+			SecretKey: cfg.ArchiveS3SecretKey,
+			Prefix:    cfg.ArchiveS3Prefix + cfg.EventExportS3Prefix,
+		})
+	}
+	return coldstore.NewDir(cfg.EventExportDir)
+}
+
+// exportEvents exports the journaled events every EventExportInterval minutes until ctx is done,
+// and once more on the way out so a stopping node leaves nothing behind
+func exportEvents(ctx context.Context, cfg *config.Config, journal *events.Journal, exporter *events.Exporter) {
+	interval := time.Duration(cfg.EventExportInterval) * time.Minute
+
+	for running := true; running; {
+		running = sleep(ctx, interval)
+
+		exported, err := exporter.Export(time.Now())
+		if err != nil {
+			logger.Errorf("Event export failed, it is retried with the next export: %v", err)
+		} else if exported > 0 {
+			logger.Infof("Exported %d audit and validation events", exported)
+		}
+		if dropped := journal.Dropped(); dropped > 0 {
+			logger.Warnf("Lost %d audit and validation events that could not be journaled", dropped)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package node
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/d1nch8g/consensuscraft/config"
+	"github.com/d1nch8g/consensuscraft/logger"
+	"github.com/d1nch8g/consensuscraft/network"
+)
+
+// networkSetting applies one setting of a network configuration to the node configuration and
+// profile, reporting whether it changed them
+type networkSetting func(cfg *config.Config, profile *ValidatorProfile, settings network.NetworkSettings) bool
+
+// networkSettings are the settings of a network configuration by their name in the document
+var networkSettings = map[string]networkSetting{
+	"allowed_namespaces": func(cfg *config.Config, profile *ValidatorProfile, s network.NetworkSettings) bool {
+		return setSlice(&profile.Namespaces, s.AllowedNamespaces)
+	},
+	"namespace_action": func(cfg *config.Config, profile *ValidatorProfile, s network.NetworkSettings) bool {
+		return setValue(&profile.NamespaceAction, s.NamespaceAction)
+	},
+	"peer_invalid_items": func(cfg *config.Config, profile *ValidatorProfile, s network.NetworkSettings) bool {
+		return setValue(&cfg.PeerInvalidItems, s.PeerInvalidItems)
+	},
+	"banned_nodes": func(cfg *config.Config, profile *ValidatorProfile, s network.NetworkSettings) bool {
+		// Servers banned locally stay banned, the network can only add to them
+		changed := false
+		for _, server := range s.BannedNodes {
+			if !slices.Contains(cfg.BannedNodes, server) && server != cfg.WebAddress {
+				cfg.BannedNodes = append(slices.Clip(cfg.BannedNodes), server)
+				changed = true
+			}
+		}
+		return changed
+	},
+	"origin_quorum": func(cfg *config.Config, profile *ValidatorProfile, s network.NetworkSettings) bool {
+		return setValue(&cfg.OriginQuorum, s.OriginQuorum)
+	},
+	"origin_quorum_window": func(cfg *config.Config, profile *ValidatorProfile, s network.NetworkSettings) bool {
+		return setValue(&cfg.OriginQuorumWindow, s.OriginQuorumWindow)
+	},
+	"uptime_tolerance": func(cfg *config.Config, profile *ValidatorProfile, s network.NetworkSettings) bool {
+		return setValue(&cfg.UptimeTolerance, s.UptimeTolerance)
+	},
+	"origin_format": func(cfg *config.Config, profile *ValidatorProfile, s network.NetworkSettings) bool {
+		return setValue(&cfg.OriginFormat, s.OriginFormat)
+	},
+	"peer_max_message_bytes": func(cfg *config.Config, profile *ValidatorProfile, s network.NetworkSettings) bool {
+		return setValue(&cfg.PeerMaxMessageBytes, s.PeerMaxMessageBytes)
+	},
+	"hash_algorithms": func(cfg *config.Config, profile *ValidatorProfile, s network.NetworkSettings) bool {
+		return setSlice(&cfg.HashAlgorithms, s.HashAlgorithms)
+	},
+}
+
+// setValue sets a setting to a value that is not the zero value, reporting whether it changed
+func setValue[T comparable](setting *T, value T) bool {
+	var zero T
+	if value == zero || *setting == value {
+		return false
+	}
+	*setting = value
+	return true
+}
+
+// setSlice sets a setting to a list that is not empty, reporting whether it changed
+func setSlice(setting *[]string, value []string) bool {
+	if len(value) == 0 || slices.Equal(*setting, value) {
+		return false
+	}
+	*setting = slices.Clone(value)
+	return true
+}
+
+// checkKeptSettings checks NETWORK_CONFIG_KEEP only names settings of the network configuration
+func checkKeptSettings(keep []string) error {
+	for _, name := range keep {
+		if _, ok := networkSettings[name]; !ok {
+			return fmt.Errorf("unknown network configuration setting %q in NETWORK_CONFIG_KEEP", name)
+		}
+	}
+	return nil
+}
+
+// overlayNetworkConfig applies the settings of a network configuration over the node configuration
+// and profile, except those in keep, and returns the names of the settings it changed
+func overlayNetworkConfig(cfg *config.Config, profile *ValidatorProfile, settings network.NetworkSettings, keep []string) []string {
+	var changed []string
+	for name, apply := range networkSettings {
+		if slices.Contains(keep, name) {
+			continue
+		}
+		if apply(cfg, profile, settings) {
+			changed = append(changed, name)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// loadNetworkConfig overlays the network configuration accepted before the node started on the
+// configuration of the node, nothing is applied without signers
+func (n *Node) loadNetworkConfig() error {
+	cfg := n.cfg
+	if len(cfg.NetworkConfigSigners) == 0 {
+		return nil
+	}
+
+	policy, err := network.ParseConfigPolicy(cfg.NetworkConfigPolicy)
+	if err != nil {
+		return err
+	}
+	if err := checkKeptSettings(cfg.NetworkConfigKeep); err != nil {
+		return err
+	}
+	if policy == network.ConfigIgnore {
+		return nil
+	}
+
+	netConfig, err := network.LoadNetworkConfig(NetworkConfigFile, cfg.NetworkConfigSigners)
+	if errors.Is(err, network.ErrUntrustedNetworkConfig) {
+		logger.Warnf("Ignored the saved network configuration: %v", err)
+		return nil
+	}
+	if err != nil || netConfig == nil {
+		return err
+	}
+
+	changed := overlayNetworkConfig(cfg, &n.profile, netConfig.Settings, cfg.NetworkConfigKeep)
+	logger.Infof("Network configuration version %d from %s applied, it sets %v", netConfig.Version, netConfig.Issuer, changed)
+	return nil
+}
+
+// adoptNetworkConfig applies a network configuration accepted while the node runs: its bans right
+// away, the other settings it changes once the node restarts
+func (n *Node) adoptNetworkConfig(netConfig *network.NetworkConfig, bans *network.Bans) {
+	keep := n.cfg.NetworkConfigKeep
+	if !slices.Contains(keep, "banned_nodes") {
+		for _, server := range netConfig.Settings.BannedNodes {
+			banned, err := bans.Ban(network.BanEntry{
+				Server: server,
+				Reason: fmt.Sprintf("network configuration version %d", netConfig.Version),
+				Source: netConfig.Issuer,
+			})
+			switch {
+			case err != nil:
+				logger.Warnf("Failed to apply ban of %s from the network configuration: %v", server, err)
+			case banned:
+				logger.Infof("Audit: banned %s as the network configuration version %d says", server, netConfig.Version)
+			}
+		}
+	}
+
+	cfg, profile := *n.cfg, n.profile
+	changed := overlayNetworkConfig(&cfg, &profile, netConfig.Settings, append(slices.Clip(keep), "banned_nodes"))
+	if len(changed) > 0 {
+		logger.Warnf("Network configuration version %d changes %v, restart the node to apply them", netConfig.Version, changed)
+	}
+}
@@ -0,0 +1,327 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/admin"
+	"github.com/d1nch8g/consensuscraft/bds"
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/gen/pb"
+	"github.com/d1nch8g/consensuscraft/hashing"
+	"github.com/d1nch8g/consensuscraft/logger"
+	"github.com/d1nch8g/consensuscraft/network"
+)
+
+// startNetwork serves the peer protocol and admin dashboard, then keeps joining the configured peers
+// Connectivity reports when the node falls back to local-only mode
+// With a router, updates of players owned by other shard members are forwarded to them
+func (n *Node) startNetwork(world *bds.WorldSettings) error {
+	cfg := n.cfg
+
+	handshake, err := network.NewHandshake(n.km, cfg.WebAddress, world, cfg.BannedNodes)
+	if err != nil {
+		return fmt.Errorf("unable to create handshake: %w", err)
+	}
+	handshake.HashAlgorithms = hashing.Names(n.hashes)
+
+	banPolicy, err := network.ParseBanPolicy(cfg.BanPolicy)
+	if err != nil {
+		return fmt.Errorf("invalid ban policy: %w", err)
+	}
+	configPolicy := network.ConfigIgnore
+	if len(cfg.NetworkConfigSigners) > 0 {
+		if configPolicy, err = network.ParseConfigPolicy(cfg.NetworkConfigPolicy); err != nil {
+			return err
+		}
+	}
+
+	freeze, err := network.NewFreeze(n.km, cfg.WebAddress, cfg.FreezeSigners, cfg.FreezeQuorum, func(frozen bool, reason string) {
+		announceFreeze(n.server, frozen, reason)
+	})
+	if err != nil {
+		return fmt.Errorf("invalid freeze settings: %w", err)
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.GRPCPort))
+	if err != nil {
+		return fmt.Errorf("unable to listen for peers: %w", err)
+	}
+
+	trustedBanLists, err := network.ParseBanListKeys(cfg.BanListKeys)
+	if err != nil {
+		return err
+	}
+
+	peers := network.NewPeers(world)
+	bans := network.NewBans(banPolicy, cfg.BannedNodes, func(server string) error {
+		return n.db.Delete(server, true)
+	})
+	if err := bans.EnableExchange(n.km, cfg.WebAddress, trustedBanLists, ImportedBansFile); err != nil {
+		return err
+	}
+	peers.SetBans(bans)
+
+	configs, err := network.NewNetworkConfigs(n.km, cfg.WebAddress, cfg.NetworkConfigSigners, configPolicy, NetworkConfigFile, func(netConfig *network.NetworkConfig) {
+		n.adoptNetworkConfig(netConfig, bans)
+	})
+	if err != nil {
+		return err
+	}
+	peers.SetNetworkConfigs(configs)
+	peers.SetNotices(network.NewNotices(n.km, cfg.WebAddress))
+	peers.SetFreeze(freeze)
+	peers.SetUptime(n.uptime)
+	peers.SetOriginQuorum(n.quorum)
+	peers.SetHashAlgorithms(n.hashes)
+	n.freeze = freeze
+	if len(n.peerAddresses) > 0 && cfg.PeerFetchTimeout > 0 {
+		n.fetcher = network.NewFetcher(n.km, cfg.WebAddress, n.db, peers, time.Duration(cfg.PeerFetchTimeout)*time.Millisecond)
+	}
+	n.connectivity = network.NewConnectivity(time.Duration(cfg.LocalOnlyGrace)*time.Second, func(localOnly bool) {
+		announceConnectivity(n.server, localOnly)
+	})
+
+	maintenance := network.NewMaintenance(func(enabled bool, reason string) error {
+		return n.switchMaintenance(enabled, reason)
+	})
+
+	peerServer := network.NewServer(handshake, n.km, n.db, peers)
+	peerServer.SetMaintenance(maintenance)
+	peerServer.SetAllowlist(cfg.PeerAllowlist)
+	if len(cfg.PeerAllowlist) == 0 {
+		logger.Infof("PEER_ALLOWLIST is empty, peers handshake but get no database snapshot")
+	}
+	context.AfterFunc(n.ctx, peerServer.Stop)
+
+	if n.router != nil {
+		peerServer.SetRouter(n.router)
+		n.goLoop("shard forwarding", func() { n.forwardShards(handshake, peers, maintenance) })
+	}
+	n.goRun("peer server", func() {
+		if err := peerServer.Serve(listener); err != nil && n.ctx.Err() == nil {
+			logger.Errorf("Peer server stopped: %v", err)
+		}
+	})
+
+	if cfg.WebSocketAddress != "" {
+		n.goRun("websocket server", func() { n.serveWebSocket(peerServer) })
+	}
+
+	if cfg.AdminAddress != "" {
+		n.serveHTTP("admin", cfg.AdminAddress, admin.New(admin.Parameters{
+			Peers:        peers,
+			Connectivity: n.connectivity,
+			DB:           n.db,
+			Startup:      n.report,
+			Maintenance:  maintenance,
+			Token:        cfg.AdminToken,
+			ViewerToken:  cfg.AdminViewerToken,
+			Redaction:    n.redaction,
+			ExportRate:   cfg.AdminExportRate,
+			Quarantine:   database.QuarantineDir(DatabasePath),
+			Sessions:     n.sessions,
+			Online:       n.server.Sessions,
+			PackHealth:   n.server.PackHealth,
+			WebAddress:   cfg.WebAddress,
+		}))
+	}
+
+	if len(n.peerAddresses) > 0 {
+		n.goLoop("peer sync", func() { n.maintainPeers(handshake, peers, maintenance) })
+	}
+
+	return nil
+}
+
+// maintainPeers periodically joins the configured peers, tracking reachability and pushing
+// the updates queued while the node was local-only to the first peer that answers again
+// During maintenance or a freeze queued updates are held back until it is over
+func (n *Node) maintainPeers(handshake *pb.RegisterNodeRequest, peers *network.Peers, maintenance *network.Maintenance) {
+	for {
+		var reached string
+		var lastErr error
+		for _, address := range n.peerAddresses {
+			ctx := network.WithMaintenance(n.ctx, maintenance)
+			changed, err := network.Join(ctx, address, handshake, n.km, n.db, peers)
+			if err != nil {
+				if n.ctx.Err() != nil {
+					return
+				}
+				logger.Errorf("Unable to join %s: %v", address, err)
+				lastErr = err
+				continue
+			}
+			if changed > 0 {
+				logger.Infof("Joined %s, %d player records updated", address, changed)
+			}
+			if reached == "" {
+				reached = address
+			}
+		}
+
+		if reached == "" {
+			n.connectivity.Unreachable(lastErr)
+		} else {
+			n.connectivity.Reachable()
+			if !maintenance.Enabled() && !peers.Freeze().Frozen() {
+				n.pushQueued(reached)
+			}
+		}
+
+		if !sleep(n.ctx, time.Duration(n.cfg.PeerRetryInterval)*time.Second) {
+			return
+		}
+	}
+}
+
+// pushQueued sends inventories changed while local-only to a peer, requeueing them if the push fails
+func (n *Node) pushQueued(address string) {
+	queued := n.connectivity.Drain()
+	if len(queued) == 0 {
+		return
+	}
+
+	pushed, err := network.Push(n.ctx, address, n.cfg.WebAddress, n.km, n.db, queued)
+	if err != nil {
+		logger.Errorf("Unable to push %d queued updates to %s: %v", len(queued), address, err)
+		for _, player := range queued {
+			n.connectivity.Queue(player)
+		}
+		return
+	}
+	logger.Infof("Pushed %d queued updates to %s", pushed, address)
+}
+
+// forwardShards periodically pushes updates of players owned by other shard members to them,
+// joining each member once first so it knows this node's key, nothing is forwarded during maintenance or a freeze
+func (n *Node) forwardShards(handshake *pb.RegisterNodeRequest, peers *network.Peers, maintenance *network.Maintenance) {
+	joined := make(map[string]bool)
+
+	for sleep(n.ctx, time.Duration(n.cfg.PeerRetryInterval)*time.Second) {
+		if maintenance.Enabled() || peers.Freeze().Frozen() {
+			continue
+		}
+
+		for owner, players := range n.router.Drain() {
+			var err error
+			if !joined[owner] {
+				_, err = network.Join(network.WithMaintenance(n.ctx, maintenance), n.router.Address(owner), handshake, n.km, n.db, peers)
+				joined[owner] = err == nil
+			}
+
+			pushed := 0
+			if err == nil {
+				pushed, err = network.Push(n.ctx, n.router.Address(owner), n.cfg.WebAddress, n.km, n.db, players)
+			}
+			if err != nil {
+				logger.Errorf("Unable to forward %d players to shard %s: %v", len(players), owner, err)
+				for _, player := range players {
+					n.router.Queue(player)
+				}
+				continue
+			}
+			logger.Debugf("Forwarded %d players to shard %s", pushed, owner)
+		}
+	}
+}
+
+// announceConnectivity warns operators and players when the node enters or leaves local-only mode
+func announceConnectivity(server *bds.Bds, localOnly bool) {
+	message := bds.MessageReconnected
+	if localOnly {
+		message = bds.MessageLocalOnly
+		logger.Warn("No peers reachable, entering local-only mode")
+	} else {
+		logger.Info("Peers reachable again, leaving local-only mode")
+	}
+
+	if err := server.Announce(message); err != nil {
+		logger.Warnf("Unable to announce connectivity change: %v", err)
+	}
+}
+
+// announceFreeze tells players when inventory updates are frozen network-wide and when they resume
+func announceFreeze(server *bds.Bds, frozen bool, reason string) {
+	key, values := bds.MessageUnfrozen, []string(nil)
+	if frozen {
+		key, values = bds.MessageFrozen, []string{"reason", reason}
+	}
+
+	if err := server.Announce(key, values...); err != nil {
+		logger.Warnf("Unable to announce freeze: %v", err)
+	}
+}
+
+// switchMaintenance restricts the server to the maintenance operators and kicks everyone else,
+// or opens it to all players again
+func (n *Node) switchMaintenance(enabled bool, reason string) error {
+	if !enabled {
+		if err := n.server.LeaveMaintenance(); err != nil {
+			return fmt.Errorf("unable to leave maintenance: %w", err)
+		}
+		logger.Info("Left maintenance mode")
+		return n.server.Announce(bds.MessageMaintenanceOver)
+	}
+
+	message := n.server.Message(bds.MessageMaintenance)
+	if reason != "" {
+		message = n.server.Message(bds.MessageMaintenanceReason, "reason", reason)
+	}
+	if err := n.server.EnterMaintenance(n.cfg.MaintenancePlayers, message); err != nil {
+		return fmt.Errorf("unable to enter maintenance: %w", err)
+	}
+	logger.Warnf("Entered maintenance mode, only %v may play: %s", n.cfg.MaintenancePlayers, reason)
+	return nil
+}
+
+// serveWebSocket serves the peer protocol over WebSocket on the configured path, using TLS when a certificate is set
+func (n *Node) serveWebSocket(server *network.Server) {
+	tcp, err := net.Listen("tcp", n.cfg.WebSocketAddress)
+	if err != nil {
+		logger.Errorf("Unable to listen for websocket peers: %v", err)
+		return
+	}
+
+	listener := network.NewWebSocketListener(tcp.Addr())
+	context.AfterFunc(n.ctx, func() { listener.Close() })
+	n.goRun("websocket peer server", func() {
+		if err := server.Serve(listener); err != nil && n.ctx.Err() == nil {
+			logger.Errorf("Websocket peer server stopped: %v", err)
+		}
+	})
+
+	mux := http.NewServeMux()
+	mux.Handle(n.cfg.WebSocketPath, listener.Handler())
+	httpServer := n.newHTTPServer(mux)
+
+	if n.cfg.WebSocketTLSCert != "" {
+		err = httpServer.ServeTLS(tcp, n.cfg.WebSocketTLSCert, n.cfg.WebSocketTLSKey)
+	} else {
+		err = httpServer.Serve(tcp)
+	}
+	if n.ctx.Err() == nil {
+		logger.Errorf("Websocket server stopped: %v", err)
+	}
+}
+
+// serveHTTP serves a handler on address until the node stops
+func (n *Node) serveHTTP(name, address string, handler http.Handler) {
+	server := n.newHTTPServer(handler)
+	server.Addr = address
+	n.goRun(name+" server", func() {
+		if err := server.ListenAndServe(); err != nil && n.ctx.Err() == nil {
+			logger.Errorf("The %s server stopped: %v", name, err)
+		}
+	})
+}
+
+// newHTTPServer creates an HTTP server closed when the node stops
+func (n *Node) newHTTPServer(handler http.Handler) *http.Server {
+	server := &http.Server{Handler: handler}
+	context.AfterFunc(n.ctx, func() { server.Close() })
+	return server
+}
@@ -0,0 +1,74 @@
+package node
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/bds"
+	"github.com/d1nch8g/consensuscraft/config"
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/keys"
+	"github.com/d1nch8g/consensuscraft/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_MaintainPeers(t *testing.T) {
+	chdirTemp(t)
+	world := &bds.WorldSettings{SeedHash: "seed", Difficulty: "normal", Gamemode: "survival"}
+
+	peerKeys, err := keys.New("peer.example.com")
+	require.NoError(t, err)
+	peerDB, err := database.NewMemory()
+	require.NoError(t, err)
+	defer peerDB.Close()
+	require.NoError(t, peerDB.Put("alice", []byte(`[{"typeId":"minecraft:diamond","amount":1}]`), "peer.example.com"))
+
+	peerHandshake, err := network.NewHandshake(peerKeys, "peer.example.com", world, nil)
+	require.NoError(t, err)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	peerServer := network.NewServer(peerHandshake, peerKeys, peerDB, network.NewPeers(world))
+	peerServer.SetAllowlist([]string{"node.example.com"})
+	go peerServer.Serve(listener)
+	defer peerServer.Stop()
+
+	// Nothing listens on the first address, the node falls through to the second one
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closed.Close()
+
+	db, err := database.NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+
+	n := New(&config.Config{WebAddress: "node.example.com", PeerRetryInterval: 3600},
+		WithDatabase(db), WithPeers(closed.Addr().String(), listener.Addr().String()))
+	n.km, err = keys.New("node.example.com")
+	require.NoError(t, err)
+	n.ctx, n.cancel = context.WithCancel(context.Background())
+	n.connectivity = network.NewConnectivity(time.Minute, nil)
+
+	handshake, err := network.NewHandshake(n.km, "node.example.com", world, nil)
+	require.NoError(t, err)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		n.maintainPeers(handshake, network.NewPeers(world), network.NewMaintenance(nil))
+	}()
+
+	require.Eventually(t, func() bool {
+		_, err := db.Get("alice")
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.False(t, n.connectivity.LocalOnly())
+
+	n.cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("peer maintenance did not stop with the node")
+	}
+}
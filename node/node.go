@@ -0,0 +1,678 @@
+// Package node runs a consensuscraft node: the bedrock dedicated server, the inventory database
+// and the peer network, so it can be embedded in other programs such as hosting panels
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/bds"
+	"github.com/d1nch8g/consensuscraft/config"
+	"github.com/d1nch8g/consensuscraft/crash"
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/events"
+	"github.com/d1nch8g/consensuscraft/hashing"
+	"github.com/d1nch8g/consensuscraft/keys"
+	"github.com/d1nch8g/consensuscraft/logger"
+	"github.com/d1nch8g/consensuscraft/network"
+	"github.com/d1nch8g/consensuscraft/startup"
+)
+
+// DatabasePath is the inventories database opened in the working directory without WithDatabase
+const DatabasePath = "inventories.ldb"
+
+// IngestOffsetsFile remembers the last ender chest update applied from the server output
+const IngestOffsetsFile = "ingest_offsets.json"
+
+// ImportedBansFile keeps the bans imported from ban lists of allied networks
+const ImportedBansFile = "imported_bans.json"
+
+// NetworkConfigFile keeps the network configuration accepted last
+const NetworkConfigFile = "network_config.json"
+
+// AdmittedOriginsFile keeps the item origins that met the origin quorum
+const AdmittedOriginsFile = "admitted_origins.json"
+
+// watchInterval is how often the running node checks its server and connectivity
+const watchInterval = time.Minute
+
+var (
+	ErrStarted    = errors.New("node was already started")
+	ErrNotStarted = errors.New("node is not running")
+)
+
+// Node is a consensuscraft node, create it with New and run it with Start
+// Keys, the server and its world live in the working directory, as with the consensuscraft command
+type Node struct {
+	cfg           *config.Config
+	profile       ValidatorProfile
+	peerAddresses []string
+	db            *database.DB
+	ownsDB        bool
+
+	report      *startup.Report
+	checkpoints *database.Checkpoints
+	sessions    *bds.SessionLog
+	messages    *bds.Messages
+	router      *network.Router
+	km          *keys.KeyManager
+	server      *bds.Bds
+
+	// Set before the server starts, updates made without reachable peers are queued for it
+	connectivity *network.Connectivity
+	// Set with connectivity, updates made while frozen are dropped
+	freeze *network.Freeze
+	// Set with connectivity, fetches players missing locally from peers, nil without peers or PEER_FETCH_TIMEOUT
+	fetcher *network.Fetcher
+	// Peer sightings entries from peers are verified against, nil without VERIFY_PEER_ENTRIES
+	uptime *database.Uptime
+	// Item origins admitted into peer entries, nil without ORIGIN_QUORUM
+	quorum *database.OriginQuorum
+	// Hash algorithms advertised to peers, most preferred first
+	hashes []hashing.Algorithm
+	// Fields of inventories hidden from admin viewers
+	redaction *database.Redaction
+	// Audit records and rejected updates awaiting export, nil without EVENT_EXPORT_INTERVAL
+	journal *events.Journal
+	// Undo the process wide settings and hooks of the node when it stops, latest first
+	restores []func()
+
+	// Servers and background tasks stop once ctx is done, Stop waits for them through wg
+	mu       sync.Mutex
+	started  bool
+	ctx      context.Context
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+	stopOnce sync.Once
+	stopErr  error
+}
+
+// New creates a node from the configuration, nothing is started until Start
+// The node works on a copy of cfg, which the network configuration may override
+func New(cfg *config.Config, options ...Option) *Node {
+	local := *cfg
+	n := &Node{
+		cfg:         &local,
+		profile:     profileFromConfig(cfg),
+		ownsDB:      true,
+		report:      startup.NewReport(),
+		checkpoints: database.OpenCheckpoints(CheckpointsFile, cfg.CheckpointRetention),
+		sessions:    bds.OpenSessionLog(SessionsFile),
+		messages:    bds.DefaultMessages(),
+	}
+	if cfg.ConnectedNode != "" {
+		n.peerAddresses = []string{cfg.ConnectedNode}
+	}
+
+	for _, option := range options {
+		option(n)
+	}
+	return n
+}
+
+// Start runs the startup phases and launches the server, returning once the node is running
+// The node runs until Stop is called or ctx is cancelled, a failed start releases everything
+// started so far
+func (n *Node) Start(ctx context.Context) error {
+	n.mu.Lock()
+	if n.started {
+		n.mu.Unlock()
+		return ErrStarted
+	}
+	n.started = true
+	n.ctx, n.cancel = context.WithCancel(ctx)
+	n.mu.Unlock()
+
+	runBDS := make(chan struct{})
+	for _, phase := range n.phases(runBDS) {
+		if err := n.report.Run(phase); err != nil {
+			n.Stop()
+			return err
+		}
+	}
+	n.report.Complete()
+
+	runBDS <- struct{}{}
+
+	n.goLoop("watch", n.watch)
+	go func() {
+		<-n.ctx.Done()
+		n.Stop()
+	}()
+	return nil
+}
+
+// Stop shuts the server and the network down and closes the database opened by the node,
+// concurrent calls wait for the same shutdown
+func (n *Node) Stop() error {
+	n.mu.Lock()
+	started := n.started
+	n.mu.Unlock()
+	if !started {
+		return ErrNotStarted
+	}
+
+	n.stopOnce.Do(func() {
+		n.cancel()
+		if n.server != nil {
+			n.server.Stop()
+		}
+		n.wg.Wait()
+
+		n.mu.Lock()
+		restores := n.restores
+		n.restores = nil
+		n.mu.Unlock()
+		for i := len(restores) - 1; i >= 0; i-- {
+			restores[i]()
+		}
+
+		if n.journal != nil {
+			n.db.OnRejected(nil)
+		}
+		if n.ownsDB && n.db != nil {
+			n.stopErr = n.db.Close()
+		}
+	})
+	return n.stopErr
+}
+
+// onStop runs restore when the node stops, to undo a process wide setting or hook of the node
+// Settings every server of a network must agree on, as the fingerprint algorithm, stay process
+// wide: a node only changes them when its configuration differs and puts them back when it stops
+func (n *Node) onStop(restore func()) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.restores = append(n.restores, restore)
+}
+
+// DB returns the inventories database, nil before the db startup phase
+func (n *Node) DB() *database.DB {
+	return n.db
+}
+
+// Startup returns the startup report, phases are added as Start runs them
+func (n *Node) Startup() *startup.Report {
+	return n.report
+}
+
+// Server returns the bedrock dedicated server, nil before the bds start phase
+func (n *Node) Server() *bds.Bds {
+	return n.server
+}
+
+// phases returns the startup phases, the server waits for runBDS once they are all done
+func (n *Node) phases(runBDS chan struct{}) []startup.Phase {
+	cfg := n.cfg
+	retryDelay := time.Duration(cfg.StartupRetryDelay) * time.Second
+
+	var (
+		namespaces *database.NamespaceRule
+		rules      []database.ValidatorRule
+		cold       database.ColdStore
+		dumper     *database.PayloadDumper
+		setup      = bds.NewSetup()
+		serverPath string
+		ports      bds.PortRange
+		restarts   *bds.RestartSchedule
+		addresses  *regexp.Regexp
+		invalid    database.InvalidItemPolicy
+		exporter   *events.Exporter
+	)
+
+	return []startup.Phase{
+		{
+			Name: "config",
+			Run: func() (err error) {
+				if err := n.loadNetworkConfig(); err != nil {
+					return err
+				}
+
+				// The pack stamps origins in the configured format, the validator must read them alike
+				format := database.MustOriginFormat(database.DefaultOriginFormat)
+				if cfg.OriginFormat != "" {
+					if format, err = database.NewOriginFormat(cfg.OriginFormat); err != nil {
+						return fmt.Errorf("invalid origin format: %w", err)
+					}
+				}
+				if previous := database.CurrentOriginFormat(); previous.String() != format.String() {
+					database.SetOriginFormat(format)
+					n.onStop(func() { database.SetOriginFormat(previous) })
+				}
+				messageSize := network.DefaultMaxMessageSize
+				if cfg.PeerMaxMessageBytes != 0 {
+					messageSize = cfg.PeerMaxMessageBytes
+				}
+				if previous := network.MaxMessageSize(); previous != messageSize {
+					if err := network.SetMaxMessageSize(messageSize); err != nil {
+						return fmt.Errorf("invalid peer message size: %w", err)
+					}
+					n.onStop(func() { network.SetMaxMessageSize(previous) })
+				}
+
+				if namespaces, err = n.profile.validate(); err != nil {
+					return err
+				}
+				rules = slices.Clone(n.profile.Rules)
+				if namespaces != nil {
+					rules = append(rules, namespaces)
+				}
+
+				if cfg.MessagesFile != "" {
+					if n.messages, err = bds.LoadMessages(cfg.MessagesFile); err != nil {
+						return fmt.Errorf("invalid in-game messages: %w", err)
+					}
+				}
+
+				if ports, err = bds.ParsePortRange(cfg.BDSPortRange); err != nil {
+					return err
+				}
+
+				if cfg.RestartSchedule != "" {
+					if restarts, err = bds.ParseRestartSchedule(cfg.RestartSchedule); err != nil {
+						return err
+					}
+				}
+
+				if cfg.PlayerAddressPattern != "" {
+					if addresses, err = bds.ParseAddressPattern(cfg.PlayerAddressPattern); err != nil {
+						return err
+					}
+				}
+
+				if invalid, err = database.ParseInvalidItemPolicy(cfg.PeerInvalidItems); err != nil {
+					return err
+				}
+
+				if n.redaction, err = database.NewRedaction(cfg.AdminRedactFields); err != nil {
+					return fmt.Errorf("invalid admin redaction: %w", err)
+				}
+				if cfg.OriginQuorum > 0 && !cfg.VerifyPeerEntries {
+					return errors.New("ORIGIN_QUORUM needs VERIFY_PEER_ENTRIES, peer entries are not checked without it")
+				}
+				if cfg.AdminViewerToken != "" && cfg.AdminToken == "" {
+					return errors.New("ADMIN_VIEWER_TOKEN needs ADMIN_TOKEN, the admin API is open to everyone without it")
+				}
+
+				if n.hashes, err = hashing.ParseList(cfg.HashAlgorithms); err != nil {
+					return fmt.Errorf("invalid hash algorithms: %w", err)
+				}
+				if previous := database.FingerprintAlgorithm(); previous != n.hashes[0] {
+					database.SetFingerprintAlgorithm(n.hashes[0])
+					n.onStop(func() { database.SetFingerprintAlgorithm(previous) })
+				}
+
+				if cold, err = newColdStore(cfg); err != nil {
+					return fmt.Errorf("invalid archive configuration: %w", err)
+				}
+
+				if cfg.EventExportInterval > 0 {
+					format, err := events.ParseFormat(cfg.EventExportFormat)
+					if err != nil {
+						return err
+					}
+					store, err := newEventStore(cfg)
+					if err != nil {
+						return fmt.Errorf("invalid event export configuration: %w", err)
+					}
+					n.journal = events.OpenJournal(EventsFile)
+					exporter = events.NewExporter(n.journal, store, format)
+				}
+
+				if cfg.DebugDumpDir != "" {
+					dumper, err = database.NewPayloadDumper(cfg.DebugDumpDir, int64(cfg.DebugDumpMaxBytes),
+						time.Duration(cfg.DebugDumpInterval)*time.Second, cfg.DebugDumpRedactNameTags)
+					if err != nil {
+						return fmt.Errorf("unable to enable payload dump: %w", err)
+					}
+					if err := dumper.SetRules(rules); err != nil {
+						return fmt.Errorf("unable to set validator rules: %w", err)
+					}
+					logger.Infof("Dumping inventory payloads to %s", cfg.DebugDumpDir)
+				}
+
+				if len(cfg.ShardNodes) > 0 {
+					if n.router, err = network.NewRouter(cfg.WebAddress, cfg.ShardNodes, cfg.ShardReplicas); err != nil {
+						return fmt.Errorf("invalid shard configuration: %w", err)
+					}
+					logger.Infof("Sharding player records across %d nodes", len(cfg.ShardNodes))
+				}
+
+				setup.TrustPackKeys(cfg.PackTrustedKeys)
+				setup.SetWorldTemplate(cfg.WorldTemplate, cfg.WorldTemplateSHA256)
+				return nil
+			},
+		},
+		{
+			Name: "keys",
+			Run: func() (err error) {
+				if n.km, err = keys.New(cfg.WebAddress); err != nil {
+					return fmt.Errorf("unable to load node keys: %w", err)
+				}
+
+				factor, minimum := keys.AnomalyThresholds()
+				if factor != float64(cfg.KeyAnomalyFactor) || minimum != cfg.KeyAnomalyMinimum {
+					keys.SetAnomalyThresholds(float64(cfg.KeyAnomalyFactor), cfg.KeyAnomalyMinimum)
+					n.onStop(func() { keys.SetAnomalyThresholds(factor, minimum) })
+				}
+				if err := keys.LoadUsage(); err != nil {
+					logger.Warnf("Key usage baselines start over: %v", err)
+				}
+
+				if cfg.KeyMaxAgeDays > 0 {
+					keys.SetKeyLifetime(time.Duration(cfg.KeyMaxAgeDays)*24*time.Hour, time.Duration(cfg.KeyRotationWarnDays)*24*time.Hour)
+					n.goLoop("key expiry", func() { watchCredentials(n.ctx) })
+				}
+				return nil
+			},
+		},
+		{
+			Name:     "db",
+			Attempts: cfg.StartupAttempts,
+			Backoff:  retryDelay,
+			Run: func() (err error) {
+				// Retried while a previous process still holds the database lock
+				if n.db == nil {
+					if n.db, err = database.New(DatabasePath); err != nil {
+						return fmt.Errorf("unable to open inventories database: %w", err)
+					}
+				}
+
+				n.db.SetSlowThreshold(time.Duration(cfg.DBSlowThreshold) * time.Millisecond)
+
+				if err := migrateOriginLore(n.db); err != nil {
+					return err
+				}
+
+				// Set rather than registered, so a retry of this phase or another node of the process
+				// does not find them registered already
+				if err := n.db.SetRules(rules); err != nil {
+					return fmt.Errorf("unable to set validator rules: %w", err)
+				}
+
+				if namespaces != nil {
+					n.db.SetFilter(namespaces.Filter(n.profile.NamespaceAction == "strip"))
+					n.db.OnFiltered(func(player, origin string) {
+						// Only players on this server just saw their items disappear
+						if origin == cfg.WebAddress && n.server != nil {
+							go tellStripped(n.server, player, origin)
+						}
+					})
+					logger.Infof("Accepting items from namespaces %v, inventories with other items: %s", namespaces.Namespaces(), n.profile.NamespaceAction)
+				}
+
+				if cfg.VerifyPeerEntries {
+					n.uptime = database.NewUptime(cfg.WebAddress, time.Duration(cfg.UptimeTolerance)*time.Second)
+					verifier := database.NewPeerVerifier(n.uptime)
+					if err := verifier.SetRules(rules); err != nil {
+						return fmt.Errorf("unable to set validator rules: %w", err)
+					}
+					if err := verifier.SetInvalidItemPolicy(invalid, database.QuarantineDir(DatabasePath)); err != nil {
+						return err
+					}
+					if cfg.OriginQuorum > 0 {
+						n.quorum, err = database.NewOriginQuorum(cfg.WebAddress, cfg.OriginQuorum, time.Duration(cfg.OriginQuorumWindow)*time.Second, AdmittedOriginsFile)
+						if err != nil {
+							return err
+						}
+						verifier.SetOriginQuorum(n.quorum)
+						logger.Infof("Admitting item origins seen online by %d nodes, %d admitted so far", cfg.OriginQuorum, len(n.quorum.Origins()))
+					}
+					n.db.SetPeerVerifier(verifier)
+					n.db.OnPeerStripped(func(player, origin string) {
+						if n.server != nil && slices.ContainsFunc(n.server.OnlinePlayers(), func(online string) bool { return strings.EqualFold(online, player) }) {
+							go tellInvalidStripped(n.server, player, origin)
+						}
+					})
+					logger.Infof("Verifying peer entries against their item origins and peer uptime, entries with invalid items: %s", invalid)
+				}
+
+				if exporter != nil {
+					n.onStop(logger.AddHook(n.journal.Audit))
+					n.db.OnRejected(n.journal.Rejected)
+					n.goLoop("event export", func() { exportEvents(n.ctx, cfg, n.journal, exporter) })
+					logger.Infof("Exporting audit and validation events as %s every %d minutes", cfg.EventExportFormat, cfg.EventExportInterval)
+				}
+
+				n.goLoop("tombstone collection", func() { collectTombstones(n.ctx, cfg, n.db) })
+
+				if cfg.BanSnapshotDays > 0 {
+					n.db.SetBanSnapshotDir(database.BanSnapshotDir(DatabasePath))
+					n.goLoop("ban snapshot collection", func() { collectBanSnapshots(n.ctx, cfg, n.db) })
+				}
+
+				if cold != nil {
+					n.db.SetColdStore(cold)
+					if cfg.ArchiveAfterDays > 0 {
+						n.goLoop("archiving", func() { archiveOldEntries(n.ctx, cfg, n.db) })
+					}
+				}
+
+				for _, bn := range cfg.BannedNodes {
+					n.db.Delete(bn, true)
+				}
+				return nil
+			},
+		},
+		{
+			Name:     "bds download",
+			Attempts: cfg.StartupAttempts,
+			Backoff:  retryDelay,
+			Run: func() (err error) {
+				serverPath, err = setup.EnsureExecutable()
+				return err
+			},
+		},
+		{
+			Name: "world import",
+			Run:  setup.EnsureWorld,
+		},
+		{
+			Name: "pack install",
+			Run:  setup.EnsurePack,
+		},
+		{
+			Name: "bds start",
+			Run: func() (err error) {
+				n.server, err = bds.New(bds.Parameters{
+					InventoryReceiveCallback: n.receiveInventory,
+					InventoryEntryCallback: func(ctx context.Context, update bds.InventoryUpdate) error {
+						return n.storeUpdate(ctx, dumper, update)
+					},
+					WorldSavedCallback: func(save bds.WorldSave) {
+						recordCheckpoint(n.checkpoints, cfg.WebAddress, save)
+					},
+					StartTrigger:       runBDS,
+					UpdateQueueSize:    cfg.UpdateQueueSize,
+					ShedThreshold:      cfg.UpdateShedThreshold,
+					WebAddress:         cfg.WebAddress,
+					OriginFormat:       cfg.OriginFormat,
+					ConsoleOperators:   cfg.ConsoleOperators,
+					ConfirmDestructive: cfg.ConsoleConfirmDestructive,
+					ConsolePIN:         cfg.ConsolePIN,
+					ConsoleCommands:    cfg.ConsoleCommands,
+					TrustedPackKeys:    cfg.PackTrustedKeys,
+					ServerPath:         serverPath,
+					Messages:           n.messages,
+					PortRange:          ports,
+					IngestOffsetsPath:  IngestOffsetsFile,
+					AddressPattern:     addresses,
+					SessionEnded:       n.recordSession,
+					Limits: bds.ResourceLimits{
+						MemoryMax:  int64(cfg.BDSMemoryMax) << 20,
+						CPUWeight:  cfg.BDSCPUWeight,
+						Nice:       cfg.BDSNice,
+						CgroupRoot: cfg.BDSCgroupRoot,
+					},
+				})
+				if err != nil {
+					return fmt.Errorf("unable to launch bedrock dedicated server: %w", err)
+				}
+
+				if restarts != nil {
+					n.goLoop("scheduled restarts", func() { n.server.RunRestartSchedule(n.ctx, restarts) })
+				}
+				return nil
+			},
+		},
+		{
+			Name:     "network",
+			Attempts: cfg.StartupAttempts,
+			Backoff:  retryDelay,
+			Run: func() error {
+				world, err := n.server.WorldSettings()
+				if err != nil {
+					logger.Warnf("World settings will not be published: %v", err)
+				}
+				return n.startNetwork(world)
+			},
+		},
+	}
+}
+
+// receiveInventory returns the inventory restored to a spawning player, fetching it from peers when
+// this node has no record of the player yet
+func (n *Node) receiveInventory(playerName string) ([]byte, error) {
+	inventory, err := n.db.Get(playerName)
+	if !errors.Is(err, database.ErrPlayerNotFound) || n.fetcher == nil {
+		return inventory, err
+	}
+
+	if err := n.fetcher.Fetch(n.ctx, playerName); err != nil {
+		logger.Debugf("Unable to fetch %s from peers: %v", playerName, err)
+		return nil, database.ErrPlayerNotFound
+	}
+	return n.db.Get(playerName)
+}
+
+// storeUpdate stores an ender chest update of a player on this server and queues it for peers
+func (n *Node) storeUpdate(ctx context.Context, dumper *database.PayloadDumper, update bds.InventoryUpdate) error {
+	playerName, inventory, position := update.PlayerName, update.Inventory, update.Position
+
+	// Nothing is saved during an emergency freeze, the player keeps the last synced ender chest
+	if n.freeze.Frozen() {
+		if err := n.server.Tell(playerName, n.server.Message(bds.MessageFrozenChange)); err != nil {
+			logger.Warnf("Unable to tell %s about the freeze: %v", playerName, err)
+		}
+		return nil
+	}
+
+	if dumper != nil {
+		if _, err := dumper.Dump(playerName, inventory, n.cfg.WebAddress); err != nil {
+			logger.Warnf("Failed to dump payload for %s: %v", playerName, err)
+		}
+	}
+
+	var location *database.Location
+	if position != nil {
+		location = &database.Location{
+			X:         position.X,
+			Y:         position.Y,
+			Z:         position.Z,
+			Dimension: position.Dimension,
+		}
+	}
+	if err := n.db.PutWithIDContext(ctx, playerName, inventory, n.cfg.WebAddress, location, update.UpdateID); err != nil {
+		return err
+	}
+	if fingerprints, err := database.InventoryFingerprints(inventory); err == nil && len(fingerprints) > 0 {
+		if err := n.server.SendFingerprints(playerName, fingerprints); err != nil {
+			logger.Warnf("Unable to send item fingerprints of %s: %v", playerName, err)
+		}
+	}
+	if n.router != nil && !n.router.Local(playerName) {
+		n.router.Queue(playerName)
+	} else if n.connectivity.LocalOnly() {
+		n.connectivity.Queue(playerName)
+	}
+	return nil
+}
+
+// watch reminds players of local-only mode and reports unmonitored server output, a stalled inventory
+// pipeline and a pack labelling origins with another server name until the node stops
+func (n *Node) watch() {
+	stallTimeout := time.Duration(n.cfg.IngestStallTimeout) * time.Minute
+	// A stall is reported once, until updates are ingested again or every player left
+	stallReported := false
+
+	for minute := 0; sleep(n.ctx, watchInterval); minute++ {
+		// Remind players every ten minutes, the first announcement may precede the server start
+		if n.connectivity.LocalOnly() && minute%10 == 0 {
+			announceConnectivity(n.server, true)
+		}
+
+		health := n.server.Health()
+		if !health.Healthy() {
+			logger.Warnf("Server output is not fully monitored: %d readers attached, %d restarts, last error: %s",
+				health.ActiveReaders, health.Restarts, health.LastError)
+		}
+		stall, stalled := n.server.IngestStalled(stallTimeout)
+		if stalled && !stallReported {
+			logger.Errorf("No inventory updates ingested for %s while %d players were online (%s), the pack or the log parser may be broken",
+				stall.For.Round(time.Minute), len(stall.Players), strings.Join(stall.Players, ", "))
+		}
+		stallReported = stalled
+		if health.IdentityMismatch {
+			logger.Errorf("Pack labels item origins as %s instead of %s", health.Identity, n.cfg.WebAddress)
+		}
+		if health.OriginFormatMismatch {
+			logger.Errorf("Pack stamps item origins as %q instead of %q, new items are rejected", health.OriginFormat, n.cfg.OriginFormat)
+		}
+		if err := keys.SaveUsage(); err != nil {
+			logger.Warnf("Unable to save key usage: %v", err)
+		}
+	}
+}
+
+// goRun runs a background task that Stop waits for, a panic of the task is recorded as a crash
+func (n *Node) goRun(name string, task func()) {
+	n.wg.Add(1)
+	go func() {
+		defer n.wg.Done()
+		defer crash.Guard(name)
+		task()
+	}()
+}
+
+// goLoop runs a background loop like goRun, restarting it after a crash until the node stops
+func (n *Node) goLoop(name string, loop func()) {
+	n.wg.Add(1)
+	go func() {
+		defer n.wg.Done()
+		crash.Run(n.ctx, name, loop)
+	}()
+}
+
+// sleep waits for d, reporting false when ctx is done first
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// tellInvalidStripped lets a player know invalid items received from a peer were removed from their ender chest
+func tellInvalidStripped(server *bds.Bds, player, origin string) {
+	message := server.Message(bds.MessageInvalidItemsStripped, "player", player, "server", origin)
+	if err := server.Tell(player, message); err != nil {
+		logger.Warnf("Unable to tell %s about stripped items: %v", player, err)
+	}
+}
+
+// tellStripped lets a player know items from disallowed namespaces were removed from their ender chest
+func tellStripped(server *bds.Bds, player, origin string) {
+	message := server.Message(bds.MessageItemsStripped, "player", player, "server", origin)
+	if err := server.Tell(player, message); err != nil {
+		logger.Warnf("Unable to tell %s about stripped items: %v", player, err)
+	}
+}
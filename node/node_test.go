@@ -0,0 +1,202 @@
+package node
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/config"
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/hashing"
+	"github.com/d1nch8g/consensuscraft/keys"
+	"github.com/d1nch8g/consensuscraft/network"
+	"github.com/d1nch8g/consensuscraft/startup"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// chdirTemp runs the test in an empty node directory
+func chdirTemp(t *testing.T) {
+	originalDir, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(t.TempDir()))
+	t.Cleanup(func() { os.Chdir(originalDir) })
+}
+
+func TestNew(t *testing.T) {
+	cfg := &config.Config{
+		ConnectedNode:     "peer.example.com:50051",
+		AllowedNamespaces: []string{"minecraft"},
+		NamespaceAction:   "strip",
+	}
+
+	n := New(cfg)
+	assert.Equal(t, []string{"peer.example.com:50051"}, n.peerAddresses)
+	assert.Equal(t, []string{"minecraft"}, n.profile.Namespaces)
+	assert.True(t, n.ownsDB)
+	assert.Nil(t, n.DB())
+
+	db, err := database.NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+
+	profile := ValidatorProfile{Namespaces: []string{"minecraft", "mymod"}, NamespaceAction: "reject"}
+	n = New(cfg, WithDatabase(db), WithPeers("a.example.com:50051", "b.example.com:50051"), WithValidatorProfile(profile))
+	assert.Equal(t, []string{"a.example.com:50051", "b.example.com:50051"}, n.peerAddresses)
+	assert.Equal(t, profile, n.profile)
+	assert.Same(t, db, n.DB())
+	assert.False(t, n.ownsDB)
+}
+
+func TestValidatorProfile(t *testing.T) {
+	rule, err := ValidatorProfile{}.validate()
+	assert.NoError(t, err)
+	assert.Nil(t, rule, "all items are accepted without namespaces")
+
+	rule, err = ValidatorProfile{Namespaces: []string{"minecraft"}, NamespaceAction: "strip"}.validate()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"minecraft"}, rule.Namespaces())
+
+	_, err = ValidatorProfile{Namespaces: []string{"minecraft"}, NamespaceAction: "drop"}.validate()
+	assert.Error(t, err)
+}
+
+func TestOverlayNetworkConfig(t *testing.T) {
+	cfg := &config.Config{
+		WebAddress:          "node.example.com",
+		BannedNodes:         []string{"local.example.com"},
+		OriginFormat:        "Origin: <server>",
+		PeerMaxMessageBytes: 4 << 20,
+		OriginQuorum:        1,
+	}
+	profile := ValidatorProfile{NamespaceAction: "strip"}
+
+	settings := network.NetworkSettings{
+		AllowedNamespaces:   []string{"minecraft"},
+		NamespaceAction:     "reject",
+		BannedNodes:         []string{"griefers.example.com", "node.example.com", "local.example.com"},
+		OriginQuorum:        1,
+		OriginFormat:        "Forged on <server>",
+		PeerMaxMessageBytes: 1 << 20,
+	}
+	changed := overlayNetworkConfig(cfg, &profile, settings, []string{"peer_max_message_bytes"})
+
+	// Unchanged and kept settings are left out, local bans stay and this node is never banned
+	assert.Equal(t, []string{"allowed_namespaces", "banned_nodes", "namespace_action", "origin_format"}, changed)
+	assert.Equal(t, ValidatorProfile{Namespaces: []string{"minecraft"}, NamespaceAction: "reject"}, profile)
+	assert.Equal(t, []string{"local.example.com", "griefers.example.com"}, cfg.BannedNodes)
+	assert.Equal(t, "Forged on <server>", cfg.OriginFormat)
+	assert.Equal(t, 4<<20, cfg.PeerMaxMessageBytes)
+
+	assert.NoError(t, checkKeptSettings([]string{"origin_format", "banned_nodes"}))
+	assert.Error(t, checkKeptSettings([]string{"max_players"}))
+}
+
+func TestNode_LoadNetworkConfig(t *testing.T) {
+	chdirTemp(t)
+
+	km, err := keys.New("a.example.com")
+	require.NoError(t, err)
+	configs, err := network.NewNetworkConfigs(km, "a.example.com", []string{"a.example.com"}, network.ConfigAccept, NetworkConfigFile, nil)
+	require.NoError(t, err)
+	_, err = configs.Publish(network.NetworkSettings{AllowedNamespaces: []string{"minecraft"}, OriginQuorum: 2})
+	require.NoError(t, err)
+
+	cfg := &config.Config{WebAddress: "node.example.com", NetworkConfigPolicy: "accept", NetworkConfigSigners: []string{"a.example.com"}}
+	n := New(cfg)
+	require.NoError(t, n.loadNetworkConfig())
+	assert.Equal(t, 2, n.cfg.OriginQuorum)
+	assert.Equal(t, []string{"minecraft"}, n.profile.Namespaces)
+	assert.Zero(t, cfg.OriginQuorum, "the configuration of the caller is left alone")
+
+	// Ignored and no longer trusted configurations are not applied
+	for _, cfg := range []*config.Config{
+		{NetworkConfigPolicy: "ignore", NetworkConfigSigners: []string{"a.example.com"}},
+		{NetworkConfigPolicy: "accept", NetworkConfigSigners: []string{"b.example.com"}},
+	} {
+		n := New(cfg)
+		require.NoError(t, n.loadNetworkConfig())
+		assert.Zero(t, n.cfg.OriginQuorum)
+	}
+
+	signers := []string{"a.example.com"}
+	assert.Error(t, New(&config.Config{NetworkConfigPolicy: "always", NetworkConfigSigners: signers}).loadNetworkConfig())
+	assert.Error(t, New(&config.Config{NetworkConfigPolicy: "accept", NetworkConfigSigners: signers, NetworkConfigKeep: []string{"max_players"}}).loadNetworkConfig())
+	assert.NoError(t, New(&config.Config{}).loadNetworkConfig(), "disabled without signers")
+}
+
+func TestNode_StartFailure(t *testing.T) {
+	chdirTemp(t)
+
+	db, err := database.NewMemory()
+	require.NoError(t, err)
+	defer db.Close()
+
+	n := New(&config.Config{WebAddress: "node.example.com"}, WithDatabase(db),
+		WithValidatorProfile(ValidatorProfile{Namespaces: []string{"minecraft"}, NamespaceAction: "drop"}))
+	assert.ErrorIs(t, n.Stop(), ErrNotStarted)
+
+	err = n.Start(context.Background())
+	assert.ErrorContains(t, err, "namespace action")
+
+	report := n.Startup().Snapshot()
+	require.Len(t, report.Phases, 1)
+	assert.Equal(t, startup.StatusFailed, report.Phases[0].Status)
+
+	assert.ErrorIs(t, n.Start(context.Background()), ErrStarted)
+	assert.NoError(t, n.Stop())
+
+	// A database passed with WithDatabase is left open for its owner
+	assert.NoError(t, db.Put("alice", []byte(`[]`), "node.example.com"))
+}
+
+func TestNode_StopRestoresProcessSettings(t *testing.T) {
+	chdirTemp(t)
+
+	// A file in place of the database fails the start in the db phase, after the settings were applied
+	require.NoError(t, os.WriteFile(DatabasePath, nil, 0644))
+
+	factor, minimum := keys.AnomalyThresholds()
+	n := New(&config.Config{WebAddress: "node.example.com", PeerInvalidItems: "reject", HashAlgorithms: []string{"blake3"},
+		KeyAnomalyFactor: int(factor) + 1, KeyAnomalyMinimum: minimum, OriginFormat: "Forged on <server>",
+		PeerMaxMessageBytes: 1 << 10})
+
+	assert.Error(t, n.Start(context.Background()))
+	report := n.Startup().Snapshot()
+	require.Len(t, report.Phases, 3)
+	assert.Equal(t, "db", report.Phases[2].Name)
+
+	assert.Equal(t, hashing.Default, database.FingerprintAlgorithm())
+	assert.Equal(t, database.DefaultOriginFormat, database.CurrentOriginFormat().String())
+	assert.Equal(t, network.DefaultMaxMessageSize, network.MaxMessageSize())
+	restoredFactor, restoredMinimum := keys.AnomalyThresholds()
+	assert.Equal(t, factor, restoredFactor)
+	assert.Equal(t, minimum, restoredMinimum)
+}
+
+func TestNode_ConfigAppliesProcessSettings(t *testing.T) {
+	chdirTemp(t)
+
+	n := New(&config.Config{WebAddress: "node.example.com", PeerInvalidItems: "reject",
+		OriginFormat: "Forged on <server>", PeerMaxMessageBytes: 1 << 10})
+	t.Cleanup(func() {
+		for i := len(n.restores) - 1; i >= 0; i-- {
+			n.restores[i]()
+		}
+	})
+
+	// The validator reads the origins the pack stamps in the configured format
+	require.Equal(t, "config", n.phases(nil)[0].Name)
+	require.NoError(t, n.phases(nil)[0].Run())
+	assert.Equal(t, "Forged on <server>", database.CurrentOriginFormat().String())
+	assert.Equal(t, 1<<10, network.MaxMessageSize())
+}
+
+func TestSleep(t *testing.T) {
+	assert.True(t, sleep(context.Background(), time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.False(t, sleep(ctx, time.Hour))
+}
@@ -0,0 +1,67 @@
+package node
+
+import (
+	"fmt"
+
+	"github.com/d1nch8g/consensuscraft/config"
+	"github.com/d1nch8g/consensuscraft/database"
+)
+
+// Option customizes a node created by New
+type Option func(*Node)
+
+// ValidatorProfile is the item policy of a node: the item namespaces it accepts, what happens to
+// inventories holding other items and the custom rules of the network
+type ValidatorProfile struct {
+	Namespaces      []string                 // Accepted item namespaces, all items when empty
+	NamespaceAction string                   // strip removes other items, reject drops the whole inventory
+	Rules           []database.ValidatorRule // Applied by the validators of this node only
+}
+
+// profileFromConfig returns the profile set by ALLOWED_NAMESPACES and NAMESPACE_ACTION
+func profileFromConfig(cfg *config.Config) ValidatorProfile {
+	return ValidatorProfile{
+		Namespaces:      cfg.AllowedNamespaces,
+		NamespaceAction: cfg.NamespaceAction,
+	}
+}
+
+// validate checks the namespace settings and builds the namespace rule, nil when all items are accepted
+func (p ValidatorProfile) validate() (*database.NamespaceRule, error) {
+	if len(p.Namespaces) == 0 {
+		return nil, nil
+	}
+
+	namespaces, err := database.NewNamespaceRule(p.Namespaces)
+	if err != nil {
+		return nil, fmt.Errorf("invalid allowed namespaces: %w", err)
+	}
+	if p.NamespaceAction != "strip" && p.NamespaceAction != "reject" {
+		return nil, fmt.Errorf("namespace action must be strip or reject, got %q", p.NamespaceAction)
+	}
+	return namespaces, nil
+}
+
+// WithDatabase runs the node on an open database instead of inventories.ldb in the working
+// directory, the caller keeps ownership and closes it after Stop
+func WithDatabase(db *database.DB) Option {
+	return func(n *Node) {
+		n.db = db
+		n.ownsDB = false
+	}
+}
+
+// WithValidatorProfile replaces the item policy read from the configuration
+func WithValidatorProfile(profile ValidatorProfile) Option {
+	return func(n *Node) {
+		n.profile = profile
+	}
+}
+
+// WithPeers sets the nodes joined and kept in sync with, replacing CONNECTED_NODE
+// Updates queued while no peer was reachable are pushed to the first peer that answers
+func WithPeers(addresses ...string) Option {
+	return func(n *Node) {
+		n.peerAddresses = append([]string(nil), addresses...)
+	}
+}
@@ -0,0 +1,47 @@
+package node
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// OriginMigrationFile records that the legacy origin lines of the database were migrated
+const OriginMigrationFile = "origin_migration.json"
+
+// originMigration is the content of OriginMigrationFile
+type originMigration struct {
+	At     time.Time                       `json:"at"`
+	Format string                          `json:"format"`
+	Report *database.OriginMigrationReport `json:"report"`
+}
+
+// migrateOriginLore rewrites the legacy origin lines of the database once, later entries are
+// normalized as they are stored
+func migrateOriginLore(db *database.DB) error {
+	if _, err := os.Stat(OriginMigrationFile); err == nil {
+		return nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("unable to check origin migration: %w", err)
+	}
+
+	report, err := db.MigrateOriginLore()
+	if err != nil {
+		return fmt.Errorf("unable to migrate legacy origin lore: %w", err)
+	}
+	logger.Infof("Migrated legacy origin lore of %d records, %d entries rewritten", report.Records, report.Entries)
+
+	data, err := json.Marshal(originMigration{At: time.Now(), Format: database.CurrentOriginFormat().String(), Report: report})
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(OriginMigrationFile, data, 0644); err != nil {
+		return fmt.Errorf("unable to record origin migration: %w", err)
+	}
+	return nil
+}
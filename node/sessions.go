@@ -0,0 +1,16 @@
+package node
+
+import (
+	"github.com/d1nch8g/consensuscraft/bds"
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// SessionsFile logs the ended player sessions with their client addresses
+const SessionsFile = "sessions.jsonl"
+
+// recordSession appends an ended player session to the session log
+func (n *Node) recordSession(session bds.Session) {
+	if err := n.sessions.Append(session); err != nil {
+		logger.Warnf("Unable to record session of %s: %v", session.Player, err)
+	}
+}
@@ -0,0 +1,125 @@
+package shard
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// DefaultReplicas is the number of virtual points each node gets on the ring
+const DefaultReplicas = 128
+
+// Ring assigns keys to nodes by consistent hashing, so adding or removing a node
+// only moves the keys of its neighbours on the ring
+type Ring struct {
+	mu       sync.RWMutex
+	replicas int
+	points   []uint64
+	owners   map[uint64]string
+	nodes    map[string]bool
+}
+
+// NewRing creates a ring placing every node at replicas virtual points, DefaultReplicas when not positive
+func NewRing(replicas int, nodes ...string) *Ring {
+	if replicas <= 0 {
+		replicas = DefaultReplicas
+	}
+
+	r := &Ring{
+		replicas: replicas,
+		owners:   make(map[uint64]string),
+		nodes:    make(map[string]bool),
+	}
+	for _, node := range nodes {
+		r.Add(node)
+	}
+
+	return r
+}
+
+// hash maps a string to a point on the ring
+func hash(value string) uint64 {
+	sum := sha256.Sum256([]byte(value))
+	return binary.BigEndian.Uint64(sum[:8])
+}
+
+// Add places a node on the ring, adding a node twice has no effect
+func (r *Ring) Add(node string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.nodes[node] {
+		return
+	}
+	r.nodes[node] = true
+
+	for i := 0; i < r.replicas; i++ {
+		point := hash(node + "#" + strconv.Itoa(i))
+		if _, taken := r.owners[point]; taken {
+			continue
+		}
+		r.owners[point] = node
+		r.points = append(r.points, point)
+	}
+	sort.Slice(r.points, func(i, j int) bool { return r.points[i] < r.points[j] })
+}
+
+// Remove takes a node off the ring, its keys move to the following nodes
+func (r *Ring) Remove(node string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.nodes[node] {
+		return
+	}
+	delete(r.nodes, node)
+
+	points := r.points[:0]
+	for _, point := range r.points {
+		if r.owners[point] == node {
+			delete(r.owners, point)
+			continue
+		}
+		points = append(points, point)
+	}
+	r.points = points
+}
+
+// Has reports whether a node is on the ring
+func (r *Ring) Has(node string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.nodes[node]
+}
+
+// Nodes returns the nodes on the ring in sorted order
+func (r *Ring) Nodes() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	nodes := make([]string, 0, len(r.nodes))
+	for node := range r.nodes {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+	return nodes
+}
+
+// Owner returns the node responsible for a key, or an empty string for an empty ring
+func (r *Ring) Owner(key string) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if len(r.points) == 0 {
+		return ""
+	}
+
+	point := hash(key)
+	i := sort.Search(len(r.points), func(i int) bool { return r.points[i] >= point })
+	if i == len(r.points) {
+		i = 0
+	}
+	return r.owners[r.points[i]]
+}
@@ -0,0 +1,69 @@
+package shard
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRing_Owner(t *testing.T) {
+	t.Run("empty ring", func(t *testing.T) {
+		assert.Empty(t, NewRing(0).Owner("alice"))
+	})
+
+	t.Run("deterministic", func(t *testing.T) {
+		a := NewRing(0, "a", "b", "c")
+		b := NewRing(0, "c", "a", "b")
+		for i := 0; i < 100; i++ {
+			key := fmt.Sprintf("player%d", i)
+			assert.Equal(t, a.Owner(key), b.Owner(key))
+		}
+	})
+
+	t.Run("balanced", func(t *testing.T) {
+		ring := NewRing(0, "a", "b", "c", "d")
+		counts := make(map[string]int)
+		for i := 0; i < 10000; i++ {
+			counts[ring.Owner(fmt.Sprintf("player%d", i))]++
+		}
+
+		require.Len(t, counts, 4)
+		for node, count := range counts {
+			assert.InDelta(t, 2500, count, 750, "node %s", node)
+		}
+	})
+}
+
+func TestRing_AddRemove(t *testing.T) {
+	ring := NewRing(0, "a", "b", "c")
+	before := make(map[string]string)
+	for i := 0; i < 5000; i++ {
+		key := fmt.Sprintf("player%d", i)
+		before[key] = ring.Owner(key)
+	}
+
+	t.Run("adding a node only moves keys to it", func(t *testing.T) {
+		ring.Add("d")
+		ring.Add("d")
+		assert.Equal(t, []string{"a", "b", "c", "d"}, ring.Nodes())
+
+		moved := 0
+		for key, owner := range before {
+			if now := ring.Owner(key); now != owner {
+				assert.Equal(t, "d", now)
+				moved++
+			}
+		}
+		assert.InDelta(t, 1250, moved, 500)
+	})
+
+	t.Run("removing it restores the layout", func(t *testing.T) {
+		ring.Remove("d")
+		assert.False(t, ring.Has("d"))
+		for key, owner := range before {
+			assert.Equal(t, owner, ring.Owner(key))
+		}
+	})
+}
@@ -0,0 +1,132 @@
+package startup
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// Phase statuses reported for each startup phase
+const (
+	StatusRunning = "running"
+	StatusOK      = "ok"
+	StatusFailed  = "failed"
+)
+
+// Phase is a named step of node startup, retried independently of the other phases
+type Phase struct {
+	Name     string
+	Attempts int           // Tries before the phase fails, at least one
+	Backoff  time.Duration // Wait between tries
+	Run      func() error
+}
+
+// PhaseResult is how a phase went, timings cover every attempt
+type PhaseResult struct {
+	Name       string    `json:"name"`
+	Status     string    `json:"status"`
+	Attempts   int       `json:"attempts"`
+	StartedAt  time.Time `json:"started_at"`
+	DurationMS int64     `json:"duration_ms"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// Snapshot is the machine readable startup report
+type Snapshot struct {
+	StartedAt  time.Time     `json:"started_at"`
+	DurationMS int64         `json:"duration_ms"`
+	Completed  bool          `json:"completed"`
+	Phases     []PhaseResult `json:"phases"`
+}
+
+// Report runs startup phases in order and records their outcome
+type Report struct {
+	mu        sync.Mutex
+	started   time.Time
+	finished  time.Time
+	completed bool
+	phases    []PhaseResult
+	sleep     func(time.Duration)
+}
+
+// NewReport starts a startup report
+func NewReport() *Report {
+	return &Report{started: time.Now(), sleep: time.Sleep}
+}
+
+// Run runs a phase until it succeeds or runs out of attempts, returning the last error
+func (r *Report) Run(phase Phase) error {
+	attempts := max(phase.Attempts, 1)
+
+	r.mu.Lock()
+	index := len(r.phases)
+	r.phases = append(r.phases, PhaseResult{Name: phase.Name, Status: StatusRunning, StartedAt: time.Now()})
+	r.mu.Unlock()
+
+	started := time.Now()
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		r.update(index, func(result *PhaseResult) { result.Attempts = attempt })
+
+		if err = phase.Run(); err == nil {
+			break
+		}
+		if attempt < attempts {
+			logger.Warnf("Startup phase %s failed (attempt %d of %d), retrying in %s: %v", phase.Name, attempt, attempts, phase.Backoff, err)
+			r.sleep(phase.Backoff)
+		}
+	}
+	elapsed := time.Since(started)
+
+	r.update(index, func(result *PhaseResult) {
+		result.DurationMS = elapsed.Milliseconds()
+		result.Status = StatusOK
+		if err != nil {
+			result.Status = StatusFailed
+			result.Error = err.Error()
+		}
+	})
+
+	if err != nil {
+		logger.Errorf("Startup phase %s failed after %s: %v", phase.Name, elapsed.Round(time.Millisecond), err)
+		return fmt.Errorf("startup phase %s: %w", phase.Name, err)
+	}
+	logger.Infof("Startup phase %s finished in %s", phase.Name, elapsed.Round(time.Millisecond))
+	return nil
+}
+
+// Complete marks startup as finished once every phase succeeded
+func (r *Report) Complete() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.finished = time.Now()
+	r.completed = true
+	logger.Infof("Startup completed in %s", r.finished.Sub(r.started).Round(time.Millisecond))
+}
+
+// Snapshot returns the report so far
+func (r *Report) Snapshot() Snapshot {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	end := r.finished
+	if !r.completed {
+		end = time.Now()
+	}
+
+	return Snapshot{
+		StartedAt:  r.started,
+		DurationMS: end.Sub(r.started).Milliseconds(),
+		Completed:  r.completed,
+		Phases:     append([]PhaseResult{}, r.phases...),
+	}
+}
+
+func (r *Report) update(index int, change func(result *PhaseResult)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	change(&r.phases[index])
+}
@@ -0,0 +1,76 @@
+package startup
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestReport() *Report {
+	r := NewReport()
+	r.sleep = func(time.Duration) {}
+	return r
+}
+
+func TestReport_Run(t *testing.T) {
+	r := newTestReport()
+
+	require.NoError(t, r.Run(Phase{Name: "config", Run: func() error { return nil }}))
+
+	tries := 0
+	require.NoError(t, r.Run(Phase{Name: "db", Attempts: 3, Backoff: time.Second, Run: func() error {
+		tries++
+		if tries < 2 {
+			return errors.New("locked")
+		}
+		return nil
+	}}))
+
+	snapshot := r.Snapshot()
+	assert.False(t, snapshot.Completed)
+	require.Len(t, snapshot.Phases, 2)
+	assert.Equal(t, "config", snapshot.Phases[0].Name)
+	assert.Equal(t, StatusOK, snapshot.Phases[0].Status)
+	assert.Equal(t, 1, snapshot.Phases[0].Attempts)
+	assert.Equal(t, StatusOK, snapshot.Phases[1].Status)
+	assert.Equal(t, 2, snapshot.Phases[1].Attempts)
+	assert.Empty(t, snapshot.Phases[1].Error)
+
+	r.Complete()
+	assert.True(t, r.Snapshot().Completed)
+}
+
+func TestReport_RunFailure(t *testing.T) {
+	r := newTestReport()
+	locked := errors.New("locked")
+
+	tries := 0
+	err := r.Run(Phase{Name: "db", Attempts: 3, Run: func() error {
+		tries++
+		return locked
+	}})
+	assert.ErrorIs(t, err, locked)
+	assert.ErrorContains(t, err, "startup phase db")
+	assert.Equal(t, 3, tries)
+
+	snapshot := r.Snapshot()
+	require.Len(t, snapshot.Phases, 1)
+	assert.Equal(t, StatusFailed, snapshot.Phases[0].Status)
+	assert.Equal(t, 3, snapshot.Phases[0].Attempts)
+	assert.Equal(t, "locked", snapshot.Phases[0].Error)
+}
+
+func TestReport_RunningPhase(t *testing.T) {
+	r := newTestReport()
+
+	require.NoError(t, r.Run(Phase{Name: "network", Run: func() error {
+		// A phase in progress is already reported
+		snapshot := r.Snapshot()
+		require.Len(t, snapshot.Phases, 1)
+		assert.Equal(t, StatusRunning, snapshot.Phases[0].Status)
+		return nil
+	}}))
+}
@@ -0,0 +1,520 @@
+package testkit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/bds"
+	"github.com/d1nch8g/consensuscraft/database"
+	"github.com/d1nch8g/consensuscraft/gen/pb"
+	"github.com/d1nch8g/consensuscraft/keys"
+	"github.com/d1nch8g/consensuscraft/network"
+)
+
+// DefaultTimeout bounds how long helpers wait for asynchronous node activity
+const DefaultTimeout = 5 * time.Second
+
+// Cluster is a set of in-process consensuscraft nodes connected over loopback links
+// Every node serves the peer protocol on a 127.0.0.1 listener, updates replicate through network.Push
+type Cluster struct {
+	Nodes []*Node
+
+	mu           sync.Mutex
+	disconnected map[string]bool
+	failed       []error // Loopback deliveries a peer refused to store
+	inflight     sync.WaitGroup
+}
+
+// Node is a single in-process node with an in-memory database, a peer server and a simulated BDS
+type Node struct {
+	Name    string
+	DB      *database.DB
+	Address string // Loopback address the peer server listens on
+
+	cluster   *Cluster
+	km        *keys.KeyManager
+	handshake *pb.RegisterNodeRequest
+	peers     *network.Peers
+	server    *network.Server
+	stdout    *io.PipeWriter
+	stderr    *io.PipeWriter
+	stdin     *commandRecorder
+	ingested  chan error
+	received  chan receiveResult
+}
+
+type receiveResult struct {
+	player string
+	size   int
+	err    error
+}
+
+// NewCluster launches n nodes named node-0 ... node-(n-1), joined to each other, and registers cleanup on t
+// Node keys are kept in a temporary working directory the test runs in until cleanup
+// The test fails on cleanup when a peer refused a loopback delivery, see Errors
+func NewCluster(t testing.TB, n int) *Cluster {
+	t.Helper()
+
+	workDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("failed to read the working directory: %v", err)
+	}
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatalf("failed to enter a temporary directory: %v", err)
+	}
+	t.Cleanup(func() { os.Chdir(workDir) })
+
+	names := make([]string, n)
+	for i := range names {
+		names[i] = fmt.Sprintf("node-%d", i)
+	}
+
+	c := &Cluster{disconnected: make(map[string]bool)}
+	for _, name := range names {
+		node, err := c.newNode(name, names)
+		if err != nil {
+			c.Close()
+			t.Fatalf("failed to start node %s: %v", name, err)
+		}
+		c.Nodes = append(c.Nodes, node)
+	}
+	for _, node := range c.Nodes {
+		if err := c.join(node); err != nil {
+			c.Close()
+			t.Fatalf("failed to join node %s to its peers: %v", node.Name, err)
+		}
+	}
+
+	// Cleanups run last registered first, so deliveries are done by the time they are checked
+	t.Cleanup(func() {
+		for _, err := range c.Errors() {
+			t.Errorf("loopback delivery failed: %v", err)
+		}
+	})
+	t.Cleanup(c.Close)
+	return c
+}
+
+// Node returns the node with the given name or nil
+func (c *Cluster) Node(name string) *Node {
+	for _, n := range c.Nodes {
+		if n.Name == name {
+			return n
+		}
+	}
+	return nil
+}
+
+// Disconnect cuts the loopback links of a node, updates are neither sent nor received
+func (c *Cluster) Disconnect(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.disconnected[name] = true
+}
+
+// Connect restores the loopback links of a node and joins it to its peers again, merging the updates
+// it missed, a failed join is reported by Errors
+func (c *Cluster) Connect(name string) {
+	c.mu.Lock()
+	delete(c.disconnected, name)
+	c.mu.Unlock()
+
+	if n := c.Node(name); n != nil {
+		if err := c.join(n); err != nil {
+			c.fail(err)
+		}
+	}
+}
+
+// Sync waits until every in-flight loopback delivery has been applied
+func (c *Cluster) Sync() {
+	c.inflight.Wait()
+}
+
+// Errors returns the loopback deliveries peers refused to store so far, after waiting for those in flight
+func (c *Cluster) Errors() []error {
+	c.Sync()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]error(nil), c.failed...)
+}
+
+// Ban removes a server from every connected node, the same way BANNED_NODES does on startup
+func (c *Cluster) Ban(server string) error {
+	c.Sync()
+	for _, n := range c.Nodes {
+		if c.isDisconnected(n.Name) {
+			continue
+		}
+		if err := n.DB.Delete(server, true); err != nil {
+			return fmt.Errorf("failed to ban %s on %s: %w", server, n.Name, err)
+		}
+	}
+	return nil
+}
+
+// Close stops the simulated servers and closes every database
+func (c *Cluster) Close() {
+	c.Sync()
+	for _, n := range c.Nodes {
+		n.close()
+	}
+}
+
+// AssertConverged fails the test if connected nodes disagree on a player's latest inventory
+func (c *Cluster) AssertConverged(t testing.TB, player string) {
+	t.Helper()
+	c.Sync()
+
+	var reference []byte
+	var referenceNode string
+	for _, n := range c.Nodes {
+		if c.isDisconnected(n.Name) {
+			continue
+		}
+		inventory, err := n.DB.Get(player)
+		if err != nil && err != database.ErrPlayerNotFound {
+			t.Errorf("node %s failed to read %s: %v", n.Name, player, err)
+			return
+		}
+		if referenceNode == "" {
+			reference, referenceNode = inventory, n.Name
+			continue
+		}
+		if string(inventory) != string(reference) {
+			t.Errorf("nodes %s and %s diverged for %s: %s != %s", referenceNode, n.Name, player, reference, inventory)
+		}
+	}
+}
+
+// AssertBanned fails the test if any connected node still holds entries or items from a server
+func (c *Cluster) AssertBanned(t testing.TB, server string) {
+	t.Helper()
+	c.Sync()
+
+	validator := database.NewItemValidator()
+	for _, n := range c.Nodes {
+		if c.isDisconnected(n.Name) {
+			continue
+		}
+		for _, player := range n.Players() {
+			entries, err := n.DB.GetPlayerInventories(player)
+			if err != nil {
+				t.Errorf("node %s failed to read %s: %v", n.Name, player, err)
+				continue
+			}
+			for _, entry := range entries {
+				if entry.Server == server {
+					t.Errorf("node %s still has an entry from %s for %s", n.Name, server, player)
+				}
+				for _, item := range items(entry.Inventory) {
+					if validator.HasOriginFromServer(&item, server) {
+						t.Errorf("node %s still has %s from %s in %s's inventory", n.Name, item.TypeID, server, player)
+					}
+				}
+			}
+		}
+	}
+}
+
+// AssertNoDuplicates fails the test if any node holds the same unique item in more than one inventory
+func (c *Cluster) AssertNoDuplicates(t testing.TB) {
+	t.Helper()
+	c.Sync()
+
+	for _, n := range c.Nodes {
+		for fingerprint, players := range n.Duplicates() {
+			t.Errorf("node %s has duplicated item %s held by %s", n.Name, fingerprint, strings.Join(players, ", "))
+		}
+	}
+}
+
+// fail records a loopback delivery that did not go through
+func (c *Cluster) fail(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.failed = append(c.failed, err)
+}
+
+func (c *Cluster) isDisconnected(name string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.disconnected[name]
+}
+
+// join registers a node with every connected peer and merges their databases, as nodes do with their configured peers
+func (c *Cluster) join(n *Node) error {
+	for _, peer := range c.Nodes {
+		if peer == n || c.isDisconnected(peer.Name) {
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+		_, err := network.Join(ctx, peer.Address, n.handshake, n.km, n.DB, n.peers)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("node %s failed to join %s: %w", n.Name, peer.Name, err)
+		}
+	}
+	return nil
+}
+
+// broadcast pushes a locally accepted update to every connected peer
+func (c *Cluster) broadcast(from *Node, player string) {
+	if c.isDisconnected(from.Name) {
+		return
+	}
+
+	for _, peer := range c.Nodes {
+		if peer == from || c.isDisconnected(peer.Name) {
+			continue
+		}
+
+		c.inflight.Add(1)
+		go func(peer *Node) {
+			defer c.inflight.Done()
+
+			ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+			defer cancel()
+			if _, err := network.Push(ctx, peer.Address, from.Name, from.km, from.DB, []string{player}); err != nil {
+				c.fail(fmt.Errorf("node %s refused %s from %s: %w", peer.Name, player, from.Name, err))
+			}
+		}(peer)
+	}
+}
+
+// newNode starts a node serving the peer protocol, every node of the cluster is on its allowlist
+func (c *Cluster) newNode(name string, cluster []string) (*Node, error) {
+	km, err := keys.New(name)
+	if err != nil {
+		return nil, err
+	}
+	handshake, err := network.NewHandshake(km, name, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	db, err := database.NewMemory()
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	peers := network.NewPeers(nil)
+	server := network.NewServer(handshake, km, db, peers)
+	server.SetAllowlist(cluster)
+	go server.Serve(listener)
+
+	stdoutReader, stdoutWriter := io.Pipe()
+	stderrReader, stderrWriter := io.Pipe()
+
+	n := &Node{
+		Name:      name,
+		DB:        db,
+		Address:   listener.Addr().String(),
+		cluster:   c,
+		km:        km,
+		handshake: handshake,
+		peers:     peers,
+		server:    server,
+		stdout:    stdoutWriter,
+		stderr:    stderrWriter,
+		stdin:     &commandRecorder{},
+		ingested:  make(chan error, 100),
+		received:  make(chan receiveResult, 100),
+	}
+
+	params := bds.Parameters{
+		InventoryReceiveCallback: func(playerName string) ([]byte, error) {
+			inventory, err := n.DB.Get(playerName)
+			n.received <- receiveResult{player: playerName, size: len(inventory), err: err}
+			return inventory, err
+		},
+		InventoryUpdateCallback: func(playerName string, inventory []byte) error {
+			err := n.DB.Put(playerName, inventory, n.Name)
+			if err == nil {
+				c.broadcast(n, playerName)
+			}
+			n.ingested <- err
+			return err
+		},
+		WebAddress: name,
+	}
+
+	parser := bds.NewOutputParser(params.InventoryReceiveCallback, params.InventoryUpdateCallback)
+	parser.Start(nil, params, stdoutReader, stderrReader, n.stdin)
+
+	return n, nil
+}
+
+// EnderChest simulates the behavior pack logging an ender chest update and waits until it is stored
+func (n *Node) EnderChest(player string, inventory string) error {
+	line := fmt.Sprintf("[X_ENDER_CHEST][%s][%s]\n", player, inventory)
+	if _, err := n.stdout.Write([]byte(line)); err != nil {
+		return fmt.Errorf("failed to write log line: %w", err)
+	}
+
+	select {
+	case err := <-n.ingested:
+		return err
+	case <-time.After(DefaultTimeout):
+		return fmt.Errorf("timed out waiting for %s to ingest update for %s", n.Name, player)
+	}
+}
+
+// Spawn simulates a player spawning and returns the restore commands sent to the server
+func (n *Node) Spawn(player string) ([]string, error) {
+	line := fmt.Sprintf("[2024-01-01 00:00:00:000 INFO] Player Spawned: %s xuid: 0\n", player)
+	if _, err := n.stdout.Write([]byte(line)); err != nil {
+		return nil, fmt.Errorf("failed to write log line: %w", err)
+	}
+
+	var result receiveResult
+	select {
+	case result = <-n.received:
+	case <-time.After(DefaultTimeout):
+		return nil, fmt.Errorf("timed out waiting for %s to look up %s", n.Name, player)
+	}
+	if result.err != nil {
+		return nil, result.err
+	}
+
+	// Restoration chunks the inventory into 1500 character tags
+	expected := (result.size + 1499) / 1500
+	deadline := time.Now().Add(DefaultTimeout)
+	for {
+		commands := n.stdin.forPlayer(player)
+		if len(commands) >= expected {
+			return commands, nil
+		}
+		if time.Now().After(deadline) {
+			return commands, fmt.Errorf("timed out waiting for restore commands for %s", player)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// Players returns every player known to the node's database
+func (n *Node) Players() []string {
+	var players []string
+	iter := n.DB.NewIterator()
+	if iter == nil {
+		return nil
+	}
+	defer iter.Release()
+
+	for iter.Next() {
+		players = append(players, string(iter.Key()))
+	}
+	sort.Strings(players)
+	return players
+}
+
+// Duplicates returns unique items (named or enchanted) that appear in more than one player's latest inventory,
+// keyed by item fingerprint so copies with edited lore are still caught
+func (n *Node) Duplicates() map[string][]string {
+	holders := make(map[string][]string)
+	for _, player := range n.Players() {
+		inventory, err := n.DB.Get(player)
+		if err != nil {
+			continue
+		}
+		for _, item := range items(inventory) {
+			if item.NameTag == "" && len(item.Enchantments) == 0 {
+				continue
+			}
+			data, err := json.Marshal(&item)
+			if err != nil {
+				continue
+			}
+			fingerprint, err := database.Fingerprint(data)
+			if err != nil {
+				continue
+			}
+			holders[fingerprint] = append(holders[fingerprint], player)
+		}
+	}
+
+	duplicates := make(map[string][]string)
+	for fingerprint, players := range holders {
+		if len(players) > 1 {
+			duplicates[fingerprint] = players
+		}
+	}
+	return duplicates
+}
+
+func (n *Node) close() {
+	n.server.Stop()
+	n.stdout.Close()
+	n.stderr.Close()
+	n.DB.Close()
+}
+
+// items flattens an inventory including nested shulker contents, skipping unparseable slots
+func items(inventory []byte) []database.Item {
+	var slots []json.RawMessage
+	if err := json.Unmarshal(inventory, &slots); err != nil {
+		return nil
+	}
+
+	var result []database.Item
+	for _, slot := range slots {
+		var item database.Item
+		if string(slot) == "null" || json.Unmarshal(slot, &item) != nil {
+			continue
+		}
+		result = append(result, item)
+
+		if len(item.ShulkerContents) > 0 {
+			contents, err := json.Marshal(item.ShulkerContents)
+			if err == nil {
+				result = append(result, items(contents)...)
+			}
+		}
+	}
+	return result
+}
+
+// commandRecorder captures commands the parser writes to the simulated server stdin
+type commandRecorder struct {
+	mu       sync.Mutex
+	commands []string
+}
+
+func (r *commandRecorder) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.commands = append(r.commands, strings.TrimSpace(string(p)))
+	return len(p), nil
+}
+
+func (r *commandRecorder) Close() error {
+	return nil
+}
+
+func (r *commandRecorder) forPlayer(player string) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var result []string
+	prefix := fmt.Sprintf(`tag "%s" add`, player)
+	for _, command := range r.commands {
+		if strings.HasPrefix(command, prefix) {
+			result = append(result, command)
+		}
+	}
+	return result
+}
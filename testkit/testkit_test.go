@@ -0,0 +1,149 @@
+package testkit
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/d1nch8g/consensuscraft/database"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCluster_Convergence(t *testing.T) {
+	cluster := NewCluster(t, 3)
+
+	inventory := `[{"typeId":"minecraft:diamond","amount":5,"lore":["Origin: node-0"]},null]`
+	require.NoError(t, cluster.Nodes[0].EnderChest("alice", inventory))
+
+	cluster.AssertConverged(t, "alice")
+
+	for _, node := range cluster.Nodes {
+		stored, err := node.DB.Get("alice")
+		require.NoError(t, err)
+		assert.JSONEq(t, inventory, string(stored), "node %s", node.Name)
+	}
+}
+
+func TestCluster_SpawnRestoresInventory(t *testing.T) {
+	cluster := NewCluster(t, 2)
+
+	inventory := `[{"typeId":"minecraft:apple","amount":3,"lore":["Origin: node-0"]}]`
+	require.NoError(t, cluster.Nodes[0].EnderChest("bob", inventory))
+	cluster.Sync()
+
+	commands, err := cluster.Nodes[1].Spawn("bob")
+	require.NoError(t, err)
+	require.Len(t, commands, 1)
+	assert.Contains(t, commands[0], `tag "bob" add "restore_inv_0_`)
+	assert.Contains(t, commands[0], `minecraft:apple`)
+}
+
+func TestCluster_ReplicatesOverPeerConnections(t *testing.T) {
+	cluster := NewCluster(t, 2)
+
+	require.NoError(t, cluster.Nodes[0].EnderChest("judy", `[{"typeId":"minecraft:iron_ingot","amount":4,"lore":["Origin: node-0"]}]`))
+	cluster.Sync()
+
+	// Every node handshaked with the others and the update arrived through the peer server
+	for _, node := range cluster.Nodes {
+		peers := node.peers.List()
+		require.Len(t, peers, 1, "node %s", node.Name)
+		assert.NotEmpty(t, peers[0].Address, "node %s", node.Name)
+	}
+	entries, err := cluster.Nodes[1].DB.GetPlayerInventories("judy")
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "node-0", entries[0].Server)
+}
+
+func TestCluster_DisconnectedNodeDiverges(t *testing.T) {
+	cluster := NewCluster(t, 2)
+	cluster.Disconnect("node-1")
+
+	require.NoError(t, cluster.Nodes[0].EnderChest("carol", `[{"typeId":"minecraft:coal","amount":1,"lore":["Origin: node-0"]}]`))
+	cluster.Sync()
+
+	assert.Equal(t, []string{"carol"}, cluster.Nodes[0].Players())
+	assert.Empty(t, cluster.Nodes[1].Players())
+
+	// Joining again merges what the node missed
+	cluster.Connect("node-1")
+	cluster.AssertConverged(t, "carol")
+
+	require.NoError(t, cluster.Nodes[0].EnderChest("carol", `[{"typeId":"minecraft:coal","amount":2,"lore":["Origin: node-0"]}]`))
+	cluster.AssertConverged(t, "carol")
+}
+
+func TestCluster_BanPropagation(t *testing.T) {
+	cluster := NewCluster(t, 3)
+
+	require.NoError(t, cluster.Nodes[0].EnderChest("dave", `[{"typeId":"minecraft:diamond","amount":1,"lore":["Origin: node-0"]}]`))
+	require.NoError(t, cluster.Nodes[1].EnderChest("erin", `[{"typeId":"minecraft:diamond","amount":1,"lore":["Origin: node-1"]},{"typeId":"minecraft:bread","amount":1,"lore":["Origin: node-0"]}]`))
+
+	require.NoError(t, cluster.Ban("node-0"))
+
+	cluster.AssertBanned(t, "node-0")
+	cluster.AssertConverged(t, "erin")
+}
+
+func TestCluster_NoDuplicates(t *testing.T) {
+	cluster := NewCluster(t, 2)
+
+	require.NoError(t, cluster.Nodes[0].EnderChest("heidi", `[{"typeId":"minecraft:bow","amount":1,"nameTag":"Longshot","lore":["Origin: node-0"]}]`))
+	require.NoError(t, cluster.Nodes[1].EnderChest("ivan", `[{"typeId":"minecraft:bow","amount":1,"nameTag":"Shortshot","lore":["Origin: node-1"]}]`))
+
+	cluster.AssertNoDuplicates(t)
+}
+
+func TestCluster_DuplicationDetection(t *testing.T) {
+	cluster := NewCluster(t, 2)
+
+	// The copy had its lore edited, it is still the same item
+	sword := `{"typeId":"minecraft:diamond_sword","amount":1,"nameTag":"Excalibur","lore":["Origin: node-0"]}`
+	copied := `{"typeId":"minecraft:diamond_sword","amount":1,"nameTag":"Excalibur","lore":["Origin: node-1"]}`
+	require.NoError(t, cluster.Nodes[0].EnderChest("frank", "["+sword+"]"))
+	require.NoError(t, cluster.Nodes[1].EnderChest("grace", "["+copied+"]"))
+	cluster.Sync()
+
+	for _, node := range cluster.Nodes {
+		duplicates := node.Duplicates()
+		require.Len(t, duplicates, 1, "node %s", node.Name)
+		for _, players := range duplicates {
+			assert.ElementsMatch(t, []string{"frank", "grace"}, players)
+		}
+	}
+}
+
+// recordingTB collects the failures and cleanups of a cluster instead of handing them to the test
+type recordingTB struct {
+	testing.TB
+	failures []string
+	cleanups []func()
+}
+
+func (r *recordingTB) Helper() {}
+
+func (r *recordingTB) Cleanup(f func()) { r.cleanups = append(r.cleanups, f) }
+
+func (r *recordingTB) Errorf(format string, args ...any) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func TestCluster_DeliveryErrors(t *testing.T) {
+	recorder := &recordingTB{TB: t}
+	cluster := NewCluster(recorder, 2)
+
+	require.NoError(t, cluster.Nodes[1].DB.Close())
+	require.NoError(t, cluster.Nodes[0].EnderChest("ivan", `[{"typeId":"minecraft:dirt","amount":1,"lore":["Origin: node-0"]}]`))
+
+	errs := cluster.Errors()
+	require.Len(t, errs, 1)
+	assert.ErrorContains(t, errs[0], database.ErrClosed.Error())
+
+	for i := len(recorder.cleanups) - 1; i >= 0; i-- {
+		recorder.cleanups[i]()
+	}
+	require.Len(t, recorder.failures, 1)
+	assert.Contains(t, recorder.failures[0], "node node-1 refused ivan from node-0")
+}
@@ -0,0 +1,152 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OTLPExporter sends spans to an OpenTelemetry collector using OTLP/HTTP with JSON encoding
+type OTLPExporter struct {
+	endpoint    string
+	serviceName string
+	headers     map[string]string
+	client      *http.Client
+}
+
+// NewOTLPExporter creates an exporter posting to {endpoint}/v1/traces
+func NewOTLPExporter(endpoint, serviceName string, headers map[string]string) *OTLPExporter {
+	return &OTLPExporter{
+		endpoint:    strings.TrimSuffix(endpoint, "/") + "/v1/traces",
+		serviceName: serviceName,
+		headers:     headers,
+		client:      &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+type otlpKeyValue struct {
+	Key   string `json:"key"`
+	Value struct {
+		StringValue string `json:"stringValue"`
+	} `json:"value"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+type otlpScopeSpans struct {
+	Scope struct {
+		Name string `json:"name"`
+	} `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpResourceSpans struct {
+	Resource struct {
+		Attributes []otlpKeyValue `json:"attributes"`
+	} `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+// Export posts a batch of spans to the collector
+func (e *OTLPExporter) Export(spans []*Span) error {
+	body, err := e.encode(spans)
+	if err != nil {
+		return fmt.Errorf("failed to encode spans: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, e.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range e.headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to export spans: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("export failed with status: %s", resp.Status)
+	}
+
+	return nil
+}
+
+// encode converts spans into the OTLP JSON request body
+func (e *OTLPExporter) encode(spans []*Span) ([]byte, error) {
+	var scope otlpScopeSpans
+	scope.Scope.Name = "github.com/d1nch8g/consensuscraft"
+
+	for _, span := range spans {
+		span.mu.Lock()
+		encoded := otlpSpan{
+			TraceID:           hex.EncodeToString(span.TraceID[:]),
+			SpanID:            hex.EncodeToString(span.SpanID[:]),
+			Name:              span.Name,
+			Kind:              1, // SPAN_KIND_INTERNAL
+			StartTimeUnixNano: strconv.FormatInt(span.Start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(span.End.UnixNano(), 10),
+		}
+
+		if span.ParentID != [8]byte{} {
+			encoded.ParentSpanID = hex.EncodeToString(span.ParentID[:])
+		}
+
+		keys := make([]string, 0, len(span.Attributes))
+		for key := range span.Attributes {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			encoded.Attributes = append(encoded.Attributes, keyValue(key, span.Attributes[key]))
+		}
+
+		if span.Err != nil {
+			encoded.Status = otlpStatus{Code: 2, Message: span.Err.Error()} // STATUS_CODE_ERROR
+		}
+		span.mu.Unlock()
+
+		scope.Spans = append(scope.Spans, encoded)
+	}
+
+	var resource otlpResourceSpans
+	resource.Resource.Attributes = []otlpKeyValue{keyValue("service.name", e.serviceName)}
+	resource.ScopeSpans = []otlpScopeSpans{scope}
+
+	return json.Marshal(otlpRequest{ResourceSpans: []otlpResourceSpans{resource}})
+}
+
+func keyValue(key, value string) otlpKeyValue {
+	kv := otlpKeyValue{Key: key}
+	kv.Value.StringValue = value
+	return kv
+}
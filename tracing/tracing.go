@@ -0,0 +1,283 @@
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/d1nch8g/consensuscraft/logger"
+)
+
+// exportErrorInterval is how often failed exports are logged, a collector that is down fails every batch
+const exportErrorInterval = time.Minute
+
+// Exporter receives finished spans in batches
+type Exporter interface {
+	Export(spans []*Span) error
+}
+
+// Span is a single timed operation within a trace
+type Span struct {
+	TraceID    [16]byte
+	SpanID     [8]byte
+	ParentID   [8]byte
+	Name       string
+	Start      time.Time
+	End        time.Time
+	Attributes map[string]string
+	Err        error
+
+	mu       sync.Mutex
+	tracer   *Tracer
+	finished bool
+}
+
+// Tracer batches finished spans and hands them to an exporter
+type Tracer struct {
+	exporter  Exporter
+	batchSize int
+	interval  time.Duration
+
+	spans chan *Span
+	done  chan struct{}
+	wg    sync.WaitGroup
+
+	// Failed exports since the last one logged, only used by the export loop
+	failures int
+	lost     int
+	reported time.Time
+}
+
+type spanKey struct{}
+
+var (
+	globalMu     sync.RWMutex
+	globalTracer *Tracer
+)
+
+// NewTracer creates a tracer that exports batches of up to batchSize spans at least every interval
+func NewTracer(exporter Exporter, batchSize int, interval time.Duration) *Tracer {
+	t := &Tracer{
+		exporter:  exporter,
+		batchSize: batchSize,
+		interval:  interval,
+		spans:     make(chan *Span, batchSize*4),
+		done:      make(chan struct{}),
+	}
+
+	t.wg.Add(1)
+	go t.loop()
+
+	return t
+}
+
+// Init installs a global tracer exporting to the given exporter
+func Init(exporter Exporter) *Tracer {
+	t := NewTracer(exporter, 512, 5*time.Second)
+
+	globalMu.Lock()
+	previous := globalTracer
+	globalTracer = t
+	globalMu.Unlock()
+
+	if previous != nil {
+		previous.Shutdown()
+	}
+
+	return t
+}
+
+// Shutdown flushes pending spans and stops the global tracer
+func Shutdown() {
+	globalMu.Lock()
+	t := globalTracer
+	globalTracer = nil
+	globalMu.Unlock()
+
+	if t != nil {
+		t.Shutdown()
+	}
+}
+
+// Start begins a span, as a child of the span in ctx if there is one
+// When no tracer is installed the returned span is a no-op
+func Start(ctx context.Context, name string) (context.Context, *Span) {
+	globalMu.RLock()
+	t := globalTracer
+	globalMu.RUnlock()
+
+	return t.Start(ctx, name)
+}
+
+// Start begins a span on this tracer
+func (t *Tracer) Start(ctx context.Context, name string) (context.Context, *Span) {
+	if t == nil {
+		return ctx, nil
+	}
+
+	span := &Span{
+		Name:       name,
+		Start:      time.Now(),
+		Attributes: make(map[string]string),
+		tracer:     t,
+	}
+
+	if parent, ok := ctx.Value(spanKey{}).(*Span); ok && parent != nil {
+		span.TraceID = parent.TraceID
+		span.ParentID = parent.SpanID
+	} else {
+		rand.Read(span.TraceID[:])
+	}
+	rand.Read(span.SpanID[:])
+
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+// Shutdown flushes pending spans and stops the export loop
+func (t *Tracer) Shutdown() {
+	close(t.done)
+	t.wg.Wait()
+}
+
+// loop collects finished spans and exports them in batches
+func (t *Tracer) loop() {
+	defer t.wg.Done()
+
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	batch := make([]*Span, 0, t.batchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := t.exporter.Export(batch); err != nil {
+			t.exportFailed(err, len(batch))
+		}
+		batch = make([]*Span, 0, t.batchSize)
+	}
+
+	for {
+		select {
+		case span := <-t.spans:
+			batch = append(batch, span)
+			if len(batch) >= t.batchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-t.done:
+			for {
+				select {
+				case span := <-t.spans:
+					batch = append(batch, span)
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+// exportFailed logs a failed export, at most once every exportErrorInterval with the failures since
+func (t *Tracer) exportFailed(err error, spans int) {
+	t.failures++
+	t.lost += spans
+	if now := time.Now(); now.Sub(t.reported) >= exportErrorInterval {
+		logger.Warnf("Failed to export traces, %d spans lost in %d failed exports: %v", t.lost, t.failures, err)
+		t.failures, t.lost, t.reported = 0, 0, now
+	}
+}
+
+// SetAttribute records a key/value pair on the span
+func (s *Span) SetAttribute(key, value string) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.Attributes[key] = value
+}
+
+// RecordError marks the span as failed
+func (s *Span) RecordError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.Err = err
+}
+
+// Finish ends the span and queues it for export, spans are dropped if the queue is full
+func (s *Span) Finish() {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	if s.finished {
+		s.mu.Unlock()
+		return
+	}
+	s.finished = true
+	s.End = time.Now()
+	s.mu.Unlock()
+
+	select {
+	case s.tracer.spans <- s:
+	default:
+	}
+}
+
+// TraceIDHex returns the trace id as a hex string
+func (s *Span) TraceIDHex() string {
+	return hex.EncodeToString(s.TraceID[:])
+}
+
+// SpanIDHex returns the span id as a hex string
+func (s *Span) SpanIDHex() string {
+	return hex.EncodeToString(s.SpanID[:])
+}
+
+// Traceparent returns the W3C traceparent header naming the span in ctx as the parent of remote
+// spans, empty when ctx carries no span
+func Traceparent(ctx context.Context) string {
+	span, ok := ctx.Value(spanKey{}).(*Span)
+	if !ok || span == nil {
+		return ""
+	}
+	return "00-" + span.TraceIDHex() + "-" + span.SpanIDHex() + "-01"
+}
+
+// WithTraceparent returns ctx carrying the remote span of a W3C traceparent header, so spans started
+// from it join the caller's trace, ctx is returned unchanged for a missing or invalid header
+func WithTraceparent(ctx context.Context, traceparent string) context.Context {
+	parts := strings.Split(traceparent, "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" || (parts[0] == "00" && len(parts) != 4) {
+		return ctx
+	}
+
+	remote := &Span{}
+	traceID, err := hex.DecodeString(parts[1])
+	if err != nil || len(traceID) != len(remote.TraceID) {
+		return ctx
+	}
+	spanID, err := hex.DecodeString(parts[2])
+	if err != nil || len(spanID) != len(remote.SpanID) {
+		return ctx
+	}
+	copy(remote.TraceID[:], traceID)
+	copy(remote.SpanID[:], spanID)
+	if remote.TraceID == [16]byte{} || remote.SpanID == [8]byte{} {
+		return ctx
+	}
+
+	return context.WithValue(ctx, spanKey{}, remote)
+}
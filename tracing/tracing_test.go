@@ -0,0 +1,215 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingExporter struct {
+	mu    sync.Mutex
+	spans []*Span
+}
+
+func (r *recordingExporter) Export(spans []*Span) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.spans = append(r.spans, spans...)
+	return nil
+}
+
+type failingExporter struct{}
+
+func (failingExporter) Export([]*Span) error { return errors.New("collector unreachable") }
+
+func TestTracer_LogsExportErrors(t *testing.T) {
+	var output bytes.Buffer
+	log.SetOutput(&output)
+	defer log.SetOutput(os.Stderr)
+
+	tracer := NewTracer(failingExporter{}, 10, time.Hour)
+	_, span := tracer.Start(context.Background(), "span")
+	span.Finish()
+	tracer.Shutdown()
+
+	// Later failures within the interval are counted for the next warning instead of logged
+	tracer.exportFailed(errors.New("collector unreachable"), 3)
+	tracer.exportFailed(errors.New("collector unreachable"), 4)
+
+	warnings := strings.Split(strings.TrimSpace(output.String()), "\n")
+	require.Len(t, warnings, 1)
+	assert.Contains(t, warnings[0], "WARN")
+	assert.Contains(t, warnings[0], "1 spans lost in 1 failed exports: collector unreachable")
+	assert.Equal(t, 2, tracer.failures)
+	assert.Equal(t, 7, tracer.lost)
+}
+
+func TestStart_WithoutTracer(t *testing.T) {
+	ctx, span := Start(context.Background(), "noop")
+	assert.Nil(t, span)
+	assert.NotNil(t, ctx)
+
+	assert.NotPanics(t, func() {
+		span.SetAttribute("key", "value")
+		span.RecordError(errors.New("boom"))
+		span.Finish()
+	})
+}
+
+func TestTracer_ParentChild(t *testing.T) {
+	exporter := &recordingExporter{}
+	tracer := NewTracer(exporter, 10, time.Hour)
+
+	ctx, parent := tracer.Start(context.Background(), "parent")
+	_, child := tracer.Start(ctx, "child")
+	child.RecordError(errors.New("failed"))
+	child.Finish()
+	parent.SetAttribute("player", "alice")
+	parent.Finish()
+	parent.Finish()
+
+	tracer.Shutdown()
+
+	require.Len(t, exporter.spans, 2)
+	assert.Equal(t, "child", exporter.spans[0].Name)
+	assert.Equal(t, parent.TraceID, child.TraceID)
+	assert.Equal(t, parent.SpanID, child.ParentID)
+	assert.EqualError(t, child.Err, "failed")
+	assert.Equal(t, "alice", parent.Attributes["player"])
+	assert.False(t, parent.End.Before(parent.Start))
+}
+
+func TestTraceparent(t *testing.T) {
+	exporter := &recordingExporter{}
+	tracer := NewTracer(exporter, 10, time.Hour)
+	defer tracer.Shutdown()
+
+	assert.Empty(t, Traceparent(context.Background()))
+
+	ctx, caller := tracer.Start(context.Background(), "caller")
+	traceparent := Traceparent(ctx)
+	assert.Equal(t, "00-"+caller.TraceIDHex()+"-"+caller.SpanIDHex()+"-01", traceparent)
+
+	// The remote side continues the trace of the caller
+	_, remote := tracer.Start(WithTraceparent(context.Background(), traceparent), "remote")
+	assert.Equal(t, caller.TraceID, remote.TraceID)
+	assert.Equal(t, caller.SpanID, remote.ParentID)
+
+	for _, invalid := range []string{
+		"",
+		"garbage",
+		"00-" + caller.TraceIDHex() + "-" + caller.SpanIDHex(),
+		"ff-" + caller.TraceIDHex() + "-" + caller.SpanIDHex() + "-01",
+		"00-00000000000000000000000000000000-" + caller.SpanIDHex() + "-01",
+		"00-" + caller.TraceIDHex() + "-zz" + caller.SpanIDHex()[2:] + "-01",
+	} {
+		ctx := WithTraceparent(context.Background(), invalid)
+		assert.Empty(t, Traceparent(ctx), invalid)
+	}
+}
+
+func TestTracer_BatchSize(t *testing.T) {
+	exporter := &recordingExporter{}
+	tracer := NewTracer(exporter, 2, time.Hour)
+	defer tracer.Shutdown()
+
+	for i := 0; i < 2; i++ {
+		_, span := tracer.Start(context.Background(), "span")
+		span.Finish()
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		exporter.mu.Lock()
+		exported := len(exporter.spans)
+		exporter.mu.Unlock()
+		if exported == 2 {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatal("batch was not exported when full")
+}
+
+func TestGlobalTracer(t *testing.T) {
+	exporter := &recordingExporter{}
+	Init(exporter)
+
+	_, span := Start(context.Background(), "global")
+	require.NotNil(t, span)
+	span.Finish()
+
+	Shutdown()
+
+	require.Len(t, exporter.spans, 1)
+	assert.Equal(t, "global", exporter.spans[0].Name)
+
+	_, span = Start(context.Background(), "after shutdown")
+	assert.Nil(t, span)
+}
+
+func TestOTLPExporter_Export(t *testing.T) {
+	var body []byte
+	var headers http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/traces", r.URL.Path)
+		headers = r.Header
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	exporter := NewOTLPExporter(server.URL+"/", "consensuscraft", map[string]string{"Authorization": "Bearer token"})
+	tracer := NewTracer(exporter, 10, time.Hour)
+
+	ctx, parent := tracer.Start(context.Background(), "bds.ingest")
+	parent.SetAttribute("player", "alice")
+	_, child := tracer.Start(ctx, "bds.inventory_update")
+	child.RecordError(errors.New("database is closed"))
+	child.Finish()
+	parent.Finish()
+	tracer.Shutdown()
+
+	assert.Equal(t, "application/json", headers.Get("Content-Type"))
+	assert.Equal(t, "Bearer token", headers.Get("Authorization"))
+
+	var req otlpRequest
+	require.NoError(t, json.Unmarshal(body, &req))
+	require.Len(t, req.ResourceSpans, 1)
+	assert.Equal(t, "service.name", req.ResourceSpans[0].Resource.Attributes[0].Key)
+	assert.Equal(t, "consensuscraft", req.ResourceSpans[0].Resource.Attributes[0].Value.StringValue)
+
+	spans := req.ResourceSpans[0].ScopeSpans[0].Spans
+	require.Len(t, spans, 2)
+	assert.Equal(t, "bds.inventory_update", spans[0].Name)
+	assert.Equal(t, parent.SpanIDHex(), spans[0].ParentSpanID)
+	assert.Equal(t, parent.TraceIDHex(), spans[0].TraceID)
+	assert.Equal(t, 2, spans[0].Status.Code)
+	assert.Equal(t, "database is closed", spans[0].Status.Message)
+	assert.Empty(t, spans[1].ParentSpanID)
+	assert.Equal(t, "player", spans[1].Attributes[0].Key)
+}
+
+func TestOTLPExporter_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	exporter := NewOTLPExporter(server.URL, "consensuscraft", nil)
+	err := exporter.Export(nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "400")
+}